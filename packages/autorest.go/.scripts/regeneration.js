@@ -144,6 +144,8 @@ generate("azalias", pkgRoot + 'test/swagger/alias.json', 'test/maps/azalias', '-
 
 generate("azarrayofrawjson", pkgRoot + 'test/swagger/arrayOfRawJSON.json', 'test/misc/azarrayofrawjson', '--security=AzureKey --module="azarrayofrawjson" --openapi-type="data-plane" --rawjson-as-bytes=true');
 
+generate("azstatuscoderesponses", pkgRoot + 'test/swagger/statusCodeResponses.json', 'test/misc/azstatuscoderesponses', '--module="azstatuscoderesponses" --openapi-type="data-plane" --single-client --typed-status-code-responses');
+
 const dataprotection = repoRoot + 'swagger/specification/dataprotection/resource-manager/readme.md';
 generateFromReadme("armdataprotection", dataprotection, 'package-2025-07-01', 'test/dataprotection/armdataprotection', '--module=armdataprotection --azure-arm=true --remove-unreferenced-types --inject-spans=false --fix-const-stuttering=true');
 
@@ -54,6 +54,7 @@ export async function generateCode(host: AutorestExtensionHost) {
       { filePrefix },
     );
     await emitter.emit('openapi');
+    await emitter.emitSerDeBenchmarks();
   } catch (E) {
     if (debug) {
       console.error(`${fileURLToPath(import.meta.url)} - FAILURE  ${JSON.stringify(E)} ${(<Error>E).stack}`);
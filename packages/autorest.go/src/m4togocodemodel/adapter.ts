@@ -31,6 +31,15 @@ export async function m4ToGoCodeModel(host: AutorestExtensionHost) {
     );
     options.headerText = await session.getValue('header-text', 'MISSING LICENSE HEADER');
     options.factoryGatherAllParams = await session.getValue('factory-gather-all-params', true);
+    options.streamingJSONSerDe = await session.getValue('streaming-json-serde', false);
+    options.generateSerDeBenchmarks = await session.getValue('generate-serde-benchmarks', false);
+    options.pagerIterators = await session.getValue('pager-iterators', false);
+    options.generateInterfaces = await session.getValue('generate-interfaces', false);
+    options.spanAttributes = await session.getValue('span-attributes', false);
+    options.operationMetrics = await session.getValue('operation-metrics', false);
+    if (options.spanAttributes && !options.injectSpans) {
+      throw new Error('span-attributes requires inject-spans');
+    }
     options.omitConstructors = true;
 
     const azcoreVersion = await session.getValue('azcore-version', '');
@@ -96,6 +96,11 @@ function adaptNextPageMethod(op: m4.Operation, m4CodeModel: m4.CodeModel, client
     const httpMethod = <go.HTTPMethod>op.language.go!.paging.nextLinkOperation.requests![0].protocol.http!.method;
     nextPageMethod = new go.NextPageMethod(nextPageMethodName, client, httpPath, httpMethod, getStatusCodes(op.language.go!.paging.nextLinkOperation));
     populateMethod(op.language.go!.paging.nextLinkOperation, m4CodeModel, nextPageMethod);
+    // by convention, the next link is passed to the next page method as a string param named next*
+    const nextLinkParam = nextPageMethod.parameters.find((param) => param.name.startsWith('next') && param.type.kind === 'string');
+    if (nextLinkParam?.kind === 'headerScalarParam' || nextLinkParam?.kind === 'pathScalarParam' || nextLinkParam?.kind === 'queryScalarParam') {
+      nextPageMethod.nextLinkParam = nextLinkParam;
+    }
     adaptedNextPageMethods.set(nextPageMethodName, nextPageMethod);
   }
   return nextPageMethod;
@@ -116,6 +121,17 @@ function populateMethod(op: m4.Operation, m4CodeModel: m4.CodeModel, method: go.
     }
   };
 
+  const getItemsPath = function (method: go.LROPageableMethod | go.PageableMethod, itemName: string): Array<go.ModelField> | undefined {
+    // find the field in the response envelope's type that contains the items
+    if (method.returns.result?.kind === 'modelResult') {
+      const itemsField = method.returns.result.modelType.fields.find((field) => field.serializedName === itemName && field.type.kind === 'slice');
+      if (itemsField) {
+        return [itemsField];
+      }
+    }
+    return undefined;
+  };
+
   if (method.kind !== 'nextPageMethod') {
     if (hasDescription(op.language.go!)) {
       method.docs.description = op.language.go!.description;
@@ -129,8 +145,13 @@ function populateMethod(op: m4.Operation, m4CodeModel: m4.CodeModel, method: go.
 
     method.optionalParamsGroup = optionalParamsGroup;
     method.returns = adaptResponseEnvelope(m4CodeModel, op, method.receiver.type.pkg, method);
+    if (m4CodeModel.language.go!.typedErrors === true) {
+      method.errorType = adaptServiceError(op, method.receiver.type.pkg);
+    }
 
     if (method.kind === 'lroPageableMethod' || method.kind === 'pageableMethod') {
+      // per x-ms-pageable, the items are in the value field unless specified otherwise
+      method.itemsPath = getItemsPath(method, op.language.go!.paging.itemName ?? 'value');
       if (op.language.go!.paging.nextLinkName) {
         method.strategy = getNextLinkStrategy(method, op.language.go!.paging.nextLinkName);
         if (op.language.go!.paging.nextLinkOperation) {
@@ -269,8 +290,8 @@ function adaptResponseEnvelope(m4CodeModel: m4.CodeModel, op: m4.Operation, pkg:
 
   // now add the result field
   const resultProp = <m4.Property>respEnvSchema.language.go!.resultProp;
-  if (helpers.isMultiRespOperation(op)) {
-    respEnv.result = adaptAnyResult(op, pkg);
+  if (helpers.isMultiRespOperation(op, m4CodeModel.language.go!.statusCodeResults === true)) {
+    respEnv.result = adaptMultiResponseResult(op, pkg, m4CodeModel.language.go!.statusCodeResults === true);
   } else if (resultProp.schema.type === m4.SchemaType.Binary) {
     respEnv.result = new go.BinaryResult(resultProp.language.go!.name);
   } else if (m4CodeModel.language.go!.headAsBoolean && op.requests![0].protocol.http!.method === 'head') {
@@ -689,7 +710,7 @@ function adaptBodyFormat(protocol: m4.Protocols): go.BodyFormat {
   }
 }
 
-function adaptAnyResult(op: m4.Operation, pkg: go.PackageContent): go.AnyResult {
+function adaptMultiResponseResult(op: m4.Operation, pkg: go.PackageContent, statusCodeResults: boolean): go.AnyResult | go.StatusCodeResult {
   const resultTypes: Record<number, go.WireType> = {};
   for (const resp of values(op.responses)) {
     let wireType: go.WireType;
@@ -700,12 +721,47 @@ function adaptAnyResult(op: m4.Operation, pkg: go.PackageContent): go.AnyResult
       continue;
     }
 
-    resultTypes[parseInt(resp.protocol.http!.statusCodes)] = wireType;
+    for (const statusCode of values(<Array<string>>resp.protocol.http!.statusCodes)) {
+      resultTypes[parseInt(statusCode)] = wireType;
+    }
   }
 
+  if (statusCodeResults) {
+    return new go.StatusCodeResult('JSON', resultTypes, pkg);
+  }
   return new go.AnyResult('Value', 'JSON', resultTypes);
 }
 
+// returns the typed error for the operation's error schema.
+// returns undefined if the operation doesn't declare exactly one JSON error schema.
+function adaptServiceError(op: m4.Operation, pkg: go.PackageContent): go.ServiceError | undefined {
+  const errorSchemas = new Set<m4.Schema>();
+  for (const exception of values(op.exceptions)) {
+    if (!helpers.isSchemaResponse(exception) || !helpers.isObjectSchema(exception.schema)) {
+      continue;
+    } else if (exception.protocol.http!.knownMediaType !== KnownMediaType.Json) {
+      return undefined;
+    }
+    errorSchemas.add(exception.schema);
+  }
+  if (errorSchemas.size !== 1) {
+    return undefined;
+  }
+
+  const errorModel = adaptWireType([...errorSchemas][0], pkg);
+  if (errorModel.kind !== 'model') {
+    // discriminated error models aren't supported
+    return undefined;
+  }
+  // methods that return the same error model share the typed error
+  let serviceError = pkg.errors.find((each) => each.model === errorModel);
+  if (!serviceError) {
+    serviceError = new go.ServiceError(errorModel);
+    pkg.errors.push(serviceError);
+  }
+  return serviceError;
+}
+
 function adaptResultFormat(protocol: m4.Protocols): go.ResultFormat {
   switch (protocol.http!.knownMediaType) {
     case KnownMediaType.Json:
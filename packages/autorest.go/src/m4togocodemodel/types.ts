@@ -25,6 +25,7 @@ export function adaptConstantType(choice: m4.ChoiceSchema | m4.SealedChoiceSchem
   }
   constType = new go.Constant(pkg, choice.language.go!.name, adaptPrimitiveType(choice.choiceType.language.go!.name), choice.language.go!.possibleValuesFunc);
   constType.values = adaptConstantValue(constType, choice.choices);
+  constType.fixed = choice.type === m4.SchemaType.SealedChoice;
   if (hasDescription(choice.language.go!)) {
     constType.docs.description = choice.language.go!.description;
   }
@@ -83,7 +84,7 @@ export function adaptModel(obj: m4.ObjectSchema, pkg: go.PackageContent): go.Mod
     return <go.Model | go.PolymorphicModel>modelType;
   }
 
-  const annotations = new go.ModelAnnotations(obj.language.go!.omitSerDeMethods, false);
+  const annotations = new go.ModelAnnotations(obj.language.go!.omitSerDeMethods, false, false);
   if (obj.discriminator || obj.discriminatorValue) {
     let ifaceName: string | undefined;
     if (obj.language.go!.discriminatorInterface) {
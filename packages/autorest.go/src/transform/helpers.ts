@@ -88,13 +88,8 @@ export function hasPolymorphicField(obj: m4.ObjectSchema): boolean {
   return false;
 }
 
-// returns the schema response for this operation.
-// calling this on multi-response operations will result in an error.
-export function getSchemaResponse(op: m4.Operation): m4.SchemaResponse | undefined {
-  if (!op.responses) {
-    return undefined;
-  }
-  // get the list and count of distinct schema responses
+// returns the distinct schema responses for this operation
+function getDistinctSchemaResponses(op: m4.Operation): Array<m4.SchemaResponse> {
   const schemaResponses = new Array<m4.SchemaResponse>();
   for (const response of values(op.responses)) {
     // perform the comparison by name as some responses have different objects for the same underlying response type
@@ -107,68 +102,78 @@ export function getSchemaResponse(op: m4.Operation): m4.SchemaResponse | undefin
       schemaResponses.push(response);
     }
   }
+  return schemaResponses;
+}
+
+// returns the schema response for the 200 status code or undefined
+function getSchemaResponseFor200(schemaResponses: Array<m4.SchemaResponse>): m4.SchemaResponse | undefined {
+  for (const response of values(schemaResponses)) {
+    if ((<Array<string>>response.protocol.http!.statusCodes).indexOf('200') > -1) {
+      return response;
+    }
+  }
+  return undefined;
+}
+
+// returns the schema response for this operation.
+// calling this on multi-response operations will result in an error.
+export function getSchemaResponse(op: m4.Operation): m4.SchemaResponse | undefined {
+  if (!op.responses) {
+    return undefined;
+  }
+  const schemaResponses = getDistinctSchemaResponses(op);
   if (schemaResponses.length === 0) {
     return undefined;
   } else if (schemaResponses.length === 1) {
     return schemaResponses[0];
   }
   // multiple schema responses, for LROs find the best fit.
-  if (!isLROOperation(op)) {
-    throw new Error('getSchemaResponse() called for multi-response operation');
-  }
   // for LROs, there are a couple of corner-cases we need to handle WRT response types.
-  // 1. 200 Foo / 20x Bar - we take Foo and display a warning
-  // 2. 201 Foo / 202 Bar - this is a hard error
+  // 1. 200 Foo / 20x Bar - we take Foo unless typed-status-code-responses is enabled
+  // 2. 201 Foo / 202 Bar - this is a hard error unless typed-status-code-responses is enabled
   // 3. 200 void / 20x Bar - we take Bar
-  // since we always assume responses[0] has the return type we need to fix up
-  // the list of responses so that it points to the schema we select.
-
-  // multiple schemas, find the one for 200 status code
   // note that case #3 was handled earlier
-  let with200: m4.SchemaResponse | undefined;
-  for (const response of values(schemaResponses)) {
-    if ((<Array<string>>response.protocol.http!.statusCodes).indexOf('200') > -1) {
-      with200 = response;
-      break;
-    }
+  if (!isLROOperation(op)) {
+    throw new Error('getSchemaResponse() called for multi-response operation');
   }
+  const with200 = getSchemaResponseFor200(schemaResponses);
   if (with200 === undefined) {
     // case #2
     throw new Error(`LRO ${op.language.go!.clientName}.${op.language.go!.name} contains multiple response types which is not supported`);
   }
   // case #1
-  // TODO: log warning
   return with200;
 }
 
-// returns true if the operation returns multiple response types
-export function isMultiRespOperation(op: m4.Operation): boolean {
-  // treat LROs as single-response ops
-  if (!op.responses || op.responses.length === 1 || isLROOperation(op)) {
+// returns true if the operation returns multiple response types.
+// LROs are treated as single-response ops unless statusCodeResults is true.
+// throws an error for LROs with multiple response types and none for the 200 status code.
+export function isMultiRespOperation(op: m4.Operation, statusCodeResults = false): boolean {
+  if (!op.responses || op.responses.length === 1) {
     return false;
   }
   // count the number of distinct schemas returned by this operation
-  const schemaResponses = new Array<m4.SchemaResponse>();
-  for (const response of values(op.responses)) {
-    // perform the comparison by name as some responses have different objects for the same underlying response type
-    if (
-      isSchemaResponse(response) &&
-      !values(schemaResponses)
-        .where((sr) => sr.schema.language.go!.name === response.schema.language.go!.name)
-        .any()
-    ) {
-      schemaResponses.push(response);
+  const schemaResponses = getDistinctSchemaResponses(op);
+  if (schemaResponses.length < 2) {
+    return false;
+  } else if (statusCodeResults) {
+    return true;
+  } else if (isLROOperation(op)) {
+    // LROs are treated as single-response ops when one of the response types is for the 200 status code
+    if (getSchemaResponseFor200(schemaResponses) === undefined) {
+      throw new Error(`LRO ${op.language.go!.clientName}.${op.language.go!.name} contains multiple response types which is not supported`);
     }
+    return false;
   }
-  return schemaResponses.length > 1;
+  return true;
 }
 
 // returns the BinaryResponse if the operation returns a binary response or undefined.
 // throws an error if called on a multi-response operation.
-export function isBinaryResponseOperation(op: m4.Operation): m4.BinaryResponse | undefined {
+export function isBinaryResponseOperation(op: m4.Operation, statusCodeResults = false): m4.BinaryResponse | undefined {
   if (!op.responses) {
     return undefined;
-  } else if (isMultiRespOperation(op)) {
+  } else if (isMultiRespOperation(op, statusCodeResults)) {
     throw new Error('isBinaryResponseOperation() called for multi-response operation');
   }
   if (isBinaryResponse(op.responses[0])) {
@@ -82,6 +82,10 @@ export async function namer(session: Session<CodeModel>) {
   model.language.go!.azureARM = azureARM;
   const headAsBoolean = await session.getValue('head-as-boolean', false);
   model.language.go!.headAsBoolean = headAsBoolean;
+  const statusCodeResults = await session.getValue('typed-status-code-responses', false);
+  model.language.go!.statusCodeResults = statusCodeResults;
+  const typedErrors = await session.getValue('typed-errors', false);
+  model.language.go!.typedErrors = typedErrors;
   const groupParameters = await session.getValue('group-parameters', true);
   model.language.go!.groupParameters = groupParameters;
   const honorBodyPlacement = await session.getValue('honor-body-placement', false);
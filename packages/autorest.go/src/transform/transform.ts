@@ -867,7 +867,7 @@ function createResponseEnvelope(codeModel: m4.CodeModel, group: m4.OperationGrou
     respEnv.language.go!.resultProp = successProp;
     return;
   }
-  if (helpers.isMultiRespOperation(op)) {
+  if (helpers.isMultiRespOperation(op, codeModel.language.go!.statusCodeResults === true)) {
     const resultTypes = new Array<string>();
     for (const response of values(op.responses)) {
       // the operation might contain a mix of schemas and non-schema responses.
@@ -917,7 +917,7 @@ function createResponseEnvelope(codeModel: m4.CodeModel, group: m4.OperationGrou
     }
     respEnv.properties.push(resultProp);
     respEnv.language.go!.resultProp = resultProp;
-  } else if (helpers.isBinaryResponseOperation(op)) {
+  } else if (helpers.isBinaryResponseOperation(op, codeModel.language.go!.statusCodeResults === true)) {
     const binaryProp = newProperty('Body', 'Body contains the streaming response.', newBinary('binary response'));
     binaryProp.language.go!.byValue = true;
     respEnv.properties.push(binaryProp);
@@ -1161,8 +1161,9 @@ async function labelUnreferencedTypes(session: Session<m4.CodeModel>) {
   if (!isRemoveUnreferencedTypes) return;
 
   const referencedTypes = new Set<m4.Schema>();
+  const typedErrors = await session.getValue('typed-errors', false);
 
-  iterateOperations(model, referencedTypes);
+  iterateOperations(model, referencedTypes, typedErrors);
 
   labelOmitTypes(model.schemas.choices, referencedTypes);
   labelOmitTypes(model.schemas.sealedChoices, referencedTypes);
@@ -1184,15 +1185,18 @@ function labelOmitTypes<Type extends m4.Schema>(modelSchemas: Array<Type> | unde
 // For each operation, we will aggregate all the parameters' type of the operation and put them into the referencedTypes set.
 // Also, all responses body types and header types will be put into the referencedTypes set.
 // Some special cases: exceptions response types, x-ms-odata types.
-function iterateOperations(model: m4.CodeModel, referencedTypes: Set<m4.Schema>) {
+function iterateOperations(model: m4.CodeModel, referencedTypes: Set<m4.Schema>, typedErrors: boolean) {
   for (const group of values(model.operationGroups)) {
     for (const op of values(group.operations)) {
       for (const param of values(helpers.aggregateParameters(op))) {
         dfsSchema(param.schema, referencedTypes);
       }
-      // We do not use error responses' definition to unmarshal response. So exceptions will be ignored.
-      // const responses = values(op.responses).concat(values(op.exceptions));
-      const responses = values(op.responses);
+      // error responses' definitions are only used to unmarshal responses for typed errors.
+      // otherwise, exceptions will be ignored.
+      let responses = values(op.responses).toArray();
+      if (typedErrors) {
+        responses = responses.concat(values(op.exceptions).toArray());
+      }
       for (const resp of values(responses)) {
         if (helpers.isSchemaResponse(resp)) {
           dfsSchema(resp.schema, referencedTypes);
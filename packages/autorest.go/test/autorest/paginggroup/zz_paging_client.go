@@ -1220,7 +1220,7 @@ func (client *PagingClient) replaceAPIVersionHandleResponse(resp *http.Response)
 	return result, nil
 }
 
-// nextFragmentCreateRequest creates the nextFragmentCreateRequest request.
+// nextFragmentCreateRequest creates the NextFragment request.
 func (client *PagingClient) nextFragmentCreateRequest(ctx context.Context, apiVersion string, tenant string, nextLink string) (*policy.Request, error) {
 	urlPath := "/paging/multiple/fragment/{tenant}/{nextLink}"
 	if tenant == "" {
@@ -1239,7 +1239,7 @@ func (client *PagingClient) nextFragmentCreateRequest(ctx context.Context, apiVe
 	return req, nil
 }
 
-// nextFragmentWithGroupingCreateRequest creates the nextFragmentWithGroupingCreateRequest request.
+// nextFragmentWithGroupingCreateRequest creates the NextFragmentWithGrouping request.
 func (client *PagingClient) nextFragmentWithGroupingCreateRequest(ctx context.Context, nextLink string, customParameterGroup CustomParameterGroup) (*policy.Request, error) {
 	urlPath := "/paging/multiple/fragmentwithgrouping/{tenant}/{nextLink}"
 	if customParameterGroup.Tenant == "" {
@@ -1258,7 +1258,7 @@ func (client *PagingClient) nextFragmentWithGroupingCreateRequest(ctx context.Co
 	return req, nil
 }
 
-// nextOperationWithQueryParamsCreateRequest creates the nextOperationWithQueryParamsCreateRequest request.
+// nextOperationWithQueryParamsCreateRequest creates the NextOperationWithQueryParams request.
 func (client *PagingClient) nextOperationWithQueryParamsCreateRequest(ctx context.Context) (*policy.Request, error) {
 	urlPath := "/paging/multiple/nextOperationWithQueryParams"
 	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.endpoint, urlPath))
@@ -9,6 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/fake/server"
 	"io"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strings"
 	"sync"
@@ -42,6 +43,16 @@ func getOptional[T any](v T) *T {
 	return &v
 }
 
+func nextPageRequest(req *http.Request, nextLink string) (*http.Request, error) {
+	nextURL, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, err
+	}
+	nextReq := req.Clone(req.Context())
+	nextReq.URL = nextURL
+	return nextReq, nil
+}
+
 func parseOptional[T any](v string, parse func(v string) (T, error)) (*T, error) {
 	if v == "" {
 		return nil, nil
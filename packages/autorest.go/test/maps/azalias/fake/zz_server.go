@@ -432,6 +432,14 @@ func (s *ServerTransport) dispatchNewListWithSharedNextOnePager(req *http.Reques
 	if s.srv.NewListWithSharedNextOnePager == nil {
 		return nil, &nonRetriableError{errors.New("fake for method NewListWithSharedNextOnePager not implemented")}
 	}
+	nextLink := getHeaderValue(req.Header, "nextLink")
+	if nextLink != "" {
+		nextReq, err := nextPageRequest(req, nextLink)
+		if err != nil {
+			return nil, err
+		}
+		req = nextReq
+	}
 	newListWithSharedNextOnePager := s.newListWithSharedNextOnePager.get(req)
 	if newListWithSharedNextOnePager == nil {
 		resp := s.srv.NewListWithSharedNextOnePager(nil)
@@ -459,6 +467,14 @@ func (s *ServerTransport) dispatchNewListWithSharedNextTwoPager(req *http.Reques
 	if s.srv.NewListWithSharedNextTwoPager == nil {
 		return nil, &nonRetriableError{errors.New("fake for method NewListWithSharedNextTwoPager not implemented")}
 	}
+	nextLink := getHeaderValue(req.Header, "nextLink")
+	if nextLink != "" {
+		nextReq, err := nextPageRequest(req, nextLink)
+		if err != nil {
+			return nil, err
+		}
+		req = nextReq
+	}
 	newListWithSharedNextTwoPager := s.newListWithSharedNextTwoPager.get(req)
 	if newListWithSharedNextTwoPager == nil {
 		resp := s.srv.NewListWithSharedNextTwoPager(nil)
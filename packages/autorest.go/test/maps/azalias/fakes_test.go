@@ -87,3 +87,38 @@ func TestFakeGetScript(t *testing.T) {
 	}, nil)
 	require.NoError(t, err)
 }
+
+func TestFakeNewListWithSharedNextOnePager(t *testing.T) {
+	server := fake.Server{
+		NewListWithSharedNextOnePager: func(options *azalias.ListWithSharedNextOneOptions) (resp azfake.PagerResponder[azalias.ListWithSharedNextOneResponse]) {
+			resp.AddPage(http.StatusOK, azalias.ListWithSharedNextOneResponse{
+				ListResponse: azalias.ListResponse{
+					Aliases: []azalias.ListItem{{}},
+				},
+			}, nil)
+			resp.AddPage(http.StatusOK, azalias.ListWithSharedNextOneResponse{
+				ListResponse: azalias.ListResponse{
+					Aliases: []azalias.ListItem{{}, {}},
+				},
+			}, nil)
+			return
+		},
+	}
+	client, err := azalias.NewClient(&azalias.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerTransport(&server),
+		},
+	})
+	require.NoError(t, err)
+	pager := client.NewListWithSharedNextOnePager(nil)
+	pages := 0
+	items := 0
+	for pager.More() {
+		page, err := pager.NextPage(context.Background())
+		require.NoError(t, err)
+		pages++
+		items += len(page.Aliases)
+	}
+	require.EqualValues(t, 2, pages)
+	require.EqualValues(t, 3, items)
+}
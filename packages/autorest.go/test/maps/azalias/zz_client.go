@@ -585,7 +585,7 @@ func (client *Client) uploadFormCreateRequest(ctx context.Context, requiredStrin
 	return req, nil
 }
 
-// listLRONextCreateRequest creates the listLRONextCreateRequest request.
+// listLRONextCreateRequest creates the ListLRONext request.
 func (client *Client) listLRONextCreateRequest(ctx context.Context, nextLink string) (*policy.Request, error) {
 	host := "https://{geography}.atlas.microsoft.com"
 	host = strings.ReplaceAll(host, "{geography}", string(client.geography))
@@ -599,7 +599,7 @@ func (client *Client) listLRONextCreateRequest(ctx context.Context, nextLink str
 	return req, nil
 }
 
-// listWithSharedNextCreateRequest creates the listWithSharedNextCreateRequest request.
+// listWithSharedNextCreateRequest creates the ListWithSharedNext request.
 func (client *Client) listWithSharedNextCreateRequest(ctx context.Context, nextLink string) (*policy.Request, error) {
 	host := "https://{geography}.atlas.microsoft.com"
 	host = strings.ReplaceAll(host, "{geography}", string(client.geography))
@@ -0,0 +1,21 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azstatuscoderesponses
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+func NewClient(endpoint string, options *azcore.ClientOptions) (*Client, error) {
+	cl, err := azcore.NewClient(moduleName, moduleVersion, runtime.PipelineOptions{}, options)
+	if err != nil {
+		return nil, err
+	}
+	client := &Client{
+		internal: cl,
+		endpoint: endpoint,
+	}
+	return client, nil
+}
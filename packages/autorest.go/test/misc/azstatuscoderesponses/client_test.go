@@ -0,0 +1,116 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azstatuscoderesponses_test
+
+import (
+	"azstatuscoderesponses"
+	"azstatuscoderesponses/fake"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeClient(t *testing.T, srv *fake.Server) *azstatuscoderesponses.Client {
+	client, err := azstatuscoderesponses.NewClient("https://contoso.com", &azcore.ClientOptions{
+		Transport: fake.NewServerTransport(srv),
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestClient_PutThing(t *testing.T) {
+	statusCode := http.StatusOK
+	client := newFakeClient(t, &fake.Server{
+		PutThing: func(ctx context.Context, widget azstatuscoderesponses.Widget, options *azstatuscoderesponses.PutThingOptions) (resp azfake.Responder[azstatuscoderesponses.PutThingResponse], errResp azfake.ErrorResponder) {
+			var body azstatuscoderesponses.PutThingResponse
+			switch statusCode {
+			case http.StatusOK:
+				body.Widget = &widget
+			case http.StatusCreated:
+				body.Gadget = &azstatuscoderesponses.Gadget{ID: to.Ptr("gadget-" + *widget.Name)}
+			}
+			resp.SetResponse(statusCode, body, nil)
+			return
+		},
+	})
+	widget := azstatuscoderesponses.Widget{Name: to.Ptr("thing"), Count: to.Ptr[int32](3)}
+
+	resp, err := client.PutThing(context.Background(), widget, nil)
+	require.NoError(t, err)
+	require.Equal(t, &widget, resp.Widget)
+	require.Nil(t, resp.Gadget)
+
+	statusCode = http.StatusCreated
+	resp, err = client.PutThing(context.Background(), widget, nil)
+	require.NoError(t, err)
+	require.Nil(t, resp.Widget)
+	require.Equal(t, "gadget-thing", *resp.Gadget.ID)
+
+	statusCode = http.StatusNoContent
+	resp, err = client.PutThing(context.Background(), widget, nil)
+	require.NoError(t, err)
+	require.Zero(t, resp)
+}
+
+func TestClient_BeginCreateThing(t *testing.T) {
+	client := newFakeClient(t, &fake.Server{
+		BeginCreateThing: func(ctx context.Context, widget azstatuscoderesponses.Widget, options *azstatuscoderesponses.BeginCreateThingOptions) (resp azfake.PollerResponder[azstatuscoderesponses.CreateThingResponse], errResp azfake.ErrorResponder) {
+			resp.AddNonTerminalResponse(http.StatusAccepted, nil)
+			resp.SetTerminalResponse(http.StatusCreated, azstatuscoderesponses.CreateThingResponse{Widget: &widget}, nil)
+			return
+		},
+	})
+	widget := azstatuscoderesponses.Widget{Name: to.Ptr("thing"), Count: to.Ptr[int32](3)}
+
+	poller, err := client.BeginCreateThing(context.Background(), widget, nil)
+	require.NoError(t, err)
+	resp, err := poller.PollUntilDone(context.Background(), &runtime.PollUntilDoneOptions{Frequency: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, &widget, resp.Widget)
+	require.Nil(t, resp.Gadget)
+}
+
+// lroTransport responds to the initial request with a 202 and a Location
+// header, and returns the final Widget payload when it's polled.
+type lroTransport struct{}
+
+func (lroTransport) Do(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:  http.Header{},
+		Body:    http.NoBody,
+		Request: req,
+	}
+	if req.Method == http.MethodPut {
+		resp.StatusCode = http.StatusAccepted
+		resp.Header.Set("Location", "https://contoso.com/things/lro/status")
+		return resp, nil
+	}
+	resp.StatusCode = http.StatusOK
+	resp.Header.Set("Content-Type", "application/json")
+	resp.Body = io.NopCloser(strings.NewReader(`{"name":"thing","count":3}`))
+	return resp, nil
+}
+
+func TestClient_BeginCreateThingFinalResult(t *testing.T) {
+	client, err := azstatuscoderesponses.NewClient("https://contoso.com", &azcore.ClientOptions{
+		Transport: lroTransport{},
+	})
+	require.NoError(t, err)
+
+	poller, err := client.BeginCreateThing(context.Background(), azstatuscoderesponses.Widget{Name: to.Ptr("thing")}, nil)
+	require.NoError(t, err)
+	resp, err := poller.PollUntilDone(context.Background(), &runtime.PollUntilDoneOptions{Frequency: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, &azstatuscoderesponses.Widget{Name: to.Ptr("thing"), Count: to.Ptr[int32](3)}, resp.Widget)
+	require.Nil(t, resp.Gadget)
+}
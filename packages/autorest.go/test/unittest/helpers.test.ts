@@ -0,0 +1,53 @@
+import { describe, it, expect } from 'vitest';
+import * as m4 from '@autorest/codemodel';
+import { isBinaryResponseOperation, isMultiRespOperation } from '../../src/transform/helpers.js';
+
+describe('isMultiRespOperation', () => {
+  function newSchemaResponse(schemaName: string, ...statusCodes: Array<string>): m4.SchemaResponse {
+    return <m4.SchemaResponse>{
+      schema: <m4.Schema>{ language: { go: { name: schemaName } } },
+      protocol: { http: { statusCodes: statusCodes } },
+    };
+  }
+
+  function newOperation(lro: boolean, ...responses: Array<m4.Response>): m4.Operation {
+    return <m4.Operation>{
+      language: { go: { name: 'BeginCreate', clientName: 'WidgetsClient' } },
+      extensions: lro ? { 'x-ms-long-running-operation': true } : undefined,
+      responses: responses,
+    };
+  }
+
+  it('treats operations with multiple schemas as multi-response', () => {
+    const op = newOperation(false, newSchemaResponse('Foo', '200'), newSchemaResponse('Bar', '201'));
+    expect(isMultiRespOperation(op)).toBe(true);
+    expect(isMultiRespOperation(op, true)).toBe(true);
+  });
+
+  it('treats operations with a single schema as single-response', () => {
+    const op = newOperation(false, newSchemaResponse('Foo', '200'), newSchemaResponse('Foo', '201'));
+    expect(isMultiRespOperation(op)).toBe(false);
+    expect(isMultiRespOperation(op, true)).toBe(false);
+  });
+
+  it('treats LROs with a 200 schema as single-response without statusCodeResults', () => {
+    const op = newOperation(true, newSchemaResponse('Foo', '200'), newSchemaResponse('Bar', '201'));
+    expect(isMultiRespOperation(op)).toBe(false);
+    expect(isBinaryResponseOperation(op)).toBeUndefined();
+  });
+
+  it('rejects LROs without a 200 schema without statusCodeResults', () => {
+    const op = newOperation(true, newSchemaResponse('Foo', '201'), newSchemaResponse('Bar', '202'));
+    const err = 'LRO WidgetsClient.BeginCreate contains multiple response types which is not supported';
+    expect(() => isMultiRespOperation(op)).toThrow(err);
+    expect(() => isBinaryResponseOperation(op)).toThrow(err);
+  });
+
+  it('treats LROs with multiple schemas as multi-response with statusCodeResults', () => {
+    const with200 = newOperation(true, newSchemaResponse('Foo', '200'), newSchemaResponse('Bar', '201'));
+    expect(isMultiRespOperation(with200, true)).toBe(true);
+    const without200 = newOperation(true, newSchemaResponse('Foo', '201'), newSchemaResponse('Bar', '202'));
+    expect(isMultiRespOperation(without200, true)).toBe(true);
+    expect(() => isBinaryResponseOperation(without200, true)).toThrow('isBinaryResponseOperation() called for multi-response operation');
+  });
+});
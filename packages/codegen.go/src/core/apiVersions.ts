@@ -0,0 +1,57 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from './helpers.js';
+import { ImportManager } from './imports.js';
+
+/**
+ * Creates the content for the api_versions.go file.
+ * this includes the known API versions and the checkAPIVersion
+ * helper that's called by the request builders.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generateAPIVersionChecks(pkg: go.PackageContent): string {
+  // all clients in a package share the same API versions.
+  // pick the longest history in case a client omits some.
+  let history = new Array<string>();
+  for (const client of pkg.clients) {
+    if (client.apiVersionHistory.length > history.length) {
+      history = client.apiVersionHistory;
+    }
+  }
+  if (history.length === 0) {
+    return '';
+  }
+
+  const imports = new ImportManager(pkg);
+  imports.add('fmt');
+  imports.add('slices');
+
+  const body = `// knownAPIVersions contains the API versions supported by the clients in this package, oldest first.
+var knownAPIVersions = []string{${history.map((apiVersion) => `"${apiVersion}"`).join(', ')}}
+
+// checkAPIVersion returns an error if apiVersion is outside of the range [minVersion, maxVersion].
+// An empty minVersion or maxVersion leaves that end of the range open.
+// API versions that aren't in knownAPIVersions aren't checked.
+func checkAPIVersion(name, apiVersion, minVersion, maxVersion string) error {
+	current := slices.Index(knownAPIVersions, apiVersion)
+	if current < 0 {
+		return nil
+	}
+	if minVersion != "" && current < slices.Index(knownAPIVersions, minVersion) {
+		return fmt.Errorf("%s requires API version >= %s", name, minVersion)
+	}
+	if maxVersion != "" && current > slices.Index(knownAPIVersions, maxVersion) {
+		return fmt.Errorf("%s requires API version <= %s", name, maxVersion)
+	}
+	return nil
+}
+`;
+
+  return helpers.contentPreamble(pkg) + imports.text() + body;
+}
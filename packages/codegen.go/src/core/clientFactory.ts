@@ -53,6 +53,9 @@ export function generateClientFactory(pkg: go.PackageContent, target: go.CodeMod
     result += `${indent.get()}${clientParam.name} ${helpers.formatParameterTypeName(pkg, clientParam)}\n`;
   }
   result += `${indent.get()}internal *arm.Client\n`;
+  if (options.operationMetrics) {
+    result += `${indent.get()}meter OperationMeter\n`;
+  }
   result += '}\n\n';
 
   // add factory CTOR
@@ -74,6 +77,13 @@ export function generateClientFactory(pkg: go.PackageContent, target: go.CodeMod
   result += `${indent.get()}if err != nil {\n`;
   result += `${indent.push().get()}return nil, err\n`;
   result += `${indent.pop().get()}}\n`;
+  if (options.operationMetrics) {
+    // the meter is passed to the constructor in the client options
+    result += `${indent.get()}var meter OperationMeter\n`;
+    result += `${indent.get()}if options != nil {\n`;
+    result += `${indent.push().get()}meter = getOperationMeter(options.PerCallPolicies)\n`;
+    result += `${indent.pop().get()}}\n`;
+  }
   result += `${indent.get()}return &ClientFactory{\n`;
 
   indent.push();
@@ -85,6 +95,9 @@ export function generateClientFactory(pkg: go.PackageContent, target: go.CodeMod
     result += `${indent.get()}${clientParam.name}: ${clientParam.name},\n`;
   }
   result += `${indent.get()}internal: internal,\n`;
+  if (options.operationMetrics) {
+    result += `${indent.get()}meter: meter,\n`;
+  }
   result += `${indent.pop().get()}}, nil\n`;
   result += '}\n\n';
 
@@ -116,7 +129,8 @@ export function generateClientFactory(pkg: go.PackageContent, target: go.CodeMod
         })
         .join(', ')}`;
     }
-    result += `) *${client.name} {\n`;
+    // when interfaces are generated, the client is returned as its interface
+    result += `) ${options.generateInterfaces ? helpers.getClientInterfaceName(client) : `*${client.name}`} {\n`;
     result += `${indent.get()}return &${client.name}{\n`;
 
     // some clients (e.g. operations client) don't utilize the client params
@@ -134,6 +148,9 @@ export function generateClientFactory(pkg: go.PackageContent, target: go.CodeMod
     }
 
     result += `${indent.pop().get()}internal: c.internal,\n`;
+    if (options.operationMetrics) {
+      result += `${indent.get()}meter: c.meter,\n`;
+    }
     result += `${indent.get()}}\n`;
     result += '}\n\n';
   }
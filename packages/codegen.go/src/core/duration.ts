@@ -0,0 +1,257 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from './helpers.js';
+import { ImportManager } from './imports.js';
+
+/**
+ * Creates the content for the time.Duration serde helpers.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generateDurationHelpers(pkg: go.PackageContent): string {
+  if (!usesDuration(pkg)) {
+    return '';
+  }
+
+  let text = helpers.contentPreamble(pkg);
+  const imports = new ImportManager(pkg);
+  const body = emitDurationTypes(imports);
+  text += imports.text();
+  text += body;
+  return text;
+}
+
+/**
+ * returns the text for the unexported types used to encode and decode a time.Duration.
+ * there's a type per go.DurationFormat, each named durationFORMAT, along with the
+ * durationConstraints type constraint that's satisfied by all of them.
+ *
+ * @param imports the import manager for the file that will contain the types
+ * @returns the text for the types
+ */
+export function emitDurationTypes(imports: ImportManager): string {
+  imports.add('errors');
+  imports.add('fmt');
+  imports.add('math');
+  imports.add('strconv');
+  imports.add('strings');
+  imports.add('time');
+  let text = `
+type durationConstraints interface {
+	durationISO8601 | durationMilliseconds | durationMillisecondsInt | durationSeconds | durationSecondsInt
+}
+
+// durationISO8601 is a time.Duration that's encoded as an ISO 8601 duration (e.g. PT1H30M).
+type durationISO8601 time.Duration
+
+// MarshalText implements the encoding.TextMarshaler interface for durationISO8601.
+func (d durationISO8601) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// String returns the ISO 8601 representation of the duration.
+func (d durationISO8601) String() string {
+	v := time.Duration(d)
+	if v == 0 {
+		return "PT0S"
+	}
+	var sb strings.Builder
+	if v < 0 {
+		sb.WriteByte('-')
+		v = -v
+	}
+	sb.WriteString("PT")
+	if h := v / time.Hour; h > 0 {
+		sb.WriteString(strconv.FormatInt(int64(h), 10))
+		sb.WriteByte('H')
+		v -= h * time.Hour
+	}
+	if m := v / time.Minute; m > 0 {
+		sb.WriteString(strconv.FormatInt(int64(m), 10))
+		sb.WriteByte('M')
+		v -= m * time.Minute
+	}
+	if v > 0 {
+		sb.WriteString(strconv.FormatFloat(v.Seconds(), 'f', -1, 64))
+		sb.WriteByte('S')
+	}
+	return sb.String()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for durationISO8601.
+func (d *durationISO8601) UnmarshalText(data []byte) error {
+	s := string(data)
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	} else {
+		s = strings.TrimPrefix(s, "+")
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 2 {
+		return fmt.Errorf("invalid ISO 8601 duration %q", string(data))
+	}
+	s = s[1:]
+	var total float64
+	inTime := false
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return fmt.Errorf("invalid ISO 8601 duration %q", string(data))
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+		i := strings.IndexFunc(s, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if i < 1 {
+			return fmt.Errorf("invalid ISO 8601 duration %q", string(data))
+		}
+		v, err := strconv.ParseFloat(strings.Replace(s[:i], ",", ".", 1), 64)
+		if err != nil {
+			return fmt.Errorf("invalid ISO 8601 duration %q", string(data))
+		}
+		var unit time.Duration
+		switch {
+		case !inTime && s[i] == 'W':
+			unit = 7 * 24 * time.Hour
+		case !inTime && s[i] == 'D':
+			unit = 24 * time.Hour
+		case inTime && s[i] == 'H':
+			unit = time.Hour
+		case inTime && s[i] == 'M':
+			unit = time.Minute
+		case inTime && s[i] == 'S':
+			unit = time.Second
+		case !inTime && (s[i] == 'Y' || s[i] == 'M'):
+			return errors.New("ISO 8601 durations with years or months can't be represented as a time.Duration")
+		default:
+			return fmt.Errorf("invalid ISO 8601 duration %q", string(data))
+		}
+		total += v * float64(unit)
+		s = s[i+1:]
+	}
+	if neg {
+		total = -total
+	}
+	*d = durationISO8601(math.Round(total))
+	return nil
+}
+`;
+  for (const format of <Array<go.DurationFormat>>['Milliseconds', 'MillisecondsInt', 'Seconds', 'SecondsInt']) {
+    const isInt = format.endsWith('Int');
+    const unit = isInt ? format.substring(0, format.length - 3) : format;
+    const typeName = `duration${format}`;
+    const timeUnit = `time.${unit.substring(0, unit.length - 1)}`;
+    let encoding = `a number of ${unit.toLowerCase()}`;
+    let stringDoc = `the number of ${unit.toLowerCase()} in the duration`;
+    let stringImpl = `strconv.FormatFloat(float64(d)/float64(${timeUnit}), 'f', -1, 64)`;
+    if (isInt) {
+      encoding = `an integer number of ${unit.toLowerCase()}`;
+      stringDoc += ` rounded to the nearest ${unit.substring(0, unit.length - 1).toLowerCase()}`;
+      stringImpl = `strconv.FormatInt(int64(time.Duration(d).Round(${timeUnit})/${timeUnit}), 10)`;
+    }
+    text += `
+// ${typeName} is a time.Duration that's encoded as ${encoding}.
+type ${typeName} time.Duration
+
+// MarshalJSON implements the json.Marshaller interface for ${typeName}.
+func (d ${typeName}) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for ${typeName}.
+func (d ${typeName}) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// String returns ${stringDoc}.
+func (d ${typeName}) String() string {
+	return ${stringImpl}
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for ${typeName}.
+func (d *${typeName}) UnmarshalJSON(data []byte) error {
+	return d.UnmarshalText(data)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for ${typeName}.
+func (d *${typeName}) UnmarshalText(data []byte) error {
+	v, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return err
+	}
+	*d = ${typeName}(math.Round(v * float64(${timeUnit})))
+	return nil
+}
+`;
+  }
+  return text;
+}
+
+/**
+ * returns true if any of the package's content uses a go.Duration
+ *
+ * @param pkg the package to inspect
+ * @returns true if the package uses a go.Duration
+ */
+export function usesDuration(pkg: go.PackageContent): boolean {
+  for (const model of pkg.models) {
+    for (const field of model.fields) {
+      if (isDuration(field.type)) {
+        return true;
+      }
+    }
+  }
+  for (const client of pkg.clients) {
+    for (const param of client.parameters) {
+      if (isDuration(param.type)) {
+        return true;
+      }
+    }
+    for (const method of client.methods) {
+      for (const param of method.parameters) {
+        if (isDuration(param.type)) {
+          return true;
+        }
+      }
+    }
+  }
+  for (const respEnv of pkg.responseEnvelopes) {
+    for (const header of respEnv.headers) {
+      if (header.kind !== 'setCookieResponse' && isDuration(header.type)) {
+        return true;
+      }
+    }
+    if (respEnv.result?.kind === 'monomorphicResult' && isDuration(respEnv.result.monomorphicType)) {
+      return true;
+    }
+  }
+  return false;
+}
+
+/**
+ * returns true if the type is a go.Duration or contains one
+ *
+ * @param type the type to inspect
+ * @returns true if the type is or contains a go.Duration
+ */
+function isDuration(type: go.Type): boolean {
+  switch (type.kind) {
+    case 'duration':
+      return true;
+    case 'map':
+      return isDuration(type.valueType);
+    case 'slice':
+      return isDuration(type.elementType);
+    default:
+      return false;
+  }
+}
@@ -7,8 +7,10 @@ import * as go from '../../../codemodel.go/src/index.js';
 import * as naming from '../../../naming.go/src/naming.js';
 import * as helpers from './helpers.js';
 import { ImportManager } from './imports.js';
-import { fixUpMethodName } from './operations.js';
+import { fixUpMethodName, getPagerIteratorName } from './operations.js';
+import { isStatusCodeFieldByValue } from './responses.js';
 import { CodegenError } from './errors.js';
+import { getAPIParametersSig, getServerName, getServerResponseSig } from '../fake/servers.js';
 
 // represents the generated content for an example
 export class ExampleContent {
@@ -50,21 +52,9 @@ export function generateExamples(pkg: go.TestPackage, target: go.CodeModelType,
       // clients that are purely hierarchical (i.e. having no APIs) won't need them.
       imports.add('context');
       imports.add('log');
-      imports.add('github.com/Azure/azure-sdk-for-go/sdk/azidentity');
       imports.addForPkg(pkg.src);
     }
 
-    let clientFactoryParams = new Array<go.ClientParameter>();
-    if (options.factoryGatherAllParams) {
-      clientFactoryParams = helpers.getAllClientParameters(pkg.src, target);
-    } else {
-      clientFactoryParams = helpers.getCommonClientParameters(pkg.src, target);
-    }
-    const clientFactoryParamsMap = new Map<string, go.ClientParameter>();
-    for (const param of clientFactoryParams) {
-      clientFactoryParamsMap.set(param.name, param);
-    }
-
     let exampleText = '';
     for (const method of client.methods) {
       for (const example of method.examples) {
@@ -74,100 +64,59 @@ export function generateExamples(pkg: go.TestPackage, target: go.CodeModelType,
         const exampleFuncNamePrefix = method.examples.length > 1 ? `_${helpers.camelCase(example.name)}` : '';
         exampleText += `func Example${client.name}_${fixUpMethodName(method)}${exampleFuncNamePrefix}() {\n`;
 
-        // create credential
-        exampleText += `${indent.get()}cred, err := azidentity.NewDefaultAzureCredential(nil)\n`;
-        exampleText += `${indent.get()}if err != nil {\n`;
-        exampleText += `${indent.push().get()}log.Fatalf("failed to obtain a credential: %v", err)\n`;
-        exampleText += `${indent.pop().get()}}\n`;
+        // runnable examples send their requests to the fake for the method.
+        // unexported methods don't have a fake.
+        const runnable = options.runnableExamples && !helpers.isMethodInternal(method);
 
-        // create context
-        exampleText += `${indent.get()}ctx := context.Background()\n`;
-
-        // create client
-        const clientParameters: go.ParameterExample[] = [];
-        for (const param of method.parameters) {
-          if (param.location === 'client') {
-            if (go.isLiteralParameter(param.style)) {
-              continue;
-            }
-            const clientParam = example.parameters.find((p) => p.parameter.name === param.name);
-            if (clientParam) {
-              clientParameters.push(clientParam);
-            }
-          }
-        }
-        // TODO: client optional parameters
-
-        let clientRef = '';
-        if (azureARM) {
-          // since not all operation has all the client factory required parameters, we need to fake for the missing ones
-          const clientFactoryParamsExample: go.ParameterExample[] = [];
-          for (const clientParam of clientFactoryParams) {
-            const clientFactoryParam = clientParameters.find((p) => p.parameter.name === clientParam.name);
-            if (clientFactoryParam) {
-              clientFactoryParamsExample.push(clientFactoryParam);
-            } else {
-              clientFactoryParamsExample.push({ parameter: clientParam, value: generateFakeExample(clientParam.type, clientParam.name) });
-            }
-          }
-          exampleText += `${indent.get()}clientFactory, err := ${go.getPackageName(pkg.src)}.NewClientFactory(${clientFactoryParamsExample.map((p) => getExampleValue(pkg, p.value, '\t', imports, p.parameter.byValue)).join(', ')}, nil)\n`;
-          exampleText += `${indent.get()}if err != nil {\n`;
-          exampleText += `${indent.push().get()}log.Fatalf("failed to create client: %v", err)\n`;
-          exampleText += `${indent.pop().get()}}\n`;
-          clientRef = `clientFactory.${client.instance.constructors[0].name}(`;
-          // since not all operations have all the client constructor required parameters, we need to generate fake values for the missing ones
-          const clientPrivateParameters: go.ParameterExample[] = [];
-          for (const ctorParam of client.instance.constructors[0].parameters) {
-            if (clientFactoryParamsMap.has(ctorParam.name) || go.isAPIVersionParameter(ctorParam)) {
-              continue;
-            }
-            const existingParam = clientParameters.find((p) => p.parameter.name === ctorParam.name);
-            if (existingParam) {
-              clientPrivateParameters.push(existingParam);
-            } else {
-              clientPrivateParameters.push({ parameter: ctorParam, value: generateFakeExample(ctorParam.type, ctorParam.name) });
-            }
-          }
-          if (clientPrivateParameters.length > 0) {
-            clientRef += `${clientPrivateParameters.map((p) => getExampleValue(pkg, p.value, '\t', imports, p.parameter.byValue).slice(1)).join(', ')}`;
-          }
-          clientRef += `)`;
+        // create credential
+        const credentialParam = client.instance.constructors[0].parameters.find((param) => param.kind === 'credentialParam');
+        if (credentialParam?.type.kind === 'keyCredential') {
+          imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+          exampleText += `${indent.get()}cred := azcore.NewKeyCredential("<key>")\n`;
+        } else if (runnable) {
+          imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/fake', 'azfake');
+          exampleText += `${indent.get()}cred := &azfake.TokenCredential{}\n`;
         } else {
-          exampleText += `${indent.get()}client, err := ${go.getPackageName(client.instance.constructors[0].pkg)}.${client.instance.constructors[0].name}(${clientParameters.map((p) => getExampleValue(pkg, p.value, '\t', imports, p.parameter.byValue).slice(1)).join(', ')}, cred, nil)\n`;
+          imports.add('github.com/Azure/azure-sdk-for-go/sdk/azidentity');
+          exampleText += `${indent.get()}cred, err := azidentity.NewDefaultAzureCredential(nil)\n`;
           exampleText += `${indent.get()}if err != nil {\n`;
-          exampleText += `${indent.push().get()}log.Fatalf("failed to create client: %v", err)\n`;
+          exampleText += `${indent.push().get()}log.Fatalf("failed to obtain a credential: %v", err)\n`;
           exampleText += `${indent.pop().get()}}\n`;
-          clientRef = 'client';
         }
 
-        // call method, using getMethodParameters for correct ordering (including required param groups)
-        const renderedParams: string[] = [];
-        for (const methodParam of helpers.getMethodParameters(method)) {
-          if (methodParam.kind === 'paramGroup') {
-            if (methodParam === method.optionalParamsGroup) continue;
-            imports.addForPkg(methodParam.pkg);
-            const fieldTexts: string[] = [];
-            for (const groupParam of methodParam.params) {
-              if (!shouldRenderParam(groupParam, example)) continue;
-              fieldTexts.push(`${naming.capitalize(groupParam.name)}: ${getParamExampleValue(pkg, groupParam, example, imports)}`);
-            }
-            renderedParams.push(`${go.getPackageName(methodParam.pkg)}.${methodParam.groupName}{${fieldTexts.join(', ')}}`);
-          } else {
-            if (!shouldRenderParam(methodParam, example)) continue;
-            renderedParams.push(getParamExampleValue(pkg, methodParam, example, imports));
-          }
+        // create context
+        exampleText += `${indent.get()}ctx := context.Background()\n`;
+
+        let clientOptions = 'nil';
+        if (runnable) {
+          clientOptions = getFakeClientOptions(pkg, client, azureARM, imports, indent, (indent) => getFakeMethod(pkg, method, example, imports, indent));
         }
 
-        const methodOptionalParameters = example.optionalParamsGroup.filter((p) => p.parameter.location === 'method');
+        // create client
+        const exampleClient = getExampleClient(pkg, client, method, example, target, options, clientOptions, 'log.Fatalf', imports, indent);
+        exampleText += exampleClient.text;
+        const clientRef = exampleClient.clientRef;
+
+        const renderedParams = getExampleArgs(pkg, method, example, imports).map((arg) => arg.value);
+        const methodOptionalParametersText = getOptionalParamsValue(pkg, method, example, imports);
         const checkResponse = example.responseEnvelope !== undefined;
 
-        let methodOptionalParametersText = 'nil';
-        if (methodOptionalParameters.length > 0) {
-          methodOptionalParametersText = `&${go.getPackageName(method.optionalParamsGroup.pkg)}.${method.optionalParamsGroup.groupName}{\n`;
-          methodOptionalParametersText += methodOptionalParameters
-            .map((p) => `${naming.capitalize(p.parameter.name)}: ${getExampleValue(pkg, p.value, '\t', imports, isParamByValue(p)).slice(1)}`)
-            .join(',\n');
-          methodOptionalParametersText += `}`;
+        const iteratorName = getPagerIteratorName(method, options);
+
+        // runnable examples print the scalar fields of the result, or of each item for pageable methods
+        let output: ExampleOutput = { statements: [], lines: [] };
+        if (runnable && example.responseEnvelope) {
+          if (go.isPageableMethod(method)) {
+            const itemsPath = iteratorName ? method.itemsPath : undefined;
+            output = getExampleOutput(getItemExamples(method, example.responseEnvelope.result, itemsPath), 'v', []);
+          } else if (method.returns.result?.kind === 'modelResult') {
+            // the fields of the result are promoted, so skip any that are shadowed by a header
+            const headerFieldNames = method.returns.headers.map((header) => (<any>header).fieldName);
+            output = getExampleOutput([example.responseEnvelope.result], 'res', headerFieldNames);
+          }
+          if (output.statements.length > 0) {
+            imports.add('fmt');
+          }
         }
 
         switch (method.kind) {
@@ -189,15 +138,32 @@ export function generateExamples(pkg: go.TestPackage, target: go.CodeModelType,
             exampleText += `${indent.push().get()}log.Fatalf("failed to finish the request: %v", err)\n`;
             exampleText += `${indent.pop().get()}}\n`;
             break;
-          case 'pageableMethod':
+          case 'pageableMethod': {
+            if (iteratorName) {
+              exampleText += `${indent.get()}for v, err := range ${clientRef}.${iteratorName}(ctx, ${renderedParams.join(', ')}${renderedParams.length > 0 ? ', ' : ''}${methodOptionalParametersText.split('\n').join('\n' + indent.get())}) {\n`;
+              exampleText += `${indent.push().get()}if err != nil {\n`;
+              exampleText += `${indent.push().get()}log.Fatalf("failed to advance page: %v", err)\n`;
+              exampleText += `${indent.pop().get()}}\n`;
+              if (output.statements.length > 0) {
+                exampleText += output.statements.map((statement) => `${indent.get()}${statement}\n`).join('');
+              } else {
+                exampleText += `${indent.get()}// You could use v here. We use blank identifier for just demo purposes.\n`;
+                exampleText += `${indent.get()}_ = v\n`;
+              }
+              exampleText += `${indent.pop().get()}}\n`;
+              break;
+            }
             exampleText += `${indent.get()}pager := ${clientRef}.${fixUpMethodName(method)}(${renderedParams.join(', ')}${renderedParams.length > 0 ? ', ' : ''}${methodOptionalParametersText.split('\n').join('\n' + indent.get())})\n`;
             break;
+          }
           default:
             method satisfies never;
         }
 
         // check response
-        if ((method.kind === 'lroPageableMethod' || method.kind === 'pageableMethod') && example.responseEnvelope) {
+        if (iteratorName) {
+          // the items were consumed in the loop over the iterator
+        } else if ((method.kind === 'lroPageableMethod' || method.kind === 'pageableMethod') && example.responseEnvelope) {
           let resultName = 'pager';
           if (method.kind === 'lroPageableMethod') {
             resultName = 'res';
@@ -209,46 +175,44 @@ export function generateExamples(pkg: go.TestPackage, target: go.CodeModelType,
           exampleText += `${indent.push().get()}log.Fatalf("failed to advance page: %v", err)\n`;
           exampleText += `${indent.pop().get()}}\n`;
           exampleText += `${indent.get()}for _, v := range page.${itemType.name} {\n`;
-          exampleText += `${indent.push().get()}// You could use page here. We use blank identifier for just demo purposes.\n`;
-          exampleText += `${indent.get()}_ = v\n`;
+          indent.push();
+          if (output.statements.length > 0) {
+            exampleText += output.statements.map((statement) => `${indent.get()}${statement}\n`).join('');
+          } else {
+            exampleText += `${indent.get()}// You could use page here. We use blank identifier for just demo purposes.\n`;
+            exampleText += `${indent.get()}_ = v\n`;
+          }
           exampleText += `${indent.pop().get()}}\n`;
-          exampleText += `${indent.get()}// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.\n`;
-          exampleText += `${indent.get()}// page = ${go.getPackageName(example.responseEnvelope.response.method.receiver.type.pkg)}.${example.responseEnvelope.response.name}{\n`;
-          for (const header of example.responseEnvelope.headers ?? []) {
-            exampleText += `${indent.get()}// \t${header.header.fieldName}: ${getExampleValue(pkg, header.value, '', undefined, (header.header as any).byValue)
-              .split('\n')
-              .join(`\n${indent.get()}// \t`)},\n`;
+          if (!runnable) {
+            exampleText += `${indent.get()}// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.\n`;
+            exampleText += `${indent.get()}// page = ${go.getPackageName(example.responseEnvelope.response.method.receiver.type.pkg)}.${example.responseEnvelope.response.name}{\n`;
+            for (const [fieldName, value] of getResponseEnvelopeFields(pkg, method, example.responseEnvelope)) {
+              exampleText += `${indent.get()}// \t${fieldName}: ${value.split('\n').join(`\n${indent.get()}// \t`)},\n`;
+            }
+            exampleText += `${indent.get()}// }\n`;
           }
-          exampleText += `${indent.get()}// \t${(example.responseEnvelope.result.type as go.Model).name}: ${getExampleValue(pkg, example.responseEnvelope.result!, '', undefined, true).split('\n').join(`\n${indent.get()}// \t`)},\n`;
-          exampleText += `${indent.get()}// }\n`;
           exampleText += `${indent.pop().get()}}\n`;
         } else if (example.responseEnvelope) {
-          // if has fieldName, then the result is not a model type
-          const fieldName = (method.returns.result as any)?.fieldName;
-          exampleText += `${indent.get()}// You could use response here. We use blank identifier for just demo purposes.\n`;
-          exampleText += `${indent.get()}_ = res\n`;
-
-          exampleText += `${indent.get()}// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.\n`;
-          exampleText += `${indent.get()}// res = ${go.getPackageName(example.responseEnvelope.response.method.receiver.type.pkg)}.${example.responseEnvelope.response.name}{\n`;
-          for (const header of example.responseEnvelope?.headers ?? []) {
-            exampleText += `${indent.get()}// \t${header.header.fieldName}: ${getExampleValue(pkg, header.value, '', undefined, (header.header as any).byValue)
-              .split('\n')
-              .join(`\n${indent.get()}// \t`)},\n`;
+          if (output.statements.length > 0) {
+            exampleText += output.statements.map((statement) => `${indent.get()}${statement}\n`).join('');
+          } else {
+            exampleText += `${indent.get()}// You could use response here. We use blank identifier for just demo purposes.\n`;
+            exampleText += `${indent.get()}_ = res\n`;
           }
-          if (example.responseEnvelope?.result) {
-            // modelResult and polymorphicResult are anonymously embedded by value in the response struct.
-            // monomorphicResult has an explicit byValue property. all other result types default to by value.
-            let resultByValue = true;
-            let resultFieldName = fieldName ? fieldName : (example.responseEnvelope?.result.type as go.Model).name;
-            if (method.returns.result?.kind === 'monomorphicResult') {
-              resultByValue = method.returns.result.byValue;
-            } else if (method.returns.result?.kind === 'polymorphicResult') {
-              resultFieldName = method.returns.result.interface.name;
-              resultByValue = false;
+          if (!runnable) {
+            exampleText += `${indent.get()}// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.\n`;
+            exampleText += `${indent.get()}// res = ${go.getPackageName(example.responseEnvelope.response.method.receiver.type.pkg)}.${example.responseEnvelope.response.name}{\n`;
+            for (const [fieldName, value] of getResponseEnvelopeFields(pkg, method, example.responseEnvelope)) {
+              exampleText += `${indent.get()}// \t${fieldName}: ${value.split('\n').join(`\n${indent.get()}// \t`)},\n`;
             }
-            exampleText += `${indent.get()}// \t${resultFieldName}: ${getExampleValue(pkg, example.responseEnvelope.result, '', undefined, resultByValue).split('\n').join(`\n${indent.get()}// \t`)},\n`;
+            exampleText += `${indent.get()}// }\n`;
           }
-          exampleText += `${indent.get()}// }\n`;
+        }
+
+        if (runnable) {
+          // the output of the fake's response is checked when the example is run
+          exampleText += `${indent.get()}// Output:\n`;
+          exampleText += output.lines.map((line) => `${indent.get()}// ${line}\n`).join('');
         }
         exampleText += `}\n\n`;
       }
@@ -266,7 +230,7 @@ export function generateExamples(pkg: go.TestPackage, target: go.CodeModelType,
   return examples;
 }
 
-function getExampleValue(pkg: go.TestPackage, example: go.ExampleType, indent: string, imports?: ImportManager, byValue: boolean = false, inArray: boolean = false): string {
+export function getExampleValue(pkg: go.TestPackage, example: go.ExampleType, indent: string, imports?: ImportManager, byValue: boolean = false, inArray: boolean = false): string {
   switch (example.kind) {
     case 'string': {
       let exampleText = `"${escapeString(example.value)}"`;
@@ -274,11 +238,13 @@ function getExampleValue(pkg: go.TestPackage, example: go.ExampleType, indent: s
         exampleText = getConstantValue(pkg, example.type, example.value);
       } else if (example.type.kind === 'time') {
         exampleText = getTimeValue(example.type, example.value, imports);
+      } else if (example.type.kind === 'duration') {
+        exampleText = getDurationValue(example.type, example.value, imports);
       } else if (example.type.kind === 'encodedBytes') {
         exampleText = `[]byte("${escapeString(example.value)}")`;
       } else if (example.type.kind === 'literal' && example.type.type.kind === 'constant') {
         exampleText = getConstantValue(pkg, example.type.type, (<go.ConstantValue>example.type.literal).value);
-      } else if (example.type.kind === 'etag') {
+      } else if (example.type.kind === 'decimal' || example.type.kind === 'etag') {
         imports?.add(example.type.module);
         exampleText = `${go.getTypeDeclaration(example.type, pkg)}("${escapeString(example.value)}")`;
       } else if (example.type.kind === 'scalar' && example.type.type === 'byte') {
@@ -296,6 +262,9 @@ function getExampleValue(pkg: go.TestPackage, example: go.ExampleType, indent: s
         case 'constant':
           exampleText = `${indent}${getConstantValue(pkg, example.type, example.value)}`;
           break;
+        case 'duration':
+          exampleText = getDurationValue(example.type, example.value, imports);
+          break;
         case 'time':
           exampleText = getTimeValue(example.type, example.value, imports);
           break;
@@ -317,7 +286,7 @@ function getExampleValue(pkg: go.TestPackage, example: go.ExampleType, indent: s
       const isElementByValue = example.type.elementTypeByValue;
       // if polymorphic, need to add type name in array, so inArray will be set to false
       // if other case, no need to add type name in array, so inArray will be set to true
-      const isElementPolymorphic = example.type.elementType.kind === 'interface';
+      const isElementPolymorphic = isPolymorphic(example.type.elementType);
       let exampleText = `${indent}${getRef(byValue)}${go.getTypeDeclaration(example.type, pkg)}{\n`;
       for (const element of example.value) {
         exampleText += `${getExampleValue(pkg, element, indent + '\t', imports, isElementByValue && !isElementPolymorphic, !isElementPolymorphic)},\n`;
@@ -328,7 +297,7 @@ function getExampleValue(pkg: go.TestPackage, example: go.ExampleType, indent: s
     case 'dictionary': {
       let exampleText = `${indent}${getRef(byValue)}${go.getTypeDeclaration(example.type, pkg)}{\n`;
       const isValueByValue = example.type.valueTypeByValue;
-      const isValuePolymorphic = example.type.valueType.kind === 'interface';
+      const isValuePolymorphic = isPolymorphic(example.type.valueType);
       for (const key in example.value) {
         exampleText += `${indent}\t"${key}": ${getExampleValue(pkg, example.value[key], indent + '\t', imports, isValueByValue && !isValuePolymorphic).slice(indent.length + 1)},\n`;
       }
@@ -343,7 +312,7 @@ function getExampleValue(pkg: go.TestPackage, example: go.ExampleType, indent: s
       for (const field in example.value) {
         const goField = example.type.fields.find((f) => f.name === field)!;
         const isFieldByValue = goField.byValue ?? false;
-        const isFieldPolymorphic = goField.type.kind === 'interface';
+        const isFieldPolymorphic = isPolymorphic(goField.type);
         exampleText += `${indent}\t${field}: ${getExampleValue(pkg, example.value[field], indent + '\t', imports, isFieldByValue && !isFieldPolymorphic).slice(indent.length + 1)},\n`;
       }
       if (example.additionalProperties) {
@@ -352,7 +321,7 @@ function getExampleValue(pkg: go.TestPackage, example: go.ExampleType, indent: s
           throw new CodegenError('InternalError', `additional properties field type should be map type`);
         }
         const isAdditionalPropertiesFieldByValue = additionalPropertiesField.type.valueTypeByValue ?? false;
-        const isAdditionalPropertiesPolymorphic = additionalPropertiesField.type.valueType.kind === 'interface';
+        const isAdditionalPropertiesPolymorphic = isPolymorphic(additionalPropertiesField.type.valueType);
         exampleText += `${indent}\t${additionalPropertiesField.name}: ${getRef(additionalPropertiesField.byValue)}${go.getTypeDeclaration(additionalPropertiesField.type, pkg)}{\n`;
         for (const key in example.additionalProperties) {
           exampleText += `${indent}\t"${key}": ${getExampleValue(pkg, example.additionalProperties[key], indent + '\t', imports, isAdditionalPropertiesFieldByValue && !isAdditionalPropertiesPolymorphic).slice(indent.length + 1)},\n`;
@@ -364,9 +333,28 @@ function getExampleValue(pkg: go.TestPackage, example: go.ExampleType, indent: s
     }
     case 'tokenCredential':
       return example.value;
+    case 'union': {
+      // unions are always populated with a pointer to the variant's wrapper type
+      let variantName = example.variant.name;
+      if (example.type.pkg !== pkg) {
+        variantName = `${go.getPackageName(example.type.pkg)}.${variantName}`;
+      }
+      const value = getExampleValue(pkg, example.value, indent + '\t', imports, example.variant.byValue).slice(indent.length + 1);
+      return `${indent}&${variantName}{\n${indent}\tValue: ${value},\n${indent}}`;
+    }
   }
 }
 
+/**
+ * returns true if the type is an interface, i.e. values must be wrapped in a concrete type
+ *
+ * @param type the type to inspect
+ * @returns true if the type is a discriminated interface or a union
+ */
+function isPolymorphic(type: go.WireType): boolean {
+  return type.kind === 'interface' || type.kind === 'union';
+}
+
 function getRef(byValue: boolean): string {
   return byValue ? '' : '&';
 }
@@ -404,6 +392,45 @@ function getTimeValue(type: go.Time, value: any, imports?: ImportManager): strin
   }
 }
 
+function getDurationValue(type: go.Duration, value: number | string, imports?: ImportManager): string {
+  let nanoseconds: number;
+  if (typeof value === 'number') {
+    nanoseconds = value * (type.format === 'Milliseconds' || type.format === 'MillisecondsInt' ? 1e6 : 1e9);
+  } else {
+    const match = value.match(/^([-+])?P(?:([\d.,]+)W)?(?:([\d.,]+)D)?(?:T(?:([\d.,]+)H)?(?:([\d.,]+)M)?(?:([\d.,]+)S)?)?$/);
+    if (!match || value.endsWith('P') || value.endsWith('T')) {
+      throw new CodegenError('InvalidArgument', `invalid ISO 8601 duration example value ${value}`);
+    }
+    const units = [7 * 24 * 3600e9, 24 * 3600e9, 3600e9, 60e9, 1e9];
+    nanoseconds = 0;
+    for (let i = 0; i < units.length; ++i) {
+      if (match[i + 2]) {
+        nanoseconds += Number(match[i + 2].replace(',', '.')) * units[i];
+      }
+    }
+    if (match[1] === '-') {
+      nanoseconds = -nanoseconds;
+    }
+  }
+  nanoseconds = Math.round(nanoseconds);
+
+  // use the largest unit that represents the value exactly
+  imports?.add('time');
+  const goUnits: Array<[string, number]> = [
+    ['time.Hour', 3600e9],
+    ['time.Minute', 60e9],
+    ['time.Second', 1e9],
+    ['time.Millisecond', 1e6],
+    ['time.Microsecond', 1e3],
+  ];
+  for (const [unit, size] of goUnits) {
+    if (nanoseconds !== 0 && nanoseconds % size === 0) {
+      return `${nanoseconds / size} * ${unit}`;
+    }
+  }
+  return `time.Duration(${nanoseconds})`;
+}
+
 function getPointerValue(type: go.WireType, valueString: string, byValue: boolean, imports?: ImportManager): string {
   if (byValue) {
     return valueString;
@@ -413,6 +440,8 @@ function getPointerValue(type: go.WireType, valueString: string, byValue: boolea
     case 'any':
     case 'constant':
     case 'constantDef':
+    case 'decimal':
+    case 'duration':
     case 'etag':
     case 'string':
     case 'time':
@@ -502,6 +531,7 @@ function generateFakeExample(goType: go.Type, name?: string): go.ExampleType {
       }
     case 'string':
       return new go.StringExample(`<${name ?? 'test'}>`, goType);
+    case 'keyCredential':
     case 'tokenCredential':
       // we hard code the credential var name to cred
       return new go.TokenCredentialExample('cred');
@@ -510,6 +540,10 @@ function generateFakeExample(goType: go.Type, name?: string): go.ExampleType {
     case 'time':
       // use a placeholder date value for time types
       return new go.StringExample('2006-01-02T15:04:05Z', goType);
+    case 'decimal':
+      return new go.StringExample('0', goType);
+    case 'duration':
+      return new go.NumberExample(0, goType);
     case 'etag':
       return new go.StringExample(`<${name ?? 'etag'}>`, goType);
     case 'model':
@@ -525,6 +559,9 @@ function generateFakeExample(goType: go.Type, name?: string): go.ExampleType {
     case 'interface':
       // for interface types, use the root type (which is a PolymorphicModel) to create an example
       return new go.StructExample(goType.rootType);
+    case 'union':
+      // for union types, use the first variant to create an example
+      return new go.UnionExample(goType, goType.variants[0], generateFakeExample(goType.variants[0].type, name));
     default:
       throw new CodegenError('InternalError', `unhandled fake example kind ${goType.kind}`);
   }
@@ -546,6 +583,7 @@ function escapeString(str: string): string {
 function isParamByValue(p: go.ParameterExample): boolean {
   switch (p.parameter.type.kind) {
     case 'interface':
+    case 'union':
       return p.value.kind === 'null';
     default:
       return p.parameter.byValue;
@@ -575,3 +613,483 @@ function getParamExampleValue(pkg: go.TestPackage, param: go.MethodParameter, ex
   const fakeValue = generateFakeExample(param.type, param.name);
   return getExampleValue(pkg, fakeValue, '\t', imports, param.byValue).slice(1);
 }
+
+// the client used by an example
+export interface ExampleClient {
+  // the statements that create the client
+  text: string;
+
+  // the expression that refers to the client
+  clientRef: string;
+}
+
+/**
+ * returns the statements that create the client for an example.
+ * the credential must already be in scope as cred.
+ *
+ * @param pkg the package that contains the example
+ * @param client the client used in the example
+ * @param method the method called by the example
+ * @param example the example for method
+ * @param target the codegen target for the module
+ * @param options the emitter options
+ * @param clientOptions the text for the client options
+ * @param fatalf the function that reports a failure to create the client
+ * @param imports the import manager currently in scope
+ * @param indent the indentation of the statements
+ * @returns the statements and the expression that refers to the client
+ */
+export function getExampleClient(pkg: go.TestPackage, client: go.Client, method: go.MethodType, example: go.MethodExample, target: go.CodeModelType, options: go.Options, clientOptions: string, fatalf: string, imports: ImportManager, indent: helpers.Indentation): ExampleClient {
+  if (client.instance?.kind !== 'constructable') {
+    throw new CodegenError('InternalError', `client ${client.name} is not constructable`);
+  }
+  const ctor = client.instance.constructors[0];
+
+  const clientParameters: go.ParameterExample[] = [];
+  for (const param of method.parameters) {
+    if (param.location === 'client') {
+      if (go.isLiteralParameter(param.style)) {
+        continue;
+      }
+      const clientParam = example.parameters.find((p) => p.parameter.name === param.name);
+      if (clientParam) {
+        clientParameters.push(clientParam);
+      }
+    }
+  }
+  // TODO: client optional parameters
+
+  let text = '';
+  let clientRef = '';
+  if (target === 'azure-arm') {
+    let clientFactoryParams = new Array<go.ClientParameter>();
+    if (options.factoryGatherAllParams) {
+      clientFactoryParams = helpers.getAllClientParameters(pkg.src, target);
+    } else {
+      clientFactoryParams = helpers.getCommonClientParameters(pkg.src, target);
+    }
+
+    // since not all operation has all the client factory required parameters, we need to fake for the missing ones
+    const clientFactoryParamsExample: go.ParameterExample[] = [];
+    for (const clientParam of clientFactoryParams) {
+      const clientFactoryParam = clientParameters.find((p) => p.parameter.name === clientParam.name);
+      if (clientFactoryParam) {
+        clientFactoryParamsExample.push(clientFactoryParam);
+      } else {
+        clientFactoryParamsExample.push({ parameter: clientParam, value: generateFakeExample(clientParam.type, clientParam.name) });
+      }
+    }
+    text += `${indent.get()}clientFactory, err := ${go.getPackageName(pkg.src)}.NewClientFactory(${clientFactoryParamsExample.map((p) => getExampleValue(pkg, p.value, '\t', imports, p.parameter.byValue)).join(', ')}, ${clientOptions})\n`;
+    text += `${indent.get()}if err != nil {\n`;
+    text += `${indent.push().get()}${fatalf}("failed to create client: %v", err)\n`;
+    text += `${indent.pop().get()}}\n`;
+    clientRef = `clientFactory.${ctor.name}(`;
+    // since not all operations have all the client constructor required parameters, we need to generate fake values for the missing ones
+    const clientPrivateParameters: go.ParameterExample[] = [];
+    for (const ctorParam of ctor.parameters) {
+      if (clientFactoryParams.some((p) => p.name === ctorParam.name) || go.isAPIVersionParameter(ctorParam)) {
+        continue;
+      }
+      const existingParam = clientParameters.find((p) => p.parameter.name === ctorParam.name);
+      if (existingParam) {
+        clientPrivateParameters.push(existingParam);
+      } else {
+        clientPrivateParameters.push({ parameter: ctorParam, value: generateFakeExample(ctorParam.type, ctorParam.name) });
+      }
+    }
+    if (clientPrivateParameters.length > 0) {
+      clientRef += `${clientPrivateParameters.map((p) => getExampleValue(pkg, p.value, '\t', imports, p.parameter.byValue).slice(1)).join(', ')}`;
+    }
+    clientRef += `)`;
+  } else {
+    text += `${indent.get()}client, err := ${go.getPackageName(ctor.pkg)}.${ctor.name}(${clientParameters.map((p) => getExampleValue(pkg, p.value, '\t', imports, p.parameter.byValue).slice(1)).join(', ')}, cred, ${clientOptions})\n`;
+    text += `${indent.get()}if err != nil {\n`;
+    text += `${indent.push().get()}${fatalf}("failed to create client: %v", err)\n`;
+    text += `${indent.pop().get()}}\n`;
+    clientRef = 'client';
+  }
+
+  return { text, clientRef };
+}
+
+// a required argument passed to a method in an example
+export interface ExampleArg {
+  // the parameter or required parameter group
+  param: go.MethodParameter | go.ParameterGroup;
+
+  // the text for the argument's value
+  value: string;
+}
+
+/**
+ * returns the required arguments for a method in an example.
+ * uses getMethodParameters for correct ordering (including required param groups).
+ *
+ * @param pkg the package that contains the example
+ * @param method the method called by the example
+ * @param example the example for method
+ * @param imports the import manager currently in scope
+ * @returns the arguments in the order they're passed to the method
+ */
+export function getExampleArgs(pkg: go.TestPackage, method: go.MethodType, example: go.MethodExample, imports: ImportManager): Array<ExampleArg> {
+  const args = new Array<ExampleArg>();
+  for (const methodParam of helpers.getMethodParameters(method)) {
+    if (methodParam.kind === 'paramGroup') {
+      if (methodParam === method.optionalParamsGroup) continue;
+      imports.addForPkg(methodParam.pkg);
+      const fieldTexts: string[] = [];
+      for (const groupParam of methodParam.params) {
+        if (!shouldRenderParam(groupParam, example)) continue;
+        fieldTexts.push(`${naming.capitalize(groupParam.name)}: ${getParamExampleValue(pkg, groupParam, example, imports)}`);
+      }
+      args.push({ param: methodParam, value: `${go.getPackageName(methodParam.pkg)}.${methodParam.groupName}{${fieldTexts.join(', ')}}` });
+    } else {
+      if (!shouldRenderParam(methodParam, example)) continue;
+      args.push({ param: methodParam, value: getParamExampleValue(pkg, methodParam, example, imports) });
+    }
+  }
+  return args;
+}
+
+/**
+ * returns the optional parameters argument for a method in an example.
+ * the text is unindented and might span multiple lines.
+ *
+ * @param pkg the package that contains the example
+ * @param method the method called by the example
+ * @param example the example for method
+ * @param imports the import manager currently in scope
+ * @returns the text for the optional parameters or nil
+ */
+export function getOptionalParamsValue(pkg: go.TestPackage, method: go.MethodType, example: go.MethodExample, imports: ImportManager): string {
+  const methodOptionalParameters = example.optionalParamsGroup.filter((p) => p.parameter.location === 'method');
+  if (methodOptionalParameters.length === 0) {
+    return 'nil';
+  }
+  let text = `&${go.getPackageName(method.optionalParamsGroup.pkg)}.${method.optionalParamsGroup.groupName}{\n`;
+  text += methodOptionalParameters
+    .map((p) => `${naming.capitalize(p.parameter.name)}: ${getExampleValue(pkg, p.value, '\t', imports, isParamByValue(p)).slice(1)}`)
+    .join(',\n');
+  text += `}`;
+  return text;
+}
+
+/**
+ * returns the fields of the response envelope in an example and their example values.
+ * the values are formatted without indentation.
+ *
+ * @param pkg the package that contains the example
+ * @param method the method that returns the response envelope
+ * @param responseEnvelope the response envelope example
+ * @param imports the import manager for the values. omit when the values are emitted in comments
+ * @returns the field names and values
+ */
+export function getResponseEnvelopeFields(pkg: go.TestPackage, method: go.MethodType, responseEnvelope: go.ResponseEnvelopeExample, imports?: ImportManager): Array<[string, string]> {
+  const fields = new Array<[string, string]>();
+  for (const header of responseEnvelope.headers) {
+    fields.push([header.header.fieldName, getExampleValue(pkg, header.value, '', imports, (header.header as any).byValue)]);
+  }
+  if (responseEnvelope.result) {
+    // if has fieldName, then the result is not a model type
+    const fieldName = (method.returns.result as any)?.fieldName;
+    // modelResult and polymorphicResult are anonymously embedded by value in the response struct.
+    // monomorphicResult has an explicit byValue property. all other result types default to by value.
+    let resultByValue = true;
+    let resultFieldName = fieldName ? fieldName : (responseEnvelope.result.type as go.Model).name;
+    if (method.returns.result?.kind === 'monomorphicResult') {
+      resultByValue = method.returns.result.byValue;
+    } else if (method.returns.result?.kind === 'polymorphicResult') {
+      resultFieldName = method.returns.result.interface.name;
+      resultByValue = false;
+    } else if (method.returns.result?.kind === 'statusCodeResult') {
+      // the example is for the 200 response
+      const field = method.returns.result.fields.find((f) => f.httpStatusCodes.includes(200));
+      if (field) {
+        resultFieldName = field.fieldName;
+        resultByValue = isStatusCodeFieldByValue(field.type);
+      }
+    }
+    fields.push([resultFieldName, getExampleValue(pkg, responseEnvelope.result, '', imports, resultByValue)]);
+  }
+  return fields;
+}
+
+/**
+ * returns the client options for an example that sends its request to a fake.
+ * the client's transport is the fake server that contains the fake method.
+ *
+ * @param pkg the package that contains the example
+ * @param client the client used in the example
+ * @param azureARM true when the client is created from the client factory
+ * @param imports the import manager currently in scope
+ * @param indent the indentation of the statement that contains the client options
+ * @param fakeMethod returns the text for the fake method at the provided indentation
+ * @returns the text for the client options
+ */
+export function getFakeClientOptions(pkg: go.TestPackage, client: go.Client, azureARM: boolean, imports: ImportManager, indent: helpers.Indentation, fakeMethod: (indent: helpers.Indentation) => string): string {
+  const clientOptions = (<go.Constructable>client.instance).options;
+  imports.addForType(clientOptions);
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+  imports.addForFakePkg(pkg.src);
+
+  const serverName = getServerName(client);
+  let text = `&${go.getTypeDeclaration(clientOptions, pkg)}{\n`;
+  text += `${indent.push().get()}ClientOptions: azcore.ClientOptions{\n`;
+  if (azureARM) {
+    text += `${indent.push().get()}Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{\n`;
+    text += `${indent.push().get()}${serverName}: fake.${serverName}{\n`;
+  } else {
+    text += `${indent.push().get()}Transport: fake.New${serverName}Transport(&fake.${serverName}{\n`;
+  }
+  indent.push();
+  text += fakeMethod(indent);
+  if (azureARM) {
+    text += `${indent.pop().get()}},\n`;
+  }
+  text += `${indent.pop().get()}}),\n`;
+  text += `${indent.pop().get()}},\n`;
+  text += `${indent.pop().get()}}`;
+  return text;
+}
+
+/**
+ * returns the fake for method that returns the example's response.
+ *
+ * @param pkg the package that contains the example
+ * @param method the method for which to emit the fake
+ * @param example the example for method
+ * @param imports the import manager currently in scope
+ * @param indent the indentation of the fake
+ * @param statements optional statements that precede the response. multi-line statements are indented per line
+ * @param respValue optional text for the response. defaults to the example's response
+ * @returns the text for the fake
+ */
+export function getFakeMethod(pkg: go.TestPackage, method: go.MethodType, example: go.MethodExample, imports: ImportManager, indent: helpers.Indentation, statements: Array<string> = [], respValue?: string): string {
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/fake', 'azfake');
+  imports.add('net/http');
+  for (const param of helpers.getMethodParameters(method)) {
+    if (param.kind !== 'paramGroup') {
+      imports.addForType(param.type);
+    }
+  }
+
+  let text = `${indent.get()}${fixUpMethodName(method)}: func(${getAPIParametersSig(pkg, method, imports)}) (${getServerResponseSig(pkg, method)}) {\n`;
+  indent.push();
+  for (const statement of statements) {
+    text += statement.split('\n').map((line) => `${indent.get()}${line}\n`).join('');
+  }
+
+  // the example's response is for the 200 status code when the method returns one.
+  // the fake returns an LRO's terminal response to the initial request, so it must
+  // use a status code of the initial response (e.g. 202 for a DELETE).
+  const statusCodes = method.httpStatusCodes;
+  const statusCode = helpers.formatStatusCode(statusCodes.includes(200) ? 200 : statusCodes[0]);
+  const respType = go.getTypeDeclaration(method.returns, pkg);
+  if (!respValue) {
+    respValue = getFakeResponseValue(pkg, method, example, imports, indent);
+  }
+
+  switch (method.kind) {
+    case 'lroMethod':
+      text += `${indent.get()}resp.SetTerminalResponse(${statusCode}, ${respValue}, nil)\n`;
+      break;
+    case 'lroPageableMethod':
+      text += `${indent.get()}var pages azfake.PagerResponder[${respType}]\n`;
+      text += `${indent.get()}pages.AddPage(http.StatusOK, ${respValue}, nil)\n`;
+      text += `${indent.get()}resp.SetTerminalResponse(${statusCode}, pages, nil)\n`;
+      break;
+    case 'method':
+      text += `${indent.get()}resp.SetResponse(${statusCode}, ${respValue}, nil)\n`;
+      break;
+    case 'pageableMethod':
+      text += `${indent.get()}resp.AddPage(${statusCode}, ${respValue}, nil)\n`;
+      break;
+  }
+  text += `${indent.get()}return\n`;
+  text += `${indent.pop().get()}},\n`;
+  return text;
+}
+
+/**
+ * returns the response envelope that a fake returns for an example.
+ * the response for a pageable method is the last page.
+ *
+ * @param pkg the package that contains the example
+ * @param method the method that returns the response envelope
+ * @param example the example for method
+ * @param imports the import manager currently in scope
+ * @param indent the indentation of the statement that contains the response envelope
+ * @returns the text for the response envelope
+ */
+export function getFakeResponseValue(pkg: go.TestPackage, method: go.MethodType, example: go.MethodExample, imports: ImportManager, indent: helpers.Indentation): string {
+  const respType = go.getTypeDeclaration(method.returns, pkg);
+  if (!example.responseEnvelope) {
+    return `${respType}{}`;
+  }
+  let responseEnvelope = example.responseEnvelope;
+  if (go.isPageableMethod(method)) {
+    responseEnvelope = withoutNextPage(method, responseEnvelope);
+  }
+  const fields = getResponseEnvelopeFields(pkg, method, responseEnvelope, imports);
+  if (fields.length === 0) {
+    return `${respType}{}`;
+  }
+  let respValue = `${respType}{\n`;
+  for (const [fieldName, value] of fields) {
+    respValue += `${indent.get()}\t${fieldName}: ${value.split('\n').join(`\n${indent.get()}\t`)},\n`;
+  }
+  respValue += `${indent.get()}}`;
+  return respValue;
+}
+
+/**
+ * returns the response envelope example without the link or token for the next page.
+ * the fake returns a single page, so the page must not refer to another one.
+ *
+ * @param method the pageable method that returns the response envelope
+ * @param responseEnvelope the response envelope example
+ * @returns the response envelope example for the last page
+ */
+export function withoutNextPage(method: go.LROPageableMethod | go.PageableMethod, responseEnvelope: go.ResponseEnvelopeExample): go.ResponseEnvelopeExample {
+  let nextLink: go.PageableStrategyNextLink | undefined;
+  let headers = responseEnvelope.headers;
+  if (method.strategy?.kind === 'nextLink') {
+    nextLink = method.strategy;
+  } else if (method.strategy?.kind === 'continuationToken') {
+    const responseToken = method.strategy.responseToken;
+    if (responseToken.kind === 'nextLink') {
+      nextLink = responseToken;
+    } else {
+      headers = headers.filter((header) => header.header !== responseToken);
+    }
+  }
+
+  let result = responseEnvelope.result;
+  if (nextLink && result.kind === 'model') {
+    result = omitField(result, nextLink.nextLinkPath);
+  }
+  return { response: responseEnvelope.response, headers: headers, result: result };
+}
+
+/**
+ * returns a copy of the model example without the field at the end of path.
+ *
+ * @param example the model example
+ * @param path the path to the field to omit
+ * @returns the model example without the field
+ */
+function omitField(example: go.StructExample, path: Array<go.ModelField>): go.StructExample {
+  const result = new go.StructExample(example.type);
+  result.additionalProperties = example.additionalProperties;
+  for (const [fieldName, value] of Object.entries(example.value)) {
+    if (fieldName !== path[0].name) {
+      result.value[fieldName] = value;
+    } else if (path.length > 1 && value.kind === 'model') {
+      result.value[fieldName] = omitField(value, path.slice(1));
+    }
+  }
+  return result;
+}
+
+/**
+ * returns the examples of the items in the page example.
+ *
+ * @param method the pageable method that returns the page
+ * @param page the page example
+ * @param itemsPath the path to the items. when undefined, the page's slice field is used
+ * @returns the item examples or an empty array
+ */
+function getItemExamples(method: go.LROPageableMethod | go.PageableMethod, page: go.ExampleType, itemsPath?: Array<go.ModelField>): Array<go.ExampleType> {
+  if (!itemsPath) {
+    const itemsField = (<go.ModelResult>method.returns.result).modelType.fields.find((f) => f.type.kind === 'slice');
+    if (!itemsField) {
+      return [];
+    }
+    itemsPath = [itemsField];
+  }
+
+  let items: go.ExampleType | undefined = page;
+  for (const field of itemsPath) {
+    if (items?.kind !== 'model') {
+      return [];
+    }
+    items = items.value[field.name];
+  }
+  return items?.kind === 'array' ? items.value : [];
+}
+
+// the statements that print an example's result and the output they produce
+interface ExampleOutput {
+  statements: Array<string>;
+  lines: Array<string>;
+}
+
+/**
+ * returns the statements that print the scalar fields of the model examples and the output they produce.
+ * a field is printed when its value in all examples can be printed exactly.
+ * i.e. floats, times, and strings with surrounding whitespace are skipped.
+ * the statements are emitted once and run for each example (e.g. in a loop over the items in a page).
+ *
+ * @param examples the model examples
+ * @param receiver the expression that contains the model
+ * @param omit the names of fields to skip
+ * @returns the statements and the lines of output
+ */
+function getExampleOutput(examples: Array<go.ExampleType>, receiver: string, omit: Array<string>): ExampleOutput {
+  const output: ExampleOutput = { statements: [], lines: [] };
+  if (examples.length === 0 || examples.some((example) => example.kind !== 'model')) {
+    return output;
+  }
+  const models = <Array<go.StructExample>>examples;
+
+  const fields = new Array<go.ModelField>();
+  for (const fieldName of Object.keys(models[0].value)) {
+    if (omit.includes(fieldName) || models.some((model) => getOutputValue(model.value[fieldName]) === undefined)) {
+      continue;
+    }
+    const field = models[0].type.fields.find((f) => f.name === fieldName)!;
+    fields.push(field);
+    output.statements.push(`fmt.Println("${field.name}:", ${field.byValue ? '' : '*'}${receiver}.${field.name})`);
+  }
+
+  for (const model of models) {
+    for (const field of fields) {
+      output.lines.push(`${field.name}: ${getOutputValue(model.value[field.name])}`);
+    }
+  }
+  return output;
+}
+
+/**
+ * returns the text that fmt.Println prints for the example value.
+ * returns undefined if the value isn't a scalar or can't be printed exactly.
+ *
+ * @param example the example value
+ * @returns the printed value or undefined
+ */
+function getOutputValue(example?: go.ExampleType): string | undefined {
+  switch (example?.kind) {
+    case 'boolean':
+      if (example.type.kind === 'scalar' && example.type.type === 'bool') {
+        return `${example.value}`;
+      }
+      return undefined;
+    case 'number': {
+      const numberType = example.type.kind === 'scalar' || example.type.kind === 'constant' ? example.type.type : undefined;
+      if (numberType?.match(/^u?int/) && Number.isSafeInteger(example.value)) {
+        return `${example.value}`;
+      }
+      return undefined;
+    }
+    case 'string': {
+      const isString = example.type.kind === 'string' || (example.type.kind === 'constant' && example.type.type === 'string');
+      // Output blocks are compared line by line with surrounding whitespace removed
+      if (isString && example.value.length > 0 && example.value === example.value.trim() && !example.value.match(/[\r\n]/)) {
+        return example.value;
+      }
+      return undefined;
+    }
+    default:
+      return undefined;
+  }
+}
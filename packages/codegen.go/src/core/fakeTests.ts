@@ -0,0 +1,190 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as naming from '../../../naming.go/src/naming.js';
+import * as helpers from './helpers.js';
+import {
+  ExampleContent,
+  getExampleArgs,
+  getExampleClient,
+  getFakeClientOptions,
+  getFakeMethod,
+  getFakeResponseValue,
+  getOptionalParamsValue,
+} from './example.js';
+import { ImportManager } from './imports.js';
+import { fixUpMethodName } from './operations.js';
+
+/**
+ * Creates the content for all the *_fake_test.go files.
+ * Each example becomes a test that sends the example's parameters
+ * to the fake server for the client. The fake checks the parameters
+ * it receives and returns the example's response, which the test
+ * compares with the result returned by the client.
+ *
+ * @param pkg contains the package content
+ * @param target the codegen target for the module
+ * @param options the emitter options
+ * @returns the text for the files or the empty array
+ */
+export function generateFakeTests(pkg: go.TestPackage, target: go.CodeModelType, options: go.Options): Array<ExampleContent> {
+  const fakeTests = new Array<ExampleContent>();
+  const azureARM = target === 'azure-arm';
+
+  for (const client of pkg.src.clients) {
+    // client must be constructable to send requests to the fake
+    if (client.instance?.kind != 'constructable') {
+      continue;
+    }
+    const imports = new ImportManager(pkg);
+
+    let testText = '';
+    for (const method of client.methods) {
+      if (helpers.isMethodInternal(method)) {
+        // unexported methods don't have a fake
+        continue;
+      }
+      for (const example of method.examples) {
+        imports.add('context');
+        imports.add('reflect');
+        imports.add('testing');
+        imports.addForPkg(pkg.src);
+        imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/fake', 'azfake');
+
+        const indent = new helpers.Indentation();
+        testText += `// Generated from example definition: ${example.filePath}\n`;
+        const testFuncNameSuffix = method.examples.length > 1 ? `_${helpers.camelCase(example.name)}` : '';
+        testText += `func Test${client.name}_${fixUpMethodName(method)}${testFuncNameSuffix}(t *testing.T) {\n`;
+
+        // the arguments are declared as variables so the fake can compare them with what it receives
+        const args = getExampleArgs(pkg, method, example, imports);
+        const argNames = new Array<string>();
+        const checks = new Array<string>();
+        for (const arg of args) {
+          const paramName = naming.uncapitalize(arg.param.name);
+          const varName = `example${naming.capitalize(arg.param.name)}`;
+          testText += `${indent.get()}${varName} := ${arg.value}\n`;
+          argNames.push(varName);
+          if (arg.param.kind === 'uriParam' || (arg.param.kind !== 'paramGroup' && !isComparable(arg.param.type))) {
+            // host params are consolidated in the fake and streams can only be read once
+            continue;
+          }
+          checks.push(`if !reflect.DeepEqual(${varName}, ${paramName}) {\n\tt.Errorf("unexpected ${paramName} %v", ${paramName})\n}`);
+        }
+        const optionalParams = getOptionalParamsValue(pkg, method, example, imports);
+        testText += `${indent.get()}exampleRes := ${getFakeResponseValue(pkg, method, example, imports, indent)}\n`;
+        testText += `${indent.get()}cred := ${getCredential(client, imports)}\n`;
+        testText += `${indent.get()}ctx := context.Background()\n`;
+
+        const clientOptions = getFakeClientOptions(pkg, client, azureARM, imports, indent, (indent) => getFakeMethod(pkg, method, example, imports, indent, checks, 'exampleRes'));
+        const exampleClient = getExampleClient(pkg, client, method, example, target, options, clientOptions, 't.Fatalf', imports, indent);
+        testText += exampleClient.text;
+
+        argNames.push(optionalParams.split('\n').join('\n' + indent.get()));
+        const callArgs = argNames.join(', ');
+        const checkResponse = method.returns.result?.kind !== 'binaryResult' && method.returns.result?.kind !== 'streamResult';
+
+        switch (method.kind) {
+          case 'lroMethod':
+          case 'lroPageableMethod':
+            testText += `${indent.get()}poller, err := ${exampleClient.clientRef}.${fixUpMethodName(method)}(ctx, ${callArgs})\n`;
+            testText += getFatalCheck('failed to finish the request', indent);
+            testText += `${indent.get()}${checkResponse ? 'res' : '_'}, err ${checkResponse ? ':=' : '='} poller.PollUntilDone(ctx, nil)\n`;
+            testText += getFatalCheck('failed to poll the result', indent);
+            if (method.kind === 'lroPageableMethod') {
+              testText += getPageCheck('res', indent);
+            } else if (checkResponse) {
+              testText += getResponseCheck('res', indent);
+            }
+            break;
+          case 'method':
+            testText += `${indent.get()}${checkResponse ? 'res' : '_'}, err := ${exampleClient.clientRef}.${fixUpMethodName(method)}(ctx, ${callArgs})\n`;
+            testText += getFatalCheck('failed to finish the request', indent);
+            if (checkResponse) {
+              testText += getResponseCheck('res', indent);
+            }
+            break;
+          case 'pageableMethod':
+            testText += `${indent.get()}pager := ${exampleClient.clientRef}.${fixUpMethodName(method)}(${callArgs})\n`;
+            testText += getPageCheck('pager', indent);
+            break;
+          default:
+            method satisfies never;
+        }
+        testText += '}\n\n';
+      }
+    }
+
+    // if no example, then do not generate fake test file
+    if (testText === '') continue;
+
+    let text = helpers.contentPreamble(pkg);
+    text += imports.text();
+    text += testText;
+    fakeTests.push(new ExampleContent(client.name, text));
+  }
+  return fakeTests;
+}
+
+/**
+ * returns the credential used to create a client in a fake test.
+ *
+ * @param client the client used in the test
+ * @param imports the import manager currently in scope
+ * @returns the text for the credential
+ */
+function getCredential(client: go.Client, imports: ImportManager): string {
+  const credentialParam = (<go.Constructable>client.instance).constructors[0].parameters.find((param) => param.kind === 'credentialParam');
+  if (credentialParam?.type.kind === 'keyCredential') {
+    imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+    return 'azcore.NewKeyCredential("<key>")';
+  }
+  return '&azfake.TokenCredential{}';
+}
+
+/**
+ * returns false if the fake can't compare an argument of the specified type
+ * with the example's value. e.g. a stream is consumed when the request is sent.
+ *
+ * @param type the type of the argument
+ * @returns true if the argument can be compared
+ */
+function isComparable(type: go.Type): boolean {
+  switch (type.kind) {
+    case 'eventStream':
+    case 'multipartContent':
+    case 'readCloser':
+    case 'readSeekCloser':
+      return false;
+    default:
+      return true;
+  }
+}
+
+function getFatalCheck(message: string, indent: helpers.Indentation): string {
+  let text = `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}t.Fatalf("${message}: %v", err)\n`;
+  text += `${indent.pop().get()}}\n`;
+  return text;
+}
+
+function getResponseCheck(result: string, indent: helpers.Indentation): string {
+  let text = `${indent.get()}if !reflect.DeepEqual(exampleRes, ${result}) {\n`;
+  text += `${indent.push().get()}t.Errorf("unexpected response %v", ${result})\n`;
+  text += `${indent.pop().get()}}\n`;
+  return text;
+}
+
+// the fake returns the example's response as the only page
+function getPageCheck(pager: string, indent: helpers.Indentation): string {
+  let text = `${indent.get()}page, err := ${pager}.NextPage(ctx)\n`;
+  text += getFatalCheck('failed to advance page', indent);
+  text += getResponseCheck('page', indent);
+  text += `${indent.get()}if ${pager}.More() {\n`;
+  text += `${indent.push().get()}t.Errorf("unexpected next page")\n`;
+  text += `${indent.pop().get()}}\n`;
+  return text;
+}
@@ -42,7 +42,7 @@ export function setCustomHeaderText(headerText: string): void {
  * @param doNotEdit when false the 'DO NOT EDIT' clause is omitted
  * @returns the source file preamble
  */
-export function contentPreamble(pkg: go.FakePackage | go.PackageContent | go.TestPackage, doNotEdit = true): string {
+export function contentPreamble(pkg: go.PackageType, doNotEdit = true): string {
   const headerText = comment(overrideHeaderText ?? defaultHeaderText, '// ');
   let text = headerText;
   if (doNotEdit) {
@@ -275,6 +275,11 @@ export function formatBytesEncoding(enc: go.BytesEncoding): string {
   return 'Std';
 }
 
+// returns the name of the unexported type used to encode and decode a time.Duration (see duration.ts)
+export function getDurationTypeName(type: go.Duration): string {
+  return `duration${type.format}`;
+}
+
 export function formatParamValue(param: go.MethodParameter, imports: ImportManager, indent: Indentation): string {
   let paramName = getParamName(param);
   switch (param.kind) {
@@ -312,6 +317,9 @@ export function formatParamValue(param: go.MethodParameter, imports: ImportManag
           imports.add('strings');
           imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
           return emitConvertOver(param.name, `datetime.${param.type.elementType.format}(${param.name}[i]).String()`);
+        case 'duration':
+          imports.add('strings');
+          return emitConvertOver(param.name, `${getDurationTypeName(param.type.elementType)}(${param.name}[i]).String()`);
         default:
           imports.add('fmt');
           imports.add('strings');
@@ -358,6 +366,7 @@ export function formatValue(paramName: string, type: go.WireType, imports: Impor
       // a base-64 encoded value in string format
       imports.add('encoding/base64');
       return `base64.${formatBytesEncoding(type.encoding)}Encoding.EncodeToString(${paramName})`;
+    case 'decimal':
     case 'etag':
       return `string(${star}${paramName})`;
     case 'literal':
@@ -391,6 +400,8 @@ export function formatValue(paramName: string, type: go.WireType, imports: Impor
     case 'time':
       imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
       return `datetime.${type.format}(${star}${paramName}).String()`;
+    case 'duration':
+      return `${getDurationTypeName(type)}(${star}${paramName}).String()`;
     default:
       return `${star}${paramName}`;
   }
@@ -449,6 +460,7 @@ export function hasSchemaResponse(method: go.MethodType): boolean {
     case 'modelResult':
     case 'monomorphicResult':
     case 'polymorphicResult':
+    case 'statusCodeResult':
       return true;
     default:
       return false;
@@ -466,11 +478,14 @@ export function getResultFieldName(method: go.MethodType): string {
     case 'binaryResult':
     case 'headAsBooleanResult':
     case 'monomorphicResult':
+    case 'streamResult':
       return result.fieldName;
     case 'modelResult':
       return result.modelType.name;
     case 'polymorphicResult':
       return result.interface.name;
+    case 'statusCodeResult':
+      throw new CodegenError('InternalError', `method ${method.name} has a result field per status code`);
   }
 }
 
@@ -782,6 +797,11 @@ export function getSerDeFormat(model: go.Model | go.PolymorphicModel, pkg: go.Pa
           recursiveWalkModelFields(possibleType, serDeFormat);
         }
         break;
+      case 'union':
+        for (const variant of type.variants) {
+          recursiveWalkModelFields(variant.type, serDeFormat);
+        }
+        break;
       case 'model':
       case 'polymorphicModel':
         if (serDeFormatCache.has(type.name)) {
@@ -829,6 +849,13 @@ export function getSerDeFormat(model: go.Model | go.PolymorphicModel, pkg: go.Pa
         case 'polymorphicResult':
           recursiveWalkModelFields(resultType.interface, resultType.format);
           break;
+        case 'statusCodeResult':
+          if (resultType.format === 'JSON' || resultType.format === 'XML') {
+            for (const field of resultType.fields) {
+              recursiveWalkModelFields(field.type, resultType.format);
+            }
+          }
+          break;
       }
     }
   }
@@ -919,6 +946,9 @@ export interface MethodParamGroups {
   /** encoded query params. can be empty */
   encodedQueryParams: Array<go.QueryParameter>;
 
+  /** cookie params. can be empty */
+  cookieParams: Array<go.CookieParameter>;
+
   /** form body params. can be empty */
   formBodyParams: Array<go.FormBodyParameter>;
 
@@ -946,6 +976,7 @@ export interface MethodParamGroups {
  */
 export function getMethodParamGroups(method: go.MethodType | go.NextPageMethod): MethodParamGroups {
   let bodyParam: go.BodyParameter | undefined;
+  const cookieParams = new Array<go.CookieParameter>();
   const encodedQueryParams = new Array<go.QueryParameter>();
   const formBodyParams = new Array<go.FormBodyParameter>();
   const headerParams = new Array<go.HeaderParameter>();
@@ -959,6 +990,9 @@ export function getMethodParamGroups(method: go.MethodType | go.NextPageMethod):
       case 'bodyParam':
         bodyParam = param;
         break;
+      case 'cookieParam':
+        cookieParams.push(param);
+        break;
       case 'formBodyCollectionParam':
       case 'formBodyScalarParam':
         formBodyParams.push(param);
@@ -991,6 +1025,7 @@ export function getMethodParamGroups(method: go.MethodType | go.NextPageMethod):
 
   return {
     bodyParam,
+    cookieParams,
     encodedQueryParams,
     formBodyParams,
     headerParams,
@@ -1122,6 +1157,16 @@ export function isMethodInternal(method: go.MethodType): boolean {
   return !!method.name.match(/^[a-z]{1}/);
 }
 
+/**
+ * returns the name of the interface that contains the exported methods of a client
+ *
+ * @param client the client for which to get the interface name
+ * @returns the interface name
+ */
+export function getClientInterfaceName(client: go.Client): string {
+  return `${client.name}API`;
+}
+
 /**
  * returns true if the provided client has no exported methods
  *
@@ -11,14 +11,14 @@ type importEntry = { imp: string; alias?: string };
 // tracks packages that need to be imported
 export class ImportManager {
   private readonly imports: Array<importEntry>;
-  private readonly pkg: go.FakePackage | go.PackageContent | go.TestPackage;
+  private readonly pkg: go.PackageType;
 
   /**
    * creates a new instance of ImportManager for the specified package
    *
    * @param pkg the package that contains the import statements to emit
    */
-  constructor(pkg: go.FakePackage | go.PackageContent | go.TestPackage) {
+  constructor(pkg: go.PackageType) {
     this.imports = new Array<importEntry>();
     this.pkg = pkg;
   }
@@ -46,18 +46,16 @@ export class ImportManager {
    * @param alias optional package alias
    */
   addForPkg(pkg: go.PackageContent, alias?: string): void {
-    let pkgPath: string;
-    switch (pkg.kind) {
-      case 'module':
-        pkgPath = pkg.identity;
-        break;
-      case 'package': {
-        pkgPath = buildImportPath(pkg);
-        break;
-      }
-    }
+    this.add(getPkgImportPath(pkg), alias);
+  }
 
-    this.add(pkgPath, alias);
+  /**
+   * adds the fake package of the specified package for importing if not already in the list.
+   *
+   * @param pkg the package whose fake package to import
+   */
+  addForFakePkg(pkg: go.PackageContent): void {
+    this.add(`${getPkgImportPath(pkg)}/fake`);
   }
 
   /**
@@ -106,11 +104,18 @@ export class ImportManager {
       case 'slice':
         this.addForType(type.elementType);
         break;
+      case 'eventStream':
+        this.addForType(type.itemType);
+        if (go.getPackageName(type.pkg) !== go.getPackageName(this.pkg)) {
+          this.add(buildImportPath(type.pkg));
+        }
+        break;
       case 'client':
       case 'constant':
       case 'interface':
       case 'model':
       case 'polymorphicModel':
+      case 'union':
         if (go.getPackageName(type.pkg) !== go.getPackageName(this.pkg)) {
           this.add(buildImportPath(type.pkg));
         }
@@ -136,6 +141,21 @@ export class ImportManager {
   }
 }
 
+/**
+ * returns the import path for the provided package
+ *
+ * @param pkg the package for which to return the import path
+ * @returns the package's import path
+ */
+function getPkgImportPath(pkg: go.PackageContent): string {
+  switch (pkg.kind) {
+    case 'module':
+      return pkg.identity;
+    case 'package':
+      return buildImportPath(pkg);
+  }
+}
+
 /**
  * builds the complete package import path for the provided package
  *
@@ -0,0 +1,102 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from './helpers.js';
+import { ImportManager } from './imports.js';
+
+/**
+ * Creates the content for the key_credential_helper.go file.
+ * this includes the policy that places a key in the query string
+ * for clients that authenticate with a key in a query parameter.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generateKeyCredentialHelpers(pkg: go.PackageContent): string {
+  if (!hasQueryKeyCredentials(pkg)) {
+    return '';
+  }
+
+  const imports = new ImportManager(pkg);
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/policy');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
+  imports.add('net/http');
+  imports.add('net/url');
+  imports.add('strings');
+
+  const body = `// keyCredentialQueryPolicy authorizes requests with an [azcore.KeyCredential]
+// by placing the key in the query parameter with the specified name.
+type keyCredentialQueryPolicy struct {
+	cred      *azcore.KeyCredential
+	name      string
+	keyPolicy *runtime.KeyCredentialPolicy
+}
+
+// newKeyCredentialQueryPolicy creates a new instance of keyCredentialQueryPolicy.
+// only the InsecureAllowCredentialWithHTTP option applies.
+func newKeyCredentialQueryPolicy(cred *azcore.KeyCredential, name string, options *runtime.KeyCredentialPolicyOptions) *keyCredentialQueryPolicy {
+	return &keyCredentialQueryPolicy{
+		cred:      cred,
+		name:      name,
+		keyPolicy: runtime.NewKeyCredentialPolicy(cred, name, options),
+	}
+}
+
+// Do implements the policy.Policy interface for keyCredentialQueryPolicy.
+func (k *keyCredentialQueryPolicy) Do(req *policy.Request) (*http.Response, error) {
+	// skip adding the key if no KeyCredential was provided
+	if k.cred == nil {
+		return req.Next()
+	}
+	// azcore.KeyCredential doesn't expose its key, so runtime.KeyCredentialPolicy
+	// adds it to a copy of the request from which it's read. the copy is never sent.
+	scratch, err := runtime.NewRequest(req.Raw().Context(), req.Raw().Method, req.Raw().URL.String())
+	if err != nil {
+		return nil, err
+	}
+	// the copy has no other policies so an error is always returned.
+	// the key is added only when the request satisfies the policy's TLS requirement.
+	_, err = k.keyPolicy.Do(scratch)
+	key := scratch.Raw().Header.Get(k.name)
+	if key == "" {
+		return nil, err
+	}
+	// append the key without re-encoding any existing query params
+	param := url.QueryEscape(k.name) + "=" + strings.ReplaceAll(url.QueryEscape(key), "+", "%20")
+	if req.Raw().URL.RawQuery == "" {
+		req.Raw().URL.RawQuery = param
+	} else {
+		req.Raw().URL.RawQuery += "&" + param
+	}
+	return req.Next()
+}
+`;
+
+  return helpers.contentPreamble(pkg) + imports.text() + body;
+}
+
+/**
+ * returns true if any client in the package authenticates with a key in a query parameter
+ *
+ * @param pkg contains the package content
+ * @returns true if the package contains a query key credential
+ */
+function hasQueryKeyCredentials(pkg: go.PackageContent): boolean {
+  for (const client of pkg.clients) {
+    if (client.instance?.kind !== 'constructable') {
+      continue;
+    }
+    for (const ctor of client.instance.constructors) {
+      for (const param of ctor.parameters) {
+        if (param.kind === 'credentialParam' && param.type.kind === 'keyCredential' && param.type.location === 'query') {
+          return true;
+        }
+      }
+    }
+  }
+  return false;
+}
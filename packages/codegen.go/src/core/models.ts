@@ -43,11 +43,20 @@ export function generateModels(pkg: go.PackageContent, options: go.Options, emit
   // structs
   let needsJSONPopulate = false;
   let needsJSONPopulateTime = false;
+  let needsJSONPopulateDuration = false;
   let needsJSONUnpopulate = false;
   let needsJSONUnpopulateTime = false;
+  let needsJSONUnpopulateDuration = false;
   let needsJSONPopulateByteArray = false;
   let needsJSONPopulateAny = false;
+  let needsJSONPopulateDecimalString = false;
   let needsJSONPopulateMultipart = false;
+  let needsJSONWriter = false;
+  let needsJSONReader = false;
+  let needsJSONDecodeField = false;
+  let needsJSONDecodeTimeField = false;
+  let needsJSONDecodeDurationField = false;
+  let needsMergePatch = false;
   let serdeTextBody = '';
   for (const modelDef of modelDefs) {
     modelText += modelDef.text(indent);
@@ -77,33 +86,66 @@ export function generateModels(pkg: go.PackageContent, options: go.Options, emit
     if (modelDef.SerDe.needsJSONPopulateTime) {
       needsJSONPopulateTime = true;
     }
+    if (modelDef.SerDe.needsJSONPopulateDuration) {
+      needsJSONPopulateDuration = true;
+    }
     if (modelDef.SerDe.needsJSONUnpopulate) {
       needsJSONUnpopulate = true;
     }
     if (modelDef.SerDe.needsJSONUnpopulateTime) {
       needsJSONUnpopulateTime = true;
     }
+    if (modelDef.SerDe.needsJSONUnpopulateDuration) {
+      needsJSONUnpopulateDuration = true;
+    }
     if (modelDef.SerDe.needsJSONPopulateByteArray) {
       needsJSONPopulateByteArray = true;
     }
     if (modelDef.SerDe.needsJSONPopulateAny) {
       needsJSONPopulateAny = true;
     }
+    if (modelDef.SerDe.needsJSONPopulateDecimalString) {
+      needsJSONPopulateDecimalString = true;
+    }
     if (modelDef.SerDe.needsJSONPopulateMultipart) {
       needsJSONPopulateMultipart = true;
     }
+    if (modelDef.SerDe.needsJSONWriter) {
+      needsJSONWriter = true;
+    }
+    if (modelDef.SerDe.needsJSONReader) {
+      needsJSONReader = true;
+    }
+    if (modelDef.SerDe.needsJSONDecodeField) {
+      needsJSONDecodeField = true;
+    }
+    if (modelDef.SerDe.needsJSONDecodeTimeField) {
+      needsJSONDecodeTimeField = true;
+    }
+    if (modelDef.SerDe.needsJSONDecodeDurationField) {
+      needsJSONDecodeDurationField = true;
+    }
+    if (modelDef.SerDe.needsMergePatch) {
+      needsMergePatch = true;
+    }
   }
 
+  // in streaming mode the populate helpers write to a jsonWriter instead of a map
+  const populateTarget = options.streamingJSONSerDe ? 'w *jsonWriter' : 'm map[string]any';
+  const populateSet = function (value: string): string {
+    return options.streamingJSONSerDe ? `w.set(k, ${value})` : `m[k] = ${value}`;
+  };
+
   if (needsJSONPopulate) {
     serdeImports.add('reflect');
     serdeImports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
-    serdeTextBody += 'func populate(m map[string]any, k string, v any) {\n';
+    serdeTextBody += `func populate(${populateTarget}, k string, v any) {\n`;
     serdeTextBody += `${indent.get()}if v == nil {\n`;
     serdeTextBody += `${indent.push().get()}return\n`;
     serdeTextBody += `${indent.pop().get()}} else if azcore.IsNullValue(v) {\n`;
-    serdeTextBody += `${indent.push().get()}m[k] = nil\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('nil')}\n`;
     serdeTextBody += `${indent.pop().get()}} else if !reflect.ValueOf(v).IsNil() {\n`;
-    serdeTextBody += `${indent.push().get()}m[k] = v\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('v')}\n`;
     serdeTextBody += `${indent.pop().get()}}\n`;
     serdeTextBody += '}\n\n';
   }
@@ -111,41 +153,71 @@ export function generateModels(pkg: go.PackageContent, options: go.Options, emit
     serdeImports.add('time');
     serdeImports.add('reflect');
     serdeImports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
-    serdeTextBody += 'func populateTime[T dateTimeConstraints](m map[string]any, k string, t *time.Time) {\n';
+    serdeTextBody += `func populateTime[T dateTimeConstraints](${populateTarget}, k string, t *time.Time) {\n`;
     serdeTextBody += `${indent.get()}if t == nil {\n`;
     serdeTextBody += `${indent.push().get()}return\n`;
     serdeTextBody += `${indent.pop().get()}} else if azcore.IsNullValue(t) {\n`;
-    serdeTextBody += `${indent.push().get()}m[k] = nil\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('nil')}\n`;
     serdeTextBody += `${indent.pop().get()}} else if !reflect.ValueOf(t).IsNil() {\n`;
     indent.push();
     serdeTextBody += `${indent.get()}newTime := T(*t)\n`;
-    serdeTextBody += `${indent.get()}m[k] = (*T)(&newTime)\n`;
+    serdeTextBody += `${indent.get()}${populateSet('(*T)(&newTime)')}\n`;
+    indent.pop();
+    serdeTextBody += `${indent.get()}}\n`;
+    serdeTextBody += '}\n\n';
+  }
+  if (needsJSONPopulateDuration) {
+    serdeImports.add('time');
+    serdeImports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+    serdeTextBody += `func populateDuration[T durationConstraints](${populateTarget}, k string, d *time.Duration) {\n`;
+    serdeTextBody += `${indent.get()}if d == nil {\n`;
+    serdeTextBody += `${indent.push().get()}return\n`;
+    serdeTextBody += `${indent.pop().get()}} else if azcore.IsNullValue(d) {\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('nil')}\n`;
+    serdeTextBody += `${indent.pop().get()}} else {\n`;
+    indent.push();
+    serdeTextBody += `${indent.get()}newDuration := T(*d)\n`;
+    serdeTextBody += `${indent.get()}${populateSet('(*T)(&newDuration)')}\n`;
     indent.pop();
     serdeTextBody += `${indent.get()}}\n`;
     serdeTextBody += '}\n\n';
   }
   if (needsJSONPopulateAny) {
     serdeImports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
-    serdeTextBody += 'func populateAny(m map[string]any, k string, v any) {\n';
+    serdeTextBody += `func populateAny(${populateTarget}, k string, v any) {\n`;
     serdeTextBody += `${indent.get()}if v == nil {\n`;
     serdeTextBody += `${indent.push().get()}return\n`;
     serdeTextBody += `${indent.pop().get()}} else if azcore.IsNullValue(v) {\n`;
-    serdeTextBody += `${indent.push().get()}m[k] = nil\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('nil')}\n`;
+    serdeTextBody += `${indent.pop().get()}} else {\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('v')}\n`;
+    serdeTextBody += `${indent.pop().get()}}\n`;
+    serdeTextBody += '}\n\n';
+  }
+  if (needsJSONPopulateDecimalString) {
+    // json.Number is written as a JSON number so it's converted to a string
+    serdeImports.add('encoding/json');
+    serdeImports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+    serdeTextBody += `func populateDecimalString(${populateTarget}, k string, n *json.Number) {\n`;
+    serdeTextBody += `${indent.get()}if n == nil {\n`;
+    serdeTextBody += `${indent.push().get()}return\n`;
+    serdeTextBody += `${indent.pop().get()}} else if azcore.IsNullValue(n) {\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('nil')}\n`;
     serdeTextBody += `${indent.pop().get()}} else {\n`;
-    serdeTextBody += `${indent.push().get()}m[k] = v\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('n.String()')}\n`;
     serdeTextBody += `${indent.pop().get()}}\n`;
     serdeTextBody += '}\n\n';
   }
   if (needsJSONPopulateByteArray) {
     serdeImports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
     serdeImports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
-    serdeTextBody += 'func populateByteArray[T any](m map[string]any, k string, b []T, convert func() any) {\n';
+    serdeTextBody += `func populateByteArray[T any](${populateTarget}, k string, b []T, convert func() any) {\n`;
     serdeTextBody += `${indent.get()}if azcore.IsNullValue(b) {\n`;
-    serdeTextBody += `${indent.push().get()}m[k] = nil\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('nil')}\n`;
     serdeTextBody += `${indent.pop().get()}} else if len(b) == 0 {\n`;
     serdeTextBody += `${indent.push().get()}return\n`;
     serdeTextBody += `${indent.pop().get()}} else {\n`;
-    serdeTextBody += `${indent.push().get()}m[k] = convert()\n`;
+    serdeTextBody += `${indent.push().get()}${populateSet('convert()')}\n`;
     serdeTextBody += `${indent.pop().get()}}\n`;
     serdeTextBody += '}\n\n';
   }
@@ -177,6 +249,22 @@ export function generateModels(pkg: go.PackageContent, options: go.Options, emit
     serdeTextBody += `${indent.get()}return nil\n`;
     serdeTextBody += '}\n\n';
   }
+  if (needsJSONUnpopulateDuration) {
+    serdeImports.add('fmt');
+    serdeImports.add('time');
+    serdeTextBody += 'func unpopulateDuration[T durationConstraints](data json.RawMessage, fn string, d **time.Duration) error {\n';
+    serdeTextBody += `${indent.get()}if data == nil || string(data) == "null" {\n`;
+    serdeTextBody += `${indent.push().get()}return nil\n`;
+    serdeTextBody += `${indent.pop().get()}}\n`;
+    serdeTextBody += `${indent.get()}var aux T\n`;
+    serdeTextBody += `${indent.get()}if err := json.Unmarshal(data, &aux); err != nil {\n`;
+    serdeTextBody += `${indent.push().get()}return fmt.Errorf("struct field %s: %v", fn, err)\n`;
+    serdeTextBody += `${indent.pop().get()}}\n`;
+    serdeTextBody += `${indent.get()}newDuration := time.Duration(aux)\n`;
+    serdeTextBody += `${indent.get()}*d = &newDuration\n`;
+    serdeTextBody += `${indent.get()}return nil\n`;
+    serdeTextBody += '}\n\n';
+  }
   if (needsJSONPopulateMultipart) {
     serdeImports.add('encoding/json');
     serdeTextBody += 'func populateMultipartJSON(m map[string]any, k string, v any) error {\n';
@@ -188,7 +276,60 @@ export function generateModels(pkg: go.PackageContent, options: go.Options, emit
     serdeTextBody += `${indent.get()}return nil\n`;
     serdeTextBody += '}\n\n';
   }
-  if (needsJSONUnpopulateTime || needsJSONPopulateTime) {
+  if (needsJSONDecodeField) {
+    serdeImports.add('fmt');
+    serdeTextBody += 'func decodeField(r *jsonReader, fn string, v any) error {\n';
+    serdeTextBody += `${indent.get()}if r.isNull() {\n`;
+    serdeTextBody += `${indent.push().get()}return r.skip()\n`;
+    serdeTextBody += `${indent.pop().get()}}\n`;
+    serdeTextBody += `${indent.get()}if err := r.dec.Decode(v); err != nil {\n`;
+    serdeTextBody += `${indent.push().get()}return fmt.Errorf("struct field %s: %v", fn, err)\n`;
+    serdeTextBody += `${indent.pop().get()}}\n`;
+    serdeTextBody += `${indent.get()}return nil\n`;
+    serdeTextBody += '}\n\n';
+  }
+  if (needsJSONDecodeTimeField) {
+    serdeImports.add('fmt');
+    serdeImports.add('time');
+    serdeTextBody += 'func decodeTimeField[T dateTimeConstraints](r *jsonReader, fn string, t **time.Time) error {\n';
+    serdeTextBody += `${indent.get()}if r.isNull() {\n`;
+    serdeTextBody += `${indent.push().get()}return r.skip()\n`;
+    serdeTextBody += `${indent.pop().get()}}\n`;
+    serdeTextBody += `${indent.get()}var aux T\n`;
+    serdeTextBody += `${indent.get()}if err := r.dec.Decode(&aux); err != nil {\n`;
+    serdeTextBody += `${indent.push().get()}return fmt.Errorf("struct field %s: %v", fn, err)\n`;
+    serdeTextBody += `${indent.pop().get()}}\n`;
+    serdeTextBody += `${indent.get()}newTime := time.Time(aux)\n`;
+    serdeTextBody += `${indent.get()}*t = &newTime\n`;
+    serdeTextBody += `${indent.get()}return nil\n`;
+    serdeTextBody += '}\n\n';
+  }
+  if (needsJSONDecodeDurationField) {
+    serdeImports.add('fmt');
+    serdeImports.add('time');
+    serdeTextBody += 'func decodeDurationField[T durationConstraints](r *jsonReader, fn string, d **time.Duration) error {\n';
+    serdeTextBody += `${indent.get()}if r.isNull() {\n`;
+    serdeTextBody += `${indent.push().get()}return r.skip()\n`;
+    serdeTextBody += `${indent.pop().get()}}\n`;
+    serdeTextBody += `${indent.get()}var aux T\n`;
+    serdeTextBody += `${indent.get()}if err := r.dec.Decode(&aux); err != nil {\n`;
+    serdeTextBody += `${indent.push().get()}return fmt.Errorf("struct field %s: %v", fn, err)\n`;
+    serdeTextBody += `${indent.pop().get()}}\n`;
+    serdeTextBody += `${indent.get()}newDuration := time.Duration(aux)\n`;
+    serdeTextBody += `${indent.get()}*d = &newDuration\n`;
+    serdeTextBody += `${indent.get()}return nil\n`;
+    serdeTextBody += '}\n\n';
+  }
+  if (needsMergePatch) {
+    serdeTextBody += generateMergePatchHelpers(serdeImports, indent);
+  }
+  if (needsJSONWriter) {
+    serdeTextBody += generateJSONWriter(serdeImports, indent);
+  }
+  if (needsJSONReader) {
+    serdeTextBody += generateJSONReader(serdeImports, indent);
+  }
+  if (needsJSONUnpopulateTime || needsJSONPopulateTime || needsJSONDecodeTimeField) {
     const formats = new Array<string>('datetime.PlainDate', 'datetime.PlainTime', 'datetime.RFC3339', 'datetime.Unix');
     serdeTextBody += `type dateTimeConstraints interface {\n`;
     // rfc1123 and rfc7231 are mutually exclusive based on the emitter
@@ -215,6 +356,172 @@ export function generateModels(pkg: go.PackageContent, options: go.Options, emit
   };
 }
 
+/**
+ * generates the jsonWriter type used by streaming JSON marshallers.
+ * members are appended to a buffer in field order, and scalar values
+ * are encoded without going through reflection where possible.
+ *
+ * @param imports the import manager for the models_serde file
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the jsonWriter type and its helpers
+ */
+function generateJSONWriter(imports: ImportManager, indent: helpers.Indentation): string {
+  imports.add('encoding/json');
+  imports.add('strconv');
+  let text = '// jsonWriter incrementally encodes the members of a JSON object.\n';
+  text += 'type jsonWriter struct {\n';
+  text += `${indent.get()}buf []byte\n`;
+  text += `${indent.get()}err error\n`;
+  text += '}\n\n';
+
+  text += 'func newJSONWriter() *jsonWriter {\n';
+  text += `${indent.get()}return &jsonWriter{buf: append(make([]byte, 0, 256), '{')}\n`;
+  text += '}\n\n';
+
+  text += '// set writes the member k with value v.\n';
+  text += '// the first encoding error is retained and returned from finish.\n';
+  text += 'func (w *jsonWriter) set(k string, v any) {\n';
+  text += `${indent.get()}if w.err != nil {\n`;
+  text += `${indent.push().get()}return\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}if len(w.buf) > 1 {\n`;
+  text += `${indent.push().get()}w.buf = append(w.buf, ',')\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}w.buf = appendJSONString(w.buf, k)\n`;
+  text += `${indent.get()}w.buf = append(w.buf, ':')\n`;
+  text += `${indent.get()}switch tv := v.(type) {\n`;
+  text += `${indent.get()}case nil:\n`;
+  text += `${indent.push().get()}w.buf = append(w.buf, "null"...)\n`;
+  text += `${indent.get()}return\n`;
+  indent.pop();
+  // fast paths for the most common scalar types. nil pointers fall
+  // through to json.Marshal which encodes them as JSON null.
+  const scalars: Array<{ type: string; append: string }> = [
+    { type: 'string', append: 'appendJSONString(w.buf, *tv)' },
+    { type: 'bool', append: 'strconv.AppendBool(w.buf, *tv)' },
+    { type: 'int32', append: 'strconv.AppendInt(w.buf, int64(*tv), 10)' },
+    { type: 'int64', append: 'strconv.AppendInt(w.buf, *tv, 10)' },
+  ];
+  for (const scalar of scalars) {
+    text += `${indent.get()}case *${scalar.type}:\n`;
+    text += `${indent.push().get()}if tv != nil {\n`;
+    text += `${indent.push().get()}w.buf = ${scalar.append}\n`;
+    text += `${indent.get()}return\n`;
+    text += `${indent.pop().get()}}\n`;
+    indent.pop();
+  }
+  text += `${indent.get()}}\n`;
+  text += `${indent.get()}data, err := json.Marshal(v)\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}w.err = err\n`;
+  text += `${indent.get()}return\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}w.buf = append(w.buf, data...)\n`;
+  text += '}\n\n';
+
+  text += '// finish returns the encoded object or the first encoding error.\n';
+  text += 'func (w *jsonWriter) finish() ([]byte, error) {\n';
+  text += `${indent.get()}if w.err != nil {\n`;
+  text += `${indent.push().get()}return nil, w.err\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}return append(w.buf, '}'), nil\n`;
+  text += '}\n\n';
+
+  text += '// appendJSONString appends s as a JSON string, deferring to encoding/json when s requires escaping.\n';
+  text += 'func appendJSONString(buf []byte, s string) []byte {\n';
+  text += `${indent.get()}for i := 0; i < len(s); i++ {\n`;
+  text += `${indent.push().get()}if c := s[i]; c < 0x20 || c >= 0x80 || c == '"' || c == '\\\\' || c == '<' || c == '>' || c == '&' {\n`;
+  text += `${indent.push().get()}data, _ := json.Marshal(s)\n`;
+  text += `${indent.get()}return append(buf, data...)\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}buf = append(buf, '"')\n`;
+  text += `${indent.get()}buf = append(buf, s...)\n`;
+  text += `${indent.get()}return append(buf, '"')\n`;
+  text += '}\n\n';
+  return text;
+}
+
+/**
+ * generates the jsonReader type used by streaming JSON unmarshallers.
+ * object members are decoded one at a time from a json.Decoder.
+ *
+ * @param imports the import manager for the models_serde file
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the jsonReader type and its helpers
+ */
+function generateJSONReader(imports: ImportManager, indent: helpers.Indentation): string {
+  imports.add('bytes');
+  imports.add('encoding/json');
+  imports.add('fmt');
+  let text = '// jsonReader decodes the members of a JSON object one at a time.\n';
+  text += 'type jsonReader struct {\n';
+  text += `${indent.get()}data []byte\n`;
+  text += `${indent.get()}dec  *json.Decoder\n`;
+  text += '}\n\n';
+
+  text += 'func newJSONReader(data []byte) *jsonReader {\n';
+  text += `${indent.get()}return &jsonReader{data: data, dec: json.NewDecoder(bytes.NewReader(data))}\n`;
+  text += '}\n\n';
+
+  text += '// begin consumes the start of the object. it returns false if the value is JSON null.\n';
+  text += 'func (r *jsonReader) begin() (bool, error) {\n';
+  text += `${indent.get()}tok, err := r.dec.Token()\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return false, err\n`;
+  text += `${indent.pop().get()}} else if tok == nil {\n`;
+  text += `${indent.push().get()}return false, nil\n`;
+  text += `${indent.pop().get()}} else if delim, ok := tok.(json.Delim); !ok || delim != '{' {\n`;
+  text += `${indent.push().get()}return false, fmt.Errorf("expected JSON object, found %v", tok)\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}return true, nil\n`;
+  text += '}\n\n';
+
+  text += '// more returns true if there are more object members to decode.\n';
+  text += 'func (r *jsonReader) more() bool {\n';
+  text += `${indent.get()}return r.dec.More()\n`;
+  text += '}\n\n';
+
+  text += '// key returns the name of the next object member.\n';
+  text += 'func (r *jsonReader) key() (string, error) {\n';
+  text += `${indent.get()}tok, err := r.dec.Token()\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return "", err\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}key, ok := tok.(string)\n`;
+  text += `${indent.get()}if !ok {\n`;
+  text += `${indent.push().get()}return "", fmt.Errorf("expected JSON object key, found %v", tok)\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}return key, nil\n`;
+  text += '}\n\n';
+
+  text += '// end consumes the end of the object.\n';
+  text += 'func (r *jsonReader) end() error {\n';
+  text += `${indent.get()}_, err := r.dec.Token()\n`;
+  text += `${indent.get()}return err\n`;
+  text += '}\n\n';
+
+  text += '// isNull returns true if the next value is JSON null.\n';
+  text += 'func (r *jsonReader) isNull() bool {\n';
+  text += `${indent.get()}rest := bytes.TrimLeft(r.data[r.dec.InputOffset():], " \\t\\r\\n:")\n`;
+  text += `${indent.get()}return bytes.HasPrefix(rest, []byte("null"))\n`;
+  text += '}\n\n';
+
+  text += '// raw returns the next value without decoding it.\n';
+  text += 'func (r *jsonReader) raw() (json.RawMessage, error) {\n';
+  text += `${indent.get()}var val json.RawMessage\n`;
+  text += `${indent.get()}err := r.dec.Decode(&val)\n`;
+  text += `${indent.get()}return val, err\n`;
+  text += '}\n\n';
+
+  text += '// skip discards the next value.\n';
+  text += 'func (r *jsonReader) skip() error {\n';
+  text += `${indent.get()}_, err := r.raw()\n`;
+  text += `${indent.get()}return err\n`;
+  text += '}\n\n';
+  return text;
+}
+
 /**
  * converts model types to an array of ModelDef types
  *
@@ -274,7 +581,7 @@ function generateModelDefs(modelImports: ImportManager, serdeImports: ImportMana
           // import azcore as we don't explicitly reference the type
           serdeImports.addForType(field.type);
         }
-        if (field.type.kind === 'time') {
+        if (field.type.kind === 'duration' || field.type.kind === 'time') {
           needsDateTimeMarshalling = true;
         } else if (field.type.kind === 'encodedBytes') {
           byteArrayFormat = true;
@@ -304,8 +611,12 @@ function generateModelDefs(modelImports: ImportManager, serdeImports: ImportMana
       generateToMultipartForm(modelDef, indent);
       modelDef.SerDe.needsJSONPopulateMultipart = true;
     } else if (!model.annotations.omitSerDeMethods) {
-      generateJSONMarshaller(modelDef, serdeImports, indent);
+      generateJSONMarshaller(modelDef, options, serdeImports, indent);
       generateJSONUnmarshaller(modelDef, options, serdeImports, indent);
+      if (model.annotations.mergePatch) {
+        generateMergePatchMethods(modelDef, indent);
+        modelDef.SerDe.needsMergePatch = true;
+      }
     }
     modelDefs.push(modelDef);
   }
@@ -392,15 +703,169 @@ function generateToMultipartForm(modelDef: ModelDef, indent: helpers.Indentation
   modelDef.SerDe.methods.push({ name: 'toMultipartFormData', desc: `toMultipartFormData converts ${modelDef.Model.name} to multipart/form data.`, text: method });
 }
 
+/**
+ * returns true if the field can be set and cleared in a JSON merge patch.
+ * constant and read-only fields aren't sent and non-nillable fields can't be cleared.
+ *
+ * @param field the field to check
+ * @returns true if the field gets merge patch methods
+ */
+function isMergePatchField(field: go.ModelField): boolean {
+  if (field.annotations.isDiscriminator || field.annotations.readOnly || field.annotations.isAdditionalProperties || field.type.kind === 'literal') {
+    return false;
+  } else if (!field.byValue) {
+    return true;
+  }
+  switch (field.type.kind) {
+    case 'any':
+    case 'encodedBytes':
+    case 'interface':
+    case 'map':
+    case 'rawJSON':
+    case 'slice':
+    case 'union':
+      return true;
+    default:
+      return false;
+  }
+}
+
+/**
+ * generates the Set, Clear and Remove methods for a model sent as a JSON merge patch.
+ * the methods are added to modelDef.Methods.
+ *
+ * @param modelDef the type for which to emit the methods
+ * @param indent the indentation helper currently in scope
+ */
+function generateMergePatchMethods(modelDef: ModelDef, indent: helpers.Indentation): void {
+  const typeName = modelDef.Model.name;
+  const receiver = modelDef.receiverName();
+  for (const field of modelDef.Model.fields) {
+    if (!isMergePatchField(field)) {
+      continue;
+    }
+    const fieldType = go.getTypeDeclaration(field.type, modelDef.Model.pkg);
+
+    const setName = `Set${field.name}`;
+    let setter = `func (${receiver} *${typeName}) ${setName}(v ${fieldType}) {\n`;
+    setter += `${indent.get()}${receiver}.${field.name} = ${field.byValue ? 'v' : '&v'}\n`;
+    setter += `${indent.get()}delete(${receiver}.clearedFields, "${field.serializedName}")\n`;
+    setter += '}\n\n';
+    modelDef.Methods.push({ name: setName, desc: `${setName} sets ${field.name} and sends it in a JSON merge patch.`, text: setter });
+
+    const clearName = `Clear${field.name}`;
+    let clearer = `func (${receiver} *${typeName}) ${clearName}() {\n`;
+    clearer += `${indent.get()}${receiver}.${field.name} = nil\n`;
+    clearer += `${indent.get()}${receiver}.clearedFields = clearMergePatchField(${receiver}.clearedFields, "${field.serializedName}")\n`;
+    clearer += '}\n\n';
+    modelDef.Methods.push({ name: clearName, desc: `${clearName} sets ${field.name} to nil and sends null for it in a JSON merge patch.`, text: clearer });
+
+    if (field.type.kind === 'map' && !field.type.valueTypeByValue) {
+      const removeName = `Remove${field.name}Key`;
+      let remover = `func (${receiver} *${typeName}) ${removeName}(key string) {\n`;
+      remover += `${indent.get()}if ${receiver}.${field.name} == nil {\n`;
+      remover += `${indent.push().get()}${receiver}.${field.name} = ${fieldType}{}\n`;
+      remover += `${indent.pop().get()}}\n`;
+      remover += `${indent.get()}${receiver}.${field.name}[key] = nil\n`;
+      remover += '}\n\n';
+      modelDef.Methods.push({ name: removeName, desc: `${removeName} sends null for key in ${field.name} in a JSON merge patch, which removes the key.`, text: remover });
+    }
+  }
+}
+
+/**
+ * generates the helpers used by models sent as a JSON merge patch, and
+ * NewMergePatch which computes the RFC 7396 patch between two models.
+ *
+ * @param imports the import manager for the models_serde file
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the merge patch helpers
+ */
+function generateMergePatchHelpers(imports: ImportManager, indent: helpers.Indentation): string {
+  imports.add('encoding/json');
+  imports.add('reflect');
+  let text = '// NewMergePatch returns the JSON merge patch (RFC 7396) that transforms original into modified.\n';
+  text += '// Fields that are absent from modified are cleared, nested objects and maps are compared member\n';
+  text += '// by member, and all other values that differ, including arrays, are replaced.\n';
+  text += '// T is the model sent as the patch, e.g. the model for the PATCH request body.\n';
+  text += 'func NewMergePatch[T any](original, modified any) (T, error) {\n';
+  text += `${indent.get()}var patch T\n`;
+  text += `${indent.get()}originalObj, err := toMergePatchObject(original)\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return patch, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}modifiedObj, err := toMergePatchObject(modified)\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return patch, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}data, err := json.Marshal(diffMergePatch(originalObj, modifiedObj))\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return patch, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}err = json.Unmarshal(data, &patch)\n`;
+  text += `${indent.get()}return patch, err\n`;
+  text += '}\n\n';
+
+  text += 'func toMergePatchObject(v any) (map[string]any, error) {\n';
+  text += `${indent.get()}data, err := json.Marshal(v)\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return nil, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}var obj map[string]any\n`;
+  text += `${indent.get()}if err := json.Unmarshal(data, &obj); err != nil {\n`;
+  text += `${indent.push().get()}return nil, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}return obj, nil\n`;
+  text += '}\n\n';
+
+  text += 'func diffMergePatch(original, modified map[string]any) map[string]any {\n';
+  text += `${indent.get()}patch := map[string]any{}\n`;
+  text += `${indent.get()}for k, ov := range original {\n`;
+  text += `${indent.push().get()}mv, ok := modified[k]\n`;
+  text += `${indent.get()}if !ok || mv == nil {\n`;
+  text += `${indent.push().get()}if ov != nil {\n`;
+  text += `${indent.push().get()}patch[k] = nil\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}continue\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}om, oIsObj := ov.(map[string]any)\n`;
+  text += `${indent.get()}mm, mIsObj := mv.(map[string]any)\n`;
+  text += `${indent.get()}if oIsObj && mIsObj {\n`;
+  text += `${indent.push().get()}if diff := diffMergePatch(om, mm); len(diff) > 0 {\n`;
+  text += `${indent.push().get()}patch[k] = diff\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.pop().get()}} else if !reflect.DeepEqual(ov, mv) {\n`;
+  text += `${indent.push().get()}patch[k] = mv\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}for k, mv := range modified {\n`;
+  text += `${indent.push().get()}if _, ok := original[k]; !ok && mv != nil {\n`;
+  text += `${indent.push().get()}patch[k] = mv\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}return patch\n`;
+  text += '}\n\n';
+
+  text += 'func clearMergePatchField(cleared map[string]bool, k string) map[string]bool {\n';
+  text += `${indent.get()}if cleared == nil {\n`;
+  text += `${indent.push().get()}cleared = map[string]bool{}\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}cleared[k] = true\n`;
+  text += `${indent.get()}return cleared\n`;
+  text += '}\n\n';
+  return text;
+}
+
 /**
  * generates the MarshalJSON method for the provided type.
  * the method impl is added to modelDef.SerDe.methods.
  *
  * @param modelDef the type for which to emit the method
+ * @param options the Go emitter options
  * @param imports the import manager currently in scope
  * @param indent the indentation helper currently in scope
  */
-function generateJSONMarshaller(modelDef: ModelDef, imports: ImportManager, indent: helpers.Indentation): void {
+function generateJSONMarshaller(modelDef: ModelDef, options: go.Options, imports: ImportManager, indent: helpers.Indentation): void {
   if (modelDef.Model.kind === 'model' && modelDef.Model.fields.length === 0) {
     // non-discriminated types without content don't need a custom marshaller.
     // there is a case in network where child is allOf base and child has no properties.
@@ -410,9 +875,16 @@ function generateJSONMarshaller(modelDef: ModelDef, imports: ImportManager, inde
   const typeName = modelDef.Model.name;
   const receiver = modelDef.receiverName();
   let marshaller = `func (${receiver} ${typeName}) MarshalJSON() ([]byte, error) {\n`;
-  marshaller += `${indent.get()}objectMap := make(map[string]any)\n`;
-  marshaller += generateJSONMarshallerBody(modelDef, receiver, imports, indent);
-  marshaller += `${indent.get()}return json.Marshal(objectMap)\n`;
+  if (options.streamingJSONSerDe) {
+    marshaller += `${indent.get()}writer := newJSONWriter()\n`;
+    marshaller += generateJSONMarshallerBody(modelDef, receiver, true, imports, indent);
+    marshaller += `${indent.get()}return writer.finish()\n`;
+    modelDef.SerDe.needsJSONWriter = true;
+  } else {
+    marshaller += `${indent.get()}objectMap := make(map[string]any)\n`;
+    marshaller += generateJSONMarshallerBody(modelDef, receiver, false, imports, indent);
+    marshaller += `${indent.get()}return json.Marshal(objectMap)\n`;
+  }
   marshaller += '}\n\n';
   modelDef.SerDe.methods.push({ name: 'MarshalJSON', desc: `MarshalJSON implements the json.Marshaller interface for type ${typeName}.`, text: marshaller });
 }
@@ -422,11 +894,16 @@ function generateJSONMarshaller(modelDef: ModelDef, imports: ImportManager, inde
  *
  * @param modelDef the type being encoded
  * @param receiver the name of the receiver in the MarshalJSON method
+ * @param streaming when true, values are written to a jsonWriter named writer instead of a map named objectMap
  * @param imports the import manager currently in scope
  * @param indent the indentation helper currently in scope
  * @returns the text for encoding the target type
  */
-function generateJSONMarshallerBody(modelDef: ModelDef, receiver: string, imports: ImportManager, indent: helpers.Indentation): string {
+function generateJSONMarshallerBody(modelDef: ModelDef, receiver: string, streaming: boolean, imports: ImportManager, indent: helpers.Indentation): string {
+  const target = streaming ? 'writer' : 'objectMap';
+  const setValue = function (key: string, value: string): string {
+    return streaming ? `writer.set(${key}, ${value})` : `objectMap[${key}] = ${value}`;
+  };
   let marshaller = '';
   let addlProps: go.Map | undefined;
   for (const field of modelDef.Model.fields) {
@@ -436,21 +913,21 @@ function generateJSONMarshallerBody(modelDef: ModelDef, receiver: string, import
     }
     if (field.annotations.isDiscriminator) {
       if (field.defaultValue) {
-        marshaller += `${indent.get()}objectMap["${field.serializedName}"] = ${helpers.formatLiteralValue(field.defaultValue, true)}\n`;
+        marshaller += `${indent.get()}${setValue(`"${field.serializedName}"`, helpers.formatLiteralValue(field.defaultValue, true))}\n`;
       } else {
         // if there's no discriminator value (e.g. Fish in test server), use the field's value.
         // this will enable support for custom types that aren't (yet) described in the swagger.
-        marshaller += `${indent.get()}objectMap["${field.serializedName}"] = ${receiver}.${field.name}\n`;
+        marshaller += `${indent.get()}${setValue(`"${field.serializedName}"`, `${receiver}.${field.name}`)}\n`;
       }
     } else if (field.type.kind === 'encodedBytes') {
       imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
-      marshaller += `${indent.get()}populateByteArray(objectMap, "${field.serializedName}", ${receiver}.${field.name}, func() any {\n`;
+      marshaller += `${indent.get()}populateByteArray(${target}, "${field.serializedName}", ${receiver}.${field.name}, func() any {\n`;
       marshaller += `${indent.push().get()}return runtime.EncodeByteArray(${receiver}.${field.name}, runtime.Base64${field.type.encoding}Format)\n`;
       marshaller += `${indent.pop().get()}})\n`;
       modelDef.SerDe.needsJSONPopulateByteArray = true;
     } else if (field.type.kind === 'slice' && field.type.elementType.kind === 'encodedBytes') {
       imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
-      marshaller += `${indent.get()}populateByteArray(objectMap, "${field.serializedName}", ${receiver}.${field.name}, func() any {\n`;
+      marshaller += `${indent.get()}populateByteArray(${target}, "${field.serializedName}", ${receiver}.${field.name}, func() any {\n`;
       marshaller += `${indent.push().get()}encodedValue := make([]string, len(${receiver}.${field.name}))\n`;
       marshaller += `${indent.get()}for i := 0; i < len(${receiver}.${field.name}); i++ {\n`;
       marshaller += `${indent.push().get()}encodedValue[i] = runtime.EncodeByteArray(${receiver}.${field.name}[i], runtime.Base64${field.type.elementType.encoding}Format)\n`;
@@ -469,10 +946,23 @@ function generateJSONMarshallerBody(modelDef: ModelDef, receiver: string, import
       marshaller += `${indent.get()}for i := 0; i < len(${source}); i++ {\n`;
       marshaller += `${indent.push().get()}aux[i] = (${elementPtr}datetime.${field.type.elementType.format})(${source}[i])\n`;
       marshaller += `${indent.pop().get()}}\n`;
-      marshaller += `${indent.get()}populate(objectMap, "${field.serializedName}", aux)\n`;
+      marshaller += `${indent.get()}populate(${target}, "${field.serializedName}", aux)\n`;
+      modelDef.SerDe.needsJSONPopulate = true;
+    } else if (field.type.kind === 'slice' && field.type.elementType.kind === 'duration') {
+      const source = `${receiver}.${field.name}`;
+      let elementPtr = '*';
+      if (field.type.elementTypeByValue) {
+        elementPtr = '';
+      }
+      const durationType = helpers.getDurationTypeName(field.type.elementType);
+      marshaller += `${indent.get()}aux${field.name} := make([]${elementPtr}${durationType}, len(${source}), len(${source}))\n`;
+      marshaller += `${indent.get()}for i := 0; i < len(${source}); i++ {\n`;
+      marshaller += `${indent.push().get()}aux${field.name}[i] = (${elementPtr}${durationType})(${source}[i])\n`;
+      marshaller += `${indent.pop().get()}}\n`;
+      marshaller += `${indent.get()}populate(${target}, "${field.serializedName}", aux${field.name})\n`;
       modelDef.SerDe.needsJSONPopulate = true;
     } else if (field.type.kind === 'literal') {
-      const setter = `objectMap["${field.serializedName}"] = ${helpers.formatLiteralValue(field.type, true)}`;
+      const setter = setValue(`"${field.serializedName}"`, helpers.formatLiteralValue(field.type, true));
       if (!field.annotations.required) {
         marshaller += `${indent.get()}if ${receiver}.${field.name} != nil {\n`;
         marshaller += `${indent.push().get()}${setter}\n`;
@@ -481,7 +971,7 @@ function generateJSONMarshallerBody(modelDef: ModelDef, receiver: string, import
         marshaller += `${indent.get()}${setter}\n`;
       }
     } else if (field.type.kind === 'rawJSON') {
-      marshaller += `${indent.get()}populate(objectMap, "${field.serializedName}", json.RawMessage(${receiver}.${field.name}))\n`;
+      marshaller += `${indent.get()}populate(${target}, "${field.serializedName}", json.RawMessage(${receiver}.${field.name}))\n`;
       modelDef.SerDe.needsJSONPopulate = true;
     } else {
       if (field.defaultValue) {
@@ -495,9 +985,15 @@ function generateJSONMarshallerBody(modelDef: ModelDef, receiver: string, import
         imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
         populate = `populateTime[datetime.${field.type.format}]`;
         modelDef.SerDe.needsJSONPopulateTime = true;
+      } else if (field.type.kind === 'duration') {
+        populate = `populateDuration[${helpers.getDurationTypeName(field.type)}]`;
+        modelDef.SerDe.needsJSONPopulateDuration = true;
       } else if (field.type.kind === 'any') {
         populate = 'populateAny';
         modelDef.SerDe.needsJSONPopulateAny = true;
+      } else if (field.type.kind === 'decimal' && field.type.encodeAsString) {
+        populate = 'populateDecimalString';
+        modelDef.SerDe.needsJSONPopulateDecimalString = true;
       } else {
         populate = 'populate';
         modelDef.SerDe.needsJSONPopulate = true;
@@ -507,14 +1003,20 @@ function generateJSONMarshallerBody(modelDef: ModelDef, receiver: string, import
         imports.add('strconv');
         imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/to');
         if ((field.type.type.startsWith('uint') && field.type.type !== 'uint64') || (field.type.type.startsWith('int') && field.type.type !== 'int64')) {
-          marshaller += `${indent.get()}${populate}(objectMap, "${field.serializedName}", to.Ptr(strconv.${field.type.type.startsWith('int') ? 'FormatInt' : 'FormatUint'}(${field.type.type.startsWith('int') ? 'int64' : 'uint64'}(*${receiver}.${field.name}), 10)))\n`;
+          marshaller += `${indent.get()}${populate}(${target}, "${field.serializedName}", to.Ptr(strconv.${field.type.type.startsWith('int') ? 'FormatInt' : 'FormatUint'}(${field.type.type.startsWith('int') ? 'int64' : 'uint64'}(*${receiver}.${field.name}), 10)))\n`;
         } else {
-          marshaller += `${indent.get()}${populate}(objectMap, "${field.serializedName}", to.Ptr(strconv.${field.type.type.startsWith('int') ? 'FormatInt' : 'FormatUint'}(*${receiver}.${field.name}, 10)))\n`;
+          marshaller += `${indent.get()}${populate}(${target}, "${field.serializedName}", to.Ptr(strconv.${field.type.type.startsWith('int') ? 'FormatInt' : 'FormatUint'}(*${receiver}.${field.name}, 10)))\n`;
         }
       } else {
-        marshaller += `${indent.get()}${populate}(objectMap, "${field.serializedName}", ${receiver}.${field.name})\n`;
+        marshaller += `${indent.get()}${populate}(${target}, "${field.serializedName}", ${receiver}.${field.name})\n`;
       }
     }
+    if (modelDef.Model.annotations.mergePatch && isMergePatchField(field)) {
+      // populate skips nil values so cleared fields are sent as null here
+      marshaller += `${indent.get()}if ${receiver}.${field.name} == nil && ${receiver}.clearedFields["${field.serializedName}"] {\n`;
+      marshaller += `${indent.push().get()}${setValue(`"${field.serializedName}"`, 'nil')}\n`;
+      marshaller += `${indent.pop().get()}}\n`;
+    }
   }
   if (addlProps) {
     marshaller += `${indent.get()}if ${receiver}.AdditionalProperties != nil {\n`;
@@ -523,7 +1025,7 @@ function generateJSONMarshallerBody(modelDef: ModelDef, receiver: string, import
     if (addlProps.valueType.kind === 'time') {
       assignment = `(*${addlProps.valueType.format})(val)`;
     }
-    marshaller += `${indent.push().get()}objectMap[key] = ${assignment}\n`;
+    marshaller += `${indent.push().get()}${setValue('key', assignment)}\n`;
     marshaller += `${indent.pop().get()}}\n`;
     marshaller += `${indent.pop().get()}}\n`;
   }
@@ -550,10 +1052,20 @@ function generateJSONUnmarshaller(modelDef: ModelDef, options: go.Options, impor
   const typeName = modelDef.Model.name;
   const receiver = modelDef.receiverName();
   let unmarshaller = `func (${receiver} *${typeName}) UnmarshalJSON(data []byte) error {\n`;
-  unmarshaller += `${indent.get()}var rawMsg map[string]json.RawMessage\n`;
-  unmarshaller += `${indent.get()}if err := json.Unmarshal(data, &rawMsg); err != nil {\n`;
-  unmarshaller += `${indent.push().get()}return fmt.Errorf("unmarshalling type %T: %v", ${receiver}, err)\n`;
-  unmarshaller += `${indent.pop().get()}}\n`;
+  if (options.streamingJSONSerDe) {
+    unmarshaller += `${indent.get()}reader := newJSONReader(data)\n`;
+    unmarshaller += `${indent.get()}if ok, err := reader.begin(); err != nil {\n`;
+    unmarshaller += `${indent.push().get()}return fmt.Errorf("unmarshalling type %T: %v", ${receiver}, err)\n`;
+    unmarshaller += `${indent.pop().get()}} else if !ok {\n`;
+    unmarshaller += `${indent.push().get()}return nil\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+    modelDef.SerDe.needsJSONReader = true;
+  } else {
+    unmarshaller += `${indent.get()}var rawMsg map[string]json.RawMessage\n`;
+    unmarshaller += `${indent.get()}if err := json.Unmarshal(data, &rawMsg); err != nil {\n`;
+    unmarshaller += `${indent.push().get()}return fmt.Errorf("unmarshalling type %T: %v", ${receiver}, err)\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+  }
   unmarshaller += generateJSONUnmarshallerBody(modelDef, receiver, options, imports, indent);
   unmarshaller += '}\n\n';
   modelDef.SerDe.methods.push({ name: 'UnmarshalJSON', desc: `UnmarshalJSON implements the json.Unmarshaller interface for type ${typeName}.`, text: unmarshaller });
@@ -576,6 +1088,19 @@ function generateJSONUnmarshallerBody(modelDef: ModelDef, receiver: string, opti
   // and can be elided (the linter complains about it otherwise).
   let needsErrCheck = false;
 
+  // in streaming mode, values are decoded from a jsonReader named reader instead of ranging over rawMsg
+  const streaming = options.streamingJSONSerDe;
+
+  // emits the start of a block that reads the raw value for the current key.
+  // used in streaming mode for values that require additional processing.
+  const emitBeginRawValue = function (): string {
+    let rawValueText = `${indent.get()}var val json.RawMessage\n`;
+    rawValueText += `${indent.get()}if val, err = reader.raw(); err == nil {\n`;
+    indent.push();
+    needsErrCheck = true;
+    return rawValueText;
+  };
+
   const emitAddlProps = function (addlProps: go.Map): string {
     // indent is at the case body level when called
     let addlPropsText = `${indent.get()}if ${receiver}.AdditionalProperties == nil {\n`;
@@ -597,7 +1122,9 @@ function generateJSONUnmarshallerBody(modelDef: ModelDef, receiver: string, opti
     addlPropsText += `${indent.get()}err = json.Unmarshal(val, &aux)\n`;
     addlPropsText += `${indent.get()}${receiver}.AdditionalProperties[key] = ${assignment}\n`;
     addlPropsText += `${indent.pop().get()}}\n`;
-    addlPropsText += `${indent.get()}delete(rawMsg, key)\n`;
+    if (!streaming) {
+      addlPropsText += `${indent.get()}delete(rawMsg, key)\n`;
+    }
     needsErrCheck = true;
     return addlPropsText;
   };
@@ -614,6 +1141,24 @@ function generateJSONUnmarshallerBody(modelDef: ModelDef, receiver: string, opti
       }
       unmarshalBody += `${indent.get()}case "${field.serializedName}":\n`;
       indent.push(); // case body level
+      if (streaming && isStreamDecodable(field)) {
+        if (field.type.kind === 'time') {
+          imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
+          unmarshalBody += `${indent.get()}err = decodeTimeField[datetime.${field.type.format}](reader, "${field.name}", &${receiver}.${field.name})\n`;
+          modelDef.SerDe.needsJSONDecodeTimeField = true;
+        } else if (field.type.kind === 'duration') {
+          unmarshalBody += `${indent.get()}err = decodeDurationField[${helpers.getDurationTypeName(field.type)}](reader, "${field.name}", &${receiver}.${field.name})\n`;
+          modelDef.SerDe.needsJSONDecodeDurationField = true;
+        } else {
+          unmarshalBody += `${indent.get()}err = decodeField(reader, "${field.name}", &${receiver}.${field.name})\n`;
+          modelDef.SerDe.needsJSONDecodeField = true;
+        }
+        needsErrCheck = true;
+        indent.pop(); // back to switch level
+        continue;
+      } else if (streaming) {
+        unmarshalBody += emitBeginRawValue();
+      }
       if (hasDiscriminatorInterface(field.type)) {
         unmarshalBody += generateDiscriminatorUnmarshaller(modelDef.Model, field, receiver, indent);
         needsErrCheck = true;
@@ -621,6 +1166,23 @@ function generateJSONUnmarshallerBody(modelDef: ModelDef, receiver: string, opti
         unmarshalBody += `${indent.get()}err = unpopulateTime[datetime.${field.type.format}](val, "${field.name}", &${receiver}.${field.name})\n`;
         modelDef.SerDe.needsJSONUnpopulateTime = true;
         needsErrCheck = true;
+      } else if (field.type.kind === 'duration') {
+        unmarshalBody += `${indent.get()}err = unpopulateDuration[${helpers.getDurationTypeName(field.type)}](val, "${field.name}", &${receiver}.${field.name})\n`;
+        modelDef.SerDe.needsJSONUnpopulateDuration = true;
+        needsErrCheck = true;
+      } else if (field.type.kind === 'slice' && field.type.elementType.kind === 'duration') {
+        imports.add('time');
+        let elementPtr = '*';
+        if (field.type.elementTypeByValue) {
+          elementPtr = '';
+        }
+        unmarshalBody += `${indent.get()}var aux []${elementPtr}${helpers.getDurationTypeName(field.type.elementType)}\n`;
+        unmarshalBody += `${indent.get()}err = unpopulate(val, "${field.name}", &aux)\n`;
+        unmarshalBody += `${indent.get()}for _, au := range aux {\n`;
+        unmarshalBody += `${indent.push().get()}${receiver}.${field.name} = append(${receiver}.${field.name}, (${elementPtr}time.Duration)(au))\n`;
+        unmarshalBody += `${indent.pop().get()}}\n`;
+        modelDef.SerDe.needsJSONUnpopulate = true;
+        needsErrCheck = true;
       } else if (field.type.kind === 'slice' && field.type.elementType.kind === 'time') {
         imports.add('time');
         imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
@@ -692,50 +1254,118 @@ function generateJSONUnmarshallerBody(modelDef: ModelDef, receiver: string, opti
         modelDef.SerDe.needsJSONUnpopulate = true;
         needsErrCheck = true;
       }
-      unmarshalBody += `${indent.get()}delete(rawMsg, key)\n`;
+      if (streaming) {
+        unmarshalBody += `${indent.pop().get()}}\n`;
+      } else {
+        unmarshalBody += `${indent.get()}delete(rawMsg, key)\n`;
+      }
       indent.pop(); // back to switch level
     }
     if (addlProps) {
       unmarshalBody += `${indent.get()}default:\n`;
       indent.push(); // case body level
-      unmarshalBody += emitAddlProps(addlProps);
+      if (streaming) {
+        unmarshalBody += emitBeginRawValue();
+        unmarshalBody += emitAddlProps(addlProps);
+        unmarshalBody += `${indent.pop().get()}}\n`;
+      } else {
+        unmarshalBody += emitAddlProps(addlProps);
+      }
       indent.pop();
     } else if (options.disallowUnknownFields) {
       unmarshalBody += `${indent.get()}default:\n`;
       unmarshalBody += `${indent.push().get()}err = fmt.Errorf("unmarshalling type %T, unknown field %q", ${receiver}, key)\n`;
       indent.pop();
       needsErrCheck = true;
+    } else if (streaming) {
+      // unknown values must still be consumed from the token stream
+      unmarshalBody += `${indent.get()}default:\n`;
+      unmarshalBody += `${indent.push().get()}err = reader.skip()\n`;
+      indent.pop();
+      needsErrCheck = true;
     }
     unmarshalBody += `${indent.get()}}\n`;
     return unmarshalBody;
   };
 
-  let unmarshalBody = `${indent.get()}for key, val := range rawMsg {\n`;
+  const emitErrCheck = function (): string {
+    let errCheckText = `${indent.get()}if err != nil {\n`;
+    errCheckText += `${indent.push().get()}return fmt.Errorf("unmarshalling type %T: %v", ${receiver}, err)\n`;
+    errCheckText += `${indent.pop().get()}}\n`;
+    return errCheckText;
+  };
+
+  let unmarshalBody = '';
+  if (streaming) {
+    unmarshalBody += `${indent.get()}for reader.more() {\n`;
+  } else {
+    unmarshalBody += `${indent.get()}for key, val := range rawMsg {\n`;
+  }
   indent.push(); // level 2 (for loop body)
 
   // emitSwitchCase sets needsErrCheck so we must call it first
   const switchCaseBody = emitSwitchCase();
 
-  if (needsErrCheck) {
+  if (streaming) {
+    unmarshalBody += `${indent.get()}key, err := reader.key()\n`;
+    unmarshalBody += emitErrCheck();
+  } else if (needsErrCheck) {
     unmarshalBody += `${indent.get()}var err error\n`;
   }
+  if (modelDef.Model.annotations.mergePatch) {
+    // a null value clears the field so it round-trips as part of a merge patch
+    unmarshalBody += `${indent.get()}if ${streaming ? 'reader.isNull()' : 'string(val) == "null"'} {\n`;
+    unmarshalBody += `${indent.push().get()}${receiver}.clearedFields = clearMergePatchField(${receiver}.clearedFields, key)\n`;
+    unmarshalBody += `${indent.pop().get()}}\n`;
+  }
   unmarshalBody += switchCaseBody;
   if (needsErrCheck) {
-    unmarshalBody += `${indent.get()}if err != nil {\n`;
+    unmarshalBody += emitErrCheck();
+  }
+  indent.pop(); // level 1
+  unmarshalBody += `${indent.get()}}\n`; // end for loop
+  if (streaming) {
+    unmarshalBody += `${indent.get()}if err := reader.end(); err != nil {\n`;
     unmarshalBody += `${indent.push().get()}return fmt.Errorf("unmarshalling type %T: %v", ${receiver}, err)\n`;
     unmarshalBody += `${indent.pop().get()}}\n`;
   }
-  indent.pop(); // level 1
-  unmarshalBody += `${indent.get()}}\n`; // end for key, val := range rawMsg
   unmarshalBody += `${indent.get()}return nil\n`;
   return unmarshalBody;
 }
 
+/**
+ * returns true if the field's value can be decoded directly from the token stream.
+ * fields that require additional processing (e.g. discriminated types, encoded bytes)
+ * are first read as raw JSON and then decoded.
+ *
+ * @param field the field to check
+ * @returns true if the field can be decoded in place
+ */
+function isStreamDecodable(field: go.ModelField): boolean {
+  if (hasDiscriminatorInterface(field.type)) {
+    return false;
+  }
+  switch (field.type.kind) {
+    case 'encodedBytes':
+    case 'rawJSON':
+      return false;
+    case 'scalar':
+      return !((field.type.type.startsWith('uint') || field.type.type.startsWith('int')) && field.type.encodeAsString);
+    case 'slice':
+      return field.type.elementType.kind !== 'duration' && field.type.elementType.kind !== 'encodedBytes' && field.type.elementType.kind !== 'time';
+    case 'string':
+      return !field.annotations.unmarshalEmptyStringAsNil;
+    default:
+      return true;
+  }
+}
+
 // returns true if item has a discriminator interface.
 // recursively called for arrays and dictionaries.
 function hasDiscriminatorInterface(item: go.WireType): boolean {
   switch (item.kind) {
     case 'interface':
+    case 'union':
       return true;
     case 'map':
       return hasDiscriminatorInterface(item.valueType);
@@ -746,16 +1376,21 @@ function hasDiscriminatorInterface(item: go.WireType): boolean {
   }
 }
 
+// returns true if item is unmarshalled with a generated unmarshal<Interface> helper
+function isInterfaceType(item: go.WireType): item is go.Interface | go.Union {
+  return item.kind === 'interface' || item.kind === 'union';
+}
+
 // returns the text for unmarshalling a discriminated type
 function generateDiscriminatorUnmarshaller(modelType: go.Model | go.PolymorphicModel, field: go.ModelField, receiver: string, indent: helpers.Indentation): string {
   const propertyName = field.name;
 
   // these are the simple, non-nested cases (e.g. IterfaceType, []InterfaceType, map[string]InterfaceType)
-  if (field.type.kind === 'interface') {
+  if (isInterfaceType(field.type)) {
     return `${indent.get()}${receiver}.${propertyName}, err = unmarshal${field.type.name}(val)\n`;
-  } else if (field.type.kind === 'slice' && field.type.elementType.kind === 'interface') {
+  } else if (field.type.kind === 'slice' && isInterfaceType(field.type.elementType)) {
     return `${indent.get()}${receiver}.${propertyName}, err = unmarshal${field.type.elementType.name}Array(val)\n`;
-  } else if (field.type.kind === 'map' && field.type.valueType.kind === 'interface') {
+  } else if (field.type.kind === 'map' && isInterfaceType(field.type.valueType)) {
     return `${indent.get()}${receiver}.${propertyName}, err = unmarshal${field.type.valueType.name}Map(val)\n`;
   }
 
@@ -792,11 +1427,11 @@ function generateDiscriminatorUnmarshaller(modelType: go.Model | go.PolymorphicM
 function recursiveGetDiscriminatorTypeName(modelType: go.Model | go.PolymorphicModel, item: go.WireType, raw: boolean): string {
   // when raw is true, stop recursing at the level before the leaf schema
   if (item.kind === 'slice') {
-    if (!raw || item.elementType.kind !== 'interface') {
+    if (!raw || !isInterfaceType(item.elementType)) {
       return `[]${recursiveGetDiscriminatorTypeName(modelType, item.elementType, raw)}`;
     }
   } else if (item.kind === 'map') {
-    if (!raw || item.valueType.kind !== 'interface') {
+    if (!raw || !isInterfaceType(item.valueType)) {
       return `map[string]${recursiveGetDiscriminatorTypeName(modelType, item.valueType, raw)}`;
     }
   }
@@ -832,7 +1467,7 @@ function recursivePopulateDiscriminator(
   let targetType = '';
 
   if (item.kind === 'slice') {
-    if (item.elementType.kind !== 'interface') {
+    if (!isInterfaceType(item.elementType)) {
       if (nesting > 1) {
         // at nestling level 1, the destination var was already created in generateDiscriminatorUnmarshaller()
         text += `${indent.get()}${dest} = make(${recursiveGetDiscriminatorTypeName(modelType, item, false)}, len(${rawSrc}))\n`;
@@ -852,7 +1487,7 @@ function recursivePopulateDiscriminator(
     interfaceName = go.getTypeDeclaration(item.elementType, modelType.pkg);
     targetType = 'Array';
   } else if (item.kind === 'map') {
-    if (item.valueType.kind !== 'interface') {
+    if (!isInterfaceType(item.valueType)) {
       if (nesting > 1) {
         // at nestling level 1, the destination var was already created in generateDiscriminatorUnmarshaller()
         text += `${indent.get()}${dest} = ${recursiveGetDiscriminatorTypeName(modelType, item, false)}{}\n`;
@@ -940,6 +1575,8 @@ function generateXMLUnmarshaller(modelDef: ModelDef, imports: ImportManager, ind
       text += `${indent.get()}if aux.${field.name} != nil && !(*time.Time)(aux.${field.name}).IsZero() {\n`;
       text += `${indent.push().get()}${receiver}.${field.name} = (*time.Time)(aux.${field.name})\n`;
       text += `${indent.pop().get()}}\n`;
+    } else if (field.type.kind === 'duration') {
+      text += `${indent.get()}${receiver}.${field.name} = (*time.Duration)(aux.${field.name})\n`;
     } else if (field.annotations.isAdditionalProperties || field.type.kind === 'map') {
       text += `${indent.get()}${receiver}.${field.name} = (map[string]*string)(aux.${field.name})\n`;
     } else if (field.type.kind === 'encodedBytes') {
@@ -975,6 +1612,8 @@ function generateAliasType(modelType: go.Model | go.PolymorphicModel, receiver:
     if (field.type.kind === 'time') {
       imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
       text += `${indent.get()}${field.name} *datetime.${field.type.format} \`xml:"${sn}"\`\n`;
+    } else if (field.type.kind === 'duration') {
+      text += `${indent.get()}${field.name} *${helpers.getDurationTypeName(field.type)} \`xml:"${sn}"\`\n`;
     } else if (field.annotations.isAdditionalProperties || field.type.kind === 'map') {
       text += `${indent.get()}${field.name} additionalProperties \`xml:"${sn}"\`\n`;
     } else if (field.type.kind === 'slice') {
@@ -992,7 +1631,10 @@ function generateAliasType(modelType: go.Model | go.PolymorphicModel, receiver:
   if (forMarshal) {
     // emit code to initialize time fields
     for (const field of modelType.fields) {
-      if (field.type.kind !== 'time') {
+      if (field.type.kind === 'duration') {
+        text += `${indent.get()}${field.name}: (*${helpers.getDurationTypeName(field.type)})(${receiver}.${field.name}),\n`;
+        continue;
+      } else if (field.type.kind !== 'time') {
         continue;
       }
       imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
@@ -1015,21 +1657,39 @@ class SerDeInfo {
   methods: Array<ModelMethod>;
   needsJSONPopulate: boolean;
   needsJSONPopulateTime: boolean;
+  needsJSONPopulateDuration: boolean;
   needsJSONUnpopulate: boolean;
   needsJSONUnpopulateTime: boolean;
+  needsJSONUnpopulateDuration: boolean;
   needsJSONPopulateByteArray: boolean;
   needsJSONPopulateAny: boolean;
+  needsJSONPopulateDecimalString: boolean;
   needsJSONPopulateMultipart: boolean;
+  needsJSONWriter: boolean;
+  needsJSONReader: boolean;
+  needsJSONDecodeField: boolean;
+  needsJSONDecodeTimeField: boolean;
+  needsJSONDecodeDurationField: boolean;
+  needsMergePatch: boolean;
 
   constructor() {
     this.methods = new Array<ModelMethod>();
     this.needsJSONPopulate = false;
     this.needsJSONPopulateTime = false;
+    this.needsJSONPopulateDuration = false;
     this.needsJSONUnpopulate = false;
     this.needsJSONUnpopulateTime = false;
+    this.needsJSONUnpopulateDuration = false;
     this.needsJSONPopulateByteArray = false;
     this.needsJSONPopulateAny = false;
+    this.needsJSONPopulateDecimalString = false;
     this.needsJSONPopulateMultipart = false;
+    this.needsJSONWriter = false;
+    this.needsJSONReader = false;
+    this.needsJSONDecodeField = false;
+    this.needsJSONDecodeTimeField = false;
+    this.needsJSONDecodeDurationField = false;
+    this.needsMergePatch = false;
   }
 }
 
@@ -1095,6 +1755,14 @@ class ModelDef {
       first = false;
     }
 
+    if (this.Model.annotations.mergePatch) {
+      if (!first) {
+        text += '\n';
+      }
+      text += `${indent.get()}// the serialized names of the fields that are sent as null in a JSON merge patch\n`;
+      text += `${indent.get()}clearedFields map[string]bool\n`;
+    }
+
     text += '}\n\n';
     return text;
   }
@@ -8,6 +8,10 @@ import * as naming from '../../../naming.go/src/naming.js';
 import * as helpers from './helpers.js';
 import { ImportManager } from './imports.js';
 import { CodegenError } from './errors.js';
+import { emitNestedValidation, emitValueChecks, needsParamValidation } from './validation.js';
+import { getStreamDecoder } from './streams.js';
+import { newResponseError } from './serviceErrors.js';
+import { getResourceIDTrim, getSpanAttributes } from './telemetry.js';
 
 // represents the generated content for an operation group
 export class OperationGroupContent {
@@ -86,6 +90,9 @@ export function generateOperations(pkg: go.PackageContent, target: go.CodeModelT
 
     clientText += `type ${client.name} struct {\n`;
     clientText += `${indent.get()}internal *${azureARM ? 'arm' : 'azcore'}.Client\n`;
+    if (options.operationMetrics) {
+      clientText += `${indent.get()}meter OperationMeter\n`;
+    }
 
     // check for any optional host params
     const optionalParams = new Array<go.ClientParameter>();
@@ -121,10 +128,18 @@ export function generateOperations(pkg: go.PackageContent, target: go.CodeModelT
       }
     }
 
+    if (needsAPIVersionField(client)) {
+      clientText += `${indent.get()}apiVersion string\n`;
+    }
+
     // end of client definition
     clientText += '}\n\n';
 
-    clientText += generateConstructors(client, target, imports, indent);
+    clientText += generateConstructors(client, target, options, imports, indent);
+
+    if (options.generateInterfaces) {
+      clientText += generateClientInterface(client, options, imports, indent);
+    }
 
     // generate client accessors and operations
     let opText = '';
@@ -132,7 +147,7 @@ export function generateOperations(pkg: go.PackageContent, target: go.CodeModelT
       imports.addForType(clientAccessor.returns);
       const subClientDecl = go.getTypeDeclaration(clientAccessor.returns, pkg);
       opText += helpers.formatDocComment(clientAccessor.docs);
-      opText += `func (client *${client.name}) ${clientAccessor.name}(${getAPIParametersSig(clientAccessor, imports)}) *${subClientDecl} {\n`;
+      opText += `func (client *${client.name}) ${clientAccessor.name}(${getAPIParametersSig(clientAccessor, imports)}) ${getClientAccessorReturnType(clientAccessor, options)} {\n`;
       opText += `${indent.get()}return &${subClientDecl}{\n`;
       const initFields = new Array<string>('internal: client.internal');
       // propagate all client params
@@ -153,6 +168,14 @@ export function generateOperations(pkg: go.PackageContent, target: go.CodeModelT
         }
       }
 
+      if (needsAPIVersionField(clientAccessor.returns) && client.apiVersionHistory.length > 0) {
+        initFields.push('apiVersion: client.apiVersion');
+      }
+
+      if (options.operationMetrics) {
+        initFields.push('meter: client.meter');
+      }
+
       initFields.sort();
       indent.push();
       for (const initField of initFields) {
@@ -168,9 +191,12 @@ export function generateOperations(pkg: go.PackageContent, target: go.CodeModelT
       // it must be done before the imports are written out
       if (go.isLROMethod(method)) {
         // generate Begin method
-        opText += generateLROBeginMethod(method, options, imports, indent);
+        opText += generateLROBeginMethod(method, azureARM, options, imports, indent);
+      }
+      opText += generateOperation(method, azureARM, options, imports, indent);
+      if (method.kind === 'pageableMethod' && getPagerIteratorName(method, options)) {
+        opText += generatePagerIterator(method, options, imports, indent);
       }
-      opText += generateOperation(method, options, imports, indent);
       opText += createProtocolRequest(azureARM, method, imports, indent);
       if (method.kind !== 'lroMethod') {
         // LRO responses are handled elsewhere, with the exception of pageable LROs
@@ -201,10 +227,11 @@ export function generateOperations(pkg: go.PackageContent, target: go.CodeModelT
  * if there are no client constructors, the empty string is returned.
  *
  * @param client the client for which to generate constructors and the client options type
+ * @param options the emitter options
  * @param imports the import manager currently in scope
  * @returns the client constructor code or the empty string
  */
-function generateConstructors(client: go.Client, type: go.CodeModelType, imports: ImportManager, indent: helpers.Indentation): string {
+function generateConstructors(client: go.Client, type: go.CodeModelType, options: go.Options, imports: ImportManager, indent: helpers.Indentation): string {
   if (client.instance?.kind !== 'constructable') {
     return '';
   }
@@ -373,6 +400,33 @@ function generateConstructors(client: go.Client, type: go.CodeModelType, imports
               break;
           }
           break;
+        case 'keyCredential': {
+          if (clientOptions.kind !== 'clientOptions') {
+            throw new CodegenError('InternalError', `key credentials are not supported for ${clientOptions.kind}`);
+          }
+          imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+          imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/policy');
+          paramDocs.push(helpers.formatCommentAsBulletItem('credential', { summary: 'used to authorize requests with a key.' }));
+          indent.push(); // level 2 for PipelineOptions fields
+          indent.push(); // level 3 for KeyCredentialPolicyOptions fields
+          let keyPolicyOpts = `&runtime.KeyCredentialPolicyOptions{\n${indent.get()}InsecureAllowCredentialWithHTTP: options.InsecureAllowCredentialWithHTTP,\n`;
+          if (credentialParam.type.prefix) {
+            keyPolicyOpts += `${indent.get()}Prefix: "${credentialParam.type.prefix}",\n`;
+          }
+          keyPolicyOpts += `${indent.pop().get()}}`;
+          let keyPolicy: string;
+          switch (credentialParam.type.location) {
+            case 'header':
+              keyPolicy = `\n${indent.get()}PerRetry: []policy.Policy{\n${indent.get()}runtime.NewKeyCredentialPolicy(credential, "${credentialParam.type.name}", ${keyPolicyOpts}),\n${indent.get()}},\n`;
+              break;
+            case 'query':
+              keyPolicy = `\n${indent.get()}PerRetry: []policy.Policy{\n${indent.get()}newKeyCredentialQueryPolicy(credential, "${credentialParam.type.name}", ${keyPolicyOpts}),\n${indent.get()}},\n`;
+              break;
+          }
+          indent.pop(); // back to level 1
+          prolog = emitProlog(go.getTypeDeclaration(clientOptions, client.pkg), false, keyPolicy);
+          break;
+        }
       }
     } else {
       prolog = emitProlog(go.getTypeDeclaration(clientOptions, client.pkg), false);
@@ -411,6 +465,22 @@ function generateConstructors(client: go.Client, type: go.CodeModelType, imports
       }
     }
 
+    // the API version checks need the API version in use
+    let apiVersionVar: string | undefined;
+    if (needsAPIVersionField(client)) {
+      const defaultParam = clientOptions.kind === 'clientOptions' ? clientOptions.parameters.find((param) => go.isAPIVersionParameter(param) && go.isClientSideDefault(param.style)) : undefined;
+      if (defaultParam) {
+        apiVersionVar = defaultParam.name;
+      } else {
+        apiVersionVar = 'apiVersion';
+        const optionsCheck = clientOptions.kind === 'armClientOptions' ? 'options != nil && ' : '';
+        ctorText += `${indent.get()}${apiVersionVar} := "${client.apiVersionHistory[client.apiVersionHistory.length - 1]}"\n`;
+        ctorText += `${indent.get()}if ${optionsCheck}options.APIVersion != "" {\n`;
+        ctorText += `${indent.push().get()}${apiVersionVar} = options.APIVersion\n`;
+        ctorText += `${indent.pop().get()}}\n`;
+      }
+    }
+
     // construct the supplemental path and join it to the endpoint
     if (client.instance.endpoint?.supplemental) {
       // the endpoint param is always the first ctor param
@@ -428,6 +498,14 @@ function generateConstructors(client: go.Client, type: go.CodeModelType, imports
       }
     }
 
+    // the meter is passed to the constructor in the client options
+    if (options.operationMetrics && clientOptions.kind === 'armClientOptions') {
+      ctorText += `${indent.get()}var meter OperationMeter\n`;
+      ctorText += `${indent.get()}if options != nil {\n`;
+      ctorText += `${indent.push().get()}meter = getOperationMeter(options.PerCallPolicies)\n`;
+      ctorText += `${indent.pop().get()}}\n`;
+    }
+
     // construct client literal
     let clientVar = 'client';
     // ensure clientVar doesn't collide with any params
@@ -450,7 +528,13 @@ function generateConstructors(client: go.Client, type: go.CodeModelType, imports
       // each client field will have a matching parameter with the same name
       ctorText += `${indent.get()}${parameter.name}: ${parameter.name},\n`;
     }
+    if (apiVersionVar) {
+      ctorText += `${indent.get()}apiVersion: ${apiVersionVar},\n`;
+    }
     ctorText += `${indent.get()}internal: cl,\n`;
+    if (options.operationMetrics) {
+      ctorText += `${indent.get()}meter: ${clientOptions.kind === 'armClientOptions' ? 'meter' : 'getOperationMeter(options.PerCallPolicies)'},\n`;
+    }
     indent.pop();
     ctorText += `${indent.get()}}\n`;
     ctorText += `${indent.get()}return ${clientVar}, nil\n`;
@@ -463,7 +547,7 @@ function generateConstructors(client: go.Client, type: go.CodeModelType, imports
 // use this to generate the code that will help process values returned in response headers
 function formatHeaderResponseValue(
   method: go.SyncMethod | go.LROPageableMethod | go.PageableMethod,
-  headerResp: go.HeaderScalarResponse | go.HeaderMapResponse,
+  headerResp: go.HeaderResponse,
   respObj: string,
   zeroResp: string,
   imports: ImportManager,
@@ -483,6 +567,11 @@ function formatHeaderResponseValue(
     text += `${indent.pop().get()}}\n`;
     text += `${indent.pop().get()}}\n`;
     return text;
+  } else if (headerResp.kind === 'setCookieResponse') {
+    let text = `${indent.get()}if val := resp.Cookies(); len(val) > 0 {\n`;
+    text += `${indent.push().get()}${respObj}.${headerResp.fieldName} = val\n`;
+    text += `${indent.pop().get()}}\n`;
+    return text;
   }
 
   let text = `${indent.get()}if val := resp.Header.Get("${headerResp.headerName}"); val != "" {\n`;
@@ -491,11 +580,20 @@ function formatHeaderResponseValue(
   let byRef = '&';
   switch (headerResp.type.kind) {
     case 'constant':
+    case 'decimal':
     case 'etag':
+      imports.addForType(headerResp.type);
       text += `${indent.get()}${respObj}.${headerResp.fieldName} = (*${go.getTypeDeclaration(headerResp.type, method.receiver.type.pkg)})(&val)\n`;
       indent.pop();
       text += `${indent.get()}}\n`;
       return text;
+    case 'duration':
+      imports.add('time');
+      text += `${indent.get()}var ${name} ${helpers.getDurationTypeName(headerResp.type)}\n`;
+      text += `${indent.get()}err := ${name}.UnmarshalText([]byte(val))\n`;
+      name = `(*time.Duration)(&${name})`;
+      byRef = '';
+      break;
     case 'encodedBytes':
       // a base-64 encoded value in string format
       imports.add('encoding/base64');
@@ -638,7 +736,7 @@ function generateNilChecks(segments: Array<go.ModelField>, varName: string = 'pa
  * @param indent the indentation helper currently in scope
  * @returns the complete call to runtime.NewPager(...)
  */
-function emitPagerDefinition(method: go.LROPageableMethod | go.PageableMethod, options: go.Options, imports: ImportManager, indent: helpers.Indentation): string {
+function emitPagerDefinition(method: go.LROPageableMethod | go.PageableMethod, azureARM: boolean, options: go.Options, imports: ImportManager, indent: helpers.Indentation): string {
   imports.add('context');
   let text = `runtime.NewPager(runtime.PagingHandler[${method.returns.name}]{\n`;
   text += `${indent.push().get()}More: func(page ${method.returns.name}) bool {\n`;
@@ -678,10 +776,22 @@ function emitPagerDefinition(method: go.LROPageableMethod | go.PageableMethod, o
   }
   text += `${indent.pop().get()}},\n`; // end More func
 
-  text += `${indent.get()}Fetcher: func(ctx context.Context, page *${method.returns.name}) (${method.returns.name}, error) {\n`;
+  // the fetcher is wrapped to add span attributes and record metrics for each page
+  let fetcherWrappers = '';
+  let fetcherWrappersEnd = '';
+  if (options.spanAttributes) {
+    fetcherWrappers += `tracePages(client.internal.Tracer(), []tracing.Attribute{${getSpanAttributes(method, azureARM, imports, indent).join(', ')}}, `;
+    fetcherWrappersEnd += ')';
+  }
+  if (options.operationMetrics) {
+    fetcherWrappers += `meterPages(client.meter, "${method.receiver.type.name}.${fixUpMethodName(method)}", `;
+    fetcherWrappersEnd += ')';
+  }
+  text += `${indent.get()}Fetcher: ${fetcherWrappers}func(ctx context.Context, page *${method.returns.name}) (${method.returns.name}, error) {\n`;
   indent.push();
   const reqParams = helpers.getCreateRequestParameters(method);
-  if (options.generateFakes) {
+  // the API name is used by fakes and the recording transport
+  if (options.generateFakes || options.generateRecording) {
     text += `${indent.get()}ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, "${method.receiver.type.name}.${fixUpMethodName(method)}")\n`;
   }
 
@@ -689,7 +799,7 @@ function emitPagerDefinition(method: go.LROPageableMethod | go.PageableMethod, o
   const emitStatusCodeCheckAndResponse = function (respVarName: string, indent: helpers.Indentation): string {
     let content = `${indent.get()}${helpers.buildIfBlock(indent, {
       condition: `!runtime.HasStatusCode(${respVarName}, http.StatusOK)`,
-      body: (indent) => `${indent.get()}return ${getZeroReturnValue(method, 'op')}, runtime.NewResponseError(${respVarName})\n`,
+      body: (indent) => `${indent.get()}return ${getZeroReturnValue(method, 'op')}, ${newResponseError(method, respVarName)}\n`,
     })}\n`;
     content += `${indent.get()}return client.${method.naming.responseMethod}(${respVarName})\n`;
     return content;
@@ -750,11 +860,10 @@ function emitPagerDefinition(method: go.LROPageableMethod | go.PageableMethod, o
         // nextPageMethod might be absent in some cases, see https://github.com/Azure/autorest/issues/4393
         if (method.strategy.method) {
           const nextOpParams = helpers.getCreateRequestParametersSig(method.strategy.method).split(',');
-          // keep the parameter names from the name/type tuples and find nextLink param
+          // keep the parameter names from the name/type tuples and pass the next link to the nextLink param
           for (let i = 0; i < nextOpParams.length; ++i) {
             const paramName = nextOpParams[i].trim().split(' ')[0];
-            const paramType = nextOpParams[i].trim().split(' ')[1];
-            if (paramName.startsWith('next') && paramType === 'string') {
+            if (paramName === method.strategy.method.nextLinkParam?.name) {
               nextOpParams[i] = 'encodedNextLink';
             } else {
               nextOpParams[i] = paramName;
@@ -767,6 +876,15 @@ function emitPagerDefinition(method: go.LROPageableMethod | go.PageableMethod, o
           text += `${indent.push().get()}return client.${method.strategy.method.name}(${nextOpParams.join(', ')})\n`;
           text += `${indent.pop().get()}},\n`;
           text += `${indent.pop().get()}})\n`;
+        } else if (method.strategy.reinjectedParams.length > 0) {
+          // add a definition for the nextReq func that re-applies the params to the next link
+          indent.push();
+          text += `&runtime.FetcherForNextLinkOptions{\n`;
+          text += `${indent.get()}NextReq: func(ctx context.Context, encodedNextLink string) (*policy.Request, error) {\n`;
+          indent.push();
+          text += emitNextLinkRequestWithReinjectedParams(method, method.strategy.reinjectedParams, imports, indent);
+          text += `${indent.pop().get()}},\n`;
+          text += `${indent.pop().get()}})\n`;
         } else if (method.nextLinkVerb !== 'get') {
           text += `&runtime.FetcherForNextLinkOptions{\n`;
           text += `${indent.push().get()}HTTPVerb: http.Method${naming.capitalize(method.nextLinkVerb)},\n`;
@@ -786,7 +904,7 @@ function emitPagerDefinition(method: go.LROPageableMethod | go.PageableMethod, o
     text += callPipelineDoWithErrCheck(method, 'req', 'resp', indent);
     text += emitStatusCodeCheckAndResponse('resp', indent);
   }
-  text += `${indent.pop().get()}},\n`; // end Fetcher func
+  text += `${indent.pop().get()}}${fetcherWrappersEnd},\n`; // end Fetcher func
   if (options.injectSpans) {
     text += `${indent.get()}Tracer: client.internal.Tracer(),\n`;
   }
@@ -794,6 +912,73 @@ function emitPagerDefinition(method: go.LROPageableMethod | go.PageableMethod, o
   return text;
 }
 
+/**
+ * emits the body of the NextReq func for pageable methods that re-apply params
+ * from the initial request to the next link URL.
+ *
+ * @param method the pageable method for which to emit the body
+ * @param params the params to re-apply to the next link URL
+ * @param imports the import manager currently in scope
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the NextReq func body
+ */
+function emitNextLinkRequestWithReinjectedParams(
+  method: go.LROPageableMethod | go.PageableMethod,
+  params: Array<go.HeaderScalarParameter | go.QueryScalarParameter>,
+  imports: ImportManager,
+  indent: helpers.Indentation,
+): string {
+  let text = `${indent.get()}req, err := runtime.NewRequest(ctx, http.Method${naming.capitalize(method.nextLinkVerb)}, encodedNextLink)\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return nil, err\n`;
+  text += `${indent.pop().get()}}\n`;
+
+  const emitParam = function (param: go.HeaderScalarParameter | go.QueryScalarParameter, setterFormat: (name: string, val: string) => string): string {
+    if (param.location === 'method' && go.isClientSideDefault(param.style)) {
+      return emitClientSideDefault(param, param.style, (name, val) => `${indent.get()}${setterFormat(name, val)}`, imports, indent);
+    }
+
+    const serializedName = param.kind === 'headerScalarParam' ? param.headerName : param.queryParameter;
+    const setter = setterFormat(`"${serializedName}"`, helpers.formatParamValue(param, imports, indent));
+    if (go.isRequiredParameter(param.style) || go.isLiteralParameter(param.style) || (param.location === 'client' && go.isClientSideDefault(param.style))) {
+      return `${indent.get()}${setter}\n`;
+    } else if (param.location === 'client' && !param.group) {
+      // global optional param
+      let paramText = `${indent.get()}if client.${param.name} != nil {\n`;
+      paramText += `${indent.push().get()}${setter}\n`;
+      paramText += `${indent.pop().get()}}\n`;
+      return paramText;
+    }
+    let paramText = emitParamGroupCheck(param, indent);
+    paramText += `${indent.push().get()}${setter}\n`;
+    paramText += `${indent.pop().get()}}\n`;
+    return paramText;
+  };
+
+  const queryParams = params
+    .filter((param): param is go.QueryScalarParameter => param.kind === 'queryScalarParam')
+    .sort((a: go.QueryScalarParameter, b: go.QueryScalarParameter) => helpers.sortAscending(a.queryParameter, b.queryParameter));
+  if (queryParams.length > 0) {
+    // the params replace any values with the same name in the next link
+    text += `${indent.get()}reqQP := req.Raw().URL.Query()\n`;
+    for (const queryParam of queryParams) {
+      text += emitParam(queryParam, (name, val) => `reqQP.Set(${name}, ${val})`);
+    }
+    imports.add('strings');
+    text += `${indent.get()}req.Raw().URL.RawQuery = strings.ReplaceAll(reqQP.Encode(), "+", "%20")\n`;
+  }
+
+  const headerParams = params
+    .filter((param): param is go.HeaderScalarParameter => param.kind === 'headerScalarParam')
+    .sort((a: go.HeaderScalarParameter, b: go.HeaderScalarParameter) => helpers.sortAscending(a.headerName, b.headerName));
+  for (const headerParam of headerParams) {
+    text += emitParam(headerParam, (name, val) => `req.Raw().Header[${name}] = []string{${val}}`);
+  }
+
+  text += `${indent.get()}return req, nil\n`;
+  return text;
+}
+
 /**
  * emits the call to the *CreateRequest method for an SDK method.
  * if the call returns an error, the error is propagated to the caller.
@@ -829,15 +1014,94 @@ function callPipelineDoWithErrCheck(method: go.MethodType, reqVarName: string, r
   return text;
 }
 
-function genRespErrorDoc(method: go.MethodType): string {
+function genRespErrorDoc(method: go.MethodType, forPoller = false): string {
   if (!(method.returns.result?.kind === 'headAsBooleanResult') && !go.isPageableMethod(method)) {
     // when head-as-boolean is enabled, no error is returned for 4xx status codes.
     // pager constructors don't return an error
+    if (method.errorType && forPoller) {
+      // pollers create their errors with runtime.NewResponseError so only the initial request returns the typed error
+      let text = `// If the initial request fails it returns an *${method.errorType.name} type, which wraps an *azcore.ResponseError.\n`;
+      text += '// Errors returned by the poller are of type *azcore.ResponseError.\n';
+      return text;
+    } else if (method.errorType) {
+      return `// If the operation fails it returns an *${method.errorType.name} type, which wraps an *azcore.ResponseError.\n`;
+    }
     return '// If the operation fails it returns an *azcore.ResponseError type.\n';
   }
   return '';
 }
 
+/**
+ * generates the interface that contains the exported methods of a client
+ * along with a compile-time assertion that the client implements it.
+ *
+ * @param client the client for which to generate the interface
+ * @param options the emitter options
+ * @param imports the import manager currently in scope
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the interface
+ */
+function generateClientInterface(client: go.Client, options: go.Options, imports: ImportManager, indent: helpers.Indentation): string {
+  const ifaceName = helpers.getClientInterfaceName(client);
+  let text = `// ${ifaceName} contains the exported methods of [${client.name}].\n`;
+  text += '// Use it in place of the client to substitute a mock in tests.\n';
+  text += `type ${ifaceName} interface {\n`;
+
+  const methodSigs = new Array<string>();
+  for (const clientAccessor of client.clientAccessors) {
+    methodSigs.push(`${clientAccessor.name}(${getAPIParametersSig(clientAccessor, imports)}) ${getClientAccessorReturnType(clientAccessor, options)}`);
+  }
+
+  for (const method of client.methods) {
+    if (helpers.isMethodInternal(method)) {
+      continue;
+    }
+    let methodSig = helpers.formatDocCommentWithPrefix(fixUpMethodName(method), { summary: method.docs.summary });
+    methodSig += `${fixUpMethodName(method)}(${getAPIParametersSig(method, imports)}) `;
+    const returns = generateReturnsInfo(method, 'api');
+    if (returns.length > 1) {
+      methodSig += `(${returns.join(', ')})`;
+    } else {
+      methodSig += returns[0];
+    }
+    methodSigs.push(methodSig);
+
+    const iteratorName = method.kind === 'pageableMethod' ? getPagerIteratorName(method, options) : undefined;
+    if (iteratorName) {
+      methodSigs.push(`// ${iteratorName} returns an iterator over the items in all pages returned by ${fixUpMethodName(method)}.\n${iteratorName}${getPagerIteratorSig(<go.PageableMethod>method, imports)}`);
+    }
+  }
+
+  for (const methodSig of methodSigs) {
+    text += methodSig
+      .trimEnd()
+      .split('\n')
+      .map((line) => `${indent.get()}${line}\n`)
+      .join('');
+  }
+  text += '}\n\n';
+  text += `// ensure ${client.name} implements ${ifaceName}\n`;
+  text += `var _ ${ifaceName} = (*${client.name})(nil)\n\n`;
+  return text;
+}
+
+/**
+ * returns the type returned by a client accessor.
+ * when interfaces are generated, accessors return the interface for the client.
+ *
+ * @param clientAccessor the client accessor
+ * @param options the emitter options
+ * @returns the return type for the client accessor
+ */
+function getClientAccessorReturnType(clientAccessor: go.ClientAccessor, options: go.Options): string {
+  const subClientDecl = go.getTypeDeclaration(clientAccessor.returns, clientAccessor.receiver.type.pkg);
+  if (options.generateInterfaces) {
+    // replace the client name with the interface name, preserving any package qualifier
+    return `${subClientDecl.substring(0, subClientDecl.length - clientAccessor.returns.name.length)}${helpers.getClientInterfaceName(clientAccessor.returns)}`;
+  }
+  return `*${subClientDecl}`;
+}
+
 /**
  * returns the receiver definition for a client
  *
@@ -848,7 +1112,7 @@ function getClientReceiverDefinition(receiver: go.Receiver<go.Client>): string {
   return `(${receiver.name} ${receiver.byValue ? '' : '*'}${receiver.type.name})`;
 }
 
-function generateOperation(method: go.MethodType, options: go.Options, imports: ImportManager, indent: helpers.Indentation): string {
+function generateOperation(method: go.MethodType, azureARM: boolean, options: go.Options, imports: ImportManager, indent: helpers.Indentation): string {
   const params = getAPIParametersSig(method, imports);
   const returns = generateReturnsInfo(method, 'op');
   let methodName = method.name;
@@ -876,29 +1140,49 @@ function generateOperation(method: go.MethodType, options: go.Options, imports:
   text += `func ${getClientReceiverDefinition(method.receiver)} ${methodName}(${params}) (${returns.join(', ')}) {\n`;
   if (method.kind === 'pageableMethod') {
     text += `${indent.get()}return `;
-    text += emitPagerDefinition(method, options, imports, indent);
+    text += emitPagerDefinition(method, azureARM, options, imports, indent);
     text += '}\n\n';
     return text;
   }
   text += `${indent.get()}var err error\n`;
   let operationName = `"${method.receiver.type.name}.${fixUpMethodName(method)}"`;
-  if (options.generateFakes && options.injectSpans) {
+  const operationNameUses = [options.generateFakes || options.generateRecording, options.injectSpans, options.operationMetrics].filter((used) => used).length;
+  if (operationNameUses > 1) {
     text += `${indent.get()}const operationName = ${operationName}\n`;
     operationName = 'operationName';
   }
-  if (options.generateFakes) {
+  if (options.generateFakes || options.generateRecording) {
     text += `${indent.get()}ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, ${operationName})\n`;
   }
   if (options.injectSpans) {
-    text += `${indent.get()}ctx, endSpan := runtime.StartSpan(ctx, ${operationName}, client.internal.Tracer(), nil)\n`;
+    let spanOptions = 'nil';
+    if (options.spanAttributes) {
+      spanOptions = '&runtime.StartSpanOptions{\n';
+      spanOptions += `${indent.push().get()}Attributes: []tracing.Attribute{\n`;
+      indent.push();
+      for (const attr of getSpanAttributes(method, azureARM, imports, indent)) {
+        spanOptions += `${indent.get()}${attr},\n`;
+      }
+      spanOptions += `${indent.pop().get()}},\n`;
+      spanOptions += `${indent.pop().get()}}`;
+    }
+    text += `${indent.get()}ctx, endSpan := runtime.StartSpan(ctx, ${operationName}, client.internal.Tracer(), ${spanOptions})\n`;
     text += `${indent.get()}defer func() { endSpan(err) }()\n`;
   }
+  if (options.operationMetrics) {
+    imports.add('time');
+    text += `${indent.get()}defer recordOperation(ctx, client.meter, ${operationName}, time.Now(), &err)\n`;
+  }
   const zeroResp = getZeroReturnValue(method, 'op');
   text += callCreateRequestWithErrCheck(method, 'req', indent);
+  const resourceIDTrim = getResourceIDTrim(method);
+  if (options.spanAttributes && azureARM && resourceIDTrim !== undefined) {
+    text += `${indent.get()}client.internal.Tracer().SpanFromContext(ctx).SetAttributes(tracing.Attribute{Key: "az.resource.id", Value: armResourceID(req, ${resourceIDTrim})})\n`;
+  }
   text += callPipelineDoWithErrCheck(method, 'req', 'httpResp', indent);
   text += `${indent.get()}if !runtime.HasStatusCode(httpResp, ${helpers.formatStatusCodes(method.httpStatusCodes)}) {\n`;
   indent.push();
-  text += `${indent.get()}err = runtime.NewResponseError(httpResp)\n`;
+  text += `${indent.get()}err = ${newResponseError(method, 'httpResp')}\n`;
   text += `${indent.get()}return ${zeroResp}, err\n`;
   text += `${indent.pop().get()}}\n`;
   // HAB with headers response is handled in protocol responder
@@ -913,6 +1197,8 @@ function generateOperation(method: go.MethodType, options: go.Options, imports:
       text += `${indent.get()}return resp, err\n`;
     } else if (method.returns.result?.kind === 'binaryResult') {
       text += `${indent.get()}return ${method.returns.name}{${method.returns.result.fieldName}: httpResp.Body}, nil\n`;
+    } else if (method.returns.result?.kind === 'streamResult') {
+      text += `${indent.get()}return ${method.returns.name}{${method.returns.result.fieldName}: newEventStream(httpResp.Body, ${getStreamDecoder(method.returns.result)})}, nil\n`;
     } else {
       text += `${indent.get()}return ${method.returns.name}{}, nil\n`;
     }
@@ -921,6 +1207,96 @@ function generateOperation(method: go.MethodType, options: go.Options, imports:
   return text;
 }
 
+/**
+ * generates the All* method that iterates over the items in all pages of a pageable method
+ *
+ * @param method the pageable method for which to generate the iterator
+ * @param options the emitter options
+ * @param imports the import manager currently in scope
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the iterator method
+ */
+function generatePagerIterator(method: go.PageableMethod, options: go.Options, imports: ImportManager, indent: helpers.Indentation): string {
+  const itemsPath = method.itemsPath!;
+  const itemsType = getPagerItemsType(method);
+  let itemType = go.getTypeDeclaration(itemsType.elementType, method.receiver.type.pkg);
+  if (!itemsType.elementTypeByValue) {
+    itemType = `*${itemType}`;
+  }
+
+  const methodName = getPagerIteratorName(method, options)!;
+  let text = `// ${methodName} returns an iterator over the items in all pages returned by ${fixUpMethodName(method)}.\n`;
+  text += '// Iteration stops after the first error, which is yielded with the zero value for the item.\n';
+  for (const param of helpers.getMethodParameters(method)) {
+    text += helpers.formatCommentAsBulletItem(param.name, param.docs);
+  }
+  text += `func ${getClientReceiverDefinition(method.receiver)} ${methodName}${getPagerIteratorSig(method, imports)} {\n`;
+  text += `${indent.get()}return func(yield func(${itemType}, error) bool) {\n`;
+  indent.push();
+  const pagerArgs = helpers.getMethodParameters(method).map((param) => param.name);
+  text += `${indent.get()}pager := client.${fixUpMethodName(method)}(${pagerArgs.join(', ')})\n`;
+  text += `${indent.get()}for pager.More() {\n`;
+  text += `${indent.push().get()}page, err := pager.NextPage(ctx)\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  indent.push();
+  if (itemsType.elementTypeByValue) {
+    text += `${indent.get()}var zero ${itemType}\n`;
+    text += `${indent.get()}yield(zero, err)\n`;
+  } else {
+    text += `${indent.get()}yield(nil, err)\n`;
+  }
+  text += `${indent.get()}return\n`;
+  text += `${indent.pop().get()}}\n`;
+  if (itemsPath.length > 1) {
+    // ranging over a nil slice is fine but the fields that contain it must not be nil
+    text += `${indent.get()}if !(${generateNilChecks(itemsPath, 'page', true)}) {\n`;
+    text += `${indent.push().get()}continue\n`;
+    text += `${indent.pop().get()}}\n`;
+  }
+  text += `${indent.get()}for _, item := range page.${itemsPath.map((segment) => segment.name).join('.')} {\n`;
+  text += `${indent.push().get()}if !yield(item, nil) {\n`;
+  text += `${indent.push().get()}return\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.pop().get()}}\n`; // end range items
+  text += `${indent.pop().get()}}\n`; // end for pager.More()
+  text += `${indent.pop().get()}}\n`; // end func
+  text += '}\n\n';
+  return text;
+}
+
+/**
+ * returns the slice type that contains the items of a pageable method
+ *
+ * @param method the pageable method with an items path
+ * @returns the slice type of the items field
+ */
+function getPagerItemsType(method: go.PageableMethod): go.Slice {
+  const itemsField = method.itemsPath![method.itemsPath!.length - 1];
+  if (itemsField.type.kind !== 'slice') {
+    throw new CodegenError('InternalError', `unexpected items field type ${itemsField.type.kind} for method ${method.name}`);
+  }
+  return itemsField.type;
+}
+
+/**
+ * returns the parameters and return type of the All* method for a pageable method
+ *
+ * @param method the pageable method for which to get the iterator signature
+ * @param imports the import manager currently in scope
+ * @returns the iterator signature without the method name
+ */
+function getPagerIteratorSig(method: go.PageableMethod, imports: ImportManager): string {
+  imports.add('context');
+  imports.add('iter');
+  const itemsType = getPagerItemsType(method);
+  let itemType = go.getTypeDeclaration(itemsType.elementType, method.receiver.type.pkg);
+  if (!itemsType.elementTypeByValue) {
+    itemType = `*${itemType}`;
+  }
+  const params = getAPIParametersSig(method, imports);
+  return `(ctx context.Context${params.length > 0 ? ', ' + params : ''}) iter.Seq2[${itemType}, error]`;
+}
+
 /**
  * emits Go code to set ContentType on a MultipartContent variable if it has a fixed content type.
  * handles both direct MultipartContent and slices of MultipartContent.
@@ -952,8 +1328,12 @@ function emitMultipartContentTypeSetter(paramName: string, wireType: go.WireType
 
 function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.NextPageMethod, imports: ImportManager, indent: helpers.Indentation): string {
   let name = method.name;
+  let operationName = method.name;
   if (method.kind !== 'nextPageMethod') {
     name = method.naming.requestMethod;
+  } else {
+    // next page methods are named after their operation (e.g. listNextPageCreateRequest for List)
+    operationName = naming.capitalize(method.name.replace(/(NextPage)?CreateRequest$/, ''));
   }
 
   for (const param of method.parameters) {
@@ -964,8 +1344,11 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
   }
 
   const returns = ['*policy.Request', 'error'];
-  let text = `${helpers.comment(name, '// ')} creates the ${method.name} request.\n`;
+  let text = `${helpers.comment(name, '// ')} creates the ${operationName} request.\n`;
   text += `func ${getClientReceiverDefinition(method.receiver)} ${name}(${helpers.getCreateRequestParametersSig(method)}) (${returns.join(', ')}) {\n`;
+  text += emitParamValidation(method, imports, indent);
+  text += emitAPIVersionChecks(method, indent);
+  text += emitUnsupportedParamChecks(method, imports, indent);
 
   const hostParams = new Array<go.URIParameter>();
   for (const parameter of method.receiver.type.parameters) {
@@ -1015,23 +1398,6 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
     hostParam = `runtime.JoinPaths(${hostParam}, urlPath)`;
   }
 
-  // helper to build nil checks for param groups
-  const emitParamGroupCheck = function (param: go.MethodParameter): string {
-    if (!param.group) {
-      throw new CodegenError('InternalError', `emitParamGroupCheck called for ungrouped parameter ${param.name}`);
-    }
-    let client = '';
-    if (param.location === 'client') {
-      client = 'client.';
-    }
-    const paramGroupName = naming.uncapitalize(param.group.name);
-    let optionalParamGroupCheck = `${client}${paramGroupName} != nil && `;
-    if (param.group.required) {
-      optionalParamGroupCheck = '';
-    }
-    return `${indent.get()}if ${optionalParamGroupCheck}${client}${paramGroupName}.${naming.capitalize(param.name)} != nil {\n`;
-  };
-
   if (hasPathParams) {
     // swagger defines path params, emit path and replace tokens
     imports.add('strings');
@@ -1079,7 +1445,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
       } else if (go.isClientSideDefault(pp.style)) {
         const defaultValue = naming.uncapitalize(pp.name) + 'Default';
         text += `${indent.get()}${defaultValue} := ${helpers.formatLiteralValue(pp.style.defaultValue, true)}\n`;
-        text += emitParamGroupCheck(pp);
+        text += emitParamGroupCheck(pp, indent);
         text += `${indent.push().get()}${defaultValue} = ${helpers.getParamName(pp)}\n`;
         text += `${indent.pop().get()}}\n`;
         paramValue = helpers.formatValue(defaultValue, pp.type, imports);
@@ -1089,7 +1455,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
         // the optional value when set.
         paramValue = `optional${naming.capitalize(pp.name)}`;
         text += `${indent.get()}${paramValue} := ""\n`;
-        text += emitParamGroupCheck(pp);
+        text += emitParamGroupCheck(pp, indent);
         text += `${indent.push().get()}${paramValue} = ${helpers.formatParamValue(pp, imports, indent)}\n`;
         text += `${indent.pop().get()}}\n`;
 
@@ -1151,7 +1517,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
       qpText += `${indent.push().get()}${setter}\n`;
       qpText += `${indent.pop().get()}}\n`;
     } else {
-      qpText = emitParamGroupCheck(qp);
+      qpText = emitParamGroupCheck(qp, indent);
       qpText += `${indent.push().get()}${setter}\n`;
       qpText += `${indent.pop().get()}}\n`;
     }
@@ -1231,8 +1597,8 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
     text += `${indent.get()}req.Raw().URL.RawQuery = strings.Join(unencodedParams, "&")\n`;
   }
 
-  if (method.kind !== 'nextPageMethod' && method.returns.result?.kind === 'binaryResult') {
-    // skip auto-body downloading for binary stream responses
+  if (method.kind !== 'nextPageMethod' && (method.returns.result?.kind === 'binaryResult' || method.returns.result?.kind === 'streamResult')) {
+    // skip auto-body downloading for binary and event stream responses
     text += `${indent.get()}runtime.SkipBodyDownload(req)\n`;
   }
 
@@ -1300,7 +1666,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
       indent.pop();
       text += `${indent.get()}}\n`;
     } else {
-      text += emitParamGroupCheck(param);
+      text += emitParamGroupCheck(param, indent);
       indent.push();
       text += emitHeaderSet(param);
       indent.pop();
@@ -1308,6 +1674,45 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
     }
   }
 
+  // add any cookies. http.Request.AddCookie drops bytes that aren't valid
+  // in a cookie value, so the values are percent-encoded to preserve them.
+  const emitCookieSet = function (cookieParam: go.CookieParameter): string {
+    imports.add('net/url');
+    if (cookieParam.location === 'method' && go.isClientSideDefault(cookieParam.style)) {
+      return emitClientSideDefault(
+        cookieParam,
+        cookieParam.style,
+        (name, val) => {
+          return `${indent.get()}req.Raw().AddCookie(&http.Cookie{Name: ${name}, Value: url.PathEscape(${val})})`;
+        },
+        imports,
+        indent,
+      );
+    }
+    return `${indent.get()}req.Raw().AddCookie(&http.Cookie{Name: "${cookieParam.cookieName}", Value: url.PathEscape(${helpers.formatParamValue(cookieParam, imports, indent)})})\n`;
+  };
+
+  for (const param of methodParamGroups.cookieParams.sort((a: go.CookieParameter, b: go.CookieParameter) => {
+    return helpers.sortAscending(a.cookieName, b.cookieName);
+  })) {
+    if (go.isRequiredParameter(param.style) || go.isLiteralParameter(param.style) || go.isClientSideDefault(param.style)) {
+      text += emitCookieSet(param);
+    } else if (param.location === 'client' && !param.group) {
+      // global optional param
+      text += `${indent.get()}if client.${param.name} != nil {\n`;
+      indent.push();
+      text += emitCookieSet(param);
+      indent.pop();
+      text += `${indent.get()}}\n`;
+    } else {
+      text += emitParamGroupCheck(param, indent);
+      indent.push();
+      text += emitCookieSet(param);
+      indent.pop();
+      text += `${indent.get()}}\n`;
+    }
+  }
+
   // note that these are mutually exclusive
   const bodyParam = methodParamGroups.bodyParam;
   const formBodyParams = methodParamGroups.formBodyParams;
@@ -1385,6 +1790,9 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
         // no need for RFC3339 as the JSON marshaler defaults to that.
         imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
         body = `datetime.${bodyParam.type.format}(${body})`;
+      } else if (bodyParam.type.kind === 'duration') {
+        // wrap the body in the internal duration type
+        body = `${helpers.getDurationTypeName(bodyParam.type)}(${body})`;
       } else if (isArrayOfDateTimeForMarshalling(bodyParam.type)) {
         const timeInfo = isArrayOfDateTimeForMarshalling(bodyParam.type);
         let elementPtr = '*';
@@ -1416,7 +1824,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
         text += emitSetBodyWithErrCheck(setBody, contentType);
         text += `${indent.get()}return req, nil\n`;
       } else {
-        text += emitParamGroupCheck(bodyParam);
+        text += emitParamGroupCheck(bodyParam, indent);
         indent.push();
         text += emitSetBodyWithErrCheck(setBody, contentType);
         text += `${indent.get()}return req, nil\n`;
@@ -1429,7 +1837,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
         text += emitSetBodyWithErrCheck(`req.SetBody(${bodyParam.name}, ${getContentTypeValue(bodyParam.contentType)})`, contentType);
         text += `${indent.get()}return req, nil\n`;
       } else {
-        text += emitParamGroupCheck(bodyParam);
+        text += emitParamGroupCheck(bodyParam, indent);
         indent.push();
         text += emitSetBodyWithErrCheck(`req.SetBody(${helpers.getParamName(bodyParam)}, ${getContentTypeValue(bodyParam.contentType)})`, contentType);
         text += `${indent.get()}return req, nil\n`;
@@ -1445,7 +1853,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
         text += emitSetBodyWithErrCheck(`req.SetBody(body, ${getContentTypeValue(bodyParam.contentType)})`, contentType);
         text += `${indent.get()}return req, nil\n`;
       } else {
-        text += emitParamGroupCheck(bodyParam);
+        text += emitParamGroupCheck(bodyParam, indent);
         indent.push();
         text += `${indent.get()}body := streaming.NopCloser(strings.NewReader(${helpers.getParamName(bodyParam)}))\n`;
         text += emitSetBodyWithErrCheck(`req.SetBody(body, ${getContentTypeValue(bodyParam.contentType)})`, contentType);
@@ -1477,7 +1885,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
     // now populate any optional params from the options type
     for (const partialBodyParam of partialBodyParams) {
       if (!go.isRequiredParameter(partialBodyParam.style)) {
-        text += emitParamGroupCheck(partialBodyParam);
+        text += emitParamGroupCheck(partialBodyParam, indent);
         text += `${indent.push().get()}body.${naming.capitalize(partialBodyParam.serializedName)} = options.${naming.capitalize(partialBodyParam.name)}\n`;
         text += `${indent.pop().get()}}\n`;
       }
@@ -1513,7 +1921,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
         if (go.isRequiredParameter(param.style)) {
           text += `${indent.get()}${setter}\n`;
         } else {
-          text += emitParamGroupCheck(param);
+          text += emitParamGroupCheck(param, indent);
           text += `${indent.push().get()}${setter}\n`;
           text += `${indent.pop().get()}}\n`;
         }
@@ -1529,7 +1937,7 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
       if (go.isRequiredParameter(param.style)) {
         formDataText = `${indent.get()}${setter}\n`;
       } else {
-        formDataText = emitParamGroupCheck(param);
+        formDataText = emitParamGroupCheck(param, indent);
         formDataText += `${indent.push().get()}${setter}\n`;
         formDataText += `${indent.pop().get()}}\n`;
       }
@@ -1554,6 +1962,157 @@ function createProtocolRequest(azureARM: boolean, method: go.MethodType | go.Nex
   return text;
 }
 
+/**
+ * emits the opening of an if block that checks if an optional, grouped param has a value.
+ *
+ * @param param the grouped param to check
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the opening of the if block
+ */
+function emitParamGroupCheck(param: go.MethodParameter, indent: helpers.Indentation): string {
+  if (!param.group) {
+    throw new CodegenError('InternalError', `emitParamGroupCheck called for ungrouped parameter ${param.name}`);
+  }
+  let client = '';
+  if (param.location === 'client') {
+    client = 'client.';
+  }
+  const paramGroupName = naming.uncapitalize(param.group.name);
+  let optionalParamGroupCheck = `${client}${paramGroupName} != nil && `;
+  if (param.group.required) {
+    optionalParamGroupCheck = '';
+  }
+  return `${indent.get()}if ${optionalParamGroupCheck}${client}${paramGroupName}.${naming.capitalize(param.name)} != nil {\n`;
+}
+
+/**
+ * emits the pre-flight validation of the method's parameters.
+ * the violations are returned as a *ValidationError before the request is created.
+ *
+ * @param method the method for which to emit the validation
+ * @param imports the import manager currently in scope
+ * @param indent the current indentation
+ * @returns the text for the validation or the empty string
+ */
+function emitParamValidation(method: go.MethodType | go.NextPageMethod, imports: ImportManager, indent: helpers.Indentation): string {
+  if (!needsParamValidation(method)) {
+    return '';
+  }
+  let text = `${indent.get()}cv := &constraintValidator{}\n`;
+  for (const param of method.parameters) {
+    if (param.style === 'literal' || param.style === 'flag') {
+      continue;
+    }
+    // the value name is also used as the path in any violations
+    const valueName = helpers.getParamName(param).replace(/^\*/, '');
+    const isGrouped = param.group !== undefined && !go.isRequiredParameter(param.style);
+    if (isGrouped) {
+      indent.push();
+    }
+    let checks = '';
+    if (param.constraints) {
+      checks += emitValueChecks(`"${valueName}"`, helpers.getParamName(param), param.type, param.constraints, indent);
+    }
+    if (param.kind === 'bodyParam') {
+      // a grouped body has already been checked for nil
+      checks += emitNestedValidation(valueName, `"${valueName}"`, param.type, false, imports, indent);
+    }
+    if (isGrouped) {
+      indent.pop();
+      if (checks.length > 0) {
+        checks = `${emitParamGroupCheck(param, indent)}${checks}${indent.get()}}\n`;
+      }
+    }
+    text += checks;
+  }
+  text += `${indent.get()}if err := cv.err(); err != nil {\n`;
+  text += `${indent.push().get()}return nil, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  return text;
+}
+
+/**
+ * returns true if the client needs an apiVersion field for API version checks.
+ * this is the case when the API version isn't already stored in a client field.
+ *
+ * @param client the client to inspect
+ * @returns true if the client needs the field
+ */
+function needsAPIVersionField(client: go.Client): boolean {
+  return client.apiVersionHistory.length > 0 && !client.parameters.some((param) => param.name === 'apiVersion' && !param.group && !go.isLiteralParameter(param.style));
+}
+
+/**
+ * emits the checks that reject params the method doesn't support
+ * when they're set in a param group that's shared with other methods.
+ *
+ * @param method the method for which to emit the checks
+ * @param imports the import manager currently in scope
+ * @param indent the current indentation
+ * @returns the text for the checks or the empty string
+ */
+function emitUnsupportedParamChecks(method: go.MethodType | go.NextPageMethod, imports: ImportManager, indent: helpers.Indentation): string {
+  if (method.kind === 'nextPageMethod') {
+    return '';
+  }
+
+  let text = '';
+  for (const methodParam of helpers.getMethodParameters(method)) {
+    if (methodParam.kind !== 'paramGroup' || !methodParam.rejectUnsupportedParams) {
+      continue;
+    }
+    const paramGroupName = naming.uncapitalize(methodParam.name);
+    for (const groupParam of methodParam.params.toSorted((a, b) => helpers.sortAscending(a.name, b.name))) {
+      if (method.parameters.some((param) => param.group === methodParam && param.name === groupParam.name)) {
+        continue;
+      }
+      const fieldName = `${paramGroupName}.${naming.capitalize(groupParam.name)}`;
+      imports.add('errors');
+      text += `${indent.get()}if ${paramGroupName} != nil && ${fieldName} != nil {\n`;
+      text += `${indent.push().get()}return nil, errors.New("parameter ${fieldName} is not supported by operation ${method.receiver.type.name}.${fixUpMethodName(method)}")\n`;
+      text += `${indent.pop().get()}}\n`;
+    }
+  }
+  return text;
+}
+
+/**
+ * emits the checks that reject the method, or any of its optional parameters,
+ * when they aren't available in the client's API version.
+ *
+ * @param method the method for which to emit the checks
+ * @param indent the current indentation
+ * @returns the text for the checks or the empty string
+ */
+function emitAPIVersionChecks(method: go.MethodType | go.NextPageMethod, indent: helpers.Indentation): string {
+  if (method.kind === 'nextPageMethod' || method.receiver.type.apiVersionHistory.length === 0) {
+    return '';
+  }
+
+  const emitCheck = function (name: string, range: go.APIVersionRange): string {
+    let check = `${indent.get()}if err := checkAPIVersion("${name}", client.apiVersion, "${range.min ?? ''}", "${range.max ?? ''}"); err != nil {\n`;
+    check += `${indent.push().get()}return nil, err\n`;
+    check += `${indent.pop().get()}}\n`;
+    return check;
+  };
+
+  let text = '';
+  if (method.apiVersionRange) {
+    text += emitCheck(`operation ${method.receiver.type.name}.${fixUpMethodName(method)}`, method.apiVersionRange);
+  }
+  for (const param of method.parameters) {
+    if (!param.apiVersionRange || !param.group) {
+      continue;
+    }
+    text += emitParamGroupCheck(param, indent);
+    indent.push();
+    text += emitCheck(`parameter ${naming.capitalize(param.name)}`, param.apiVersionRange);
+    indent.pop();
+    text += `${indent.get()}}\n`;
+  }
+  return text;
+}
+
 /**
  * returns the var name to use for a param's client-side default value
  *
@@ -1565,7 +2124,7 @@ function getClientSideDefaultVarName(param: go.HeaderCollectionParameter | go.He
 }
 
 function emitClientSideDefault(
-  param: go.HeaderCollectionParameter | go.HeaderScalarParameter | go.QueryParameter,
+  param: go.CookieParameter | go.HeaderCollectionParameter | go.HeaderScalarParameter | go.QueryParameter,
   csd: go.ClientSideDefault,
   setterFormat: (name: string, val: string) => string,
   imports: ImportManager,
@@ -1579,6 +2138,9 @@ function emitClientSideDefault(
 
   let serializedName: string;
   switch (param.kind) {
+    case 'cookieParam':
+      serializedName = param.cookieName;
+      break;
     case 'headerCollectionParam':
     case 'headerScalarParam':
       serializedName = param.headerName;
@@ -1657,6 +2219,15 @@ function generateResponseUnmarshaller(
     unmarshallerText += `${indent.pop().get()}}\n`;
     unmarshallerText += `${indent.get()}result.${helpers.getResultFieldName(method)} = (*time.Time)(aux)\n`;
     return unmarshallerText;
+  } else if (type.kind === 'duration') {
+    // use the designated duration type for unmarshalling
+    imports.add('time');
+    unmarshallerText += `${indent.get()}var aux *${helpers.getDurationTypeName(type)}\n`;
+    unmarshallerText += `${indent.get()}if err := runtime.UnmarshalAs${format}(resp, &aux); err != nil {\n`;
+    unmarshallerText += `${indent.push().get()}return ${zeroValue}, err\n`;
+    unmarshallerText += `${indent.pop().get()}}\n`;
+    unmarshallerText += `${indent.get()}result.${helpers.getResultFieldName(method)} = (*time.Duration)(aux)\n`;
+    return unmarshallerText;
   } else if (isArrayOfDateTime(type)) {
     // unmarshalling arrays of date/time is a little more involved
     const timeInfo = isArrayOfDateTime(type);
@@ -1689,6 +2260,25 @@ function generateResponseUnmarshaller(
     unmarshallerText += `${indent.get()}result.${helpers.getResultFieldName(method)} = cp\n`;
     return unmarshallerText;
   }
+  if (format === 'JSON' && helpers.recursiveUnwrapMapSlice(type).kind === 'union') {
+    // unions are unmarshalled from the raw payload with the generated helpers
+    const unionType = <go.Union>helpers.recursiveUnwrapMapSlice(type);
+    let helperSuffix = '';
+    if (type.kind === 'slice') {
+      helperSuffix = 'Array';
+    } else if (type.kind === 'map') {
+      helperSuffix = 'Map';
+    }
+    unmarshallerText += `${indent.get()}body, err := runtime.Payload(resp)\n`;
+    unmarshallerText += `${indent.get()}if err != nil {\n`;
+    unmarshallerText += `${indent.push().get()}return ${zeroValue}, err\n`;
+    unmarshallerText += `${indent.pop().get()}}\n`;
+    unmarshallerText += `${indent.get()}${unmarshalTarget}, err = unmarshal${unionType.name}${helperSuffix}(body)\n`;
+    unmarshallerText += `${indent.get()}if err != nil {\n`;
+    unmarshallerText += `${indent.push().get()}return ${zeroValue}, err\n`;
+    unmarshallerText += `${indent.pop().get()}}\n`;
+    return unmarshallerText;
+  }
   if (format === 'JSON' || format === 'XML') {
     if (type.kind === 'rawJSON') {
       unmarshallerText += `${indent.get()}body, err := runtime.Payload(resp)\n`;
@@ -1736,7 +2326,7 @@ function createProtocolResponse(method: go.SyncMethod | go.LROPageableMethod | g
   let text = `${helpers.comment(name, '// ')} handles the ${method.name} response.\n`;
   text += `func ${getClientReceiverDefinition(method.receiver)} ${name}(resp *http.Response) (${generateReturnsInfo(method, 'handler').join(', ')}) {\n`;
 
-  const addHeaders = function (headers: Array<go.HeaderScalarResponse | go.HeaderMapResponse>) {
+  const addHeaders = function (headers: Array<go.HeaderResponse>) {
     for (const header of headers) {
       text += formatHeaderResponseValue(method, header, 'result', `${method.returns.name}{}`, imports, indent);
     }
@@ -1797,6 +2387,29 @@ function createProtocolResponse(method: go.SyncMethod | go.LROPageableMethod | g
         addHeaders(method.returns.headers);
         text += generateResponseUnmarshaller(method, result.interface, result.format, 'result', imports, indent);
         break;
+      case 'statusCodeResult':
+        imports.add('fmt');
+        text += `${indent.get()}result := ${method.returns.name}{}\n`;
+        addHeaders(method.returns.headers);
+        text += `${indent.get()}switch resp.StatusCode {\n`;
+        for (const statusCode of method.httpStatusCodes) {
+          text += `${indent.get()}case ${helpers.formatStatusCodes([statusCode])}:\n`;
+          const field = result.fields.find((f) => f.httpStatusCodes.includes(statusCode));
+          if (!field) {
+            // the operation contains a mix of schemas and non-schema responses
+            continue;
+          }
+          text += generateStatusCodeFieldUnmarshaller(method, field, result.format, imports, indent);
+        }
+        text += `${indent.get()}default:\n`;
+        text += `${indent.push().get()}return ${getZeroReturnValue(method, 'handler')}, fmt.Errorf("unhandled HTTP status code %d", resp.StatusCode)\n`;
+        text += `${indent.pop().get()}}\n`;
+        break;
+      case 'streamResult':
+        text += `${indent.get()}result := ${method.returns.name}{}\n`;
+        addHeaders(method.returns.headers);
+        text += `${indent.get()}result.${result.fieldName} = newEventStream(resp.Body, ${getStreamDecoder(result)})\n`;
+        break;
       default:
         result satisfies never;
     }
@@ -1807,6 +2420,43 @@ function createProtocolResponse(method: go.SyncMethod | go.LROPageableMethod | g
   return text;
 }
 
+/**
+ * emits the unmarshalling of a response body into its StatusCodeResult field.
+ *
+ * @param method the method that returns the StatusCodeResult
+ * @param field the field to unmarshal into
+ * @param format the format of the response body
+ * @param imports the import manager currently in scope
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the unmarshaller
+ */
+function generateStatusCodeFieldUnmarshaller(
+  method: go.SyncMethod | go.LROPageableMethod | go.PageableMethod,
+  field: go.StatusCodeResultField,
+  format: go.ResultFormat,
+  imports: ImportManager,
+  indent: helpers.Indentation,
+): string {
+  if (field.type.kind === 'time' || field.type.kind === 'duration' || isArrayOfDateTime(field.type) || isMapOfDateTime(field.type)) {
+    throw new CodegenError('UnsupportedTsp', `unsupported result type ${field.type.kind} for status code field ${field.fieldName} in method ${method.receiver.type.name}.${method.name}`);
+  }
+  const target = `result.${field.fieldName}`;
+  if (field.type.kind !== 'interface') {
+    return generateResponseUnmarshaller(method, field.type, format, target, imports, indent);
+  }
+  // polymorphic types are unmarshalled from the raw payload with the generated helpers
+  const zeroValue = getZeroReturnValue(method, 'handler');
+  let text = `${indent.get()}body, err := runtime.Payload(resp)\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return ${zeroValue}, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}${target}, err = unmarshal${field.type.name}(body)\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return ${zeroValue}, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  return text;
+}
+
 function isArrayOfDateTime(paramType: go.WireType): { format: go.TimeFormat; elemByVal: boolean } | undefined {
   if (paramType.kind !== 'slice') {
     return undefined;
@@ -1877,7 +2527,10 @@ function generateReturnsInfo(method: go.MethodType, apiType: 'api' | 'op' | 'han
       switch (apiType) {
         case 'api':
           if (method.kind === 'lroPageableMethod') {
-            returnType = `*runtime.Poller[*runtime.Pager[${returnType}]]`;
+            returnType = `*runtime.Pager[${returnType}]`;
+          }
+          if (method.statusMonitor) {
+            returnType = `*StatusMonitorPoller[${returnType}, ${go.getTypeDeclaration(method.statusMonitor, method.receiver.type.pkg)}]`;
           } else {
             returnType = `*runtime.Poller[${returnType}]`;
           }
@@ -1905,14 +2558,14 @@ function generateReturnsInfo(method: go.MethodType, apiType: 'api' | 'op' | 'han
   return [returnType, 'error'];
 }
 
-function generateLROBeginMethod(method: go.LROMethod | go.LROPageableMethod, options: go.Options, imports: ImportManager, indent: helpers.Indentation): string {
+function generateLROBeginMethod(method: go.LROMethod | go.LROPageableMethod, azureARM: boolean, options: go.Options, imports: ImportManager, indent: helpers.Indentation): string {
   const params = getAPIParametersSig(method, imports);
   const returns = generateReturnsInfo(method, 'api');
   imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
   let text = '';
   if (method.docs.summary || method.docs.description) {
     text += helpers.formatDocCommentWithPrefix(fixUpMethodName(method), method.docs);
-    text += genRespErrorDoc(method);
+    text += genRespErrorDoc(method, true);
   }
   const zeroResp = getZeroReturnValue(method, 'lro');
   const methodParams = helpers.getMethodParameters(method);
@@ -1920,6 +2573,8 @@ function generateLROBeginMethod(method: go.LROMethod | go.LROPageableMethod, opt
     text += helpers.formatCommentAsBulletItem(param.name, param.docs);
   }
   text += `func ${getClientReceiverDefinition(method.receiver)} ${fixUpMethodName(method)}(${params}) (${returns.join(', ')}) {\n`;
+  // with span attributes, the poller is wrapped by one that uses the client's tracer
+  const pollerTracer = options.injectSpans && !options.spanAttributes;
   let pollerType = 'nil';
   let pollerTypeParam = `[${method.returns.name}]`;
   if (method.kind === 'lroPageableMethod') {
@@ -1927,7 +2582,7 @@ function generateLROBeginMethod(method: go.LROMethod | go.LROPageableMethod, opt
     pollerTypeParam = `[*runtime.Pager${pollerTypeParam}]`;
     pollerType = '&pager';
     text += `${indent.get()}pager := `;
-    text += emitPagerDefinition(method, options, imports, indent);
+    text += emitPagerDefinition(method, azureARM, options, imports, indent);
   }
 
   text += `${indent.get()}if options == nil || options.ResumeToken == "" {\n`;
@@ -1964,13 +2619,13 @@ function generateLROBeginMethod(method: go.LROMethod | go.LROPageableMethod, opt
   }
 
   text += `${indent.get()}poller, err := runtime.NewPoller`;
-  if (finalStateVia === '' && pollerType === 'nil' && !options.injectSpans) {
+  if (finalStateVia === '' && pollerType === 'nil' && !pollerTracer) {
     // the generic type param is redundant when it's also specified in the
     // options struct so we only include it when there's no options.
     text += pollerTypeParam;
   }
   text += '(resp, client.internal.Pipeline(), ';
-  if (finalStateVia === '' && pollerType === 'nil' && !options.injectSpans && !method.operationLocationResultPath) {
+  if (finalStateVia === '' && pollerType === 'nil' && !pollerTracer && !method.operationLocationResultPath) {
     // no options
     text += 'nil)\n';
   } else {
@@ -1986,25 +2641,31 @@ function generateLROBeginMethod(method: go.LROMethod | go.LROPageableMethod, opt
     if (pollerType !== 'nil') {
       text += `${indent.get()}Response: ${pollerType},\n`;
     }
-    if (options.injectSpans) {
+    if (pollerTracer) {
       text += `${indent.get()}Tracer: client.internal.Tracer(),\n`;
     }
     indent.pop();
     text += `${indent.get()}})\n`;
   }
-  text += `${indent.get()}return poller, err\n`;
+  if (options.spanAttributes) {
+    text += emitTracePolls(method, 'resp', zeroResp, pollerTypeParam, indent);
+  } else if (method.statusMonitor) {
+    text += emitStatusMonitorPoller(method, pollerTypeParam, 'resp', indent);
+  } else {
+    text += `${indent.get()}return poller, err\n`;
+  }
   indent.pop();
   text += `${indent.get()}} else {\n`;
   indent.push();
 
   // creating the poller from resume token branch
 
-  text += `${indent.get()}return runtime.NewPollerFromResumeToken`;
-  if (pollerType === 'nil' && !options.injectSpans) {
+  text += `${indent.get()}${method.statusMonitor || options.spanAttributes ? 'poller, err :=' : 'return'} runtime.NewPollerFromResumeToken`;
+  if (pollerType === 'nil' && !pollerTracer) {
     text += pollerTypeParam;
   }
   text += '(options.ResumeToken, client.internal.Pipeline(), ';
-  if (pollerType === 'nil' && !options.injectSpans) {
+  if (pollerType === 'nil' && !pollerTracer) {
     text += 'nil)\n';
   } else {
     indent.push();
@@ -2012,12 +2673,17 @@ function generateLROBeginMethod(method: go.LROMethod | go.LROPageableMethod, opt
     if (pollerType !== 'nil') {
       text += `${indent.get()}Response: ${pollerType},\n`;
     }
-    if (options.injectSpans) {
+    if (pollerTracer) {
       text += `${indent.get()}Tracer: client.internal.Tracer(),\n`;
     }
     indent.pop();
     text += `${indent.get()}})\n`;
   }
+  if (options.spanAttributes) {
+    text += emitTracePolls(method, 'nil', zeroResp, pollerTypeParam, indent);
+  } else if (method.statusMonitor) {
+    text += emitStatusMonitorPoller(method, pollerTypeParam, 'nil', indent);
+  }
   indent.pop();
   text += `${indent.get()}}\n`;
 
@@ -2025,6 +2691,73 @@ function generateLROBeginMethod(method: go.LROMethod | go.LROPageableMethod, opt
   return text;
 }
 
+/**
+ * emits the code that wraps the poller in one that adds the poll count to its spans.
+ * if the LRO has a status monitor, the traced poller is then wrapped in a StatusMonitorPoller.
+ *
+ * @param method the LRO method for which to emit the code
+ * @param initialResp the expression for the initial response
+ * @param zeroResp the zero value for the Begin method's return value
+ * @param pollerTypeParam the type param for the poller's result type
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the traced poller
+ */
+function emitTracePolls(method: go.LROMethod | go.LROPageableMethod, initialResp: string, zeroResp: string, pollerTypeParam: string, indent: helpers.Indentation): string {
+  let text = `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return ${zeroResp}, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  const tracePolls = `tracePolls(${initialResp}, poller, client.internal.Pipeline(), client.internal.Tracer())`;
+  if (method.statusMonitor) {
+    text += `${indent.get()}poller, err = ${tracePolls}\n`;
+    text += emitStatusMonitorPoller(method, pollerTypeParam, initialResp, indent);
+  } else {
+    text += `${indent.get()}return ${tracePolls}\n`;
+  }
+  return text;
+}
+
+/**
+ * emits the code that wraps the poller in a StatusMonitorPoller.
+ *
+ * @param method the LRO method that has a status monitor
+ * @param pollerTypeParam the type param for the poller's result type
+ * @param initialResp the expression for the initial response
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the wrapped poller
+ */
+function emitStatusMonitorPoller(method: go.LROMethod | go.LROPageableMethod, pollerTypeParam: string, initialResp: string, indent: helpers.Indentation): string {
+  if (!method.statusMonitor) {
+    throw new CodegenError('InternalError', `LRO method ${method.name} has no status monitor`);
+  }
+  const statusType = go.getTypeDeclaration(method.statusMonitor, method.receiver.type.pkg);
+  // the poller's type param is in brackets, e.g. [FooResponse]
+  const resultType = pollerTypeParam.substring(1, pollerTypeParam.length - 1);
+  let text = `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return nil, err\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += `${indent.get()}return newStatusMonitorPoller[${resultType}, ${statusType}](poller, ${initialResp}, client.internal.Pipeline())\n`;
+  return text;
+}
+
+/**
+ * returns the name of the All* method that iterates over the items of a pageable method.
+ * returns undefined if the method doesn't have one.
+ *
+ * @param method the method for which to get the iterator name
+ * @param options the emitter options
+ * @returns the name of the iterator method or undefined
+ */
+export function getPagerIteratorName(method: go.MethodType, options: go.Options): string | undefined {
+  if (!options.pagerIterators || method.kind !== 'pageableMethod' || !method.itemsPath) {
+    return undefined;
+  }
+  if (method.name[0] !== method.name[0].toUpperCase()) {
+    // the method isn't exported; don't export the iterator
+    return `all${method.name[0].toUpperCase()}${method.name.substring(1)}`;
+  }
+  return `All${method.name}`;
+}
+
 export function fixUpMethodName(method: go.MethodType): string {
   switch (method.kind) {
     case 'lroMethod':
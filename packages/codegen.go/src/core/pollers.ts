@@ -0,0 +1,142 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from './helpers.js';
+import { ImportManager } from './imports.js';
+
+/**
+ * Creates the content for the poller_helper.go file.
+ * this includes the StatusMonitorPoller type that's returned
+ * by Begin methods for LROs with a typed status monitor.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generatePollerHelpers(pkg: go.PackageContent): string {
+  if (!hasStatusMonitors(pkg)) {
+    return '';
+  }
+
+  const imports = new ImportManager(pkg);
+  imports.add('context');
+  imports.add('encoding/json');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
+  imports.add('net/http');
+  imports.add('sync');
+
+  const body = `// StatusMonitorPoller is a Poller for a long-running operation that reports
+// its progress through a typed status monitor. Use Status to get the status
+// monitor returned by the most recent call to Poll.
+type StatusMonitorPoller[T, S any] struct {
+	*runtime.Poller[T]
+	handler *statusMonitorHandler[T, S]
+}
+
+func newStatusMonitorPoller[T, S any](poller *runtime.Poller[T], initial *http.Response, pl runtime.Pipeline) (*StatusMonitorPoller[T, S], error) {
+	handler := &statusMonitorHandler[T, S]{
+		poller:  poller,
+		initial: initial,
+	}
+	// wrapping the handler in a runtime.Poller lets azcore drive PollUntilDone
+	wrapped, err := runtime.NewPoller(initial, pl, &runtime.NewPollerOptions[T]{
+		Handler: handler,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &StatusMonitorPoller[T, S]{
+		Poller:  wrapped,
+		handler: handler,
+	}, nil
+}
+
+// ResumeToken returns a value representing the poller that can be used to resume
+// the LRO at a later time. ResumeTokens are unique per service operation.
+// The token's format should be considered opaque and is subject to change.
+// Calling this on an LRO in a terminal state will return an error.
+func (p *StatusMonitorPoller[T, S]) ResumeToken() (string, error) {
+	return p.handler.poller.ResumeToken()
+}
+
+// Status returns the status monitor from the most recent call to Poll.
+// It returns nil if the LRO hasn't been polled.
+func (p *StatusMonitorPoller[T, S]) Status() *S {
+	p.handler.mu.Lock()
+	defer p.handler.mu.Unlock()
+	return p.handler.status
+}
+
+// statusMonitorHandler is a runtime.PollingHandler that delegates to
+// the LRO's Poller and keeps the status monitor from each poll.
+type statusMonitorHandler[T, S any] struct {
+	poller  *runtime.Poller[T]
+	initial *http.Response
+	mu      sync.Mutex
+	status  *S
+}
+
+// Done returns true if the LRO has reached a terminal state.
+func (h *statusMonitorHandler[T, S]) Done() bool {
+	return h.poller.Done()
+}
+
+// Poll fetches the latest state of the LRO and updates the status monitor.
+func (h *statusMonitorHandler[T, S]) Poll(ctx context.Context) (*http.Response, error) {
+	resp, err := h.poller.Poll(ctx)
+	if err != nil {
+		return resp, err
+	}
+	// the initial response doesn't contain the status monitor
+	if resp == nil || resp == h.initial {
+		return resp, nil
+	}
+	payload, err := runtime.Payload(resp)
+	if err != nil {
+		return resp, err
+	}
+	if len(payload) == 0 {
+		return resp, nil
+	}
+	status := new(S)
+	if err := json.Unmarshal(payload, status); err != nil {
+		return resp, err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.status = status
+	return resp, nil
+}
+
+// Result populates out with the result of the LRO.
+func (h *statusMonitorHandler[T, S]) Result(ctx context.Context, out *T) error {
+	result, err := h.poller.Result(ctx)
+	if err != nil {
+		return err
+	}
+	*out = result
+	return nil
+}
+`;
+
+  return helpers.contentPreamble(pkg) + imports.text() + body;
+}
+
+/**
+ * returns true if any LRO in the package has a typed status monitor
+ *
+ * @param pkg contains the package content
+ * @returns true if the StatusMonitorPoller type is required
+ */
+function hasStatusMonitors(pkg: go.PackageContent): boolean {
+  for (const client of pkg.clients) {
+    for (const method of client.methods) {
+      if (go.isLROMethod(method) && method.statusMonitor) {
+        return true;
+      }
+    }
+  }
+  return false;
+}
@@ -15,12 +15,11 @@ import { ImportManager } from './imports.js';
  */
 export function generatePolymorphicHelpers(pkg: go.FakePackage | go.PackageContent): string {
   const content = pkg.kind === 'fake' ? pkg.parent : pkg;
-  if (content.interfaces.length === 0) {
+  if (content.interfaces.length === 0 && content.unions.length === 0) {
     // no polymorphic types
     return '';
   }
 
-  let text = helpers.contentPreamble(pkg);
   const imports = new ImportManager(pkg);
   imports.add('encoding/json');
   if (pkg.kind === 'fake') {
@@ -28,8 +27,6 @@ export function generatePolymorphicHelpers(pkg: go.FakePackage | go.PackageConte
     imports.addForPkg(pkg.parent);
   }
 
-  text += imports.text();
-
   const scalars = new Set<string>();
   const arrays = new Set<string>();
   const maps = new Set<string>();
@@ -40,11 +37,12 @@ export function generatePolymorphicHelpers(pkg: go.FakePackage | go.PackageConte
   const trackDisciminator = function (type: go.WireType) {
     switch (type.kind) {
       case 'interface':
+      case 'union':
         scalars.add(type.name);
         break;
       case 'map': {
         const leafType = helpers.recursiveUnwrapMapSlice(type);
-        if (leafType.kind === 'interface') {
+        if (leafType.kind === 'interface' || leafType.kind === 'union') {
           scalars.add(leafType.name);
           maps.add(leafType.name);
         }
@@ -52,7 +50,7 @@ export function generatePolymorphicHelpers(pkg: go.FakePackage | go.PackageConte
       }
       case 'slice': {
         const leafType = helpers.recursiveUnwrapMapSlice(type);
-        if (leafType.kind === 'interface') {
+        if (leafType.kind === 'interface' || leafType.kind === 'union') {
           scalars.add(leafType.name);
           arrays.add(leafType.name);
         }
@@ -82,6 +80,11 @@ export function generatePolymorphicHelpers(pkg: go.FakePackage | go.PackageConte
     for (const respEnv of pkg.responseEnvelopes) {
       switch (respEnv.result?.kind) {
         case 'monomorphicResult':
+          if (helpers.recursiveUnwrapMapSlice(respEnv.result.monomorphicType).kind === 'union') {
+            // unions are unmarshalled directly from the response body
+            trackDisciminator(respEnv.result.monomorphicType);
+            break;
+          }
           switch (respEnv.result.monomorphicType.kind) {
             case 'map':
               trackDisciminator(respEnv.result.monomorphicType.valueType);
@@ -94,6 +97,11 @@ export function generatePolymorphicHelpers(pkg: go.FakePackage | go.PackageConte
         case 'polymorphicResult':
           trackDisciminator(respEnv.result.interface);
           break;
+        case 'statusCodeResult':
+          for (const field of respEnv.result.fields) {
+            trackDisciminator(field.type);
+          }
+          break;
       }
     }
   }
@@ -113,6 +121,57 @@ export function generatePolymorphicHelpers(pkg: go.FakePackage | go.PackageConte
   }
 
   const indent = new helpers.Indentation();
+  let text = '';
+
+  // emits an unmarshaller for a slice of the named interface type
+  const emitArrayUnmarshaller = function (name: string): string {
+    let unmarshaller = '';
+    unmarshaller += `func unmarshal${name}Array(rawMsg json.RawMessage) ([]${prefix}${name}, error) {\n`;
+    unmarshaller += `${indent.get()}if rawMsg == nil || string(rawMsg) == "null" {\n`;
+    unmarshaller += `${indent.push().get()}return nil, nil\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+    unmarshaller += `${indent.get()}var rawMessages []json.RawMessage\n`;
+    unmarshaller += `${indent.get()}if err := json.Unmarshal(rawMsg, &rawMessages); err != nil {\n`;
+    unmarshaller += `${indent.push().get()}return nil, err\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+    unmarshaller += `${indent.get()}fArray := make([]${prefix}${name}, len(rawMessages))\n`;
+    unmarshaller += `${indent.get()}for index, rawMessage := range rawMessages {\n`;
+    indent.push();
+    unmarshaller += `${indent.get()}f, err := unmarshal${name}(rawMessage)\n`;
+    unmarshaller += `${indent.get()}if err != nil {\n`;
+    unmarshaller += `${indent.push().get()}return nil, err\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+    unmarshaller += `${indent.get()}fArray[index] = f\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+    unmarshaller += `${indent.get()}return fArray, nil\n`;
+    unmarshaller += '}\n\n';
+    return unmarshaller;
+  };
+
+  // emits an unmarshaller for a map of the named interface type
+  const emitMapUnmarshaller = function (name: string): string {
+    let unmarshaller = '';
+    unmarshaller += `func unmarshal${name}Map(rawMsg json.RawMessage) (map[string]${prefix}${name}, error) {\n`;
+    unmarshaller += `${indent.get()}if rawMsg == nil || string(rawMsg) == "null" {\n`;
+    unmarshaller += `${indent.push().get()}return nil, nil\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+    unmarshaller += `${indent.get()}var rawMessages map[string]json.RawMessage\n`;
+    unmarshaller += `${indent.get()}if err := json.Unmarshal(rawMsg, &rawMessages); err != nil {\n`;
+    unmarshaller += `${indent.push().get()}return nil, err\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+    unmarshaller += `${indent.get()}fMap := make(map[string]${prefix}${name}, len(rawMessages))\n`;
+    unmarshaller += `${indent.get()}for key, rawMessage := range rawMessages {\n`;
+    indent.push();
+    unmarshaller += `${indent.get()}f, err := unmarshal${name}(rawMessage)\n`;
+    unmarshaller += `${indent.get()}if err != nil {\n`;
+    unmarshaller += `${indent.push().get()}return nil, err\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+    unmarshaller += `${indent.get()}fMap[key] = f\n`;
+    unmarshaller += `${indent.pop().get()}}\n`;
+    unmarshaller += `${indent.get()}return fMap, nil\n`;
+    unmarshaller += '}\n\n';
+    return unmarshaller;
+  };
 
   for (const interfaceType of content.interfaces) {
     // generate unmarshallers for each discriminator
@@ -152,49 +211,121 @@ export function generatePolymorphicHelpers(pkg: go.FakePackage | go.PackageConte
 
     // array unmarshaller
     if (arrays.has(interfaceType.name)) {
-      text += `func unmarshal${interfaceType.name}Array(rawMsg json.RawMessage) ([]${prefix}${interfaceType.name}, error) {\n`;
-      text += `${indent.get()}if rawMsg == nil || string(rawMsg) == "null" {\n`;
-      text += `${indent.push().get()}return nil, nil\n`;
-      text += `${indent.pop().get()}}\n`;
-      text += `${indent.get()}var rawMessages []json.RawMessage\n`;
-      text += `${indent.get()}if err := json.Unmarshal(rawMsg, &rawMessages); err != nil {\n`;
-      text += `${indent.push().get()}return nil, err\n`;
-      text += `${indent.pop().get()}}\n`;
-      text += `${indent.get()}fArray := make([]${prefix}${interfaceType.name}, len(rawMessages))\n`;
-      text += `${indent.get()}for index, rawMessage := range rawMessages {\n`;
-      indent.push();
-      text += `${indent.get()}f, err := unmarshal${interfaceType.name}(rawMessage)\n`;
-      text += `${indent.get()}if err != nil {\n`;
-      text += `${indent.push().get()}return nil, err\n`;
-      text += `${indent.pop().get()}}\n`;
-      text += `${indent.get()}fArray[index] = f\n`;
-      text += `${indent.pop().get()}}\n`;
-      text += `${indent.get()}return fArray, nil\n`;
-      text += '}\n\n';
+      text += emitArrayUnmarshaller(interfaceType.name);
     }
 
     // map unmarshaller
     if (maps.has(interfaceType.name)) {
-      text += `func unmarshal${interfaceType.name}Map(rawMsg json.RawMessage) (map[string]${prefix}${interfaceType.name}, error) {\n`;
+      text += emitMapUnmarshaller(interfaceType.name);
+    }
+  }
+
+  let needsHasOnlyFields = false;
+  for (const union of content.unions) {
+    // generate unmarshallers for each union. a model variant whose discriminator or
+    // fields match the payload is preferred, then the variants are tried in declaration order
+
+    // scalar unmarshaller
+    if (scalars.has(union.name)) {
+      imports.add('fmt');
+      const variants = union.variants.map((variant: go.UnionVariant) => `&${prefix}${variant.name}{}`);
+      text += `func unmarshal${union.name}(rawMsg json.RawMessage) (${prefix}${union.name}, error) {\n`;
       text += `${indent.get()}if rawMsg == nil || string(rawMsg) == "null" {\n`;
       text += `${indent.push().get()}return nil, nil\n`;
       text += `${indent.pop().get()}}\n`;
-      text += `${indent.get()}var rawMessages map[string]json.RawMessage\n`;
-      text += `${indent.get()}if err := json.Unmarshal(rawMsg, &rawMessages); err != nil {\n`;
-      text += `${indent.push().get()}return nil, err\n`;
-      text += `${indent.pop().get()}}\n`;
-      text += `${indent.get()}fMap := make(map[string]${prefix}${interfaceType.name}, len(rawMessages))\n`;
-      text += `${indent.get()}for key, rawMessage := range rawMessages {\n`;
-      indent.push();
-      text += `${indent.get()}f, err := unmarshal${interfaceType.name}(rawMessage)\n`;
-      text += `${indent.get()}if err != nil {\n`;
-      text += `${indent.push().get()}return nil, err\n`;
+      const matches = getUnionVariantMatches(union, prefix);
+      if (matches.length > 0) {
+        text += `${indent.get()}// prefer the variant whose discriminator or fields match the payload\n`;
+        text += `${indent.get()}var m map[string]any\n`;
+        text += `${indent.get()}if err := json.Unmarshal(rawMsg, &m); err == nil {\n`;
+        text += `${indent.push().get()}var b ${prefix}${union.name}\n`;
+        text += `${indent.get()}switch {\n`;
+        for (const match of matches) {
+          text += `${indent.get()}case ${match.condition}:\n`;
+          text += `${indent.push().get()}b = &${prefix}${match.variant.name}{}\n`;
+          indent.pop();
+          if (match.condition.startsWith('hasOnlyFields')) {
+            needsHasOnlyFields = true;
+          }
+        }
+        text += `${indent.get()}}\n`;
+        text += `${indent.get()}if b != nil && json.Unmarshal(rawMsg, b) == nil {\n`;
+        text += `${indent.push().get()}return b, nil\n`;
+        text += `${indent.pop().get()}}\n`;
+        text += `${indent.pop().get()}}\n`;
+        text += `${indent.get()}// otherwise use the first variant that can unmarshal the payload\n`;
+      }
+      text += `${indent.get()}for _, b := range []${prefix}${union.name}{${variants.join(', ')}} {\n`;
+      text += `${indent.push().get()}if err := json.Unmarshal(rawMsg, b); err == nil {\n`;
+      text += `${indent.push().get()}return b, nil\n`;
       text += `${indent.pop().get()}}\n`;
-      text += `${indent.get()}fMap[key] = f\n`;
       text += `${indent.pop().get()}}\n`;
-      text += `${indent.get()}return fMap, nil\n`;
+      text += `${indent.get()}return nil, fmt.Errorf("unable to unmarshal %s into ${union.name}", rawMsg)\n`;
       text += '}\n\n';
     }
+
+    // array unmarshaller
+    if (arrays.has(union.name)) {
+      text += emitArrayUnmarshaller(union.name);
+    }
+
+    // map unmarshaller
+    if (maps.has(union.name)) {
+      text += emitMapUnmarshaller(union.name);
+    }
+  }
+
+  if (needsHasOnlyFields) {
+    imports.add('slices');
+    text += '// hasOnlyFields returns true if every field in m is one of the specified fields.\n';
+    text += 'func hasOnlyFields(m map[string]any, fields ...string) bool {\n';
+    text += `${indent.get()}for key := range m {\n`;
+    text += `${indent.push().get()}if !slices.Contains(fields, key) {\n`;
+    text += `${indent.push().get()}return false\n`;
+    text += `${indent.pop().get()}}\n`;
+    text += `${indent.pop().get()}}\n`;
+    text += `${indent.get()}return true\n`;
+    text += '}\n\n';
+  }
+
+  // imports are added while generating the helpers so they must be emitted last
+  return helpers.contentPreamble(pkg) + imports.text() + text;
+}
+
+/** a condition that selects a union variant from the JSON object m */
+interface UnionVariantMatch {
+  condition: string;
+  variant: go.UnionVariant;
+}
+
+/**
+ * returns the conditions used to select the model variants of a union.
+ * discriminated variants are matched on their discriminator value and come first.
+ * the remaining model variants match when the payload contains only their fields.
+ * variants that permit additional properties match any object so they have no condition.
+ *
+ * @param union the union for which to return the conditions
+ * @param prefix the package prefix for type names
+ * @returns the conditions in the order they're evaluated
+ */
+function getUnionVariantMatches(union: go.Union, prefix: string): Array<UnionVariantMatch> {
+  const discriminated = new Array<UnionVariantMatch>();
+  const strict = new Array<UnionVariantMatch>();
+  for (const variant of union.variants) {
+    if (variant.type.kind === 'polymorphicModel' && variant.type.discriminatorValue) {
+      let disc = helpers.formatLiteralValue(variant.type.discriminatorValue, true);
+      // when the discriminator value is an enum, cast the const as a string
+      if (variant.type.discriminatorValue.type.kind === 'constant') {
+        disc = `string(${prefix}${disc})`;
+      }
+      discriminated.push({ condition: `m["${variant.type.interface.discriminatorField}"] == ${disc}`, variant });
+    } else if (variant.type.kind === 'model' || variant.type.kind === 'polymorphicModel') {
+      if (variant.type.fields.some((field: go.ModelField) => field.annotations.isAdditionalProperties)) {
+        continue;
+      }
+      const fields = variant.type.fields.map((field: go.ModelField) => `"${field.serializedName}"`).sort();
+      strict.push({ condition: `hasOnlyFields(${['m', ...fields].join(', ')})`, variant });
+    }
   }
-  return text;
+  return [...discriminated, ...strict];
 }
@@ -79,6 +79,14 @@ function generateMarshaller(respEnv: go.ResponseEnvelope, imports: ImportManager
     text += `func (${receiver} ${respEnv.name}) MarshalJSON() ([]byte, error) {\n`;
     // TODO: this doesn't include any headers. however, LROs with header responses are currently broken :(
     text += `${indent.get()}return json.Marshal(${receiver}.${go.getTypeDeclaration(respEnv.result.interface, respEnv.method.receiver.type.pkg)})\n}\n\n`;
+  } else if (go.isLROMethod(respEnv.method) && respEnv.result?.kind === 'statusCodeResult') {
+    // fakes return the final result of the LRO as the response body so only the terminal field is marshalled
+    imports.add('encoding/json');
+    const receiver = respEnv.name[0].toLowerCase();
+    const field = getLROResultField(respEnv.result);
+    text += `${helpers.comment(`MarshalJSON implements the json.Marshaller interface for type ${respEnv.name}.`, '// ', undefined, helpers.commentLength)}\n`;
+    text += `func (${receiver} ${respEnv.name}) MarshalJSON() ([]byte, error) {\n`;
+    text += `${indent.get()}return json.Marshal(${receiver}.${field.fieldName})\n}\n\n`;
   }
   return text;
 }
@@ -97,19 +105,30 @@ function generateUnmarshaller(respEnv: go.ResponseEnvelope, imports: ImportManag
   let polymorphicRes: go.PolymorphicResult | undefined;
   // in addition, if it's an LRO operation that returns a scalar, we will also need one
   let monomorphicRes: go.MonomorphicResult | undefined;
+  // LROs with a field per status code need one to populate the field for the final result
+  let statusCodeRes: go.StatusCodeResult | undefined;
   if (respEnv.result?.kind === 'polymorphicResult') {
     polymorphicRes = respEnv.result;
   } else if (go.isLROMethod(respEnv.method) && respEnv.result?.kind === 'monomorphicResult') {
     monomorphicRes = respEnv.result;
+  } else if (go.isLROMethod(respEnv.method) && respEnv.result?.kind === 'statusCodeResult') {
+    statusCodeRes = respEnv.result;
   }
 
-  if (!polymorphicRes && !monomorphicRes) {
+  if (!polymorphicRes && !monomorphicRes && !statusCodeRes) {
     // no unmarshaller required
     return '';
   }
 
   const receiver = respEnv.name[0].toLowerCase();
   let unmarshaller = `${helpers.comment(`UnmarshalJSON implements the json.Unmarshaller interface for type ${respEnv.name}.`, '// ', undefined, helpers.commentLength)}\n`;
+  let statusCodeField: go.StatusCodeResultField | undefined;
+  if (statusCodeRes) {
+    // pollers don't expose the status code of the final response to the unmarshaller
+    statusCodeField = getLROResultField(statusCodeRes);
+    const statusCodes = statusCodeField.httpStatusCodes.map((statusCode) => statusCode.toString()).join(', ');
+    unmarshaller += `${helpers.comment(`The final result of the long-running operation populates ${statusCodeField.fieldName}, the field for HTTP status code ${statusCodes}.`, '// ', undefined, helpers.commentLength)}\n`;
+  }
   unmarshaller += `func (${receiver} *${respEnv.name}) UnmarshalJSON(data []byte) error {\n`;
 
   // add a custom unmarshaller to the response envelope
@@ -126,6 +145,20 @@ function generateUnmarshaller(respEnv: go.ResponseEnvelope, imports: ImportManag
   } else if (monomorphicRes) {
     imports.add('encoding/json');
     unmarshaller += `${indent.get()}return json.Unmarshal(data, &${receiver}.${monomorphicRes.fieldName})\n`;
+  } else if (statusCodeField) {
+    if (statusCodeField.type.kind === 'interface') {
+      unmarshaller += `${indent.get()}res, err := unmarshal${statusCodeField.type.name}(data)\n`;
+      unmarshaller += `${indent.get()}if err != nil {\n`;
+      indent.push();
+      unmarshaller += `${indent.get()}return err\n`;
+      indent.pop();
+      unmarshaller += `${indent.get()}}\n`;
+      unmarshaller += `${indent.get()}${receiver}.${statusCodeField.fieldName} = res\n`;
+      unmarshaller += `${indent.get()}return nil\n`;
+    } else {
+      imports.add('encoding/json');
+      unmarshaller += `${indent.get()}return json.Unmarshal(data, &${receiver}.${statusCodeField.fieldName})\n`;
+    }
   } else {
     throw new CodegenError('InternalError', `unhandled case for response envelope ${respEnv.name}`);
   }
@@ -156,7 +189,20 @@ function emit(respEnv: go.ResponseEnvelope, imports: ImportManager, indent: help
     // used to track when to add an extra \n between fields that have comments
     let first = true;
 
-    if (respEnv.result) {
+    if (respEnv.result?.kind === 'statusCodeResult') {
+      for (const field of respEnv.result.fields) {
+        imports.addForType(field.type);
+        const statusCodes = field.httpStatusCodes.map((statusCode) => statusCode.toString());
+        let populated = `HTTP status code ${statusCodes[0]}`;
+        if (statusCodes.length > 1) {
+          populated = `one of HTTP status codes ${statusCodes.slice(0, -1).join(', ')} or ${statusCodes[statusCodes.length - 1]}`;
+        }
+        fields.push({
+          docs: { summary: `${field.fieldName} is populated when the service returns ${populated}.` },
+          field: `${indent.get()}${field.fieldName} ${helpers.star(isStatusCodeFieldByValue(field.type))}${go.getTypeDeclaration(field.type, respEnv.method.receiver.type.pkg)}\n`,
+        });
+      }
+    } else if (respEnv.result) {
       const respType = go.getResultType(respEnv.result);
       imports.addForType(respType);
       if (respEnv.result.kind === 'modelResult' || respEnv.result.kind === 'polymorphicResult') {
@@ -178,6 +224,8 @@ function emit(respEnv: go.ResponseEnvelope, imports: ImportManager, indent: help
         let byValue = true;
         if (respEnv.result.kind === 'monomorphicResult') {
           byValue = respEnv.result.byValue;
+        } else if (respEnv.result.kind === 'streamResult') {
+          byValue = false;
         }
 
         fields.push({
@@ -188,6 +236,14 @@ function emit(respEnv: go.ResponseEnvelope, imports: ImportManager, indent: help
     }
 
     for (const header of respEnv.headers) {
+      if (header.kind === 'setCookieResponse') {
+        imports.add('net/http');
+        fields.push({
+          docs: header.docs,
+          field: `${indent.get()}${header.fieldName} []*http.Cookie\n`,
+        });
+        continue;
+      }
       imports.addForType(header.type);
       let byValue = true;
       if (header.kind === 'headerScalarResponse') {
@@ -220,3 +276,45 @@ function emit(respEnv: go.ResponseEnvelope, imports: ImportManager, indent: help
   text += '}\n\n';
   return text;
 }
+
+/**
+ * returns true if the field of a StatusCodeResult is held by value.
+ * slices, maps, and interfaces have a nil value so they don't need a pointer.
+ *
+ * @param type the type of the field
+ * @returns true if the field is held by value
+ */
+export function isStatusCodeFieldByValue(type: go.WireType): boolean {
+  switch (type.kind) {
+    case 'any':
+    case 'encodedBytes':
+    case 'interface':
+    case 'map':
+    case 'rawJSON':
+    case 'slice':
+    case 'union':
+      return true;
+    default:
+      return false;
+  }
+}
+
+/**
+ * returns the field of an LRO's StatusCodeResult that contains the final result.
+ * this is the field for the 200 status code, falling back to the lowest status code
+ * when no field exists for it.
+ *
+ * @param result the StatusCodeResult for the LRO
+ * @returns the field that contains the final result
+ */
+function getLROResultField(result: go.StatusCodeResult): go.StatusCodeResultField {
+  let lroField = result.fields[0];
+  for (const field of result.fields) {
+    if (field.httpStatusCodes.includes(200)) {
+      return field;
+    } else if (Math.min(...field.httpStatusCodes) < Math.min(...lroField.httpStatusCodes)) {
+      lroField = field;
+    }
+  }
+  return lroField;
+}
@@ -0,0 +1,212 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from './helpers.js';
+import { getExampleValue } from './example.js';
+import { ImportManager } from './imports.js';
+
+// the maximum depth of nested models to populate in sample values
+const maxSampleDepth = 3;
+
+/**
+ * Creates the content for the models_serde_bench_test.go file.
+ * The benchmarks are identical regardless of the serde mode so the
+ * output of both modes can be compared (e.g. with benchstat).
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generateSerDeBenchmarks(pkg: go.TestPackage): string {
+  const imports = new ImportManager(pkg);
+  let text = '';
+
+  for (const model of pkg.src.models) {
+    if (!hasJSONSerDeMethods(model, pkg.src)) {
+      continue;
+    }
+
+    const sample = buildSampleExample(model, new Array<string>());
+    if (!sample) {
+      continue;
+    }
+
+    const indent = new helpers.Indentation();
+    text += `func Benchmark${model.name}(b *testing.B) {\n`;
+    text += `${indent.get()}v := ${getExampleValue(pkg, sample, indent.get(), imports, true).trimStart()}\n`;
+    text += `${indent.get()}b.Run("MarshalJSON", func(b *testing.B) {\n`;
+    text += `${indent.push().get()}b.ReportAllocs()\n`;
+    text += `${indent.get()}for b.Loop() {\n`;
+    text += `${indent.push().get()}if _, err := json.Marshal(v); err != nil {\n`;
+    text += `${indent.push().get()}b.Fatal(err)\n`;
+    text += `${indent.pop().get()}}\n`;
+    text += `${indent.pop().get()}}\n`;
+    text += `${indent.pop().get()}})\n`;
+    text += `${indent.get()}b.Run("UnmarshalJSON", func(b *testing.B) {\n`;
+    text += `${indent.push().get()}data, err := json.Marshal(v)\n`;
+    text += `${indent.get()}if err != nil {\n`;
+    text += `${indent.push().get()}b.Fatal(err)\n`;
+    text += `${indent.pop().get()}}\n`;
+    text += `${indent.get()}b.ReportAllocs()\n`;
+    text += `${indent.get()}for b.Loop() {\n`;
+    text += `${indent.push().get()}var target ${go.getTypeDeclaration(model, pkg)}\n`;
+    text += `${indent.get()}if err := json.Unmarshal(data, &target); err != nil {\n`;
+    text += `${indent.push().get()}b.Fatal(err)\n`;
+    text += `${indent.pop().get()}}\n`;
+    text += `${indent.pop().get()}}\n`;
+    text += `${indent.pop().get()}})\n`;
+    text += '}\n\n';
+  }
+
+  if (text.length === 0) {
+    return '';
+  }
+
+  imports.add('encoding/json');
+  imports.add('testing');
+  imports.addForPkg(pkg.src);
+
+  let content = helpers.contentPreamble(pkg);
+  content += imports.text();
+  content += text;
+  return content;
+}
+
+/**
+ * returns true if the model has generated MarshalJSON and UnmarshalJSON methods
+ *
+ * @param model the model to inspect
+ * @param pkg the package that contains the model
+ * @returns true if the model has JSON serde methods
+ */
+function hasJSONSerDeMethods(model: go.Model | go.PolymorphicModel, pkg: go.PackageContent): boolean {
+  if (model.fields.length === 0 || model.annotations.omitSerDeMethods || model.annotations.multipartFormData) {
+    return false;
+  }
+  return helpers.getSerDeFormat(model, pkg) === 'JSON';
+}
+
+/**
+ * builds an example value for the specified model, populating all
+ * fields for which a sample value can be synthesized.
+ *
+ * @param model the model for which to build the sample
+ * @param stack the models currently being populated, used to break cycles
+ * @returns the sample or undefined if the model is already being populated
+ */
+function buildSampleExample(model: go.Model | go.PolymorphicModel, stack: Array<string>): go.StructExample | undefined {
+  if (stack.includes(model.name) || stack.length === maxSampleDepth) {
+    return undefined;
+  }
+  stack.push(model.name);
+  const example = new go.StructExample(model);
+  for (const field of model.fields) {
+    if (field.annotations.isDiscriminator || field.annotations.isAdditionalProperties) {
+      continue;
+    }
+    const value = buildSampleValue(field.type, stack);
+    if (value) {
+      example.value[field.name] = value;
+    }
+  }
+  stack.pop();
+  return example;
+}
+
+/**
+ * builds a sample value for the specified type
+ *
+ * @param type the type for which to build a sample value
+ * @param stack the models currently being populated, used to break cycles
+ * @returns the sample or undefined if no sample is applicable
+ */
+function buildSampleValue(type: go.WireType, stack: Array<string>): go.ExampleType | undefined {
+  switch (type.kind) {
+    case 'any':
+      return new go.AnyExample('value');
+    case 'constant': {
+      const first = type.values[0];
+      switch (type.type) {
+        case 'bool':
+          return new go.BooleanExample(first.value as boolean, type);
+        case 'string':
+          return new go.StringExample(first.value as string, type);
+        default:
+          return new go.NumberExample(first.value as number, type);
+      }
+    }
+    case 'decimal':
+      return new go.StringExample('1.5', type);
+    case 'duration':
+      return new go.NumberExample(90, type);
+    case 'encodedBytes':
+    case 'etag':
+    case 'string':
+      return new go.StringExample('value', type);
+    case 'interface': {
+      if (type.possibleTypes.length === 0) {
+        return undefined;
+      }
+      return buildSampleExample(type.possibleTypes[0], stack);
+    }
+    case 'map': {
+      const value = buildSampleValue(type.valueType, stack);
+      if (!value) {
+        return undefined;
+      }
+      const example = new go.DictionaryExample(type);
+      example.value['key'] = value;
+      return example;
+    }
+    case 'model':
+    case 'polymorphicModel':
+      return buildSampleExample(type, stack);
+    case 'scalar':
+      switch (type.type) {
+        case 'bool':
+          return new go.BooleanExample(true, type);
+        case 'byte':
+        case 'rune':
+          return undefined;
+        case 'float32':
+        case 'float64':
+          return new go.NumberExample(1.5, type);
+        default:
+          return new go.NumberExample(1, type);
+      }
+    case 'slice': {
+      const element = buildSampleValue(type.elementType, stack);
+      if (!element) {
+        return undefined;
+      }
+      const example = new go.ArrayExample(type);
+      example.value.push(element);
+      return example;
+    }
+    case 'time':
+      switch (type.format) {
+        case 'PlainDate':
+          return new go.StringExample('2024-01-01', type);
+        case 'PlainTime':
+          return new go.StringExample('12:00:00', type);
+        case 'RFC1123':
+          return new go.StringExample('Mon, 01 Jan 2024 12:00:00 GMT', type);
+        case 'RFC3339':
+          return new go.StringExample('2024-01-01T12:00:00Z', type);
+        default:
+          return undefined;
+      }
+    case 'union': {
+      const variant = type.variants[0];
+      const value = buildSampleValue(variant.type, stack);
+      if (!value) {
+        return undefined;
+      }
+      return new go.UnionExample(type, variant, value);
+    }
+    default:
+      return undefined;
+  }
+}
@@ -0,0 +1,89 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from './helpers.js';
+import { ImportManager } from './imports.js';
+
+/**
+ * Creates the content for the service_errors.go file.
+ * this includes the typed errors for service error responses
+ * and their constructors that are called by the client methods.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generateServiceErrors(pkg: go.PackageContent): string {
+  if (pkg.errors.length === 0) {
+    return '';
+  }
+
+  const imports = new ImportManager(pkg);
+  imports.add('encoding/json');
+  imports.add('errors');
+  imports.add('net/http');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
+
+  const serviceErrors = pkg.errors.toSorted((a, b) => helpers.sortAscending(a.name, b.name));
+  const bodies = new Array<string>();
+  for (const serviceError of serviceErrors) {
+    let body = '';
+    const modelName = serviceError.model.name;
+    if (serviceError.docs.summary || serviceError.docs.description) {
+      body += helpers.formatDocComment(serviceError.docs);
+    } else {
+      body += `// ${serviceError.name} contains the ${modelName} returned by the service for an unsuccessful request.\n`;
+      body += '// It wraps the *azcore.ResponseError created from the response. Use errors.As to retrieve it.\n';
+    }
+    body += `type ${serviceError.name} struct {\n`;
+    body += '\t*azcore.ResponseError\n\n';
+    body += `\t// Body contains the unmarshalled ${modelName}.\n`;
+    body += `\t// It's nil when the response body is empty or isn't a valid ${modelName}.\n`;
+    body += `\tBody *${modelName}\n`;
+    body += '}\n\n';
+
+    body += '// Unwrap returns the wrapped *azcore.ResponseError.\n';
+    body += `func (e *${serviceError.name}) Unwrap() error {\n`;
+    body += '\treturn e.ResponseError\n';
+    body += '}\n\n';
+
+    body += `// new${serviceError.name} creates an *${serviceError.name} from the specified response.\n`;
+    body += `func new${serviceError.name}(resp *http.Response) error {\n`;
+    body += '\terr := runtime.NewResponseError(resp)\n';
+    body += '\tvar respErr *azcore.ResponseError\n';
+    body += '\tif !errors.As(err, &respErr) {\n';
+    body += '\t\treturn err\n';
+    body += '\t}\n';
+    body += `\ttypedErr := &${serviceError.name}{ResponseError: respErr}\n`;
+    body += '\tpayload, err := runtime.Payload(resp)\n';
+    body += '\tif err != nil || len(payload) == 0 {\n';
+    body += '\t\treturn typedErr\n';
+    body += '\t}\n';
+    body += `\tvar body ${modelName}\n`;
+    body += '\tif err := json.Unmarshal(payload, &body); err == nil {\n';
+    body += '\t\ttypedErr.Body = &body\n';
+    body += '\t}\n';
+    body += '\treturn typedErr\n';
+    body += '}\n';
+    bodies.push(body);
+  }
+
+  return helpers.contentPreamble(pkg) + imports.text() + bodies.join('\n');
+}
+
+/**
+ * returns the expression that creates the error for an unsuccessful response.
+ *
+ * @param method the method for which to create the error
+ * @param respVarName the var name of the *http.Response
+ * @returns the error expression
+ */
+export function newResponseError(method: go.MethodType, respVarName: string): string {
+  if (method.errorType) {
+    return `new${method.errorType.name}(${respVarName})`;
+  }
+  return `runtime.NewResponseError(${respVarName})`;
+}
@@ -0,0 +1,292 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as naming from '../../../naming.go/src/naming.js';
+import * as helpers from './helpers.js';
+import { ImportManager } from './imports.js';
+import { CodegenError } from './errors.js';
+
+/**
+ * Creates the content for the stream_helper.go file.
+ * this includes the EventStream type and the decoders for
+ * each stream returned by a method in the package.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generateStreamHelpers(pkg: go.PackageContent): string {
+  const streams = getStreamResults(pkg);
+  if (streams.length === 0) {
+    return '';
+  }
+
+  const imports = new ImportManager(pkg);
+  imports.add('bufio');
+  imports.add('context');
+  imports.add('errors');
+  imports.add('io');
+  imports.add('iter');
+
+  let body = `// EventStream is a stream of items decoded from a response body.
+// Use All to iterate over the items in the stream. The stream is
+// closed once iteration completes. Call Close to release the stream
+// without iterating over its items.
+type EventStream[T any] struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+	decode func(*bufio.Reader) (T, error)
+	items  []T
+}
+
+// NewEventStream creates an EventStream that contains the specified items.
+// This is useful for returning streams from fakes and mocks.
+func NewEventStream[T any](items ...T) *EventStream[T] {
+	return &EventStream[T]{items: items}
+}
+
+func newEventStream[T any](body io.ReadCloser, decode func(*bufio.Reader) (T, error)) *EventStream[T] {
+	return &EventStream[T]{
+		body:   body,
+		reader: bufio.NewReader(body),
+		decode: decode,
+	}
+}
+
+// All returns an iterator over the items in the stream.
+// Iteration stops after the first error. Canceling ctx stops
+// iteration and closes the stream.
+func (e *EventStream[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if e.body == nil {
+			for _, item := range e.items {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+			return
+		}
+		defer e.body.Close()
+		// unblock any pending read when ctx is canceled
+		stop := context.AfterFunc(ctx, func() {
+			e.body.Close()
+		})
+		defer stop()
+		for {
+			item, err := e.decode(e.reader)
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				yield(zero, ctxErr)
+				return
+			} else if errors.Is(err, io.EOF) {
+				return
+			} else if err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Close closes the stream. It's safe to call Close more than once.
+func (e *EventStream[T]) Close() error {
+	if e.body == nil {
+		return nil
+	}
+	return e.body.Close()
+}
+`;
+
+  if (streams.some((stream) => stream.format === 'JSONL')) {
+    imports.add('bytes');
+    imports.add('encoding/json');
+    body += `
+// decodeJSONLine decodes the next line in a stream of JSON lines into a T.
+func decodeJSONLine[T any](reader *bufio.Reader) (T, error) {
+	var item T
+	for {
+		line, err := reader.ReadBytes('\\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			return item, json.Unmarshal(line, &item)
+		} else if err != nil {
+			return item, err
+		}
+	}
+}
+`;
+  }
+
+  const sseStreams = streams.filter((stream) => stream.format === 'SSE');
+  if (sseStreams.length > 0) {
+    imports.add('strings');
+    body += `
+// serverSentEvent is an event read from a stream of server-sent events.
+type serverSentEvent struct {
+	Type string
+	Data string
+}
+
+// readServerSentEvent reads the next event from a stream of server-sent events.
+// Any incomplete event at the end of the stream is discarded.
+func readServerSentEvent(reader *bufio.Reader) (serverSentEvent, error) {
+	var event serverSentEvent
+	var data []string
+	for {
+		line, err := reader.ReadString('\\n')
+		if err != nil {
+			return serverSentEvent{}, err
+		}
+		line = strings.TrimRight(line, "\\r\\n")
+		if line == "" {
+			if data == nil {
+				// nothing to dispatch
+				event = serverSentEvent{}
+				continue
+			}
+			if event.Type == "" {
+				event.Type = "message"
+			}
+			event.Data = strings.Join(data, "\\n")
+			return event, nil
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			event.Type = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+}
+`;
+    for (const stream of sseStreams) {
+      body += emitEventDecoder(stream, imports);
+    }
+  }
+
+  return helpers.contentPreamble(pkg) + imports.text() + body;
+}
+
+/**
+ * returns the expression for the function that decodes the items in the specified stream
+ *
+ * @param stream the stream for which to return the decoder
+ * @returns the decoder expression
+ */
+export function getStreamDecoder(stream: go.StreamResult): string {
+  switch (stream.format) {
+    case 'JSONL':
+      return `decodeJSONLine[${go.getTypeDeclaration(stream.streamType.itemType, stream.streamType.pkg)}]`;
+    case 'SSE':
+      return `decode${naming.capitalize(getEventsUnion(stream).name)}`;
+  }
+}
+
+/**
+ * returns the union that contains the events for a stream of server-sent events
+ *
+ * @param stream the stream of server-sent events
+ * @returns the union of events
+ */
+export function getEventsUnion(stream: go.StreamResult): go.Union {
+  if (stream.streamType.itemType.kind !== 'union') {
+    throw new CodegenError('InternalError', `unexpected item type ${stream.streamType.itemType.kind} for stream of server-sent events`);
+  }
+  return stream.streamType.itemType;
+}
+
+/**
+ * returns the stream results in the package.
+ * SSE streams that share the same events union are only included once.
+ *
+ * @param pkg contains the package content
+ * @returns the stream results sorted by item type
+ */
+export function getStreamResults(pkg: go.PackageContent): Array<go.StreamResult> {
+  const streams = new Map<string, go.StreamResult>();
+  for (const respEnv of pkg.responseEnvelopes) {
+    if (respEnv.result?.kind !== 'streamResult') {
+      continue;
+    }
+    const key = `${respEnv.result.format}:${go.getTypeDeclaration(respEnv.result.streamType.itemType, pkg)}`;
+    if (!streams.has(key)) {
+      streams.set(key, respEnv.result);
+    }
+  }
+  return [...streams.entries()].sort((a, b) => helpers.sortAscending(a[0], b[0])).map((entry) => entry[1]);
+}
+
+/**
+ * emits the function that decodes the next server-sent event into the stream's events union.
+ * events with an unknown type are skipped.
+ *
+ * @param stream the stream of server-sent events
+ * @param imports the import manager currently in scope
+ * @returns the text for the decoder
+ */
+function emitEventDecoder(stream: go.StreamResult, imports: ImportManager): string {
+  const union = getEventsUnion(stream);
+  const indent = new helpers.Indentation();
+  const decoder = getStreamDecoder(stream);
+  let text = `\n// ${decoder} decodes the next server-sent event into a ${union.name}.\n`;
+  text += '// Events with an unknown type are skipped.\n';
+  text += `func ${decoder}(reader *bufio.Reader) (${union.name}, error) {\n`;
+  text += `${indent.get()}for {\n`;
+  indent.push();
+  text += `${indent.get()}event, err := readServerSentEvent(reader)\n`;
+  text += `${indent.get()}if err != nil {\n`;
+  text += `${indent.push().get()}return nil, err\n`;
+  text += `${indent.pop().get()}}\n`;
+
+  // terminal events without an event type are identified by their data
+  for (const event of stream.events) {
+    if (event.terminal && event.name === 'message' && event.data !== undefined) {
+      text += `${indent.get()}if event.Type == "message" && event.Data == ${JSON.stringify(event.data)} {\n`;
+      text += `${indent.push().get()}return nil, io.EOF\n`;
+      text += `${indent.pop().get()}}\n`;
+    }
+  }
+
+  text += `${indent.get()}switch event.Type {\n`;
+  for (const event of stream.events) {
+    if (event.terminal) {
+      if (event.name === 'message' && event.data !== undefined) {
+        continue;
+      }
+      text += `${indent.get()}case "${event.name}":\n`;
+      text += `${indent.push().get()}return nil, io.EOF\n`;
+      indent.pop();
+      continue;
+    }
+    if (!event.variant) {
+      throw new CodegenError('InternalError', `missing variant for event ${event.name} in union ${union.name}`);
+    }
+    text += `${indent.get()}case "${event.name}":\n`;
+    indent.push();
+    if (event.format === 'Text') {
+      text += `${indent.get()}return &${event.variant.name}{Value: event.Data}, nil\n`;
+    } else {
+      imports.add('encoding/json');
+      text += `${indent.get()}variant := &${event.variant.name}{}\n`;
+      text += `${indent.get()}if err := json.Unmarshal([]byte(event.Data), variant); err != nil {\n`;
+      text += `${indent.push().get()}return nil, err\n`;
+      text += `${indent.pop().get()}}\n`;
+      text += `${indent.get()}return variant, nil\n`;
+    }
+    indent.pop();
+  }
+  text += `${indent.get()}}\n`;
+  text += `${indent.pop().get()}}\n`;
+  text += '}\n';
+  return text;
+}
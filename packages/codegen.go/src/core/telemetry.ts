@@ -0,0 +1,265 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from './helpers.js';
+import { ImportManager } from './imports.js';
+
+/**
+ * Creates the content for the telemetry.go file.
+ * this includes the helpers that add span attributes to pagers and
+ * pollers, and the OperationMeter used to record operation metrics.
+ *
+ * @param pkg contains the package content
+ * @param target the codegen target for the module
+ * @param options the emitter options
+ * @returns the text for the file or the empty string
+ */
+export function generateTelemetryHelpers(pkg: go.PackageContent, target: go.CodeModelType, options: go.Options): string {
+  const methods = pkg.clients.flatMap((client) => client.methods);
+  if (methods.length === 0 || (!options.spanAttributes && !options.operationMetrics)) {
+    return '';
+  }
+  const hasPagers = methods.some((method) => go.isPageableMethod(method));
+  const hasLROs = methods.some((method) => go.isLROMethod(method));
+
+  const imports = new ImportManager(pkg);
+  let body = '';
+
+  if (options.spanAttributes) {
+    imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/tracing');
+    if (target === 'azure-arm' && methods.some((method) => method.kind !== 'pageableMethod' && getResourceIDTrim(method) !== undefined)) {
+      imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/policy');
+      imports.add('strings');
+      body += `// armResourceID returns the ID of the resource targeted by req.
+// the ID is the request's path without its last n segments.
+func armResourceID(req *policy.Request, n int) string {
+	id := req.Raw().URL.Path
+	for ; n > 0; n-- {
+		if i := strings.LastIndex(id, "/"); i >= 0 {
+			id = id[:i]
+		}
+	}
+	return id
+}
+
+`;
+    }
+
+    if (hasPagers) {
+      imports.add('context');
+      body += `// tracePages returns a Fetcher that adds attrs and the number of the page
+// being fetched to the span for each page. the first page is number 1.
+func tracePages[T any](tracer tracing.Tracer, attrs []tracing.Attribute, fetcher func(context.Context, *T) (T, error)) func(context.Context, *T) (T, error) {
+	pageNumber := 0
+	return func(ctx context.Context, page *T) (T, error) {
+		if page == nil {
+			// the pager is fetching its first page
+			pageNumber = 0
+		}
+		pageNumber++
+		span := tracer.SpanFromContext(ctx)
+		span.SetAttributes(attrs...)
+		span.SetAttributes(tracing.Attribute{Key: "az.page.number", Value: pageNumber})
+		return fetcher(ctx, page)
+	}
+}
+
+`;
+    }
+
+    if (hasLROs) {
+      imports.add('context');
+      imports.add('encoding/json');
+      imports.add('net/http');
+      imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
+      body += `// tracePolls returns a Poller that adds the number of the poll to the span
+// for each call to Poll. the Poller is created with the client's tracer, so
+// poller must be created without one to avoid nested spans.
+// resp is the initial response used to create poller, or nil if it was resumed.
+func tracePolls[T any](resp *http.Response, poller *runtime.Poller[T], pl runtime.Pipeline, tracer tracing.Tracer) (*runtime.Poller[T], error) {
+	return runtime.NewPoller(resp, pl, &runtime.NewPollerOptions[T]{
+		Handler: &pollTracer[T]{poller: poller, tracer: tracer},
+		Tracer:  tracer,
+	})
+}
+
+// pollTracer is a runtime.PollingHandler that delegates to the wrapped Poller.
+type pollTracer[T any] struct {
+	poller *runtime.Poller[T]
+	tracer tracing.Tracer
+	count  int
+}
+
+func (p *pollTracer[T]) Done() bool {
+	return p.poller.Done()
+}
+
+func (p *pollTracer[T]) Poll(ctx context.Context) (*http.Response, error) {
+	p.count++
+	p.tracer.SpanFromContext(ctx).SetAttributes(tracing.Attribute{Key: "az.lro.poll_count", Value: p.count})
+	return p.poller.Poll(ctx)
+}
+
+func (p *pollTracer[T]) Result(ctx context.Context, out *T) error {
+	res, err := p.poller.Result(ctx)
+	if err != nil {
+		return err
+	}
+	*out = res
+	return nil
+}
+
+// MarshalJSON returns the state of the wrapped Poller so that resume
+// tokens are the same as the ones from a Poller that isn't traced.
+func (p *pollTracer[T]) MarshalJSON() ([]byte, error) {
+	tk, err := p.poller.ResumeToken()
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(tk), &raw); err != nil {
+		return nil, err
+	}
+	return raw["token"], nil
+}
+
+`;
+    }
+  }
+
+  if (options.operationMetrics) {
+    imports.add('context');
+    imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/policy');
+    imports.add('net/http');
+    imports.add('time');
+    body += `// OperationMeter records metrics for the operations of the clients in this package.
+// Pass an implementation to NewOperationMeterPolicy to export the metrics, e.g. to OpenTelemetry.
+// Implementations must be safe for concurrent use.
+type OperationMeter interface {
+	// RecordDuration records how long a call to the operation took.
+	// operation is the name of the operation, e.g. "Client.Method".
+	// The duration of each page fetched by a pager is recorded separately.
+	// For long-running operations, only the initial request is timed.
+	// The time spent polling the operation isn't included.
+	RecordDuration(ctx context.Context, operation string, duration time.Duration)
+
+	// AddError counts a call to the operation that returned an error.
+	AddError(ctx context.Context, operation string)
+}
+
+// NewOperationMeterPolicy returns a policy that passes meter to the clients in this package.
+// Add it to the PerCallPolicies in the client options to record metrics for the operations
+// of the client and any client created from it. The policy doesn't modify requests.
+func NewOperationMeterPolicy(meter OperationMeter) policy.Policy {
+	return &operationMeterPolicy{meter: meter}
+}
+
+type operationMeterPolicy struct {
+	meter OperationMeter
+}
+
+func (p *operationMeterPolicy) Do(req *policy.Request) (*http.Response, error) {
+	return req.Next()
+}
+
+// getOperationMeter returns the OperationMeter from the first
+// operationMeterPolicy in policies, or nil if there isn't one.
+func getOperationMeter(policies []policy.Policy) OperationMeter {
+	for _, pl := range policies {
+		if p, ok := pl.(*operationMeterPolicy); ok {
+			return p.meter
+		}
+	}
+	return nil
+}
+
+// recordOperation records the duration of the operation that began at start,
+// and counts an error if *err isn't nil. it's intended to be deferred.
+func recordOperation(ctx context.Context, meter OperationMeter, operation string, start time.Time, err *error) {
+	if meter == nil {
+		return
+	}
+	meter.RecordDuration(ctx, operation, time.Since(start))
+	if *err != nil {
+		meter.AddError(ctx, operation)
+	}
+}
+
+`;
+    if (hasPagers) {
+      body += `// meterPages returns a Fetcher that records metrics for each page fetched by the operation.
+func meterPages[T any](meter OperationMeter, operation string, fetcher func(context.Context, *T) (T, error)) func(context.Context, *T) (T, error) {
+	return func(ctx context.Context, page *T) (result T, err error) {
+		defer recordOperation(ctx, meter, operation, time.Now(), &err)
+		return fetcher(ctx, page)
+	}
+}
+
+`;
+    }
+  }
+
+  return helpers.contentPreamble(pkg) + imports.text() + body.trimEnd() + '\n';
+}
+
+/**
+ * returns the span attributes for the operation of the specified method.
+ * this includes the templated URL path, the API version when its value is
+ * known when the span starts, and the resource provider namespace for ARM.
+ *
+ * @param method the method for which to get the attributes
+ * @param azureARM true if the method belongs to an ARM module
+ * @param imports the import manager currently in scope
+ * @param indent the indentation helper currently in scope
+ * @returns the attributes formatted as tracing.Attribute composite literals
+ */
+export function getSpanAttributes(method: go.MethodType, azureARM: boolean, imports: ImportManager, indent: helpers.Indentation): Array<string> {
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/tracing');
+  const attrs = new Array<string>(`{Key: "url.template", Value: "${method.httpPath}"}`);
+  const apiVersion = method.parameters.find((param) => {
+    switch (param.kind) {
+      case 'headerScalarParam':
+      case 'pathScalarParam':
+      case 'queryScalarParam':
+        // optional method params might be nil so only include values that are always available
+        return (
+          param.isApiVersion &&
+          (go.isRequiredParameter(param.style) || go.isLiteralParameter(param.style) || (param.location === 'client' && go.isClientSideDefault(param.style)))
+        );
+      default:
+        return false;
+    }
+  });
+  if (apiVersion) {
+    attrs.push(`{Key: "az.api_version", Value: ${helpers.formatParamValue(apiVersion, imports, indent)}}`);
+  }
+  if (azureARM) {
+    // the namespace of the resource provider that handles the request, e.g. Microsoft.Compute
+    const namespaces = [...method.httpPath.matchAll(/\/providers\/([^/{}]+)/g)];
+    if (namespaces.length > 0) {
+      attrs.push(`{Key: "az.namespace", Value: "${namespaces[namespaces.length - 1][1]}"}`);
+    }
+  }
+  return attrs;
+}
+
+/**
+ * returns the number of path segments after the resource ID in the
+ * method's path, e.g. 1 for .../botServices/{resourceName}/listKeys.
+ * returns undefined if the path doesn't contain a resource ID.
+ *
+ * @param method the method for which to get the number of segments
+ * @returns the number of segments to trim or undefined
+ */
+export function getResourceIDTrim(method: go.MethodType): number | undefined {
+  const segments = method.httpPath.split('/');
+  for (let i = segments.length - 1; i >= 0; --i) {
+    if (segments[i].startsWith('{')) {
+      return segments.length - 1 - i;
+    }
+  }
+  return undefined;
+}
@@ -0,0 +1,97 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from './helpers.js';
+import { ImportManager } from './imports.js';
+
+/** contains the generated content for unions.go and unions_serde.go */
+export interface UnionsSerDe {
+  unions: string;
+  serDe: string;
+}
+
+/**
+ * Creates the content for the unions.go and unions_serde.go files.
+ * each union is emitted as a sealed interface with one wrapper type per variant.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the files or the empty string
+ */
+export function generateUnions(pkg: go.PackageContent): UnionsSerDe {
+  if (pkg.unions.length === 0) {
+    return {
+      unions: '',
+      serDe: '',
+    };
+  }
+
+  const indent = new helpers.Indentation();
+  const unionImports = new ImportManager(pkg);
+  const serdeImports = new ImportManager(pkg);
+  serdeImports.add('encoding/json');
+
+  let unionsText = '';
+  let serdeText = '';
+
+  const unions = [...pkg.unions].sort((a: go.Union, b: go.Union) => helpers.sortAscending(a.name, b.name));
+  for (const union of unions) {
+    const sealer = getSealerMethodName(union);
+    if (union.docs.summary || union.docs.description) {
+      unionsText += helpers.formatDocCommentWithPrefix(union.name, union.docs);
+    } else {
+      unionsText += `// ${union.name} contains one of several possible types.\n`;
+    }
+    unionsText += '// Use a type switch to determine the concrete type.  The possible types are:\n';
+    const variantNames = union.variants.map((variant: go.UnionVariant) => `*${variant.name}`).sort(helpers.sortAscending);
+    unionsText += helpers.comment(variantNames.join(', '), '// - ');
+    unionsText += `\ntype ${union.name} interface {\n`;
+    unionsText += `${indent.get()}${sealer}()\n`;
+    unionsText += '}\n\n';
+
+    for (const variant of union.variants) {
+      unionImports.addForType(variant.type);
+      const valueType = `${helpers.star(variant.byValue)}${go.getTypeDeclaration(variant.type, pkg)}`;
+      unionsText += `// ${variant.name} contains the ${valueType} variant of ${union.name}.\n`;
+      unionsText += `type ${variant.name} struct {\n`;
+      unionsText += `${indent.get()}Value ${valueType}\n`;
+      unionsText += '}\n\n';
+      unionsText += `func (*${variant.name}) ${sealer}() {}\n\n`;
+
+      const receiver = variant.name[0].toLowerCase();
+      serdeText += `// MarshalJSON implements the json.Marshaller interface for type ${variant.name}.\n`;
+      serdeText += `func (${receiver} ${variant.name}) MarshalJSON() ([]byte, error) {\n`;
+      serdeText += `${indent.get()}return json.Marshal(${receiver}.Value)\n`;
+      serdeText += '}\n\n';
+      serdeText += `// UnmarshalJSON implements the json.Unmarshaller interface for type ${variant.name}.\n`;
+      serdeText += `func (${receiver} *${variant.name}) UnmarshalJSON(data []byte) error {\n`;
+      serdeText += `${indent.get()}return json.Unmarshal(data, &${receiver}.Value)\n`;
+      serdeText += '}\n\n';
+    }
+  }
+
+  let unionsContent = helpers.contentPreamble(pkg);
+  unionsContent += unionImports.text();
+  unionsContent += unionsText;
+
+  let serdeContent = helpers.contentPreamble(pkg);
+  serdeContent += serdeImports.text();
+  serdeContent += serdeText;
+
+  return {
+    unions: unionsContent,
+    serDe: serdeContent,
+  };
+}
+
+/**
+ * returns the name of the unexported method that seals the union's interface
+ *
+ * @param union the union for which to return the method name
+ * @returns the method name
+ */
+function getSealerMethodName(union: go.Union): string {
+  return `is${union.name[0].toUpperCase()}${union.name.substring(1)}`;
+}
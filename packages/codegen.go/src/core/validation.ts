@@ -0,0 +1,392 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from './helpers.js';
+import { ImportManager } from './imports.js';
+
+/**
+ * Creates the content for the validation.go file.
+ * this includes the ValidationError type, the validation helpers,
+ * and the Validate methods for models with constraints.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generateValidation(pkg: go.PackageContent): string {
+  const models = pkg.models.filter((model) => needsValidation(model)).sort((a, b) => helpers.sortAscending(a.name, b.name));
+  if (models.length === 0 && !pkg.clients.some((client) => client.methods.some((method) => needsParamValidation(method)))) {
+    return '';
+  }
+
+  const imports = new ImportManager(pkg);
+  imports.add('cmp');
+  imports.add('fmt');
+  imports.add('regexp');
+  imports.add('strings');
+  imports.add('sync');
+  imports.add('unicode/utf8');
+
+  let text = helpers.contentPreamble(pkg);
+  let body = `// ValidationError is returned when one or more values don't satisfy their constraints.
+// When a method returns a *ValidationError, no request was sent.
+type ValidationError struct {
+	// Violations contains every constraint violation that was found.
+	Violations []ConstraintViolation
+}
+
+// Error implements the error interface for type ValidationError.
+func (e *ValidationError) Error() string {
+	violations := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		violations = append(violations, violation.String())
+	}
+	return "validation failed: " + strings.Join(violations, "; ")
+}
+
+// ConstraintViolation describes a value that doesn't satisfy a constraint.
+type ConstraintViolation struct {
+	// Path is the location of the value (e.g. Widget.Name or options.Filter).
+	Path string
+
+	// Constraint is the name of the constraint (e.g. maxLength).
+	Constraint string
+
+	// Message describes why the value doesn't satisfy the constraint.
+	Message string
+}
+
+// String returns the violation in the form "Path: Message".
+func (c ConstraintViolation) String() string {
+	return c.Path + ": " + c.Message
+}
+
+// constraintValidator accumulates constraint violations.
+type constraintValidator struct {
+	violations []ConstraintViolation
+}
+
+func (cv *constraintValidator) add(path, constraint, message string) {
+	cv.violations = append(cv.violations, ConstraintViolation{
+		Path:       path,
+		Constraint: constraint,
+		Message:    message,
+	})
+}
+
+func (cv *constraintValidator) err() error {
+	if len(cv.violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: cv.violations}
+}
+
+func validateMinLength(cv *constraintValidator, path, value string, minLength int) {
+	if utf8.RuneCountInString(value) < minLength {
+		cv.add(path, "minLength", fmt.Sprintf("length must be at least %d", minLength))
+	}
+}
+
+func validateMaxLength(cv *constraintValidator, path, value string, maxLength int) {
+	if utf8.RuneCountInString(value) > maxLength {
+		cv.add(path, "maxLength", fmt.Sprintf("length must be at most %d", maxLength))
+	}
+}
+
+// patterns caches compiled regular expressions
+var patterns sync.Map
+
+func validatePattern(cv *constraintValidator, path, value, pattern string) {
+	var re *regexp.Regexp
+	if cached, ok := patterns.Load(pattern); ok {
+		re = cached.(*regexp.Regexp)
+	} else {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			// patterns that use syntax unsupported by RE2 (e.g. lookarounds) are left to the service
+			return
+		}
+		patterns.Store(pattern, compiled)
+		re = compiled
+	}
+	if !re.MatchString(value) {
+		cv.add(path, "pattern", fmt.Sprintf("must match the pattern %s", pattern))
+	}
+}
+
+func validateMinValue[T cmp.Ordered](cv *constraintValidator, path string, value, minValue T) {
+	if value < minValue {
+		cv.add(path, "minValue", fmt.Sprintf("must be greater than or equal to %v", minValue))
+	}
+}
+
+func validateMaxValue[T cmp.Ordered](cv *constraintValidator, path string, value, maxValue T) {
+	if value > maxValue {
+		cv.add(path, "maxValue", fmt.Sprintf("must be less than or equal to %v", maxValue))
+	}
+}
+
+func validateMinValueExclusive[T cmp.Ordered](cv *constraintValidator, path string, value, minValue T) {
+	if value <= minValue {
+		cv.add(path, "minValueExclusive", fmt.Sprintf("must be greater than %v", minValue))
+	}
+}
+
+func validateMaxValueExclusive[T cmp.Ordered](cv *constraintValidator, path string, value, maxValue T) {
+	if value >= maxValue {
+		cv.add(path, "maxValueExclusive", fmt.Sprintf("must be less than %v", maxValue))
+	}
+}
+
+func validateMinItems(cv *constraintValidator, path string, count, minItems int) {
+	if count < minItems {
+		cv.add(path, "minItems", fmt.Sprintf("must contain at least %d items", minItems))
+	}
+}
+
+func validateMaxItems(cv *constraintValidator, path string, count, maxItems int) {
+	if count > maxItems {
+		cv.add(path, "maxItems", fmt.Sprintf("must contain at most %d items", maxItems))
+	}
+}
+`;
+
+  for (const model of models) {
+    body += emitValidateMethods(model, imports);
+  }
+
+  text += imports.text();
+  text += body;
+  return text;
+}
+
+/**
+ * returns true if the type is a model with constraints,
+ * or (transitively) contains one.
+ *
+ * @param type the type to inspect
+ * @returns true if the type requires validation
+ */
+export function needsValidation(type: go.WireType): boolean {
+  return needsValidationImpl(type, new Set<go.WireType>());
+}
+
+function needsValidationImpl(type: go.WireType, visited: Set<go.WireType>): boolean {
+  if (visited.has(type)) {
+    return false;
+  }
+  switch (type.kind) {
+    case 'interface':
+      visited.add(type);
+      return type.possibleTypes.some((possibleType) => needsValidationImpl(possibleType, visited));
+    case 'map':
+      return needsValidationImpl(type.valueType, visited);
+    case 'model':
+    case 'polymorphicModel':
+      visited.add(type);
+      return type.fields.some((field) => field.constraints !== undefined || needsValidationImpl(field.type, visited));
+    case 'slice':
+      return needsValidationImpl(type.elementType, visited);
+    default:
+      return false;
+  }
+}
+
+/**
+ * returns true if any of the method's parameters require validation
+ *
+ * @param method the method to inspect
+ * @returns true if validation is required
+ */
+export function needsParamValidation(method: go.MethodType | go.NextPageMethod): boolean {
+  for (const param of method.parameters) {
+    if (param.style === 'literal' || param.style === 'flag') {
+      continue;
+    }
+    if (param.constraints && emitValueChecks('', '', param.type, param.constraints, new helpers.Indentation()).length > 0) {
+      return true;
+    } else if (param.kind === 'bodyParam' && needsValidation(param.type)) {
+      return true;
+    }
+  }
+  return false;
+}
+
+/**
+ * emits the Validate and validate methods for the specified model
+ *
+ * @param model the model for which to emit the methods
+ * @param imports the import manager for the file
+ * @returns the text for the methods
+ */
+function emitValidateMethods(model: go.Model | go.PolymorphicModel, imports: ImportManager): string {
+  const receiver = model.name[0].toLowerCase();
+  const indent = new helpers.Indentation();
+  let text = `\n// Validate returns a *ValidationError if any of the ${model.name}'s values don't satisfy their constraints.\n`;
+  text += `func (${receiver} *${model.name}) Validate() error {\n`;
+  text += `${indent.get()}cv := &constraintValidator{}\n`;
+  text += `${indent.get()}${receiver}.validate(cv, "${model.name}")\n`;
+  text += `${indent.get()}return cv.err()\n`;
+  text += '}\n\n';
+
+  text += `func (${receiver} *${model.name}) validate(cv *constraintValidator, path string) {\n`;
+  for (const field of model.fields) {
+    const fieldName = `${receiver}.${field.name}`;
+    const fieldPath = `path+".${field.name}"`;
+    const isPointer = !field.byValue && field.type.kind !== 'slice' && field.type.kind !== 'map' && field.type.kind !== 'interface';
+    if (field.constraints) {
+      const checks = emitValueChecks(fieldPath, isPointer ? `*${fieldName}` : fieldName, field.type, field.constraints, isPointer ? indent.push() : indent);
+      if (isPointer) {
+        indent.pop();
+      }
+      if (checks.length > 0) {
+        if (isPointer) {
+          text += `${indent.get()}if ${fieldName} != nil {\n${checks}${indent.get()}}\n`;
+        } else {
+          text += checks;
+        }
+      }
+    }
+    text += emitNestedValidation(fieldName, fieldPath, field.type, isPointer, imports, indent);
+  }
+  text += '}\n';
+  return text;
+}
+
+/**
+ * emits the validation of any models contained in the specified value
+ *
+ * @param value the Go expression for the value
+ * @param path the Go expression for the value's path
+ * @param type the value's type
+ * @param isPointer indicates if the value is pointer-to-type
+ * @param imports the import manager for the file
+ * @param indent the current indentation
+ * @returns the text for the validation or the empty string
+ */
+export function emitNestedValidation(value: string, path: string, type: go.WireType, isPointer: boolean, imports: ImportManager, indent: helpers.Indentation): string {
+  if (!needsValidation(type)) {
+    return '';
+  }
+  let text = '';
+  switch (type.kind) {
+    case 'interface': {
+      // only the possible types with constraints have a validate method
+      const possibleTypes = type.possibleTypes.filter((possibleType) => needsValidation(possibleType)).sort((a, b) => helpers.sortAscending(a.name, b.name));
+      text += `${indent.get()}switch val := ${value}.(type) {\n`;
+      for (const possibleType of possibleTypes) {
+        text += `${indent.get()}case *${possibleType.name}:\n`;
+        text += `${indent.push().get()}val.validate(cv, ${path})\n`;
+        indent.pop();
+      }
+      text += `${indent.get()}}\n`;
+      break;
+    }
+    case 'map':
+      // nested collections shadow item, so the path is computed before descending
+      text += `${indent.get()}for key, item := range ${value} {\n`;
+      text += `${indent.push().get()}itemPath := ${path} + "[" + key + "]"\n`;
+      text += emitNestedValidation('item', 'itemPath', type.valueType, !type.valueTypeByValue && type.valueType.kind !== 'interface', imports, indent);
+      text += `${indent.pop().get()}}\n`;
+      break;
+    case 'model':
+    case 'polymorphicModel':
+      if (isPointer) {
+        text += `${indent.get()}if ${value} != nil {\n`;
+        text += `${indent.push().get()}${value}.validate(cv, ${path})\n`;
+        text += `${indent.pop().get()}}\n`;
+      } else {
+        text += `${indent.get()}${value}.validate(cv, ${path})\n`;
+      }
+      break;
+    case 'slice':
+      imports.add('fmt');
+      text += `${indent.get()}for idx, item := range ${value} {\n`;
+      text += `${indent.push().get()}itemPath := fmt.Sprintf("%s[%d]", ${path}, idx)\n`;
+      text += emitNestedValidation('item', 'itemPath', type.elementType, !type.elementTypeByValue && type.elementType.kind !== 'interface', imports, indent);
+      text += `${indent.pop().get()}}\n`;
+      break;
+  }
+  return text;
+}
+
+/**
+ * emits the constraint checks for a value.
+ * constraints that don't apply to the value's type are ignored.
+ *
+ * @param path the Go expression for the value's path
+ * @param value the Go expression for the value
+ * @param type the value's type
+ * @param constraints the constraints to check
+ * @param indent the current indentation
+ * @returns the text for the checks or the empty string
+ */
+export function emitValueChecks(path: string, value: string, type: go.WireType, constraints: go.Constraints, indent: helpers.Indentation): string {
+  let text = '';
+  switch (type.kind) {
+    case 'scalar': {
+      if (type.type === 'bool' || type.type === 'byte' || type.type === 'rune') {
+        break;
+      }
+      // integer types can't be compared with fractional constants
+      const isInteger = type.type !== 'float32' && type.type !== 'float64';
+      if (constraints.minValue !== undefined) {
+        const minValue = isInteger ? Math.ceil(constraints.minValue) : constraints.minValue;
+        text += `${indent.get()}validateMinValue(cv, ${path}, ${value}, ${minValue})\n`;
+      }
+      if (constraints.maxValue !== undefined) {
+        const maxValue = isInteger ? Math.floor(constraints.maxValue) : constraints.maxValue;
+        text += `${indent.get()}validateMaxValue(cv, ${path}, ${value}, ${maxValue})\n`;
+      }
+      // for integer types, an integer > 1.5 is also > 1 and an integer < 1.5 is also < 2
+      if (constraints.minValueExclusive !== undefined) {
+        const minValue = isInteger ? Math.floor(constraints.minValueExclusive) : constraints.minValueExclusive;
+        text += `${indent.get()}validateMinValueExclusive(cv, ${path}, ${value}, ${minValue})\n`;
+      }
+      if (constraints.maxValueExclusive !== undefined) {
+        const maxValue = isInteger ? Math.ceil(constraints.maxValueExclusive) : constraints.maxValueExclusive;
+        text += `${indent.get()}validateMaxValueExclusive(cv, ${path}, ${value}, ${maxValue})\n`;
+      }
+      break;
+    }
+    case 'slice':
+      if (constraints.minItems !== undefined) {
+        // an omitted slice is distinct from an empty one
+        text += `${indent.get()}if ${value} != nil {\n`;
+        text += `${indent.push().get()}validateMinItems(cv, ${path}, len(${value}), ${constraints.minItems})\n`;
+        text += `${indent.pop().get()}}\n`;
+      }
+      if (constraints.maxItems !== undefined) {
+        text += `${indent.get()}validateMaxItems(cv, ${path}, len(${value}), ${constraints.maxItems})\n`;
+      }
+      break;
+    case 'string':
+      if (constraints.minLength !== undefined) {
+        text += `${indent.get()}validateMinLength(cv, ${path}, ${value}, ${constraints.minLength})\n`;
+      }
+      if (constraints.maxLength !== undefined) {
+        text += `${indent.get()}validateMaxLength(cv, ${path}, ${value}, ${constraints.maxLength})\n`;
+      }
+      if (constraints.pattern !== undefined) {
+        text += `${indent.get()}validatePattern(cv, ${path}, ${value}, ${quotePattern(constraints.pattern)})\n`;
+      }
+      break;
+  }
+  return text;
+}
+
+/**
+ * returns the pattern as a Go string literal.
+ * raw strings are used unless the pattern contains a backtick.
+ *
+ * @param pattern the pattern to quote
+ * @returns the Go string literal
+ */
+function quotePattern(pattern: string): string {
+  if (!pattern.includes('`')) {
+    return `\`${pattern}\``;
+  }
+  return JSON.stringify(pattern);
+}
@@ -4,24 +4,38 @@
  *--------------------------------------------------------------------------------------------*/
 
 import * as go from '../../codemodel.go/src/index.js';
+import { generateAPIVersionChecks } from './core/apiVersions.js';
 import { generateClientFactory } from './core/clientFactory.js';
 import { generateCloudConfig } from './core/cloudConfig.js';
 import { generateConstants } from './core/constants.js';
+import { generateDurationHelpers } from './core/duration.js';
 import { generateExamples } from './core/example.js';
+import { generateFakeTests } from './core/fakeTests.js';
 import { generateGoModFile } from './core/gomod.js';
 import { setCustomHeaderText } from './core/helpers.js';
 import { generateInterfaces } from './core/interfaces.js';
+import { generateKeyCredentialHelpers } from './core/keyCredentials.js';
 import { generateLicenseTxt } from './core/license.js';
 import { generateMetadataFile } from './core/metadata.js';
 import { generateModels } from './core/models.js';
 import { generateOperations } from './core/operations.js';
 import { generateOptions } from './core/options.js';
+import { generatePollerHelpers } from './core/pollers.js';
 import { generatePolymorphicHelpers } from './core/polymorphics.js';
 import { generateResponses } from './core/responses.js';
+import { generateSerDeBenchmarks } from './core/serdeBenchmarks.js';
+import { generateServiceErrors } from './core/serviceErrors.js';
+import { generateTelemetryHelpers } from './core/telemetry.js';
+import { generateStreamHelpers } from './core/streams.js';
+import { generateUnions } from './core/unions.js';
+import { generateValidation } from './core/validation.js';
 import { generateVersionInfo } from './core/version.js';
 import { generateXMLAdditionalPropsHelpers } from './core/xmlAdditionalProps.js';
 import { generateServers } from './fake/servers.js';
+import { generateServiceErrorHelpers } from './fake/serviceErrors.js';
 import { generateServerFactory } from './fake/factory.js';
+import { generateInMemoryServer } from './fake/inmemory.js';
+import { generateRecordingOperations, generateRecordingTransport } from './recording/transport.js';
 
 /** abstractions over various file handling facilities */
 export interface FsFacilities {
@@ -125,6 +139,14 @@ export class Emitter {
         await write('polymorphic_helpers.go', polymorphics);
       }
 
+      const unions = generateUnions(pkg);
+      if (unions.unions.length > 0) {
+        await write('unions.go', unions.unions);
+      }
+      if (unions.serDe.length > 0) {
+        await write('unions_serde.go', unions.serDe);
+      }
+
       const responses = generateResponses(pkg, this.codeModel.options);
       if (responses.responses.length > 0) {
         await write('responses.go', responses.responses);
@@ -138,28 +160,93 @@ export class Emitter {
         await write('xml_helper.go', xmlAddlProps);
       }
 
+      const durationHelpers = generateDurationHelpers(pkg);
+      if (durationHelpers.length > 0) {
+        await write('duration_helper.go', durationHelpers);
+      }
+
+      const streamHelpers = generateStreamHelpers(pkg);
+      if (streamHelpers.length > 0) {
+        await write('stream_helper.go', streamHelpers);
+      }
+
+      const keyCredentialHelpers = generateKeyCredentialHelpers(pkg);
+      if (keyCredentialHelpers.length > 0) {
+        await write('key_credential_helper.go', keyCredentialHelpers);
+      }
+
+      const pollerHelpers = generatePollerHelpers(pkg);
+      if (pollerHelpers.length > 0) {
+        await write('poller_helper.go', pollerHelpers);
+      }
+
+      const telemetryHelpers = generateTelemetryHelpers(pkg, this.codeModel.type, this.codeModel.options);
+      if (telemetryHelpers.length > 0) {
+        await write('telemetry.go', telemetryHelpers);
+      }
+
+      const apiVersionChecks = generateAPIVersionChecks(pkg);
+      if (apiVersionChecks.length > 0) {
+        await write('api_versions.go', apiVersionChecks);
+      }
+
+      const serviceErrors = generateServiceErrors(pkg);
+      if (serviceErrors.length > 0) {
+        await write('service_errors.go', serviceErrors);
+      }
+
+      const validation = generateValidation(pkg);
+      if (validation.length > 0) {
+        await write('validation.go', validation);
+      }
+
       if (this.codeModel.options.generateFakes) {
         const fakePkg = new go.FakePackage(pkg);
-        const serverContent = generateServers(fakePkg, this.codeModel.type);
+        const serverContent = generateServers(
+          fakePkg,
+          this.codeModel.type,
+          this.codeModel.options.generateFakeHandlers,
+          this.codeModel.options.validateFakeRequests,
+          this.codeModel.options.pagerIterators,
+        );
         if (serverContent.servers.length > 0) {
           for (const op of serverContent.servers) {
             const fileName = `${snakeClientFileName(op.name, 'server')}.go`;
             await write(fileName, op.content, fakePkg.kind);
           }
 
-          const serverFactory = generateServerFactory(fakePkg, this.codeModel.type);
+          // the in-memory server is built on the server factory which hooks into its store
+          const inMemoryServer = this.codeModel.options.generateInMemoryFakes ? generateInMemoryServer(fakePkg, this.codeModel.type) : '';
+          const serverFactory = generateServerFactory(fakePkg, this.codeModel.type, this.codeModel.options.generateFakeHandlers, inMemoryServer.length > 0);
           if (serverFactory.length > 0) {
             await write('server_factory.go', serverFactory, fakePkg.kind);
+            if (inMemoryServer.length > 0) {
+              await write('in_memory_server.go', inMemoryServer, fakePkg.kind);
+            }
           }
 
           await write('internal.go', serverContent.internals, fakePkg.kind);
 
+          const serviceErrorHelpers = generateServiceErrorHelpers(fakePkg);
+          if (serviceErrorHelpers.length > 0) {
+            await write('service_errors.go', serviceErrorHelpers, fakePkg.kind);
+          }
+
           const polymorphics = generatePolymorphicHelpers(fakePkg);
           if (polymorphics.length > 0) {
             await write('polymorphic_helpers.go', polymorphics, fakePkg.kind);
           }
         }
       }
+
+      if (this.codeModel.options.generateRecording) {
+        const recordingPkg = new go.RecordingPackage(pkg);
+        const operations = generateRecordingOperations(recordingPkg);
+        if (operations.length > 0) {
+          await write('operations.go', operations, recordingPkg.kind);
+          await write('transport.go', generateRecordingTransport(recordingPkg), recordingPkg.kind);
+        }
+      }
     });
 
     // only one version.go file per module
@@ -198,6 +285,34 @@ export class Emitter {
     });
   }
 
+  /** writes the *_fake_test.go files */
+  async emitFakeTests(): Promise<void> {
+    if (!this.codeModel.options.generateFakeTests) {
+      return;
+    }
+
+    await this.recursiveEmit(async (pkg: go.PackageContent, write: (name: string, content: string) => Promise<void>): Promise<void> => {
+      const fakeTests = generateFakeTests(new go.TestPackage(pkg), this.codeModel.type, this.codeModel.options);
+      for (const fakeTest of fakeTests) {
+        await write(`${snakeClientFileName(fakeTest.name)}_fake_test.go`, fakeTest.content);
+      }
+    });
+  }
+
+  /** writes the models_serde_bench_test.go files */
+  async emitSerDeBenchmarks(): Promise<void> {
+    if (!this.codeModel.options.generateSerDeBenchmarks) {
+      return;
+    }
+
+    await this.recursiveEmit(async (pkg: go.PackageContent, write: (name: string, content: string) => Promise<void>): Promise<void> => {
+      const benchmarks = generateSerDeBenchmarks(new go.TestPackage(pkg));
+      if (benchmarks.length > 0) {
+        await write('models_serde_bench_test.go', benchmarks);
+      }
+    });
+  }
+
   /** writes the LICENSE.txt file */
   async emitLicenseFile(): Promise<void> {
     if (this.codeModel.root.kind !== 'module') {
@@ -329,7 +444,7 @@ function sortContent(pkg: go.PackageContent): void {
     return sortAscending(a.name, b.name);
   });
   for (const respEnv of pkg.responseEnvelopes) {
-    respEnv.headers.sort((a: go.HeaderScalarResponse | go.HeaderMapResponse, b: go.HeaderScalarResponse | go.HeaderMapResponse) => {
+    respEnv.headers.sort((a: go.HeaderResponse, b: go.HeaderResponse) => {
       return sortAscending(a.fieldName, b.fieldName);
     });
   }
@@ -4,7 +4,7 @@
  *--------------------------------------------------------------------------------------------*/
 
 import * as go from '../../../codemodel.go/src/index.js';
-import { getServerName } from './servers.js';
+import { getServerName, getServerRoutesVarName } from './servers.js';
 import * as helpers from '../core/helpers.js';
 import { ImportManager } from '../core/imports.js';
 
@@ -13,9 +13,11 @@ import { ImportManager } from '../core/imports.js';
  *
  * @param pkg contains the package content
  * @param target the codegen target for the module
+ * @param generateHandlers when true, an http.Handler adapter is emitted for the server factory
+ * @param inMemory when true, the server factory hooks into the store used by NewInMemoryServer
  * @returns the text for the file or the empty string
  */
-export function generateServerFactory(pkg: go.FakePackage, target: go.CodeModelType): string {
+export function generateServerFactory(pkg: go.FakePackage, target: go.CodeModelType, generateHandlers: boolean, inMemory: boolean): string {
   // generate server factory only for ARM
   if (target !== 'azure-arm' || pkg.parent.clients.length === 0) {
     return '';
@@ -51,6 +53,10 @@ export function generateServerFactory(pkg: go.FakePackage, target: go.CodeModelT
     text += `${indent.get()}${serverName} ${serverName}\n\n`;
     finalSubClients.push(client);
   }
+  if (inMemory) {
+    text += `${indent.get()}// store contains the resources when created by NewInMemoryServer\n`;
+    text += `${indent.get()}store *inMemoryStore\n`;
+  }
   text += '}\n\n';
 
   text += '// NewServerFactoryTransport creates a new instance of ServerFactoryTransport with the provided implementation.\n';
@@ -59,6 +65,20 @@ export function generateServerFactory(pkg: go.FakePackage, target: go.CodeModelT
   text += 'func NewServerFactoryTransport(srv *ServerFactory) *ServerFactoryTransport {\n';
   text += `${indent.get()}return &ServerFactoryTransport{\n${indent.push().get()}srv: srv,\n${indent.pop().get()}}\n}\n\n`;
 
+  if (generateHandlers) {
+    const args = ['NewServerFactoryTransport(srv)'];
+    for (const client of finalSubClients) {
+      const varName = getServerRoutesVarName(client);
+      if (varName) {
+        args.push(varName);
+      }
+    }
+    text += '// NewServerFactoryHandler creates an http.Handler that serves the fakes in srv over HTTP.\n';
+    text += '// Requests are dispatched through ServerFactoryTransport so pagers and pollers behave the same as in-process.\n';
+    text += 'func NewServerFactoryHandler(srv *ServerFactory) http.Handler {\n';
+    text += `${indent.get()}return newTransportHandler(${args.join(', ')})\n}\n\n`;
+  }
+
   text += `// ServerFactoryTransport connects instances of ${clientPkgName}.ClientFactory to instances of ServerFactory.\n`;
   text += "// Don't use this type directly, use NewServerFactoryTransport instead.\n";
   text += 'type ServerFactoryTransport struct {\n';
@@ -87,6 +107,11 @@ export function generateServerFactory(pkg: go.FakePackage, target: go.CodeModelT
   }
   text += `${indent.get()}default:\n${indent.push().get()}err = fmt.Errorf("unhandled client %s", client)\n`;
   text += `${indent.pop().get()}}\n\n`;
+  if (inMemory) {
+    text += `${indent.get()}if s.srv.store != nil {\n`;
+    text += `${indent.push().get()}resp, err = s.srv.store.handleResponse(req, resp, err)\n`;
+    text += `${indent.pop().get()}}\n\n`;
+  }
   text += `${indent.get()}${helpers.buildErrCheck(indent, 'err', 'nil')}\n\n`;
   text += `${indent.get()}return resp, nil\n}\n\n`;
   return text;
@@ -0,0 +1,641 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as naming from '../../../naming.go/src/naming.js';
+import * as helpers from '../core/helpers.js';
+import { ImportManager } from '../core/imports.js';
+import { fixUpMethodName } from '../core/operations.js';
+import { getAPIParametersSig, getMethodStatusCodes, getServerName } from './servers.js';
+
+/** the resource operations the in-memory server knows how to emulate */
+type ResourceOperation = 'put' | 'patch' | 'get' | 'delete' | 'list';
+
+/** a method that's backed by the in-memory store */
+interface InMemoryMethod {
+  method: go.MethodType;
+  operation: ResourceOperation;
+
+  /** the Go expression that builds the resource ID (or list path) */
+  path: string;
+}
+
+/**
+ * Generates the contents for the in_memory_server.go file.
+ *
+ * @param pkg contains the package content
+ * @param target the codegen target for the module
+ * @returns the text for the file or the empty string
+ */
+export function generateInMemoryServer(pkg: go.FakePackage, target: go.CodeModelType): string {
+  // the in-memory server is built on the server factory which is ARM only
+  if (target !== 'azure-arm' || pkg.parent.clients.length === 0) {
+    return '';
+  }
+
+  const imports = new ImportManager(pkg);
+  const indent = new helpers.Indentation();
+
+  // client params that are part of resource IDs (e.g. the subscription ID) become params to NewInMemoryServer
+  const clientParams = new Array<go.PathScalarParameter>();
+  let servers = '';
+
+  for (const client of pkg.parent.clients) {
+    if (client.clientAccessors.length === 0 && helpers.clientHasNoExportedMethods(client)) {
+      // matches the clients in the server factory
+      continue;
+    }
+
+    const inMemoryMethods = new Array<InMemoryMethod>();
+    for (const method of client.methods) {
+      if (helpers.isMethodInternal(method)) {
+        continue;
+      }
+      const inMemoryMethod = getInMemoryMethod(method, clientParams);
+      if (inMemoryMethod) {
+        inMemoryMethods.push(inMemoryMethod);
+      }
+    }
+
+    if (inMemoryMethods.length === 0) {
+      continue;
+    }
+
+    const serverName = getServerName(client);
+    indent.push().push();
+    servers += `${indent.get()}${serverName}: ${serverName}{\n`;
+    indent.push();
+    for (const inMemoryMethod of inMemoryMethods) {
+      servers += emitInMemoryMethod(pkg, inMemoryMethod, imports, indent);
+    }
+    indent.pop();
+    servers += `${indent.get()}},\n`;
+    indent.pop().pop();
+  }
+
+  if (servers.length === 0) {
+    return '';
+  }
+
+  imports.addForPkg(pkg.parent);
+  imports.add('bytes');
+  imports.add('encoding/json');
+  imports.add('errors');
+  imports.add('fmt');
+  imports.add('io');
+  imports.add('net/http');
+  imports.add('slices');
+  imports.add('strings');
+  imports.add('sync');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/fake', 'azfake');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/fake/server');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
+
+  const ctorParams = new Array<string>();
+  for (const clientParam of clientParams) {
+    ctorParams.push(`${naming.uncapitalize(clientParam.name)} string`);
+  }
+
+  let text = helpers.contentPreamble(pkg);
+  text += imports.text();
+
+  text += '// NewInMemoryServer creates a ServerFactory whose fakes share an in-memory store of resources keyed by resource ID.\n';
+  text += '// Resources created with PUT can be read, patched, listed, and deleted through any client in the factory. Unknown\n';
+  text += '// resource IDs return a ResourceNotFound error and creating a child resource whose parent is unknown returns a\n';
+  text += '// ParentResourceNotFound error. Errors contain an ARM error response body. Long-running operations complete after\n';
+  text += '// one non-terminal response. Until then, resources created or updated by them have a provisioning state of Accepted\n';
+  text += "// or Updating. Fakes for methods that don't operate on a resource are left nil and, like any other fake, can be\n";
+  text += '// replaced on the returned value.\n';
+  for (const clientParam of clientParams) {
+    const paramName = naming.uncapitalize(clientParam.name);
+    text += `//   - ${paramName} - the value of the ${paramName} parameter used to construct the clients\n`;
+  }
+  text += `func NewInMemoryServer(${ctorParams.join(', ')}) *ServerFactory {\n`;
+  text += `${indent.get()}store := newInMemoryStore()\n`;
+  text += `${indent.get()}return &ServerFactory{\n`;
+  text += `${indent.push().get()}store: store,\n`;
+  indent.pop();
+  text += servers;
+  text += `${indent.get()}}\n}\n\n`;
+  text += inMemoryStoreHelpers;
+  return text;
+}
+
+/**
+ * returns the in-memory implementation details for method or undefined
+ * if the method doesn't map to an operation on a resource.
+ *
+ * @param method the method to inspect
+ * @param clientParams the client params used to construct resource IDs. new ones are appended
+ * @returns the in-memory method or undefined
+ */
+function getInMemoryMethod(method: go.MethodType, clientParams: Array<go.PathScalarParameter>): InMemoryMethod | undefined {
+  if (!method.httpPath.includes('/providers/')) {
+    return undefined;
+  }
+
+  const isItemPath = method.httpPath.endsWith('}');
+  let operation: ResourceOperation | undefined;
+  switch (method.httpMethod) {
+    case 'put':
+    case 'patch':
+      if (isItemPath && (method.kind === 'method' || method.kind === 'lroMethod') && method.returns.result?.kind === 'modelResult' && getBodyParam(method)) {
+        operation = method.httpMethod;
+      }
+      break;
+    case 'get':
+      if (isItemPath && method.kind === 'method' && method.returns.result?.kind === 'modelResult') {
+        operation = 'get';
+      } else if (!isItemPath && method.kind === 'pageableMethod' && getItemsField(method)) {
+        operation = 'list';
+      }
+      break;
+    case 'delete':
+      if (isItemPath && (method.kind === 'method' || method.kind === 'lroMethod') && !method.returns.result) {
+        operation = 'delete';
+      }
+      break;
+  }
+
+  if (!operation) {
+    return undefined;
+  }
+
+  // build the resource ID from the path template
+  const segments = new Array<string>();
+  const newClientParams = new Array<go.PathScalarParameter>();
+  for (const part of method.httpPath.split(/(\{[^}]+\})/)) {
+    if (part.length === 0) {
+      continue;
+    } else if (!part.startsWith('{')) {
+      segments.push(`"${part}"`);
+      continue;
+    }
+
+    const pathSegment = part.substring(1, part.length - 1);
+    const pathParam = method.parameters.find((each) => each.kind === 'pathScalarParam' && each.pathSegment === pathSegment);
+    if (!pathParam || pathParam.kind !== 'pathScalarParam' || pathParam.group) {
+      // path collections and grouped params aren't supported
+      return undefined;
+    }
+
+    const paramName = naming.uncapitalize(pathParam.name);
+    switch (pathParam.type.kind) {
+      case 'string':
+        segments.push(paramName);
+        break;
+      case 'constant':
+        if (pathParam.type.type !== 'string' || pathParam.location === 'client') {
+          return undefined;
+        }
+        segments.push(`string(${paramName})`);
+        break;
+      default:
+        return undefined;
+    }
+
+    if (pathParam.location === 'client' && !clientParams.concat(newClientParams).find((each) => each.name === pathParam.name)) {
+      newClientParams.push(pathParam);
+    }
+  }
+
+  clientParams.push(...newClientParams);
+  return { method, operation, path: segments.join(' + ') };
+}
+
+/** returns the body param for method or undefined */
+function getBodyParam(method: go.MethodType): go.BodyParameter | undefined {
+  for (const param of method.parameters) {
+    if (param.kind === 'bodyParam' && !param.group) {
+      return param;
+    }
+  }
+  return undefined;
+}
+
+/** returns the field within the page that contains the resources or undefined */
+function getItemsField(method: go.MethodType): go.ModelField | undefined {
+  if (method.returns.result?.kind !== 'modelResult') {
+    return undefined;
+  }
+  for (const field of method.returns.result.modelType.fields) {
+    if (field.serializedName === 'value' && field.type.kind === 'slice' && field.type.elementType.kind === 'model' && !field.type.elementTypeByValue) {
+      return field;
+    }
+  }
+  return undefined;
+}
+
+/** returns true if the model has a properties.provisioningState field */
+function hasProvisioningState(model: go.Model | go.PolymorphicModel): boolean {
+  const properties = model.fields.find((field) => field.serializedName === 'properties');
+  if (!properties || properties.type.kind !== 'model') {
+    return false;
+  }
+  return properties.type.fields.find((field) => field.serializedName === 'provisioningState') !== undefined;
+}
+
+/** returns the first status code in preferred that's a success code for method or undefined */
+function pickStatusCode(method: go.MethodType, preferred: Array<number>): number | undefined {
+  const statusCodes = getMethodStatusCodes(method);
+  return preferred.find((statusCode) => statusCodes.includes(statusCode));
+}
+
+function emitInMemoryMethod(pkg: go.FakePackage, inMemoryMethod: InMemoryMethod, imports: ImportManager, indent: helpers.Indentation): string {
+  const method = inMemoryMethod.method;
+  const respType = go.getTypeDeclaration(method.returns, pkg);
+  const isLRO = method.kind === 'lroMethod';
+
+  let serverResponse: string;
+  switch (method.kind) {
+    case 'lroMethod':
+      serverResponse = `resp azfake.PollerResponder[${respType}], errResp azfake.ErrorResponder`;
+      break;
+    case 'pageableMethod':
+      serverResponse = `resp azfake.PagerResponder[${respType}]`;
+      break;
+    default:
+      serverResponse = `resp azfake.Responder[${respType}], errResp azfake.ErrorResponder`;
+  }
+
+  // sets the successful response for a sync method or the terminal response for an LRO
+  const setResponse = function (statusCode: number, value: string): string {
+    if (isLRO) {
+      return `${indent.get()}resp.SetTerminalResponse(${helpers.formatStatusCode(statusCode)}, ${value}, nil)\n`;
+    }
+    return `${indent.get()}resp.SetResponse(${helpers.formatStatusCode(statusCode)}, ${value}, nil)\n`;
+  };
+
+  // adds the non-terminal response for an LRO
+  const addNonTerminal = function (preferred: Array<number>): string {
+    if (!isLRO) {
+      return '';
+    }
+    const statusCode = pickStatusCode(method, preferred);
+    if (!statusCode) {
+      return '';
+    }
+    return `${indent.get()}resp.AddNonTerminalResponse(${helpers.formatStatusCode(statusCode)}, nil)\n`;
+  };
+
+  let text = `${indent.get()}${fixUpMethodName(method)}: func(${getAPIParametersSig(pkg, method, imports)}) (${serverResponse}) {\n`;
+  indent.push();
+  const setError = `${indent.get()}if err != nil {\n${indent.push().get()}errResp.SetError(err)\n${indent.get()}return\n${indent.pop().get()}`;
+  const notFound = `} else if !found {\n${indent.push().get()}errResp.SetError(newResourceNotFoundError(id))\n${indent.get()}return\n${indent.pop().get()}}\n`;
+  const pathVar = inMemoryMethod.operation === 'list' ? 'listPath' : 'id';
+  text += `${indent.get()}${pathVar} := ${inMemoryMethod.path}\n`;
+
+  switch (inMemoryMethod.operation) {
+    case 'put':
+    case 'patch':
+    case 'get': {
+      const result = <go.ModelResult>method.returns.result;
+      const modelType = go.getTypeDeclaration(result.modelType, pkg);
+      // the provisioning state stored for the resource. LROs report Succeeded once they complete
+      let provisioningState = '';
+      if (hasProvisioningState(result.modelType)) {
+        provisioningState = !isLRO ? 'Succeeded' : inMemoryMethod.operation === 'put' ? 'Accepted' : 'Updating';
+      }
+      const statusCode = pickStatusCode(method, isLRO ? [200] : [200, 201]) ?? 200;
+      const value = `${respType}{${result.modelType.name}: result}`;
+      if (inMemoryMethod.operation === 'put') {
+        const bodyParam = <go.BodyParameter>getBodyParam(method);
+        text += `${indent.get()}result, err := putResource[${modelType}](store, id, ${naming.uncapitalize(bodyParam.name)}, "${provisioningState}")\n`;
+        text += `${setError}}\n`;
+        text += addNonTerminal([201, 202]);
+      } else {
+        if (inMemoryMethod.operation === 'patch') {
+          const bodyParam = <go.BodyParameter>getBodyParam(method);
+          text += `${indent.get()}result, found, err := patchResource[${modelType}](store, id, ${naming.uncapitalize(bodyParam.name)}, "${provisioningState}")\n`;
+        } else {
+          text += `${indent.get()}result, found, err := getResource[${modelType}](store, id)\n`;
+        }
+        text += setError;
+        text += notFound;
+        text += addNonTerminal([202]);
+      }
+      text += setResponse(statusCode, value);
+      break;
+    }
+    case 'delete': {
+      const noContent = pickStatusCode(method, [204]);
+      text += `${indent.get()}if !deleteResource(store, id) {\n`;
+      indent.push();
+      if (noContent) {
+        text += setResponse(noContent, `${respType}{}`);
+      } else {
+        text += `${indent.get()}errResp.SetError(newResourceNotFoundError(id))\n`;
+      }
+      text += `${indent.get()}return\n`;
+      text += `${indent.pop().get()}}\n`;
+      text += addNonTerminal([202]);
+      text += setResponse(pickStatusCode(method, [200, 204]) ?? 200, `${respType}{}`);
+      break;
+    }
+    case 'list': {
+      const result = <go.ModelResult>method.returns.result;
+      const itemsField = <go.ModelField>getItemsField(method);
+      const itemType = go.getTypeDeclaration((<go.Slice>itemsField.type).elementType, pkg);
+      const pageType = go.getTypeDeclaration(result.modelType, pkg);
+      text += `${indent.get()}items, err := listResources[${itemType}](store, listPath)\n`;
+      text += `${indent.get()}if err != nil {\n${indent.push().get()}resp.AddError(err)\n${indent.get()}return\n${indent.pop().get()}}\n`;
+      text += `${indent.get()}resp.AddPage(http.StatusOK, ${respType}{${result.modelType.name}: ${pageType}{${itemsField.name}: items}}, nil)\n`;
+      break;
+    }
+  }
+
+  text += `${indent.get()}return\n`;
+  text += `${indent.pop().get()}},\n`;
+  return text;
+}
+
+const inMemoryStoreHelpers = `type inMemoryStore struct {
+	mu        sync.Mutex
+	resources map[string]map[string]any
+}
+
+func newInMemoryStore() *inMemoryStore {
+	return &inMemoryStore{
+		resources: map[string]map[string]any{},
+	}
+}
+
+// handleResponse is called by ServerFactoryTransport for every request. It completes the provisioning
+// of a resource once the terminal response of its long-running operation is returned and converts
+// resource errors to responses with an ARM error body.
+func (store *inMemoryStore) handleResponse(req *http.Request, resp *http.Response, err error) (*http.Response, error) {
+	var resErr *resourceError
+	if errors.As(err, &resErr) {
+		return nil, resErr.toResponseError(req)
+	} else if err != nil {
+		return nil, err
+	}
+	if resp.Header.Get("Fake-Poller-Status") == "Succeeded" {
+		completeProvisioning(store, server.SanitizePagerPollerPath(req.URL.Path))
+	}
+	return resp, nil
+}
+
+// resourceError is an error for a resource in the in-memory store.
+type resourceError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func newResourceNotFoundError(id string) *resourceError {
+	return &resourceError{
+		statusCode: http.StatusNotFound,
+		code:       "ResourceNotFound",
+		message:    fmt.Sprintf("The resource %s was not found.", id),
+	}
+}
+
+func (e *resourceError) Error() string {
+	return e.code + ": " + e.message
+}
+
+// toResponseError returns a non-retriable *azcore.ResponseError with an ARM error body.
+func (e *resourceError) toResponseError(req *http.Request) error {
+	body, err := json.Marshal(map[string]any{
+		"error": map[string]string{
+			"code":    e.code,
+			"message": e.message,
+		},
+	})
+	if err != nil {
+		return nonRetriableError{err}
+	}
+	resp := &http.Response{
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Request:    req,
+		Status:     fmt.Sprintf("%d %s", e.statusCode, http.StatusText(e.statusCode)),
+		StatusCode: e.statusCode,
+	}
+	return nonRetriableResponseError{runtime.NewResponseError(resp).(*azcore.ResponseError)}
+}
+
+// nonRetriableResponseError is an *azcore.ResponseError that isn't retried.
+type nonRetriableResponseError struct {
+	*azcore.ResponseError
+}
+
+func (nonRetriableResponseError) NonRetriable() {
+	// marker method
+}
+
+func (e nonRetriableResponseError) Unwrap() error {
+	return e.ResponseError
+}
+
+func putResource[T any](store *inMemoryStore, id string, resource any, provisioningState string) (T, error) {
+	var result T
+	raw, err := toResourceMap(resource)
+	if err != nil {
+		return result, err
+	}
+	raw["id"] = id
+	raw["name"] = id[strings.LastIndex(id, "/")+1:]
+	raw["type"] = resourceTypeFromID(id)
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if parentID := parentResourceID(id); parentID != "" {
+		if _, ok := store.resources[strings.ToLower(parentID)]; !ok {
+			return result, &resourceError{
+				statusCode: http.StatusNotFound,
+				code:       "ParentResourceNotFound",
+				message:    fmt.Sprintf("The parent resource %s was not found.", parentID),
+			}
+		}
+	}
+	store.resources[strings.ToLower(id)] = raw
+	return fromProvisionedResourceMap[T](raw, provisioningState)
+}
+
+func getResource[T any](store *inMemoryStore, id string) (T, bool, error) {
+	var result T
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	raw, ok := store.resources[strings.ToLower(id)]
+	if !ok {
+		return result, false, nil
+	}
+	result, err := fromResourceMap[T](raw)
+	return result, true, err
+}
+
+func patchResource[T any](store *inMemoryStore, id string, patch any, provisioningState string) (T, bool, error) {
+	var result T
+	rawPatch, err := toResourceMap(patch)
+	if err != nil {
+		return result, false, err
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	raw, ok := store.resources[strings.ToLower(id)]
+	if !ok {
+		return result, false, nil
+	}
+	mergePatch(raw, rawPatch)
+	result, err = fromProvisionedResourceMap[T](raw, provisioningState)
+	return result, true, err
+}
+
+// fromProvisionedResourceMap sets the provisioning state of raw and returns the resource as it
+// is once provisioning completes. an empty provisioning state means the resource doesn't have one.
+func fromProvisionedResourceMap[T any](raw map[string]any, provisioningState string) (T, error) {
+	if provisioningState == "" {
+		return fromResourceMap[T](raw)
+	}
+	setProvisioningState(raw, "Succeeded")
+	result, err := fromResourceMap[T](raw)
+	setProvisioningState(raw, provisioningState)
+	return result, err
+}
+
+// completeProvisioning sets the provisioning state of the resource to Succeeded if it has one.
+func completeProvisioning(store *inMemoryStore, id string) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	raw, ok := store.resources[strings.ToLower(id)]
+	if !ok {
+		return
+	}
+	if properties, ok := raw["properties"].(map[string]any); ok {
+		if _, ok := properties["provisioningState"]; ok {
+			properties["provisioningState"] = "Succeeded"
+		}
+	}
+}
+
+func deleteResource(store *inMemoryStore, id string) bool {
+	key := strings.ToLower(id)
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if _, ok := store.resources[key]; !ok {
+		return false
+	}
+	for k := range store.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(store.resources, k)
+		}
+	}
+	return true
+}
+
+func listResources[T any](store *inMemoryStore, listPath string) ([]*T, error) {
+	scope, typePath := splitResourceID(strings.ToLower(listPath))
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	var ids []string
+	for k := range store.resources {
+		itemScope, itemPath := splitResourceID(k)
+		if i := strings.LastIndex(itemPath, "/"); i < 0 || itemPath[:i] != typePath {
+			continue
+		}
+		if itemScope == scope || strings.HasPrefix(itemScope, scope+"/") {
+			ids = append(ids, k)
+		}
+	}
+	slices.Sort(ids)
+	items := make([]*T, 0, len(ids))
+	for _, id := range ids {
+		item, err := fromResourceMap[T](store.resources[id])
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+	}
+	return items, nil
+}
+
+func splitResourceID(id string) (string, string) {
+	const providers = "/providers/"
+	i := strings.LastIndex(strings.ToLower(id), providers)
+	if i < 0 {
+		return id, ""
+	}
+	return id[:i], id[i+len(providers):]
+}
+
+// parentResourceID returns the ID of the parent of a child resource or the empty string
+func parentResourceID(id string) string {
+	scope, path := splitResourceID(id)
+	segments := strings.Split(path, "/")
+	// a top-level resource is namespace/type/name
+	if len(segments) <= 3 {
+		return ""
+	}
+	return scope + "/providers/" + strings.Join(segments[:len(segments)-2], "/")
+}
+
+func resourceTypeFromID(id string) string {
+	_, path := splitResourceID(id)
+	segments := strings.Split(path, "/")
+	if len(segments) == 0 {
+		return ""
+	}
+	resourceType := segments[0]
+	for i := 1; i < len(segments); i += 2 {
+		resourceType += "/" + segments[i]
+	}
+	return resourceType
+}
+
+func setProvisioningState(raw map[string]any, provisioningState string) {
+	properties, ok := raw["properties"].(map[string]any)
+	if !ok {
+		properties = map[string]any{}
+		raw["properties"] = properties
+	}
+	properties["provisioningState"] = provisioningState
+}
+
+func mergePatch(dst, patch map[string]any) {
+	for k, v := range patch {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		if patchValue, ok := v.(map[string]any); ok {
+			if dstValue, ok := dst[k].(map[string]any); ok {
+				mergePatch(dstValue, patchValue)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+func toResourceMap(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	raw := map[string]any{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+func fromResourceMap[T any](raw map[string]any) (T, error) {
+	var result T
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return result, err
+	}
+	err = json.Unmarshal(data, &result)
+	return result, err
+}
+`;
@@ -4,28 +4,43 @@
  *--------------------------------------------------------------------------------------------*/
 
 import * as go from '../../../codemodel.go/src/index.js';
+import * as naming from '../../../naming.go/src/naming.js';
+import { emitDurationTypes } from '../core/duration.js';
 import { contentPreamble } from '../core/helpers.js';
 import { ImportManager } from '../core/imports.js';
+import { getEventsUnion, getStreamResults } from '../core/streams.js';
 
 export class RequiredHelpers {
+  durationTypes: boolean;
+  getCookieValue: boolean;
   getHeaderValue: boolean;
   getOptional: boolean;
+  handler: boolean;
   initServer: boolean;
+  nextPageRequest: boolean;
   parseOptional: boolean;
   parseWithCast: boolean;
   readRequestBody: boolean;
   splitHelper: boolean;
+  streams: boolean;
   tracker: boolean;
+  validateRequest: boolean;
 
   constructor() {
+    this.durationTypes = false;
+    this.getCookieValue = false;
     this.getHeaderValue = false;
     this.getOptional = false;
+    this.handler = false;
     this.initServer = false;
+    this.nextPageRequest = false;
     this.parseOptional = false;
     this.parseWithCast = false;
     this.readRequestBody = false;
     this.splitHelper = false;
+    this.streams = false;
     this.tracker = false;
+    this.validateRequest = false;
   }
 }
 
@@ -46,15 +61,27 @@ export function generateServerInternal(pkg: go.FakePackage, requiredHelpers: Req
   let body = alwaysUsed;
   imports.add('net/http');
 
+  if (requiredHelpers.durationTypes) {
+    body += emitDurationTypes(imports);
+  }
+  if (requiredHelpers.getCookieValue) {
+    body += emitGetCookieValue(imports);
+  }
   if (requiredHelpers.getHeaderValue) {
     body += emitGetHeaderValue(imports);
   }
   if (requiredHelpers.getOptional) {
     body += emitGetOptional(imports);
   }
+  if (requiredHelpers.handler) {
+    body += emitHandler(imports);
+  }
   if (requiredHelpers.initServer) {
     body += emitInitServer(imports);
   }
+  if (requiredHelpers.nextPageRequest) {
+    body += emitNextPageRequest(imports);
+  }
   if (requiredHelpers.parseOptional) {
     body += emitParseOptional();
   }
@@ -67,8 +94,14 @@ export function generateServerInternal(pkg: go.FakePackage, requiredHelpers: Req
   if (requiredHelpers.splitHelper) {
     body += emitSplitHelper(imports);
   }
+  if (requiredHelpers.streams) {
+    body += emitStreamHelpers(pkg, imports);
+  }
   if (requiredHelpers.tracker) {
-    body += emitTracker(imports);
+    body += emitTracker(imports, requiredHelpers.handler);
+  }
+  if (requiredHelpers.validateRequest) {
+    body += emitValidateRequest(imports);
   }
 
   return text + imports.text() + body;
@@ -102,6 +135,25 @@ func getOptional[T any](v T) *T {
 `;
 }
 
+function emitGetCookieValue(imports: ImportManager): string {
+  imports.add('net/http');
+  imports.add('net/url');
+  return `
+func getCookieValue(req *http.Request, name string) string {
+	c, err := req.Cookie(name)
+	if err != nil {
+		return ""
+	}
+	// cookie values are percent-encoded by the client
+	v, err := url.PathUnescape(c.Value)
+	if err != nil {
+		return c.Value
+	}
+	return v
+}
+`;
+}
+
 function emitGetHeaderValue(imports: ImportManager): string {
   imports.add('net/http');
   return `
@@ -115,6 +167,127 @@ func getHeaderValue(h http.Header, k string) string {
 `;
 }
 
+function emitHandler(imports: ImportManager): string {
+  imports.add('context');
+  imports.add('errors');
+  imports.add('fmt');
+  imports.add('io');
+  imports.add('net/http');
+  imports.add('net/http/httptest');
+  imports.add('regexp');
+  imports.add('sync');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/fake/server');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/policy');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
+  return `
+// NewTestServer starts a TLS httptest.Server that serves the provided handler.
+// Use the server's URL as the service endpoint and its Client as the
+// azcore.ClientOptions.Transport when constructing clients.
+// Call Close on the returned server to shut it down.
+func NewTestServer(handler http.Handler) *httptest.Server {
+	return httptest.NewTLSServer(handler)
+}
+
+type route struct {
+	method string
+	path   *regexp.Regexp
+	api    string
+	// true for pagers and pollers which send follow-up requests
+	tracked bool
+}
+
+// trackerDoneKey is the context key for the flag that's set
+// when a tracker removes a finished pager or poller
+type trackerDoneKey struct{}
+
+type transportHandler struct {
+	tr     policy.Transporter
+	routes []route
+	mu     sync.Mutex
+	// maps the sanitized path of a pager or poller to its API name
+	inFlight map[string]string
+}
+
+func newTransportHandler(tr policy.Transporter, routes ...[]route) *transportHandler {
+	h := &transportHandler{
+		tr:       tr,
+		inFlight: map[string]string{},
+	}
+	for _, r := range routes {
+		h.routes = append(h.routes, r...)
+	}
+	return h
+}
+
+func (h *transportHandler) apiName(req *http.Request) (string, bool) {
+	path := server.SanitizePagerPollerPath(req.URL.Path)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if path != req.URL.Path {
+		// follow-up request for a pager or poller
+		api, ok := h.inFlight[path]
+		return api, ok
+	}
+	for _, r := range h.routes {
+		if r.method == req.Method && r.path.MatchString(req.URL.EscapedPath()) {
+			if r.tracked {
+				h.inFlight[path] = r.api
+			}
+			return r.api, true
+		}
+	}
+	return "", false
+}
+
+func (h *transportHandler) removeInFlight(req *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.inFlight, server.SanitizePagerPollerPath(req.URL.Path))
+}
+
+// ServeHTTP implements the http.Handler interface for transportHandler.
+func (h *transportHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	api, ok := h.apiName(req)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no fake for %s %s", req.Method, req.URL.Path), http.StatusNotFound)
+		return
+	}
+
+	done := false
+	ctx := context.WithValue(req.Context(), runtime.CtxAPINameKey{}, api)
+	fakeReq := req.Clone(context.WithValue(ctx, trackerDoneKey{}, &done))
+	fakeReq.RequestURI = ""
+	fakeReq.URL.Host = req.Host
+	fakeReq.URL.Scheme = "http"
+	if req.TLS != nil {
+		fakeReq.URL.Scheme = "https"
+	}
+
+	resp, err := h.tr.Do(fakeReq)
+	if done {
+		// the pager or poller has finished so it no longer receives follow-up requests
+		h.removeInFlight(req)
+	}
+	if err != nil {
+		var respErr *azcore.ResponseError
+		if !errors.As(err, &respErr) || respErr.RawResponse == nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp = respErr.RawResponse
+	}
+	defer resp.Body.Close()
+
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+`;
+}
+
 function emitInitServer(imports: ImportManager): string {
   imports.add('sync');
   return `
@@ -128,6 +301,22 @@ func initServer[T any](mu *sync.Mutex, dst **T, src func() *T) {
 `;
 }
 
+function emitNextPageRequest(imports: ImportManager): string {
+  imports.add('net/http');
+  imports.add('net/url');
+  return `
+func nextPageRequest(req *http.Request, nextLink string) (*http.Request, error) {
+	nextURL, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, err
+	}
+	nextReq := req.Clone(req.Context())
+	nextReq.URL = nextURL
+	return nextReq, nil
+}
+`;
+}
+
 function emitParseOptional(): string {
   return `
 func parseOptional[T any](v string, parse func(v string) (T, error)) (*T, error) {
@@ -185,10 +374,169 @@ func splitHelper(s, sep string) []string {
 `;
 }
 
-function emitTracker(imports: ImportManager): string {
+/**
+ * returns the function that encodes the items in the specified stream
+ *
+ * @param stream the stream for which to return the encoder
+ * @param pkg the fake package
+ * @returns the encoder expression
+ */
+export function getStreamEncoder(stream: go.StreamResult, pkg: go.FakePackage): string {
+  switch (stream.format) {
+    case 'JSONL':
+      return `encodeJSONLine[${go.getTypeDeclaration(stream.streamType.itemType, pkg)}]`;
+    case 'SSE':
+      return `encode${naming.capitalize(getEventsUnion(stream).name)}`;
+  }
+}
+
+/**
+ * returns the function that writes the terminal event for the specified stream
+ *
+ * @param stream the stream for which to return the terminator
+ * @returns the terminator function or nil if the stream has no terminal event
+ */
+export function getStreamTerminator(stream: go.StreamResult): string {
+  if (!stream.events.some((event) => event.terminal)) {
+    return 'nil';
+  }
+  return `terminate${naming.capitalize(getEventsUnion(stream).name)}Stream`;
+}
+
+function emitStreamHelpers(pkg: go.FakePackage, imports: ImportManager): string {
+  imports.add('context');
+  imports.add('io');
+  imports.addForPkg(pkg.parent);
+  const streams = getStreamResults(pkg.parent);
+  let text = `
+// newStreamBody returns a response body that streams the items in stream.
+// each item is written with encode as it's yielded by the stream. when
+// the stream is exhausted, end is called (if not nil) to terminate it.
+func newStreamBody[T any](ctx context.Context, stream *${go.getPackageName(pkg.parent)}.EventStream[T], encode func(io.Writer, T) error, end func(io.Writer) error) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		if stream != nil {
+			for item, err := range stream.All(ctx) {
+				if err == nil {
+					err = encode(pw, item)
+				}
+				if err != nil {
+					pw.CloseWithError(err)
+					return
+				}
+			}
+		}
+		if end != nil {
+			if err := end(pw); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.Close()
+	}()
+	return pr
+}
+`;
+
+  if (streams.some((stream) => stream.format === 'JSONL')) {
+    imports.add('encoding/json');
+    text += `
+func encodeJSONLine[T any](w io.Writer, item T) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+`;
+  }
+
+  const sseStreams = streams.filter((stream) => stream.format === 'SSE');
+  if (sseStreams.length === 0) {
+    return text;
+  }
+
+  imports.add('fmt');
+  imports.add('strings');
+  text += `
+func writeServerSentEvent(w io.Writer, eventType, data string) error {
+	var sb strings.Builder
+	sb.WriteString("event: " + eventType + "\n")
+	for line := range strings.SplitSeq(data, "\n") {
+		sb.WriteString("data: " + line + "\n")
+	}
+	sb.WriteString("\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+`;
+
+  for (const stream of sseStreams) {
+    const union = getEventsUnion(stream);
+    text += `
+func ${getStreamEncoder(stream, pkg)}(w io.Writer, item ${go.getTypeDeclaration(union, pkg)}) error {
+`;
+    text += '	switch variant := item.(type) {
+';
+    for (const event of stream.events) {
+      if (event.terminal || !event.variant) {
+        continue;
+      }
+      text += `	case *${go.getPackageName(pkg.parent)}.${event.variant.name}:
+`;
+      if (event.format === 'Text') {
+        text += `		return writeServerSentEvent(w, "${event.name}", variant.Value)
+`;
+      } else {
+        imports.add('encoding/json');
+        text += '		data, err := json.Marshal(variant)
+';
+        text += '		if err != nil {
+';
+        text += '			return err
+';
+        text += '		}
+';
+        text += `		return writeServerSentEvent(w, "${event.name}", string(data))
+`;
+      }
+    }
+    text += '	default:
+';
+    text += '		return fmt.Errorf("unhandled event type %T", item)
+';
+    text += '	}
+';
+    text += '}
+';
+
+    const terminal = stream.events.find((event) => event.terminal);
+    if (terminal) {
+      text += `
+func ${getStreamTerminator(stream)}(w io.Writer) error {
+`;
+      text += `	return writeServerSentEvent(w, "${terminal.name}", ${JSON.stringify(terminal.data ?? '')})
+`;
+      text += '}
+';
+    }
+  }
+  return text;
+}
+
+function emitTracker(imports: ImportManager, handler: boolean): string {
   imports.add('net/http');
   imports.add('sync');
   imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/fake/server');
+  let notifyHandler = '';
+  if (handler) {
+    // lets transportHandler know the pager or poller is finished
+    notifyHandler = `
+	if done, ok := req.Context().Value(trackerDoneKey{}).(*bool); ok {
+		*done = true
+	}`;
+  }
   return `
 func newTracker[T any]() *tracker[T] {
 	return &tracker[T]{
@@ -219,7 +567,102 @@ func (p *tracker[T]) add(req *http.Request, item *T) {
 func (p *tracker[T]) remove(req *http.Request) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	delete(p.items, server.SanitizePagerPollerPath(req.URL.Path))
+	delete(p.items, server.SanitizePagerPollerPath(req.URL.Path))${notifyHandler}
+}
+`;
+}
+
+function emitValidateRequest(imports: ImportManager): string {
+  imports.add('bytes');
+  imports.add('encoding/json');
+  imports.add('fmt');
+  imports.add('io');
+  imports.add('mime');
+  imports.add('net/http');
+  imports.add('slices');
+  imports.add('strings');
+  return `
+// paramContract describes a query or header parameter checked by validateRequest.
+type paramContract struct {
+	name     string
+	required bool
+	values   []string
+}
+
+// requestContract describes the parts of a request checked by validateRequest.
+type requestContract struct {
+	query        []paramContract
+	headers      []paramContract
+	contentType  string
+	bodyRequired bool
+}
+
+// validateRequest checks req against contract before it's dispatched to a fake.
+// if req violates the contract, a 400 response describing the violations is returned.
+func validateRequest(req *http.Request, contract requestContract) *http.Response {
+	var violations []string
+	qp := req.URL.Query()
+	for _, param := range contract.query {
+		violations = append(violations, checkParamContract("query", param, qp[param.name])...)
+	}
+	for _, param := range contract.headers {
+		// clients don't canonicalize header names but HTTP servers do
+		values, ok := req.Header[param.name]
+		if !ok {
+			values = req.Header.Values(param.name)
+		}
+		violations = append(violations, checkParamContract("header", param, values)...)
+	}
+	if contract.contentType != "" {
+		if contentType := req.Header.Get("Content-Type"); contentType == "" {
+			if contract.bodyRequired {
+				violations = append(violations, "missing required header Content-Type")
+			}
+		} else if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || !strings.EqualFold(mediaType, contract.contentType) {
+			violations = append(violations, fmt.Sprintf("invalid Content-Type %q, expected %q", contentType, contract.contentType))
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	const errorCode = "InvalidRequest"
+	body, _ := json.Marshal(map[string]map[string]string{
+		"error": {
+			"code":    errorCode,
+			"message": strings.Join(violations, "; "),
+		},
+	})
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", http.StatusBadRequest, http.StatusText(http.StatusBadRequest)),
+		StatusCode: http.StatusBadRequest,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type":    []string{"application/json"},
+			"X-Ms-Error-Code": []string{errorCode},
+		},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func checkParamContract(location string, param paramContract, values []string) []string {
+	if len(values) == 0 || (len(values) == 1 && values[0] == "") {
+		if param.required {
+			return []string{fmt.Sprintf("missing required %s parameter %s", location, param.name)}
+		}
+		return nil
+	}
+	var violations []string
+	for _, value := range values {
+		if len(param.values) > 0 && !slices.Contains(param.values, value) {
+			violations = append(violations, fmt.Sprintf("invalid value %q for %s parameter %s, expected one of %s", value, location, param.name, strings.Join(param.values, ", ")))
+		}
+	}
+	return violations
 }
 `;
 }
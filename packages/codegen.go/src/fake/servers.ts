@@ -8,7 +8,7 @@ import * as naming from '../../../naming.go/src/naming.js';
 import * as helpers from '../core/helpers.js';
 import { ImportManager } from '../core/imports.js';
 import { fixUpMethodName } from '../core/operations.js';
-import { generateServerInternal, RequiredHelpers } from './internal.js';
+import { generateServerInternal, getStreamEncoder, getStreamTerminator, RequiredHelpers } from './internal.js';
 import { CodegenError } from '../core/errors.js';
 
 // contains the generated content for all servers and the required helpers
@@ -46,9 +46,11 @@ export function getServerName(client: go.Client): string {
  *
  * @param pkg contains the package content
  * @param target the codegen target for the module
+ * @param generateHandlers when true, http.Handler adapters are emitted for the servers
+ * @param pagerIterators when true, a request for the first page of a pager with an iterator restarts the pager
  * @returns the contents to generate or an empty object
  */
-export function generateServers(pkg: go.FakePackage, target: go.CodeModelType): ServerContent {
+export function generateServers(pkg: go.FakePackage, target: go.CodeModelType, generateHandlers: boolean, validateRequests: boolean, pagerIterators: boolean): ServerContent {
   const operations = new Array<OperationGroupContent>();
   for (const client of pkg.parent.clients) {
     if (client.clientAccessors.length === 0 && helpers.clientHasNoExportedMethods(client)) {
@@ -98,25 +100,7 @@ export function generateServers(pkg: go.FakePackage, target: go.CodeModelType):
         continue;
       }
 
-      let serverResponse: string;
-
-      switch (method.kind) {
-        case 'lroMethod':
-        case 'lroPageableMethod':
-          let respType = go.getTypeDeclaration(method.returns, pkg);
-          if (method.kind === 'lroPageableMethod') {
-            respType = `azfake.PagerResponder[${respType}]`;
-          }
-          serverResponse = `resp azfake.PollerResponder[${respType}], errResp azfake.ErrorResponder`;
-          break;
-        case 'method':
-          serverResponse = `resp azfake.Responder[${go.getTypeDeclaration(method.returns, pkg)}], errResp azfake.ErrorResponder`;
-          break;
-        case 'pageableMethod':
-          serverResponse = `resp azfake.PagerResponder[${go.getTypeDeclaration(method.returns, pkg)}]`;
-          break;
-      }
-
+      const serverResponse = getServerResponseSig(pkg, method);
       const operationName = fixUpMethodName(method);
       content += `${indent.get()}// ${operationName} is the fake for method ${client.name}.${operationName}\n`;
       const successCodes = new Array<string>();
@@ -134,6 +118,21 @@ export function generateServers(pkg: go.FakePackage, target: go.CodeModelType):
         for (const successCode of successCodes) {
           content += `${indent.get()}//   - ${successCode}\n`;
         }
+      } else if (method.returns.result?.kind === 'statusCodeResult' && !go.isLROMethod(method)) {
+        // the final result of an LRO is always unmarshalled into the same field
+        for (const httpStatus of getMethodStatusCodes(method)) {
+          const field = method.returns.result.fields.find((f) => f.httpStatusCodes.includes(httpStatus));
+          if (!field) {
+            // the operation contains a mix of schemas and non-schema responses
+            successCodes.push(`${helpers.formatStatusCode(httpStatus)} (no return type)`);
+            continue;
+          }
+          successCodes.push(`${helpers.formatStatusCode(httpStatus)} (populate ${field.fieldName})`);
+        }
+        content += `${indent.get()}// HTTP status codes to indicate success:\n`;
+        for (const successCode of successCodes) {
+          content += `${indent.get()}//   - ${successCode}\n`;
+        }
       } else {
         for (const statusCode of getMethodStatusCodes(method)) {
           successCodes.push(`${helpers.formatStatusCode(statusCode)}`);
@@ -229,13 +228,17 @@ export function generateServers(pkg: go.FakePackage, target: go.CodeModelType):
     content += generateServerTransportDo(serverTransport, client, finalSubClients, finalMethods, indent);
     content += generateServerTransportClientDispatch(serverTransport, finalSubClients, imports, indent);
     content += generateServerTransportMethodDispatch(serverTransport, client, finalMethods, indent);
-    content += generateServerTransportMethods(pkg, serverTransport, finalMethods, imports, indent);
+    content += generateServerTransportMethods(pkg, serverTransport, finalMethods, validateRequests, pagerIterators, imports, indent);
 
     content += `// set this to conditionally intercept incoming requests to ${serverTransport}\n`;
     content += `var ${getTransportInterceptorVarName(client)} interface {\n`;
     content += `${indent.get()}// Do returns true if the server transport should use the returned response/error\n`;
     content += `${indent.get()}Do(*http.Request) (*http.Response, error, bool)\n}\n`;
 
+    if (generateHandlers) {
+      content += generateServerHandler(client, finalMethods, imports, indent);
+    }
+
     ///////////////////////////////////////////////////////////////////////////
 
     // stitch everything together
@@ -257,6 +260,65 @@ function getTransportInterceptorVarName(client: go.Client): string {
   return `${helpers.camelCase(getServerName(client))}TransportInterceptor`;
 }
 
+/**
+ * returns the name of the var that contains the routes for the client's fakes.
+ * clients without exported methods have no routes.
+ *
+ * @param client the client for which to get the routes
+ * @returns the var name or undefined
+ */
+export function getServerRoutesVarName(client: go.Client): string | undefined {
+  if (helpers.clientHasNoExportedMethods(client)) {
+    return undefined;
+  }
+  return `${helpers.camelCase(getServerName(client))}Routes`;
+}
+
+/**
+ * generates the routes that map HTTP requests to the client's methods and
+ * the New<Server>Handler constructor that serves the fakes over HTTP.
+ *
+ * @param client the client for which to generate the handler
+ * @param finalMethods the methods that have fakes
+ * @param imports the import manager currently in scope
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the routes and handler
+ */
+function generateServerHandler(client: go.Client, finalMethods: Array<go.MethodType>, imports: ImportManager, indent: helpers.Indentation): string {
+  requiredHelpers.handler = true;
+  const serverName = getServerName(client);
+  let content = '';
+
+  const routesVarName = getServerRoutesVarName(client);
+  if (routesVarName) {
+    imports.add('regexp');
+    content += `\nvar ${routesVarName} = []route{\n`;
+    for (const method of finalMethods) {
+      // query params in the path don't participate in routing
+      const pathOnly = method.httpPath.includes('?') ? { ...method, httpPath: method.httpPath.split('?')[0] } : method;
+      const regex = createPathParamsRegex(pathOnly, helpers.getMethodParamGroups(method).pathParams);
+      const tracked = go.isLROMethod(method) || go.isPageableMethod(method) ? ', tracked: true' : '';
+      content += `${indent.get()}{method: http.Method${naming.capitalize(method.httpMethod)}, path: regexp.MustCompile(\`${regex}$\`), api: "${client.name}.${fixUpMethodName(method)}"${tracked}},\n`;
+    }
+    content += '}\n';
+  }
+
+  // requests for sub-clients are dispatched through this server too
+  const args = [`New${serverName}Transport(srv)`];
+  for (const each of [client, ...getAllSubClients(client)]) {
+    const varName = getServerRoutesVarName(each);
+    if (varName) {
+      args.push(varName);
+    }
+  }
+
+  content += `\n// New${serverName}Handler creates an http.Handler that serves the fakes in srv over HTTP.\n`;
+  content += `// Requests are dispatched through ${serverName}Transport so pagers and pollers behave the same as in-process.\n`;
+  content += `func New${serverName}Handler(srv *${serverName}) http.Handler {\n`;
+  content += `${indent.get()}return newTransportHandler(${args.join(', ')})\n}\n`;
+  return content;
+}
+
 // method names for fakes dispatching
 const dispatchMethodFake = 'dispatchToMethodFake';
 const dispatchToClientFake = 'dispatchToClientFake';
@@ -294,17 +356,30 @@ function generateServerTransportDo(
   return content;
 }
 
-function generateServerTransportClientDispatch(serverTransport: string, subClients: Array<go.Client>, imports: ImportManager, indent: helpers.Indentation): string {
-  if (subClients.length === 0) {
-    return '';
+/**
+ * gathers all children, not just immediate children, in breadth first order.
+ * sub-clients without exported methods are omitted.
+ *
+ * @param client the client whose sub-clients to gather
+ * @returns the sub-clients
+ */
+function getAllSubClients(client: go.Client): Array<go.Client> {
+  const result = new Array<go.Client>();
+  const visited = new Set<go.Client>();
+  const queue = new Array<go.Client>();
+  for (const clientAccessor of client.clientAccessors) {
+    if (helpers.clientHasNoExportedMethods(clientAccessor.returns)) {
+      continue;
+    }
+    if (!visited.has(clientAccessor.returns)) {
+      visited.add(clientAccessor.returns);
+      queue.push(clientAccessor.returns);
+      result.push(clientAccessor.returns);
+    }
   }
-
-  /** gathers all children, not just immediate children, in breadth first order */
-  const getAllSubClientsForCase = function (client: go.Client): Array<go.Client> {
-    const result = new Array<go.Client>();
-    const visited = new Set<go.Client>();
-    const queue = new Array<go.Client>();
-    for (const clientAccessor of client.clientAccessors) {
+  while (queue.length > 0) {
+    const current = queue.shift()!;
+    for (const clientAccessor of current.clientAccessors) {
       if (helpers.clientHasNoExportedMethods(clientAccessor.returns)) {
         continue;
       }
@@ -314,21 +389,14 @@ function generateServerTransportClientDispatch(serverTransport: string, subClien
         result.push(clientAccessor.returns);
       }
     }
-    while (queue.length > 0) {
-      const current = queue.shift()!;
-      for (const clientAccessor of current.clientAccessors) {
-        if (helpers.clientHasNoExportedMethods(clientAccessor.returns)) {
-          continue;
-        }
-        if (!visited.has(clientAccessor.returns)) {
-          visited.add(clientAccessor.returns);
-          queue.push(clientAccessor.returns);
-          result.push(clientAccessor.returns);
-        }
-      }
-    }
-    return result;
-  };
+  }
+  return result;
+}
+
+function generateServerTransportClientDispatch(serverTransport: string, subClients: Array<go.Client>, imports: ImportManager, indent: helpers.Indentation): string {
+  if (subClients.length === 0) {
+    return '';
+  }
 
   const receiverName = serverTransport[0].toLowerCase();
   imports.add('strings');
@@ -337,7 +405,7 @@ function generateServerTransportClientDispatch(serverTransport: string, subClien
   content += `${indent.get()}switch client {\n`;
   for (const subClient of subClients) {
     // we must include all child clients, not just the immediate children
-    const subClientsForCase = getAllSubClientsForCase(subClient);
+    const subClientsForCase = getAllSubClients(subClient);
     const allClientNamesForCase = new Array<string>(`"${subClient.name}"`);
     allClientNamesForCase.push(...subClientsForCase.map((each) => `"${each.name}"`));
     content += `${indent.get()}case ${allClientNamesForCase.join(', ')}:\n`;
@@ -414,6 +482,8 @@ function generateServerTransportMethods(
   pkg: go.FakePackage,
   serverTransport: string,
   finalMethods: Array<go.MethodType>,
+  validateRequests: boolean,
+  pagerIterators: boolean,
   imports: ImportManager,
   indent: helpers.Indentation,
 ): string {
@@ -439,10 +509,10 @@ function generateServerTransportMethods(
       case 'lroMethod':
       case 'lroPageableMethod':
         // must check LRO before pager as you can have paged LROs
-        content += dispatchForLROBody(pkg, receiverName, method, imports, indent);
+        content += dispatchForLROBody(pkg, receiverName, method, validateRequests, imports, indent);
         break;
       case 'method': {
-        content += dispatchForOperationBody(pkg, receiverName, method, imports, indent);
+        content += dispatchForOperationBody(pkg, receiverName, method, validateRequests, imports, indent);
         content += `${indent.get()}respContent := server.GetResponseContent(respr)\n`;
         const formattedStatusCodes = helpers.formatStatusCodes(method.httpStatusCodes);
         content += `${indent.get()}if !slices.Contains([]int{${formattedStatusCodes}}, respContent.HTTPStatus) {\n`;
@@ -452,12 +522,42 @@ function generateServerTransportMethods(
           content += `${indent.get()}resp, err := server.NewResponse(respContent, req, nil)\n`;
         } else if (method.returns.result.kind === 'anyResult') {
           content += `${indent.get()}resp, err := server.MarshalResponseAs${method.returns.result.format}(respContent, server.GetResponse(respr).${getResultFieldName(method.returns.result)}, req)\n`;
+        } else if (method.returns.result.kind === 'statusCodeResult') {
+          // marshal the field that corresponds to the status code
+          content += `${indent.get()}var respBody any\n`;
+          content += `${indent.get()}switch respContent.HTTPStatus {\n`;
+          const result = method.returns.result;
+          for (const field of result.fields) {
+            content += `${indent.get()}case ${helpers.formatStatusCodes(field.httpStatusCodes)}:\n`;
+            content += `${indent.push().get()}respBody = server.GetResponse(respr).${field.fieldName}\n`;
+            indent.pop();
+          }
+          // the operation might contain a mix of schemas and non-schema responses
+          const noBodyStatusCodes = method.httpStatusCodes.filter((statusCode) => !result.fields.some((f) => f.httpStatusCodes.includes(statusCode)));
+          if (noBodyStatusCodes.length > 0) {
+            content += `${indent.get()}case ${helpers.formatStatusCodes(noBodyStatusCodes)}:\n`;
+            content += `${indent.push().get()}// no response body\n`;
+            indent.pop();
+          }
+          content += `${indent.get()}default:\n`;
+          content += `${indent.push().get()}return nil, &nonRetriableError{fmt.Errorf("unhandled status code %d", respContent.HTTPStatus)}\n`;
+          content += `${indent.pop().get()}}\n`;
+          content += `${indent.get()}resp, err := server.MarshalResponseAs${method.returns.result.format}(respContent, respBody, req)\n`;
         } else if (method.returns.result.kind === 'binaryResult') {
           content += `${indent.get()}resp, err := server.NewResponse(respContent, req, &server.ResponseOptions{\n`;
           indent.push();
           content += `${indent.get()}Body: server.GetResponse(respr).${getResultFieldName(method.returns.result)},\n`;
           content += `${indent.get()}ContentType: req.Header.Get("Content-Type"),\n`;
           content += `${indent.pop().get()}})\n`;
+        } else if (method.returns.result.kind === 'streamResult') {
+          requiredHelpers.streams = true;
+          const stream = method.returns.result;
+          const encoder = getStreamEncoder(stream, pkg);
+          content += `${indent.get()}resp, err := server.NewResponse(respContent, req, &server.ResponseOptions{\n`;
+          indent.push();
+          content += `${indent.get()}Body: newStreamBody(req.Context(), server.GetResponse(respr).${stream.fieldName}, ${encoder}, ${getStreamTerminator(stream)}),\n`;
+          content += `${indent.get()}ContentType: "${stream.format === 'JSONL' ? 'application/jsonl' : 'text/event-stream'}",\n`;
+          content += `${indent.pop().get()}})\n`;
         } else if (method.returns.result.kind === 'monomorphicResult') {
           if (method.returns.result.monomorphicType.kind === 'encodedBytes') {
             const encoding = method.returns.result.monomorphicType.encoding;
@@ -508,6 +608,9 @@ function generateServerTransportMethods(
             if (method.returns.result.monomorphicType.kind === 'time') {
               imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
               responseField = `(*datetime.${method.returns.result.monomorphicType.format})(${responseField})`;
+            } else if (method.returns.result.monomorphicType.kind === 'duration') {
+              requiredHelpers.durationTypes = true;
+              responseField = `(*${helpers.getDurationTypeName(method.returns.result.monomorphicType)})(${responseField})`;
             }
             content += `${indent.get()}resp, err := server.MarshalResponseAs${method.returns.result.format}(respContent, ${responseField}, req)\n`;
           }
@@ -529,7 +632,14 @@ function generateServerTransportMethods(
             content += `${indent.push().get()}resp.Header.Set("${header.headerName}"+k, *v)\n`;
             content += `${indent.pop().get()}}\n`;
             content += `${indent.pop().get()}}\n`;
+          } else if (header.kind === 'setCookieResponse') {
+            content += `${indent.get()}for _, c := range server.GetResponse(respr).${header.fieldName} {\n`;
+            content += `${indent.push().get()}resp.Header.Add("Set-Cookie", c.String())\n`;
+            content += `${indent.pop().get()}}\n`;
           } else {
+            if (header.type.kind === 'duration') {
+              requiredHelpers.durationTypes = true;
+            }
             content += `${indent.get()}if val := server.GetResponse(respr).${header.fieldName}; val != nil {\n`;
             content += `${indent.push().get()}resp.Header.Set("${header.headerName}", ${helpers.formatValue('val', header.type, imports, true)})\n`;
             content += `${indent.pop().get()}}\n`;
@@ -540,7 +650,7 @@ function generateServerTransportMethods(
         break;
       }
       case 'pageableMethod':
-        content += dispatchForPagerBody(pkg, receiverName, method, imports, indent);
+        content += dispatchForPagerBody(pkg, receiverName, method, validateRequests, pagerIterators, imports, indent);
         break;
       default:
         method satisfies never;
@@ -562,10 +672,20 @@ function generateServerTransportMethods(
  * @param indent the indentation helper currently in scope
  * @returns the text for dispatching logic
  */
-function dispatchForOperationBody(pkg: go.FakePackage, receiverName: string, method: go.MethodType, imports: ImportManager, indent: helpers.Indentation): string {
+function dispatchForOperationBody(
+  pkg: go.FakePackage,
+  receiverName: string,
+  method: go.MethodType,
+  validateRequests: boolean,
+  imports: ImportManager,
+  indent: helpers.Indentation,
+): string {
   const methodParamGroups = helpers.getMethodParamGroups(method);
   const numPathParams = methodParamGroups.pathParams.filter((each: go.PathParameter) => !go.isLiteralParameter(each.style)).length;
   let content = '';
+  if (validateRequests) {
+    content += validateRequestContract(methodParamGroups, indent);
+  }
   if (numPathParams > 0) {
     imports.add('regexp');
     content += `${indent.get()}const regexStr = \`${createPathParamsRegex(method, methodParamGroups.pathParams)}\`\n`;
@@ -600,6 +720,7 @@ function dispatchForOperationBody(pkg: go.FakePackage, receiverName: string, met
               content += `${indent.get()}if err != nil {\n${indent.push().get()}return nil, err\n${indent.pop().get()}}\n`;
               break;
             case 'interface':
+            case 'union':
               requiredHelpers.readRequestBody = true;
               content += `${indent.get()}raw, err := readRequestBody(req)\n`;
               content += `${indent.get()}if err != nil {\n${indent.push().get()}return nil, err\n${indent.pop().get()}}\n`;
@@ -617,6 +738,9 @@ function dispatchForOperationBody(pkg: go.FakePackage, receiverName: string, met
               if (bodyParam.type.kind === 'time') {
                 imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime/datetime');
                 bodyTypeName = `datetime.${bodyParam.type.format}`;
+              } else if (bodyParam.type.kind === 'duration') {
+                requiredHelpers.durationTypes = true;
+                bodyTypeName = helpers.getDurationTypeName(bodyParam.type);
               }
               content += `${indent.get()}body, err := server.UnmarshalRequestAs${bodyParam.bodyFormat}[${bodyTypeName}](req)\n`;
               content += `${indent.get()}if err != nil {\n${indent.push().get()}return nil, err\n${indent.pop().get()}}\n`;
@@ -856,11 +980,137 @@ function dispatchForOperationBody(pkg: go.FakePackage, receiverName: string, met
   }
   content += `${indent.get()}respr, errRespr ${apiCall}\n`;
   content += `${indent.get()}if respErr := server.GetError(errRespr, req); respErr != nil {\n`;
-  content += `${indent.push().get()}return nil, respErr\n${indent.pop().get()}}\n`;
+  if (method.errorType) {
+    // errors set by the Set* helpers for typed errors are returned as HTTP responses
+    content += `${indent.push().get()}return getServiceErrorResponse(respErr, req)\n${indent.pop().get()}}\n`;
+  } else {
+    content += `${indent.push().get()}return nil, respErr\n${indent.pop().get()}}\n`;
+  }
+  return content;
+}
+
+/**
+ * generates the call to validateRequest that checks the request against
+ * the operation's query and header params and its body's content type.
+ *
+ * @param methodParamGroups the params for the method being dispatched
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the validation or the empty string if there's nothing to validate
+ */
+function validateRequestContract(methodParamGroups: helpers.MethodParamGroups, indent: helpers.Indentation): string {
+  const emitParamContracts = function (field: string, params: Array<{ name: string; param: go.MethodParameter }>): string {
+    if (params.length === 0) {
+      return '';
+    }
+    params.sort((a, b) => helpers.sortAscending(a.name, b.name));
+    let contracts = `${indent.get()}${field}: []paramContract{\n`;
+    indent.push();
+    for (const each of params) {
+      const fields = [`name: "${each.name}"`];
+      if (go.isRequiredParameter(each.param.style) || go.isLiteralParameter(each.param.style)) {
+        fields.push('required: true');
+      }
+      const values = getContractValues(each.param.type);
+      if (values) {
+        fields.push(`values: []string{${values.map((value) => `"${value}"`).join(', ')}}`);
+      }
+      contracts += `${indent.get()}{${fields.join(', ')}},\n`;
+    }
+    contracts += `${indent.pop().get()}},\n`;
+    return contracts;
+  };
+
+  indent.push();
+  let contract = emitParamContracts(
+    'query',
+    methodParamGroups.encodedQueryParams.concat(methodParamGroups.unencodedQueryParams).map((param) => ({ name: param.queryParameter, param: param })),
+  );
+  contract += emitParamContracts(
+    'headers',
+    methodParamGroups.headerParams
+      // header maps use a prefix instead of a header name
+      .filter((param): param is go.HeaderCollectionParameter | go.HeaderScalarParameter => param.kind !== 'headerMapParam')
+      .map((param) => ({ name: param.headerName, param: param })),
+  );
+
+  let contentType: string | undefined;
+  let bodyRequired = false;
+  if (methodParamGroups.bodyParam) {
+    if (methodParamGroups.bodyParam.contentType.kind === 'literal') {
+      contentType = getMediaType(<string>methodParamGroups.bodyParam.contentType.literal);
+    }
+    bodyRequired = go.isRequiredParameter(methodParamGroups.bodyParam.style) || go.isLiteralParameter(methodParamGroups.bodyParam.style);
+  } else if (methodParamGroups.multipartBodyParams.length > 0) {
+    contentType = 'multipart/form-data';
+  } else if (methodParamGroups.formBodyParams.length > 0) {
+    contentType = 'application/x-www-form-urlencoded';
+  } else if (methodParamGroups.partialBodyParams.length > 0) {
+    contentType = `application/${methodParamGroups.partialBodyParams[0].format.toLowerCase()}`;
+  }
+  if (contentType) {
+    contract += `${indent.get()}contentType: "${contentType}",\n`;
+    if (bodyRequired) {
+      contract += `${indent.get()}bodyRequired: true,\n`;
+    }
+  }
+  indent.pop();
+
+  if (contract === '') {
+    return '';
+  }
+  requiredHelpers.validateRequest = true;
+  let content = `${indent.get()}if resp := validateRequest(req, requestContract{\n`;
+  content += contract;
+  content += `${indent.get()}}); resp != nil {\n`;
+  content += `${indent.push().get()}return resp, nil\n`;
+  content += `${indent.pop().get()}}\n`;
   return content;
 }
 
-function getMethodStatusCodes(method: go.MethodType): Array<number> {
+/**
+ * returns the permitted wire values for a param of the specified type.
+ * only fixed enums and literals have a fixed set of values.
+ *
+ * @param type the param's type
+ * @returns the permitted values or undefined if any value is permitted
+ */
+function getContractValues(type: go.WireType): Array<string> | undefined {
+  switch (type.kind) {
+    case 'constant':
+      if (!type.fixed) {
+        // extensible enums permit values that aren't predefined
+        return undefined;
+      }
+      return type.values.map((each) => `${each.value}`).sort();
+    case 'literal':
+      switch (type.type.kind) {
+        case 'constant':
+          if ((<go.ConstantValue>type.literal)?.kind === 'constantValue') {
+            return [`${(<go.ConstantValue>type.literal).value}`];
+          }
+          return [`${type.literal}`];
+        case 'scalar':
+          return [`${type.literal}`];
+        case 'string':
+          // string literals might already be quoted
+          return [(<string>type.literal).replace(/^"(.*)"$/, '$1')];
+      }
+  }
+  return undefined;
+}
+
+/**
+ * returns the media type from a Content-Type value, removing any parameters.
+ * e.g. application/json; charset=utf-8 becomes application/json
+ *
+ * @param contentType the Content-Type value, possibly quoted
+ * @returns the media type
+ */
+function getMediaType(contentType: string): string {
+  return contentType.replace(/^"(.*)"$/, '$1').split(';')[0].trim();
+}
+
+export function getMethodStatusCodes(method: go.MethodType): Array<number> {
   // NOTE: don't modify the original array!
   const statusCodes = Array.from(method.httpStatusCodes);
   switch (method.kind) {
@@ -889,13 +1139,20 @@ function getMethodStatusCodes(method: go.MethodType): Array<number> {
  * @param indent the indentation helper currently in scope
  * @returns the text for the LRO dispatch logic
  */
-function dispatchForLROBody(pkg: go.FakePackage, receiverName: string, method: go.LROMethod | go.LROPageableMethod, imports: ImportManager, indent: helpers.Indentation): string {
+function dispatchForLROBody(
+  pkg: go.FakePackage,
+  receiverName: string,
+  method: go.LROMethod | go.LROPageableMethod,
+  validateRequests: boolean,
+  imports: ImportManager,
+  indent: helpers.Indentation,
+): string {
   const operationName = fixUpMethodName(method);
   const localVarName = naming.uncapitalize(operationName);
   const operationStateMachine = `${receiverName}.${naming.uncapitalize(operationName)}`;
   let content = `${indent.get()}${localVarName} := ${operationStateMachine}.get(req)\n`;
   content += `${indent.get()}if ${localVarName} == nil {\n`;
-  content += dispatchForOperationBody(pkg, receiverName, method, imports, indent);
+  content += dispatchForOperationBody(pkg, receiverName, method, validateRequests, imports, indent);
   indent.push();
   content += `${indent.get()}${localVarName} = &respr\n`;
   content += `${indent.get()}${operationStateMachine}.add(req, ${localVarName})\n`;
@@ -926,17 +1183,38 @@ function dispatchForLROBody(pkg: go.FakePackage, receiverName: string, method: g
  * @param pkg contains the package contents
  * @param receiverName the name of the receiver for the dispatch method
  * @param method the pageable method for which to emit the dispatch logic
+ * @param pagerIterators when true, a request for the first page of a pager with an iterator restarts the pager
  * @param imports the import manager currently in scope
  * @param indent the indentation helper currently in scope
  * @returns the text for the pageable dispatch logic
  */
-function dispatchForPagerBody(pkg: go.FakePackage, receiverName: string, method: go.PageableMethod, imports: ImportManager, indent: helpers.Indentation): string {
+function dispatchForPagerBody(
+  pkg: go.FakePackage,
+  receiverName: string,
+  method: go.PageableMethod,
+  validateRequests: boolean,
+  pagerIterators: boolean,
+  imports: ImportManager,
+  indent: helpers.Indentation,
+): string {
   const operationName = fixUpMethodName(method);
   const localVarName = naming.uncapitalize(operationName);
   const operationStateMachine = `${receiverName}.${naming.uncapitalize(operationName)}`;
-  let content = `${indent.get()}${localVarName} := ${operationStateMachine}.get(req)\n`;
+  let content = '';
+  if (method.strategy?.kind === 'nextLink' && method.strategy.method) {
+    content += dispatchForNextPageMethod(method.strategy.method, imports, indent);
+  }
+  content += `${indent.get()}${localVarName} := ${operationStateMachine}.get(req)\n`;
+  if (pagerIterators && method.itemsPath && method.strategy?.kind === 'nextLink') {
+    // next links created by the fake have a suffix so a request without one is for the first page.
+    // this discards the pages of a pager that wasn't read to the end (e.g. the caller broke out
+    // of an iterator) instead of returning its next page.
+    content += `${indent.get()}if server.SanitizePagerPollerPath(req.URL.Path) == req.URL.Path {\n`;
+    content += `${indent.push().get()}${localVarName} = nil\n`;
+    content += `${indent.pop().get()}}\n`;
+  }
   content += `${indent.get()}if ${localVarName} == nil {\n`;
-  content += dispatchForOperationBody(pkg, receiverName, method, imports, indent);
+  content += dispatchForOperationBody(pkg, receiverName, method, validateRequests, imports, indent);
   indent.push();
   content += `${indent.get()}${localVarName} = &resp\n`;
   content += `${indent.get()}${operationStateMachine}.add(req, ${localVarName})\n`;
@@ -967,12 +1245,59 @@ function dispatchForPagerBody(pkg: go.FakePackage, receiverName: string, method:
   return content;
 }
 
+/**
+ * generates the logic to map a request sent by a next page method to the
+ * request for the first page. this allows the request to be correlated with
+ * the pager's tracked state as the next page method can have a different path.
+ *
+ * @param nextPageMethod the next page method used to fetch subsequent pages
+ * @param imports the import manager currently in scope
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the next page dispatch logic or the empty string
+ */
+function dispatchForNextPageMethod(nextPageMethod: go.NextPageMethod, imports: ImportManager, indent: helpers.Indentation): string {
+  const nextLinkParam = nextPageMethod.nextLinkParam;
+  let content = '';
+  switch (nextLinkParam?.kind) {
+    case 'headerScalarParam':
+      requiredHelpers.getHeaderValue = true;
+      content += `${indent.get()}nextLink := getHeaderValue(req.Header, "${nextLinkParam.headerName}")\n`;
+      break;
+    case 'pathScalarParam': {
+      imports.add('regexp');
+      const pathParams = helpers.getMethodParamGroups(nextPageMethod).pathParams;
+      content += `${indent.get()}nextLink := ""\n`;
+      content += `${indent.get()}const nextPageRegexStr = \`${createPathParamsRegex(nextPageMethod, pathParams)}\`\n`;
+      content += `${indent.get()}nextPageRegex := regexp.MustCompile(nextPageRegexStr)\n`;
+      content += `${indent.get()}if matches := nextPageRegex.FindStringSubmatch(req.URL.EscapedPath()); matches != nil {\n`;
+      content += `${indent.push().get()}nextLink = matches[nextPageRegex.SubexpIndex("${sanitizeRegexpCaptureGroupName(nextLinkParam.pathSegment)}")]\n`;
+      content += `${indent.pop().get()}}\n`;
+      break;
+    }
+    case 'queryScalarParam':
+      content += `${indent.get()}nextLink := req.URL.Query().Get("${nextLinkParam.queryParameter}")\n`;
+      break;
+    default:
+      // no next link param so there's nothing to correlate
+      return '';
+  }
+  requiredHelpers.nextPageRequest = true;
+  content += `${indent.get()}if nextLink != "" {\n`;
+  content += `${indent.push().get()}nextReq, err := nextPageRequest(req, nextLink)\n`;
+  content += `${indent.get()}if err != nil {\n`;
+  content += `${indent.push().get()}return nil, err\n`;
+  content += `${indent.pop().get()}}\n`;
+  content += `${indent.get()}req = nextReq\n`;
+  content += `${indent.pop().get()}}\n`;
+  return content;
+}
+
 function sanitizeRegexpCaptureGroupName(name: string): string {
   // dash '-' characters are not allowed so replace them with '_'
   return name.replace('-', '_');
 }
 
-function createPathParamsRegex(method: go.MethodType, pathParams: Array<go.PathParameter>): string {
+export function createPathParamsRegex(method: go.MethodType | go.NextPageMethod, pathParams: Array<go.PathParameter>): string {
   // "/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{parentResourcePath}/{resourceType}/{resourceName}"
   // each path param will replaced with a regex capture.
   // note that some path params are optional.
@@ -1114,7 +1439,7 @@ function parseHeaderPathQueryParams(pkg: go.FakePackage, method: go.MethodType,
         }
 
         const paramVar = createLocalVariableName(param, 'Param');
-        let elementFormat: go.ScalarType | go.TimeFormat | go.BytesEncoding | 'string';
+        let elementFormat: go.ScalarType | go.TimeFormat | go.BytesEncoding | go.DurationFormat | 'string';
         switch (param.type.elementType.kind) {
           case 'constant':
           case 'scalar':
@@ -1123,6 +1448,7 @@ function parseHeaderPathQueryParams(pkg: go.FakePackage, method: go.MethodType,
           case 'encodedBytes':
             elementFormat = param.type.elementType.encoding;
             break;
+          case 'duration':
           case 'time':
             elementFormat = param.type.elementType.format;
             break;
@@ -1171,6 +1497,12 @@ function parseHeaderPathQueryParams(pkg: go.FakePackage, method: go.MethodType,
             content += `${indent.get()}${fromVar}, parseErr := time.Parse(${format}, ${paramValue}[i])\n`;
             content += `${indent.get()}if parseErr != nil {\n${indent.push().get()}return nil, parseErr\n${indent.pop().get()}}\n`;
           }
+        } else if (param.type.elementType.kind === 'duration') {
+          imports.add('time');
+          requiredHelpers.durationTypes = true;
+          fromVar = 'parsedDuration';
+          content += `${indent.get()}var ${fromVar} ${helpers.getDurationTypeName(param.type.elementType)}\n`;
+          content += `${indent.get()}if parseErr := ${fromVar}.UnmarshalText([]byte(${paramValue}[i])); parseErr != nil {\n${indent.push().get()}return nil, parseErr\n${indent.pop().get()}}\n`;
         } else {
           throw new CodegenError('InternalError', `unhandled element format ${elementFormat}`);
         }
@@ -1252,6 +1584,22 @@ function parseHeaderPathQueryParams(pkg: go.FakePackage, method: go.MethodType,
         content += `${indent.get()}${createLocalVariableName(param, 'Param')}, err := ${emitNumericConversion(paramValue, param.type.type)}\n`;
       }
       content += `${indent.get()}if err != nil {\n${indent.push().get()}return nil, err\n${indent.pop().get()}}\n`;
+    } else if (param.type.kind === 'duration') {
+      imports.add('time');
+      requiredHelpers.durationTypes = true;
+      let parser: string;
+      if (!go.isRequiredParameter(param.style)) {
+        requiredHelpers.parseOptional = true;
+        parser = 'parseOptional';
+      } else {
+        requiredHelpers.parseWithCast = true;
+        parser = 'parseWithCast';
+      }
+      content += `${indent.get()}${createLocalVariableName(param, 'Param')}, err := ${parser}(${paramValue}, func(v string) (time.Duration, error) {\n`;
+      content += `${indent.push().get()}var d ${helpers.getDurationTypeName(param.type)}\n`;
+      content += `${indent.get()}parseErr := d.UnmarshalText([]byte(v))\n`;
+      content += `${indent.get()}return time.Duration(d), parseErr\n${indent.pop().get()}})\n`;
+      content += `${indent.get()}if err != nil {\n${indent.push().get()}return nil, err\n${indent.pop().get()}}\n`;
     } else if (param.kind === 'headerMapParam') {
       imports.add('strings');
       imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/to');
@@ -1293,7 +1641,7 @@ function parseHeaderPathQueryParams(pkg: go.FakePackage, method: go.MethodType,
     } else if (!go.isRequiredParameter(param.style)) {
       // we check this last as it's a superset of the previous conditions
       requiredHelpers.getOptional = true;
-      if (param.type.kind === 'constant' || param.type.kind === 'etag') {
+      if (param.type.kind === 'constant' || param.type.kind === 'decimal' || param.type.kind === 'etag') {
         imports.addForType(param.type);
         paramValue = `${go.getTypeDeclaration(param.type, pkg)}(${paramValue})`;
       }
@@ -1417,6 +1765,9 @@ function getRawParamValue(param: go.MethodParameter): string {
       }
       // JSON/XML/text bodies have been deserialized into a local named body
       return 'body';
+    case 'cookieParam':
+      requiredHelpers.getCookieValue = true;
+      return `getCookieValue(req, "${param.cookieName}")`;
     case 'formBodyCollectionParam':
     case 'formBodyScalarParam':
     case 'multipartFormBodyParam':
@@ -1471,6 +1822,9 @@ function getFinalParamValue(pkg: go.FakePackage, param: go.MethodParameter, para
   if ((param.kind === 'bodyParam' || go.isFormBodyParameter(param) || param.kind === 'multipartFormBodyParam') && param.type.kind === 'time') {
     // time types in the body have been unmarshalled into our time helpers thus require a cast to time.Time
     return `time.Time(${paramValue})`;
+  } else if (param.kind === 'bodyParam' && param.type.kind === 'duration') {
+    // duration types in the body have been unmarshalled into our duration helpers thus require a cast to time.Duration
+    return `time.Duration(${paramValue})`;
   } else if (go.isRequiredParameter(param.style)) {
     // optional params are always in their "final" form
     if (param.kind === 'headerCollectionParam' || param.kind === 'pathCollectionParam' || param.kind === 'queryCollectionParam') {
@@ -1480,8 +1834,11 @@ function getFinalParamValue(pkg: go.FakePackage, param: go.MethodParameter, para
         requiredHelpers.splitHelper = true;
         return `splitHelper(${paramValue}, "${helpers.getDelimiterForCollectionFormat(param.collectionFormat)}")`;
       }
-    } else if ((go.isHeaderParameter(param) || go.isQueryParameter(param)) && param.type.kind === 'constant' && param.type.type === 'string') {
-      // query params from req.URL.Query() are already decoded, so like headers we cast required, string-based enums inline
+    } else if ((param.kind === 'cookieParam' || go.isHeaderParameter(param) || go.isQueryParameter(param)) && param.type.kind === 'constant' && param.type.type === 'string') {
+      // query params from req.URL.Query() are already decoded, so like headers and cookies we cast required, string-based enums inline
+      return `${go.getTypeDeclaration(param.type, pkg)}(${paramValue})`;
+    } else if (param.type.kind === 'decimal' && param.kind !== 'bodyParam') {
+      // decimals are sent as strings in headers, paths, and query params
       return `${go.getTypeDeclaration(param.type, pkg)}(${paramValue})`;
     }
   } else if (param.kind === 'partialBodyParam') {
@@ -1518,15 +1875,39 @@ function consolidateHostParams(params: Array<go.MethodParameter>): Array<go.Meth
   return consolidatedParams;
 }
 
+/**
+ * returns the named return values for the fake of the specified method
+ *
+ * @param pkg the package in which the fake's signature is declared
+ * @param method the method for which to generate the return values
+ * @returns the text for the fake's return values
+ */
+export function getServerResponseSig(pkg: go.FakePackage | go.TestPackage, method: go.MethodType): string {
+  switch (method.kind) {
+    case 'lroMethod':
+    case 'lroPageableMethod': {
+      let respType = go.getTypeDeclaration(method.returns, pkg);
+      if (method.kind === 'lroPageableMethod') {
+        respType = `azfake.PagerResponder[${respType}]`;
+      }
+      return `resp azfake.PollerResponder[${respType}], errResp azfake.ErrorResponder`;
+    }
+    case 'method':
+      return `resp azfake.Responder[${go.getTypeDeclaration(method.returns, pkg)}], errResp azfake.ErrorResponder`;
+    case 'pageableMethod':
+      return `resp azfake.PagerResponder[${go.getTypeDeclaration(method.returns, pkg)}]`;
+  }
+}
+
 /**
  * copied from generator/operations.ts but with a slight tweak to consolidate host parameters
  *
- * @param pkg the contents of the package
+ * @param pkg the package in which the parameter signature is declared
  * @param method the method for which to generate the parameter signature
  * @param imports the import manager currently in scope
  * @returns the text for the method's parameter signature
  */
-function getAPIParametersSig(pkg: go.FakePackage, method: go.MethodType, imports: ImportManager): string {
+export function getAPIParametersSig(pkg: go.FakePackage | go.TestPackage, method: go.MethodType, imports: ImportManager): string {
   const methodParams = helpers.getMethodParameters(method, consolidateHostParams);
   const params = new Array<string>();
   if (method.kind !== 'pageableMethod') {
@@ -0,0 +1,67 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as helpers from '../core/helpers.js';
+import { ImportManager } from '../core/imports.js';
+
+/**
+ * Generates the contents for the service_errors.go file.
+ * this includes a Set* helper per typed error that makes an
+ * ErrorResponder return the error body in an HTTP response.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generateServiceErrorHelpers(pkg: go.FakePackage): string {
+  if (pkg.parent.errors.length === 0) {
+    return '';
+  }
+
+  const imports = new ImportManager(pkg);
+  imports.add('errors');
+  imports.add('fmt');
+  imports.add('net/http');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/fake', 'azfake');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/fake/server');
+
+  const clientPkgName = go.getPackageName(pkg.parent);
+  const serviceErrors = pkg.parent.errors.toSorted((a, b) => helpers.sortAscending(a.name, b.name));
+  let body = '';
+  for (const serviceError of serviceErrors) {
+    imports.addForType(serviceError.model);
+    body += `// Set${serviceError.name} sets errResp to return an HTTP response with the specified status code and body.\n`;
+    body += `// The client method returns an *${clientPkgName}.${serviceError.name} that contains body.\n`;
+    body += '// NOTE: responses with status codes that the pipeline retries (e.g. http.StatusServiceUnavailable) are retried.\n';
+    body += `func Set${serviceError.name}(errResp *azfake.ErrorResponder, httpStatus int, body ${go.getTypeDeclaration(serviceError.model, pkg)}) {\n`;
+    body += '\terrResp.SetError(&serviceErrorResponse{httpStatus: httpStatus, body: body})\n';
+    body += '}\n\n';
+  }
+
+  body += `// serviceErrorResponse is the error set by the Set* helpers for typed errors.
+// the server transport converts it into an HTTP response.
+type serviceErrorResponse struct {
+	httpStatus int
+	body       any
+}
+
+// Error implements the error interface for serviceErrorResponse.
+func (s *serviceErrorResponse) Error() string {
+	return fmt.Sprintf("fake error response with HTTP status code %d", s.httpStatus)
+}
+
+// getServiceErrorResponse returns the HTTP response for errors set by the Set* helpers for typed errors.
+// all other errors are returned as-is.
+func getServiceErrorResponse(err error, req *http.Request) (*http.Response, error) {
+	var errResp *serviceErrorResponse
+	if !errors.As(err, &errResp) {
+		return nil, err
+	}
+	return server.MarshalResponseAsJSON(server.ResponseContent{HTTPStatus: errResp.httpStatus}, errResp.body, req)
+}
+`;
+
+  return helpers.contentPreamble(pkg) + imports.text() + body;
+}
@@ -0,0 +1,510 @@
+/*---------------------------------------------------------------------------------------------
+ *  Copyright (c) Microsoft Corporation. All rights reserved.
+ *  Licensed under the MIT License. See License.txt in the project root for license information.
+ *--------------------------------------------------------------------------------------------*/
+
+import * as go from '../../../codemodel.go/src/index.js';
+import * as naming from '../../../naming.go/src/naming.js';
+import * as helpers from '../core/helpers.js';
+import { ImportManager } from '../core/imports.js';
+import { fixUpMethodName } from '../core/operations.js';
+import { createPathParamsRegex } from '../fake/servers.js';
+
+/**
+ * Generates the contents for the recording/operations.go file.
+ * it contains the values used to match recorded requests for each operation.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file or the empty string
+ */
+export function generateRecordingOperations(pkg: go.RecordingPackage): string {
+  const indent = new helpers.Indentation();
+  let body = '';
+  for (const client of pkg.parent.clients) {
+    for (const method of client.methods) {
+      if (helpers.isMethodInternal(method)) {
+        continue;
+      }
+      body += operationEntry(client, method, indent);
+    }
+  }
+  if (body.length === 0) {
+    return '';
+  }
+
+  const imports = new ImportManager(pkg);
+  imports.add('net/http');
+  imports.add('regexp');
+
+  let text = helpers.contentPreamble(pkg);
+  text += imports.text();
+  text += '// operations maps the API name of each operation to the values used to match its requests\n';
+  text += 'var operations = map[string]operation{\n';
+  text += body;
+  text += '}\n';
+  return text;
+}
+
+/**
+ * Generates the contents for the recording/transport.go file.
+ *
+ * @param pkg contains the package content
+ * @returns the text for the file
+ */
+export function generateRecordingTransport(pkg: go.RecordingPackage): string {
+  const imports = new ImportManager(pkg);
+  imports.add('bytes');
+  imports.add('encoding/json');
+  imports.add('fmt');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/policy');
+  imports.add('github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime');
+  imports.add('io');
+  imports.add('maps');
+  imports.add('net/http');
+  imports.add('net/url');
+  imports.add('os');
+  imports.add('path/filepath');
+  imports.add('regexp');
+  imports.add('strings');
+  imports.add('sync');
+  return helpers.contentPreamble(pkg) + imports.text() + recordingTransport;
+}
+
+/**
+ * returns the entry in the operations table for the specified method.
+ * query params in the path don't participate in matching.
+ *
+ * @param client the client that contains the method
+ * @param method the method for which to generate the entry
+ * @param indent the indentation helper currently in scope
+ * @returns the text for the entry
+ */
+function operationEntry(client: go.Client, method: go.MethodType, indent: helpers.Indentation): string {
+  const paramGroups = helpers.getMethodParamGroups(method);
+  const pathOnly = method.httpPath.includes('?') ? { ...method, httpPath: method.httpPath.split('?')[0] } : method;
+
+  const queryParams = new Array<string>();
+  for (const param of [...paramGroups.encodedQueryParams, ...paramGroups.unencodedQueryParams]) {
+    queryParams.push(`"${param.queryParameter}"`);
+  }
+
+  const headerParams = new Array<string>();
+  for (const param of paramGroups.headerParams) {
+    // header maps use a prefix instead of a header name
+    if (param.kind !== 'headerMapParam') {
+      headerParams.push(`"${param.headerName}"`);
+    }
+  }
+
+  let text = `${indent.get()}"${client.name}.${fixUpMethodName(method)}": {\n`;
+  indent.push();
+  text += `${indent.get()}method: http.Method${naming.capitalize(method.httpMethod)},\n`;
+  text += `${indent.get()}template: "${pathOnly.httpPath}",\n`;
+  text += `${indent.get()}path: regexp.MustCompile(\`${createPathParamsRegex(pathOnly, paramGroups.pathParams)}$\`),\n`;
+  if (queryParams.length > 0) {
+    text += `${indent.get()}query: []string{${queryParams.sort().join(', ')}},\n`;
+  }
+  if (headerParams.length > 0) {
+    text += `${indent.get()}headers: []string{${headerParams.sort().join(', ')}},\n`;
+  }
+  text += `${indent.pop().get()}},\n`;
+  return text;
+}
+
+const recordingTransport = `// Mode controls how a Transport handles requests.
+type Mode string
+
+const (
+	// ModePlayback replays responses from a recording without sending requests.
+	ModePlayback Mode = "playback"
+	// ModeRecord sends requests to the service and records the responses.
+	ModeRecord Mode = "record"
+	// ModeLive sends requests to the service without recording them.
+	ModeLive Mode = "live"
+)
+
+// TransportOptions contains the optional values for NewTransport.
+type TransportOptions struct {
+	// Dir is the directory that contains the recordings.
+	// The default value is testdata/recordings.
+	Dir string
+
+	// Mode controls whether requests are recorded or replayed.
+	// The default value is ModePlayback.
+	Mode Mode
+
+	// SanitizeHeaders contains the names of additional headers that aren't
+	// used when matching requests and whose values are redacted in recordings.
+	SanitizeHeaders []string
+
+	// Transport sends requests to the service in ModeRecord and ModeLive.
+	// The default value is http.DefaultClient.
+	Transport policy.Transporter
+}
+
+// Transport is a policy.Transporter that records requests and responses to a JSON file and replays them.
+// Requests for a known operation are matched on the operation's HTTP method, path template and parameter
+// values, query parameters, header parameters, and request body. Other requests, like polling an LRO or
+// fetching the next page of a pager, are matched on their HTTP method, path, query string, and request body.
+// JSON request bodies are compared without regard to formatting or the order of their fields. Matching
+// entries are replayed in the order they were recorded.
+// Don't use this type directly, use NewTransport instead.
+type Transport struct {
+	file      string
+	mode      Mode
+	sanitize  map[string]bool
+	transport policy.Transporter
+
+	mu      sync.Mutex
+	entries []*entry
+	used    []bool
+}
+
+// NewTransport creates a Transport for the recording with the specified name.
+// The recording is stored in a JSON file named after the recording in TransportOptions.Dir.
+// In ModePlayback, the recording is loaded immediately.
+//   - name - the name of the recording, typically the name of the test
+//   - options - TransportOptions contains the optional values, pass nil to accept the default values
+func NewTransport(name string, options *TransportOptions) (*Transport, error) {
+	if options == nil {
+		options = &TransportOptions{}
+	}
+	dir := options.Dir
+	if dir == "" {
+		dir = filepath.Join("testdata", "recordings")
+	}
+	t := &Transport{
+		file:      filepath.Join(dir, name+".json"),
+		mode:      options.Mode,
+		sanitize:  map[string]bool{},
+		transport: options.Transport,
+	}
+	if t.mode == "" {
+		t.mode = ModePlayback
+	}
+	if t.transport == nil {
+		t.transport = http.DefaultClient
+	}
+	for _, header := range defaultSanitizedHeaders {
+		t.sanitize[strings.ToLower(header)] = true
+	}
+	for _, header := range options.SanitizeHeaders {
+		t.sanitize[strings.ToLower(header)] = true
+	}
+
+	switch t.mode {
+	case ModePlayback:
+		data, err := os.ReadFile(t.file)
+		if err != nil {
+			return nil, err
+		}
+		var rec recording
+		if err := json.Unmarshal(data, &rec); err != nil {
+			return nil, fmt.Errorf("failed to read recording %s: %w", t.file, err)
+		}
+		t.entries = rec.Entries
+		t.used = make([]bool, len(rec.Entries))
+	case ModeRecord, ModeLive:
+	default:
+		return nil, fmt.Errorf("unknown recording mode %q", t.mode)
+	}
+	return t, nil
+}
+
+// Do implements the policy.Transporter interface for Transport.
+func (t *Transport) Do(req *http.Request) (*http.Response, error) {
+	switch t.mode {
+	case ModeLive:
+		return t.transport.Do(req)
+	case ModeRecord:
+		return t.record(req)
+	default:
+		return t.playback(req)
+	}
+}
+
+// Stop saves the recording in ModeRecord. It does nothing in the other modes.
+func (t *Transport) Stop() error {
+	if t.mode != ModeRecord {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	data, err := json.MarshalIndent(recording{Entries: t.entries}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.file), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(t.file, append(data, '\\n'), 0644)
+}
+
+func (t *Transport) record(req *http.Request) (*http.Response, error) {
+	e, err := t.newEntry(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.transport.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	e.StatusCode = resp.StatusCode
+	e.ResponseHeaders = map[string][]string{}
+	for k, v := range resp.Header {
+		if t.sanitize[strings.ToLower(k)] {
+			v = []string{redacted}
+		}
+		e.ResponseHeaders[k] = v
+	}
+	e.ResponseBody = string(body)
+
+	t.mu.Lock()
+	t.entries = append(t.entries, e)
+	t.mu.Unlock()
+	return resp, nil
+}
+
+func (t *Transport) playback(req *http.Request) (*http.Response, error) {
+	e, err := t.newEntry(req)
+	if err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, recorded := range t.entries {
+		if t.used[i] || !e.matches(recorded) {
+			continue
+		}
+		t.used[i] = true
+		header := http.Header{}
+		for k, v := range recorded.ResponseHeaders {
+			header[k] = v
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(recorded.ResponseBody)),
+			ContentLength: int64(len(recorded.ResponseBody)),
+			Request:       req,
+		}, nil
+	}
+	return nil, nonRetriableError{fmt.Errorf("no recorded response in %s for %s %s%s", t.file, req.Method, req.URL.Path, t.mismatch(e))}
+}
+
+// mismatch describes how e differs from the closest unused entry in the recording.
+// the caller must hold t.mu.
+func (t *Transport) mismatch(e *entry) string {
+	var closest []string
+	for i, recorded := range t.entries {
+		if t.used[i] {
+			continue
+		}
+		if diffs := e.diff(recorded); closest == nil || len(diffs) < len(closest) {
+			closest = diffs
+		}
+	}
+	if closest == nil {
+		return ""
+	}
+	return "\\nthe closest recorded request differs in\\n\\t" + strings.Join(closest, "\\n\\t")
+}
+
+// newEntry creates the entry used to match req.
+func (t *Transport) newEntry(req *http.Request) (*entry, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	e := &entry{
+		Method:      req.Method,
+		Path:        req.URL.EscapedPath(),
+		RequestBody: normalizeBody(req.Header.Get("Content-Type"), body),
+	}
+	e.Operation, _ = req.Context().Value(runtime.CtxAPINameKey{}).(string)
+	op, ok := operations[e.Operation]
+	var matches []string
+	if ok && op.method == req.Method {
+		matches = op.path.FindStringSubmatch(req.URL.EscapedPath())
+	}
+	if matches == nil {
+		// pager next links and LRO status requests use URLs from the service
+		e.Query = queryValues(req.URL.Query(), nil)
+		return e, nil
+	}
+
+	e.Path = op.template
+	e.PathParams = map[string]string{}
+	for i, name := range op.path.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		value, err := url.PathUnescape(matches[i])
+		if err != nil {
+			value = matches[i]
+		}
+		e.PathParams[name] = value
+	}
+	e.Query = queryValues(req.URL.Query(), op.query)
+	for _, name := range op.headers {
+		if t.sanitize[strings.ToLower(name)] {
+			continue
+		}
+		if value := headerValue(req.Header, name); value != "" {
+			if e.Headers == nil {
+				e.Headers = map[string]string{}
+			}
+			e.Headers[name] = value
+		}
+	}
+	return e, nil
+}
+
+const redacted = "REDACTED"
+
+// headers that are never used for matching and are redacted in recordings
+var defaultSanitizedHeaders = []string{
+	"Authorization",
+	"client-request-id",
+	"Set-Cookie",
+	"traceparent",
+	"x-ms-client-request-id",
+	"x-ms-date",
+}
+
+type operation struct {
+	method   string
+	template string
+	path     *regexp.Regexp
+	query    []string
+	headers  []string
+}
+
+type recording struct {
+	Entries []*entry \`json:"entries"\`
+}
+
+type entry struct {
+	Operation       string              \`json:"operation,omitempty"\`
+	Method          string              \`json:"method"\`
+	Path            string              \`json:"path"\`
+	PathParams      map[string]string   \`json:"pathParams,omitempty"\`
+	Query           map[string]string   \`json:"query,omitempty"\`
+	Headers         map[string]string   \`json:"headers,omitempty"\`
+	RequestBody     string              \`json:"requestBody,omitempty"\`
+	StatusCode      int                 \`json:"statusCode"\`
+	ResponseHeaders map[string][]string \`json:"responseHeaders,omitempty"\`
+	ResponseBody    string              \`json:"responseBody,omitempty"\`
+}
+
+func (e *entry) matches(other *entry) bool {
+	return len(e.diff(other)) == 0
+}
+
+// diff returns a description of each value used for matching that differs between e and recorded.
+func (e *entry) diff(recorded *entry) []string {
+	var diffs []string
+	if e.Operation != recorded.Operation {
+		diffs = append(diffs, fmt.Sprintf("operation: got %q, recorded %q", e.Operation, recorded.Operation))
+	}
+	if e.Method != recorded.Method {
+		diffs = append(diffs, fmt.Sprintf("method: got %s, recorded %s", e.Method, recorded.Method))
+	}
+	if e.Path != recorded.Path {
+		diffs = append(diffs, fmt.Sprintf("path: got %s, recorded %s", e.Path, recorded.Path))
+	}
+	if !maps.Equal(e.PathParams, recorded.PathParams) {
+		diffs = append(diffs, fmt.Sprintf("path params: got %v, recorded %v", e.PathParams, recorded.PathParams))
+	}
+	if !maps.Equal(e.Query, recorded.Query) {
+		diffs = append(diffs, fmt.Sprintf("query: got %v, recorded %v", e.Query, recorded.Query))
+	}
+	if !maps.Equal(e.Headers, recorded.Headers) {
+		diffs = append(diffs, fmt.Sprintf("headers: got %v, recorded %v", e.Headers, recorded.Headers))
+	}
+	if e.RequestBody != recorded.RequestBody {
+		diffs = append(diffs, fmt.Sprintf("request body: got %s, recorded %s", e.RequestBody, recorded.RequestBody))
+	}
+	return diffs
+}
+
+// readRequestBody reads the body of req and replaces it so it can be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// normalizeBody returns the request body used for matching.
+// JSON bodies are compacted with their object fields sorted by name.
+func normalizeBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.Contains(strings.ToLower(contentType), "json") {
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		var v any
+		if err := decoder.Decode(&v); err == nil {
+			if normalized, err := json.Marshal(v); err == nil {
+				return string(normalized)
+			}
+		}
+	}
+	return string(body)
+}
+
+// queryValues returns the values for the named query params or all query params when names is nil.
+func queryValues(query url.Values, names []string) map[string]string {
+	values := map[string]string{}
+	if names == nil {
+		for name, v := range query {
+			values[name] = strings.Join(v, ",")
+		}
+	} else {
+		for _, name := range names {
+			if v, ok := query[name]; ok {
+				values[name] = strings.Join(v, ",")
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func headerValue(header http.Header, name string) string {
+	// request headers are set without canonicalizing their names
+	if v, ok := header[name]; ok {
+		return strings.Join(v, ",")
+	}
+	return strings.Join(header.Values(name), ",")
+}
+
+type nonRetriableError struct {
+	error
+}
+
+func (nonRetriableError) NonRetriable() {
+	// marker method
+}
+`;
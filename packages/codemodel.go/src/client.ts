@@ -45,6 +45,13 @@ export interface Client {
    */
   apiVersions: Array<type.ConstantDef>;
 
+  /**
+   * the API versions supported by the client, oldest first.
+   * only populated when API version checks are enabled and at least one
+   * method or parameter isn't available in all API versions. can be empty.
+   */
+  apiVersionHistory: Array<string>;
+
   /** the parent client in a hierarchical client */
   parent?: Client;
 }
@@ -97,7 +104,7 @@ export interface ClientCredentialParameter extends method.Parameter {
   kind: 'credentialParam';
 
   /** the parameter's type */
-  type: type.TokenCredential;
+  type: type.KeyCredential | type.TokenCredential;
 
   style: 'required';
 
@@ -233,6 +240,9 @@ export interface NextPageMethod {
   /** any modeled parameters */
   parameters: Array<param.MethodParameter>;
 
+  /** the parameter that receives the next link. it's one of the parameters in parameters */
+  nextLinkParam?: param.HeaderScalarParameter | param.PathScalarParameter | param.QueryScalarParameter;
+
   /** the complete list of successful HTTP status codes */
   httpStatusCodes: Array<number>;
 
@@ -275,6 +285,13 @@ export interface PageableStrategyNextLink {
 
   /** the custom method used to fetch the next link */
   method?: NextPageMethod;
+
+  /**
+   * the params from the initial request that are re-applied to the next link URL.
+   * these are for services that omit the params from the next link they return.
+   * not applicable when a custom method is used to fetch the next link.
+   */
+  reinjectedParams: Array<param.HeaderScalarParameter | param.QueryScalarParameter>;
 }
 
 /** narrows method to a LRO method type within the conditional block */
@@ -307,6 +324,12 @@ interface LROMethodBase extends HttpMethodBase {
   finalStateVia?: FinalStateVia;
 
   operationLocationResultPath?: string;
+
+  /**
+   * the model returned when polling the LRO.
+   * when set, the Begin method returns a poller that exposes the status monitor.
+   */
+  statusMonitor?: type.Model;
 }
 
 interface HttpMethodBase extends method.Method<Client, result.ResponseEnvelope> {
@@ -328,6 +351,12 @@ interface HttpMethodBase extends method.Method<Client, result.ResponseEnvelope>
   /** the complete list of successful HTTP status codes */
   httpStatusCodes: Array<number>;
 
+  /** the API versions in which the method is available. undefined when it's available in all API versions */
+  apiVersionRange?: type.APIVersionRange;
+
+  /** the typed error returned for unsuccessful HTTP status codes. undefined when a plain *azcore.ResponseError is returned */
+  errorType?: result.ServiceError;
+
   /** naming info for the internal hepler methods for which this method depends */
   naming: MethodNaming;
 
@@ -348,6 +377,14 @@ interface PageableMethodBase extends HttpMethodBase {
    * but doesn't (yet) support fetching subsequent pages.
    */
   strategy?: PageableStrategyKind;
+
+  /**
+   * the field path in the response that contains the page's items.
+   * when the items are nested in the response type, the array will
+   * contain the "path" to the items. the last field is a slice.
+   * undefined when the items path isn't known.
+   */
+  itemsPath?: Array<type.ModelField>;
 }
 
 class HttpMethodBase extends method.Method<Client, result.ResponseEnvelope> implements HttpMethodBase {
@@ -380,6 +417,7 @@ export class Client implements Client {
     this.parameters = new Array<ClientParameter>();
     this.pkg = pkg;
     this.apiVersions = new Array<type.ConstantDef>();
+    this.apiVersionHistory = new Array<string>();
   }
 }
 
@@ -406,8 +444,9 @@ export class Constructable implements Constructable {
 }
 
 export class ClientCredentialParameter extends method.Parameter implements ClientCredentialParameter {
-  constructor(name: string, type: type.TokenCredential) {
-    super(name, type, true);
+  constructor(name: string, type: type.KeyCredential | type.TokenCredential) {
+    // key credentials are passed by pointer
+    super(name, type, type.kind === 'tokenCredential');
     this.kind = 'credentialParam';
     this.style = 'required';
   }
@@ -496,6 +535,7 @@ export class PageableStrategyNextLink implements PageableStrategyNextLink {
   constructor(nextLinkPath: Array<type.ModelField>) {
     this.kind = 'nextLink';
     this.nextLinkPath = nextLinkPath;
+    this.reinjectedParams = new Array<param.HeaderScalarParameter | param.QueryScalarParameter>();
   }
 }
 
@@ -77,6 +77,72 @@ export interface Options {
 
   /** whether or not to gather all client parameters for the client factory. the default value is true */
   factoryGatherAllParams: boolean;
+
+  /**
+   * emits JSON marshallers/unmarshallers that write to and read from the token stream
+   * instead of going through intermediate maps. the default value is false
+   */
+  streamingJSONSerDe: boolean;
+
+  /** generates benchmarks for model JSON serde. the default value is false */
+  generateSerDeBenchmarks: boolean;
+
+  /** emits decimal types as json.Number instead of float64. the default value is false */
+  decimalAsJSONNumber: boolean;
+
+  /** emits duration types as time.Duration instead of their wire type. the default value is false */
+  durationAsTimeDuration: boolean;
+
+  /** emits Validate methods on models and validates parameters before sending requests. the default value is false */
+  validateConstraints: boolean;
+
+  /** emits a stateful, in-memory fake server for ARM resources. requires generateFakes. the default value is false */
+  generateInMemoryFakes: boolean;
+
+  /** emits http.Handler adapters for fake servers. requires generateFakes. the default value is false */
+  generateFakeHandlers: boolean;
+
+  /** emits a recording package containing a record/replay transport. the default value is false */
+  generateRecording: boolean;
+
+  /** emits fakes that reject requests that violate the operation's contract. requires generateFakes. the default value is false */
+  validateFakeRequests: boolean;
+
+  /** emits pollers that expose the typed status monitor for LROs that declare one. the default value is false */
+  typedStatusMonitors: boolean;
+
+  /** emits checks that reject operations and optional parameters that aren't available in the client's API version. the default value is false */
+  checkAPIVersions: boolean;
+
+  /** emits a typed field per result type for operations that return different schemas based on the HTTP status code. the default value is false */
+  statusCodeResults: boolean;
+
+  /** emits a typed error per error model that wraps *azcore.ResponseError and contains the service's error response body. the default value is false */
+  typedErrors: boolean;
+
+  /** groups conditional request headers into shared MatchConditions and RequestConditions types. the default value is false */
+  conditionalRequestTypes: boolean;
+
+  /** emits an All* method per pageable method that iterates over the items in all pages. the default value is false */
+  pagerIterators: boolean;
+
+  /** wires examples to fakes seeded with the example's response and emits Output blocks so they run as tests. requires generateExamples and generateFakes. the default value is false */
+  runnableExamples: boolean;
+
+  /** emits a *_fake_test.go file per client with a test per example that sends its request to the fakes. requires generateFakes. the default value is false */
+  generateFakeTests: boolean;
+
+  /** emits a <Client>API interface per client. client accessors and ClientFactory accessors return the interfaces. the default value is false */
+  generateInterfaces: boolean;
+
+  /** models used in JSON merge patch requests track fields that are set or cleared, and NewMergePatch computes a patch from two models. the default value is false */
+  mergePatchModels: boolean;
+
+  /** adds the templated URL path, API version, and ARM resource info to operation spans, and page and poll numbers to pager and poller spans. requires injectSpans. the default value is false */
+  spanAttributes: boolean;
+
+  /** records the duration and errors of each operation with the OperationMeter passed to the client in a NewOperationMeterPolicy. the default value is false */
+  operationMetrics: boolean;
 }
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////
@@ -8,7 +8,7 @@ import * as param from './param.js';
 import * as result from './result.js';
 import * as type from './type.js';
 
-export type ExampleType = AnyExample | ArrayExample | BooleanExample | DictionaryExample | NullExample | NumberExample | StringExample | StructExample | TokenCredentialExample;
+export type ExampleType = AnyExample | ArrayExample | BooleanExample | DictionaryExample | NullExample | NumberExample | StringExample | StructExample | TokenCredentialExample | UnionExample;
 
 export interface AnyExample {
   kind: 'any';
@@ -58,7 +58,7 @@ export interface NullExample {
 export interface NumberExample {
   kind: 'number';
   value: number;
-  type: type.Constant | type.Literal | type.Scalar | type.Time;
+  type: type.Constant | type.Duration | type.Literal | type.Scalar | type.Time;
 }
 
 export interface ParameterExample<T extends client.ClientParameter = client.ClientParameter> {
@@ -80,7 +80,7 @@ export interface ResponseHeaderExample {
 export interface StringExample {
   kind: 'string';
   value: string;
-  type: type.Constant | type.EncodedBytes | type.ETag | type.Literal | type.ReadSeekCloser | type.Scalar | type.String | type.Time;
+  type: type.Constant | type.Decimal | type.Duration | type.EncodedBytes | type.ETag | type.Literal | type.ReadSeekCloser | type.Scalar | type.String | type.Time;
 }
 
 export interface StructExample {
@@ -95,6 +95,15 @@ export interface TokenCredentialExample {
   value: string;
 }
 
+export interface UnionExample {
+  kind: 'union';
+  /** the example value for the selected variant */
+  value: ExampleType;
+  type: type.Union;
+  /** the variant of the union the value belongs to */
+  variant: type.UnionVariant;
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////
 ///////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -148,7 +157,7 @@ export class NullExample implements NullExample {
 }
 
 export class NumberExample implements NumberExample {
-  constructor(value: number, type: type.Constant | type.Literal | type.Scalar | type.Time) {
+  constructor(value: number, type: type.Constant | type.Duration | type.Literal | type.Scalar | type.Time) {
     this.kind = 'number';
     this.value = value;
     this.type = type;
@@ -177,7 +186,7 @@ export class ResponseHeaderExample implements ResponseHeaderExample {
 }
 
 export class StringExample implements StringExample {
-  constructor(value: string, type: type.Constant | type.EncodedBytes | type.ETag | type.Literal | type.ReadSeekCloser | type.Scalar | type.String | type.Time) {
+  constructor(value: string, type: type.Constant | type.Decimal | type.Duration | type.EncodedBytes | type.ETag | type.Literal | type.ReadSeekCloser | type.Scalar | type.String | type.Time) {
     this.kind = 'string';
     this.value = value;
     this.type = type;
@@ -198,3 +207,12 @@ export class TokenCredentialExample implements TokenCredentialExample {
     this.value = value;
   }
 }
+
+export class UnionExample implements UnionExample {
+  constructor(type: type.Union, variant: type.UnionVariant, value: ExampleType) {
+    this.kind = 'union';
+    this.type = type;
+    this.variant = variant;
+    this.value = value;
+  }
+}
@@ -59,6 +59,14 @@ export interface Package extends PackageBase {
   parent: ContainingModule | Module | Package;
 }
 
+/** represents the package used for the record/replay transport */
+export interface RecordingPackage {
+  kind: 'recording';
+
+  /** the container for this package */
+  parent: PackageContent;
+}
+
 /** represents the _test package for an existing package */
 export interface TestPackage {
   kind: 'test';
@@ -71,7 +79,7 @@ export interface TestPackage {
 export type PackageContent = Module | Package;
 
 /** the complete set of package types */
-export type PackageType = FakePackage | PackageContent | TestPackage;
+export type PackageType = FakePackage | PackageContent | RecordingPackage | TestPackage;
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////
 // helpers
@@ -85,9 +93,10 @@ export type PackageType = FakePackage | PackageContent | TestPackage;
  * @param pkg is the package source
  * @returns the package name for pkg
  */
-export function getPackageName(pkg: FakePackage | PackageContent | TestPackage): string {
+export function getPackageName(pkg: PackageType): string {
   switch (pkg.kind) {
     case 'fake':
+    case 'recording':
       return pkg.kind;
     case 'module':
       return path.basename(pkg.identity.replace(/\/v\d+$/, ''));
@@ -118,9 +127,15 @@ interface PackageBase {
   /** all of the response envelopes (responses.go file). can be empty */
   responseEnvelopes: Array<result.ResponseEnvelope>;
 
+  /** all of the typed errors for service error responses (errors.go file). can be empty */
+  errors: Array<result.ServiceError>;
+
   /** all of the interfaces for discriminated types (interfaces.go file) */
   interfaces: Array<type.Interface>;
 
+  /** all of the interfaces for non-discriminated unions (unions.go file). can be empty */
+  unions: Array<type.Union>;
+
   /** any subpackages within this package. can be empty */
   packages: Array<Package>;
 }
@@ -129,11 +144,13 @@ class PackageBase implements PackageBase {
   constructor() {
     this.clients = new Array<client.Client>();
     this.constants = new Array<type.Constant>();
+    this.errors = new Array<result.ServiceError>();
     this.interfaces = new Array<type.Interface>();
     this.models = new Array<type.Model | type.PolymorphicModel>();
     this.packages = new Array<Package>();
     this.paramGroups = new Array<type.Struct>();
     this.responseEnvelopes = new Array<result.ResponseEnvelope>();
+    this.unions = new Array<type.Union>();
   }
 }
 
@@ -171,6 +188,13 @@ export class Package extends PackageBase implements Package {
   }
 }
 
+export class RecordingPackage implements RecordingPackage {
+  constructor(parent: PackageContent) {
+    this.kind = 'recording';
+    this.parent = parent;
+  }
+}
+
 export class TestPackage implements TestPackage {
   constructor(src: PackageContent) {
     this.kind = 'test';
@@ -25,6 +25,7 @@ export type QueryParameter = QueryCollectionParameter | QueryScalarParameter;
 /** defines the possible method parameter types */
 export type MethodParameter =
   | BodyParameter
+  | CookieParameter
   | FormBodyParameter
   | HeaderParameter
   | MultipartFormBodyParameter
@@ -57,6 +58,17 @@ export interface ClientSideDefault {
   defaultValue: type.Literal;
 }
 
+/** a value that goes in the HTTP Cookie header */
+export interface CookieParameter extends HttpParameterBase {
+  kind: 'cookieParam';
+
+  /** the name of the cookie in the HTTP request */
+  cookieName: string;
+
+  /** the type of the parameter */
+  type: HeaderScalarType;
+}
+
 /** indicates how a collection is formatted on the wire */
 export type CollectionFormat = 'csv' | 'ssv' | 'tsv' | 'pipes';
 
@@ -131,7 +143,7 @@ export interface HeaderScalarParameter extends HttpParameterBase {
 }
 
 /** defines the possible types for a scalar header */
-export type HeaderScalarType = type.Constant | type.EncodedBytes | type.ETag | type.Literal | type.Scalar | type.String | type.Time;
+export type HeaderScalarType = type.Constant | type.Decimal | type.Duration | type.EncodedBytes | type.ETag | type.Literal | type.Scalar | type.String | type.Time;
 
 /** parameter goes in multipart/form body */
 export interface MultipartFormBodyParameter extends HttpParameterBase {
@@ -174,6 +186,12 @@ export interface ParameterGroup {
   /** the parameters that belong to this group */
   params: Array<MethodParameter>;
 
+  /**
+   * indicates the group is shared by methods that might not support all of its params.
+   * when true, a method returns an error if a param it doesn't support is set.
+   */
+  rejectUnsupportedParams: boolean;
+
   /** the package to which this type belongs */
   pkg: module.PackageContent;
 }
@@ -236,7 +254,7 @@ export interface PathScalarParameter extends HttpParameterBase {
 }
 
 /** defines the possible types for a PathScalarParameter */
-export type PathScalarParameterType = type.Constant | type.EncodedBytes | type.Literal | type.Scalar | type.String | type.Time;
+export type PathScalarParameterType = type.Constant | type.Decimal | type.Duration | type.EncodedBytes | type.Literal | type.Scalar | type.String | type.Time;
 
 /** a reference to an existing parameter */
 export interface ParameterRef {
@@ -284,7 +302,7 @@ export interface QueryScalarParameter extends HttpParameterBase {
 }
 
 /** defines the possible types for a QueryScalarParameter */
-export type QueryScalarParameterType = type.Constant | type.EncodedBytes | type.Literal | type.Scalar | type.String | type.Time;
+export type QueryScalarParameterType = type.Constant | type.Decimal | type.Duration | type.EncodedBytes | type.Literal | type.Scalar | type.String | type.Time;
 
 /** the synthesized resume token parameter for LROs */
 export interface ResumeTokenParameter extends HttpParameterBase {
@@ -330,6 +348,8 @@ export function isHeaderParameter(param: MethodParameter): param is HeaderParame
 export function isHeaderScalarType(type: type.WireType): type is HeaderScalarType {
   switch (type.kind) {
     case 'constant':
+    case 'decimal':
+    case 'duration':
     case 'encodedBytes':
     case 'etag':
     case 'literal':
@@ -351,6 +371,8 @@ export function isPathParameter(param: MethodParameter): param is PathParameter
 export function isPathScalarParameterType(type: type.WireType): type is PathScalarParameterType {
   switch (type.kind) {
     case 'constant':
+    case 'decimal':
+    case 'duration':
     case 'encodedBytes':
     case 'literal':
     case 'scalar':
@@ -371,6 +393,8 @@ export function isQueryParameter(param: MethodParameter): param is QueryParamete
 export function isQueryScalarParameterType(type: type.WireType): type is QueryScalarParameterType {
   switch (type.kind) {
     case 'constant':
+    case 'decimal':
+    case 'duration':
     case 'encodedBytes':
     case 'literal':
     case 'scalar':
@@ -430,6 +454,12 @@ interface HttpParameterBase extends method.Parameter {
 
   /** indicates if the parameter is part of the method signature or a value on the client */
   location: ParameterLocation;
+
+  /** any constraints on the parameter's value */
+  constraints?: type.Constraints;
+
+  /** the API versions in which the parameter is available. undefined when it's available in all API versions */
+  apiVersionRange?: type.APIVersionRange;
 }
 
 class HttpParameterBase extends method.Parameter implements HttpParameterBase {
@@ -459,6 +489,14 @@ export class ClientSideDefault implements ClientSideDefault {
   }
 }
 
+export class CookieParameter extends HttpParameterBase implements CookieParameter {
+  constructor(name: string, cookieName: string, type: HeaderScalarType, style: ParameterStyle, byValue: boolean, location: ParameterLocation) {
+    super(name, type, style, byValue, location);
+    this.kind = 'cookieParam';
+    this.cookieName = cookieName;
+  }
+}
+
 export class FormBodyCollectionParameter extends HttpParameterBase implements FormBodyCollectionParameter {
   constructor(name: string, formDataName: string, type: type.Slice, collectionFormat: ExtendedCollectionFormat, style: ParameterStyle, byValue: boolean) {
     super(name, type, style, byValue, 'method');
@@ -517,6 +555,7 @@ export class ParameterGroup implements ParameterGroup {
     this.name = name;
     // params is required but must be populated post construction
     this.params = new Array<MethodParameter>();
+    this.rejectUnsupportedParams = false;
     this.required = required;
     this.docs = {};
     this.pkg = pkg;
@@ -4,11 +4,12 @@
  *--------------------------------------------------------------------------------------------*/
 
 import * as client from './client.js';
+import * as module from './module.js';
 import * as param from './param.js';
 import * as type from './type.js';
 
 /** defines the possible method result types within a response envelope */
-export type Result = AnyResult | BinaryResult | HeadAsBooleanResult | ModelResult | MonomorphicResult | PolymorphicResult;
+export type Result = AnyResult | BinaryResult | HeadAsBooleanResult | ModelResult | MonomorphicResult | PolymorphicResult | StatusCodeResult | StreamResult;
 
 /** for endpoints that return a different schema based on the HTTP status code */
 export interface AnyResult {
@@ -52,6 +53,9 @@ export interface HeadAsBooleanResult {
   docs: type.Docs;
 }
 
+/** the union of all response header types */
+export type HeaderResponse = HeaderMapResponse | HeaderScalarResponse | SetCookieResponse;
+
 /**
  * a collection of header responses.
  * NOTE: this is a specialized type to support storage.
@@ -143,7 +147,7 @@ export interface MonomorphicResult {
 }
 
 /** the possible monomorphic result types */
-export type MonomorphicResultType = type.Any | type.Constant | type.EncodedBytes | type.Map | type.RawJSON | type.Scalar | type.Slice | type.String | type.Time;
+export type MonomorphicResultType = type.Any | type.Constant | type.Decimal | type.Duration | type.EncodedBytes | type.Map | type.RawJSON | type.Scalar | type.Slice | type.String | type.Time | type.Union;
 
 /**
  * used for methods that return a discriminated type.
@@ -185,17 +189,133 @@ export interface ResponseEnvelope {
   result?: Result;
 
   /** any modeled response headers. can be empty */
-  headers: Array<HeaderScalarResponse | HeaderMapResponse>;
+  headers: Array<HeaderResponse>;
 
   /** the method that returns this type */
   method: client.MethodType;
 }
 
+/**
+ * a typed error for a service's error response body.
+ * it wraps the *azcore.ResponseError created from the response.
+ */
+export interface ServiceError {
+  kind: 'serviceError';
+
+  /** the name of the error type */
+  name: string;
+
+  /** any docs for the error type */
+  docs: type.Docs;
+
+  /** the model for the error response body */
+  model: type.Model;
+}
+
+/**
+ * the cookies returned in the Set-Cookie headers of a HTTP response.
+ * the field's type is always []*http.Cookie.
+ */
+export interface SetCookieResponse {
+  kind: 'setCookieResponse';
+
+  /** the name of the field within the response envelope */
+  fieldName: string;
+
+  /** any docs for the header */
+  docs: type.Docs;
+}
+
+/**
+ * for endpoints that return a different schema based on the HTTP status code.
+ * unlike AnyResult, each schema gets its own typed field within the response envelope.
+ */
+export interface StatusCodeResult {
+  kind: 'statusCodeResult';
+
+  /** any docs for the result */
+  docs: type.Docs;
+
+  /**
+   * the fields within the response envelope, one per distinct result type.
+   * status codes that don't return a schema won't have a field.
+   */
+  fields: Array<StatusCodeResultField>;
+
+  /** the format in which the result is returned */
+  format: ResultFormat;
+}
+
+/** a field within a StatusCodeResult */
+export interface StatusCodeResultField {
+  /** the name of the field within the response envelope */
+  fieldName: string;
+
+  /** any docs for the field */
+  docs: type.Docs;
+
+  /** the HTTP status codes for which the field is populated */
+  httpStatusCodes: Array<number>;
+
+  /** the type of the field */
+  type: type.WireType;
+}
+
+/** for endpoints that return a stream of typed items (e.g. JSONL or server-sent events) */
+export interface StreamResult {
+  kind: 'streamResult';
+
+  /** the name of the field within the response envelope */
+  fieldName: string;
+
+  /** any docs for the result */
+  docs: type.Docs;
+
+  /** the stream type returned in the response envelope */
+  streamType: type.EventStream;
+
+  /** the wire format of the stream */
+  format: StreamFormat;
+
+  /**
+   * the events that can be sent in a stream of server-sent events.
+   * the non-terminal events are the variants of the stream's item type.
+   * this is empty for JSONL streams.
+   */
+  events: Array<StreamEvent>;
+}
+
+/** an event within a stream of server-sent events */
+export interface StreamEvent {
+  /** the event type sent over the wire (i.e. the value of the event field) */
+  name: string;
+
+  /** the union variant that contains the event's data. undefined for terminal events */
+  variant?: type.UnionVariant;
+
+  /** the format of the event's data */
+  format: StreamEventFormat;
+
+  /** indicates that the stream ends after this event */
+  terminal: boolean;
+
+  /** for terminal events that are identified by their data (e.g. [DONE]) */
+  data?: string;
+}
+
+/** the wire format for the event data within a stream of server-sent events */
+export type StreamEventFormat = 'JSON' | 'Text';
+
+/** the wire format for streams */
+export type StreamFormat = 'JSONL' | 'SSE';
+
 /** indicates the wire format for response bodies */
 export type ResultFormat = 'JSON' | 'XML' | 'Text';
 
 /** returns the underlying type used for the specified result type */
-export function getResultType(result: Result): type.Interface | type.Model | MonomorphicResultType | type.Scalar | type.ReadCloser | type.PolymorphicModel {
+export function getResultType(
+  result: Exclude<Result, StatusCodeResult>,
+): type.EventStream | type.Interface | type.Model | MonomorphicResultType | type.Scalar | type.ReadCloser | type.PolymorphicModel {
   switch (result.kind) {
     case 'anyResult':
       return new type.Any();
@@ -209,6 +329,8 @@ export function getResultType(result: Result): type.Interface | type.Model | Mon
       return result.monomorphicType;
     case 'polymorphicResult':
       return result.interface;
+    case 'streamResult':
+      return result.streamType;
   }
 }
 
@@ -217,6 +339,8 @@ export function isMonomorphicResultType(type: type.WireType): type is Monomorphi
   switch (type.kind) {
     case 'any':
     case 'constant':
+    case 'decimal':
+    case 'duration':
     case 'encodedBytes':
     case 'map':
     case 'rawJSON':
@@ -224,6 +348,7 @@ export function isMonomorphicResultType(type: type.WireType): type is Monomorphi
     case 'slice':
     case 'string':
     case 'time':
+    case 'union':
       return true;
     default:
       return false;
@@ -313,8 +438,86 @@ export class ResponseEnvelope implements ResponseEnvelope {
   constructor(name: string, docs: type.Docs, forMethod: client.MethodType) {
     this.kind = 'responseEnvelope';
     this.docs = docs;
-    this.headers = new Array<HeaderScalarResponse | HeaderMapResponse>();
+    this.headers = new Array<HeaderResponse>();
     this.method = forMethod;
     this.name = name;
   }
 }
+
+export class ServiceError implements ServiceError {
+  constructor(model: type.Model) {
+    this.kind = 'serviceError';
+    this.name = `${model.name}Error`;
+    this.model = model;
+    this.docs = {};
+  }
+}
+
+export class SetCookieResponse implements SetCookieResponse {
+  constructor(fieldName: string) {
+    this.kind = 'setCookieResponse';
+    this.fieldName = fieldName;
+    this.docs = {};
+  }
+}
+
+export class StreamEvent implements StreamEvent {
+  constructor(name: string, format: StreamEventFormat, terminal: boolean) {
+    this.name = name;
+    this.format = format;
+    this.terminal = terminal;
+  }
+}
+
+export class StatusCodeResult implements StatusCodeResult {
+  constructor(format: ResultFormat, resultTypes: Record<number, type.WireType>, scope: module.PackageType) {
+    this.kind = 'statusCodeResult';
+    this.format = format;
+    this.docs = {};
+
+    // status codes that return the same type share a field
+    const fields = new Map<string, StatusCodeResultField>();
+    for (const [statusCode, resultType] of Object.entries(resultTypes)) {
+      const typeDecl = type.getTypeDeclaration(resultType, scope);
+      const field = fields.get(typeDecl);
+      if (field) {
+        field.httpStatusCodes.push(Number(statusCode));
+        continue;
+      }
+      let fieldName: string;
+      switch (resultType.kind) {
+        case 'constant':
+        case 'interface':
+        case 'model':
+        case 'polymorphicModel':
+        case 'union':
+          fieldName = resultType.name;
+          break;
+        default:
+          fieldName = `Value${statusCode}`;
+      }
+      fields.set(typeDecl, new StatusCodeResultField(fieldName, resultType, [Number(statusCode)]));
+    }
+    this.fields = [...fields.values()].sort((a, b) => a.fieldName.localeCompare(b.fieldName));
+  }
+}
+
+export class StatusCodeResultField implements StatusCodeResultField {
+  constructor(fieldName: string, type: type.WireType, httpStatusCodes: Array<number>) {
+    this.fieldName = fieldName;
+    this.type = type;
+    this.httpStatusCodes = httpStatusCodes;
+    this.docs = {};
+  }
+}
+
+export class StreamResult implements StreamResult {
+  constructor(fieldName: string, streamType: type.EventStream, format: StreamFormat) {
+    this.kind = 'streamResult';
+    this.fieldName = fieldName;
+    this.streamType = streamType;
+    this.format = format;
+    this.events = new Array<StreamEvent>();
+    this.docs = {};
+  }
+}
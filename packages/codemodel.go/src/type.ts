@@ -19,7 +19,7 @@ export interface Docs {
 }
 
 /** defines types used in generated code but do not go across the wire */
-export type SdkType = ArmClientOptions | ClientOptions | ParameterGroup | ResponseEnvelope | TokenCredential;
+export type SdkType = ArmClientOptions | ClientOptions | EventStream | KeyCredential | ParameterGroup | ResponseEnvelope | TokenCredential;
 
 /** defines types that go across the wire */
 export type WireType =
@@ -27,6 +27,8 @@ export type WireType =
   | Constant
   | ConstantDef
   | ConstantValue
+  | Decimal
+  | Duration
   | EncodedBytes
   | ETag
   | Interface
@@ -41,7 +43,8 @@ export type WireType =
   | Scalar
   | Slice
   | String
-  | Time;
+  | Time
+  | Union;
 
 /** defines a type within the Go type system */
 export type Type = SdkType | WireType;
@@ -51,6 +54,18 @@ export interface Any {
   kind: 'any';
 }
 
+/**
+ * the range of API versions in which an operation or parameter is available.
+ * an undefined min or max leaves that end of the range open.
+ */
+export interface APIVersionRange {
+  /** the first API version in which it's available */
+  min?: string;
+
+  /** the last API version in which it's available */
+  max?: string;
+}
+
 /** an arm.ClientOptions type from azcore */
 export interface ArmClientOptions extends QualifiedType {
   kind: 'armClientOptions';
@@ -77,6 +92,12 @@ export interface Constant {
 
   /** the name of the func that returns the set of values */
   valuesFuncName: string;
+
+  /**
+   * indicates if values are restricted to the possible values.
+   * when false, the service can add values (i.e. the enum is extensible).
+   */
+  fixed: boolean;
 }
 
 /** the underlying type of a const */
@@ -113,6 +134,61 @@ export interface ConstantDef {
 /** the underlying type of a const value */
 export type ConstantValueType = boolean | number | string;
 
+/** constraints on a value that are validated before a request is sent */
+export interface Constraints {
+  /** the minimum length of a string */
+  minLength?: number;
+
+  /** the maximum length of a string */
+  maxLength?: number;
+
+  /** the regular expression a string must match */
+  pattern?: string;
+
+  /** the minimum value of a number (inclusive) */
+  minValue?: number;
+
+  /** the maximum value of a number (inclusive) */
+  maxValue?: number;
+
+  /** the minimum value of a number (exclusive) */
+  minValueExclusive?: number;
+
+  /** the maximum value of a number (exclusive) */
+  maxValueExclusive?: number;
+
+  /** the minimum number of items in a slice */
+  minItems?: number;
+
+  /** the maximum number of items in a slice */
+  maxItems?: number;
+}
+
+/**
+ * an arbitrary-precision decimal number (json.Number).
+ * the value retains the exact text sent over the wire.
+ */
+export interface Decimal extends QualifiedType {
+  kind: 'decimal';
+
+  /** indicates the value is sent/received as a string */
+  encodeAsString: boolean;
+}
+
+/** a time.Duration type from the standard library with a format specifier */
+export interface Duration extends QualifiedType {
+  kind: 'duration';
+
+  /** the serde format used */
+  format: DurationFormat;
+}
+
+/**
+ * the set of duration serde formats.
+ * the Int formats are encoded as integers instead of floats.
+ */
+export type DurationFormat = 'ISO8601' | 'Milliseconds' | 'MillisecondsInt' | 'Seconds' | 'SecondsInt';
+
 /** a byte slice that's base64 encoded */
 export interface EncodedBytes {
   kind: 'encodedBytes';
@@ -129,6 +205,17 @@ export interface ETag extends QualifiedType {
   kind: 'etag';
 }
 
+/** a stream of items decoded from a response body (e.g. JSONL or server-sent events) */
+export interface EventStream {
+  kind: 'eventStream';
+
+  /** the type of the items in the stream */
+  itemType: WireType;
+
+  /** the package that contains the stream type */
+  pkg: PackageContent;
+}
+
 /** a Go interface type used for discriminated types */
 export interface Interface {
   kind: 'interface';
@@ -207,6 +294,9 @@ export interface ModelField extends StructField {
 
   /** any XML metadata */
   xml?: XMLInfo;
+
+  /** any constraints on the field's value */
+  constraints?: Constraints;
 }
 
 /** additional settings for a model type */
@@ -216,6 +306,9 @@ export interface ModelAnnotations {
 
   /** indicates the model should be converted into multipart/form data */
   multipartFormData: boolean;
+
+  /** indicates the model is sent as a JSON merge patch and tracks its cleared fields */
+  mergePatch: boolean;
 }
 
 /** additional settings for a model field */
@@ -346,6 +439,23 @@ export interface Time extends QualifiedType {
 /** the set of time serde formats */
 export type TimeFormat = 'PlainDate' | 'RFC1123' | 'RFC3339' | 'RFC7231' | 'PlainTime' | 'Unix';
 
+/** an azcore.KeyCredential */
+export interface KeyCredential extends QualifiedType {
+  kind: 'keyCredential';
+
+  /** indicates where in the HTTP request the key is placed */
+  location: KeyCredentialLocation;
+
+  /** the name of the HTTP request header or query parameter in which the key is placed */
+  name: string;
+
+  /** optional prefix placed before the key (e.g. "Bearer ") */
+  prefix?: string;
+}
+
+/** the possible locations of a key in an HTTP request */
+export type KeyCredentialLocation = 'header' | 'query';
+
 /** an azcore.TokenCredential */
 export interface TokenCredential extends QualifiedType {
   kind: 'tokenCredential';
@@ -354,6 +464,42 @@ export interface TokenCredential extends QualifiedType {
   scopes: Array<string>;
 }
 
+/** a Go interface type used for non-discriminated unions */
+export interface Union {
+  kind: 'union';
+
+  /** the name of the interface (e.g. PetUnion) */
+  name: string;
+
+  /** any docs for the interface */
+  docs: Docs;
+
+  /**
+   * the variants for this union in the order they're declared.
+   * unmarshalling prefers a model variant whose discriminator or fields
+   * match the payload, then tries each variant in this order.
+   */
+  variants: Array<UnionVariant>;
+
+  /** the package to which this type belongs */
+  pkg: PackageContent;
+}
+
+/** a wrapper type for one variant of a union (e.g. PetUnionString) */
+export interface UnionVariant {
+  /** the name of the wrapper type */
+  name: string;
+
+  /** the type of the wrapped value */
+  type: UnionVariantType;
+
+  /** indicates if the wrapped value is pointer-to-type or not */
+  byValue: boolean;
+}
+
+/** the set of union variant types */
+export type UnionVariantType = Any | Constant | Map | Model | PolymorphicModel | Scalar | Slice | String;
+
 /** bit flags indicating how a model/polymorphic type is used */
 export enum UsageFlags {
   /** the type is unreferenced */
@@ -438,7 +584,8 @@ export function getTypeDeclaration(type: Client | Type, scope: PackageType): str
     case 'model':
     case 'paramGroup':
     case 'polymorphicModel':
-    case 'responseEnvelope': {
+    case 'responseEnvelope':
+    case 'union': {
       let pkg: PackageType;
       const typeName = type.kind === 'paramGroup' ? type.groupName : type.name;
       switch (type.kind) {
@@ -460,6 +607,13 @@ export function getTypeDeclaration(type: Client | Type, scope: PackageType): str
     }
     case 'constantDef':
       return type.literal.type.kind;
+    case 'eventStream': {
+      const streamType = `EventStream[${getTypeDeclaration(type.itemType, scope)}]`;
+      if (type.pkg !== scope) {
+        return `${getPackageName(type.pkg)}.${streamType}`;
+      }
+      return streamType;
+    }
     case 'encodedBytes':
     case 'rawJSON':
       return '[]byte';
@@ -474,7 +628,10 @@ export function getTypeDeclaration(type: Client | Type, scope: PackageType): str
     case 'time':
       return 'time.Time';
     case 'armClientOptions':
+    case 'decimal':
+    case 'duration':
     case 'etag':
+    case 'keyCredential':
     case 'multipartContent':
     case 'readCloser':
     case 'readSeekCloser':
@@ -601,6 +758,7 @@ export class Constant implements Constant {
     this.type = type;
     this.values = new Array<ConstantValue>();
     this.valuesFuncName = valuesFuncName;
+    this.fixed = false;
     this.docs = {};
   }
 }
@@ -630,6 +788,22 @@ export class EncodedBytes implements EncodedBytes {
   }
 }
 
+export class Decimal extends QualifiedType implements Decimal {
+  constructor(encodeAsString: boolean) {
+    super('Number', 'encoding/json');
+    this.kind = 'decimal';
+    this.encodeAsString = encodeAsString;
+  }
+}
+
+export class Duration extends QualifiedType implements Duration {
+  constructor(format: DurationFormat) {
+    super('Duration', 'time');
+    this.kind = 'duration';
+    this.format = format;
+  }
+}
+
 export class ETag extends QualifiedType implements ETag {
   constructor() {
     super('ETag', 'github.com/Azure/azure-sdk-for-go/sdk/azcore');
@@ -637,6 +811,14 @@ export class ETag extends QualifiedType implements ETag {
   }
 }
 
+export class EventStream implements EventStream {
+  constructor(itemType: WireType, pkg: PackageContent) {
+    this.kind = 'eventStream';
+    this.itemType = itemType;
+    this.pkg = pkg;
+  }
+}
+
 export class Interface implements Interface {
   // WireTypes and rootType are required. however, we have a chicken-and-egg
   // problem as creating a PolymorphicType requires the necessary InterfaceType.
@@ -668,9 +850,10 @@ export class Map implements Map {
 }
 
 export class ModelAnnotations implements ModelAnnotations {
-  constructor(omitSerDe: boolean, multipartForm: boolean) {
+  constructor(omitSerDe: boolean, multipartForm: boolean, mergePatch: boolean) {
     this.omitSerDeMethods = omitSerDe;
     this.multipartFormData = multipartForm;
+    this.mergePatch = mergePatch;
   }
 }
 
@@ -774,6 +957,15 @@ export class Time extends QualifiedType implements Time {
   }
 }
 
+export class KeyCredential extends QualifiedType implements KeyCredential {
+  constructor(location: KeyCredentialLocation, name: string) {
+    super('KeyCredential', 'github.com/Azure/azure-sdk-for-go/sdk/azcore');
+    this.kind = 'keyCredential';
+    this.location = location;
+    this.name = name;
+  }
+}
+
 export class TokenCredential extends QualifiedType implements TokenCredential {
   constructor(scopes: Array<string>) {
     super('TokenCredential', 'github.com/Azure/azure-sdk-for-go/sdk/azcore');
@@ -782,6 +974,26 @@ export class TokenCredential extends QualifiedType implements TokenCredential {
   }
 }
 
+export class Union implements Union {
+  // variants can reference the union itself (e.g. via a slice)
+  // so they MUST be populated after creating the Union.
+  constructor(pkg: PackageContent, name: string) {
+    this.kind = 'union';
+    this.name = name;
+    this.pkg = pkg;
+    this.variants = new Array<UnionVariant>();
+    this.docs = {};
+  }
+}
+
+export class UnionVariant implements UnionVariant {
+  constructor(name: string, type: UnionVariantType, byValue: boolean) {
+    this.name = name;
+    this.type = type;
+    this.byValue = byValue;
+  }
+}
+
 export class XMLInfo implements XMLInfo {
   constructor() {
     this.attribute = false;
@@ -33,7 +33,7 @@ const httpSpecsGroup = {
   'querygroup': ['parameters/query'],
   'spreadgroup': ['parameters/spread'],
   'contentneggroup': ['payload/content-negotiation'],
-  'jmergepatchgroup': ['payload/json-merge-patch'],
+  'jmergepatchgroup': ['payload/json-merge-patch', 'merge-patch-models=true'],
   'mediatypegroup': ['payload/media-type'],
   'multipartgroup': ['payload/multipart'],
   'pageablegroup': ['payload/pageable'], // missing support for continuation tokens: https://github.com/Azure/autorest.go/issues/1494
@@ -130,7 +130,7 @@ const azureHttpSpecsGroup = {
   'clientnamespacegroup': ['client/namespace'],
   'overloadgroup': ['client/overload/client.tsp'],
   'srvdrivenoldgroup': ['resiliency/srv-driven/old.tsp'],
-  'srvdrivennewgroup': ['resiliency/srv-driven'],
+  'srvdrivennewgroup': ['resiliency/srv-driven', 'check-api-versions=true'],
   'multipleservicesgroup' : ['/service/multiple-services'],
   'multiservicegroup' : ['/service/multi-service'],
 };
@@ -173,41 +173,48 @@ function should_generate(name) {
 }
 
 const armcodesigning = pkgRoot + 'test/tsp/CodeSigning.Management';
-generate('armcodesigning', armcodesigning, 'test/local/armcodesigning', [`examples-directory=${armcodesigning}/examples`, 'generate-samples=true']);
+generate('armcodesigning', armcodesigning, 'test/local/armcodesigning', [`examples-directory=${armcodesigning}/examples`, 'generate-samples=true', 'runnable-samples=true', 'generate-in-memory-fakes=true', 'generate-fake-handlers=true', 'generate-recording=true']);
 
 const armapicenter = pkgRoot +  'test/tsp/ApiCenter.Management';
-generate('armapicenter', armapicenter, 'test/local/armapicenter', [`examples-directory=${armapicenter}/examples`, 'generate-samples=true']);
+generate('armapicenter', armapicenter, 'test/local/armapicenter', [`examples-directory=${armapicenter}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armcognitiveservices = pkgRoot +  'test/tsp/CognitiveServices.Management';
-generate('armcognitiveservices', armcognitiveservices, 'test/local/armcognitiveservices', [`examples-directory=${armcognitiveservices}/examples`, 'generate-samples=true']);
+generate('armcognitiveservices', armcognitiveservices, 'test/local/armcognitiveservices', [`examples-directory=${armcognitiveservices}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armlargeinstance = pkgRoot + 'test/tsp/AzureLargeInstance.Management';
-generate('armlargeinstance', armlargeinstance, 'test/local/armlargeinstance', ['stutter=AzureLargeInstance', `examples-directory=${armlargeinstance}/examples`, 'generate-samples=true']);
+generate('armlargeinstance', armlargeinstance, 'test/local/armlargeinstance', ['stutter=AzureLargeInstance', `examples-directory=${armlargeinstance}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armdatabasewatcher = pkgRoot + 'test/tsp/DatabaseWatcher.Management';
-generate('armdatabasewatcher', armdatabasewatcher, 'test/local/armdatabasewatcher', ['fix-const-stuttering=false', `examples-directory=${armdatabasewatcher}/examples`, 'generate-samples=true']);
+generate('armdatabasewatcher', armdatabasewatcher, 'test/local/armdatabasewatcher', ['fix-const-stuttering=false', `examples-directory=${armdatabasewatcher}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armloadtestservice = pkgRoot + 'test/tsp/LoadTestService.Management';
-generate('armloadtestservice', armloadtestservice, 'test/local/armloadtestservice', [`examples-directory=${armloadtestservice}/examples`, 'generate-samples=true', 'factory-gather-all-params=false']);
+generate('armloadtestservice', armloadtestservice, 'test/local/armloadtestservice', [`examples-directory=${armloadtestservice}/examples`, 'generate-samples=true', 'runnable-samples=true', 'factory-gather-all-params=false']);
 
 const armdevopsinfrastructure = pkgRoot + 'test/tsp/Microsoft.DevOpsInfrastructure';
-generate('armdevopsinfrastructure', armdevopsinfrastructure, 'test/local/armdevopsinfrastructure', [`examples-directory=${armdevopsinfrastructure}/examples`, 'generate-samples=true']);
+generate('armdevopsinfrastructure', armdevopsinfrastructure, 'test/local/armdevopsinfrastructure', [`examples-directory=${armdevopsinfrastructure}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armrandom = pkgRoot + 'test/tsp/Random.Management';
 generate('armrandom', armrandom, 'test/local/armrandom');
 
 const armcommunitymanagement = pkgRoot + 'test/tsp/Community.Management';
-generate('armcommunitymanagement', armcommunitymanagement, 'test/local/armcommunitymanagement', [`examples-directory=${armcommunitymanagement}/examples`, 'generate-samples=true']);
+generate('armcommunitymanagement', armcommunitymanagement, 'test/local/armcommunitymanagement', [`examples-directory=${armcommunitymanagement}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armmongocluster = pkgRoot + 'test/tsp/MongoCluster.Management';
-generate('armmongocluster', armmongocluster, 'test/local/armmongocluster', [`examples-directory=${armmongocluster}/examples`, 'generate-samples=true']);
+generate('armmongocluster', armmongocluster, 'test/local/armmongocluster', [`examples-directory=${armmongocluster}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armcontainerorchestratorruntime = pkgRoot + 'test/tsp/KubernetesRuntime.Management';
-generate('armcontainerorchestratorruntime', armcontainerorchestratorruntime, 'test/local/armcontainerorchestratorruntime', [`examples-directory=${armcontainerorchestratorruntime}/examples`, 'generate-samples=true']);
+generate('armcontainerorchestratorruntime', armcontainerorchestratorruntime, 'test/local/armcontainerorchestratorruntime', [`examples-directory=${armcontainerorchestratorruntime}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const azmodelsonly = pkgRoot + 'test/tsp/ModelsOnlyWithBaseTypes';
 generate('azmodelsonly', azmodelsonly, 'test/local/azmodelsonly');
 
+const azstreamingserde = pkgRoot + 'test/tsp/StreamingSerDe';
+generate('azstreamingserde', azstreamingserde, 'test/local/azstreamingserde', ['streaming-json-serde=true', 'generate-serde-benchmarks=true']);
+generate('azstreamingserde', azstreamingserde, 'test/local/azstreamingserde/mapserde', ['containing-module=azstreamingserde', 'generate-serde-benchmarks=true']);
+
+const azunions = pkgRoot + 'test/tsp/Unions';
+generate('azunions', azunions, 'test/local/azunions');
+
 const azkeys = pkgRoot + 'test/tsp/KeyVault.Keys/client.tsp';
 generate('azkeys', azkeys, 'test/local/azkeys', ['single-client=true', 'omit-constructors=true']);
 
@@ -215,25 +222,34 @@ const azblob = pkgRoot + 'test/tsp/Microsoft.BlobStorage/client.tsp';
 generate('azblob', azblob, 'test/local/azblob', ['generate-fakes=false', 'omit-constructors=true', 'inject-spans=false']);
 
 const armtest = pkgRoot + 'test/tsp/Test.Management';
-generate('armtest/v2', armtest, 'test/local/armtest', [`examples-directory=${armtest}/examples`, 'generate-samples=true', 'factory-gather-all-params=false']);
+generate('armtest/v2', armtest, 'test/local/armtest', [`examples-directory=${armtest}/examples`, 'generate-samples=true', 'runnable-samples=true', 'factory-gather-all-params=false']);
 
 const internalpager = pkgRoot + 'test/tsp/Internal.Pager';
 generate('internalpager', internalpager, 'test/local/internalpager', ['generate-fakes=false']);
 
+const azapikeyquery = pkgRoot + 'test/tsp/ApiKey.Query';
+generate('azapikeyquery', azapikeyquery, 'test/local/azapikeyquery');
+
+const aznextpage = pkgRoot + 'test/tsp/NextPage.Operations';
+generate('aznextpage', aznextpage, 'test/local/aznextpage');
+
+const reinjectedpager = pkgRoot + 'test/tsp/Reinjected.Pager';
+generate('reinjectedpager', reinjectedpager, 'test/local/reinjectedpager', ['generate-interfaces=true']);
+
 const armoracledatabase = pkgRoot + 'test/tsp/Oracle.Database.Management';
-generate('armoracledatabase/v2', armoracledatabase, 'test/local/armoracledatabase', [`examples-directory=${armoracledatabase}/examples`, 'generate-samples=true']);
+generate('armoracledatabase/v2', armoracledatabase, 'test/local/armoracledatabase', [`examples-directory=${armoracledatabase}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armhealthbot = pkgRoot + 'test/tsp/Healthbot.Management';
-generate('armhealthbot', armhealthbot, 'test/local/armhealthbot', [`examples-directory=${armhealthbot}/examples`, 'generate-samples=true']);
+generate('armhealthbot', armhealthbot, 'test/local/armhealthbot', [`examples-directory=${armhealthbot}/examples`, 'generate-samples=true', 'runnable-samples=true', 'generate-fake-tests=true', 'span-attributes=true', 'operation-metrics=true']);
 
 const armhardwaresecuritymodules = pkgRoot + 'test/tsp/HardwareSecurityModules.Management';
-generate('armhardwaresecuritymodules', armhardwaresecuritymodules, 'test/local/armhardwaresecuritymodules', [`examples-directory=${armhardwaresecuritymodules}/examples`, 'generate-samples=true']);
+generate('armhardwaresecuritymodules', armhardwaresecuritymodules, 'test/local/armhardwaresecuritymodules', [`examples-directory=${armhardwaresecuritymodules}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armcomputeschedule = pkgRoot + 'test/tsp/ComputeSchedule.Management';
-generate('armcomputeschedule', armcomputeschedule, 'test/local/armcomputeschedule', [`examples-directory=${armcomputeschedule}/examples`, 'generate-samples=true']);
+generate('armcomputeschedule', armcomputeschedule, 'test/local/armcomputeschedule', [`examples-directory=${armcomputeschedule}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const armbillingbenefits = pkgRoot + 'test/tsp/BillingBenefits.Management';
-generate('armbillingbenefits', armbillingbenefits, 'test/local/armbillingbenefits', [`examples-directory=${armbillingbenefits}/examples`, 'generate-samples=true']);
+generate('armbillingbenefits', armbillingbenefits, 'test/local/armbillingbenefits', [`examples-directory=${armbillingbenefits}/examples`, 'generate-samples=true', 'runnable-samples=true']);
 
 const nooptionalbody = pkgRoot + 'test/tsp/NoOptionalBody';
 generate('nooptionalbody', nooptionalbody, 'test/local/nooptionalbody', ['generate-fakes=false', 'go-generate=after_generate.go', 'no-optional-body=true']);
@@ -250,8 +266,31 @@ generate('azclientoption', azclientoption, 'test/local/azclientoption');
 const azexactname = pkgRoot + 'test/tsp/ExactName';
 generate('azexactname', azexactname, 'test/local/azexactname');
 
+const azcookies = pkgRoot + 'test/tsp/Session.Cookies';
+generate('azcookies', azcookies, 'test/local/azcookies');
+const azdecimal = pkgRoot + 'test/tsp/Decimal.Billing';
+generate('azdecimal', azdecimal, 'test/local/azdecimal', ['decimal-as-json-number=true']);
+const azduration = pkgRoot + 'test/tsp/Duration.Timers';
+generate('azduration', azduration, 'test/local/azduration', ['duration-as-time-duration=true']);
+const azvalidation = pkgRoot + 'test/tsp/Validation.Widgets';
+generate('azvalidation', azvalidation, 'test/local/azvalidation', ['validate-constraints=true', 'validate-fake-requests=true']);
+const azevents = pkgRoot + 'test/tsp/Streaming.Events';
+generate('azevents', azevents, 'test/local/azevents');
+const azstatusmonitor = pkgRoot + 'test/tsp/Lro.StatusMonitor';
+generate('azstatusmonitor', azstatusmonitor, 'test/local/azstatusmonitor', ['typed-status-monitors=true']);
+
+const azstatuscodes = pkgRoot + 'test/tsp/Responses.StatusCodes';
+generate('azstatuscodes', azstatuscodes, 'test/local/azstatuscodes', ['typed-status-code-responses=true']);
+const azerrors = pkgRoot + 'test/tsp/Errors.Typed';
+generate('azerrors', azerrors, 'test/local/azerrors', ['typed-errors=true']);
+const azcondreq = pkgRoot + 'test/tsp/ConditionalRequests';
+generate('azcondreq', azcondreq, 'test/local/azcondreq', ['conditional-request-types=true']);
+
+const azpageriter = pkgRoot + 'test/tsp/Pager.Iterators';
+generate('azpageriter', azpageriter, 'test/local/azpageriter', ['pager-iterators=true', 'generate-interfaces=true']);
+
 const armpageablelros = pkgRoot + 'test/tsp/PageableLROs';
-generate('armpageablelros', armpageablelros, 'test/local/armpageablelros');
+generate('armpageablelros', armpageablelros, 'test/local/armpageablelros', ['generate-interfaces=true']);
 
 loopSpec(httpSpecsGroup, httpSpecs, 'test/http-specs')
 loopSpec(azureHttpSpecsGroup, azureHttpSpecs, 'test/azure-http-specs')
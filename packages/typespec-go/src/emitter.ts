@@ -52,6 +52,8 @@ export async function $onEmit(context: EmitContext<GoEmitterOptions>) {
     await emitter.emit('tsp');
     await emitter.emitCloudConfig();
     await emitter.emitExamples();
+    await emitter.emitFakeTests();
+    await emitter.emitSerDeBenchmarks();
     await emitter.emitLicenseFile();
     await emitter.emitMetadataFile();
 
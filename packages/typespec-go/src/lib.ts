@@ -27,6 +27,27 @@ export interface GoEmitterOptions {
   'generate-examples'?: boolean;
   'factory-gather-all-params'?: boolean;
   'generate-samples'?: boolean;
+  'generate-serde-benchmarks'?: boolean;
+  'streaming-json-serde'?: boolean;
+  'decimal-as-json-number'?: boolean;
+  'duration-as-time-duration'?: boolean;
+  'validate-constraints'?: boolean;
+  'generate-in-memory-fakes'?: boolean;
+  'generate-fake-handlers'?: boolean;
+  'generate-recording'?: boolean;
+  'validate-fake-requests'?: boolean;
+  'typed-status-monitors'?: boolean;
+  'check-api-versions'?: boolean;
+  'typed-status-code-responses'?: boolean;
+  'typed-errors'?: boolean;
+  'conditional-request-types'?: boolean;
+  'pager-iterators'?: boolean;
+  'runnable-samples'?: boolean;
+  'generate-fake-tests'?: boolean;
+  'generate-interfaces'?: boolean;
+  'merge-patch-models'?: boolean;
+  'span-attributes'?: boolean;
+  'operation-metrics'?: boolean;
 }
 
 const EmitterOptionsSchema: JSONSchemaType<GoEmitterOptions> = {
@@ -125,6 +146,117 @@ const EmitterOptionsSchema: JSONSchemaType<GoEmitterOptions> = {
       nullable: true,
       description: 'When true, the `NewClientFactory` constructor gathers all parameters. When false, it only gathers common parameters of clients. The default is true.',
     },
+    'generate-serde-benchmarks': {
+      type: 'boolean',
+      nullable: true,
+      description: 'When true, generate benchmarks for the JSON marshallers and unmarshallers of models. The default is false.',
+    },
+    'streaming-json-serde': {
+      type: 'boolean',
+      nullable: true,
+      description:
+        'When true, JSON marshallers and unmarshallers read and write the token stream directly instead of using intermediate maps. This reduces allocations when marshalling; unmarshalling is not guaranteed to allocate less. The default is false.',
+    },
+    'decimal-as-json-number': {
+      type: 'boolean',
+      nullable: true,
+      description: 'When true, decimal and decimal128 types are emitted as json.Number which preserves the exact value sent over the wire. The default is false which emits them as float64.',
+    },
+    'duration-as-time-duration': {
+      type: 'boolean',
+      nullable: true,
+      description: 'When true, duration types are emitted as time.Duration and are encoded per their ISO8601, seconds, or milliseconds encoding. Seconds and milliseconds with an integer wire type are rounded to the nearest unit. The default is false which emits them as their wire type.',
+    },
+    'validate-constraints': {
+      type: 'boolean',
+      nullable: true,
+      description: 'When true, models get a Validate method and request parameters are validated before the request is sent. Constraints come from @minLength, @maxLength, @pattern, @minValue, @maxValue, @minValueExclusive, @maxValueExclusive, @minItems, and @maxItems. The default is false.',
+    },
+    'generate-in-memory-fakes': {
+      type: 'boolean',
+      nullable: true,
+      description: 'When true, the fake package gets a NewInMemoryServer function that returns a ServerFactory backed by an in-memory store of resources. Only applies to ARM and requires generate-fakes. The default is false.',
+    },
+    'generate-fake-handlers': {
+      type: 'boolean',
+      nullable: true,
+      description: 'When true, each fake server gets a handler constructor (e.g. NewWidgetsServerHandler) that serves its fakes over HTTP, and the fake package gets a NewTestServer function. Requires generate-fakes. The default is false.',
+    },
+    'generate-recording': {
+      type: 'boolean',
+      nullable: true,
+      description: "When true, a recording package is emitted next to the client code. Its Transport records requests and responses to JSON files under testdata and replays them, matching requests on each operation's HTTP method, path template, and modeled query and header parameters. The default is false.",
+    },
+    'validate-fake-requests': {
+      type: 'boolean',
+      nullable: true,
+      description: "When true, fakes check each request against its operation's contract before calling the fake. Missing required query and header parameters, values that aren't part of a fixed enum, and an unexpected Content-Type are rejected with a 400 response describing the violations. Requires generate-fakes. The default is false.",
+    },
+    'typed-status-monitors': {
+      type: 'boolean',
+      nullable: true,
+      description: "When true, Begin methods for long-running operations that declare a status monitor (e.g. via @pollingOperation) return a StatusMonitorPoller. In addition to the final result, the poller's Status method returns the typed status monitor from the most recent poll, including any progress and error details. The default is false.",
+    },
+    'check-api-versions': {
+      type: 'boolean',
+      nullable: true,
+      description: "When true, clients that support multiple API versions check the API version before sending a request. Calling an operation, or setting an optional parameter, that isn't available in the client's API version (per @added and @removed) returns an error instead of sending the request. The default is false.",
+    },
+    'typed-status-code-responses': {
+      type: 'boolean',
+      nullable: true,
+      description: "When true, operations that return different schemas based on the HTTP status code get a response envelope with a typed field per schema instead of a single field of type any. The field that matches the response's status code is populated. The default is false.",
+    },
+    'typed-errors': {
+      type: 'boolean',
+      nullable: true,
+      description: 'When true, methods with a declared error model return a typed error named after the model (e.g. ErrorResponseError for ErrorResponse) when the service responds with an unsuccessful HTTP status code. The typed error contains the unmarshalled error body and wraps the *azcore.ResponseError, so it can be retrieved with errors.As. For long-running operations, only the initial request returns the typed error. Fakes include helpers to return the error body. The default is false.',
+    },
+    'conditional-request-types': {
+      type: 'boolean',
+      nullable: true,
+      description: 'When true, the optional If-Match, If-None-Match, If-Modified-Since, and If-Unmodified-Since headers (e.g. from Azure.Core.Traits.SupportsConditionalRequests) are grouped into a shared MatchConditions or RequestConditions type that is passed to each method that supports them. Methods return an error if a condition they don\'t support is set. If-Match and If-None-Match are typed as azcore.ETag. Response envelopes expose the ETag and Last-Modified headers as ETag and LastModified fields. The default is false.',
+    },
+    'pager-iterators': {
+      type: 'boolean',
+      nullable: true,
+      description: 'When true, each pageable method gets an All* method that returns an iter.Seq2 over the items in all pages. Iteration stops after the first error. The default is false.',
+    },
+    'runnable-samples': {
+      type: 'boolean',
+      nullable: true,
+      description: "When true, example tests use a fake credential and send their requests to the generated fakes, which return the example's response. Each example ends with an Output block derived from that response, so go test runs the examples without credentials. Requires generate-samples and generate-fakes. The default is false.",
+    },
+    'generate-fake-tests': {
+      type: 'boolean',
+      nullable: true,
+      description:
+        'When true, generate a _fake_test.go file per client with a test per example. Each test sends the example parameters to the generated fake server, checks the parameters it receives, and checks that the result matches the example response. Requires generate-fakes. The default is false.',
+    },
+    'generate-interfaces': {
+      type: 'boolean',
+      nullable: true,
+      description:
+        'When true, generate a <Client>API interface per client that contains its exported methods, so the client can be replaced with a mock. Client accessors and ClientFactory accessors return the interfaces. The default is false.',
+    },
+    'merge-patch-models': {
+      type: 'boolean',
+      nullable: true,
+      description:
+        'When true, models sent as application/merge-patch+json get Set, Clear and Remove methods that track which fields were set or cleared, and marshal to the exact JSON merge patch. A NewMergePatch function computes a patch from two full models. The default is false.',
+    },
+    'span-attributes': {
+      type: 'boolean',
+      nullable: true,
+      description:
+        'When true, operation spans include the templated URL path, the API version, and for ARM the resource provider namespace and resource ID. Pager and poller spans include the page number and poll count. Requires inject-spans. The default is false.',
+    },
+    'operation-metrics': {
+      type: 'boolean',
+      nullable: true,
+      description:
+        'When true, each operation records its duration and errors with the OperationMeter passed to the client in a NewOperationMeterPolicy. Pagers record each page; LROs record only the initial request. The default is false.',
+    },
   },
   required: [],
 };
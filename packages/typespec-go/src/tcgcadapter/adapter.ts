@@ -63,6 +63,28 @@ export class Adapter {
       throw new AdapterError('InvalidArgument', 'module and containing-module are mutually exclusive');
     }
 
+    if (this.options['generate-in-memory-fakes'] && !this.options['generate-fakes']) {
+      throw new AdapterError('InvalidArgument', 'generate-in-memory-fakes requires generate-fakes');
+    }
+
+    if (this.options['generate-fake-handlers'] && !this.options['generate-fakes']) {
+      throw new AdapterError('InvalidArgument', 'generate-fake-handlers requires generate-fakes');
+    }
+
+    if (this.options['validate-fake-requests'] && !this.options['generate-fakes']) {
+      throw new AdapterError('InvalidArgument', 'validate-fake-requests requires generate-fakes');
+    }
+
+    if (this.options['runnable-samples'] && (!this.options['generate-fakes'] || !(this.options['generate-samples'] || this.options['generate-examples']))) {
+      throw new AdapterError('InvalidArgument', 'runnable-samples requires generate-samples and generate-fakes');
+    }
+    if (this.options['generate-fake-tests'] && !this.options['generate-fakes']) {
+      throw new AdapterError('InvalidArgument', 'generate-fake-tests requires generate-fakes');
+    }
+    if (this.options['span-attributes'] && !this.options['inject-spans']) {
+      throw new AdapterError('InvalidArgument', 'span-attributes requires inject-spans');
+    }
+
     const goOptions = new go.Options(
       this.options['generate-fakes'] === true,
       this.options['inject-spans'] === true,
@@ -92,6 +114,27 @@ export class Adapter {
     this.codeModel.options.rawJSONAsBytes = this.options['rawjson-as-bytes'] ?? false;
     this.codeModel.options.sliceElementsByval = this.options['slice-elements-byval'] ?? false;
     this.codeModel.options.factoryGatherAllParams = this.options['factory-gather-all-params'] ?? true;
+    this.codeModel.options.streamingJSONSerDe = this.options['streaming-json-serde'] ?? false;
+    this.codeModel.options.generateSerDeBenchmarks = this.options['generate-serde-benchmarks'] ?? false;
+    this.codeModel.options.decimalAsJSONNumber = this.options['decimal-as-json-number'] ?? false;
+    this.codeModel.options.durationAsTimeDuration = this.options['duration-as-time-duration'] ?? false;
+    this.codeModel.options.validateConstraints = this.options['validate-constraints'] ?? false;
+    this.codeModel.options.generateInMemoryFakes = this.options['generate-in-memory-fakes'] ?? false;
+    this.codeModel.options.generateFakeHandlers = this.options['generate-fake-handlers'] ?? false;
+    this.codeModel.options.generateRecording = this.options['generate-recording'] ?? false;
+    this.codeModel.options.validateFakeRequests = this.options['validate-fake-requests'] ?? false;
+    this.codeModel.options.typedStatusMonitors = this.options['typed-status-monitors'] ?? false;
+    this.codeModel.options.checkAPIVersions = this.options['check-api-versions'] ?? false;
+    this.codeModel.options.statusCodeResults = this.options['typed-status-code-responses'] ?? false;
+    this.codeModel.options.typedErrors = this.options['typed-errors'] ?? false;
+    this.codeModel.options.conditionalRequestTypes = this.options['conditional-request-types'] ?? false;
+    this.codeModel.options.pagerIterators = this.options['pager-iterators'] ?? false;
+    this.codeModel.options.runnableExamples = this.options['runnable-samples'] ?? false;
+    this.codeModel.options.generateFakeTests = this.options['generate-fake-tests'] ?? false;
+    this.codeModel.options.generateInterfaces = this.options['generate-interfaces'] ?? false;
+    this.codeModel.options.mergePatchModels = this.options['merge-patch-models'] ?? false;
+    this.codeModel.options.spanAttributes = this.options['span-attributes'] ?? false;
+    this.codeModel.options.operationMetrics = this.options['operation-metrics'] ?? false;
   }
 
   /** performs all the steps to convert tcgc to the Go code model */
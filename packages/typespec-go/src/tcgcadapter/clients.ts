@@ -4,7 +4,7 @@
  *--------------------------------------------------------------------------------------------*/
 
 import * as tcgc from '@azure-tools/typespec-client-generator-core';
-import { ModelProperty, NoTarget } from '@typespec/compiler';
+import { ModelProperty, NoTarget, resolvePath } from '@typespec/compiler';
 import * as http from '@typespec/http';
 import * as go from '../../../codemodel.go/src/index.js';
 import {
@@ -15,6 +15,7 @@ import {
   getEscapedReservedName,
   uncapitalize,
 } from '../../../naming.go/src/naming.js';
+import { readFileSync } from 'fs';
 import { AdapterError } from './errors.js';
 import * as helpers from './helpers.js';
 import { TypeAdapter } from './types.js';
@@ -61,6 +62,17 @@ export class ClientAdapter {
         this.ta.getPkg().paramGroups.push(this.adaptParameterGroup(paramGroup));
       }
     }
+
+    // the API version history is only required when there's something to check
+    const pkgClients = this.ta.getPkg().clients;
+    const hasAPIVersionRanges = pkgClients.some((client) =>
+      client.methods.some((method) => method.apiVersionRange || method.parameters.some((param) => param.apiVersionRange)),
+    );
+    if (!hasAPIVersionRanges) {
+      for (const client of pkgClients) {
+        client.apiVersionHistory = [];
+      }
+    }
   }
 
   private recursiveAdaptClient(sdkClient: tcgc.SdkClientType<tcgc.SdkHttpOperation>, parent?: go.Client): go.Client | undefined {
@@ -111,6 +123,9 @@ export class ClientAdapter {
 
     const goClient = new go.Client(this.ta.getPkg(), clientName, docs);
     goClient.parent = parent;
+    if (this.ta.codeModel.options.checkAPIVersions) {
+      goClient.apiVersionHistory = [...sdkClient.apiVersions];
+    }
 
     // NOTE: per tcgc convention, if there is no param of kind credential
     // it means that the client doesn't require any kind of authentication.
@@ -135,7 +150,7 @@ export class ClientAdapter {
         target: sdkClient.__raw.type ?? NoTarget,
       });
     }
-    if ((this.ta.codeModel.options.omitConstructors || this.ta.codeModel.root.kind === 'containingModule') && this.ta.codeModel.options.generateExamples) {
+    if ((this.ta.codeModel.options.omitConstructors || this.ta.codeModel.root.kind === 'containingModule') && (this.ta.codeModel.options.generateExamples || this.ta.codeModel.options.generateFakeTests)) {
       // emit a diagnostic indicating that no ctors will be emitted due to containing-module.
       this.ta.ctx.program.reportDiagnostic({
         code: 'UnsupportedConfiguration',
@@ -161,6 +176,36 @@ export class ClientAdapter {
           constructable.constructors.push(this.createTokenCredentialCtor(goClient, cred));
           return AuthTypes.WithAuth;
         }
+        case 'apiKey': {
+          if (cred.in !== 'header' && cred.in !== 'query') {
+            this.ta.ctx.program.reportDiagnostic({
+              code: 'UnsupportedAuthenticationScheme',
+              severity: 'warning',
+              message: `unsupported API key location ${cred.in} will be omitted`,
+              target: sdkClient.__raw.type ?? NoTarget,
+            });
+            return AuthTypes.WithAuth;
+          }
+          this.addKeyCredentialCtor(constructable, `New${goClient.name}WithKeyCredential`, new go.KeyCredential(cred.in, cred.name));
+          return AuthTypes.WithAuth;
+        }
+        case 'http': {
+          if (cred.scheme.toLowerCase() === 'basic') {
+            // Basic requires the base64 encoding of a user name and password which a key credential can't provide
+            this.ta.ctx.program.reportDiagnostic({
+              code: 'UnsupportedAuthenticationScheme',
+              severity: 'warning',
+              message: `unsupported HTTP authentication scheme ${cred.scheme} will be omitted`,
+              target: sdkClient.__raw.type ?? NoTarget,
+            });
+            return AuthTypes.WithAuth;
+          }
+          // the key is sent in the Authorization header prefixed with the scheme (e.g. Bearer <key>)
+          const keyCred = new go.KeyCredential('header', 'Authorization');
+          keyCred.prefix = `${cred.scheme} `;
+          this.addKeyCredentialCtor(constructable, `New${goClient.name}With${ensureNameCase(cred.scheme)}Credential`, keyCred);
+          return AuthTypes.WithAuth;
+        }
         default:
           this.ta.ctx.program.reportDiagnostic({
             code: 'UnsupportedAuthenticationScheme',
@@ -378,6 +423,23 @@ export class ClientAdapter {
     return ctor;
   }
 
+  /**
+   * adds a constructor that uses an azcore.KeyCredential to the client.
+   * a constructor with the same name is only added once.
+   *
+   * @param constructable the constructable for the current Go client
+   * @param ctorName the name of the constructor
+   * @param cred the key credential used by the constructor
+   */
+  private addKeyCredentialCtor(constructable: go.Constructable, ctorName: string, cred: go.KeyCredential): void {
+    if (constructable.constructors.find((each) => each.name === ctorName)) {
+      return;
+    }
+    const ctor = new go.Constructor(this.ta.getPkg(), ctorName);
+    ctor.parameters.push(new go.ClientCredentialParameter('credential', cred));
+    constructable.constructors.push(ctor);
+  }
+
   /**
    * converts a tcgc client accessor method to a Go client accessor method
    *
@@ -451,21 +513,6 @@ export class ClientAdapter {
     const handleRespName = helpers.getEffectiveName(sdkMethod, true, 'HandleResponse');
     const naming = new go.MethodNaming(getEscapedReservedName(opName, 'Operation'), createReqName, handleRespName);
 
-    const getStatusCodes = function (httpOp: tcgc.SdkHttpOperation): Array<number> {
-      const statusCodes = new Array<number>();
-      for (const response of httpOp.responses) {
-        const statusCode = response.statusCodes;
-        if (isHttpStatusCodeRange(statusCode)) {
-          for (let code = statusCode.start; code <= statusCode.end; ++code) {
-            statusCodes.push(code);
-          }
-        } else {
-          statusCodes.push(statusCode);
-        }
-      }
-      return statusCodes;
-    };
-
     let methodName = helpers.getEffectiveName(sdkMethod);
     if (sdkMethod.access === 'internal') {
       methodName = uncapitalize(methodName);
@@ -477,10 +524,10 @@ export class ClientAdapter {
       }
     }
 
-    const setLROInfo = function (
+    const setLROInfo = (
       goMethod: go.LROMethod | go.LROPageableMethod,
       sdkMethod: tcgc.SdkLroPagingServiceMethod<tcgc.SdkHttpOperation> | tcgc.SdkLroServiceMethod<tcgc.SdkHttpOperation>,
-    ): void {
+    ): void => {
       const lroOptions = helpers.hasDecorator('@useFinalStateVia', sdkMethod.decorators);
       if (lroOptions) {
         goMethod.finalStateVia = <go.FinalStateVia>lroOptions['finalState'];
@@ -493,6 +540,14 @@ export class ClientAdapter {
           })
           .join('.');
       }
+      const statusMonitor = sdkMethod.lroMetadata.pollingStep?.responseBody;
+      if (this.ta.codeModel.options.typedStatusMonitors && statusMonitor) {
+        const statusType = this.ta.getWireType(statusMonitor, false, false);
+        if (statusType.kind !== 'model') {
+          throw new AdapterError('UnsupportedTsp', `status monitor for LRO ${goMethod.name} has unexpected kind ${statusType.kind}`, sdkMethod.__raw?.node);
+        }
+        goMethod.statusMonitor = statusType;
+      }
     };
 
     const setPageableInfo = (
@@ -512,7 +567,8 @@ export class ClientAdapter {
               sdkMethod.pagingMetadata.nextLinkVerb satisfies never;
           }
         }
-        goMethod.strategy = this.adaptPagingStrategy(sdkMethod, paramsMap, respHeadersMap);
+        goMethod.strategy = this.adaptPagingStrategy(sdkMethod, goMethod, paramsMap, respHeadersMap);
+        goMethod.itemsPath = this.adaptPageItemsPath(sdkMethod);
       };
     };
 
@@ -525,9 +581,6 @@ export class ClientAdapter {
         method = new go.SyncMethod(methodName, goClient, sdkMethod.operation.path, sdkMethod.operation.verb, statusCodes, naming);
         break;
       case 'paging':
-        if (sdkMethod.pagingMetadata.nextLinkReInjectedParametersSegments !== undefined && sdkMethod.pagingMetadata.nextLinkReInjectedParametersSegments.length > 0) {
-          throw new AdapterError('UnsupportedTsp', `paging with re-injected parameters is not supported`, sdkMethod.__raw?.node);
-        }
         method = new go.PageableMethod(methodName, goClient, sdkMethod.operation.path, sdkMethod.operation.verb, statusCodes, naming);
         applyPageableInfo = setPageableInfo(method, sdkMethod);
         break;
@@ -547,6 +600,10 @@ export class ClientAdapter {
 
     method.docs.summary = sdkMethod.summary;
     method.docs.description = sdkMethod.doc;
+    method.apiVersionRange = helpers.getAPIVersionRange(sdkMethod.apiVersions, goClient.apiVersionHistory);
+    if (this.ta.codeModel.options.typedErrors) {
+      method.errorType = this.getServiceError(sdkMethod.operation);
+    }
     goClient.methods.push(method);
     const pageableInfo = this.populateMethod(sdkMethod, method);
 
@@ -554,15 +611,80 @@ export class ClientAdapter {
     applyPageableInfo?.(pageableInfo.params, pageableInfo.respHeaders);
   }
 
+  /**
+   * returns the typed error for the operation's error model.
+   * methods that return the same error model share the same typed error.
+   *
+   * @param operation the tcgc operation for which to get the typed error
+   * @returns the typed error or undefined if the operation doesn't declare exactly one JSON error model
+   */
+  private getServiceError(operation: tcgc.SdkHttpOperation): go.ServiceError | undefined {
+    const errorModels = new Set<tcgc.SdkModelType>();
+    for (const exception of operation.exceptions) {
+      if (exception.type?.kind !== 'model') {
+        continue;
+      } else if (exception.defaultContentType && this.adaptContentType(exception.defaultContentType) !== 'JSON') {
+        return undefined;
+      }
+      errorModels.add(exception.type);
+    }
+    if (errorModels.size !== 1) {
+      return undefined;
+    }
+
+    const errorModel = this.ta.getWireType([...errorModels][0], false, false);
+    if (errorModel.kind !== 'model') {
+      // discriminated error models aren't supported
+      return undefined;
+    }
+    let serviceError = this.ta.getPkg().errors.find((each) => each.model === errorModel);
+    if (!serviceError) {
+      serviceError = new go.ServiceError(errorModel);
+      this.ta.getPkg().errors.push(serviceError);
+    }
+    return serviceError;
+  }
+
+  /**
+   * builds the field path to the items in a page.
+   * returns undefined if the items path isn't known.
+   *
+   * @param sdkMethod the tcgc pageable method
+   * @returns the field path to the items or undefined
+   */
+  private adaptPageItemsPath(
+    sdkMethod: tcgc.SdkLroPagingServiceMethod<tcgc.SdkHttpOperation> | tcgc.SdkPagingServiceMethod<tcgc.SdkHttpOperation>,
+  ): Array<go.ModelField> | undefined {
+    if (!sdkMethod.pagingMetadata.pageItemsSegments) {
+      return undefined;
+    }
+    const itemsPath = new Array<go.ModelField>();
+    for (const segment of sdkMethod.pagingMetadata.pageItemsSegments) {
+      if (segment.kind !== 'property') {
+        // items in a response header aren't supported
+        return undefined;
+      }
+      const itemsField = this.ta.fieldsMap.get(segment);
+      if (!itemsField) {
+        // the most likely explanation for this is lack of reference equality
+        throw new AdapterError('InternalError', `missing items field name ${segment.name} for operation ${sdkMethod.name}`, sdkMethod.__raw?.node);
+      }
+      itemsPath.push(itemsField);
+    }
+    return itemsPath;
+  }
+
   /**
    * creates the pageable strategy based on the method definition.
    * returns undefined if no strategy is required.
    *
    * @param sdkMethod the tcgc pageable method for which to create a strategy
+   * @param method the Go pageable method for which to create a strategy
    * @returns the pageable strategy or undefined
    */
   private adaptPagingStrategy(
     sdkMethod: tcgc.SdkLroPagingServiceMethod<tcgc.SdkHttpOperation> | tcgc.SdkPagingServiceMethod<tcgc.SdkHttpOperation>,
+    method: go.LROPageableMethod | go.PageableMethod,
     paramsMap: ParamsMapForPageable,
     respHeadersMap: RespHeadersMapForPageable,
   ): go.PageableStrategyKind | undefined {
@@ -585,9 +707,27 @@ export class ClientAdapter {
     };
 
     if (sdkMethod.pagingMetadata.nextLinkOperation) {
-      throw new AdapterError('UnsupportedTsp', 'next page operation NYI', sdkMethod.__raw?.node);
+      if (!sdkMethod.pagingMetadata.nextLinkSegments) {
+        throw new AdapterError('UnsupportedTsp', 'next page operation without a next link is not supported', sdkMethod.__raw?.node);
+      }
+      const strategy = new go.PageableStrategyNextLink(buildNextLinkPath(sdkMethod.pagingMetadata.nextLinkSegments));
+      strategy.method = this.adaptNextPageMethod(sdkMethod, method, sdkMethod.pagingMetadata.nextLinkOperation);
+      return strategy;
     } else if (sdkMethod.pagingMetadata.nextLinkSegments) {
-      return new go.PageableStrategyNextLink(buildNextLinkPath(sdkMethod.pagingMetadata.nextLinkSegments));
+      const strategy = new go.PageableStrategyNextLink(buildNextLinkPath(sdkMethod.pagingMetadata.nextLinkSegments));
+      for (const segments of sdkMethod.pagingMetadata.nextLinkReInjectedParametersSegments ?? []) {
+        // the last segment is the param to re-inject
+        const segment = segments[segments.length - 1];
+        if (segment.kind !== 'method') {
+          throw new AdapterError('UnsupportedTsp', `re-injected parameter ${segment.name} of kind ${segment.kind} is not supported`, segment.__raw?.node);
+        }
+        const reinjectedParam = paramsMap.get(segment);
+        if (!reinjectedParam) {
+          throw new AdapterError('UnsupportedTsp', `re-injected parameter ${segment.name} must be a header or query parameter`, segment.__raw?.node);
+        }
+        strategy.reinjectedParams.push(reinjectedParam);
+      }
+      return strategy;
     } else if (sdkMethod.pagingMetadata.continuationTokenParameterSegments && sdkMethod.pagingMetadata.continuationTokenResponseSegments) {
       const tokenReq = sdkMethod.pagingMetadata.continuationTokenParameterSegments[0];
       const tokenResp = sdkMethod.pagingMetadata.continuationTokenResponseSegments[0];
@@ -632,6 +772,69 @@ export class ClientAdapter {
     return undefined;
   }
 
+  /**
+   * adapts the operation used to fetch subsequent pages for a pageable method.
+   * required params are passed from the pageable method's params of the same name
+   * and optional params are read from the pageable method's options type.
+   *
+   * @param sdkMethod the tcgc pageable method that uses the next page operation
+   * @param method the Go pageable method that uses the next page operation
+   * @param nextLinkOperation the tcgc operation used to fetch subsequent pages
+   * @returns the adapted next page method
+   */
+  private adaptNextPageMethod(
+    sdkMethod: tcgc.SdkLroPagingServiceMethod<tcgc.SdkHttpOperation> | tcgc.SdkPagingServiceMethod<tcgc.SdkHttpOperation>,
+    method: go.LROPageableMethod | go.PageableMethod,
+    nextLinkOperation: tcgc.SdkHttpOperation,
+  ): go.NextPageMethod {
+    const nextPageMethod = new go.NextPageMethod(
+      helpers.getEffectiveName(sdkMethod, true, 'NextPageCreateRequest'),
+      method.receiver.type,
+      nextLinkOperation.path,
+      nextLinkOperation.verb,
+      getStatusCodes(nextLinkOperation),
+    );
+
+    const opParams = new Array<tcgc.SdkBodyParameter | tcgc.SdkCookieParameter | tcgc.SdkHeaderParameter | tcgc.SdkPathParameter | tcgc.SdkQueryParameter>();
+    opParams.push(...nextLinkOperation.parameters);
+    if (nextLinkOperation.bodyParam) {
+      opParams.push(nextLinkOperation.bodyParam);
+    }
+
+    for (const opParam of opParams) {
+      const adaptedParam = this.adaptMethodParameter(nextPageMethod, opParam);
+      adaptedParam.docs.summary = opParam.summary;
+      adaptedParam.docs.description = opParam.doc;
+      if (adaptedParam.location === 'method' && adaptedParam.style !== 'required' && adaptedParam.style !== 'literal') {
+        // NextPageMethods don't have optional params so we use the ones from the pageable method
+        if (!method.optionalParamsGroup.params.find((param) => param.name === adaptedParam.name)) {
+          throw new AdapterError('UnsupportedTsp', `optional next page parameter ${opParam.name} has no corresponding parameter in method ${sdkMethod.name}`, opParam.__raw?.node);
+        }
+        adaptedParam.group = method.optionalParamsGroup;
+      }
+      nextPageMethod.parameters.push(adaptedParam);
+    }
+
+    // the next link is passed to the param with the same name as the next link field.
+    // if there isn't one, it's the only required param that isn't a param of the pageable method.
+    const nextLinkName = sdkMethod.pagingMetadata.nextLinkSegments![sdkMethod.pagingMetadata.nextLinkSegments!.length - 1].name;
+    let nextLinkParam = nextPageMethod.parameters.find((param) => param.name === nextLinkName);
+    if (!nextLinkParam) {
+      const candidates = nextPageMethod.parameters.filter((param) => {
+        return param.location === 'method' && param.style === 'required' && !method.parameters.find((methodParam) => methodParam.name === param.name);
+      });
+      if (candidates.length === 1) {
+        nextLinkParam = candidates[0];
+      }
+    }
+    if (!nextLinkParam || (nextLinkParam.kind !== 'headerScalarParam' && nextLinkParam.kind !== 'pathScalarParam' && nextLinkParam.kind !== 'queryScalarParam')) {
+      throw new AdapterError('UnsupportedTsp', `didn't find a header, path, or query parameter for the next link in next page operation ${nextLinkOperation.path}`, sdkMethod.__raw?.node);
+    }
+    nextPageMethod.nextLinkParam = nextLinkParam;
+
+    return nextPageMethod;
+  }
+
   private populateMethod(
     sdkMethod: tcgc.SdkServiceMethod<tcgc.SdkHttpOperation>,
     method: go.MethodType | go.NextPageMethod,
@@ -697,7 +900,8 @@ export class ClientAdapter {
     // we must do this after adapting method params as it can add optional params
     this.ta.getPkg().paramGroups.push(this.adaptParameterGroup(method.optionalParamsGroup));
 
-    if (this.ta.codeModel.options.generateExamples) {
+    // fake tests are generated from the examples
+    if (this.ta.codeModel.options.generateExamples || this.ta.codeModel.options.generateFakeTests) {
       this.adaptHttpOperationExamples(sdkMethod, method, paramMapping.exampleParams);
     }
 
@@ -734,6 +938,11 @@ export class ClientAdapter {
       allOpParams.push(sdkMethod.operation.bodyParam);
     }
 
+    let conditionsGroup: go.ParameterGroup | undefined;
+    if (method.kind !== 'nextPageMethod') {
+      conditionsGroup = this.getConditionalRequestGroup(allOpParams);
+    }
+
     // Helper function to add a parameter to method.parameters and paramMapping
     const addParameterToMethod = (adaptedParam: go.MethodParameter, opParam: OperationParamType) => {
       method.parameters.push(adaptedParam);
@@ -935,10 +1144,19 @@ export class ClientAdapter {
       if (go.isClientSideDefault(adaptedParam.style) && !adaptedParam.docs.summary && !adaptedParam.docs.description) {
         adaptedParam.docs.summary = helpers.getClientDefaultValueDoc(adaptedParam.style.defaultValue);
       }
+      if (this.ta.codeModel.options.validateConstraints && param.__raw?.kind === 'ModelProperty') {
+        adaptedParam.constraints = helpers.getConstraints(this.ta.ctx.program, param.__raw);
+      }
 
       addParameterToMethod(adaptedParam, opParam);
 
-      if (adaptedParam.style !== 'required' && adaptedParam.style !== 'literal') {
+      if (conditionsGroup && opParam.kind === 'header' && this.isConditionalRequestHeader(opParam)) {
+        // conditional request headers go in the shared conditions param group
+        adaptedParam.group = conditionsGroup;
+        if (!conditionsGroup.params.some((p) => p.name === adaptedParam.name)) {
+          conditionsGroup.params.push(adaptedParam);
+        }
+      } else if (adaptedParam.style !== 'required' && adaptedParam.style !== 'literal') {
         // add optional method param to the options param group
         if (!optionalGroup) {
           throw new AdapterError('InternalError', `optional parameter ${param.name} has no optional parameter group`, param.__raw?.node);
@@ -1130,8 +1348,8 @@ export class ClientAdapter {
         }
         break;
       case 'cookie':
-        // TODO: currently we don't have Azure service using cookie parameter. need to add support if needed in the future.
-        throw new AdapterError('UnsupportedTsp', 'unsupported parameter type cookie', opParam.__raw?.node);
+        adaptedParam = new go.CookieParameter(paramName, opParam.serializedName, this.adaptHeaderScalarType(methodParam.type, true), paramStyle, byVal, location);
+        break;
       case 'header':
         if (opParam.serializedName === 'x-ms-meta') {
           const type = this.ta.getWireType(methodParam.type, true, false);
@@ -1157,6 +1375,12 @@ export class ClientAdapter {
             byVal,
             location,
           );
+        } else if (this.isConditionalRequestHeader(opParam)) {
+          // conditional request headers get the same name across all methods as they're
+          // grouped into a shared param group. If-Match and If-None-Match contain ETags.
+          const headerName = opParam.serializedName.toLowerCase();
+          const type = conditionalMatchHeaders.includes(headerName) ? this.ta.getETagType() : this.adaptHeaderScalarType(methodParam.type, true);
+          adaptedParam = new go.HeaderScalarParameter(conditionalRequestHeaders[headerName], opParam.serializedName, type, paramStyle, byVal, location);
         } else {
           adaptedParam = new go.HeaderScalarParameter(paramName, opParam.serializedName, this.adaptHeaderScalarType(methodParam.type, true), paramStyle, byVal, location);
         }
@@ -1199,6 +1423,9 @@ export class ClientAdapter {
     if (adaptedParam.location === 'client') {
       // track client parameter for later use
       this.clientParams.set(getClientParamsKey(opParam), adaptedParam);
+    } else if (adaptedParam.style === 'optional' && method.kind !== 'nextPageMethod') {
+      // required params can't be omitted so we only check optional ones
+      adaptedParam.apiVersionRange = helpers.getAPIVersionRange(opParam.apiVersions, method.receiver.type.apiVersionHistory);
     }
 
     return adaptedParam;
@@ -1296,20 +1523,29 @@ export class ClientAdapter {
             continue;
           }
 
-          let headerResp: go.HeaderScalarResponse | go.HeaderMapResponse;
-          if (httpHeader.serializedName === 'x-ms-meta' || httpHeader.serializedName === 'x-ms-or') {
+          let headerResp: go.HeaderResponse;
+          if (httpHeader.serializedName.match(/^set-cookie$/i)) {
+            // the cookies are parsed from the response by net/http so the header's type isn't used
+            headerResp = new go.SetCookieResponse(helpers.getEffectiveName(httpHeader));
+          } else if (httpHeader.serializedName === 'x-ms-meta' || httpHeader.serializedName === 'x-ms-or') {
             const type = this.ta.getWireType(httpHeader.type, true, false);
             if (type.kind !== 'map') {
               throw new AdapterError('InternalError', `unexpected kind ${type.kind} for HeaderMapResponse ${httpHeader.name}`);
             }
             headerResp = new go.HeaderMapResponse(helpers.getEffectiveName(httpHeader), type, `${httpHeader.serializedName}-`);
           } else {
-            headerResp = new go.HeaderScalarResponse(
-              helpers.getEffectiveName(httpHeader),
-              this.adaptHeaderScalarType(httpHeader.type, false),
-              httpHeader.serializedName,
-              helpers.isTypePassedByValue(httpHeader.type),
-            );
+            let fieldName = helpers.getEffectiveName(httpHeader);
+            let headerType = this.adaptHeaderScalarType(httpHeader.type, false);
+            if (this.ta.codeModel.options.conditionalRequestTypes) {
+              // the ETag and Last-Modified headers get the same field in all response envelopes
+              if (httpHeader.serializedName.match(/^etag$/i)) {
+                fieldName = 'ETag';
+                headerType = this.ta.getETagType();
+              } else if (httpHeader.serializedName.match(/^last-modified$/i)) {
+                fieldName = 'LastModified';
+              }
+            }
+            headerResp = new go.HeaderScalarResponse(fieldName, headerType, httpHeader.serializedName, helpers.isTypePassedByValue(httpHeader.type));
             if (go.isPageableMethod(method)) {
               pageableRespHeadersMap.set(httpHeader, headerResp);
             }
@@ -1336,6 +1572,23 @@ export class ClientAdapter {
       respEnv.result.docs.summary = 'Success indicates if the operation succeeded or failed.';
     }
 
+    // JSONL and SSE streams are decoded into their items as they're read
+    if (sdkMethod.kind === 'basic') {
+      for (const httpResp of sdkMethod.operation.responses) {
+        const stream = helpers.getStreamInfo(this.ta.ctx.program, httpResp);
+        if (!stream) {
+          continue;
+        }
+        // the content type is implied by the stream's format so the header is omitted
+        respEnv.result = this.ta.getStreamResult(stream);
+        respEnv.result.docs.summary = 'Stream contains the streamed items.';
+        return {
+          respEnv: respEnv,
+          respHeaders: pageableRespHeadersMap,
+        };
+      }
+    }
+
     if (!sdkResponseType) {
       if (literalContentTypeHeader) {
         respEnv.headers.push(literalContentTypeHeader);
@@ -1433,7 +1686,7 @@ export class ClientAdapter {
       }
       respEnv.result.docs.summary = sdkResponseType.summary;
       respEnv.result.docs.description = sdkResponseType.doc;
-    } else if (sdkResponseType.kind === 'union') {
+    } else if (sdkResponseType.kind === 'union' && isMultiResponse(sdkMethod, sdkResponseType)) {
       // multi-response
       const resultTypes: Record<number, go.WireType> = {};
       const possibleTypes = new Set<string>();
@@ -1455,8 +1708,12 @@ export class ClientAdapter {
           resultTypes[resp.statusCodes] = respType;
         }
       }
-      respEnv.result = new go.AnyResult('Value', contentType, resultTypes);
-      respEnv.result.docs.summary = `Possible types are ${[...possibleTypes].sort().join(', ')}`;
+      if (this.ta.codeModel.options.statusCodeResults && method.kind === 'method' && (contentType === 'JSON' || contentType === 'XML')) {
+        respEnv.result = new go.StatusCodeResult(contentType, resultTypes, method.receiver.type.pkg);
+      } else {
+        respEnv.result = new go.AnyResult('Value', contentType, resultTypes);
+        respEnv.result.docs.summary = `Possible types are ${[...possibleTypes].sort().join(', ')}`;
+      }
     } else {
       const resultType = this.ta.getWireType(sdkResponseType, false, false);
       if (go.isMonomorphicResultType(resultType)) {
@@ -1528,13 +1785,14 @@ export class ClientAdapter {
         return 'ByteArray';
       case 'enum':
       case 'model':
+      case 'union':
         return helpers.getEffectiveName(type);
       case 'utcDateTime':
       case 'offsetDateTime':
         return 'Time';
       case 'decimal':
       case 'decimal128':
-        return 'Float64';
+        return this.ta.codeModel.options.decimalAsJSONNumber ? 'Decimal' : 'Float64';
       case 'dict':
         return `MapOf${this.recursiveTypeName(type.valueType, fromArray)}`;
       case 'float32':
@@ -1547,6 +1805,7 @@ export class ClientAdapter {
       case 'nullable':
         return this.recursiveTypeName(type.type, fromArray);
       case 'duration':
+        return this.ta.codeModel.options.durationAsTimeDuration ? 'Duration' : 'String';
       case 'string':
       case 'url':
         return 'String';
@@ -1577,9 +1836,68 @@ export class ClientAdapter {
     return structType;
   }
 
+  /**
+   * returns true if opParam is an optional conditional request header
+   * that should be grouped into a MatchConditions or RequestConditions.
+   *
+   * @param opParam the operation parameter to check
+   * @returns true if opParam is a conditional request header
+   */
+  private isConditionalRequestHeader(opParam: tcgc.SdkHeaderParameter): boolean {
+    if (!this.ta.codeModel.options.conditionalRequestTypes || !opParam.optional || opParam.onClient || opParam.collectionFormat) {
+      return false;
+    }
+    return Object.keys(conditionalRequestHeaders).includes(opParam.serializedName.toLowerCase());
+  }
+
+  /**
+   * returns the shared param group for the conditional request headers in opParams.
+   * methods that only have If-Match and/or If-None-Match use MatchConditions.
+   * methods with If-Modified-Since and/or If-Unmodified-Since use RequestConditions.
+   *
+   * @param opParams the operation parameters for a method
+   * @returns the param group or undefined if there are no conditional request headers
+   */
+  private getConditionalRequestGroup(
+    opParams: Array<tcgc.SdkBodyParameter | tcgc.SdkHeaderParameter | tcgc.SdkPathParameter | tcgc.SdkQueryParameter | tcgc.SdkCookieParameter>,
+  ): go.ParameterGroup | undefined {
+    const headerNames = new Array<string>();
+    for (const opParam of opParams) {
+      if (opParam.kind === 'header' && this.isConditionalRequestHeader(opParam)) {
+        headerNames.push(opParam.serializedName.toLowerCase());
+      }
+    }
+    if (headerNames.length === 0) {
+      return undefined;
+    }
+
+    let groupName = 'MatchConditions';
+    if (headerNames.some((headerName) => !conditionalMatchHeaders.includes(headerName))) {
+      groupName = 'RequestConditions';
+    }
+
+    let paramGroup = this.parameterGroups.get(groupName);
+    if (!paramGroup) {
+      if (this.ta.getPkg().models.some((m) => m.name === groupName)) {
+        throw new AdapterError('NameCollision', `conditional request type ${groupName} collides with a model of the same name`);
+      }
+      paramGroup = new go.ParameterGroup(this.ta.getPkg(), uncapitalize(groupName), groupName, false, 'method');
+      // the group contains the conditions for all methods that use it
+      paramGroup.rejectUnsupportedParams = true;
+      if (groupName === 'MatchConditions') {
+        paramGroup.docs.summary = 'MatchConditions contains the ETag conditions for a conditional request.';
+      } else {
+        paramGroup.docs.summary = 'RequestConditions contains the ETag and date conditions for a conditional request.';
+      }
+      this.parameterGroups.set(groupName, paramGroup);
+    }
+    return paramGroup;
+  }
+
   private adaptHeaderScalarType(sdkType: tcgc.SdkType, forParam: boolean): go.HeaderScalarType {
     // It would be ideal to force the ETag type for the known ETag headers per the RFC.
     // However, doing so introduces too many breaking changes (if-match and if-none-match).
+    // The conditional-request-types option opts in to this (see isConditionalRequestHeader).
     // TODO: If we decide to force ETag types by header name in the future,
     // update adaptHeaderScalarType to receive the header name and dispatch
     // to this.ta.getETagType() for ETag-related headers.
@@ -1645,6 +1963,7 @@ export class ClientAdapter {
       for (const example of sdkMethod.operation.examples) {
         try {
           const goExample = new go.MethodExample(example.name, { summary: example.doc }, example.filePath);
+          const rawExample = this.loadRawExample(example.filePath);
           for (const param of example.parameters) {
             if (param.parameter.isApiVersionParam && param.parameter.clientDefaultValue) {
               // skip the api-version param as it's not a formal parameter
@@ -1658,11 +1977,15 @@ export class ClientAdapter {
             if (!goParams) {
               throw new AdapterError('InternalError', `can not find go param for example param ${param.parameter.name}`);
             }
+            // example files key parameters by their serialized name, except for the body
+            const rawParams = rawProperty(rawExample, 'parameters');
+            const rawParam = rawProperty(rawParams, param.parameter.kind === 'body' ? param.parameter.name : param.parameter.serializedName);
             if (goParams.length > 1) {
               // spread case
               for (const goParam of goParams) {
-                const propertyValue = (<tcgc.SdkModelExampleValue>param.value).value[(<go.PartialBodyParameter>goParam).serializedName];
-                const paramExample = new go.ParameterExample(goParam, this.adaptExampleType(propertyValue, goParam?.type));
+                const serializedName = (<go.PartialBodyParameter>goParam).serializedName;
+                const propertyValue = (<tcgc.SdkModelExampleValue>param.value).value[serializedName];
+                const paramExample = new go.ParameterExample(goParam, this.adaptExampleType(propertyValue, goParam?.type, rawProperty(rawParam, serializedName)));
                 if (goParam.group && goParam.group === method.optionalParamsGroup) {
                   goExample.optionalParamsGroup.push(paramExample);
                 } else {
@@ -1674,7 +1997,7 @@ export class ClientAdapter {
               if (go.isLiteralParameter(goParams[0].style)) {
                 continue;
               }
-              const paramExample = new go.ParameterExample(goParams[0], this.adaptExampleType(param.value, goParams[0]?.type));
+              const paramExample = new go.ParameterExample(goParams[0], this.adaptExampleType(param.value, goParams[0]?.type, rawParam));
               if (goParams[0]?.group && goParams[0].group === method.optionalParamsGroup) {
                 goExample.optionalParamsGroup.push(paramExample);
               } else {
@@ -1687,11 +2010,18 @@ export class ClientAdapter {
             return v.statusCode === 200;
           });
           if (response) {
+            const rawResponse = rawProperty(rawProperty(rawExample, 'responses'), '200');
             goExample.responseEnvelope = new go.ResponseEnvelopeExample(method.returns);
             // skip adding headers for LROs as they aren't useful on the response envelope
             if (!go.isLROMethod(method)) {
               for (const header of response.headers) {
-                const goHeader = method.returns.headers.find((h) => h.headerName === header.header.serializedName);
+                if (header.header.serializedName.match(/^set-cookie$/i)) {
+                  // cookies are exposed as []*http.Cookie which has no example representation
+                  continue;
+                }
+                const goHeader = method.returns.headers.find(
+                  (h): h is go.HeaderMapResponse | go.HeaderScalarResponse => h.kind !== 'setCookieResponse' && h.headerName === header.header.serializedName,
+                );
                 if (!goHeader) {
                   // headers that were intentionally omitted from the response envelope (for
                   // example literal content-type headers on non-binary responses) won't have
@@ -1702,29 +2032,39 @@ export class ClientAdapter {
                   }
                   throw new AdapterError('InternalError', `can not find go header for example header ${header.header.serializedName}`);
                 }
-                goExample.responseEnvelope.headers.push(new go.ResponseHeaderExample(goHeader, this.adaptExampleType(header.value, goHeader.type)));
+                const rawHeader = rawProperty(rawProperty(rawResponse, 'headers'), header.header.serializedName);
+                goExample.responseEnvelope.headers.push(new go.ResponseHeaderExample(goHeader, this.adaptExampleType(header.value, goHeader.type, rawHeader)));
               }
             }
             // there are some problems with LROs at present which can cause the result
             // to be undefined even though the operation returns a response.
             // TODO: https://github.com/Azure/typespec-azure/issues/1688
             if (response.bodyValue && method.returns.result) {
+              const rawBody = rawProperty(rawResponse, 'body');
               switch (method.returns.result.kind) {
                 case 'anyResult':
                   // use the response type for 200 response
-                  goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, method.returns.result.httpStatusCodeType[200]);
+                  goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, method.returns.result.httpStatusCodeType[200], rawBody);
                   break;
+                case 'statusCodeResult': {
+                  // use the response type for 200 response
+                  const field = method.returns.result.fields.find((f) => f.httpStatusCodes.includes(200));
+                  if (field) {
+                    goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, field.type, rawBody);
+                  }
+                  break;
+                }
                 case 'binaryResult':
                   goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, new go.Scalar('byte', false));
                   break;
                 case 'modelResult':
-                  goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, method.returns.result.modelType);
+                  goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, method.returns.result.modelType, rawBody);
                   break;
                 case 'monomorphicResult':
-                  goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, method.returns.result.monomorphicType);
+                  goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, method.returns.result.monomorphicType, rawBody);
                   break;
                 case 'polymorphicResult':
-                  goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, method.returns.result.interface);
+                  goExample.responseEnvelope.result = this.adaptExampleType(response.bodyValue, method.returns.result.interface, rawBody);
                   break;
               }
             }
@@ -1741,11 +2081,47 @@ export class ClientAdapter {
     }
   }
 
-  private adaptExampleType(exampleType: tcgc.SdkExampleValue, goType: go.WireType): Exclude<go.ExampleType, go.TokenCredentialExample> {
+  /**
+   * loads the example file with the specified path, retaining the text of its numbers.
+   * tcgc parses example values as JavaScript numbers which loses the precision of decimals.
+   *
+   * @param filePath the path of the example file relative to the examples directory
+   * @returns the parsed example or undefined if the file can't be read
+   */
+  private loadRawExample(filePath: string): unknown {
+    const examplesDir = this.ta.ctx.examplesDir ?? resolvePath(this.ta.ctx.program.projectRoot, 'examples');
+    let text: string;
+    try {
+      text = readFileSync(resolvePath(examplesDir, filePath), 'utf8');
+    } catch {
+      return undefined;
+    }
+    return JSON.parse(text, (_key: string, value: unknown, context?: { source: string }) => {
+      if (typeof value === 'number' && context) {
+        return new RawNumber(context.source);
+      }
+      return value;
+    });
+  }
+
+  /**
+   * adapts a tcgc example value to the specified type.
+   *
+   * @param exampleType the example value to adapt
+   * @param goType the type to adapt the value to
+   * @param raw the same value from the example file, if available
+   * @returns the adapted example
+   */
+  private adaptExampleType(exampleType: tcgc.SdkExampleValue, goType: go.WireType, raw?: unknown): Exclude<go.ExampleType, go.TokenCredentialExample> {
+    if (goType.kind === 'union' && exampleType.kind !== 'null') {
+      return this.adaptUnionExample(exampleType, goType, raw);
+    }
     switch (exampleType.kind) {
       case 'string':
         switch (goType.kind) {
           case 'constant':
+          case 'decimal':
+          case 'duration':
           case 'encodedBytes':
           case 'etag':
           case 'literal':
@@ -1759,10 +2135,14 @@ export class ClientAdapter {
       case 'number':
         switch (goType.kind) {
           case 'constant':
+          case 'duration':
           case 'literal':
           case 'scalar':
           case 'time':
             return new go.NumberExample(exampleType.value, goType);
+          case 'decimal':
+            // decimals retain the exact text of the value
+            return new go.StringExample(raw instanceof RawNumber ? raw.text : exampleType.value.toString(), goType);
         }
         break;
       case 'boolean':
@@ -1783,8 +2163,8 @@ export class ClientAdapter {
       case 'array':
         if (goType.kind === 'slice') {
           const ret = new go.ArrayExample(goType);
-          for (const v of exampleType.value) {
-            ret.value.push(this.adaptExampleType(v, goType.elementType));
+          for (const [i, v] of exampleType.value.entries()) {
+            ret.value.push(this.adaptExampleType(v, goType.elementType, rawProperty(raw, i)));
           }
           return ret;
         }
@@ -1793,13 +2173,11 @@ export class ClientAdapter {
         if (goType.kind === 'map') {
           const ret = new go.DictionaryExample(goType);
           for (const [k, v] of Object.entries(exampleType.value)) {
-            ret.value[k] = this.adaptExampleType(v, goType.valueType);
+            ret.value[k] = this.adaptExampleType(v, goType.valueType, rawProperty(raw, k));
           }
           return ret;
         }
         break;
-      case 'union':
-        throw new AdapterError('UnsupportedTsp', 'unsupported example type kind union');
       case 'model':
         if (goType.kind === 'interface' || goType.kind === 'model' || goType.kind === 'polymorphicModel') {
           let concreteType: go.Model | go.PolymorphicModel | undefined;
@@ -1825,7 +2203,7 @@ export class ClientAdapter {
             if (!field) {
               throw new AdapterError('InternalError', `field with serializedName '${k}' not found in model '${concreteType.name}'.`);
             }
-            ret.value[field.name] = this.adaptExampleType(v, field.type);
+            ret.value[field.name] = this.adaptExampleType(v, field.type, rawProperty(raw, k));
           }
           if (exampleType.additionalPropertiesValue) {
             ret.additionalProperties = {};
@@ -1835,7 +2213,7 @@ export class ClientAdapter {
                 throw new AdapterError('InternalError', `additional properties field not found in model '${concreteType.name}'.`);
               }
               if (filed.type.kind === 'map') {
-                ret.additionalProperties[k] = this.adaptExampleType(v, filed.type.valueType);
+                ret.additionalProperties[k] = this.adaptExampleType(v, filed.type.valueType, rawProperty(raw, k));
               } else {
                 throw new AdapterError('InternalError', `additional properties field type should be map type, but got '${filed.type.kind}' in model '${concreteType.name}'`);
               }
@@ -1847,8 +2225,170 @@ export class ClientAdapter {
     }
     throw new AdapterError('InternalError', `can not map go type into example type ${exampleType.kind}`);
   }
+
+  /**
+   * adapts an example value for a union. the first variant
+   * that's compatible with the example value is selected.
+   *
+   * @param exampleType the example value to adapt
+   * @param goType the union that contains the variants
+   * @param raw the same value from the example file, if available
+   * @returns the example for the selected variant
+   */
+  private adaptUnionExample(exampleType: tcgc.SdkExampleValue, goType: go.Union, raw?: unknown): go.UnionExample {
+    if (exampleType.kind === 'union') {
+      // tcgc couldn't match the value to a variant so we have the raw value
+      for (const variant of goType.variants) {
+        const value = adaptRawExampleValue(raw ?? exampleType.value, variant.type);
+        if (value) {
+          return new go.UnionExample(goType, variant, value);
+        }
+      }
+    } else {
+      for (const variant of goType.variants) {
+        if (exampleType.kind === 'model' && (variant.type.kind === 'model' || variant.type.kind === 'polymorphicModel') && variant.type.name !== helpers.getEffectiveName(exampleType.type)) {
+          // the example is for a different model
+          continue;
+        }
+        try {
+          return new go.UnionExample(goType, variant, this.adaptExampleType(exampleType, variant.type, raw));
+        } catch {
+          // not a match, try the next variant
+        }
+      }
+    }
+    throw new AdapterError('InternalError', `can not find a variant of union ${goType.name} for example type ${exampleType.kind}`);
+  }
+}
+
+/**
+ * adapts a raw JSON example value to the specified type
+ *
+ * @param value the raw JSON value
+ * @param goType the type to adapt the value to
+ * @returns the adapted example or undefined if the value isn't compatible with the type
+ */
+function adaptRawExampleValue(value: unknown, goType: go.WireType): Exclude<go.ExampleType, go.TokenCredentialExample> | undefined {
+  if (value instanceof RawNumber) {
+    if (goType.kind === 'decimal') {
+      return new go.StringExample(value.text, goType);
+    }
+    value = Number(value.text);
+  }
+  if (goType.kind === 'any') {
+    return new go.AnyExample(toExampleValue(value));
+  } else if (value === null) {
+    return new go.NullExample(goType);
+  }
+  switch (typeof value) {
+    case 'string':
+      if (goType.kind === 'string' || goType.kind === 'duration' || (goType.kind === 'constant' && goType.type === 'string')) {
+        return new go.StringExample(value, goType);
+      }
+      break;
+    case 'number':
+      if ((goType.kind === 'scalar' && goType.type !== 'bool') || (goType.kind === 'constant' && goType.type !== 'bool' && goType.type !== 'string')) {
+        return new go.NumberExample(value, goType);
+      } else if (goType.kind === 'duration') {
+        return new go.NumberExample(value, goType);
+      }
+      break;
+    case 'boolean':
+      if ((goType.kind === 'scalar' || goType.kind === 'constant') && goType.type === 'bool') {
+        return new go.BooleanExample(value, goType);
+      }
+      break;
+    case 'object':
+      if (Array.isArray(value)) {
+        if (goType.kind !== 'slice') {
+          break;
+        }
+        const ret = new go.ArrayExample(goType);
+        for (const element of value) {
+          const elementExample = adaptRawExampleValue(element, goType.elementType);
+          if (!elementExample) {
+            return undefined;
+          }
+          ret.value.push(elementExample);
+        }
+        return ret;
+      } else if (goType.kind === 'map') {
+        const ret = new go.DictionaryExample(goType);
+        for (const [k, v] of Object.entries(<Record<string, unknown>>value)) {
+          const valueExample = adaptRawExampleValue(v, goType.valueType);
+          if (!valueExample) {
+            return undefined;
+          }
+          ret.value[k] = valueExample;
+        }
+        return ret;
+      } else if (goType.kind === 'model' || goType.kind === 'polymorphicModel') {
+        const ret = new go.StructExample(goType);
+        for (const [k, v] of Object.entries(<Record<string, unknown>>value)) {
+          const field = goType.fields.find((f) => f.serializedName === k);
+          if (!field) {
+            return undefined;
+          }
+          const fieldExample = adaptRawExampleValue(v, field.type);
+          if (!fieldExample) {
+            return undefined;
+          }
+          ret.value[field.name] = fieldExample;
+        }
+        return ret;
+      }
+      break;
+  }
+  return undefined;
+}
+
+/** a number from an example file with the exact text of its value */
+class RawNumber {
+  constructor(readonly text: string) {}
 }
 
+/**
+ * returns the named property or element of a raw example value.
+ *
+ * @param raw the raw example value
+ * @param key the name of the property or index of the element
+ * @returns the value or undefined if raw doesn't contain it
+ */
+function rawProperty(raw: unknown, key: number | string): unknown {
+  if (raw === null || typeof raw !== 'object' || raw instanceof RawNumber) {
+    return undefined;
+  }
+  return (<Record<number | string, unknown>>raw)[key];
+}
+
+/**
+ * converts a raw example value back to plain JSON values.
+ *
+ * @param raw the raw example value
+ * @returns the value with its numbers restored
+ */
+function toExampleValue(raw: unknown): unknown {
+  if (raw instanceof RawNumber) {
+    return Number(raw.text);
+  } else if (Array.isArray(raw)) {
+    return raw.map(toExampleValue);
+  } else if (raw !== null && typeof raw === 'object') {
+    return Object.fromEntries(Object.entries(raw).map(([k, v]) => [k, toExampleValue(v)]));
+  }
+  return raw;
+}
+
+// maps the conditional request headers to their param names in MatchConditions and RequestConditions
+const conditionalRequestHeaders: Record<string, string> = {
+  'if-match': 'ifMatch',
+  'if-none-match': 'ifNoneMatch',
+  'if-modified-since': 'ifModifiedSince',
+  'if-unmodified-since': 'ifUnmodifiedSince',
+};
+
+// the conditional request headers that are included in MatchConditions
+const conditionalMatchHeaders = ['if-match', 'if-none-match'];
+
 interface HttpStatusCodeRange {
   start: number;
   end: number;
@@ -1858,6 +2398,44 @@ function isHttpStatusCodeRange(statusCode: HttpStatusCodeRange | number): status
   return (<HttpStatusCodeRange>statusCode).start !== undefined;
 }
 
+/**
+ * returns the complete collection of successful HTTP status codes for an operation
+ *
+ * @param httpOp the operation for which to return the status codes
+ * @returns the status codes
+ */
+function getStatusCodes(httpOp: tcgc.SdkHttpOperation): Array<number> {
+  const statusCodes = new Array<number>();
+  for (const response of httpOp.responses) {
+    const statusCode = response.statusCodes;
+    if (isHttpStatusCodeRange(statusCode)) {
+      for (let code = statusCode.start; code <= statusCode.end; ++code) {
+        statusCodes.push(code);
+      }
+    } else {
+      statusCodes.push(statusCode);
+    }
+  }
+  return statusCodes;
+}
+
+/**
+ * returns true if the union response type was synthesized from multiple responses
+ * with different types, false if all responses return the same (declared) union.
+ *
+ * @param sdkMethod the method that returns the union
+ * @param sdkResponseType the method's response type
+ * @returns true if the method is a multi-response operation
+ */
+function isMultiResponse(sdkMethod: tcgc.SdkServiceMethod<tcgc.SdkHttpOperation>, sdkResponseType: tcgc.SdkUnionType): boolean {
+  for (const resp of sdkMethod.operation.responses) {
+    if (resp.type && (resp.type.kind !== 'union' || resp.type.name !== sdkResponseType.name)) {
+      return true;
+    }
+  }
+  return false;
+}
+
 /** contains the common set of param info needed to adapt the parameter's style */
 interface ParameterStyleInfo {
   __raw?: ModelProperty;
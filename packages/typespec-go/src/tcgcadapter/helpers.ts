@@ -5,6 +5,7 @@
 
 import * as tcgc from '@azure-tools/typespec-client-generator-core';
 import * as tsp from '@typespec/compiler';
+import { getStreamMetadata } from '@typespec/http/experimental';
 import * as go from '../../../codemodel.go/src/index.js';
 import * as naming from '../../../naming.go/src/index.js';
 
@@ -121,7 +122,9 @@ export function isTypePassedByValue(type: tcgc.SdkType): boolean {
   if (type.kind === 'nullable') {
     type = type.type;
   }
-  return type.kind === 'unknown' || type.kind === 'array' || type.kind === 'bytes' || type.kind === 'dict' || (type.kind === 'model' && isPolymorphicRoot(type));
+  return (
+    type.kind === 'unknown' || type.kind === 'array' || type.kind === 'bytes' || type.kind === 'dict' || type.kind === 'union' || (type.kind === 'model' && isPolymorphicRoot(type))
+  );
 }
 
 /** contains the set of client options */
@@ -285,3 +288,154 @@ export function isHttpFileType(model: tcgc.SdkModelType): boolean {
   // instances with different suffixes (e.g. File1, File2, etc)
   return model.namespace.toLowerCase() === 'typespec.http' && model.name.toLowerCase().startsWith('file');
 }
+
+/**
+ * returns the value constraints for the specified property.
+ * constraints on the property take precedence over constraints
+ * on its scalar type (and the scalar's base scalars).
+ *
+ * @param program the tsp Program currently in scope
+ * @param prop the property from which to read the constraints
+ * @returns the constraints or undefined if there are none
+ */
+export function getConstraints(program: tsp.Program, prop: tsp.ModelProperty | undefined): go.Constraints | undefined {
+  if (!prop) {
+    return undefined;
+  }
+  const targets = new Array<tsp.Type>(prop);
+  for (let scalar = prop.type.kind === 'Scalar' ? prop.type : undefined; scalar; scalar = scalar.baseScalar) {
+    targets.push(scalar);
+  }
+
+  const constraints: go.Constraints = {};
+  for (const target of targets) {
+    constraints.minLength ??= tsp.getMinLength(program, target);
+    constraints.maxLength ??= tsp.getMaxLength(program, target);
+    constraints.pattern ??= tsp.getPattern(program, target);
+    constraints.minValue ??= tsp.getMinValue(program, target);
+    constraints.maxValue ??= tsp.getMaxValue(program, target);
+    constraints.minValueExclusive ??= tsp.getMinValueExclusive(program, target);
+    constraints.maxValueExclusive ??= tsp.getMaxValueExclusive(program, target);
+    constraints.minItems ??= tsp.getMinItems(program, target);
+    constraints.maxItems ??= tsp.getMaxItems(program, target);
+  }
+
+  if (Object.values(constraints).every((value) => value === undefined)) {
+    return undefined;
+  }
+  return constraints;
+}
+
+/** describes a stream of items returned by an operation */
+export interface StreamInfo {
+  /** the wire format of the stream */
+  format: go.StreamFormat;
+
+  /**
+   * the type of the items in the stream.
+   * for SSE streams, this is the union of events.
+   */
+  itemType: tsp.Type;
+
+  /** for SSE streams, the events in the union of events */
+  events: Array<StreamEventInfo>;
+}
+
+/** describes an event within a stream of server-sent events */
+export interface StreamEventInfo {
+  /** the event type sent over the wire */
+  name: string;
+
+  /** the type of the event's data */
+  type: tsp.Type;
+
+  /** the content type of the event's data from @contentType */
+  contentType?: string;
+
+  /** indicates the event was decorated with @terminalEvent */
+  terminal: boolean;
+}
+
+/**
+ * returns the stream info if the response is a JSONL stream or
+ * a stream of server-sent events. other streams are returned
+ * as binary responses.
+ *
+ * @param program the tsp Program currently in scope
+ * @param httpResp the response to inspect
+ * @returns the stream info or undefined if the response isn't a supported stream
+ */
+export function getStreamInfo(program: tsp.Program, httpResp: tcgc.SdkHttpResponse): StreamInfo | undefined {
+  for (const content of httpResp.__raw?.responses ?? []) {
+    const metadata = getStreamMetadata(program, content);
+    if (!metadata) {
+      continue;
+    }
+    if (metadata.contentTypes.includes('text/event-stream')) {
+      if (metadata.streamType.kind !== 'Union') {
+        return undefined;
+      }
+      return {
+        format: 'SSE',
+        itemType: metadata.streamType,
+        events: getStreamEvents(metadata.streamType),
+      };
+    } else if (metadata.contentTypes.some((contentType) => contentType.match(/^application\/(jsonl|x-ndjson)$/))) {
+      return {
+        format: 'JSONL',
+        itemType: metadata.streamType,
+        events: [],
+      };
+    }
+  }
+  return undefined;
+}
+
+/**
+ * returns the events within an @events union.
+ * unnamed variants are sent without an event type so they use the default type "message".
+ *
+ * @param union the union of events
+ * @returns the events in declaration order
+ */
+function getStreamEvents(union: tsp.Union): Array<StreamEventInfo> {
+  const events = new Array<StreamEventInfo>();
+  for (const [name, variant] of union.variants) {
+    const contentType = variant.decorators.find((decorator) => decorator.definition?.name === '@contentType')?.args[0]?.jsValue;
+    events.push({
+      name: typeof name === 'string' ? name : 'message',
+      type: variant.type,
+      contentType: typeof contentType === 'string' ? contentType : undefined,
+      terminal: variant.decorators.some((decorator) => decorator.definition?.name === '@terminalEvent'),
+    });
+  }
+  return events;
+}
+
+/**
+ * returns the range of API versions in which an operation or parameter is available.
+ * returns undefined when it's available in all of the API versions in history.
+ *
+ * @param apiVersions the API versions in which the operation or parameter is available
+ * @param history all of the client's API versions, oldest first. can be empty
+ * @returns the API version range or undefined
+ */
+export function getAPIVersionRange(apiVersions: Array<string>, history: Array<string>): go.APIVersionRange | undefined {
+  const indices = apiVersions.map((apiVersion) => history.indexOf(apiVersion)).filter((index) => index >= 0);
+  if (indices.length === 0) {
+    return undefined;
+  }
+  const first = Math.min(...indices);
+  const last = Math.max(...indices);
+  if (first === 0 && last === history.length - 1) {
+    return undefined;
+  }
+  const range: go.APIVersionRange = {};
+  if (first > 0) {
+    range.min = history[first];
+  }
+  if (last < history.length - 1) {
+    range.max = history[last];
+  }
+  return range;
+}
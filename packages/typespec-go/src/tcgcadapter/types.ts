@@ -52,7 +52,7 @@ export class TypeAdapter {
       if ((enumType.usage & tcgc.UsageFlags.ApiVersionEnum) !== 0) {
         // skip enums that are used for API version
         continue;
-      } else if ((enumType.usage & tcgc.UsageFlags.Input) === 0 && (enumType.usage & tcgc.UsageFlags.Output) === 0) {
+      } else if (!this.isUsed(enumType.usage)) {
         // skip types without input and output usage
         continue;
       }
@@ -71,7 +71,7 @@ export class TypeAdapter {
       } else if (modelType.access === 'internal' && (modelType.usage & tcgc.UsageFlags.Spread) !== 0) {
         // we don't use the internal models for spread params
         continue;
-      } else if ((modelType.usage & tcgc.UsageFlags.Input) === 0 && (modelType.usage & tcgc.UsageFlags.Output) === 0) {
+      } else if (!this.isUsed(modelType.usage)) {
         // skip types without input and output usage
         continue;
       } else if (modelType.isGeneratedName && modelType.usage & tcgc.UsageFlags.MultipartFormData) {
@@ -104,6 +104,39 @@ export class TypeAdapter {
       modelTypes.push({ go: model, tcgc: pagedResponse });
     }
 
+    // add the models for the items in JSONL and SSE streams
+    for (const streamModel of this.getStreamModels()) {
+      const existing = modelTypes.find((each) => each.tcgc.name === streamModel.name);
+      if (existing) {
+        existing.go.usage |= go.UsageFlags.Output;
+        continue;
+      }
+      const model = this.getModel(streamModel);
+      model.usage |= go.UsageFlags.Output;
+      modelTypes.push({ go: model, tcgc: streamModel });
+    }
+
+    // add the models and enums for LRO status monitors
+    if (this.codeModel.options.typedStatusMonitors) {
+      const statusEnums = new Array<tcgc.SdkEnumType>();
+      for (const statusModel of this.getStatusMonitorModels(statusEnums)) {
+        const existing = modelTypes.find((each) => each.tcgc.name === statusModel.name);
+        if (existing) {
+          existing.go.usage |= go.UsageFlags.Output;
+          continue;
+        }
+        const model = this.getModel(statusModel);
+        model.usage |= go.UsageFlags.Output;
+        modelTypes.push({ go: model, tcgc: statusModel });
+      }
+      for (const statusEnum of statusEnums) {
+        const constType = this.getConstantType(statusEnum);
+        if (!this.getPkg().constants.includes(constType)) {
+          this.getPkg().constants.push(constType);
+        }
+      }
+    }
+
     // now that the interface/model types have been generated, we can populate the rootType and possibleTypes
     for (const ifaceType of ifaceTypes) {
       ifaceType.go.rootType = <go.PolymorphicModel>this.getModel(ifaceType.tcgc);
@@ -130,6 +163,16 @@ export class TypeAdapter {
     }
   }
 
+  // returns true if the usage flags indicate that the type is sent or received over the wire.
+  // when typed errors are enabled, error response types are received over the wire.
+  private isUsed(usage: tcgc.UsageFlags): boolean {
+    let flags = tcgc.UsageFlags.Input | tcgc.UsageFlags.Output;
+    if (this.codeModel.options.typedErrors) {
+      flags |= tcgc.UsageFlags.Exception;
+    }
+    return (usage & flags) !== 0;
+  }
+
   // returns the synthesized paged response types
   private getPagedResponses(): Array<tcgc.SdkModelType> {
     const pagedResponses = new Array<tcgc.SdkModelType>();
@@ -162,6 +205,136 @@ export class TypeAdapter {
     return pagedResponses;
   }
 
+  // returns the JSONL and SSE streams returned by all methods
+  private getStreams(): Array<helpers.StreamInfo> {
+    const streams = new Array<helpers.StreamInfo>();
+    const recursiveWalkClients = (client: tcgc.SdkClientType<tcgc.SdkHttpOperation>): void => {
+      for (const child of client.children ?? []) {
+        recursiveWalkClients(child);
+      }
+      for (const sdkMethod of client.methods) {
+        if (sdkMethod.kind !== 'basic') {
+          continue;
+        }
+        for (const httpResp of sdkMethod.operation.responses) {
+          const stream = helpers.getStreamInfo(this.ctx.program, httpResp);
+          if (stream) {
+            streams.push(stream);
+          }
+        }
+      }
+    };
+
+    for (const sdkClient of this.ctx.sdkPackage.clients) {
+      recursiveWalkClients(sdkClient);
+    }
+    return streams;
+  }
+
+  // returns the models used by the items in JSONL and SSE streams.
+  // the stream bodies aren't modeled by tcgc so these models might not have any usage.
+  private getStreamModels(): Array<tcgc.SdkModelType> {
+    const streamModels = new Array<tcgc.SdkModelType>();
+
+    for (const stream of this.getStreams()) {
+      if (stream.format === 'JSONL') {
+        addReferencedModels(tcgc.getClientType(this.ctx, stream.itemType), streamModels);
+        continue;
+      }
+      for (const event of stream.events) {
+        if (!event.terminal) {
+          addReferencedModels(tcgc.getClientType(this.ctx, event.type), streamModels);
+        }
+      }
+    }
+    return streamModels;
+  }
+
+  // returns the models used by the status monitors of LROs.
+  // the polling responses aren't part of the method signatures so these models might not have any usage.
+  private getStatusMonitorModels(enums: Array<tcgc.SdkEnumType>): Array<tcgc.SdkModelType> {
+    const statusModels = new Array<tcgc.SdkModelType>();
+    const recursiveWalkClients = (client: tcgc.SdkClientType<tcgc.SdkHttpOperation>): void => {
+      for (const child of client.children ?? []) {
+        recursiveWalkClients(child);
+      }
+      for (const sdkMethod of client.methods) {
+        if (sdkMethod.kind !== 'lro' && sdkMethod.kind !== 'lropaging') {
+          continue;
+        }
+        const statusMonitor = sdkMethod.lroMetadata.pollingStep?.responseBody;
+        if (statusMonitor) {
+          addReferencedModels(statusMonitor, statusModels, enums);
+        }
+      }
+    };
+
+    for (const sdkClient of this.ctx.sdkPackage.clients) {
+      recursiveWalkClients(sdkClient);
+    }
+    return statusModels;
+  }
+
+  /**
+   * returns the Go code model result for a JSONL stream or a stream of server-sent events.
+   * for SSE streams, the non-terminal events are adapted into a union with one variant per event.
+   *
+   * @param stream the stream returned by the method
+   * @returns the stream result
+   */
+  getStreamResult(stream: helpers.StreamInfo): go.StreamResult {
+    if (stream.format === 'JSONL') {
+      const itemType = this.getWireType(tcgc.getClientType(this.ctx, stream.itemType), true, false);
+      if (itemType.kind === 'interface') {
+        throw new AdapterError('UnsupportedTsp', 'streams of discriminated types are not supported', stream.itemType.node);
+      }
+      return new go.StreamResult('Stream', new go.EventStream(itemType, this.getPkg()), 'JSONL');
+    }
+
+    const eventsUnion = <tsp.Union>stream.itemType;
+    if (!eventsUnion.name) {
+      throw new AdapterError('UnsupportedTsp', 'the union of events for a stream of server-sent events must be named', eventsUnion.node);
+    }
+    const unionName = naming.capitalize(eventsUnion.name);
+    let unionType = this.types.get(unionName);
+    if (unionType && unionType.kind !== 'union') {
+      throw new AdapterError('UnsupportedTsp', `events union ${unionName} collides with type ${unionType.kind}`, eventsUnion.node);
+    } else if (!unionType) {
+      unionType = new go.Union(this.getPkg(), unionName);
+      unionType.docs.description = tsp.getDoc(this.ctx.program, eventsUnion);
+      this.types.set(unionName, unionType);
+      this.getPkg().unions.push(unionType);
+    }
+
+    const result = new go.StreamResult('Stream', new go.EventStream(unionType, this.getPkg()), 'SSE');
+    for (const event of stream.events) {
+      const format = event.contentType?.startsWith('text/') ? 'Text' : 'JSON';
+      const streamEvent = new go.StreamEvent(event.name, format, event.terminal);
+      result.events.push(streamEvent);
+      if (event.terminal) {
+        if (event.type.kind === 'String') {
+          streamEvent.data = event.type.value;
+        }
+        continue;
+      }
+
+      const variantName = `${unionName}${naming.ensureNameCase(event.name)}`;
+      let variant = unionType.variants.find((each) => each.name === variantName);
+      if (!variant) {
+        const variantType = this.getWireType(tcgc.getClientType(this.ctx, event.type), false, false);
+        if (!isUnionVariantType(variantType)) {
+          throw new AdapterError('UnsupportedTsp', `unsupported type kind ${variantType.kind} for event ${event.name}`, event.type.node);
+        } else if (format === 'Text' && variantType.kind !== 'string') {
+          throw new AdapterError('UnsupportedTsp', `event ${event.name} with content type ${event.contentType} must be a string`, event.type.node);
+        }
+        variant = new go.UnionVariant(variantName, variantType, variantType.kind !== 'model' && variantType.kind !== 'polymorphicModel');
+        unionType.variants.push(variant);
+      }
+      streamEvent.variant = variant;
+    }
+    return result;
+  }
+
   // returns the Go code model type for the specified SDK type.
   // the operation is idempotent, so getting the same type multiple times
   // returns the same instance of the converted type.
@@ -232,6 +405,17 @@ export class TypeAdapter {
         return mapType;
       }
       case 'duration': {
+        if (this.codeModel.options.durationAsTimeDuration) {
+          const format = getDurationFormat(type.encode, type.wireType.kind);
+          const durationKey = `duration-${format}`;
+          let duration = this.types.get(durationKey);
+          if (duration) {
+            return duration;
+          }
+          duration = new go.Duration(format);
+          this.types.set(durationKey, duration);
+          return duration;
+        }
         switch (type.wireType.kind) {
           case 'float':
           case 'float32':
@@ -253,6 +437,8 @@ export class TypeAdapter {
         return this.getModel(type);
       case 'nullable':
         return this.getWireType(type.type, elementTypeByValue, substituteDiscriminator);
+      case 'union':
+        return this.getUnionType(type);
       default:
         throw new AdapterError('UnsupportedTsp', `unsupported type kind ${type.kind}`, type.__raw?.node);
     }
@@ -361,6 +547,16 @@ export class TypeAdapter {
       }
       case 'decimal':
       case 'decimal128': {
+        if (this.codeModel.options.decimalAsJSONNumber) {
+          const jsonNumberKey = `decimal-${type.encode === 'string' ? 'string' : 'number'}`;
+          let jsonNumber = this.types.get(jsonNumberKey);
+          if (jsonNumber) {
+            return jsonNumber;
+          }
+          jsonNumber = new go.Decimal(type.encode === 'string');
+          this.types.set(jsonNumberKey, jsonNumber);
+          return jsonNumber;
+        }
         const decimalKey = 'float64';
         let decimalType = this.types.get(decimalKey);
         if (decimalType) {
@@ -453,6 +649,7 @@ export class TypeAdapter {
     const accessPrefix = enumType.access === 'internal' ? 'p' : 'P';
     constType = new go.Constant(this.getPkg(), constTypeName, getPrimitiveType(enumType.valueType), `${accessPrefix}ossible${constTypeName}Values`);
     constType.values = this.getConstantValues(constType, enumType.values);
+    constType.fixed = enumType.isFixed;
     constType.docs.summary = enumType.summary;
     constType.docs.description = enumType.doc;
     this.types.set(constTypeName, constType);
@@ -559,6 +756,41 @@ export class TypeAdapter {
     return iface;
   }
 
+  // converts an SdkUnionType to a go.Union
+  private getUnionType(union: tcgc.SdkUnionType): go.Union {
+    let unionName = helpers.getEffectiveName(union);
+    if (union.access === 'internal') {
+      unionName = naming.uncapitalize(unionName);
+    }
+    let unionType = this.types.get(unionName);
+    if (unionType) {
+      return <go.Union>unionType;
+    }
+    unionType = new go.Union(this.getPkg(), unionName);
+    unionType.docs.summary = union.summary;
+    unionType.docs.description = union.doc;
+    // add to the cache before adapting the variants in case of recursive types
+    this.types.set(unionName, unionType);
+
+    for (let variantType of union.variantTypes) {
+      if (variantType.kind === 'nullable') {
+        variantType = variantType.type;
+      }
+      const goType = this.getWireType(variantType, false, false);
+      if (!isUnionVariantType(goType)) {
+        throw new AdapterError('UnsupportedTsp', `unsupported variant type kind ${variantType.kind} for union ${union.name}`, variantType.__raw?.node);
+      }
+      const variantName = `${unionName}${getUnionVariantSuffix(goType)}`;
+      if (unionType.variants.find((each) => each.name === variantName)) {
+        throw new AdapterError('UnsupportedTsp', `union ${union.name} contains multiple variants that map to type ${variantName}`, variantType.__raw?.node);
+      }
+      unionType.variants.push(new go.UnionVariant(variantName, goType, goType.kind !== 'model' && goType.kind !== 'polymorphicModel'));
+    }
+
+    this.getPkg().unions.push(unionType);
+    return unionType;
+  }
+
   // converts an SdkModelType to a go.ModelType or go.PolymorphicType if the model is polymorphic
   private getModel(model: tcgc.SdkModelType): go.Model | go.PolymorphicModel {
     let modelName = helpers.getEffectiveName(model);
@@ -577,6 +809,9 @@ export class TypeAdapter {
     if (model.usage & tsp.UsageFlags.Output) {
       usage |= go.UsageFlags.Output;
     }
+    if (this.codeModel.options.typedErrors && model.usage & tcgc.UsageFlags.Exception) {
+      usage |= go.UsageFlags.Output;
+    }
 
     let omitSerde = false;
     const omitValue = helpers.getClientOption<string>('omitSerdeMethods', model, this.ctx.program);
@@ -595,7 +830,8 @@ export class TypeAdapter {
       }
     }
 
-    const annotations = new go.ModelAnnotations(omitSerde, (model.usage & tcgc.UsageFlags.MultipartFormData) !== 0);
+    const mergePatch = this.codeModel.options.mergePatchModels && (model.usage & tcgc.UsageFlags.JsonMergePatch) !== 0;
+    const annotations = new go.ModelAnnotations(omitSerde, (model.usage & tcgc.UsageFlags.MultipartFormData) !== 0, mergePatch);
     if (helpers.isPolymorphicRoot(model) || model.discriminatorValue) {
       let iface: go.Interface | undefined;
       let discriminatorLiteral: go.Literal | undefined;
@@ -739,6 +975,10 @@ export class TypeAdapter {
       field.annotations.unmarshalEmptyStringAsNil = true;
     }
 
+    if (this.codeModel.options.validateConstraints && prop.__raw?.kind === 'ModelProperty') {
+      field.constraints = helpers.getConstraints(this.ctx.program, prop.__raw);
+    }
+
     // it's possible for different models to reference the same property definition
     if (!this.fieldsMap.has(prop)) {
       this.fieldsMap.set(prop, field);
@@ -943,6 +1183,20 @@ function getDateTimeEncoding(encoding: string): go.TimeFormat {
   }
 }
 
+function getDurationFormat(encoding: string, wireType: tcgc.SdkBuiltInKinds): go.DurationFormat {
+  const isInt = wireType === 'int32' || wireType === 'int64';
+  switch (encoding) {
+    case 'ISO8601':
+      return 'ISO8601';
+    case 'milliseconds':
+      return isInt ? 'MillisecondsInt' : 'Milliseconds';
+    case 'seconds':
+      return isInt ? 'SecondsInt' : 'Seconds';
+    default:
+      throw new AdapterError('UnsupportedTsp', `unsupported duration encoding ${encoding}`);
+  }
+}
+
 function recursiveKeyName(root: string, obj: tcgc.SdkType, substituteDiscriminator: boolean): string {
   switch (obj.kind) {
     case 'array':
@@ -958,7 +1212,7 @@ function recursiveKeyName(root: string, obj: tcgc.SdkType, substituteDiscriminat
     case 'utcDateTime':
       return `${root}-${getDateTimeEncoding(obj.encode)}`;
     case 'duration':
-      return `${root}-${obj.wireType.kind}`;
+      return `${root}-${obj.encode}-${obj.wireType.kind}`;
     case 'model':
       if (substituteDiscriminator) {
         return `${root}-${naming.createPolymorphicInterfaceName(obj.name)}`;
@@ -966,6 +1220,8 @@ function recursiveKeyName(root: string, obj: tcgc.SdkType, substituteDiscriminat
       return `${root}-${obj.name}`;
     case 'nullable':
       return recursiveKeyName(root, obj.type, substituteDiscriminator);
+    case 'union':
+      return `${root}-${obj.name}`;
     case 'plainTime':
       if (obj.encode !== 'rfc3339') {
         throw new AdapterError('UnsupportedTsp', `unsupported time encoding ${obj.encode}`, obj.__raw?.node);
@@ -976,6 +1232,58 @@ function recursiveKeyName(root: string, obj: tcgc.SdkType, substituteDiscriminat
   }
 }
 
+/**
+ * returns true if the type can be the variant of a union.
+ * time and bytes types are excluded as they don't round-trip through
+ * json.Unmarshal, and interfaces/unions can't be wrapped in a variant.
+ *
+ * @param type the type to inspect
+ * @returns true if the type can be wrapped in a union variant
+ */
+function isUnionVariantType(type: go.WireType): type is go.UnionVariantType {
+  switch (type.kind) {
+    case 'any':
+    case 'constant':
+    case 'model':
+    case 'polymorphicModel':
+    case 'scalar':
+    case 'string':
+      return true;
+    case 'map':
+    case 'slice': {
+      return isUnionVariantType(type.kind === 'map' ? type.valueType : type.elementType);
+    }
+    default:
+      return false;
+  }
+}
+
+/**
+ * returns the suffix used to name the wrapper type for a union variant.
+ * e.g. String, Int32, Cat, StringArray
+ *
+ * @param type the type of the variant
+ * @returns the suffix for the variant's name
+ */
+function getUnionVariantSuffix(type: go.UnionVariantType): string {
+  switch (type.kind) {
+    case 'any':
+      return 'Any';
+    case 'constant':
+    case 'model':
+    case 'polymorphicModel':
+      return naming.capitalize(type.name);
+    case 'map':
+      return `${getUnionVariantSuffix(<go.UnionVariantType>type.valueType)}Map`;
+    case 'scalar':
+      return naming.capitalize(type.type);
+    case 'slice':
+      return `${getUnionVariantSuffix(<go.UnionVariantType>type.elementType)}Array`;
+    case 'string':
+      return 'String';
+  }
+}
+
 interface ModelTypeSdkModelType {
   go: go.Model | go.PolymorphicModel;
   tcgc: tcgc.SdkModelType;
@@ -986,6 +1294,48 @@ interface InterfaceTypeSdkModelType {
   tcgc: tcgc.SdkModelType;
 }
 
+/**
+ * adds the models referenced by type to models, including type itself.
+ * any enums referenced by the models are added to enums.
+ *
+ * @param type the type to walk
+ * @param models the models found so far
+ * @param enums optional enums found so far
+ */
+function addReferencedModels(type: tcgc.SdkType, models: Array<tcgc.SdkModelType>, enums?: Array<tcgc.SdkEnumType>): void {
+  switch (type.kind) {
+    case 'array':
+    case 'dict':
+      addReferencedModels(type.valueType, models, enums);
+      break;
+    case 'enum':
+      if (enums && !enums.includes(type)) {
+        enums.push(type);
+      }
+      break;
+    case 'model':
+      if (models.find((each) => each.name === type.name)) {
+        return;
+      }
+      models.push(type);
+      if (type.baseModel) {
+        addReferencedModels(type.baseModel, models, enums);
+      }
+      for (const prop of type.properties) {
+        addReferencedModels(prop.type, models, enums);
+      }
+      break;
+    case 'nullable':
+      addReferencedModels(type.type, models, enums);
+      break;
+    case 'union':
+      for (const variantType of type.variantTypes) {
+        addReferencedModels(variantType, models, enums);
+      }
+      break;
+  }
+}
+
 // aggregate the properties from the provided type and its parent types.
 // this includes any inherited additional properties.
 function aggregateProperties(sdkContext: tcgc.SdkContext, model: tcgc.SdkModelType): { props: Array<tcgc.SdkModelPropertyType>; addlProps?: tcgc.SdkType } {
@@ -28,9 +28,7 @@ func TestResiliencyServiceDrivenClientv1_FromNone(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	resp, err := client.FromNone(context.Background(), &srvdrivennewgroup.ResiliencyServiceDrivenClientFromNoneOptions{
-		NewParameter: to.Ptr("new"),
-	})
+	resp, err := client.FromNone(context.Background(), nil)
 	require.NoError(t, err)
 	require.True(t, resp.Success)
 }
@@ -43,8 +41,7 @@ func TestResiliencyServiceDrivenClientv1_FromOneOptional(t *testing.T) {
 	})
 	require.NoError(t, err)
 	resp, err := client.FromOneOptional(context.Background(), &srvdrivennewgroup.ResiliencyServiceDrivenClientFromOneOptionalOptions{
-		NewParameter: to.Ptr("new"),
-		Parameter:    to.Ptr("optional"),
+		Parameter: to.Ptr("optional"),
 	})
 	require.NoError(t, err)
 	require.Zero(t, resp)
@@ -57,9 +54,7 @@ func TestResiliencyServiceDrivenClientv1_FromOneRequired(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	resp, err := client.FromOneRequired(context.Background(), "required", &srvdrivennewgroup.ResiliencyServiceDrivenClientFromOneRequiredOptions{
-		NewParameter: to.Ptr("new"),
-	})
+	resp, err := client.FromOneRequired(context.Background(), "required", nil)
 	require.NoError(t, err)
 	require.Zero(t, resp)
 }
@@ -94,3 +89,27 @@ func TestResiliencyServiceDrivenClientv2_FromOneRequired(t *testing.T) {
 	require.NoError(t, err)
 	require.Zero(t, resp)
 }
+
+func TestResiliencyServiceDrivenClientv1_CheckAPIVersion(t *testing.T) {
+	client, err := srvdrivennewgroup.NewResiliencyServiceDrivenClientWithNoCredential("http://localhost:3000", "v2", &srvdrivennewgroup.ResiliencyServiceDrivenClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			APIVersion: "v1",
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.AddOperation(context.Background(), nil)
+	require.EqualError(t, err, "operation ResiliencyServiceDrivenClient.AddOperation requires API version >= v2")
+	_, err = client.FromNone(context.Background(), &srvdrivennewgroup.ResiliencyServiceDrivenClientFromNoneOptions{
+		NewParameter: to.Ptr("new"),
+	})
+	require.EqualError(t, err, "parameter NewParameter requires API version >= v2")
+	_, err = client.FromOneOptional(context.Background(), &srvdrivennewgroup.ResiliencyServiceDrivenClientFromOneOptionalOptions{
+		NewParameter: to.Ptr("new"),
+		Parameter:    to.Ptr("optional"),
+	})
+	require.EqualError(t, err, "parameter NewParameter requires API version >= v2")
+	_, err = client.FromOneRequired(context.Background(), "required", &srvdrivennewgroup.ResiliencyServiceDrivenClientFromOneRequiredOptions{
+		NewParameter: to.Ptr("new"),
+	})
+	require.EqualError(t, err, "parameter NewParameter requires API version >= v2")
+}
@@ -27,8 +27,9 @@ import (
 // - A client generated from the second service spec can call the second deployment of a service with api version v2
 // Don't use this type directly, use NewResiliencyServiceDrivenClientWithNoCredential() instead.
 type ResiliencyServiceDrivenClient struct {
-	internal *azcore.Client
-	endpoint string
+	internal   *azcore.Client
+	endpoint   string
+	apiVersion string
 }
 
 // ResiliencyServiceDrivenClientOptions contains the optional values for creating a [ResiliencyServiceDrivenClient].
@@ -63,8 +64,9 @@ func NewResiliencyServiceDrivenClientWithNoCredential(endpoint string, serviceDe
 	host = strings.ReplaceAll(host, "{apiVersion}", apiVersion)
 	endpoint = runtime.JoinPaths(endpoint, host)
 	client := &ResiliencyServiceDrivenClient{
-		endpoint: endpoint,
-		internal: cl,
+		endpoint:   endpoint,
+		apiVersion: apiVersion,
+		internal:   cl,
 	}
 	return client, nil
 }
@@ -96,6 +98,9 @@ func (client *ResiliencyServiceDrivenClient) AddOperation(ctx context.Context, o
 
 // addOperationCreateRequest creates the AddOperation request.
 func (client *ResiliencyServiceDrivenClient) addOperationCreateRequest(ctx context.Context, _ *ResiliencyServiceDrivenClientAddOperationOptions) (*policy.Request, error) {
+	if err := checkAPIVersion("operation ResiliencyServiceDrivenClient.AddOperation", client.apiVersion, "v2", ""); err != nil {
+		return nil, err
+	}
 	urlPath := "/add-operation"
 	req, err := runtime.NewRequest(ctx, http.MethodDelete, runtime.JoinPaths(client.endpoint, urlPath))
 	if err != nil {
@@ -130,6 +135,11 @@ func (client *ResiliencyServiceDrivenClient) FromNone(ctx context.Context, optio
 
 // fromNoneCreateRequest creates the FromNone request.
 func (client *ResiliencyServiceDrivenClient) fromNoneCreateRequest(ctx context.Context, options *ResiliencyServiceDrivenClientFromNoneOptions) (*policy.Request, error) {
+	if options != nil && options.NewParameter != nil {
+		if err := checkAPIVersion("parameter NewParameter", client.apiVersion, "v2", ""); err != nil {
+			return nil, err
+		}
+	}
 	urlPath := "/add-optional-param/from-none"
 	req, err := runtime.NewRequest(ctx, http.MethodHead, runtime.JoinPaths(client.endpoint, urlPath))
 	if err != nil {
@@ -171,6 +181,11 @@ func (client *ResiliencyServiceDrivenClient) FromOneOptional(ctx context.Context
 
 // fromOneOptionalCreateRequest creates the FromOneOptional request.
 func (client *ResiliencyServiceDrivenClient) fromOneOptionalCreateRequest(ctx context.Context, options *ResiliencyServiceDrivenClientFromOneOptionalOptions) (*policy.Request, error) {
+	if options != nil && options.NewParameter != nil {
+		if err := checkAPIVersion("parameter NewParameter", client.apiVersion, "v2", ""); err != nil {
+			return nil, err
+		}
+	}
 	urlPath := "/add-optional-param/from-one-optional"
 	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.endpoint, urlPath))
 	if err != nil {
@@ -216,6 +231,11 @@ func (client *ResiliencyServiceDrivenClient) FromOneRequired(ctx context.Context
 
 // fromOneRequiredCreateRequest creates the FromOneRequired request.
 func (client *ResiliencyServiceDrivenClient) fromOneRequiredCreateRequest(ctx context.Context, parameter string, options *ResiliencyServiceDrivenClientFromOneRequiredOptions) (*policy.Request, error) {
+	if options != nil && options.NewParameter != nil {
+		if err := checkAPIVersion("parameter NewParameter", client.apiVersion, "v2", ""); err != nil {
+			return nil, err
+		}
+	}
 	urlPath := "/add-optional-param/from-one-required"
 	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.endpoint, urlPath))
 	if err != nil {
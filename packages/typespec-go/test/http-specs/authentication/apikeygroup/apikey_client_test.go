@@ -13,7 +13,11 @@ import (
 )
 
 func TestAPIKeyClient_Invalid(t *testing.T) {
-	client, err := apikeygroup.NewAPIKeyClient("http://localhost:3000", azcore.NewKeyCredential("invalid-key"), nil)
+	client, err := apikeygroup.NewAPIKeyClientWithKeyCredential("http://localhost:3000", azcore.NewKeyCredential("invalid-key"), &apikeygroup.APIKeyClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			InsecureAllowCredentialWithHTTP: true,
+		},
+	})
 	require.NoError(t, err)
 	resp, err := client.Invalid(context.Background(), nil)
 	require.Error(t, err)
@@ -22,7 +26,11 @@ func TestAPIKeyClient_Invalid(t *testing.T) {
 }
 
 func TestAPIKeyClient_Valid(t *testing.T) {
-	client, err := apikeygroup.NewAPIKeyClient("http://localhost:3000", azcore.NewKeyCredential("valid-key"), nil)
+	client, err := apikeygroup.NewAPIKeyClientWithKeyCredential("http://localhost:3000", azcore.NewKeyCredential("valid-key"), &apikeygroup.APIKeyClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			InsecureAllowCredentialWithHTTP: true,
+		},
+	})
 	require.NoError(t, err)
 	resp, err := client.Valid(context.Background(), nil)
 	require.NoError(t, err)
@@ -13,7 +13,7 @@ import (
 )
 
 // APIKeyClient - Illustrates clients generated with ApiKey authentication.
-// Don't use this type directly, use a constructor function instead.
+// Don't use this type directly, use NewAPIKeyClientWithKeyCredential() instead.
 type APIKeyClient struct {
 	internal *azcore.Client
 	endpoint string
@@ -24,6 +24,31 @@ type APIKeyClientOptions struct {
 	azcore.ClientOptions
 }
 
+// NewAPIKeyClientWithKeyCredential creates a new instance of APIKeyClient with the specified values.
+//   - endpoint - Service host
+//   - credential - used to authorize requests with a key.
+//   - options - Contains optional client configuration. Pass nil to accept the default values.
+func NewAPIKeyClientWithKeyCredential(endpoint string, credential *azcore.KeyCredential, options *APIKeyClientOptions) (*APIKeyClient, error) {
+	if options == nil {
+		options = &APIKeyClientOptions{}
+	}
+	cl, err := azcore.NewClient(moduleName, moduleVersion, runtime.PipelineOptions{
+		PerRetry: []policy.Policy{
+			runtime.NewKeyCredentialPolicy(credential, "x-ms-api-key", &runtime.KeyCredentialPolicyOptions{
+				InsecureAllowCredentialWithHTTP: options.InsecureAllowCredentialWithHTTP,
+			}),
+		},
+	}, &options.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+	client := &APIKeyClient{
+		endpoint: endpoint,
+		internal: cl,
+	}
+	return client, nil
+}
+
 // Invalid - Check whether client is authenticated.
 // If the operation fails it returns an *azcore.ResponseError type.
 //   - options - APIKeyClientInvalidOptions contains the optional parameters for the APIKeyClient.Invalid method.
@@ -1,28 +0,0 @@
-// Copyright (c) Microsoft Corporation. All rights reserved.
-// Licensed under the MIT License. See License.txt in the project root for license information.
-
-package customgroup
-
-import (
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
-)
-
-func NewCustomClient(endpoint string, credential *azcore.KeyCredential, options *azcore.ClientOptions) (*CustomClient, error) {
-	internal, err := azcore.NewClient(moduleName, moduleVersion, runtime.PipelineOptions{
-		PerRetry: []policy.Policy{
-			runtime.NewKeyCredentialPolicy(credential, "authorization", &runtime.KeyCredentialPolicyOptions{
-				InsecureAllowCredentialWithHTTP: true,
-				Prefix:                          "SharedAccessKey ",
-			}),
-		},
-	}, options)
-	if err != nil {
-		return nil, err
-	}
-	return &CustomClient{
-		internal: internal,
-		endpoint: endpoint,
-	}, nil
-}
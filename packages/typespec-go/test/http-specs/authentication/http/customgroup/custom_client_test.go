@@ -13,7 +13,11 @@ import (
 )
 
 func TestCustomClient_Invalid(t *testing.T) {
-	client, err := customgroup.NewCustomClient("http://localhost:3000", azcore.NewKeyCredential("invalid-key"), nil)
+	client, err := customgroup.NewCustomClientWithSharedAccessKeyCredential("http://localhost:3000", azcore.NewKeyCredential("invalid-key"), &customgroup.CustomClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			InsecureAllowCredentialWithHTTP: true,
+		},
+	})
 	require.NoError(t, err)
 	resp, err := client.Invalid(context.Background(), nil)
 	require.Error(t, err)
@@ -21,7 +25,11 @@ func TestCustomClient_Invalid(t *testing.T) {
 }
 
 func TestCustomClient_Valid(t *testing.T) {
-	client, err := customgroup.NewCustomClient("http://localhost:3000", azcore.NewKeyCredential("valid-key"), nil)
+	client, err := customgroup.NewCustomClientWithSharedAccessKeyCredential("http://localhost:3000", azcore.NewKeyCredential("valid-key"), &customgroup.CustomClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			InsecureAllowCredentialWithHTTP: true,
+		},
+	})
 	require.NoError(t, err)
 	resp, err := client.Valid(context.Background(), nil)
 	require.NoError(t, err)
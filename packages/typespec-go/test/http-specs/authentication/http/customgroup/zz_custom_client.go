@@ -13,7 +13,7 @@ import (
 )
 
 // CustomClient - Illustrates clients generated with generic HTTP auth.
-// Don't use this type directly, use a constructor function instead.
+// Don't use this type directly, use NewCustomClientWithSharedAccessKeyCredential() instead.
 type CustomClient struct {
 	internal *azcore.Client
 	endpoint string
@@ -24,6 +24,32 @@ type CustomClientOptions struct {
 	azcore.ClientOptions
 }
 
+// NewCustomClientWithSharedAccessKeyCredential creates a new instance of CustomClient with the specified values.
+//   - endpoint - Service host
+//   - credential - used to authorize requests with a key.
+//   - options - Contains optional client configuration. Pass nil to accept the default values.
+func NewCustomClientWithSharedAccessKeyCredential(endpoint string, credential *azcore.KeyCredential, options *CustomClientOptions) (*CustomClient, error) {
+	if options == nil {
+		options = &CustomClientOptions{}
+	}
+	cl, err := azcore.NewClient(moduleName, moduleVersion, runtime.PipelineOptions{
+		PerRetry: []policy.Policy{
+			runtime.NewKeyCredentialPolicy(credential, "Authorization", &runtime.KeyCredentialPolicyOptions{
+				InsecureAllowCredentialWithHTTP: options.InsecureAllowCredentialWithHTTP,
+				Prefix:                          "SharedAccessKey ",
+			}),
+		},
+	}, &options.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+	client := &CustomClient{
+		endpoint: endpoint,
+		internal: cl,
+	}
+	return client, nil
+}
+
 // Invalid - Check whether client is authenticated.
 // If the operation fails it returns an *azcore.ResponseError type.
 //   - options - CustomClientInvalidOptions contains the optional parameters for the CustomClient.Invalid method.
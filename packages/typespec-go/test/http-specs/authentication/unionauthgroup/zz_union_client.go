@@ -16,7 +16,7 @@ import (
 )
 
 // UnionClient - Illustrates clients generated with ApiKey and OAuth2 authentication.
-// Don't use this type directly, use NewUnionClient() instead.
+// Don't use this type directly, use a constructor function instead.
 type UnionClient struct {
 	internal *azcore.Client
 	endpoint string
@@ -27,6 +27,31 @@ type UnionClientOptions struct {
 	azcore.ClientOptions
 }
 
+// NewUnionClientWithKeyCredential creates a new instance of UnionClient with the specified values.
+//   - endpoint - Service host
+//   - credential - used to authorize requests with a key.
+//   - options - Contains optional client configuration. Pass nil to accept the default values.
+func NewUnionClientWithKeyCredential(endpoint string, credential *azcore.KeyCredential, options *UnionClientOptions) (*UnionClient, error) {
+	if options == nil {
+		options = &UnionClientOptions{}
+	}
+	cl, err := azcore.NewClient(moduleName, moduleVersion, runtime.PipelineOptions{
+		PerRetry: []policy.Policy{
+			runtime.NewKeyCredentialPolicy(credential, "x-ms-api-key", &runtime.KeyCredentialPolicyOptions{
+				InsecureAllowCredentialWithHTTP: options.InsecureAllowCredentialWithHTTP,
+			}),
+		},
+	}, &options.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+	client := &UnionClient{
+		endpoint: endpoint,
+		internal: cl,
+	}
+	return client, nil
+}
+
 // NewUnionClient creates a new instance of UnionClient with the specified values.
 //   - endpoint - Service host
 //   - credential - used to authorize requests. Usually a credential from azidentity.
@@ -102,6 +102,35 @@ func TestJsonMergePatchClient_UpdateOptionalResource(t *testing.T) {
 	}, resp.Resource)
 }
 
+func TestJsonMergePatchClient_UpdateOptionalResourceClearFields(t *testing.T) {
+	client, err := jmergepatchgroup.NewJSONMergePatchClientWithNoCredential("http://localhost:3000", nil)
+	require.NoError(t, err)
+	inner := &jmergepatchgroup.InnerModel{}
+	inner.ClearDescription()
+	patch := jmergepatchgroup.ResourcePatch{
+		Map: map[string]*jmergepatchgroup.InnerModel{
+			"key": inner,
+		},
+	}
+	patch.ClearDescription()
+	patch.RemoveMapKey("key2")
+	patch.ClearArray()
+	patch.ClearIntValue()
+	patch.ClearFloatValue()
+	patch.ClearInnerModel()
+	patch.ClearIntArray()
+	resp, err := client.UpdateOptionalResource(context.Background(), patch, nil)
+	require.NoError(t, err)
+	require.Equal(t, jmergepatchgroup.Resource{
+		Name: to.Ptr("Madge"),
+		Map: map[string]*jmergepatchgroup.InnerModel{
+			"key": {
+				Name: to.Ptr("InnerMadge"),
+			},
+		},
+	}, resp.Resource)
+}
+
 func TestJsonMergePatchClient_UpdateResource(t *testing.T) {
 	client, err := jmergepatchgroup.NewJSONMergePatchClientWithNoCredential("http://localhost:3000", nil)
 	require.NoError(t, err)
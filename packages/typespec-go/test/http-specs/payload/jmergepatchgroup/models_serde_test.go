@@ -0,0 +1,138 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package jmergepatchgroup_test
+
+import (
+	"encoding/json"
+	"jmergepatchgroup"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcePatch_MarshalJSON(t *testing.T) {
+	var patch jmergepatchgroup.ResourcePatch
+	data, err := json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(data))
+
+	patch.SetDescription("desc")
+	patch.ClearIntValue()
+	patch.ClearArray()
+	patch.RemoveMapKey("key2")
+	inner := jmergepatchgroup.InnerModel{}
+	inner.ClearDescription()
+	patch.SetInnerModel(inner)
+	data, err = json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"description":"desc","intValue":null,"array":null,"map":{"key2":null},"innerModel":{"description":null}}`, string(data))
+
+	// setting a cleared field sends the new value instead of null
+	patch.SetIntValue(2)
+	data, err = json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"description":"desc","intValue":2,"array":null,"map":{"key2":null},"innerModel":{"description":null}}`, string(data))
+}
+
+func TestResourcePatch_MarshalJSONNullValue(t *testing.T) {
+	// azcore.NullValue sends the same body as the Clear* and RemoveMapKey methods
+	patch := jmergepatchgroup.ResourcePatch{
+		Description: azcore.NullValue[*string](),
+		Map: map[string]*jmergepatchgroup.InnerModel{
+			"key": {
+				Description: azcore.NullValue[*string](),
+			},
+			"key2": nil,
+		},
+		Array:      azcore.NullValue[[]*jmergepatchgroup.InnerModel](),
+		IntValue:   azcore.NullValue[*int32](),
+		FloatValue: azcore.NullValue[*float32](),
+		InnerModel: azcore.NullValue[*jmergepatchgroup.InnerModel](),
+		IntArray:   azcore.NullValue[[]*int32](),
+	}
+	nullValueData, err := json.Marshal(patch)
+	require.NoError(t, err)
+
+	inner := &jmergepatchgroup.InnerModel{}
+	inner.ClearDescription()
+	patch = jmergepatchgroup.ResourcePatch{
+		Map: map[string]*jmergepatchgroup.InnerModel{
+			"key": inner,
+		},
+	}
+	patch.ClearDescription()
+	patch.RemoveMapKey("key2")
+	patch.ClearArray()
+	patch.ClearIntValue()
+	patch.ClearFloatValue()
+	patch.ClearInnerModel()
+	patch.ClearIntArray()
+	clearedData, err := json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, string(nullValueData), string(clearedData))
+	require.JSONEq(t, `{"description":null,"map":{"key":{"description":null},"key2":null},"array":null,"intValue":null,"floatValue":null,"innerModel":null,"intArray":null}`, string(clearedData))
+}
+
+func TestResourcePatch_UnmarshalJSON(t *testing.T) {
+	const body = `{"description":null,"map":{"key":{"name":"InnerMadge","description":null},"key2":null},"intArray":[1,2]}`
+	var patch jmergepatchgroup.ResourcePatch
+	require.NoError(t, json.Unmarshal([]byte(body), &patch))
+	require.Nil(t, patch.Description)
+	require.Equal(t, "InnerMadge", *patch.Map["key"].Name)
+	data, err := json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, body, string(data))
+}
+
+func TestNewMergePatch(t *testing.T) {
+	original := jmergepatchgroup.Resource{
+		Name:        to.Ptr("Madge"),
+		Description: to.Ptr("desc"),
+		IntValue:    to.Ptr[int32](1),
+		IntArray:    []*int32{to.Ptr[int32](1), to.Ptr[int32](2)},
+		InnerModel: &jmergepatchgroup.InnerModel{
+			Name:        to.Ptr("InnerMadge"),
+			Description: to.Ptr("innerDesc"),
+		},
+		Map: map[string]*jmergepatchgroup.InnerModel{
+			"key":  {Name: to.Ptr("InnerMadge")},
+			"key2": {Name: to.Ptr("InnerMadge2")},
+		},
+	}
+	modified := jmergepatchgroup.Resource{
+		Name:     to.Ptr("Madge"),
+		IntValue: to.Ptr[int32](2),
+		IntArray: []*int32{to.Ptr[int32](1)},
+		InnerModel: &jmergepatchgroup.InnerModel{
+			Name: to.Ptr("InnerMadge"),
+		},
+		Map: map[string]*jmergepatchgroup.InnerModel{
+			"key": {Name: to.Ptr("InnerMadge"), Description: to.Ptr("new")},
+		},
+		FloatValue: to.Ptr[float32](1.25),
+	}
+	patch, err := jmergepatchgroup.NewMergePatch[jmergepatchgroup.ResourcePatch](original, modified)
+	require.NoError(t, err)
+	require.Nil(t, patch.Description)
+	require.EqualValues(t, 2, *patch.IntValue)
+	data, err := json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"description": null,
+		"intValue": 2,
+		"intArray": [1],
+		"innerModel": {"description": null},
+		"map": {"key": {"description": "new"}, "key2": null},
+		"floatValue": 1.25
+	}`, string(data))
+
+	// identical models produce an empty patch
+	patch, err = jmergepatchgroup.NewMergePatch[jmergepatchgroup.ResourcePatch](original, original)
+	require.NoError(t, err)
+	data, err = json.Marshal(patch)
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(data))
+}
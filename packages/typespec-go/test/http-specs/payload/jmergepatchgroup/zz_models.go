@@ -8,6 +8,33 @@ package jmergepatchgroup
 type InnerModel struct {
 	Description *string
 	Name        *string
+
+	// the serialized names of the fields that are sent as null in a JSON merge patch
+	clearedFields map[string]bool
+}
+
+// ClearDescription sets Description to nil and sends null for it in a JSON merge patch.
+func (i *InnerModel) ClearDescription() {
+	i.Description = nil
+	i.clearedFields = clearMergePatchField(i.clearedFields, "description")
+}
+
+// ClearName sets Name to nil and sends null for it in a JSON merge patch.
+func (i *InnerModel) ClearName() {
+	i.Name = nil
+	i.clearedFields = clearMergePatchField(i.clearedFields, "name")
+}
+
+// SetDescription sets Description and sends it in a JSON merge patch.
+func (i *InnerModel) SetDescription(v string) {
+	i.Description = &v
+	delete(i.clearedFields, "description")
+}
+
+// SetName sets Name and sends it in a JSON merge patch.
+func (i *InnerModel) SetName(v string) {
+	i.Name = &v
+	delete(i.clearedFields, "name")
 }
 
 // Resource - Details about a resource.
@@ -32,4 +59,99 @@ type ResourcePatch struct {
 	IntArray    []*int32
 	IntValue    *int32
 	Map         map[string]*InnerModel
+
+	// the serialized names of the fields that are sent as null in a JSON merge patch
+	clearedFields map[string]bool
+}
+
+// ClearArray sets Array to nil and sends null for it in a JSON merge patch.
+func (r *ResourcePatch) ClearArray() {
+	r.Array = nil
+	r.clearedFields = clearMergePatchField(r.clearedFields, "array")
+}
+
+// ClearDescription sets Description to nil and sends null for it in a JSON merge patch.
+func (r *ResourcePatch) ClearDescription() {
+	r.Description = nil
+	r.clearedFields = clearMergePatchField(r.clearedFields, "description")
+}
+
+// ClearFloatValue sets FloatValue to nil and sends null for it in a JSON merge patch.
+func (r *ResourcePatch) ClearFloatValue() {
+	r.FloatValue = nil
+	r.clearedFields = clearMergePatchField(r.clearedFields, "floatValue")
+}
+
+// ClearInnerModel sets InnerModel to nil and sends null for it in a JSON merge patch.
+func (r *ResourcePatch) ClearInnerModel() {
+	r.InnerModel = nil
+	r.clearedFields = clearMergePatchField(r.clearedFields, "innerModel")
+}
+
+// ClearIntArray sets IntArray to nil and sends null for it in a JSON merge patch.
+func (r *ResourcePatch) ClearIntArray() {
+	r.IntArray = nil
+	r.clearedFields = clearMergePatchField(r.clearedFields, "intArray")
+}
+
+// ClearIntValue sets IntValue to nil and sends null for it in a JSON merge patch.
+func (r *ResourcePatch) ClearIntValue() {
+	r.IntValue = nil
+	r.clearedFields = clearMergePatchField(r.clearedFields, "intValue")
+}
+
+// ClearMap sets Map to nil and sends null for it in a JSON merge patch.
+func (r *ResourcePatch) ClearMap() {
+	r.Map = nil
+	r.clearedFields = clearMergePatchField(r.clearedFields, "map")
+}
+
+// RemoveMapKey sends null for key in Map in a JSON merge patch, which removes the key.
+func (r *ResourcePatch) RemoveMapKey(key string) {
+	if r.Map == nil {
+		r.Map = map[string]*InnerModel{}
+	}
+	r.Map[key] = nil
+}
+
+// SetArray sets Array and sends it in a JSON merge patch.
+func (r *ResourcePatch) SetArray(v []*InnerModel) {
+	r.Array = v
+	delete(r.clearedFields, "array")
+}
+
+// SetDescription sets Description and sends it in a JSON merge patch.
+func (r *ResourcePatch) SetDescription(v string) {
+	r.Description = &v
+	delete(r.clearedFields, "description")
+}
+
+// SetFloatValue sets FloatValue and sends it in a JSON merge patch.
+func (r *ResourcePatch) SetFloatValue(v float32) {
+	r.FloatValue = &v
+	delete(r.clearedFields, "floatValue")
+}
+
+// SetInnerModel sets InnerModel and sends it in a JSON merge patch.
+func (r *ResourcePatch) SetInnerModel(v InnerModel) {
+	r.InnerModel = &v
+	delete(r.clearedFields, "innerModel")
+}
+
+// SetIntArray sets IntArray and sends it in a JSON merge patch.
+func (r *ResourcePatch) SetIntArray(v []*int32) {
+	r.IntArray = v
+	delete(r.clearedFields, "intArray")
+}
+
+// SetIntValue sets IntValue and sends it in a JSON merge patch.
+func (r *ResourcePatch) SetIntValue(v int32) {
+	r.IntValue = &v
+	delete(r.clearedFields, "intValue")
+}
+
+// SetMap sets Map and sends it in a JSON merge patch.
+func (r *ResourcePatch) SetMap(v map[string]*InnerModel) {
+	r.Map = v
+	delete(r.clearedFields, "map")
 }
@@ -15,7 +15,13 @@ import (
 func (i InnerModel) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "description", i.Description)
+	if i.Description == nil && i.clearedFields["description"] {
+		objectMap["description"] = nil
+	}
 	populate(objectMap, "name", i.Name)
+	if i.Name == nil && i.clearedFields["name"] {
+		objectMap["name"] = nil
+	}
 	return json.Marshal(objectMap)
 }
 
@@ -27,6 +33,9 @@ func (i *InnerModel) UnmarshalJSON(data []byte) error {
 	}
 	for key, val := range rawMsg {
 		var err error
+		if string(val) == "null" {
+			i.clearedFields = clearMergePatchField(i.clearedFields, key)
+		}
 		switch key {
 		case "description":
 			err = unpopulate(val, "Description", &i.Description)
@@ -101,12 +110,33 @@ func (r *Resource) UnmarshalJSON(data []byte) error {
 func (r ResourcePatch) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "array", r.Array)
+	if r.Array == nil && r.clearedFields["array"] {
+		objectMap["array"] = nil
+	}
 	populate(objectMap, "description", r.Description)
+	if r.Description == nil && r.clearedFields["description"] {
+		objectMap["description"] = nil
+	}
 	populate(objectMap, "floatValue", r.FloatValue)
+	if r.FloatValue == nil && r.clearedFields["floatValue"] {
+		objectMap["floatValue"] = nil
+	}
 	populate(objectMap, "innerModel", r.InnerModel)
+	if r.InnerModel == nil && r.clearedFields["innerModel"] {
+		objectMap["innerModel"] = nil
+	}
 	populate(objectMap, "intArray", r.IntArray)
+	if r.IntArray == nil && r.clearedFields["intArray"] {
+		objectMap["intArray"] = nil
+	}
 	populate(objectMap, "intValue", r.IntValue)
+	if r.IntValue == nil && r.clearedFields["intValue"] {
+		objectMap["intValue"] = nil
+	}
 	populate(objectMap, "map", r.Map)
+	if r.Map == nil && r.clearedFields["map"] {
+		objectMap["map"] = nil
+	}
 	return json.Marshal(objectMap)
 }
 
@@ -118,6 +148,9 @@ func (r *ResourcePatch) UnmarshalJSON(data []byte) error {
 	}
 	for key, val := range rawMsg {
 		var err error
+		if string(val) == "null" {
+			r.clearedFields = clearMergePatchField(r.clearedFields, key)
+		}
 		switch key {
 		case "array":
 			err = unpopulate(val, "Array", &r.Array)
@@ -167,3 +200,73 @@ func unpopulate(data json.RawMessage, fn string, v any) error {
 	}
 	return nil
 }
+
+// NewMergePatch returns the JSON merge patch (RFC 7396) that transforms original into modified.
+// Fields that are absent from modified are cleared, nested objects and maps are compared member
+// by member, and all other values that differ, including arrays, are replaced.
+// T is the model sent as the patch, e.g. the model for the PATCH request body.
+func NewMergePatch[T any](original, modified any) (T, error) {
+	var patch T
+	originalObj, err := toMergePatchObject(original)
+	if err != nil {
+		return patch, err
+	}
+	modifiedObj, err := toMergePatchObject(modified)
+	if err != nil {
+		return patch, err
+	}
+	data, err := json.Marshal(diffMergePatch(originalObj, modifiedObj))
+	if err != nil {
+		return patch, err
+	}
+	err = json.Unmarshal(data, &patch)
+	return patch, err
+}
+
+func toMergePatchObject(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func diffMergePatch(original, modified map[string]any) map[string]any {
+	patch := map[string]any{}
+	for k, ov := range original {
+		mv, ok := modified[k]
+		if !ok || mv == nil {
+			if ov != nil {
+				patch[k] = nil
+			}
+			continue
+		}
+		om, oIsObj := ov.(map[string]any)
+		mm, mIsObj := mv.(map[string]any)
+		if oIsObj && mIsObj {
+			if diff := diffMergePatch(om, mm); len(diff) > 0 {
+				patch[k] = diff
+			}
+		} else if !reflect.DeepEqual(ov, mv) {
+			patch[k] = mv
+		}
+	}
+	for k, mv := range modified {
+		if _, ok := original[k]; !ok && mv != nil {
+			patch[k] = mv
+		}
+	}
+	return patch
+}
+
+func clearMergePatchField(cleared map[string]bool, k string) map[string]bool {
+	if cleared == nil {
+		cleared = map[string]bool{}
+	}
+	cleared[k] = true
+	return cleared
+}
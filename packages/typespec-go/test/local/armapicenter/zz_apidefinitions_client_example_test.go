@@ -6,20 +6,52 @@ package armapicenter_test
 
 import (
 	"armapicenter"
+	"armapicenter/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-03-15-preview/ApiDefinitions_CreateOrUpdate.json
 func ExampleAPIDefinitionsClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIDefinitionsServer: fake.APIDefinitionsServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, definitionName string, payload armapicenter.APIDefinition, options *armapicenter.APIDefinitionsClientCreateOrUpdateOptions) (resp azfake.Responder[armapicenter.APIDefinitionsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.APIDefinitionsClientCreateOrUpdateResponse{
+							APIDefinition: armapicenter.APIDefinition{
+								Type: to.Ptr("Microsoft.ApiCenter/services/apis/versions/definitions"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
+								Name: to.Ptr("openapi"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.APIDefinitionProperties{
+									Title:       to.Ptr("OpenAPI"),
+									Description: to.Ptr("Default spec"),
+									Specification: &armapicenter.APIDefinitionPropertiesSpecification{
+										Name:    to.Ptr("openapi"),
+										Version: to.Ptr("3.0.6"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -32,38 +64,31 @@ func ExampleAPIDefinitionsClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIDefinitionsClientCreateOrUpdateResponse{
-	// 	APIDefinition: armapicenter.APIDefinition{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/apis/versions/definitions"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
-	// 		Name: to.Ptr("openapi"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.APIDefinitionProperties{
-	// 			Title: to.Ptr("OpenAPI"),
-	// 			Description: to.Ptr("Default spec"),
-	// 			Specification: &armapicenter.APIDefinitionPropertiesSpecification{
-	// 				Name: to.Ptr("openapi"),
-	// 				Version: to.Ptr("3.0.6"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/apis/versions/definitions
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi
+	// Name: openapi
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiDefinitions_Delete.json
 func ExampleAPIDefinitionsClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIDefinitionsServer: fake.APIDefinitionsServer{
+					Delete: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, definitionName string, options *armapicenter.APIDefinitionsClientDeleteOptions) (resp azfake.Responder[armapicenter.APIDefinitionsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.APIDefinitionsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -73,19 +98,30 @@ func ExampleAPIDefinitionsClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIDefinitionsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiDefinitions_ExportSpecification.json
 func ExampleAPIDefinitionsClient_BeginExportSpecification() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIDefinitionsServer: fake.APIDefinitionsServer{
+					BeginExportSpecification: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, definitionName string, options *armapicenter.APIDefinitionsClientBeginExportSpecificationOptions) (resp azfake.PollerResponder[armapicenter.APIDefinitionsClientExportSpecificationResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armapicenter.APIDefinitionsClientExportSpecificationResponse{
+							APISpecExportResult: armapicenter.APISpecExportResult{
+								Format: to.Ptr(armapicenter.APISpecExportResultFormatInline),
+								Value:  to.Ptr("{ ... }"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -97,25 +133,47 @@ func ExampleAPIDefinitionsClient_BeginExportSpecification() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIDefinitionsClientExportSpecificationResponse{
-	// 	APISpecExportResult: armapicenter.APISpecExportResult{
-	// 		Format: to.Ptr(armapicenter.APISpecExportResultFormatInline),
-	// 		Value: to.Ptr("{ ... }"),
-	// 	},
-	// }
+	fmt.Println("Format:", *res.Format)
+	fmt.Println("Value:", *res.Value)
+	// Output:
+	// Format: inline
+	// Value: { ... }
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiDefinitions_Get.json
 func ExampleAPIDefinitionsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIDefinitionsServer: fake.APIDefinitionsServer{
+					Get: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, definitionName string, options *armapicenter.APIDefinitionsClientGetOptions) (resp azfake.Responder[armapicenter.APIDefinitionsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.APIDefinitionsClientGetResponse{
+							APIDefinition: armapicenter.APIDefinition{
+								Type: to.Ptr("Microsoft.ApiCenter/services/apis/versions/definitions"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
+								Name: to.Ptr("openapi"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.APIDefinitionProperties{
+									Title:       to.Ptr("OpenAPI"),
+									Description: to.Ptr("Default spec"),
+									Specification: &armapicenter.APIDefinitionPropertiesSpecification{
+										Name:    to.Ptr("openapi"),
+										Version: to.Ptr("3.0.6"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -123,38 +181,31 @@ func ExampleAPIDefinitionsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIDefinitionsClientGetResponse{
-	// 	APIDefinition: armapicenter.APIDefinition{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/apis/versions/definitions"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
-	// 		Name: to.Ptr("openapi"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.APIDefinitionProperties{
-	// 			Title: to.Ptr("OpenAPI"),
-	// 			Description: to.Ptr("Default spec"),
-	// 			Specification: &armapicenter.APIDefinitionPropertiesSpecification{
-	// 				Name: to.Ptr("openapi"),
-	// 				Version: to.Ptr("3.0.6"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/apis/versions/definitions
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi
+	// Name: openapi
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiDefinitions_Head.json
 func ExampleAPIDefinitionsClient_Head() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIDefinitionsServer: fake.APIDefinitionsServer{
+					Head: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, definitionName string, options *armapicenter.APIDefinitionsClientHeadOptions) (resp azfake.Responder[armapicenter.APIDefinitionsClientHeadResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.APIDefinitionsClientHeadResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -164,19 +215,25 @@ func ExampleAPIDefinitionsClient_Head() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIDefinitionsClientHeadResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiDefinitions_ImportSpecification.json
 func ExampleAPIDefinitionsClient_BeginImportSpecification() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIDefinitionsServer: fake.APIDefinitionsServer{
+					BeginImportSpecification: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, definitionName string, payload armapicenter.APISpecImportRequest, options *armapicenter.APIDefinitionsClientBeginImportSpecificationOptions) (resp azfake.PollerResponder[armapicenter.APIDefinitionsClientImportSpecificationResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armapicenter.APIDefinitionsClientImportSpecificationResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -197,19 +254,47 @@ func ExampleAPIDefinitionsClient_BeginImportSpecification() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIDefinitionsClientImportSpecificationResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiDefinitions_List.json
 func ExampleAPIDefinitionsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIDefinitionsServer: fake.APIDefinitionsServer{
+					NewListPager: func(resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, options *armapicenter.APIDefinitionsClientListOptions) (resp azfake.PagerResponder[armapicenter.APIDefinitionsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.APIDefinitionsClientListResponse{
+							APIDefinitionListResult: armapicenter.APIDefinitionListResult{
+								Value: []*armapicenter.APIDefinition{
+									{
+										Type: to.Ptr("Microsoft.ApiCenter/services/apis/versions/definitions"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
+										Name: to.Ptr("openapi"),
+										SystemData: &armapicenter.SystemData{
+											CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+											LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+										},
+										Properties: &armapicenter.APIDefinitionProperties{
+											Title:       to.Ptr("OpenAPI"),
+											Description: to.Ptr("Default spec"),
+											Specification: &armapicenter.APIDefinitionPropertiesSpecification{
+												Name:    to.Ptr("openapi"),
+												Version: to.Ptr("3.0.6"),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -220,32 +305,13 @@ func ExampleAPIDefinitionsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.APIDefinitionsClientListResponse{
-		// 	APIDefinitionListResult: armapicenter.APIDefinitionListResult{
-		// 		Value: []*armapicenter.APIDefinition{
-		// 			{
-		// 				Type: to.Ptr("Microsoft.ApiCenter/services/apis/versions/definitions"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
-		// 				Name: to.Ptr("openapi"),
-		// 				SystemData: &armapicenter.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-		// 				},
-		// 				Properties: &armapicenter.APIDefinitionProperties{
-		// 					Title: to.Ptr("OpenAPI"),
-		// 					Description: to.Ptr("Default spec"),
-		// 					Specification: &armapicenter.APIDefinitionPropertiesSpecification{
-		// 						Name: to.Ptr("openapi"),
-		// 						Version: to.Ptr("3.0.6"),
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Type: Microsoft.ApiCenter/services/apis/versions/definitions
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi
+	// Name: openapi
 }
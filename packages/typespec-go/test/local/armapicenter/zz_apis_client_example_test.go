@@ -6,20 +6,63 @@ package armapicenter_test
 
 import (
 	"armapicenter"
+	"armapicenter/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-03-15-preview/Apis_CreateOrUpdate.json
 func ExampleApisClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ApisServer: fake.ApisServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, payload armapicenter.API, options *armapicenter.ApisClientCreateOrUpdateOptions) (resp azfake.Responder[armapicenter.ApisClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.ApisClientCreateOrUpdateResponse{
+							API: armapicenter.API{
+								Type: to.Ptr("Microsoft.ApiCenter/services/apis"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api"),
+								Name: to.Ptr("echo-api"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.APIProperties{
+									Title:          to.Ptr("Echo API"),
+									Description:    to.Ptr("A simple HTTP request/response service."),
+									LifecycleStage: to.Ptr(armapicenter.LifecycleStageDesign),
+									Kind:           to.Ptr(armapicenter.APIKindRest),
+									TermsOfService: &armapicenter.TermsOfService{
+										URL: to.Ptr("https://contoso.com/terms-of-service"),
+									},
+									License: &armapicenter.License{
+										URL: to.Ptr("https://contoso.com/license"),
+									},
+									ExternalDocumentation: []*armapicenter.ExternalDocumentation{
+										{
+											Title: to.Ptr("Onboarding docs"),
+											URL:   to.Ptr("https://docs.contoso.com"),
+										},
+									},
+									CustomProperties: &armapicenter.CustomProperties{},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -47,50 +90,31 @@ func ExampleApisClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.ApisClientCreateOrUpdateResponse{
-	// 	API: armapicenter.API{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/apis"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api"),
-	// 		Name: to.Ptr("echo-api"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.APIProperties{
-	// 			Title: to.Ptr("Echo API"),
-	// 			Description: to.Ptr("A simple HTTP request/response service."),
-	// 			LifecycleStage: to.Ptr(armapicenter.LifecycleStageDesign),
-	// 			Kind: to.Ptr(armapicenter.APIKindRest),
-	// 			TermsOfService: &armapicenter.TermsOfService{
-	// 				URL: to.Ptr("https://contoso.com/terms-of-service"),
-	// 			},
-	// 			License: &armapicenter.License{
-	// 				URL: to.Ptr("https://contoso.com/license"),
-	// 			},
-	// 			ExternalDocumentation: []*armapicenter.ExternalDocumentation{
-	// 				{
-	// 					Title: to.Ptr("Onboarding docs"),
-	// 					URL: to.Ptr("https://docs.contoso.com"),
-	// 				},
-	// 			},
-	// 			CustomProperties: &armapicenter.CustomProperties{
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/apis
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api
+	// Name: echo-api
 }
 
 // Generated from example definition: 2024-03-15-preview/Apis_Delete.json
 func ExampleApisClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ApisServer: fake.ApisServer{
+					Delete: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, options *armapicenter.ApisClientDeleteOptions) (resp azfake.Responder[armapicenter.ApisClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.ApisClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -100,19 +124,54 @@ func ExampleApisClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.ApisClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/Apis_Get.json
 func ExampleApisClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ApisServer: fake.ApisServer{
+					Get: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, options *armapicenter.ApisClientGetOptions) (resp azfake.Responder[armapicenter.ApisClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.ApisClientGetResponse{
+							API: armapicenter.API{
+								Type: to.Ptr("Microsoft.ApiCenter/services/apis"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api"),
+								Name: to.Ptr("public"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.APIProperties{
+									Title:          to.Ptr("Echo API"),
+									Description:    to.Ptr("A simple HTTP request/response service."),
+									LifecycleStage: to.Ptr(armapicenter.LifecycleStageDesign),
+									Kind:           to.Ptr(armapicenter.APIKindRest),
+									TermsOfService: &armapicenter.TermsOfService{
+										URL: to.Ptr("https://contoso.com/terms-of-service"),
+									},
+									License: &armapicenter.License{
+										URL: to.Ptr("https://contoso.com/license"),
+									},
+									ExternalDocumentation: []*armapicenter.ExternalDocumentation{
+										{
+											Title: to.Ptr("Onboarding docs"),
+											URL:   to.Ptr("https://docs.contoso.com"),
+										},
+									},
+									CustomProperties: &armapicenter.CustomProperties{},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -120,50 +179,31 @@ func ExampleApisClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.ApisClientGetResponse{
-	// 	API: armapicenter.API{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/apis"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api"),
-	// 		Name: to.Ptr("public"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.APIProperties{
-	// 			Title: to.Ptr("Echo API"),
-	// 			Description: to.Ptr("A simple HTTP request/response service."),
-	// 			LifecycleStage: to.Ptr(armapicenter.LifecycleStageDesign),
-	// 			Kind: to.Ptr(armapicenter.APIKindRest),
-	// 			TermsOfService: &armapicenter.TermsOfService{
-	// 				URL: to.Ptr("https://contoso.com/terms-of-service"),
-	// 			},
-	// 			License: &armapicenter.License{
-	// 				URL: to.Ptr("https://contoso.com/license"),
-	// 			},
-	// 			ExternalDocumentation: []*armapicenter.ExternalDocumentation{
-	// 				{
-	// 					Title: to.Ptr("Onboarding docs"),
-	// 					URL: to.Ptr("https://docs.contoso.com"),
-	// 				},
-	// 			},
-	// 			CustomProperties: &armapicenter.CustomProperties{
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/apis
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api
+	// Name: public
 }
 
 // Generated from example definition: 2024-03-15-preview/Apis_Head.json
 func ExampleApisClient_Head() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ApisServer: fake.ApisServer{
+					Head: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, options *armapicenter.ApisClientHeadOptions) (resp azfake.Responder[armapicenter.ApisClientHeadResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.ApisClientHeadResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -173,19 +213,58 @@ func ExampleApisClient_Head() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.ApisClientHeadResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/Apis_List.json
 func ExampleApisClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ApisServer: fake.ApisServer{
+					NewListPager: func(resourceGroupName string, serviceName string, workspaceName string, options *armapicenter.ApisClientListOptions) (resp azfake.PagerResponder[armapicenter.ApisClientListResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.ApisClientListResponse{
+							APIListResult: armapicenter.APIListResult{
+								Value: []*armapicenter.API{
+									{
+										Type: to.Ptr("Microsoft.ApiCenter/services/environments"),
+										ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api"),
+										Name: to.Ptr("echo-api"),
+										SystemData: &armapicenter.SystemData{
+											CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+											LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+										},
+										Properties: &armapicenter.APIProperties{
+											Title:          to.Ptr("Echo API"),
+											Description:    to.Ptr("A simple HTTP request/response service."),
+											LifecycleStage: to.Ptr(armapicenter.LifecycleStageDesign),
+											Kind:           to.Ptr(armapicenter.APIKindRest),
+											TermsOfService: &armapicenter.TermsOfService{
+												URL: to.Ptr("https://contoso.com/terms-of-service"),
+											},
+											License: &armapicenter.License{
+												URL: to.Ptr("https://contoso.com/license"),
+											},
+											ExternalDocumentation: []*armapicenter.ExternalDocumentation{
+												{
+													Title: to.Ptr("Onboarding docs"),
+													URL:   to.Ptr("https://docs.contoso.com"),
+												},
+											},
+											CustomProperties: &armapicenter.CustomProperties{},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -196,44 +275,13 @@ func ExampleApisClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.ApisClientListResponse{
-		// 	APIListResult: armapicenter.APIListResult{
-		// 		Value: []*armapicenter.API{
-		// 			{
-		// 				Type: to.Ptr("Microsoft.ApiCenter/services/environments"),
-		// 				ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api"),
-		// 				Name: to.Ptr("echo-api"),
-		// 				SystemData: &armapicenter.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-		// 				},
-		// 				Properties: &armapicenter.APIProperties{
-		// 					Title: to.Ptr("Echo API"),
-		// 					Description: to.Ptr("A simple HTTP request/response service."),
-		// 					LifecycleStage: to.Ptr(armapicenter.LifecycleStageDesign),
-		// 					Kind: to.Ptr(armapicenter.APIKindRest),
-		// 					TermsOfService: &armapicenter.TermsOfService{
-		// 						URL: to.Ptr("https://contoso.com/terms-of-service"),
-		// 					},
-		// 					License: &armapicenter.License{
-		// 						URL: to.Ptr("https://contoso.com/license"),
-		// 					},
-		// 					ExternalDocumentation: []*armapicenter.ExternalDocumentation{
-		// 						{
-		// 							Title: to.Ptr("Onboarding docs"),
-		// 							URL: to.Ptr("https://docs.contoso.com"),
-		// 						},
-		// 					},
-		// 					CustomProperties: &armapicenter.CustomProperties{
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Type: Microsoft.ApiCenter/services/environments
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api
+	// Name: echo-api
 }
@@ -6,20 +6,48 @@ package armapicenter_test
 
 import (
 	"armapicenter"
+	"armapicenter/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-03-15-preview/ApiVersions_CreateOrUpdate.json
 func ExampleAPIVersionsClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer: fake.APIVersionsServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, payload armapicenter.APIVersion, options *armapicenter.APIVersionsClientCreateOrUpdateOptions) (resp azfake.Responder[armapicenter.APIVersionsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.APIVersionsClientCreateOrUpdateResponse{
+							APIVersion: armapicenter.APIVersion{
+								Type: to.Ptr("Microsoft.ApiCenter/services/workspaces/apis/versions"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01"),
+								Name: to.Ptr("2023-01-01"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.APIVersionProperties{
+									Title:          to.Ptr("2023-01-01"),
+									LifecycleStage: to.Ptr(armapicenter.LifecycleStageProduction),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -32,34 +60,31 @@ func ExampleAPIVersionsClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIVersionsClientCreateOrUpdateResponse{
-	// 	APIVersion: armapicenter.APIVersion{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/workspaces/apis/versions"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01"),
-	// 		Name: to.Ptr("2023-01-01"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.APIVersionProperties{
-	// 			Title: to.Ptr("2023-01-01"),
-	// 			LifecycleStage: to.Ptr(armapicenter.LifecycleStageProduction),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/workspaces/apis/versions
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01
+	// Name: 2023-01-01
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiVersions_Delete.json
 func ExampleAPIVersionsClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer: fake.APIVersionsServer{
+					Delete: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, options *armapicenter.APIVersionsClientDeleteOptions) (resp azfake.Responder[armapicenter.APIVersionsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.APIVersionsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -69,19 +94,39 @@ func ExampleAPIVersionsClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIVersionsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiVersions_Get.json
 func ExampleAPIVersionsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer: fake.APIVersionsServer{
+					Get: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, options *armapicenter.APIVersionsClientGetOptions) (resp azfake.Responder[armapicenter.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.APIVersionsClientGetResponse{
+							APIVersion: armapicenter.APIVersion{
+								Type: to.Ptr("Microsoft.ApiCenter/services/workspaces/apis/versions"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01"),
+								Name: to.Ptr("2023-01-01"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.APIVersionProperties{
+									Title:          to.Ptr("2023-01-01"),
+									LifecycleStage: to.Ptr(armapicenter.LifecycleStageProduction),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -89,34 +134,31 @@ func ExampleAPIVersionsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIVersionsClientGetResponse{
-	// 	APIVersion: armapicenter.APIVersion{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/workspaces/apis/versions"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01"),
-	// 		Name: to.Ptr("2023-01-01"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.APIVersionProperties{
-	// 			Title: to.Ptr("2023-01-01"),
-	// 			LifecycleStage: to.Ptr(armapicenter.LifecycleStageProduction),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/workspaces/apis/versions
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01
+	// Name: 2023-01-01
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiVersions_Head.json
 func ExampleAPIVersionsClient_Head() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer: fake.APIVersionsServer{
+					Head: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, versionName string, options *armapicenter.APIVersionsClientHeadOptions) (resp azfake.Responder[armapicenter.APIVersionsClientHeadResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.APIVersionsClientHeadResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -126,19 +168,43 @@ func ExampleAPIVersionsClient_Head() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.APIVersionsClientHeadResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/ApiVersions_List.json
 func ExampleAPIVersionsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer: fake.APIVersionsServer{
+					NewListPager: func(resourceGroupName string, serviceName string, workspaceName string, apiName string, options *armapicenter.APIVersionsClientListOptions) (resp azfake.PagerResponder[armapicenter.APIVersionsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.APIVersionsClientListResponse{
+							APIVersionListResult: armapicenter.APIVersionListResult{
+								Value: []*armapicenter.APIVersion{
+									{
+										Type: to.Ptr("Microsoft.ApiCenter/services/environments"),
+										ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01"),
+										Name: to.Ptr("public"),
+										SystemData: &armapicenter.SystemData{
+											CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+											LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+										},
+										Properties: &armapicenter.APIVersionProperties{
+											Title:          to.Ptr("2023-01-01"),
+											LifecycleStage: to.Ptr(armapicenter.LifecycleStageProduction),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -149,28 +215,13 @@ func ExampleAPIVersionsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.APIVersionsClientListResponse{
-		// 	APIVersionListResult: armapicenter.APIVersionListResult{
-		// 		Value: []*armapicenter.APIVersion{
-		// 			{
-		// 				Type: to.Ptr("Microsoft.ApiCenter/services/environments"),
-		// 				ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01"),
-		// 				Name: to.Ptr("public"),
-		// 				SystemData: &armapicenter.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-		// 				},
-		// 				Properties: &armapicenter.APIVersionProperties{
-		// 					Title: to.Ptr("2023-01-01"),
-		// 					LifecycleStage: to.Ptr(armapicenter.LifecycleStageProduction),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Type: Microsoft.ApiCenter/services/environments
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/apis/echo-api/versions/2023-01-01
+	// Name: public
 }
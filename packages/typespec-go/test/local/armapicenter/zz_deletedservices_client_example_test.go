@@ -6,19 +6,34 @@ package armapicenter_test
 
 import (
 	"armapicenter"
+	"armapicenter/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-03-15-preview/DeletedServices_Delete.json
 func ExampleDeletedServicesClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeletedServicesServer: fake.DeletedServicesServer{
+					Delete: func(ctx context.Context, resourceGroupName string, deletedServiceName string, options *armapicenter.DeletedServicesClientDeleteOptions) (resp azfake.Responder[armapicenter.DeletedServicesClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.DeletedServicesClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -28,19 +43,35 @@ func ExampleDeletedServicesClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.DeletedServicesClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/DeletedServices_Get.json
 func ExampleDeletedServicesClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeletedServicesServer: fake.DeletedServicesServer{
+					Get: func(ctx context.Context, resourceGroupName string, deletedServiceName string, options *armapicenter.DeletedServicesClientGetOptions) (resp azfake.Responder[armapicenter.DeletedServicesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.DeletedServicesClientGetResponse{
+							DeletedService: armapicenter.DeletedService{
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
+								Name: to.Ptr("contoso"),
+								Type: to.Ptr("Microsoft.ApiCenter/deletedServices"),
+								Properties: &armapicenter.DeletedServiceProperties{
+									SoftDeletionDate:   to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-01T22:15:58.348Z"); return t }()),
+									ScheduledPurgeDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-05-01T22:15:58.348Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -48,30 +79,45 @@ func ExampleDeletedServicesClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.DeletedServicesClientGetResponse{
-	// 	DeletedService: armapicenter.DeletedService{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
-	// 		Name: to.Ptr("contoso"),
-	// 		Type: to.Ptr("Microsoft.ApiCenter/deletedServices"),
-	// 		Properties: &armapicenter.DeletedServiceProperties{
-	// 			SoftDeletionDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-01T22:15:58.348Z"); return t}()),
-	// 			ScheduledPurgeDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-05-01T22:15:58.348Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso
+	// Name: contoso
+	// Type: Microsoft.ApiCenter/deletedServices
 }
 
 // Generated from example definition: 2024-03-15-preview/DeletedServices_ListBySubscription.json
 func ExampleDeletedServicesClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeletedServicesServer: fake.DeletedServicesServer{
+					NewListPager: func(resourceGroupName string, options *armapicenter.DeletedServicesClientListOptions) (resp azfake.PagerResponder[armapicenter.DeletedServicesClientListResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.DeletedServicesClientListResponse{
+							DeletedServiceListResult: armapicenter.DeletedServiceListResult{
+								Value: []*armapicenter.DeletedService{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
+										Name: to.Ptr("contoso"),
+										Type: to.Ptr("Microsoft.ApiCenter/deletedServices"),
+										Properties: &armapicenter.DeletedServiceProperties{
+											SoftDeletionDate:   to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-01T22:15:58.348Z"); return t }()),
+											ScheduledPurgeDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-05-01T22:15:58.348Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -82,36 +128,47 @@ func ExampleDeletedServicesClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.DeletedServicesClientListResponse{
-		// 	DeletedServiceListResult: armapicenter.DeletedServiceListResult{
-		// 		Value: []*armapicenter.DeletedService{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
-		// 				Name: to.Ptr("contoso"),
-		// 				Type: to.Ptr("Microsoft.ApiCenter/deletedServices"),
-		// 				Properties: &armapicenter.DeletedServiceProperties{
-		// 					SoftDeletionDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-01T22:15:58.348Z"); return t}()),
-		// 					ScheduledPurgeDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-05-01T22:15:58.348Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso
+	// Name: contoso
+	// Type: Microsoft.ApiCenter/deletedServices
 }
 
 // Generated from example definition: 2024-03-15-preview/DeletedServices_List.json
 func ExampleDeletedServicesClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeletedServicesServer: fake.DeletedServicesServer{
+					NewListBySubscriptionPager: func(options *armapicenter.DeletedServicesClientListBySubscriptionOptions) (resp azfake.PagerResponder[armapicenter.DeletedServicesClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.DeletedServicesClientListBySubscriptionResponse{
+							DeletedServiceListResult: armapicenter.DeletedServiceListResult{
+								Value: []*armapicenter.DeletedService{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
+										Name: to.Ptr("contoso"),
+										Type: to.Ptr("Microsoft.ApiCenter/deletedServices"),
+										Properties: &armapicenter.DeletedServiceProperties{
+											SoftDeletionDate:   to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-01T22:15:58.348Z"); return t }()),
+											ScheduledPurgeDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-05-01T22:15:58.348Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -122,24 +179,13 @@ func ExampleDeletedServicesClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.DeletedServicesClientListBySubscriptionResponse{
-		// 	DeletedServiceListResult: armapicenter.DeletedServiceListResult{
-		// 		Value: []*armapicenter.DeletedService{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
-		// 				Name: to.Ptr("contoso"),
-		// 				Type: to.Ptr("Microsoft.ApiCenter/deletedServices"),
-		// 				Properties: &armapicenter.DeletedServiceProperties{
-		// 					SoftDeletionDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-01T22:15:58.348Z"); return t}()),
-		// 					ScheduledPurgeDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-05-01T22:15:58.348Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso
+	// Name: contoso
+	// Type: Microsoft.ApiCenter/deletedServices
 }
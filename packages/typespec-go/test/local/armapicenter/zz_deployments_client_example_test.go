@@ -6,20 +6,56 @@ package armapicenter_test
 
 import (
 	"armapicenter"
+	"armapicenter/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-03-15-preview/Deployments_CreateOrUpdate.json
 func ExampleDeploymentsClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, deploymentName string, payload armapicenter.Deployment, options *armapicenter.DeploymentsClientCreateOrUpdateOptions) (resp azfake.Responder[armapicenter.DeploymentsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.DeploymentsClientCreateOrUpdateResponse{
+							Deployment: armapicenter.Deployment{
+								Type: to.Ptr("Microsoft.ApiCenter/services/apis/deployments"),
+								ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/deployments/production"),
+								Name: to.Ptr("production"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.DeploymentProperties{
+									Title:         to.Ptr("Production deployment"),
+									Description:   to.Ptr("Public cloud production deployment."),
+									EnvironmentID: to.Ptr("/workspaces/default/environments/production"),
+									DefinitionID:  to.Ptr("/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
+									State:         to.Ptr(armapicenter.DeploymentStateActive),
+									Server: &armapicenter.DeploymentServer{
+										RuntimeURI: []*string{
+											to.Ptr("https://api.contoso.com"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -40,42 +76,31 @@ func ExampleDeploymentsClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.DeploymentsClientCreateOrUpdateResponse{
-	// 	Deployment: armapicenter.Deployment{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/apis/deployments"),
-	// 		ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/deployments/production"),
-	// 		Name: to.Ptr("production"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.DeploymentProperties{
-	// 			Title: to.Ptr("Production deployment"),
-	// 			Description: to.Ptr("Public cloud production deployment."),
-	// 			EnvironmentID: to.Ptr("/workspaces/default/environments/production"),
-	// 			DefinitionID: to.Ptr("/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
-	// 			State: to.Ptr(armapicenter.DeploymentStateActive),
-	// 			Server: &armapicenter.DeploymentServer{
-	// 				RuntimeURI: []*string{
-	// 					to.Ptr("https://api.contoso.com"),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/apis/deployments
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/deployments/production
+	// Name: production
 }
 
 // Generated from example definition: 2024-03-15-preview/Deployments_Delete.json
 func ExampleDeploymentsClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					Delete: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, deploymentName string, options *armapicenter.DeploymentsClientDeleteOptions) (resp azfake.Responder[armapicenter.DeploymentsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.DeploymentsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -85,19 +110,47 @@ func ExampleDeploymentsClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.DeploymentsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/Deployments_Get.json
 func ExampleDeploymentsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					Get: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, deploymentName string, options *armapicenter.DeploymentsClientGetOptions) (resp azfake.Responder[armapicenter.DeploymentsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.DeploymentsClientGetResponse{
+							Deployment: armapicenter.Deployment{
+								Type: to.Ptr("Microsoft.ApiCenter/services/apis/deployments"),
+								ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/deployments/production"),
+								Name: to.Ptr("public"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.DeploymentProperties{
+									Title:         to.Ptr("Production deployment"),
+									Description:   to.Ptr("Public cloud production deployment."),
+									EnvironmentID: to.Ptr("/workspaces/default/environments/production"),
+									DefinitionID:  to.Ptr("/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
+									State:         to.Ptr(armapicenter.DeploymentStateActive),
+									Server: &armapicenter.DeploymentServer{
+										RuntimeURI: []*string{
+											to.Ptr("https://api.contoso.com"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -105,42 +158,31 @@ func ExampleDeploymentsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.DeploymentsClientGetResponse{
-	// 	Deployment: armapicenter.Deployment{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/apis/deployments"),
-	// 		ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/deployments/production"),
-	// 		Name: to.Ptr("public"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.DeploymentProperties{
-	// 			Title: to.Ptr("Production deployment"),
-	// 			Description: to.Ptr("Public cloud production deployment."),
-	// 			EnvironmentID: to.Ptr("/workspaces/default/environments/production"),
-	// 			DefinitionID: to.Ptr("/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
-	// 			State: to.Ptr(armapicenter.DeploymentStateActive),
-	// 			Server: &armapicenter.DeploymentServer{
-	// 				RuntimeURI: []*string{
-	// 					to.Ptr("https://api.contoso.com"),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/apis/deployments
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/deployments/production
+	// Name: public
 }
 
 // Generated from example definition: 2024-03-15-preview/Deployments_Head.json
 func ExampleDeploymentsClient_Head() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					Head: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, apiName string, deploymentName string, options *armapicenter.DeploymentsClientHeadOptions) (resp azfake.Responder[armapicenter.DeploymentsClientHeadResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.DeploymentsClientHeadResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -150,19 +192,51 @@ func ExampleDeploymentsClient_Head() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.DeploymentsClientHeadResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/Deployments_List.json
 func ExampleDeploymentsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					NewListPager: func(resourceGroupName string, serviceName string, workspaceName string, apiName string, options *armapicenter.DeploymentsClientListOptions) (resp azfake.PagerResponder[armapicenter.DeploymentsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.DeploymentsClientListResponse{
+							DeploymentListResult: armapicenter.DeploymentListResult{
+								Value: []*armapicenter.Deployment{
+									{
+										Type: to.Ptr("Microsoft.ApiCenter/services/apis/deployments"),
+										ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/deployments/production"),
+										Name: to.Ptr("public"),
+										SystemData: &armapicenter.SystemData{
+											CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+											LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+										},
+										Properties: &armapicenter.DeploymentProperties{
+											Title:         to.Ptr("Development"),
+											Description:   to.Ptr("Public cloud production deployment."),
+											EnvironmentID: to.Ptr("/workspaces/default/environments/production"),
+											DefinitionID:  to.Ptr("/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
+											State:         to.Ptr(armapicenter.DeploymentStateActive),
+											Server: &armapicenter.DeploymentServer{
+												RuntimeURI: []*string{
+													to.Ptr("https://api.contoso.com"),
+												},
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -173,36 +247,13 @@ func ExampleDeploymentsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.DeploymentsClientListResponse{
-		// 	DeploymentListResult: armapicenter.DeploymentListResult{
-		// 		Value: []*armapicenter.Deployment{
-		// 			{
-		// 				Type: to.Ptr("Microsoft.ApiCenter/services/apis/deployments"),
-		// 				ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/deployments/production"),
-		// 				Name: to.Ptr("public"),
-		// 				SystemData: &armapicenter.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-		// 				},
-		// 				Properties: &armapicenter.DeploymentProperties{
-		// 					Title: to.Ptr("Development"),
-		// 					Description: to.Ptr("Public cloud production deployment."),
-		// 					EnvironmentID: to.Ptr("/workspaces/default/environments/production"),
-		// 					DefinitionID: to.Ptr("/workspaces/default/apis/echo-api/versions/2023-01-01/definitions/openapi"),
-		// 					State: to.Ptr(armapicenter.DeploymentStateActive),
-		// 					Server: &armapicenter.DeploymentServer{
-		// 						RuntimeURI: []*string{
-		// 							to.Ptr("https://api.contoso.com"),
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Type: Microsoft.ApiCenter/services/apis/deployments
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/deployments/production
+	// Name: public
 }
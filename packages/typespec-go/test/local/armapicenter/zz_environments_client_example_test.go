@@ -6,20 +6,61 @@ package armapicenter_test
 
 import (
 	"armapicenter"
+	"armapicenter/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-03-15-preview/Environments_CreateOrUpdate.json
 func ExampleEnvironmentsClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				EnvironmentsServer: fake.EnvironmentsServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, environmentName string, payload armapicenter.Environment, options *armapicenter.EnvironmentsClientCreateOrUpdateOptions) (resp azfake.Responder[armapicenter.EnvironmentsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.EnvironmentsClientCreateOrUpdateResponse{
+							Environment: armapicenter.Environment{
+								Type: to.Ptr("Microsoft.ApiCenter/services/workspaces/environments"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/environments/public"),
+								Name: to.Ptr("public"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.EnvironmentProperties{
+									Title:       to.Ptr("Contoso Europe Azure API Management"),
+									Description: to.Ptr("The primary Azure API Management service for the European division of Contoso."),
+									Kind:        to.Ptr(armapicenter.EnvironmentKindProduction),
+									Server: &armapicenter.EnvironmentServer{
+										Type: to.Ptr(armapicenter.EnvironmentServerTypeAzureAPIManagement),
+										ManagementPortalURI: []*string{
+											to.Ptr("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiManagement/service/contoso"),
+										},
+									},
+									Onboarding: &armapicenter.Onboarding{
+										Instructions: to.Ptr("Sign in or sign up in the specified developer portal to request API access. You must complete the internal privacy training for your account to be approved."),
+										DeveloperPortalURI: []*string{
+											to.Ptr("https://developer.contoso.com"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -45,47 +86,31 @@ func ExampleEnvironmentsClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.EnvironmentsClientCreateOrUpdateResponse{
-	// 	Environment: armapicenter.Environment{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/workspaces/environments"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/environments/public"),
-	// 		Name: to.Ptr("public"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.EnvironmentProperties{
-	// 			Title: to.Ptr("Contoso Europe Azure API Management"),
-	// 			Description: to.Ptr("The primary Azure API Management service for the European division of Contoso."),
-	// 			Kind: to.Ptr(armapicenter.EnvironmentKindProduction),
-	// 			Server: &armapicenter.EnvironmentServer{
-	// 				Type: to.Ptr(armapicenter.EnvironmentServerTypeAzureAPIManagement),
-	// 				ManagementPortalURI: []*string{
-	// 					to.Ptr("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiManagement/service/contoso"),
-	// 				},
-	// 			},
-	// 			Onboarding: &armapicenter.Onboarding{
-	// 				Instructions: to.Ptr("Sign in or sign up in the specified developer portal to request API access. You must complete the internal privacy training for your account to be approved."),
-	// 				DeveloperPortalURI: []*string{
-	// 					to.Ptr("https://developer.contoso.com"),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/workspaces/environments
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/environments/public
+	// Name: public
 }
 
 // Generated from example definition: 2024-03-15-preview/Environments_Delete.json
 func ExampleEnvironmentsClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				EnvironmentsServer: fake.EnvironmentsServer{
+					Delete: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, environmentName string, options *armapicenter.EnvironmentsClientDeleteOptions) (resp azfake.Responder[armapicenter.EnvironmentsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.EnvironmentsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -95,19 +120,47 @@ func ExampleEnvironmentsClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.EnvironmentsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/Environments_Get.json
 func ExampleEnvironmentsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				EnvironmentsServer: fake.EnvironmentsServer{
+					Get: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, environmentName string, options *armapicenter.EnvironmentsClientGetOptions) (resp azfake.Responder[armapicenter.EnvironmentsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.EnvironmentsClientGetResponse{
+							Environment: armapicenter.Environment{
+								Type: to.Ptr("Microsoft.ApiCenter/services/environments"),
+								ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/environments/public"),
+								Name: to.Ptr("public"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.EnvironmentProperties{
+									Title: to.Ptr("Public"),
+									Kind:  to.Ptr(armapicenter.EnvironmentKindProduction),
+									Server: &armapicenter.EnvironmentServer{
+										Type:                to.Ptr(armapicenter.EnvironmentServerTypeAzureAPIManagement),
+										ManagementPortalURI: []*string{},
+									},
+									Onboarding: &armapicenter.Onboarding{
+										DeveloperPortalURI: []*string{},
+									},
+									CustomProperties: &armapicenter.CustomProperties{},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -115,45 +168,31 @@ func ExampleEnvironmentsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.EnvironmentsClientGetResponse{
-	// 	Environment: armapicenter.Environment{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/environments"),
-	// 		ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/environments/public"),
-	// 		Name: to.Ptr("public"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.EnvironmentProperties{
-	// 			Title: to.Ptr("Public"),
-	// 			Kind: to.Ptr(armapicenter.EnvironmentKindProduction),
-	// 			Server: &armapicenter.EnvironmentServer{
-	// 				Type: to.Ptr(armapicenter.EnvironmentServerTypeAzureAPIManagement),
-	// 				ManagementPortalURI: []*string{
-	// 				},
-	// 			},
-	// 			Onboarding: &armapicenter.Onboarding{
-	// 				DeveloperPortalURI: []*string{
-	// 				},
-	// 			},
-	// 			CustomProperties: &armapicenter.CustomProperties{
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/environments
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/environments/public
+	// Name: public
 }
 
 // Generated from example definition: 2024-03-15-preview/Environments_Head.json
 func ExampleEnvironmentsClient_Head() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				EnvironmentsServer: fake.EnvironmentsServer{
+					Head: func(ctx context.Context, resourceGroupName string, serviceName string, workspaceName string, environmentName string, options *armapicenter.EnvironmentsClientHeadOptions) (resp azfake.Responder[armapicenter.EnvironmentsClientHeadResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.EnvironmentsClientHeadResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -163,19 +202,52 @@ func ExampleEnvironmentsClient_Head() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.EnvironmentsClientHeadResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/Environments_List.json
 func ExampleEnvironmentsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				EnvironmentsServer: fake.EnvironmentsServer{
+					NewListPager: func(resourceGroupName string, serviceName string, workspaceName string, options *armapicenter.EnvironmentsClientListOptions) (resp azfake.PagerResponder[armapicenter.EnvironmentsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.EnvironmentsClientListResponse{
+							EnvironmentListResult: armapicenter.EnvironmentListResult{
+								Value: []*armapicenter.Environment{
+									{
+										Type: to.Ptr("Microsoft.ApiCenter/services/environments"),
+										ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/environments/public"),
+										Name: to.Ptr("public"),
+										SystemData: &armapicenter.SystemData{
+											CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+											LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+										},
+										Properties: &armapicenter.EnvironmentProperties{
+											Title:       to.Ptr("Contoso Europe Azure API Management"),
+											Description: to.Ptr("The primary Azure API Management service for the European division of Contoso."),
+											Kind:        to.Ptr(armapicenter.EnvironmentKindProduction),
+											Server: &armapicenter.EnvironmentServer{
+												Type:                to.Ptr(armapicenter.EnvironmentServerTypeAzureAPIManagement),
+												ManagementPortalURI: []*string{},
+											},
+											Onboarding: &armapicenter.Onboarding{
+												DeveloperPortalURI: []*string{},
+											},
+											CustomProperties: &armapicenter.CustomProperties{},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -186,40 +258,13 @@ func ExampleEnvironmentsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.EnvironmentsClientListResponse{
-		// 	EnvironmentListResult: armapicenter.EnvironmentListResult{
-		// 		Value: []*armapicenter.Environment{
-		// 			{
-		// 				Type: to.Ptr("Microsoft.ApiCenter/services/environments"),
-		// 				ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/environments/public"),
-		// 				Name: to.Ptr("public"),
-		// 				SystemData: &armapicenter.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-		// 				},
-		// 				Properties: &armapicenter.EnvironmentProperties{
-		// 					Title: to.Ptr("Contoso Europe Azure API Management"),
-		// 					Description: to.Ptr("The primary Azure API Management service for the European division of Contoso."),
-		// 					Kind: to.Ptr(armapicenter.EnvironmentKindProduction),
-		// 					Server: &armapicenter.EnvironmentServer{
-		// 						Type: to.Ptr(armapicenter.EnvironmentServerTypeAzureAPIManagement),
-		// 						ManagementPortalURI: []*string{
-		// 						},
-		// 					},
-		// 					Onboarding: &armapicenter.Onboarding{
-		// 						DeveloperPortalURI: []*string{
-		// 						},
-		// 					},
-		// 					CustomProperties: &armapicenter.CustomProperties{
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Type: Microsoft.ApiCenter/services/environments
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/workspaces/default/environments/public
+	// Name: public
 }
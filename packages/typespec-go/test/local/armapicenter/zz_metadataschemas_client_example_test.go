@@ -6,20 +6,53 @@ package armapicenter_test
 
 import (
 	"armapicenter"
+	"armapicenter/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-03-15-preview/MetadataSchemas_CreateOrUpdate.json
 func ExampleMetadataSchemasClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MetadataSchemasServer: fake.MetadataSchemasServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, serviceName string, metadataSchemaName string, payload armapicenter.MetadataSchema, options *armapicenter.MetadataSchemasClientCreateOrUpdateOptions) (resp azfake.Responder[armapicenter.MetadataSchemasClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.MetadataSchemasClientCreateOrUpdateResponse{
+							MetadataSchema: armapicenter.MetadataSchema{
+								Type: to.Ptr("Microsoft.ApiCenter/services/metadataSchemas"),
+								ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/metadataSchemas/author"),
+								Name: to.Ptr("author"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.MetadataSchemaProperties{
+									AssignedTo: []*armapicenter.MetadataAssignment{
+										{
+											Entity:     to.Ptr(armapicenter.MetadataAssignmentEntityAPI),
+											Deprecated: to.Ptr(true),
+										},
+									},
+									Schema: to.Ptr("{\"type\":\"string\", \"title\":\"Author\", pattern: \"^[a-zA-Z]+$\"}"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -37,39 +70,31 @@ func ExampleMetadataSchemasClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.MetadataSchemasClientCreateOrUpdateResponse{
-	// 	MetadataSchema: armapicenter.MetadataSchema{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/metadataSchemas"),
-	// 		ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/metadataSchemas/author"),
-	// 		Name: to.Ptr("author"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.MetadataSchemaProperties{
-	// 			AssignedTo: []*armapicenter.MetadataAssignment{
-	// 				{
-	// 					Entity: to.Ptr(armapicenter.MetadataAssignmentEntityAPI),
-	// 					Deprecated: to.Ptr(true),
-	// 				},
-	// 			},
-	// 			Schema: to.Ptr("{\"type\":\"string\", \"title\":\"Author\", pattern: \"^[a-zA-Z]+$\"}"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/metadataSchemas
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/metadataSchemas/author
+	// Name: author
 }
 
 // Generated from example definition: 2024-03-15-preview/MetadataSchemas_Delete.json
 func ExampleMetadataSchemasClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MetadataSchemasServer: fake.MetadataSchemasServer{
+					Delete: func(ctx context.Context, resourceGroupName string, serviceName string, metadataSchemaName string, options *armapicenter.MetadataSchemasClientDeleteOptions) (resp azfake.Responder[armapicenter.MetadataSchemasClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.MetadataSchemasClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -79,19 +104,44 @@ func ExampleMetadataSchemasClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.MetadataSchemasClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/MetadataSchemas_Get.json
 func ExampleMetadataSchemasClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MetadataSchemasServer: fake.MetadataSchemasServer{
+					Get: func(ctx context.Context, resourceGroupName string, serviceName string, metadataSchemaName string, options *armapicenter.MetadataSchemasClientGetOptions) (resp azfake.Responder[armapicenter.MetadataSchemasClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.MetadataSchemasClientGetResponse{
+							MetadataSchema: armapicenter.MetadataSchema{
+								Type: to.Ptr("Microsoft.ApiCenter/services/metadataSchemas"),
+								ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/metadataSchemas/author"),
+								Name: to.Ptr("author"),
+								SystemData: &armapicenter.SystemData{
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+								},
+								Properties: &armapicenter.MetadataSchemaProperties{
+									AssignedTo: []*armapicenter.MetadataAssignment{
+										{
+											Entity:     to.Ptr(armapicenter.MetadataAssignmentEntityAPI),
+											Deprecated: to.Ptr(true),
+										},
+									},
+									Schema: to.Ptr("{\"type\":\"string\", \"title\":\"Author\", pattern: \"^[a-zA-Z]+$\"}"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -99,39 +149,31 @@ func ExampleMetadataSchemasClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.MetadataSchemasClientGetResponse{
-	// 	MetadataSchema: armapicenter.MetadataSchema{
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services/metadataSchemas"),
-	// 		ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/metadataSchemas/author"),
-	// 		Name: to.Ptr("author"),
-	// 		SystemData: &armapicenter.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-	// 		},
-	// 		Properties: &armapicenter.MetadataSchemaProperties{
-	// 			AssignedTo: []*armapicenter.MetadataAssignment{
-	// 				{
-	// 					Entity: to.Ptr(armapicenter.MetadataAssignmentEntityAPI),
-	// 					Deprecated: to.Ptr(true),
-	// 				},
-	// 			},
-	// 			Schema: to.Ptr("{\"type\":\"string\", \"title\":\"Author\", pattern: \"^[a-zA-Z]+$\"}"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	// Output:
+	// Type: Microsoft.ApiCenter/services/metadataSchemas
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/metadataSchemas/author
+	// Name: author
 }
 
 // Generated from example definition: 2024-03-15-preview/MetadataSchemas_Head.json
 func ExampleMetadataSchemasClient_Head() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MetadataSchemasServer: fake.MetadataSchemasServer{
+					Head: func(ctx context.Context, resourceGroupName string, serviceName string, metadataSchemaName string, options *armapicenter.MetadataSchemasClientHeadOptions) (resp azfake.Responder[armapicenter.MetadataSchemasClientHeadResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.MetadataSchemasClientHeadResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -141,19 +183,48 @@ func ExampleMetadataSchemasClient_Head() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.MetadataSchemasClientHeadResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/MetadataSchemas_List.json
 func ExampleMetadataSchemasClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MetadataSchemasServer: fake.MetadataSchemasServer{
+					NewListPager: func(resourceGroupName string, serviceName string, options *armapicenter.MetadataSchemasClientListOptions) (resp azfake.PagerResponder[armapicenter.MetadataSchemasClientListResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.MetadataSchemasClientListResponse{
+							MetadataSchemaListResult: armapicenter.MetadataSchemaListResult{
+								Value: []*armapicenter.MetadataSchema{
+									{
+										Type: to.Ptr("Microsoft.ApiCenter/services/metadataSchemas"),
+										ID:   to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/metadataSchemas/author"),
+										Name: to.Ptr("author"),
+										SystemData: &armapicenter.SystemData{
+											CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t }()),
+											LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t }()),
+										},
+										Properties: &armapicenter.MetadataSchemaProperties{
+											AssignedTo: []*armapicenter.MetadataAssignment{
+												{
+													Entity:     to.Ptr(armapicenter.MetadataAssignmentEntityAPI),
+													Deprecated: to.Ptr(true),
+												},
+											},
+											Schema: to.Ptr("{\"type\":\"string\", \"title\":\"Author\", pattern: \"^[a-zA-Z]+$\"}"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -164,33 +235,13 @@ func ExampleMetadataSchemasClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.MetadataSchemasClientListResponse{
-		// 	MetadataSchemaListResult: armapicenter.MetadataSchemaListResult{
-		// 		Value: []*armapicenter.MetadataSchema{
-		// 			{
-		// 				Type: to.Ptr("Microsoft.ApiCenter/services/metadataSchemas"),
-		// 				ID: to.Ptr("/subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/metadataSchemas/author"),
-		// 				Name: to.Ptr("author"),
-		// 				SystemData: &armapicenter.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.128871Z"); return t}()),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-03T18:27:09.1288716Z"); return t}()),
-		// 				},
-		// 				Properties: &armapicenter.MetadataSchemaProperties{
-		// 					AssignedTo: []*armapicenter.MetadataAssignment{
-		// 						{
-		// 							Entity: to.Ptr(armapicenter.MetadataAssignmentEntityAPI),
-		// 							Deprecated: to.Ptr(true),
-		// 						},
-		// 					},
-		// 					Schema: to.Ptr("{\"type\":\"string\", \"title\":\"Author\", pattern: \"^[a-zA-Z]+$\"}"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Type: Microsoft.ApiCenter/services/metadataSchemas
+	// ID: /subscriptions/a200340d-6b82-494d-9dbf-687ba6e33f9e/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso/metadataSchemas/author
+	// Name: author
 }
@@ -6,19 +6,48 @@ package armapicenter_test
 
 import (
 	"armapicenter"
+	"armapicenter/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-03-15-preview/Operations_List.json
 func ExampleOperationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armapicenter.OperationsClientListOptions) (resp azfake.PagerResponder[armapicenter.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.OperationsClientListResponse{
+							OperationListResult: armapicenter.OperationListResult{
+								Value: []*armapicenter.Operation{
+									{
+										Name:         to.Ptr("Microsoft.ApiCenter/services/read"),
+										IsDataAction: to.Ptr(false),
+										Display: &armapicenter.OperationDisplay{
+											Provider:    to.Ptr("Microsoft.ApiCenter"),
+											Resource:    to.Ptr("services"),
+											Operation:   to.Ptr("Lists services"),
+											Description: to.Ptr("Lists registered services"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,25 +58,11 @@ func ExampleOperationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("IsDataAction:", *v.IsDataAction)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.OperationsClientListResponse{
-		// 	OperationListResult: armapicenter.OperationListResult{
-		// 		Value: []*armapicenter.Operation{
-		// 			{
-		// 				Name: to.Ptr("Microsoft.ApiCenter/services/read"),
-		// 				IsDataAction: to.Ptr(false),
-		// 				Display: &armapicenter.OperationDisplay{
-		// 					Provider: to.Ptr("Microsoft.ApiCenter"),
-		// 					Resource: to.Ptr("services"),
-		// 					Operation: to.Ptr("Lists services"),
-		// 					Description: to.Ptr("Lists registered services"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Microsoft.ApiCenter/services/read
+	// IsDataAction: false
 }
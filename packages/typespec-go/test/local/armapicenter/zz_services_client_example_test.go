@@ -6,20 +6,55 @@ package armapicenter_test
 
 import (
 	"armapicenter"
+	"armapicenter/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-03-15-preview/Services_CreateOrUpdate.json
 func ExampleServicesClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, serviceName string, resource armapicenter.Service, options *armapicenter.ServicesClientCreateOrUpdateOptions) (resp azfake.Responder[armapicenter.ServicesClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.ServicesClientCreateOrUpdateResponse{
+							Service: armapicenter.Service{
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
+								Type: to.Ptr("Microsoft.ApiCenter/services"),
+								Name: to.Ptr("contoso"),
+								Properties: &armapicenter.ServiceProperties{
+									ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
+								},
+								Tags:     map[string]*string{},
+								Location: to.Ptr("East US"),
+								Identity: &armapicenter.ManagedServiceIdentity{
+									Type:        to.Ptr(armapicenter.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
+									PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+									TenantID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+									UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
+										"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
+											PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+											ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -37,43 +72,33 @@ func ExampleServicesClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.ServicesClientCreateOrUpdateResponse{
-	// 	Service: armapicenter.Service{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services"),
-	// 		Name: to.Ptr("contoso"),
-	// 		Properties: &armapicenter.ServiceProperties{
-	// 			ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Location: to.Ptr("East US"),
-	// 		Identity: &armapicenter.ManagedServiceIdentity{
-	// 			Type: to.Ptr(armapicenter.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
-	// 			PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
-	// 				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
-	// 					PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 					ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso
+	// Type: Microsoft.ApiCenter/services
+	// Name: contoso
+	// Location: East US
 }
 
 // Generated from example definition: 2024-03-15-preview/Services_Delete.json
 func ExampleServicesClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					Delete: func(ctx context.Context, resourceGroupName string, serviceName string, options *armapicenter.ServicesClientDeleteOptions) (resp azfake.Responder[armapicenter.ServicesClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.ServicesClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -83,19 +108,30 @@ func ExampleServicesClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.ServicesClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-15-preview/Services_ExportMetadataSchema.json
 func ExampleServicesClient_BeginExportMetadataSchema() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					BeginExportMetadataSchema: func(ctx context.Context, resourceGroupName string, serviceName string, payload armapicenter.MetadataSchemaExportRequest, options *armapicenter.ServicesClientBeginExportMetadataSchemaOptions) (resp azfake.PollerResponder[armapicenter.ServicesClientExportMetadataSchemaResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armapicenter.ServicesClientExportMetadataSchemaResponse{
+							MetadataSchemaExportResult: armapicenter.MetadataSchemaExportResult{
+								Format: to.Ptr(armapicenter.MetadataSchemaExportFormat("json-schema")),
+								Value:  to.Ptr("{\"type\":\"object\",\"properties\":{ ... }}"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -109,25 +145,51 @@ func ExampleServicesClient_BeginExportMetadataSchema() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.ServicesClientExportMetadataSchemaResponse{
-	// 	MetadataSchemaExportResult: armapicenter.MetadataSchemaExportResult{
-	// 		Format: to.Ptr(armapicenter.MetadataSchemaExportFormat("json-schema")),
-	// 		Value: to.Ptr("{\"type\":\"object\",\"properties\":{ ... }}"),
-	// 	},
-	// }
+	fmt.Println("Format:", *res.Format)
+	fmt.Println("Value:", *res.Value)
+	// Output:
+	// Format: json-schema
+	// Value: {"type":"object","properties":{ ... }}
 }
 
 // Generated from example definition: 2024-03-15-preview/Services_Get.json
 func ExampleServicesClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					Get: func(ctx context.Context, resourceGroupName string, serviceName string, options *armapicenter.ServicesClientGetOptions) (resp azfake.Responder[armapicenter.ServicesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.ServicesClientGetResponse{
+							Service: armapicenter.Service{
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
+								Type: to.Ptr("Microsoft.ApiCenter/services"),
+								Name: to.Ptr("contoso"),
+								Properties: &armapicenter.ServiceProperties{
+									ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
+								},
+								Tags:     map[string]*string{},
+								Location: to.Ptr("East US"),
+								Identity: &armapicenter.ManagedServiceIdentity{
+									Type:        to.Ptr(armapicenter.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
+									PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+									TenantID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+									UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
+										"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
+											PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+											ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -135,43 +197,59 @@ func ExampleServicesClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.ServicesClientGetResponse{
-	// 	Service: armapicenter.Service{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services"),
-	// 		Name: to.Ptr("contoso"),
-	// 		Properties: &armapicenter.ServiceProperties{
-	// 			ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Location: to.Ptr("East US"),
-	// 		Identity: &armapicenter.ManagedServiceIdentity{
-	// 			Type: to.Ptr(armapicenter.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
-	// 			PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
-	// 				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
-	// 					PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 					ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso
+	// Type: Microsoft.ApiCenter/services
+	// Name: contoso
+	// Location: East US
 }
 
 // Generated from example definition: 2024-03-15-preview/Services_ListByResourceGroup.json
 func ExampleServicesClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armapicenter.ServicesClientListByResourceGroupOptions) (resp azfake.PagerResponder[armapicenter.ServicesClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.ServicesClientListByResourceGroupResponse{
+							ServiceListResult: armapicenter.ServiceListResult{
+								Value: []*armapicenter.Service{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
+										Type: to.Ptr("Microsoft.ApiCenter/services"),
+										Name: to.Ptr("contoso"),
+										Properties: &armapicenter.ServiceProperties{
+											ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
+										},
+										Tags:     map[string]*string{},
+										Location: to.Ptr("East US"),
+										Identity: &armapicenter.ManagedServiceIdentity{
+											Type:        to.Ptr(armapicenter.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
+											PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+											TenantID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+											UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
+												"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
+													PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+													ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+												},
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -182,49 +260,61 @@ func ExampleServicesClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.ServicesClientListByResourceGroupResponse{
-		// 	ServiceListResult: armapicenter.ServiceListResult{
-		// 		Value: []*armapicenter.Service{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
-		// 				Type: to.Ptr("Microsoft.ApiCenter/services"),
-		// 				Name: to.Ptr("contoso"),
-		// 				Properties: &armapicenter.ServiceProperties{
-		// 					ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				Location: to.Ptr("East US"),
-		// 				Identity: &armapicenter.ManagedServiceIdentity{
-		// 					Type: to.Ptr(armapicenter.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
-		// 					PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
-		// 						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
-		// 							PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 							ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso
+	// Type: Microsoft.ApiCenter/services
+	// Name: contoso
+	// Location: East US
 }
 
 // Generated from example definition: 2024-03-15-preview/Services_ListBySubscription.json
 func ExampleServicesClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					NewListBySubscriptionPager: func(options *armapicenter.ServicesClientListBySubscriptionOptions) (resp azfake.PagerResponder[armapicenter.ServicesClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armapicenter.ServicesClientListBySubscriptionResponse{
+							ServiceListResult: armapicenter.ServiceListResult{
+								Value: []*armapicenter.Service{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
+										Type: to.Ptr("Microsoft.ApiCenter/services"),
+										Name: to.Ptr("contoso"),
+										Properties: &armapicenter.ServiceProperties{
+											ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
+										},
+										Tags:     map[string]*string{},
+										Location: to.Ptr("East US"),
+										Identity: &armapicenter.ManagedServiceIdentity{
+											Type:        to.Ptr(armapicenter.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
+											PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+											TenantID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+											UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
+												"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
+													PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+													ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+												},
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -235,49 +325,57 @@ func ExampleServicesClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armapicenter.ServicesClientListBySubscriptionResponse{
-		// 	ServiceListResult: armapicenter.ServiceListResult{
-		// 		Value: []*armapicenter.Service{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
-		// 				Type: to.Ptr("Microsoft.ApiCenter/services"),
-		// 				Name: to.Ptr("contoso"),
-		// 				Properties: &armapicenter.ServiceProperties{
-		// 					ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				Location: to.Ptr("East US"),
-		// 				Identity: &armapicenter.ManagedServiceIdentity{
-		// 					Type: to.Ptr(armapicenter.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
-		// 					PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
-		// 						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
-		// 							PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 							ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso
+	// Type: Microsoft.ApiCenter/services
+	// Name: contoso
+	// Location: East US
 }
 
 // Generated from example definition: 2024-03-15-preview/Services_Update.json
 func ExampleServicesClient_Update() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armapicenter.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					Update: func(ctx context.Context, resourceGroupName string, serviceName string, payload armapicenter.ServiceUpdate, options *armapicenter.ServicesClientUpdateOptions) (resp azfake.Responder[armapicenter.ServicesClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armapicenter.ServicesClientUpdateResponse{
+							Service: armapicenter.Service{
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
+								Type: to.Ptr("Microsoft.ApiCenter/services"),
+								Name: to.Ptr("contoso"),
+								Properties: &armapicenter.ServiceProperties{
+									ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
+								},
+								Tags:     map[string]*string{},
+								Location: to.Ptr("East US"),
+								Identity: &armapicenter.ManagedServiceIdentity{
+									Type:        to.Ptr(armapicenter.ManagedServiceIdentityType("SystemAssigned, UserAssigned")),
+									PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+									TenantID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+									UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
+										"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
+											PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+											ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -293,31 +391,13 @@ func ExampleServicesClient_Update() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armapicenter.ServicesClientUpdateResponse{
-	// 	Service: armapicenter.Service{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso"),
-	// 		Type: to.Ptr("Microsoft.ApiCenter/services"),
-	// 		Name: to.Ptr("contoso"),
-	// 		Properties: &armapicenter.ServiceProperties{
-	// 			ProvisioningState: to.Ptr(armapicenter.ProvisioningStateSucceeded),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Location: to.Ptr("East US"),
-	// 		Identity: &armapicenter.ManagedServiceIdentity{
-	// 			Type: to.Ptr(armapicenter.ManagedServiceIdentityType("SystemAssigned, UserAssigned")),
-	// 			PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			UserAssignedIdentities: map[string]*armapicenter.UserAssignedIdentity{
-	// 				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/contoso-identity": &armapicenter.UserAssignedIdentity{
-	// 					PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 					ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ApiCenter/services/contoso
+	// Type: Microsoft.ApiCenter/services
+	// Name: contoso
+	// Location: East US
 }
@@ -6,21 +6,76 @@ package armbillingbenefits_test
 
 import (
 	"armbillingbenefits"
+	"armbillingbenefits/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 	"time"
 )
 
 // Generated from example definition: 2024-11-01-preview/DiscountsCreatePrimaryWithCustomPrice.json
 func ExampleDiscountsClient_BeginCreate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armbillingbenefits.NewClientFactory("30000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armbillingbenefits.NewClientFactory("30000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DiscountsServer: fake.DiscountsServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, discountName string, body armbillingbenefits.Discount, options *armbillingbenefits.DiscountsClientBeginCreateOptions) (resp azfake.PollerResponder[armbillingbenefits.DiscountsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armbillingbenefits.DiscountsClientCreateResponse{
+							Discount: armbillingbenefits.Discount{
+								Name:     to.Ptr("testprimarydiscount"),
+								Type:     to.Ptr("Microsoft.BillingBenefits/discounts"),
+								ID:       to.Ptr("/subscriptions/30000000-0000-0000-0000-000000000000/resourceGroups/testrg/providers/Microsoft.BillingBenefits/discounts/testprimarydiscount"),
+								Location: to.Ptr("global"),
+								Properties: &armbillingbenefits.EntityTypePrimaryDiscount{
+									AppliedScopeType:         to.Ptr(armbillingbenefits.DiscountAppliedScopeTypeBillingAccount),
+									BillingAccountResourceID: to.Ptr("/providers/Microsoft.Billing/billingAccounts/20000000-1000-0000-0000-000000000000:20000000-0000-3000-0000-000000000000_2019-05-31"),
+									BillingProfileResourceID: to.Ptr("/providers/Microsoft.Billing/billingAccounts/20000000-1000-0000-0000-000000000000:20000000-0000-3000-0000-000000000000_2019-05-31/billingProfiles/KPSV-DWNE-BG7-TGB"),
+									CustomerResourceID:       to.Ptr("/providers/Microsoft.Billing/billingAccounts/20000000-1000-0000-0000-000000000000:20000000-0000-3000-0000-000000000000_2019-05-31/customers/40000000-0000-0000-0000-000000000000"),
+									DiscountTypeProperties: &armbillingbenefits.ProductSKUDiscountTypeProperties{
+										ApplyDiscountOn: to.Ptr(armbillingbenefits.ApplyDiscountOnPurchase),
+										Conditions: []*armbillingbenefits.ConditionsItem{
+											{
+												Type:          to.Ptr("equalAny"),
+												ConditionName: to.Ptr("Cloud"),
+												Value: []*string{
+													to.Ptr("US-Sec"),
+												},
+											},
+										},
+										DiscountPercentage: to.Ptr[float64](14),
+										DiscountType:       to.Ptr(armbillingbenefits.DiscountTypeSKU),
+										ProductFamilyName:  to.Ptr("Azure"),
+										ProductID:          to.Ptr("DZH318Z0BQ35"),
+										SKUID:              to.Ptr("0001"),
+									},
+									DisplayName:       to.Ptr("Virtual Machines D Series"),
+									EndAt:             to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-01T23:59:59Z"); return t }()),
+									EntityType:        to.Ptr(armbillingbenefits.DiscountEntityTypePrimary),
+									ProductCode:       to.Ptr("0001d726-0000-0160-330f-a0b98cdbbdc4"),
+									ProvisioningState: to.Ptr(armbillingbenefits.DiscountProvisioningStateSucceeded),
+									StartAt:           to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-01T00:00:00Z"); return t }()),
+									Status:            to.Ptr(armbillingbenefits.DiscountStatusActive),
+									SystemID:          to.Ptr("13810867107109237"),
+								},
+								Tags: map[string]*string{
+									"key1": to.Ptr("value1"),
+									"key2": to.Ptr("value2"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -61,50 +116,13 @@ func ExampleDiscountsClient_BeginCreate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armbillingbenefits.DiscountsClientCreateResponse{
-	// 	Discount: armbillingbenefits.Discount{
-	// 		Name: to.Ptr("testprimarydiscount"),
-	// 		Type: to.Ptr("Microsoft.BillingBenefits/discounts"),
-	// 		ID: to.Ptr("/subscriptions/30000000-0000-0000-0000-000000000000/resourceGroups/testrg/providers/Microsoft.BillingBenefits/discounts/testprimarydiscount"),
-	// 		Location: to.Ptr("global"),
-	// 		Properties: &armbillingbenefits.EntityTypePrimaryDiscount{
-	// 			AppliedScopeType: to.Ptr(armbillingbenefits.DiscountAppliedScopeTypeBillingAccount),
-	// 			BillingAccountResourceID: to.Ptr("/providers/Microsoft.Billing/billingAccounts/20000000-1000-0000-0000-000000000000:20000000-0000-3000-0000-000000000000_2019-05-31"),
-	// 			BillingProfileResourceID: to.Ptr("/providers/Microsoft.Billing/billingAccounts/20000000-1000-0000-0000-000000000000:20000000-0000-3000-0000-000000000000_2019-05-31/billingProfiles/KPSV-DWNE-BG7-TGB"),
-	// 			CustomerResourceID: to.Ptr("/providers/Microsoft.Billing/billingAccounts/20000000-1000-0000-0000-000000000000:20000000-0000-3000-0000-000000000000_2019-05-31/customers/40000000-0000-0000-0000-000000000000"),
-	// 			DiscountTypeProperties: &armbillingbenefits.ProductSKUDiscountTypeProperties{
-	// 				ApplyDiscountOn: to.Ptr(armbillingbenefits.ApplyDiscountOnPurchase),
-	// 				Conditions: []*armbillingbenefits.ConditionsItem{
-	// 					{
-	// 						Type: to.Ptr("equalAny"),
-	// 						ConditionName: to.Ptr("Cloud"),
-	// 						Value: []*string{
-	// 							to.Ptr("US-Sec"),
-	// 						},
-	// 					},
-	// 				},
-	// 				DiscountPercentage: to.Ptr[float64](14),
-	// 				DiscountType: to.Ptr(armbillingbenefits.DiscountTypeSKU),
-	// 				ProductFamilyName: to.Ptr("Azure"),
-	// 				ProductID: to.Ptr("DZH318Z0BQ35"),
-	// 				SKUID: to.Ptr("0001"),
-	// 			},
-	// 			DisplayName: to.Ptr("Virtual Machines D Series"),
-	// 			EndAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-01T23:59:59Z"); return t}()),
-	// 			EntityType: to.Ptr(armbillingbenefits.DiscountEntityTypePrimary),
-	// 			ProductCode: to.Ptr("0001d726-0000-0160-330f-a0b98cdbbdc4"),
-	// 			ProvisioningState: to.Ptr(armbillingbenefits.DiscountProvisioningStateSucceeded),
-	// 			StartAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-01T00:00:00Z"); return t}()),
-	// 			Status: to.Ptr(armbillingbenefits.DiscountStatusActive),
-	// 			SystemID: to.Ptr("13810867107109237"),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"key1": to.Ptr("value1"),
-	// 			"key2": to.Ptr("value2"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: testprimarydiscount
+	// Type: Microsoft.BillingBenefits/discounts
+	// ID: /subscriptions/30000000-0000-0000-0000-000000000000/resourceGroups/testrg/providers/Microsoft.BillingBenefits/discounts/testprimarydiscount
+	// Location: global
 }
@@ -403,3 +403,19 @@ var accountsServerTransportInterceptor interface {
 	// Do returns true if the server transport should use the returned response/error
 	Do(*http.Request) (*http.Response, error, bool)
 }
+
+var accountsServerRoutes = []route{
+	{method: http.MethodPost, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/checkNameAvailability$`), api: "AccountsClient.CheckNameAvailability"},
+	{method: http.MethodPut, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts/(?P<accountName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)$`), api: "AccountsClient.BeginCreate", tracked: true},
+	{method: http.MethodDelete, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts/(?P<accountName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)$`), api: "AccountsClient.BeginDelete", tracked: true},
+	{method: http.MethodGet, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts/(?P<accountName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)$`), api: "AccountsClient.Get"},
+	{method: http.MethodGet, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts$`), api: "AccountsClient.NewListByResourceGroupPager", tracked: true},
+	{method: http.MethodGet, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts$`), api: "AccountsClient.NewListBySubscriptionPager", tracked: true},
+	{method: http.MethodPatch, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts/(?P<accountName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)$`), api: "AccountsClient.BeginUpdate", tracked: true},
+}
+
+// NewAccountsServerHandler creates an http.Handler that serves the fakes in srv over HTTP.
+// Requests are dispatched through AccountsServerTransport so pagers and pollers behave the same as in-process.
+func NewAccountsServerHandler(srv *AccountsServer) http.Handler {
+	return newTransportHandler(NewAccountsServerTransport(srv), accountsServerRoutes)
+}
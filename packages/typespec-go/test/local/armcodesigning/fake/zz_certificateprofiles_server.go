@@ -334,3 +334,17 @@ var certificateProfilesServerTransportInterceptor interface {
 	// Do returns true if the server transport should use the returned response/error
 	Do(*http.Request) (*http.Response, error, bool)
 }
+
+var certificateProfilesServerRoutes = []route{
+	{method: http.MethodPut, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts/(?P<accountName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/certificateProfiles/(?P<profileName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)$`), api: "CertificateProfilesClient.BeginCreate", tracked: true},
+	{method: http.MethodDelete, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts/(?P<accountName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/certificateProfiles/(?P<profileName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)$`), api: "CertificateProfilesClient.BeginDelete", tracked: true},
+	{method: http.MethodGet, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts/(?P<accountName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/certificateProfiles/(?P<profileName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)$`), api: "CertificateProfilesClient.Get"},
+	{method: http.MethodGet, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts/(?P<accountName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/certificateProfiles$`), api: "CertificateProfilesClient.NewListByCodeSigningAccountPager", tracked: true},
+	{method: http.MethodPost, path: regexp.MustCompile(`/subscriptions/(?P<subscriptionId>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourceGroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/Microsoft\.CodeSigning/codeSigningAccounts/(?P<accountName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/certificateProfiles/(?P<profileName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/revokeCertificate$`), api: "CertificateProfilesClient.RevokeCertificate"},
+}
+
+// NewCertificateProfilesServerHandler creates an http.Handler that serves the fakes in srv over HTTP.
+// Requests are dispatched through CertificateProfilesServerTransport so pagers and pollers behave the same as in-process.
+func NewCertificateProfilesServerHandler(srv *CertificateProfilesServer) http.Handler {
+	return newTransportHandler(NewCertificateProfilesServerTransport(srv), certificateProfilesServerRoutes)
+}
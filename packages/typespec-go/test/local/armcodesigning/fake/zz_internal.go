@@ -5,8 +5,17 @@
 package fake
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/fake/server"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"sync"
 )
 
@@ -23,6 +32,111 @@ func (nonRetriableError) NonRetriable() {
 	// marker method
 }
 
+// NewTestServer starts a TLS httptest.Server that serves the provided handler.
+// Use the server's URL as the service endpoint and its Client as the
+// azcore.ClientOptions.Transport when constructing clients.
+// Call Close on the returned server to shut it down.
+func NewTestServer(handler http.Handler) *httptest.Server {
+	return httptest.NewTLSServer(handler)
+}
+
+type route struct {
+	method string
+	path   *regexp.Regexp
+	api    string
+	// true for pagers and pollers which send follow-up requests
+	tracked bool
+}
+
+// trackerDoneKey is the context key for the flag that's set
+// when a tracker removes a finished pager or poller
+type trackerDoneKey struct{}
+
+type transportHandler struct {
+	tr     policy.Transporter
+	routes []route
+	mu     sync.Mutex
+	// maps the sanitized path of a pager or poller to its API name
+	inFlight map[string]string
+}
+
+func newTransportHandler(tr policy.Transporter, routes ...[]route) *transportHandler {
+	h := &transportHandler{
+		tr:       tr,
+		inFlight: map[string]string{},
+	}
+	for _, r := range routes {
+		h.routes = append(h.routes, r...)
+	}
+	return h
+}
+
+func (h *transportHandler) apiName(req *http.Request) (string, bool) {
+	path := server.SanitizePagerPollerPath(req.URL.Path)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if path != req.URL.Path {
+		// follow-up request for a pager or poller
+		api, ok := h.inFlight[path]
+		return api, ok
+	}
+	for _, r := range h.routes {
+		if r.method == req.Method && r.path.MatchString(req.URL.EscapedPath()) {
+			if r.tracked {
+				h.inFlight[path] = r.api
+			}
+			return r.api, true
+		}
+	}
+	return "", false
+}
+
+func (h *transportHandler) removeInFlight(req *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.inFlight, server.SanitizePagerPollerPath(req.URL.Path))
+}
+
+// ServeHTTP implements the http.Handler interface for transportHandler.
+func (h *transportHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	api, ok := h.apiName(req)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no fake for %s %s", req.Method, req.URL.Path), http.StatusNotFound)
+		return
+	}
+
+	done := false
+	ctx := context.WithValue(req.Context(), runtime.CtxAPINameKey{}, api)
+	fakeReq := req.Clone(context.WithValue(ctx, trackerDoneKey{}, &done))
+	fakeReq.RequestURI = ""
+	fakeReq.URL.Host = req.Host
+	fakeReq.URL.Scheme = "http"
+	if req.TLS != nil {
+		fakeReq.URL.Scheme = "https"
+	}
+
+	resp, err := h.tr.Do(fakeReq)
+	if done {
+		// the pager or poller has finished so it no longer receives follow-up requests
+		h.removeInFlight(req)
+	}
+	if err != nil {
+		var respErr *azcore.ResponseError
+		if !errors.As(err, &respErr) || respErr.RawResponse == nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp = respErr.RawResponse
+	}
+	defer resp.Body.Close()
+
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
 func initServer[T any](mu *sync.Mutex, dst **T, src func() *T) {
 	mu.Lock()
 	if *dst == nil {
@@ -61,4 +175,7 @@ func (p *tracker[T]) remove(req *http.Request) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	delete(p.items, server.SanitizePagerPollerPath(req.URL.Path))
+	if done, ok := req.Context().Value(trackerDoneKey{}).(*bool); ok {
+		*done = true
+	}
 }
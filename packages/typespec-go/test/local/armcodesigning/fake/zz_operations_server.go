@@ -13,6 +13,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"net/http"
+	"regexp"
 	"slices"
 )
 
@@ -111,3 +112,13 @@ var operationsServerTransportInterceptor interface {
 	// Do returns true if the server transport should use the returned response/error
 	Do(*http.Request) (*http.Response, error, bool)
 }
+
+var operationsServerRoutes = []route{
+	{method: http.MethodGet, path: regexp.MustCompile(`/providers/Microsoft\.CodeSigning/operations$`), api: "OperationsClient.NewListPager", tracked: true},
+}
+
+// NewOperationsServerHandler creates an http.Handler that serves the fakes in srv over HTTP.
+// Requests are dispatched through OperationsServerTransport so pagers and pollers behave the same as in-process.
+func NewOperationsServerHandler(srv *OperationsServer) http.Handler {
+	return newTransportHandler(NewOperationsServerTransport(srv), operationsServerRoutes)
+}
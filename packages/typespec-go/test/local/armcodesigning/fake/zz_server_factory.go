@@ -23,6 +23,9 @@ type ServerFactory struct {
 
 	// OperationsServer contains the fakes for client OperationsClient
 	OperationsServer OperationsServer
+
+	// store contains the resources when created by NewInMemoryServer
+	store *inMemoryStore
 }
 
 // NewServerFactoryTransport creates a new instance of ServerFactoryTransport with the provided implementation.
@@ -34,6 +37,12 @@ func NewServerFactoryTransport(srv *ServerFactory) *ServerFactoryTransport {
 	}
 }
 
+// NewServerFactoryHandler creates an http.Handler that serves the fakes in srv over HTTP.
+// Requests are dispatched through ServerFactoryTransport so pagers and pollers behave the same as in-process.
+func NewServerFactoryHandler(srv *ServerFactory) http.Handler {
+	return newTransportHandler(NewServerFactoryTransport(srv), accountsServerRoutes, certificateProfilesServerRoutes, operationsServerRoutes)
+}
+
 // ServerFactoryTransport connects instances of armcodesigning.ClientFactory to instances of ServerFactory.
 // Don't use this type directly, use NewServerFactoryTransport instead.
 type ServerFactoryTransport struct {
@@ -72,6 +81,10 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 		err = fmt.Errorf("unhandled client %s", client)
 	}
 
+	if s.srv.store != nil {
+		resp, err = s.srv.store.handleResponse(req, resp, err)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,302 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package armcodesigning_test
+
+import (
+	"armcodesigning"
+	"armcodesigning/fake"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func newInMemoryClientFactory(t *testing.T, srv *fake.ServerFactory) *armcodesigning.ClientFactory {
+	factory, err := armcodesigning.NewClientFactory("00000000-0000-0000-0000-000000000000", &azfake.TokenCredential{}, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(srv),
+		},
+	})
+	require.NoError(t, err)
+	return factory
+}
+
+func TestInMemoryServerCRUD(t *testing.T) {
+	factory := newInMemoryClientFactory(t, fake.NewInMemoryServer("00000000-0000-0000-0000-000000000000"))
+	client := factory.NewAccountsClient()
+
+	_, err := client.Get(context.Background(), "rg", "account1", nil)
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusNotFound, respErr.StatusCode)
+	require.Equal(t, "ResourceNotFound", respErr.ErrorCode)
+
+	poller, err := client.BeginCreate(context.Background(), "rg", "account1", armcodesigning.Account{
+		Location: to.Ptr("westus"),
+		Properties: &armcodesigning.AccountProperties{
+			SKU: &armcodesigning.AccountSKU{Name: to.Ptr(armcodesigning.SKUNameBasic)},
+		},
+	}, nil)
+	require.NoError(t, err)
+	require.False(t, poller.Done())
+	created, err := poller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.CodeSigning/codeSigningAccounts/account1", *created.ID)
+	require.Equal(t, "account1", *created.Name)
+	require.Equal(t, "Microsoft.CodeSigning/codeSigningAccounts", *created.Type)
+	require.Equal(t, armcodesigning.ProvisioningStateSucceeded, *created.Properties.ProvisioningState)
+
+	got, err := client.Get(context.Background(), "RG", "Account1", nil)
+	require.NoError(t, err)
+	require.Equal(t, created.Account, got.Account)
+
+	updatePoller, err := client.BeginUpdate(context.Background(), "rg", "account1", armcodesigning.AccountPatch{
+		Properties: &armcodesigning.AccountPatchProperties{
+			SKU: &armcodesigning.AccountSKUPatch{Name: to.Ptr(armcodesigning.SKUNamePremium)},
+		},
+		Tags: map[string]*string{"env": to.Ptr("test")},
+	}, nil)
+	require.NoError(t, err)
+	updated, err := updatePoller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, armcodesigning.SKUNamePremium, *updated.Properties.SKU.Name)
+	require.Equal(t, "westus", *updated.Location)
+	require.Equal(t, "test", *updated.Tags["env"])
+
+	deletePoller, err := client.BeginDelete(context.Background(), "rg", "account1", nil)
+	require.NoError(t, err)
+	_, err = deletePoller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+
+	_, err = client.Get(context.Background(), "rg", "account1", nil)
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusNotFound, respErr.StatusCode)
+}
+
+func TestInMemoryServerProvisioningState(t *testing.T) {
+	factory := newInMemoryClientFactory(t, fake.NewInMemoryServer("00000000-0000-0000-0000-000000000000"))
+	client := factory.NewAccountsClient()
+
+	poller, err := client.BeginCreate(context.Background(), "rg", "account1", armcodesigning.Account{Location: to.Ptr("westus")}, nil)
+	require.NoError(t, err)
+	got, err := client.Get(context.Background(), "rg", "account1", nil)
+	require.NoError(t, err)
+	require.Equal(t, armcodesigning.ProvisioningStateAccepted, *got.Properties.ProvisioningState)
+	created, err := poller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, armcodesigning.ProvisioningStateSucceeded, *created.Properties.ProvisioningState)
+	got, err = client.Get(context.Background(), "rg", "account1", nil)
+	require.NoError(t, err)
+	require.Equal(t, armcodesigning.ProvisioningStateSucceeded, *got.Properties.ProvisioningState)
+
+	updatePoller, err := client.BeginUpdate(context.Background(), "rg", "account1", armcodesigning.AccountPatch{
+		Tags: map[string]*string{"env": to.Ptr("test")},
+	}, nil)
+	require.NoError(t, err)
+	got, err = client.Get(context.Background(), "rg", "account1", nil)
+	require.NoError(t, err)
+	require.Equal(t, armcodesigning.ProvisioningStateUpdating, *got.Properties.ProvisioningState)
+	updated, err := updatePoller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, armcodesigning.ProvisioningStateSucceeded, *updated.Properties.ProvisioningState)
+	got, err = client.Get(context.Background(), "rg", "account1", nil)
+	require.NoError(t, err)
+	require.Equal(t, armcodesigning.ProvisioningStateSucceeded, *got.Properties.ProvisioningState)
+}
+
+func TestInMemoryServerErrors(t *testing.T) {
+	factory := newInMemoryClientFactory(t, fake.NewInMemoryServer("00000000-0000-0000-0000-000000000000"))
+
+	// unknown resources return an ARM error body
+	_, err := factory.NewAccountsClient().Get(context.Background(), "rg", "missing", nil)
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusNotFound, respErr.StatusCode)
+	require.Equal(t, "ResourceNotFound", respErr.ErrorCode)
+	body, err := runtime.Payload(respErr.RawResponse)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"error":{"code":"ResourceNotFound","message":"The resource /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.CodeSigning/codeSigningAccounts/missing was not found."}}`, string(body))
+
+	// child resources can't be created without their parent
+	_, err = factory.NewCertificateProfilesClient().BeginCreate(context.Background(), "rg", "missing", "profile1", armcodesigning.CertificateProfile{}, nil)
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusNotFound, respErr.StatusCode)
+	require.Equal(t, "ParentResourceNotFound", respErr.ErrorCode)
+	body, err = runtime.Payload(respErr.RawResponse)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"error":{"code":"ParentResourceNotFound","message":"The parent resource /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.CodeSigning/codeSigningAccounts/missing was not found."}}`, string(body))
+}
+
+func TestInMemoryServerList(t *testing.T) {
+	factory := newInMemoryClientFactory(t, fake.NewInMemoryServer("00000000-0000-0000-0000-000000000000"))
+	accounts := factory.NewAccountsClient()
+	profiles := factory.NewCertificateProfilesClient()
+
+	for _, acct := range []struct{ rg, name string }{{"rg1", "b"}, {"rg1", "a"}, {"rg2", "c"}} {
+		poller, err := accounts.BeginCreate(context.Background(), acct.rg, acct.name, armcodesigning.Account{Location: to.Ptr("westus")}, nil)
+		require.NoError(t, err)
+		_, err = poller.PollUntilDone(context.Background(), nil)
+		require.NoError(t, err)
+	}
+	poller, err := profiles.BeginCreate(context.Background(), "rg1", "a", "profile1", armcodesigning.CertificateProfile{
+		Properties: &armcodesigning.CertificateProfileProperties{
+			IdentityValidationID: to.Ptr("id"),
+			ProfileType:          to.Ptr(armcodesigning.ProfileTypePublicTrust),
+		},
+	}, nil)
+	require.NoError(t, err)
+	profile, err := poller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles", *profile.Type)
+
+	var names []string
+	rgPager := accounts.NewListByResourceGroupPager("rg1", nil)
+	for rgPager.More() {
+		page, err := rgPager.NextPage(context.Background())
+		require.NoError(t, err)
+		for _, item := range page.Value {
+			names = append(names, *item.Name)
+		}
+	}
+	require.Equal(t, []string{"a", "b"}, names)
+
+	names = nil
+	subPager := accounts.NewListBySubscriptionPager(nil)
+	for subPager.More() {
+		page, err := subPager.NextPage(context.Background())
+		require.NoError(t, err)
+		for _, item := range page.Value {
+			names = append(names, *item.Name)
+		}
+	}
+	require.Equal(t, []string{"a", "b", "c"}, names)
+
+	profilePager := profiles.NewListByCodeSigningAccountPager("rg1", "a", nil)
+	page, err := profilePager.NextPage(context.Background())
+	require.NoError(t, err)
+	require.Len(t, page.Value, 1)
+	require.Equal(t, "profile1", *page.Value[0].Name)
+
+	// deleting an account removes its certificate profiles
+	deletePoller, err := accounts.BeginDelete(context.Background(), "rg1", "a", nil)
+	require.NoError(t, err)
+	_, err = deletePoller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+	_, err = profiles.Get(context.Background(), "rg1", "a", "profile1", nil)
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, "ResourceNotFound", respErr.ErrorCode)
+}
+
+func TestInMemoryServerOverride(t *testing.T) {
+	srv := fake.NewInMemoryServer("00000000-0000-0000-0000-000000000000")
+	srv.AccountsServer.Get = func(ctx context.Context, resourceGroupName string, accountName string, options *armcodesigning.AccountsClientGetOptions) (resp azfake.Responder[armcodesigning.AccountsClientGetResponse], errResp azfake.ErrorResponder) {
+		errResp.SetError(errors.New("injected failure"))
+		return
+	}
+	factory := newInMemoryClientFactory(t, srv)
+	client := factory.NewAccountsClient()
+
+	poller, err := client.BeginCreate(context.Background(), "rg", "account1", armcodesigning.Account{Location: to.Ptr("westus")}, nil)
+	require.NoError(t, err)
+	_, err = poller.PollUntilDone(context.Background(), nil)
+	require.NoError(t, err)
+
+	_, err = client.Get(context.Background(), "rg", "account1", nil)
+	require.ErrorContains(t, err, "injected failure")
+}
+
+func TestServerFactoryHandler(t *testing.T) {
+	srv := fake.NewTestServer(fake.NewServerFactoryHandler(fake.NewInMemoryServer("00000000-0000-0000-0000-000000000000")))
+	defer srv.Close()
+
+	factory, err := armcodesigning.NewClientFactory("00000000-0000-0000-0000-000000000000", &azfake.TokenCredential{}, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Cloud: cloud.Configuration{
+				Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+					cloud.ResourceManager: {
+						Audience: "https://management.core.windows.net",
+						Endpoint: srv.URL,
+					},
+				},
+			},
+			Transport: srv.Client(),
+		},
+	})
+	require.NoError(t, err)
+	client := factory.NewAccountsClient()
+
+	for _, name := range []string{"account1", "account2"} {
+		poller, err := client.BeginCreate(context.Background(), "rg", name, armcodesigning.Account{Location: to.Ptr("westus")}, nil)
+		require.NoError(t, err)
+		_, err = poller.PollUntilDone(context.Background(), &runtime.PollUntilDoneOptions{Frequency: time.Millisecond})
+		require.NoError(t, err)
+	}
+
+	pager := client.NewListByResourceGroupPager("rg", nil)
+	var names []string
+	for pager.More() {
+		page, err := pager.NextPage(context.Background())
+		require.NoError(t, err)
+		for _, item := range page.Value {
+			names = append(names, *item.Name)
+		}
+	}
+	require.Equal(t, []string{"account1", "account2"}, names)
+
+	// follow-up requests for finished pagers and pollers aren't dispatched
+	accountsPath := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.CodeSigning/codeSigningAccounts"
+	for _, path := range []string{accountsPath + "/account1/get/fake/status", accountsPath + "/fake_page_1"} {
+		resp, err := srv.Client().Get(srv.URL + path)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+		require.Contains(t, string(body), "no fake for GET")
+	}
+
+	_, err = client.Get(context.Background(), "rg", "missing", nil)
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusNotFound, respErr.StatusCode)
+	require.Equal(t, "ResourceNotFound", respErr.ErrorCode)
+
+	// plain HTTP callers see the same resources
+	resp, err := srv.Client().Get(srv.URL + "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.CodeSigning/codeSigningAccounts/account1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var account armcodesigning.Account
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&account))
+	require.Equal(t, "account1", *account.Name)
+
+	resp, err = srv.Client().Get(srv.URL + "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.CodeSigning/codeSigningAccounts/missing")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	var armErr struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&armErr))
+	require.Equal(t, "ResourceNotFound", armErr.Error.Code)
+
+	resp, err = srv.Client().Get(srv.URL + "/unknown")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package armcodesigning_test
+
+import (
+	"armcodesigning"
+	"armcodesigning/fake"
+	"armcodesigning/recording"
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingTransport(t *testing.T) {
+	dir := t.TempDir()
+	run := func(mode recording.Mode, transport policy.Transporter) []string {
+		tr, err := recording.NewTransport(t.Name(), &recording.TransportOptions{
+			Dir:       dir,
+			Mode:      mode,
+			Transport: transport,
+		})
+		require.NoError(t, err)
+		factory, err := armcodesigning.NewClientFactory("00000000-0000-0000-0000-000000000000", &azfake.TokenCredential{}, &arm.ClientOptions{
+			ClientOptions: azcore.ClientOptions{Transport: tr},
+		})
+		require.NoError(t, err)
+		client := factory.NewAccountsClient()
+
+		poller, err := client.BeginCreate(context.Background(), "rg", "account1", armcodesigning.Account{Location: to.Ptr("westus")}, nil)
+		require.NoError(t, err)
+		_, err = poller.PollUntilDone(context.Background(), nil)
+		require.NoError(t, err)
+
+		var names []string
+		pager := client.NewListByResourceGroupPager("rg", nil)
+		for pager.More() {
+			page, err := pager.NextPage(context.Background())
+			require.NoError(t, err)
+			for _, item := range page.Value {
+				names = append(names, *item.Name)
+			}
+		}
+		got, err := client.Get(context.Background(), "rg", "account1", nil)
+		require.NoError(t, err)
+		names = append(names, *got.Name)
+		require.NoError(t, tr.Stop())
+		return names
+	}
+
+	recorded := run(recording.ModeRecord, fake.NewServerFactoryTransport(fake.NewInMemoryServer("00000000-0000-0000-0000-000000000000")))
+	require.Equal(t, []string{"account1", "account1"}, recorded)
+
+	// playback doesn't send any requests
+	replayed := run(recording.ModePlayback, nil)
+	require.Equal(t, recorded, replayed)
+
+	// requests that weren't recorded fail
+	tr, err := recording.NewTransport(t.Name(), &recording.TransportOptions{Dir: dir})
+	require.NoError(t, err)
+	client, err := armcodesigning.NewAccountsClient("00000000-0000-0000-0000-000000000000", &azfake.TokenCredential{}, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{Transport: tr},
+	})
+	require.NoError(t, err)
+	_, err = client.Get(context.Background(), "rg", "account2", nil)
+	require.ErrorContains(t, err, "no recorded response")
+	require.ErrorContains(t, err, `path params: got map[accountName:account2 resourceGroupName:rg subscriptionId:00000000-0000-0000-0000-000000000000], recorded map[accountName:account1`)
+
+	// requests are also matched on their body
+	_, err = client.BeginCreate(context.Background(), "rg", "account1", armcodesigning.Account{Location: to.Ptr("eastus")}, nil)
+	require.ErrorContains(t, err, "no recorded response")
+	require.ErrorContains(t, err, `request body: got {"location":"eastus"}, recorded {"location":"westus"}`)
+	_, err = client.BeginCreate(context.Background(), "rg", "account1", armcodesigning.Account{Location: to.Ptr("westus")}, nil)
+	require.NoError(t, err)
+}
@@ -6,20 +6,37 @@ package armcodesigning_test
 
 import (
 	"armcodesigning"
+	"armcodesigning/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-09-30-preview/CodeSigningAccounts_CheckNameAvailability.json
 func ExampleAccountsClient_CheckNameAvailability() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					CheckNameAvailability: func(ctx context.Context, body armcodesigning.CheckNameAvailability, options *armcodesigning.AccountsClientCheckNameAvailabilityOptions) (resp azfake.Responder[armcodesigning.AccountsClientCheckNameAvailabilityResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcodesigning.AccountsClientCheckNameAvailabilityResponse{
+							CheckNameAvailabilityResult: armcodesigning.CheckNameAvailabilityResult{
+								NameAvailable: to.Ptr(true),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,24 +46,40 @@ func ExampleAccountsClient_CheckNameAvailability() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcodesigning.AccountsClientCheckNameAvailabilityResponse{
-	// 	CheckNameAvailabilityResult: armcodesigning.CheckNameAvailabilityResult{
-	// 		NameAvailable: to.Ptr(true),
-	// 	},
-	// }
+	fmt.Println("NameAvailable:", *res.NameAvailable)
+	// Output:
+	// NameAvailable: true
 }
 
 // Generated from example definition: 2024-09-30-preview/CodeSigningAccounts_Create.json
 func ExampleAccountsClient_BeginCreate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, accountName string, resource armcodesigning.Account, options *armcodesigning.AccountsClientBeginCreateOptions) (resp azfake.PollerResponder[armcodesigning.AccountsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcodesigning.AccountsClientCreateResponse{
+							Account: armcodesigning.Account{
+								Name:     to.Ptr("MyAccount"),
+								Type:     to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
+								ID:       to.Ptr("/subscriptions/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
+								Location: to.Ptr("westus"),
+								Properties: &armcodesigning.AccountProperties{
+									ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
+									SKU: &armcodesigning.AccountSKU{
+										Name: to.Ptr(armcodesigning.SKUNameBasic),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -65,33 +98,33 @@ func ExampleAccountsClient_BeginCreate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcodesigning.AccountsClientCreateResponse{
-	// 	Account: armcodesigning.Account{
-	// 		Name: to.Ptr("MyAccount"),
-	// 		Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
-	// 		ID: to.Ptr("/subscriptions/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armcodesigning.AccountProperties{
-	// 			ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
-	// 			SKU: &armcodesigning.AccountSKU{
-	// 				Name: to.Ptr(armcodesigning.SKUNameBasic),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: MyAccount
+	// Type: Microsoft.CodeSigning/codeSigningAccounts
+	// ID: /subscriptions/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount
+	// Location: westus
 }
 
 // Generated from example definition: 2024-09-30-preview/CodeSigningAccounts_Delete.json
 func ExampleAccountsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, options *armcodesigning.AccountsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcodesigning.AccountsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcodesigning.AccountsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -103,16 +136,41 @@ func ExampleAccountsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-09-30-preview/CodeSigningAccounts_Get.json
 func ExampleAccountsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, options *armcodesigning.AccountsClientGetOptions) (resp azfake.Responder[armcodesigning.AccountsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcodesigning.AccountsClientGetResponse{
+							Account: armcodesigning.Account{
+								Name:     to.Ptr("MyAccount"),
+								Type:     to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
+								Location: to.Ptr("westus"),
+								Properties: &armcodesigning.AccountProperties{
+									ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
+									SKU: &armcodesigning.AccountSKU{
+										Name: to.Ptr(armcodesigning.SKUNameBasic),
+									},
+								},
+								Tags: map[string]*string{
+									"key1": to.Ptr("value1"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -120,36 +178,53 @@ func ExampleAccountsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcodesigning.AccountsClientGetResponse{
-	// 	Account: armcodesigning.Account{
-	// 		Name: to.Ptr("MyAccount"),
-	// 		Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armcodesigning.AccountProperties{
-	// 			ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
-	// 			SKU: &armcodesigning.AccountSKU{
-	// 				Name: to.Ptr(armcodesigning.SKUNameBasic),
-	// 			},
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"key1": to.Ptr("value1"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: MyAccount
+	// Type: Microsoft.CodeSigning/codeSigningAccounts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount
+	// Location: westus
 }
 
 // Generated from example definition: 2024-09-30-preview/CodeSigningAccounts_ListByResourceGroup.json
 func ExampleAccountsClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armcodesigning.AccountsClientListByResourceGroupOptions) (resp azfake.PagerResponder[armcodesigning.AccountsClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armcodesigning.AccountsClientListByResourceGroupResponse{
+							AccountListResult: armcodesigning.AccountListResult{
+								Value: []*armcodesigning.Account{
+									{
+										Name:     to.Ptr("alpha"),
+										Type:     to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
+										Location: to.Ptr("westcentralus"),
+										Properties: &armcodesigning.AccountProperties{
+											ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
+											SKU: &armcodesigning.AccountSKU{
+												Name: to.Ptr(armcodesigning.SKUNameBasic),
+											},
+										},
+										Tags: map[string]*string{
+											"key1": to.Ptr("value1"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -160,42 +235,55 @@ func ExampleAccountsClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcodesigning.AccountsClientListByResourceGroupResponse{
-		// 	AccountListResult: armcodesigning.AccountListResult{
-		// 		Value: []*armcodesigning.Account{
-		// 			{
-		// 				Name: to.Ptr("alpha"),
-		// 				Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
-		// 				Location: to.Ptr("westcentralus"),
-		// 				Properties: &armcodesigning.AccountProperties{
-		// 					ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
-		// 					SKU: &armcodesigning.AccountSKU{
-		// 						Name: to.Ptr(armcodesigning.SKUNameBasic),
-		// 					},
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"key1": to.Ptr("value1"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: alpha
+	// Type: Microsoft.CodeSigning/codeSigningAccounts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount
+	// Location: westcentralus
 }
 
 // Generated from example definition: 2024-09-30-preview/CodeSigningAccounts_ListBySubscription.json
 func ExampleAccountsClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					NewListBySubscriptionPager: func(options *armcodesigning.AccountsClientListBySubscriptionOptions) (resp azfake.PagerResponder[armcodesigning.AccountsClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armcodesigning.AccountsClientListBySubscriptionResponse{
+							AccountListResult: armcodesigning.AccountListResult{
+								Value: []*armcodesigning.Account{
+									{
+										Name:     to.Ptr("alpha"),
+										Type:     to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
+										Location: to.Ptr("westus"),
+										Properties: &armcodesigning.AccountProperties{
+											ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
+											SKU: &armcodesigning.AccountSKU{
+												Name: to.Ptr(armcodesigning.SKUNameBasic),
+											},
+										},
+										Tags: map[string]*string{
+											"key1": to.Ptr("value1"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -206,42 +294,51 @@ func ExampleAccountsClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcodesigning.AccountsClientListBySubscriptionResponse{
-		// 	AccountListResult: armcodesigning.AccountListResult{
-		// 		Value: []*armcodesigning.Account{
-		// 			{
-		// 				Name: to.Ptr("alpha"),
-		// 				Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcodesigning.AccountProperties{
-		// 					ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
-		// 					SKU: &armcodesigning.AccountSKU{
-		// 						Name: to.Ptr(armcodesigning.SKUNameBasic),
-		// 					},
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"key1": to.Ptr("value1"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: alpha
+	// Type: Microsoft.CodeSigning/codeSigningAccounts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount
+	// Location: westus
 }
 
 // Generated from example definition: 2024-09-30-preview/CodeSigningAccounts_Update.json
 func ExampleAccountsClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, accountName string, properties armcodesigning.AccountPatch, options *armcodesigning.AccountsClientBeginUpdateOptions) (resp azfake.PollerResponder[armcodesigning.AccountsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcodesigning.AccountsClientUpdateResponse{
+							Account: armcodesigning.Account{
+								Name:     to.Ptr("MyAccount"),
+								Type:     to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
+								Location: to.Ptr("westus"),
+								Properties: &armcodesigning.AccountProperties{
+									ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
+									SKU: &armcodesigning.AccountSKU{
+										Name: to.Ptr(armcodesigning.SKUNameBasic),
+									},
+								},
+								Tags: map[string]*string{
+									"key1": to.Ptr("value1"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -257,24 +354,13 @@ func ExampleAccountsClient_BeginUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcodesigning.AccountsClientUpdateResponse{
-	// 	Account: armcodesigning.Account{
-	// 		Name: to.Ptr("MyAccount"),
-	// 		Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armcodesigning.AccountProperties{
-	// 			ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
-	// 			SKU: &armcodesigning.AccountSKU{
-	// 				Name: to.Ptr(armcodesigning.SKUNameBasic),
-	// 			},
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"key1": to.Ptr("value1"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: MyAccount
+	// Type: Microsoft.CodeSigning/codeSigningAccounts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount
+	// Location: westus
 }
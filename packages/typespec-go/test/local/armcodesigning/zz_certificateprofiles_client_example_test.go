@@ -6,21 +6,62 @@ package armcodesigning_test
 
 import (
 	"armcodesigning"
+	"armcodesigning/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 	"time"
 )
 
 // Generated from example definition: 2024-09-30-preview/CertificateProfiles_Create.json
 func ExampleCertificateProfilesClient_BeginCreate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CertificateProfilesServer: fake.CertificateProfilesServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, accountName string, profileName string, resource armcodesigning.CertificateProfile, options *armcodesigning.CertificateProfilesClientBeginCreateOptions) (resp azfake.PollerResponder[armcodesigning.CertificateProfilesClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcodesigning.CertificateProfilesClientCreateResponse{
+							CertificateProfile: armcodesigning.CertificateProfile{
+								Name: to.Ptr("profileA"),
+								Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles"),
+								ID:   to.Ptr("/subscriptions/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount/certificateProfiles/profileA"),
+								Properties: &armcodesigning.CertificateProfileProperties{
+									Certificates: []*armcodesigning.Certificate{
+										{
+											CreatedDate:      to.Ptr("3/14/2023 5:27:49 PM"),
+											ExpiryDate:       to.Ptr("3/17/2023 5:27:49 PM"),
+											EnhancedKeyUsage: to.Ptr("1.3.6.1.4.1.311.yy.xxxxxxxx.xxxxxxxx.xxxxxxxxx.xxxxxxxx"),
+											SerialNumber:     to.Ptr("xxxxxxxxxxxxxxxxxx"),
+											Status:           to.Ptr(armcodesigning.CertificateStatusActive),
+											SubjectName:      to.Ptr("CN=Contoso Inc, O=Contoso Inc, L=New York, S=New York, C=US"),
+											Thumbprint:       to.Ptr("xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"),
+										},
+									},
+									IdentityValidationID: to.Ptr("00000000-1234-5678-3333-444444444444"),
+									IncludeCity:          to.Ptr(false),
+									IncludeCountry:       to.Ptr(false),
+									IncludePostalCode:    to.Ptr(true),
+									IncludeState:         to.Ptr(false),
+									IncludeStreetAddress: to.Ptr(false),
+									ProfileType:          to.Ptr(armcodesigning.ProfileTypePublicTrust),
+									ProvisioningState:    to.Ptr(armcodesigning.ProvisioningStateSucceeded),
+									Status:               to.Ptr(armcodesigning.CertificateProfileStatusActive),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -39,48 +80,31 @@ func ExampleCertificateProfilesClient_BeginCreate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcodesigning.CertificateProfilesClientCreateResponse{
-	// 	CertificateProfile: armcodesigning.CertificateProfile{
-	// 		Name: to.Ptr("profileA"),
-	// 		Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles"),
-	// 		ID: to.Ptr("/subscriptions/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount/certificateProfiles/profileA"),
-	// 		Properties: &armcodesigning.CertificateProfileProperties{
-	// 			Certificates: []*armcodesigning.Certificate{
-	// 				{
-	// 					CreatedDate: to.Ptr("3/14/2023 5:27:49 PM"),
-	// 					ExpiryDate: to.Ptr("3/17/2023 5:27:49 PM"),
-	// 					EnhancedKeyUsage: to.Ptr("1.3.6.1.4.1.311.yy.xxxxxxxx.xxxxxxxx.xxxxxxxxx.xxxxxxxx"),
-	// 					SerialNumber: to.Ptr("xxxxxxxxxxxxxxxxxx"),
-	// 					Status: to.Ptr(armcodesigning.CertificateStatusActive),
-	// 					SubjectName: to.Ptr("CN=Contoso Inc, O=Contoso Inc, L=New York, S=New York, C=US"),
-	// 					Thumbprint: to.Ptr("xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"),
-	// 				},
-	// 			},
-	// 			IdentityValidationID: to.Ptr("00000000-1234-5678-3333-444444444444"),
-	// 			IncludeCity: to.Ptr(false),
-	// 			IncludeCountry: to.Ptr(false),
-	// 			IncludePostalCode: to.Ptr(true),
-	// 			IncludeState: to.Ptr(false),
-	// 			IncludeStreetAddress: to.Ptr(false),
-	// 			ProfileType: to.Ptr(armcodesigning.ProfileTypePublicTrust),
-	// 			ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
-	// 			Status: to.Ptr(armcodesigning.CertificateProfileStatusActive),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: profileA
+	// Type: Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles
+	// ID: /subscriptions/xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount/certificateProfiles/profileA
 }
 
 // Generated from example definition: 2024-09-30-preview/CertificateProfiles_Delete.json
 func ExampleCertificateProfilesClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CertificateProfilesServer: fake.CertificateProfilesServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, profileName string, options *armcodesigning.CertificateProfilesClientBeginDeleteOptions) (resp azfake.PollerResponder[armcodesigning.CertificateProfilesClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcodesigning.CertificateProfilesClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -92,16 +116,53 @@ func ExampleCertificateProfilesClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-09-30-preview/CertificateProfiles_Get.json
 func ExampleCertificateProfilesClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CertificateProfilesServer: fake.CertificateProfilesServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, profileName string, options *armcodesigning.CertificateProfilesClientGetOptions) (resp azfake.Responder[armcodesigning.CertificateProfilesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcodesigning.CertificateProfilesClientGetResponse{
+							CertificateProfile: armcodesigning.CertificateProfile{
+								Name: to.Ptr("profileA"),
+								Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount/certificateProfiles/profileA"),
+								Properties: &armcodesigning.CertificateProfileProperties{
+									Certificates: []*armcodesigning.Certificate{
+										{
+											CreatedDate:      to.Ptr("3/14/2023 5:27:49 PM"),
+											ExpiryDate:       to.Ptr("3/17/2023 5:27:49 PM"),
+											EnhancedKeyUsage: to.Ptr("1.3.6.1.4.1.311.yy.xxxxxxxx.xxxxxxxx.xxxxxxxxx.xxxxxxxx"),
+											SerialNumber:     to.Ptr("xxxxxxxxxxxxxxxxxx"),
+											Status:           to.Ptr(armcodesigning.CertificateStatusActive),
+											SubjectName:      to.Ptr("CN=Contoso Inc, O=Contoso Inc, L=New York, S=New York, C=US"),
+											Thumbprint:       to.Ptr("xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"),
+										},
+									},
+									IdentityValidationID: to.Ptr("123456"),
+									IncludeCity:          to.Ptr(false),
+									IncludeCountry:       to.Ptr(false),
+									IncludePostalCode:    to.Ptr(true),
+									IncludeState:         to.Ptr(false),
+									IncludeStreetAddress: to.Ptr(false),
+									ProfileType:          to.Ptr(armcodesigning.ProfileTypePublicTrust),
+									ProvisioningState:    to.Ptr(armcodesigning.ProvisioningStateSucceeded),
+									Status:               to.Ptr(armcodesigning.CertificateProfileStatusActive),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -109,48 +170,63 @@ func ExampleCertificateProfilesClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcodesigning.CertificateProfilesClientGetResponse{
-	// 	CertificateProfile: armcodesigning.CertificateProfile{
-	// 		Name: to.Ptr("profileA"),
-	// 		Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount/certificateProfiles/profileA"),
-	// 		Properties: &armcodesigning.CertificateProfileProperties{
-	// 			Certificates: []*armcodesigning.Certificate{
-	// 				{
-	// 					CreatedDate: to.Ptr("3/14/2023 5:27:49 PM"),
-	// 					ExpiryDate: to.Ptr("3/17/2023 5:27:49 PM"),
-	// 					EnhancedKeyUsage: to.Ptr("1.3.6.1.4.1.311.yy.xxxxxxxx.xxxxxxxx.xxxxxxxxx.xxxxxxxx"),
-	// 					SerialNumber: to.Ptr("xxxxxxxxxxxxxxxxxx"),
-	// 					Status: to.Ptr(armcodesigning.CertificateStatusActive),
-	// 					SubjectName: to.Ptr("CN=Contoso Inc, O=Contoso Inc, L=New York, S=New York, C=US"),
-	// 					Thumbprint: to.Ptr("xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"),
-	// 				},
-	// 			},
-	// 			IdentityValidationID: to.Ptr("123456"),
-	// 			IncludeCity: to.Ptr(false),
-	// 			IncludeCountry: to.Ptr(false),
-	// 			IncludePostalCode: to.Ptr(true),
-	// 			IncludeState: to.Ptr(false),
-	// 			IncludeStreetAddress: to.Ptr(false),
-	// 			ProfileType: to.Ptr(armcodesigning.ProfileTypePublicTrust),
-	// 			ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
-	// 			Status: to.Ptr(armcodesigning.CertificateProfileStatusActive),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: profileA
+	// Type: Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/MyAccount/certificateProfiles/profileA
 }
 
 // Generated from example definition: 2024-09-30-preview/CertificateProfiles_ListByCodeSigningAccount.json
 func ExampleCertificateProfilesClient_NewListByCodeSigningAccountPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CertificateProfilesServer: fake.CertificateProfilesServer{
+					NewListByCodeSigningAccountPager: func(resourceGroupName string, accountName string, options *armcodesigning.CertificateProfilesClientListByCodeSigningAccountOptions) (resp azfake.PagerResponder[armcodesigning.CertificateProfilesClientListByCodeSigningAccountResponse]) {
+						resp.AddPage(http.StatusOK, armcodesigning.CertificateProfilesClientListByCodeSigningAccountResponse{
+							CertificateProfileListResult: armcodesigning.CertificateProfileListResult{
+								Value: []*armcodesigning.CertificateProfile{
+									{
+										Name: to.Ptr("profileA"),
+										Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/profileA"),
+										Properties: &armcodesigning.CertificateProfileProperties{
+											Certificates: []*armcodesigning.Certificate{
+												{
+													CreatedDate:      to.Ptr("3/14/2023 5:27:49 PM"),
+													ExpiryDate:       to.Ptr("3/17/2023 5:27:49 PM"),
+													EnhancedKeyUsage: to.Ptr("1.3.6.1.4.1.311.yy.xxxxxxxx.xxxxxxxx.xxxxxxxxx.xxxxxxxx"),
+													SerialNumber:     to.Ptr("xxxxxxxxxxxxxxxxxx"),
+													Status:           to.Ptr(armcodesigning.CertificateStatusActive),
+													SubjectName:      to.Ptr("CN=Contoso Inc, O=Contoso Inc, L=New York, S=New York, C=US"),
+													Thumbprint:       to.Ptr("xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"),
+												},
+											},
+											IdentityValidationID: to.Ptr("123456"),
+											IncludeCity:          to.Ptr(false),
+											IncludeCountry:       to.Ptr(false),
+											IncludePostalCode:    to.Ptr(true),
+											IncludeState:         to.Ptr(false),
+											IncludeStreetAddress: to.Ptr(false),
+											ProfileType:          to.Ptr(armcodesigning.ProfileTypePublicTrust),
+											ProvisioningState:    to.Ptr(armcodesigning.ProvisioningStateSucceeded),
+											Status:               to.Ptr(armcodesigning.CertificateProfileStatusActive),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -161,54 +237,33 @@ func ExampleCertificateProfilesClient_NewListByCodeSigningAccountPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcodesigning.CertificateProfilesClientListByCodeSigningAccountResponse{
-		// 	CertificateProfileListResult: armcodesigning.CertificateProfileListResult{
-		// 		Value: []*armcodesigning.CertificateProfile{
-		// 			{
-		// 				Name: to.Ptr("profileA"),
-		// 				Type: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/profileA"),
-		// 				Properties: &armcodesigning.CertificateProfileProperties{
-		// 					Certificates: []*armcodesigning.Certificate{
-		// 						{
-		// 							CreatedDate: to.Ptr("3/14/2023 5:27:49 PM"),
-		// 							ExpiryDate: to.Ptr("3/17/2023 5:27:49 PM"),
-		// 							EnhancedKeyUsage: to.Ptr("1.3.6.1.4.1.311.yy.xxxxxxxx.xxxxxxxx.xxxxxxxxx.xxxxxxxx"),
-		// 							SerialNumber: to.Ptr("xxxxxxxxxxxxxxxxxx"),
-		// 							Status: to.Ptr(armcodesigning.CertificateStatusActive),
-		// 							SubjectName: to.Ptr("CN=Contoso Inc, O=Contoso Inc, L=New York, S=New York, C=US"),
-		// 							Thumbprint: to.Ptr("xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"),
-		// 						},
-		// 					},
-		// 					IdentityValidationID: to.Ptr("123456"),
-		// 					IncludeCity: to.Ptr(false),
-		// 					IncludeCountry: to.Ptr(false),
-		// 					IncludePostalCode: to.Ptr(true),
-		// 					IncludeState: to.Ptr(false),
-		// 					IncludeStreetAddress: to.Ptr(false),
-		// 					ProfileType: to.Ptr(armcodesigning.ProfileTypePublicTrust),
-		// 					ProvisioningState: to.Ptr(armcodesigning.ProvisioningStateSucceeded),
-		// 					Status: to.Ptr(armcodesigning.CertificateProfileStatusActive),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: profileA
+	// Type: Microsoft.CodeSigning/codeSigningAccounts/certificateProfiles
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/MyResourceGroup/providers/Microsoft.CodeSigning/codeSigningAccounts/profileA
 }
 
 // Generated from example definition: 2024-09-30-preview/CertificateProfiles_RevokeCertificate.json
 func ExampleCertificateProfilesClient_RevokeCertificate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CertificateProfilesServer: fake.CertificateProfilesServer{
+					RevokeCertificate: func(ctx context.Context, resourceGroupName string, accountName string, profileName string, body armcodesigning.RevokeCertificate, options *armcodesigning.CertificateProfilesClientRevokeCertificateOptions) (resp azfake.Responder[armcodesigning.CertificateProfilesClientRevokeCertificateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusNoContent, armcodesigning.CertificateProfilesClientRevokeCertificateResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -222,4 +277,5 @@ func ExampleCertificateProfilesClient_RevokeCertificate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
+	// Output:
 }
@@ -6,19 +6,65 @@ package armcodesigning_test
 
 import (
 	"armcodesigning"
+	"armcodesigning/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-09-30-preview/Operations_List.json
 func ExampleOperationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcodesigning.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armcodesigning.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armcodesigning.OperationsClientListOptions) (resp azfake.PagerResponder[armcodesigning.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcodesigning.OperationsClientListResponse{
+							OperationListResult: armcodesigning.OperationListResult{
+								Value: []*armcodesigning.Operation{
+									{
+										Name: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/write"),
+										Display: &armcodesigning.OperationDisplay{
+											Provider:    to.Ptr("Microsoft.CodeSigning"),
+											Resource:    to.Ptr("codeSigningAccounts"),
+											Operation:   to.Ptr("Create CodeSigningAccount"),
+											Description: to.Ptr("Create any CodeSigningAccount"),
+										},
+									},
+									{
+										Name: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/read"),
+										Display: &armcodesigning.OperationDisplay{
+											Provider:    to.Ptr("Microsoft.CodeSigning"),
+											Resource:    to.Ptr("codeSigningAccounts"),
+											Operation:   to.Ptr("Get CodeSigningAccount"),
+											Description: to.Ptr("Get any CodeSigningAccount"),
+										},
+									},
+									{
+										Name: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/delete"),
+										Display: &armcodesigning.OperationDisplay{
+											Provider:    to.Ptr("Microsoft.CodeSigning"),
+											Resource:    to.Ptr("codeSigningAccounts"),
+											Operation:   to.Ptr("Delete CodeSigningAccount"),
+											Description: to.Ptr("Delete any CodeSigningAccount"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,42 +75,11 @@ func ExampleOperationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcodesigning.OperationsClientListResponse{
-		// 	OperationListResult: armcodesigning.OperationListResult{
-		// 		Value: []*armcodesigning.Operation{
-		// 			{
-		// 				Name: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/write"),
-		// 				Display: &armcodesigning.OperationDisplay{
-		// 					Provider: to.Ptr("Microsoft.CodeSigning"),
-		// 					Resource: to.Ptr("codeSigningAccounts"),
-		// 					Operation: to.Ptr("Create CodeSigningAccount"),
-		// 					Description: to.Ptr("Create any CodeSigningAccount"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/read"),
-		// 				Display: &armcodesigning.OperationDisplay{
-		// 					Provider: to.Ptr("Microsoft.CodeSigning"),
-		// 					Resource: to.Ptr("codeSigningAccounts"),
-		// 					Operation: to.Ptr("Get CodeSigningAccount"),
-		// 					Description: to.Ptr("Get any CodeSigningAccount"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Microsoft.CodeSigning/codeSigningAccounts/delete"),
-		// 				Display: &armcodesigning.OperationDisplay{
-		// 					Provider: to.Ptr("Microsoft.CodeSigning"),
-		// 					Resource: to.Ptr("codeSigningAccounts"),
-		// 					Operation: to.Ptr("Delete CodeSigningAccount"),
-		// 					Description: to.Ptr("Delete any CodeSigningAccount"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Microsoft.CodeSigning/codeSigningAccounts/write
+	// Name: Microsoft.CodeSigning/codeSigningAccounts/read
+	// Name: Microsoft.CodeSigning/codeSigningAccounts/delete
 }
@@ -6,20 +6,48 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/AccountCapabilityHost/createOrUpdate.json
 func ExampleAccountCapabilityHostsClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountCapabilityHostsServer: fake.AccountCapabilityHostsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, capabilityHostName string, capabilityHost armcognitiveservices.CapabilityHost, options *armcognitiveservices.AccountCapabilityHostsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.AccountCapabilityHostsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.AccountCapabilityHostsClientCreateOrUpdateResponse{
+							CapabilityHost: armcognitiveservices.CapabilityHost{
+								Name: to.Ptr("capabilityHostName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/capabilityHosts"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/capabilityHosts/capabilityHostName"),
+								Properties: &armcognitiveservices.CapabilityHostProperties{
+									Description:                    to.Ptr("string"),
+									CustomerSubnet:                 to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroups/providers/Microsoft.Network/virtualNetworks/myVnet/subnets/mySubne"),
+									EnablePublicHostingEnvironment: to.Ptr(true),
+									ProvisioningState:              to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
+									Tags: map[string]*string{
+										"string": to.Ptr("string"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -36,35 +64,31 @@ func ExampleAccountCapabilityHostsClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountCapabilityHostsClientCreateOrUpdateResponse{
-	// 	CapabilityHost: armcognitiveservices.CapabilityHost{
-	// 		Name: to.Ptr("capabilityHostName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/capabilityHosts"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/capabilityHosts/capabilityHostName"),
-	// 		Properties: &armcognitiveservices.CapabilityHostProperties{
-	// 			Description: to.Ptr("string"),
-	// 			CustomerSubnet: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroups/providers/Microsoft.Network/virtualNetworks/myVnet/subnets/mySubne"),
-	// 			EnablePublicHostingEnvironment: to.Ptr(true),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
-	// 			Tags: map[string]*string{
-	// 				"string": to.Ptr("string"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: capabilityHostName
+	// Type: Microsoft.CognitiveServices/accounts/capabilityHosts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/capabilityHosts/capabilityHostName
 }
 
 // Generated from example definition: 2025-10-01-preview/AccountCapabilityHost/delete.json
 func ExampleAccountCapabilityHostsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountCapabilityHostsServer: fake.AccountCapabilityHostsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, capabilityHostName string, options *armcognitiveservices.AccountCapabilityHostsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.AccountCapabilityHostsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.AccountCapabilityHostsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -76,16 +100,40 @@ func ExampleAccountCapabilityHostsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/AccountCapabilityHost/get.json
 func ExampleAccountCapabilityHostsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountCapabilityHostsServer: fake.AccountCapabilityHostsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, capabilityHostName string, options *armcognitiveservices.AccountCapabilityHostsClientGetOptions) (resp azfake.Responder[armcognitiveservices.AccountCapabilityHostsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AccountCapabilityHostsClientGetResponse{
+							CapabilityHost: armcognitiveservices.CapabilityHost{
+								Name: to.Ptr("capabilityHostName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/capabilityHosts"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/capabilityHosts/capabilityHostName"),
+								Properties: &armcognitiveservices.CapabilityHostProperties{
+									Description:                    to.Ptr("string"),
+									CustomerSubnet:                 to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroups/providers/Microsoft.Network/virtualNetworks/myVnet/subnets/mySubne"),
+									EnablePublicHostingEnvironment: to.Ptr(true),
+									ProvisioningState:              to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
+									Tags: map[string]*string{
+										"string": to.Ptr("string"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -93,35 +141,49 @@ func ExampleAccountCapabilityHostsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountCapabilityHostsClientGetResponse{
-	// 	CapabilityHost: armcognitiveservices.CapabilityHost{
-	// 		Name: to.Ptr("capabilityHostName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/capabilityHosts"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/capabilityHosts/capabilityHostName"),
-	// 		Properties: &armcognitiveservices.CapabilityHostProperties{
-	// 			Description: to.Ptr("string"),
-	// 			CustomerSubnet: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroups/providers/Microsoft.Network/virtualNetworks/myVnet/subnets/mySubne"),
-	// 			EnablePublicHostingEnvironment: to.Ptr(true),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
-	// 			Tags: map[string]*string{
-	// 				"string": to.Ptr("string"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: capabilityHostName
+	// Type: Microsoft.CognitiveServices/accounts/capabilityHosts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/capabilityHosts/capabilityHostName
 }
 
 // Generated from example definition: 2025-10-01-preview/AccountCapabilityHost/list.json
 func ExampleAccountCapabilityHostsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountCapabilityHostsServer: fake.AccountCapabilityHostsServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.AccountCapabilityHostsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.AccountCapabilityHostsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.AccountCapabilityHostsClientListResponse{
+							CapabilityHostResourceArmPaginatedResult: armcognitiveservices.CapabilityHostResourceArmPaginatedResult{
+								Value: []*armcognitiveservices.CapabilityHost{
+									{
+										Name: to.Ptr("capabilityHostName"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/capabilityHosts"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/capabilityHosts/capabilityHostName"),
+										Properties: &armcognitiveservices.CapabilityHostProperties{
+											Description:       to.Ptr("string"),
+											CustomerSubnet:    to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroups/providers/Microsoft.Network/virtualNetworks/myVnet/subnets/mySubne"),
+											ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
+											Tags: map[string]*string{
+												"string": to.Ptr("string"),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -132,28 +194,13 @@ func ExampleAccountCapabilityHostsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.AccountCapabilityHostsClientListResponse{
-		// 	CapabilityHostResourceArmPaginatedResult: armcognitiveservices.CapabilityHostResourceArmPaginatedResult{
-		// 		Value: []*armcognitiveservices.CapabilityHost{
-		// 			{
-		// 				Name: to.Ptr("capabilityHostName"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/capabilityHosts"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/capabilityHosts/capabilityHostName"),
-		// 				Properties: &armcognitiveservices.CapabilityHostProperties{
-		// 					Description: to.Ptr("string"),
-		// 					CustomerSubnet: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroups/providers/Microsoft.Network/virtualNetworks/myVnet/subnets/mySubne"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
-		// 					Tags: map[string]*string{
-		// 						"string": to.Ptr("string"),
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: capabilityHostName
+	// Type: Microsoft.CognitiveServices/accounts/capabilityHosts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/capabilityHosts/capabilityHostName
 }
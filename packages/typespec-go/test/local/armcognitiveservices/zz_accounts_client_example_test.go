@@ -6,20 +6,68 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/CreateAccount.json
 func ExampleAccountsClient_BeginCreate_createAccount() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, accountName string, account armcognitiveservices.Account, options *armcognitiveservices.AccountsClientBeginCreateOptions) (resp azfake.PollerResponder[armcognitiveservices.AccountsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.AccountsClientCreateResponse{
+							Account: armcognitiveservices.Account{
+								Name: to.Ptr("testCreate1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
+								Etag: to.Ptr("W/\"datetime'2017-04-10T08%3A00%3A05.445595Z'\""),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1"),
+								Identity: &armcognitiveservices.Identity{
+									Type:        to.Ptr(armcognitiveservices.ResourceIdentityTypeSystemAssigned),
+									PrincipalID: to.Ptr("b5cf119e-a5c2-42c7-802f-592e0efb169f"),
+									TenantID:    to.Ptr("72f988bf-86f1-41af-91ab-2d7cd011db47"),
+								},
+								Kind:     to.Ptr("Emotion"),
+								Location: to.Ptr("West US"),
+								Properties: &armcognitiveservices.AccountProperties{
+									Encryption: &armcognitiveservices.Encryption{
+										KeySource: to.Ptr(armcognitiveservices.KeySourceMicrosoftKeyVault),
+										KeyVaultProperties: &armcognitiveservices.KeyVaultProperties{
+											KeyName:     to.Ptr("FakeKeyName"),
+											KeyVaultURI: to.Ptr("https://pltfrmscrts-use-pc-dev.vault.azure.net/"),
+											KeyVersion:  to.Ptr("891CF236-D241-4738-9462-D506AF493DFA"),
+										},
+									},
+									Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
+									ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+									UserOwnedStorage: []*armcognitiveservices.UserOwnedStorage{
+										{
+											ResourceID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.Storage/storageAccounts/myStorageAccount"),
+										},
+									},
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name: to.Ptr("S0"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -55,54 +103,58 @@ func ExampleAccountsClient_BeginCreate_createAccount() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountsClientCreateResponse{
-	// 	Account: armcognitiveservices.Account{
-	// 		Name: to.Ptr("testCreate1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-	// 		Etag: to.Ptr("W/\"datetime'2017-04-10T08%3A00%3A05.445595Z'\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1"),
-	// 		Identity: &armcognitiveservices.Identity{
-	// 			Type: to.Ptr(armcognitiveservices.ResourceIdentityTypeSystemAssigned),
-	// 			PrincipalID: to.Ptr("b5cf119e-a5c2-42c7-802f-592e0efb169f"),
-	// 			TenantID: to.Ptr("72f988bf-86f1-41af-91ab-2d7cd011db47"),
-	// 		},
-	// 		Kind: to.Ptr("Emotion"),
-	// 		Location: to.Ptr("West US"),
-	// 		Properties: &armcognitiveservices.AccountProperties{
-	// 			Encryption: &armcognitiveservices.Encryption{
-	// 				KeySource: to.Ptr(armcognitiveservices.KeySourceMicrosoftKeyVault),
-	// 				KeyVaultProperties: &armcognitiveservices.KeyVaultProperties{
-	// 					KeyName: to.Ptr("FakeKeyName"),
-	// 					KeyVaultURI: to.Ptr("https://pltfrmscrts-use-pc-dev.vault.azure.net/"),
-	// 					KeyVersion: to.Ptr("891CF236-D241-4738-9462-D506AF493DFA"),
-	// 				},
-	// 			},
-	// 			Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-	// 			UserOwnedStorage: []*armcognitiveservices.UserOwnedStorage{
-	// 				{
-	// 					ResourceID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.Storage/storageAccounts/myStorageAccount"),
-	// 				},
-	// 			},
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("S0"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Kind:", *res.Kind)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: testCreate1
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-04-10T08%3A00%3A05.445595Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1
+	// Kind: Emotion
+	// Location: West US
 }
 
 // Generated from example definition: 2025-10-01-preview/CreateAccountMin.json
 func ExampleAccountsClient_BeginCreate_createAccountMin() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, accountName string, account armcognitiveservices.Account, options *armcognitiveservices.AccountsClientBeginCreateOptions) (resp azfake.PollerResponder[armcognitiveservices.AccountsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.AccountsClientCreateResponse{
+							Account: armcognitiveservices.Account{
+								Name: to.Ptr("testCreate1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
+								Etag: to.Ptr("W/\"datetime'2017-04-10T08%3A00%3A05.445595Z'\""),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1"),
+								Identity: &armcognitiveservices.Identity{
+									Type:        to.Ptr(armcognitiveservices.ResourceIdentityTypeSystemAssigned),
+									PrincipalID: to.Ptr("b5cf119e-a5c2-42c7-802f-592e0efb169f"),
+									TenantID:    to.Ptr("72f988bf-86f1-41af-91ab-2d7cd011db47"),
+								},
+								Kind:     to.Ptr("Emotion"),
+								Location: to.Ptr("West US"),
+								Properties: &armcognitiveservices.AccountProperties{
+									Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
+									ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name: to.Ptr("S0"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -124,41 +176,37 @@ func ExampleAccountsClient_BeginCreate_createAccountMin() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountsClientCreateResponse{
-	// 	Account: armcognitiveservices.Account{
-	// 		Name: to.Ptr("testCreate1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-	// 		Etag: to.Ptr("W/\"datetime'2017-04-10T08%3A00%3A05.445595Z'\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1"),
-	// 		Identity: &armcognitiveservices.Identity{
-	// 			Type: to.Ptr(armcognitiveservices.ResourceIdentityTypeSystemAssigned),
-	// 			PrincipalID: to.Ptr("b5cf119e-a5c2-42c7-802f-592e0efb169f"),
-	// 			TenantID: to.Ptr("72f988bf-86f1-41af-91ab-2d7cd011db47"),
-	// 		},
-	// 		Kind: to.Ptr("Emotion"),
-	// 		Location: to.Ptr("West US"),
-	// 		Properties: &armcognitiveservices.AccountProperties{
-	// 			Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("S0"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Kind:", *res.Kind)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: testCreate1
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-04-10T08%3A00%3A05.445595Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1
+	// Kind: Emotion
+	// Location: West US
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteAccount.json
 func ExampleAccountsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, options *armcognitiveservices.AccountsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.AccountsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.AccountsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -172,19 +220,45 @@ func ExampleAccountsClient_BeginDelete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetAccount.json
 func ExampleAccountsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, options *armcognitiveservices.AccountsClientGetOptions) (resp azfake.Responder[armcognitiveservices.AccountsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AccountsClientGetResponse{
+							Account: armcognitiveservices.Account{
+								Name:     to.Ptr("myAccount"),
+								Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+								Etag:     to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount"),
+								Kind:     to.Ptr("Emotion"),
+								Location: to.Ptr("westus"),
+								Properties: &armcognitiveservices.AccountProperties{
+									Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
+									ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name: to.Ptr("F0"),
+								},
+								Tags: map[string]*string{
+									"ExpiredDate": to.Ptr("2017/09/01"),
+									"Owner":       to.Ptr("felixwa"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -192,40 +266,105 @@ func ExampleAccountsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountsClientGetResponse{
-	// 	Account: armcognitiveservices.Account{
-	// 		Name: to.Ptr("myAccount"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-	// 		Etag: to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount"),
-	// 		Kind: to.Ptr("Emotion"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armcognitiveservices.AccountProperties{
-	// 			Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("F0"),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"ExpiredDate": to.Ptr("2017/09/01"),
-	// 			"Owner": to.Ptr("felixwa"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Kind:", *res.Kind)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: myAccount
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-04-10T04%3A42%3A19.7067387Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount
+	// Kind: Emotion
+	// Location: westus
 }
 
 // Generated from example definition: 2025-10-01-preview/ListAccountsBySubscription.json
 func ExampleAccountsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					NewListPager: func(options *armcognitiveservices.AccountsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.AccountsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.AccountsClientListResponse{
+							AccountListResult: armcognitiveservices.AccountListResult{
+								Value: []*armcognitiveservices.Account{
+									{
+										Name:     to.Ptr("bingSearch"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-03-27T11%3A19%3A08.762494Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch"),
+										Kind:     to.Ptr("Bing.Search"),
+										Location: to.Ptr("global"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://api.cognitive.microsoft.com/bing/v5.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S1"),
+										},
+									},
+									{
+										Name:     to.Ptr("CrisProd"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-03-31T08%3A57%3A07.4499566Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/CrisProd"),
+										Kind:     to.Ptr("CRIS"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/sts/v1.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+										},
+										Tags: map[string]*string{
+											"can't delete it successfully": to.Ptr("v-yunjin"),
+										},
+									},
+									{
+										Name:     to.Ptr("rayrptest0308"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-03-27T11%3A15%3A23.5232645Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/rayrptest0308"),
+										Kind:     to.Ptr("Face"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/face/v1.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+										},
+									},
+									{
+										Name:     to.Ptr("raytest02"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-04-04T02%3A07%3A07.3957572Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/raytest02"),
+										Kind:     to.Ptr("Emotion"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -236,90 +375,97 @@ func ExampleAccountsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Etag:", *v.Etag)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Kind:", *v.Kind)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.AccountsClientListResponse{
-		// 	AccountListResult: armcognitiveservices.AccountListResult{
-		// 		Value: []*armcognitiveservices.Account{
-		// 			{
-		// 				Name: to.Ptr("bingSearch"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-03-27T11%3A19%3A08.762494Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch"),
-		// 				Kind: to.Ptr("Bing.Search"),
-		// 				Location: to.Ptr("global"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://api.cognitive.microsoft.com/bing/v5.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S1"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("CrisProd"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-03-31T08%3A57%3A07.4499566Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/CrisProd"),
-		// 				Kind: to.Ptr("CRIS"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/sts/v1.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S0"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"can't delete it successfully": to.Ptr("v-yunjin"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("rayrptest0308"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-03-27T11%3A15%3A23.5232645Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/rayrptest0308"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/face/v1.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S0"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("raytest02"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-04-04T02%3A07%3A07.3957572Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/raytest02"),
-		// 				Kind: to.Ptr("Emotion"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S0"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: bingSearch
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-03-27T11%3A19%3A08.762494Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch
+	// Kind: Bing.Search
+	// Location: global
+	// Name: CrisProd
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-03-31T08%3A57%3A07.4499566Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/CrisProd
+	// Kind: CRIS
+	// Location: westus
+	// Name: rayrptest0308
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-03-27T11%3A15%3A23.5232645Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/rayrptest0308
+	// Kind: Face
+	// Location: westus
+	// Name: raytest02
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-04-04T02%3A07%3A07.3957572Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/raytest02
+	// Kind: Emotion
+	// Location: westus
 }
 
 // Generated from example definition: 2025-10-01-preview/ListAccountsByResourceGroup.json
 func ExampleAccountsClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armcognitiveservices.AccountsClientListByResourceGroupOptions) (resp azfake.PagerResponder[armcognitiveservices.AccountsClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.AccountsClientListByResourceGroupResponse{
+							AccountListResult: armcognitiveservices.AccountListResult{
+								Value: []*armcognitiveservices.Account{
+									{
+										Name:     to.Ptr("myAccount"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount"),
+										Kind:     to.Ptr("Emotion"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("F0"),
+										},
+										Tags: map[string]*string{
+											"ExpiredDate": to.Ptr("2017/09/01"),
+											"Owner":       to.Ptr("felixwa"),
+										},
+									},
+									{
+										Name:     to.Ptr("TestPropertyWU2"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-04-07T04%3A32%3A38.9187216Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/TestPropertyWU2"),
+										Kind:     to.Ptr("Face"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/face/v1.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+										},
+										Tags: map[string]*string{},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -330,63 +476,50 @@ func ExampleAccountsClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Etag:", *v.Etag)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Kind:", *v.Kind)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.AccountsClientListByResourceGroupResponse{
-		// 	AccountListResult: armcognitiveservices.AccountListResult{
-		// 		Value: []*armcognitiveservices.Account{
-		// 			{
-		// 				Name: to.Ptr("myAccount"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount"),
-		// 				Kind: to.Ptr("Emotion"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("F0"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"ExpiredDate": to.Ptr("2017/09/01"),
-		// 					"Owner": to.Ptr("felixwa"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("TestPropertyWU2"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-04-07T04%3A32%3A38.9187216Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/TestPropertyWU2"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/face/v1.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S0"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: myAccount
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-04-10T04%3A42%3A19.7067387Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount
+	// Kind: Emotion
+	// Location: westus
+	// Name: TestPropertyWU2
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-04-07T04%3A32%3A38.9187216Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/TestPropertyWU2
+	// Kind: Face
+	// Location: westus
 }
 
 // Generated from example definition: 2025-10-01-preview/ListKeys.json
 func ExampleAccountsClient_ListKeys() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					ListKeys: func(ctx context.Context, resourceGroupName string, accountName string, options *armcognitiveservices.AccountsClientListKeysOptions) (resp azfake.Responder[armcognitiveservices.AccountsClientListKeysResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AccountsClientListKeysResponse{
+							APIKeys: armcognitiveservices.APIKeys{
+								Key1: to.Ptr("KEY1"),
+								Key2: to.Ptr("KEY2"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -394,25 +527,204 @@ func ExampleAccountsClient_ListKeys() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountsClientListKeysResponse{
-	// 	APIKeys: armcognitiveservices.APIKeys{
-	// 		Key1: to.Ptr("KEY1"),
-	// 		Key2: to.Ptr("KEY2"),
-	// 	},
-	// }
+	fmt.Println("Key1:", *res.Key1)
+	fmt.Println("Key2:", *res.Key2)
+	// Output:
+	// Key1: KEY1
+	// Key2: KEY2
 }
 
 // Generated from example definition: 2025-10-01-preview/ListAccountModels.json
 func ExampleAccountsClient_NewListModelsPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					NewListModelsPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.AccountsClientListModelsOptions) (resp azfake.PagerResponder[armcognitiveservices.AccountsClientListModelsResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.AccountsClientListModelsResponse{
+							AccountModelListResult: armcognitiveservices.AccountModelListResult{
+								Value: []*armcognitiveservices.AccountModel{
+									{
+										Name:   to.Ptr("ada.1"),
+										Format: to.Ptr("OpenAI"),
+										BaseModel: &armcognitiveservices.DeploymentModel{
+											Name:    to.Ptr("ada"),
+											Format:  to.Ptr("OpenAI"),
+											Version: to.Ptr("1"),
+										},
+										Capabilities: map[string]*string{
+											"completion": to.Ptr("true"),
+											"fineTune":   to.Ptr("true"),
+											"inference":  to.Ptr("false"),
+										},
+										Deprecation: &armcognitiveservices.ModelDeprecationInfo{
+											DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusPlanned),
+											FineTune:          to.Ptr("2024-01-01T00:00:00Z"),
+											Inference:         to.Ptr("2024-01-01T00:00:00Z"),
+										},
+										IsDefaultVersion: to.Ptr(false),
+										LifecycleStatus:  to.Ptr(armcognitiveservices.ModelLifecycleStatusLegacy),
+										MaxCapacity:      to.Ptr[int32](10),
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-10-07T00:00:00Z"); return t }()),
+											CreatedBy:          to.Ptr("Microsoft"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-10-07T00:00:00Z"); return t }()),
+											LastModifiedBy:     to.Ptr("Microsoft"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+										},
+										Version: to.Ptr("1"),
+									},
+									{
+										Name:   to.Ptr("dall-e-3"),
+										Format: to.Ptr("OpenAI"),
+										Capabilities: map[string]*string{
+											"imageGenerations": to.Ptr("true"),
+											"inference":        to.Ptr("true"),
+										},
+										Deprecation: &armcognitiveservices.ModelDeprecationInfo{
+											DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusTentative),
+											Inference:         to.Ptr("2025-06-30T00:00:00Z"),
+										},
+										IsDefaultVersion:    to.Ptr(true),
+										LifecycleStatus:     to.Ptr(armcognitiveservices.ModelLifecycleStatusGenerallyAvailable),
+										MaxCapacity:         to.Ptr[int32](2),
+										ModelCatalogAssetID: to.Ptr("azureml://registries/azure-openai/models/dall-e-3/versions/3.0"),
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-11T00:00:00Z"); return t }()),
+											CreatedBy:          to.Ptr("Microsoft"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-11T00:00:00Z"); return t }()),
+											LastModifiedBy:     to.Ptr("Microsoft"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+										},
+										Version: to.Ptr("3.0"),
+									},
+									{
+										Name:   to.Ptr("gpt-35-turbo"),
+										Format: to.Ptr("OpenAI"),
+										Capabilities: map[string]*string{
+											"chatCompletion": to.Ptr("true"),
+											"completion":     to.Ptr("true"),
+											"fineTune":       to.Ptr("false"),
+											"scaleType":      to.Ptr("Manual,Standard"),
+										},
+										Deprecation: &armcognitiveservices.ModelDeprecationInfo{
+											DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusPlanned),
+											Inference:         to.Ptr("2025-04-30T00:00:00Z"),
+										},
+										IsDefaultVersion: to.Ptr(false),
+										LifecycleStatus:  to.Ptr(armcognitiveservices.ModelLifecycleStatusDeprecated),
+										MaxCapacity:      to.Ptr[int32](9),
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-03-09T00:00:00Z"); return t }()),
+											CreatedBy:          to.Ptr("Microsoft"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-06T00:00:00Z"); return t }()),
+											LastModifiedBy:     to.Ptr("Microsoft"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+										},
+										Version: to.Ptr("0301"),
+									},
+									{
+										Name:   to.Ptr("gpt-4o"),
+										Format: to.Ptr("OpenAI"),
+										Capabilities: map[string]*string{
+											"chat":       to.Ptr("true"),
+											"completion": to.Ptr("true"),
+											"fineTune":   to.Ptr("false"),
+											"inference":  to.Ptr("true"),
+											"vision":     to.Ptr("true"),
+										},
+										Deprecation: &armcognitiveservices.ModelDeprecationInfo{
+											DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusTentative),
+											Inference:         to.Ptr("2025-09-15T00:00:00Z"),
+										},
+										LifecycleStatus:     to.Ptr(armcognitiveservices.ModelLifecycleStatusDeprecating),
+										MaxCapacity:         to.Ptr[int32](50),
+										ModelCatalogAssetID: to.Ptr("azureml://registries/azure-openai/models/gpt-4o/versions/2024-05-13"),
+										ReplacementConfig: &armcognitiveservices.ReplacementConfig{
+											AutoUpgradeStartDate:        to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-03-26T07:00:00Z"); return t }()),
+											TargetModelName:             to.Ptr("gpt-4.1"),
+											TargetModelVersion:          to.Ptr("2025-04-14"),
+											UpgradeOnExpiryLeadTimeDays: to.Ptr[int32](7),
+										},
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-05-13T00:00:00Z"); return t }()),
+											CreatedBy:          to.Ptr("Microsoft"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-12-15T00:00:00Z"); return t }()),
+											LastModifiedBy:     to.Ptr("Microsoft"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+										},
+										Version: to.Ptr("2024-05-13"),
+									},
+									{
+										Name:   to.Ptr("Llama-3.2-90B-Vision-Instruct"),
+										Format: to.Ptr("Meta"),
+										Capabilities: map[string]*string{
+											"chatCompletion": to.Ptr("true"),
+										},
+										Deprecation: &armcognitiveservices.ModelDeprecationInfo{
+											DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusTentative),
+											Inference:         to.Ptr("2099-12-31T00:00:00Z"),
+										},
+										IsDefaultVersion:    to.Ptr(false),
+										LifecycleStatus:     to.Ptr(armcognitiveservices.ModelLifecycleStatusStable),
+										MaxCapacity:         to.Ptr[int32](3),
+										ModelCatalogAssetID: to.Ptr("azureml://registries/azureml-meta/models/Llama-3.2-90B-Vision-Instruct/versions/2"),
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-10-01T00:00:00Z"); return t }()),
+											CreatedBy:          to.Ptr("Microsoft"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-16T04:45:33.9367873Z"); return t }()),
+											LastModifiedBy:     to.Ptr("MaaSModelConverter"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+										},
+										Version: to.Ptr("2"),
+									},
+									{
+										Name:   to.Ptr("gpt-4o"),
+										Format: to.Ptr("OpenAI"),
+										Capabilities: map[string]*string{
+											"chat":            to.Ptr("true"),
+											"completion":      to.Ptr("true"),
+											"fineTune":        to.Ptr("false"),
+											"functionCalling": to.Ptr("true"),
+											"inference":       to.Ptr("true"),
+											"vision":          to.Ptr("true"),
+										},
+										FinetuneCapabilities: map[string]*string{
+											"chat":       to.Ptr("true"),
+											"completion": to.Ptr("true"),
+											"fineTune":   to.Ptr("true"),
+											"inference":  to.Ptr("true"),
+											"scaleType":  to.Ptr("Manual"),
+										},
+										LifecycleStatus:     to.Ptr(armcognitiveservices.ModelLifecycleStatusGenerallyAvailable),
+										MaxCapacity:         to.Ptr[int32](50),
+										ModelCatalogAssetID: to.Ptr("azureml://registries/azure-openai/models/gpt-4o/versions/2024-08-06"),
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-08-06T00:00:00Z"); return t }()),
+											CreatedBy:          to.Ptr("Microsoft"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-11-01T00:00:00Z"); return t }()),
+											LastModifiedBy:     to.Ptr("Microsoft"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
+										},
+										Version: to.Ptr("2024-08-06"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -423,197 +735,81 @@ func ExampleAccountsClient_NewListModelsPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Format:", *v.Format)
+			fmt.Println("LifecycleStatus:", *v.LifecycleStatus)
+			fmt.Println("MaxCapacity:", *v.MaxCapacity)
+			fmt.Println("Version:", *v.Version)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.AccountsClientListModelsResponse{
-		// 	AccountModelListResult: armcognitiveservices.AccountModelListResult{
-		// 		Value: []*armcognitiveservices.AccountModel{
-		// 			{
-		// 				Name: to.Ptr("ada.1"),
-		// 				Format: to.Ptr("OpenAI"),
-		// 				BaseModel: &armcognitiveservices.DeploymentModel{
-		// 					Name: to.Ptr("ada"),
-		// 					Format: to.Ptr("OpenAI"),
-		// 					Version: to.Ptr("1"),
-		// 				},
-		// 				Capabilities: map[string]*string{
-		// 					"completion": to.Ptr("true"),
-		// 					"fineTune": to.Ptr("true"),
-		// 					"inference": to.Ptr("false"),
-		// 				},
-		// 				Deprecation: &armcognitiveservices.ModelDeprecationInfo{
-		// 					DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusPlanned),
-		// 					FineTune: to.Ptr("2024-01-01T00:00:00Z"),
-		// 					Inference: to.Ptr("2024-01-01T00:00:00Z"),
-		// 				},
-		// 				IsDefaultVersion: to.Ptr(false),
-		// 				LifecycleStatus: to.Ptr(armcognitiveservices.ModelLifecycleStatusLegacy),
-		// 				MaxCapacity: to.Ptr[int32](10),
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-10-07T00:00:00Z"); return t}()),
-		// 					CreatedBy: to.Ptr("Microsoft"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-10-07T00:00:00Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("Microsoft"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 				},
-		// 				Version: to.Ptr("1"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("dall-e-3"),
-		// 				Format: to.Ptr("OpenAI"),
-		// 				Capabilities: map[string]*string{
-		// 					"imageGenerations": to.Ptr("true"),
-		// 					"inference": to.Ptr("true"),
-		// 				},
-		// 				Deprecation: &armcognitiveservices.ModelDeprecationInfo{
-		// 					DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusTentative),
-		// 					Inference: to.Ptr("2025-06-30T00:00:00Z"),
-		// 				},
-		// 				IsDefaultVersion: to.Ptr(true),
-		// 				LifecycleStatus: to.Ptr(armcognitiveservices.ModelLifecycleStatusGenerallyAvailable),
-		// 				MaxCapacity: to.Ptr[int32](2),
-		// 				ModelCatalogAssetID: to.Ptr("azureml://registries/azure-openai/models/dall-e-3/versions/3.0"),
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-11T00:00:00Z"); return t}()),
-		// 					CreatedBy: to.Ptr("Microsoft"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-11T00:00:00Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("Microsoft"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 				},
-		// 				Version: to.Ptr("3.0"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("gpt-35-turbo"),
-		// 				Format: to.Ptr("OpenAI"),
-		// 				Capabilities: map[string]*string{
-		// 					"chatCompletion": to.Ptr("true"),
-		// 					"completion": to.Ptr("true"),
-		// 					"fineTune": to.Ptr("false"),
-		// 					"scaleType": to.Ptr("Manual,Standard"),
-		// 				},
-		// 				Deprecation: &armcognitiveservices.ModelDeprecationInfo{
-		// 					DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusPlanned),
-		// 					Inference: to.Ptr("2025-04-30T00:00:00Z"),
-		// 				},
-		// 				IsDefaultVersion: to.Ptr(false),
-		// 				LifecycleStatus: to.Ptr(armcognitiveservices.ModelLifecycleStatusDeprecated),
-		// 				MaxCapacity: to.Ptr[int32](9),
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-03-09T00:00:00Z"); return t}()),
-		// 					CreatedBy: to.Ptr("Microsoft"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-07-06T00:00:00Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("Microsoft"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 				},
-		// 				Version: to.Ptr("0301"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("gpt-4o"),
-		// 				Format: to.Ptr("OpenAI"),
-		// 				Capabilities: map[string]*string{
-		// 					"chat": to.Ptr("true"),
-		// 					"completion": to.Ptr("true"),
-		// 					"fineTune": to.Ptr("false"),
-		// 					"inference": to.Ptr("true"),
-		// 					"vision": to.Ptr("true"),
-		// 				},
-		// 				Deprecation: &armcognitiveservices.ModelDeprecationInfo{
-		// 					DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusTentative),
-		// 					Inference: to.Ptr("2025-09-15T00:00:00Z"),
-		// 				},
-		// 				LifecycleStatus: to.Ptr(armcognitiveservices.ModelLifecycleStatusDeprecating),
-		// 				MaxCapacity: to.Ptr[int32](50),
-		// 				ModelCatalogAssetID: to.Ptr("azureml://registries/azure-openai/models/gpt-4o/versions/2024-05-13"),
-		// 				ReplacementConfig: &armcognitiveservices.ReplacementConfig{
-		// 					AutoUpgradeStartDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-03-26T07:00:00Z"); return t}()),
-		// 					TargetModelName: to.Ptr("gpt-4.1"),
-		// 					TargetModelVersion: to.Ptr("2025-04-14"),
-		// 					UpgradeOnExpiryLeadTimeDays: to.Ptr[int32](7),
-		// 				},
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-05-13T00:00:00Z"); return t}()),
-		// 					CreatedBy: to.Ptr("Microsoft"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-12-15T00:00:00Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("Microsoft"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 				},
-		// 				Version: to.Ptr("2024-05-13"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Llama-3.2-90B-Vision-Instruct"),
-		// 				Format: to.Ptr("Meta"),
-		// 				Capabilities: map[string]*string{
-		// 					"chatCompletion": to.Ptr("true"),
-		// 				},
-		// 				Deprecation: &armcognitiveservices.ModelDeprecationInfo{
-		// 					DeprecationStatus: to.Ptr(armcognitiveservices.DeprecationStatusTentative),
-		// 					Inference: to.Ptr("2099-12-31T00:00:00Z"),
-		// 				},
-		// 				IsDefaultVersion: to.Ptr(false),
-		// 				LifecycleStatus: to.Ptr(armcognitiveservices.ModelLifecycleStatusStable),
-		// 				MaxCapacity: to.Ptr[int32](3),
-		// 				ModelCatalogAssetID: to.Ptr("azureml://registries/azureml-meta/models/Llama-3.2-90B-Vision-Instruct/versions/2"),
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-10-01T00:00:00Z"); return t}()),
-		// 					CreatedBy: to.Ptr("Microsoft"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-16T04:45:33.9367873Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("MaaSModelConverter"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 				},
-		// 				Version: to.Ptr("2"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("gpt-4o"),
-		// 				Format: to.Ptr("OpenAI"),
-		// 				Capabilities: map[string]*string{
-		// 					"chat": to.Ptr("true"),
-		// 					"completion": to.Ptr("true"),
-		// 					"fineTune": to.Ptr("false"),
-		// 					"functionCalling": to.Ptr("true"),
-		// 					"inference": to.Ptr("true"),
-		// 					"vision": to.Ptr("true"),
-		// 				},
-		// 				FinetuneCapabilities: map[string]*string{
-		// 					"chat": to.Ptr("true"),
-		// 					"completion": to.Ptr("true"),
-		// 					"fineTune": to.Ptr("true"),
-		// 					"inference": to.Ptr("true"),
-		// 					"scaleType": to.Ptr("Manual"),
-		// 				},
-		// 				LifecycleStatus: to.Ptr(armcognitiveservices.ModelLifecycleStatusGenerallyAvailable),
-		// 				MaxCapacity: to.Ptr[int32](50),
-		// 				ModelCatalogAssetID: to.Ptr("azureml://registries/azure-openai/models/gpt-4o/versions/2024-08-06"),
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-08-06T00:00:00Z"); return t}()),
-		// 					CreatedBy: to.Ptr("Microsoft"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-11-01T00:00:00Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("Microsoft"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeApplication),
-		// 				},
-		// 				Version: to.Ptr("2024-08-06"),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: ada.1
+	// Format: OpenAI
+	// LifecycleStatus: Legacy
+	// MaxCapacity: 10
+	// Version: 1
+	// Name: dall-e-3
+	// Format: OpenAI
+	// LifecycleStatus: GenerallyAvailable
+	// MaxCapacity: 2
+	// Version: 3.0
+	// Name: gpt-35-turbo
+	// Format: OpenAI
+	// LifecycleStatus: Deprecated
+	// MaxCapacity: 9
+	// Version: 0301
+	// Name: gpt-4o
+	// Format: OpenAI
+	// LifecycleStatus: Deprecating
+	// MaxCapacity: 50
+	// Version: 2024-05-13
+	// Name: Llama-3.2-90B-Vision-Instruct
+	// Format: Meta
+	// LifecycleStatus: Stable
+	// MaxCapacity: 3
+	// Version: 2
+	// Name: gpt-4o
+	// Format: OpenAI
+	// LifecycleStatus: GenerallyAvailable
+	// MaxCapacity: 50
+	// Version: 2024-08-06
 }
 
 // Generated from example definition: 2025-10-01-preview/ListSkus.json
 func ExampleAccountsClient_ListSKUs() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					ListSKUs: func(ctx context.Context, resourceGroupName string, accountName string, options *armcognitiveservices.AccountsClientListSKUsOptions) (resp azfake.Responder[armcognitiveservices.AccountsClientListSKUsResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AccountsClientListSKUsResponse{
+							AccountSKUListResult: armcognitiveservices.AccountSKUListResult{
+								Value: []*armcognitiveservices.AccountSKU{
+									{
+										ResourceType: to.Ptr("Microsoft.CognitiveServices/accounts"),
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("F0"),
+											Tier: to.Ptr(armcognitiveservices.SKUTierFree),
+										},
+									},
+									{
+										ResourceType: to.Ptr("Microsoft.CognitiveServices/accounts"),
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+											Tier: to.Ptr(armcognitiveservices.SKUTierStandard),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -623,37 +819,42 @@ func ExampleAccountsClient_ListSKUs() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountsClientListSKUsResponse{
-	// 	AccountSKUListResult: armcognitiveservices.AccountSKUListResult{
-	// 		Value: []*armcognitiveservices.AccountSKU{
-	// 			{
-	// 				ResourceType: to.Ptr("Microsoft.CognitiveServices/accounts"),
-	// 				SKU: &armcognitiveservices.SKU{
-	// 					Name: to.Ptr("F0"),
-	// 					Tier: to.Ptr(armcognitiveservices.SKUTierFree),
-	// 				},
-	// 			},
-	// 			{
-	// 				ResourceType: to.Ptr("Microsoft.CognitiveServices/accounts"),
-	// 				SKU: &armcognitiveservices.SKU{
-	// 					Name: to.Ptr("S0"),
-	// 					Tier: to.Ptr(armcognitiveservices.SKUTierStandard),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetUsages.json
 func ExampleAccountsClient_ListUsages() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("5a4f5c2e-6983-4ccb-bd34-2196d5b5bbd3", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("5a4f5c2e-6983-4ccb-bd34-2196d5b5bbd3", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					ListUsages: func(ctx context.Context, resourceGroupName string, accountName string, options *armcognitiveservices.AccountsClientListUsagesOptions) (resp azfake.Responder[armcognitiveservices.AccountsClientListUsagesResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AccountsClientListUsagesResponse{
+							UsageListResult: armcognitiveservices.UsageListResult{
+								Value: []*armcognitiveservices.Usage{
+									{
+										Name: &armcognitiveservices.MetricName{
+											LocalizedValue: to.Ptr("Face.Transactions"),
+											Value:          to.Ptr("Face.Transactions"),
+										},
+										CurrentValue:  to.Ptr[float64](3),
+										Limit:         to.Ptr[float64](30000),
+										NextResetTime: to.Ptr("2018-03-28T09:33:51Z"),
+										QuotaPeriod:   to.Ptr("30.00:00:00"),
+										Status:        to.Ptr(armcognitiveservices.QuotaUsageStatusIncluded),
+										Unit:          to.Ptr(armcognitiveservices.UnitTypeCount),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -663,35 +864,30 @@ func ExampleAccountsClient_ListUsages() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountsClientListUsagesResponse{
-	// 	UsageListResult: armcognitiveservices.UsageListResult{
-	// 		Value: []*armcognitiveservices.Usage{
-	// 			{
-	// 				Name: &armcognitiveservices.MetricName{
-	// 					LocalizedValue: to.Ptr("Face.Transactions"),
-	// 					Value: to.Ptr("Face.Transactions"),
-	// 				},
-	// 				CurrentValue: to.Ptr[float64](3),
-	// 				Limit: to.Ptr[float64](30000),
-	// 				NextResetTime: to.Ptr("2018-03-28T09:33:51Z"),
-	// 				QuotaPeriod: to.Ptr("30.00:00:00"),
-	// 				Status: to.Ptr(armcognitiveservices.QuotaUsageStatusIncluded),
-	// 				Unit: to.Ptr(armcognitiveservices.UnitTypeCount),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/RegenerateKey.json
 func ExampleAccountsClient_RegenerateKey() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					RegenerateKey: func(ctx context.Context, resourceGroupName string, accountName string, parameters armcognitiveservices.RegenerateKeyParameters, options *armcognitiveservices.AccountsClientRegenerateKeyOptions) (resp azfake.Responder[armcognitiveservices.AccountsClientRegenerateKeyResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AccountsClientRegenerateKeyResponse{
+							APIKeys: armcognitiveservices.APIKeys{
+								Key1: to.Ptr("KEY1"),
+								Key2: to.Ptr("KEY2"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -701,25 +897,45 @@ func ExampleAccountsClient_RegenerateKey() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountsClientRegenerateKeyResponse{
-	// 	APIKeys: armcognitiveservices.APIKeys{
-	// 		Key1: to.Ptr("KEY1"),
-	// 		Key2: to.Ptr("KEY2"),
-	// 	},
-	// }
+	fmt.Println("Key1:", *res.Key1)
+	fmt.Println("Key2:", *res.Key2)
+	// Output:
+	// Key1: KEY1
+	// Key2: KEY2
 }
 
 // Generated from example definition: 2025-10-01-preview/UpdateAccount.json
 func ExampleAccountsClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AccountsServer: fake.AccountsServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, accountName string, account armcognitiveservices.Account, options *armcognitiveservices.AccountsClientBeginUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.AccountsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.AccountsClientUpdateResponse{
+							Account: armcognitiveservices.Account{
+								Name:     to.Ptr("bingSearch"),
+								Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+								Etag:     to.Ptr("W/\"datetime'2017-04-10T07%3A46%3A21.5618831Z'\""),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch"),
+								Kind:     to.Ptr("Bing.Search"),
+								Location: to.Ptr("global"),
+								Properties: &armcognitiveservices.AccountProperties{
+									Endpoint:          to.Ptr("https://api.cognitive.microsoft.com/bing/v5.0"),
+									ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name: to.Ptr("S2"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -736,24 +952,17 @@ func ExampleAccountsClient_BeginUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AccountsClientUpdateResponse{
-	// 	Account: armcognitiveservices.Account{
-	// 		Name: to.Ptr("bingSearch"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-	// 		Etag: to.Ptr("W/\"datetime'2017-04-10T07%3A46%3A21.5618831Z'\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch"),
-	// 		Kind: to.Ptr("Bing.Search"),
-	// 		Location: to.Ptr("global"),
-	// 		Properties: &armcognitiveservices.AccountProperties{
-	// 			Endpoint: to.Ptr("https://api.cognitive.microsoft.com/bing/v5.0"),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("S2"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Kind:", *res.Kind)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: bingSearch
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-04-10T07%3A46%3A21.5618831Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch
+	// Kind: Bing.Search
+	// Location: global
 }
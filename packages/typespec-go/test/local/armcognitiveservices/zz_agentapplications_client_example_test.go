@@ -6,20 +6,57 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/AgentApplication/createOrUpdate.json
 func ExampleAgentApplicationsClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentApplicationsServer: fake.AgentApplicationsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, name string, body armcognitiveservices.AgentApplication, options *armcognitiveservices.AgentApplicationsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.AgentApplicationsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.AgentApplicationsClientCreateOrUpdateResponse{
+							AgentApplication: armcognitiveservices.AgentApplication{
+								Name: to.Ptr("agent-app-1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1"),
+								Properties: &armcognitiveservices.AgenticApplicationProperties{
+									Description:       to.Ptr("Sample agent application for customer support"),
+									DisplayName:       to.Ptr("Customer Support Agent"),
+									ProvisioningState: to.Ptr(armcognitiveservices.AgenticApplicationProvisioningStateSucceeded),
+									Tags: map[string]*string{
+										"environment": to.Ptr("production"),
+										"team":        to.Ptr("ai-platform"),
+									},
+								},
+								SystemData: &armcognitiveservices.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+									CreatedBy:          to.Ptr("user@contoso.com"),
+									CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@contoso.com"),
+									LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -40,43 +77,31 @@ func ExampleAgentApplicationsClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AgentApplicationsClientCreateOrUpdateResponse{
-	// 	AgentApplication: armcognitiveservices.AgentApplication{
-	// 		Name: to.Ptr("agent-app-1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1"),
-	// 		Properties: &armcognitiveservices.AgenticApplicationProperties{
-	// 			Description: to.Ptr("Sample agent application for customer support"),
-	// 			DisplayName: to.Ptr("Customer Support Agent"),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.AgenticApplicationProvisioningStateSucceeded),
-	// 			Tags: map[string]*string{
-	// 				"environment": to.Ptr("production"),
-	// 				"team": to.Ptr("ai-platform"),
-	// 			},
-	// 		},
-	// 		SystemData: &armcognitiveservices.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 			CreatedBy: to.Ptr("user@contoso.com"),
-	// 			CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@contoso.com"),
-	// 			LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: agent-app-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/applications
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentApplication/delete.json
 func ExampleAgentApplicationsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentApplicationsServer: fake.AgentApplicationsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, name string, options *armcognitiveservices.AgentApplicationsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.AgentApplicationsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.AgentApplicationsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -88,16 +113,25 @@ func ExampleAgentApplicationsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentApplication/disable.json
 func ExampleAgentApplicationsClient_Disable() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentApplicationsServer: fake.AgentApplicationsServer{
+					Disable: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, name string, options *armcognitiveservices.AgentApplicationsClientDisableOptions) (resp azfake.Responder[armcognitiveservices.AgentApplicationsClientDisableResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AgentApplicationsClientDisableResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -107,19 +141,25 @@ func ExampleAgentApplicationsClient_Disable() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AgentApplicationsClientDisableResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentApplication/enable.json
 func ExampleAgentApplicationsClient_Enable() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentApplicationsServer: fake.AgentApplicationsServer{
+					Enable: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, name string, options *armcognitiveservices.AgentApplicationsClientEnableOptions) (resp azfake.Responder[armcognitiveservices.AgentApplicationsClientEnableResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AgentApplicationsClientEnableResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -129,19 +169,47 @@ func ExampleAgentApplicationsClient_Enable() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AgentApplicationsClientEnableResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentApplication/get.json
 func ExampleAgentApplicationsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentApplicationsServer: fake.AgentApplicationsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, name string, options *armcognitiveservices.AgentApplicationsClientGetOptions) (resp azfake.Responder[armcognitiveservices.AgentApplicationsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AgentApplicationsClientGetResponse{
+							AgentApplication: armcognitiveservices.AgentApplication{
+								Name: to.Ptr("agent-app-1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1"),
+								Properties: &armcognitiveservices.AgenticApplicationProperties{
+									Description: to.Ptr("Sample agent application for customer support"),
+									DisplayName: to.Ptr("Customer Support Agent"),
+									Tags: map[string]*string{
+										"environment": to.Ptr("production"),
+										"team":        to.Ptr("ai-platform"),
+									},
+								},
+								SystemData: &armcognitiveservices.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+									CreatedBy:          to.Ptr("user@contoso.com"),
+									CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@contoso.com"),
+									LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -149,42 +217,57 @@ func ExampleAgentApplicationsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AgentApplicationsClientGetResponse{
-	// 	AgentApplication: armcognitiveservices.AgentApplication{
-	// 		Name: to.Ptr("agent-app-1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1"),
-	// 		Properties: &armcognitiveservices.AgenticApplicationProperties{
-	// 			Description: to.Ptr("Sample agent application for customer support"),
-	// 			DisplayName: to.Ptr("Customer Support Agent"),
-	// 			Tags: map[string]*string{
-	// 				"environment": to.Ptr("production"),
-	// 				"team": to.Ptr("ai-platform"),
-	// 			},
-	// 		},
-	// 		SystemData: &armcognitiveservices.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 			CreatedBy: to.Ptr("user@contoso.com"),
-	// 			CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@contoso.com"),
-	// 			LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: agent-app-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/applications
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentApplication/list.json
 func ExampleAgentApplicationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentApplicationsServer: fake.AgentApplicationsServer{
+					NewListPager: func(resourceGroupName string, accountName string, projectName string, options *armcognitiveservices.AgentApplicationsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.AgentApplicationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.AgentApplicationsClientListResponse{
+							AgentApplicationResourceArmPaginatedResult: armcognitiveservices.AgentApplicationResourceArmPaginatedResult{
+								Value: []*armcognitiveservices.AgentApplication{
+									{
+										Name: to.Ptr("agent-app-1"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1"),
+										Properties: &armcognitiveservices.AgenticApplicationProperties{
+											Description: to.Ptr("Sample agent application for customer support"),
+											DisplayName: to.Ptr("Customer Support Agent"),
+											Tags: map[string]*string{
+												"environment": to.Ptr("production"),
+												"team":        to.Ptr("ai-platform"),
+											},
+										},
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+											CreatedBy:          to.Ptr("user@contoso.com"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@contoso.com"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -204,49 +287,73 @@ func ExampleAgentApplicationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.AgentApplicationsClientListResponse{
-		// 	AgentApplicationResourceArmPaginatedResult: armcognitiveservices.AgentApplicationResourceArmPaginatedResult{
-		// 		NextLink: to.Ptr("string"),
-		// 		Value: []*armcognitiveservices.AgentApplication{
-		// 			{
-		// 				Name: to.Ptr("agent-app-1"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1"),
-		// 				Properties: &armcognitiveservices.AgenticApplicationProperties{
-		// 					Description: to.Ptr("Sample agent application for customer support"),
-		// 					DisplayName: to.Ptr("Customer Support Agent"),
-		// 					Tags: map[string]*string{
-		// 						"environment": to.Ptr("production"),
-		// 						"team": to.Ptr("ai-platform"),
-		// 					},
-		// 				},
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-		// 					CreatedBy: to.Ptr("user@contoso.com"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@contoso.com"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: agent-app-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/applications
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentApplication/listAgents.json
 func ExampleAgentApplicationsClient_ListAgents() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentApplicationsServer: fake.AgentApplicationsServer{
+					ListAgents: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, name string, options *armcognitiveservices.AgentApplicationsClientListAgentsOptions) (resp azfake.Responder[armcognitiveservices.AgentApplicationsClientListAgentsResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AgentApplicationsClientListAgentsResponse{
+							AgentReferenceResourceArmPaginatedResult: armcognitiveservices.AgentReferenceResourceArmPaginatedResult{
+								NextLink: nil,
+								Value: []*armcognitiveservices.AgentReference{
+									{
+										Name: to.Ptr("CustomerSupportAgent"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agents"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agents/agent-001"),
+										Properties: &armcognitiveservices.AgentReferenceProperties{
+											AgentID:   to.Ptr("agent-001"),
+											AgentName: to.Ptr("CustomerSupportAgent"),
+										},
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+											CreatedBy:          to.Ptr("user@contoso.com"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@contoso.com"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+										},
+									},
+									{
+										Name: to.Ptr("TechnicalSupportAgent"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agents"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agents/agent-002"),
+										Properties: &armcognitiveservices.AgentReferenceProperties{
+											AgentID:   to.Ptr("agent-002"),
+											AgentName: to.Ptr("TechnicalSupportAgent"),
+										},
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+											CreatedBy:          to.Ptr("user@contoso.com"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@contoso.com"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -256,46 +363,5 @@ func ExampleAgentApplicationsClient_ListAgents() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AgentApplicationsClientListAgentsResponse{
-	// 	AgentReferenceResourceArmPaginatedResult: armcognitiveservices.AgentReferenceResourceArmPaginatedResult{
-	// 		NextLink: nil,
-	// 		Value: []*armcognitiveservices.AgentReference{
-	// 			{
-	// 				Name: to.Ptr("CustomerSupportAgent"),
-	// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agents"),
-	// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agents/agent-001"),
-	// 				Properties: &armcognitiveservices.AgentReferenceProperties{
-	// 					AgentID: to.Ptr("agent-001"),
-	// 					AgentName: to.Ptr("CustomerSupportAgent"),
-	// 				},
-	// 				SystemData: &armcognitiveservices.SystemData{
-	// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 					CreatedBy: to.Ptr("user@contoso.com"),
-	// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 					LastModifiedBy: to.Ptr("user@contoso.com"),
-	// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 				},
-	// 			},
-	// 			{
-	// 				Name: to.Ptr("TechnicalSupportAgent"),
-	// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agents"),
-	// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agents/agent-002"),
-	// 				Properties: &armcognitiveservices.AgentReferenceProperties{
-	// 					AgentID: to.Ptr("agent-002"),
-	// 					AgentName: to.Ptr("TechnicalSupportAgent"),
-	// 				},
-	// 				SystemData: &armcognitiveservices.SystemData{
-	// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 					CreatedBy: to.Ptr("user@contoso.com"),
-	// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 					LastModifiedBy: to.Ptr("user@contoso.com"),
-	// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
@@ -6,20 +6,68 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/AgentDeployment/createOrUpdate.json
 func ExampleAgentDeploymentsClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentDeploymentsServer: fake.AgentDeploymentsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, appName string, deploymentName string, body armcognitiveservices.AgentDeployment, options *armcognitiveservices.AgentDeploymentsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.AgentDeploymentsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.AgentDeploymentsClientCreateOrUpdateResponse{
+							AgentDeployment: armcognitiveservices.AgentDeployment{
+								Name: to.Ptr("deployment-1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agentDeployments"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agentDeployments/deployment-1"),
+								Properties: &armcognitiveservices.ManagedAgentDeployment{
+									Agents: []*armcognitiveservices.VersionedAgentReference{
+										{
+											AgentID:      to.Ptr("agent-123"),
+											AgentName:    to.Ptr("support-agent"),
+											AgentVersion: to.Ptr("1.0.0"),
+										},
+									},
+									DeploymentID:   to.Ptr("550e8400-e29b-41d4-a716-446655440001"),
+									DeploymentType: to.Ptr(armcognitiveservices.AgentDeploymentTypeManaged),
+									DisplayName:    to.Ptr("Production Deployment"),
+									Protocols: []*armcognitiveservices.AgentProtocolVersion{
+										{
+											Version:  to.Ptr("1.0"),
+											Protocol: to.Ptr(armcognitiveservices.AgentProtocolAgent),
+										},
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.AgentDeploymentProvisioningStateSucceeded),
+									State:             to.Ptr(armcognitiveservices.AgentDeploymentStateRunning),
+								},
+								SystemData: &armcognitiveservices.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+									CreatedBy:          to.Ptr("user@contoso.com"),
+									CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@contoso.com"),
+									LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -50,54 +98,31 @@ func ExampleAgentDeploymentsClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AgentDeploymentsClientCreateOrUpdateResponse{
-	// 	AgentDeployment: armcognitiveservices.AgentDeployment{
-	// 		Name: to.Ptr("deployment-1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agentDeployments"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agentDeployments/deployment-1"),
-	// 		Properties: &armcognitiveservices.ManagedAgentDeployment{
-	// 			Agents: []*armcognitiveservices.VersionedAgentReference{
-	// 				{
-	// 					AgentID: to.Ptr("agent-123"),
-	// 					AgentName: to.Ptr("support-agent"),
-	// 					AgentVersion: to.Ptr("1.0.0"),
-	// 				},
-	// 			},
-	// 			DeploymentID: to.Ptr("550e8400-e29b-41d4-a716-446655440001"),
-	// 			DeploymentType: to.Ptr(armcognitiveservices.AgentDeploymentTypeManaged),
-	// 			DisplayName: to.Ptr("Production Deployment"),
-	// 			Protocols: []*armcognitiveservices.AgentProtocolVersion{
-	// 				{
-	// 					Version: to.Ptr("1.0"),
-	// 					Protocol: to.Ptr(armcognitiveservices.AgentProtocolAgent),
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.AgentDeploymentProvisioningStateSucceeded),
-	// 			State: to.Ptr(armcognitiveservices.AgentDeploymentStateRunning),
-	// 		},
-	// 		SystemData: &armcognitiveservices.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 			CreatedBy: to.Ptr("user@contoso.com"),
-	// 			CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@contoso.com"),
-	// 			LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: deployment-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/applications/agentDeployments
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agentDeployments/deployment-1
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentDeployment/delete.json
 func ExampleAgentDeploymentsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentDeploymentsServer: fake.AgentDeploymentsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, appName string, deploymentName string, options *armcognitiveservices.AgentDeploymentsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.AgentDeploymentsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.AgentDeploymentsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -109,16 +134,59 @@ func ExampleAgentDeploymentsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentDeployment/get.json
 func ExampleAgentDeploymentsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentDeploymentsServer: fake.AgentDeploymentsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, appName string, deploymentName string, options *armcognitiveservices.AgentDeploymentsClientGetOptions) (resp azfake.Responder[armcognitiveservices.AgentDeploymentsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AgentDeploymentsClientGetResponse{
+							AgentDeployment: armcognitiveservices.AgentDeployment{
+								Name: to.Ptr("deployment-1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agentDeployments"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agentDeployments/deployment-1"),
+								Properties: &armcognitiveservices.ManagedAgentDeployment{
+									Agents: []*armcognitiveservices.VersionedAgentReference{
+										{
+											AgentID:      to.Ptr("agent-123"),
+											AgentName:    to.Ptr("support-agent"),
+											AgentVersion: to.Ptr("1.0.0"),
+										},
+									},
+									DeploymentID:   to.Ptr("550e8400-e29b-41d4-a716-446655440001"),
+									DeploymentType: to.Ptr(armcognitiveservices.AgentDeploymentTypeManaged),
+									DisplayName:    to.Ptr("Production Deployment"),
+									Protocols: []*armcognitiveservices.AgentProtocolVersion{
+										{
+											Version:  to.Ptr("1.0"),
+											Protocol: to.Ptr(armcognitiveservices.AgentProtocolAgent),
+										},
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.AgentDeploymentProvisioningStateSucceeded),
+									State:             to.Ptr(armcognitiveservices.AgentDeploymentStateRunning),
+								},
+								SystemData: &armcognitiveservices.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+									CreatedBy:          to.Ptr("user@contoso.com"),
+									CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@contoso.com"),
+									LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -126,54 +194,69 @@ func ExampleAgentDeploymentsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AgentDeploymentsClientGetResponse{
-	// 	AgentDeployment: armcognitiveservices.AgentDeployment{
-	// 		Name: to.Ptr("deployment-1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agentDeployments"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agentDeployments/deployment-1"),
-	// 		Properties: &armcognitiveservices.ManagedAgentDeployment{
-	// 			Agents: []*armcognitiveservices.VersionedAgentReference{
-	// 				{
-	// 					AgentID: to.Ptr("agent-123"),
-	// 					AgentName: to.Ptr("support-agent"),
-	// 					AgentVersion: to.Ptr("1.0.0"),
-	// 				},
-	// 			},
-	// 			DeploymentID: to.Ptr("550e8400-e29b-41d4-a716-446655440001"),
-	// 			DeploymentType: to.Ptr(armcognitiveservices.AgentDeploymentTypeManaged),
-	// 			DisplayName: to.Ptr("Production Deployment"),
-	// 			Protocols: []*armcognitiveservices.AgentProtocolVersion{
-	// 				{
-	// 					Version: to.Ptr("1.0"),
-	// 					Protocol: to.Ptr(armcognitiveservices.AgentProtocolAgent),
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.AgentDeploymentProvisioningStateSucceeded),
-	// 			State: to.Ptr(armcognitiveservices.AgentDeploymentStateRunning),
-	// 		},
-	// 		SystemData: &armcognitiveservices.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 			CreatedBy: to.Ptr("user@contoso.com"),
-	// 			CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@contoso.com"),
-	// 			LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: deployment-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/applications/agentDeployments
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agentDeployments/deployment-1
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentDeployment/list.json
 func ExampleAgentDeploymentsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentDeploymentsServer: fake.AgentDeploymentsServer{
+					NewListPager: func(resourceGroupName string, accountName string, projectName string, appName string, options *armcognitiveservices.AgentDeploymentsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.AgentDeploymentsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.AgentDeploymentsClientListResponse{
+							AgentDeploymentResourceArmPaginatedResult: armcognitiveservices.AgentDeploymentResourceArmPaginatedResult{
+								Value: []*armcognitiveservices.AgentDeployment{
+									{
+										Name: to.Ptr("deployment-1"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agentDeployments"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agentDeployments/deployment-1"),
+										Properties: &armcognitiveservices.ManagedAgentDeployment{
+											Agents: []*armcognitiveservices.VersionedAgentReference{
+												{
+													AgentID:      to.Ptr("agent-123"),
+													AgentName:    to.Ptr("support-agent"),
+													AgentVersion: to.Ptr("1.0.0"),
+												},
+											},
+											DeploymentID:   to.Ptr("550e8400-e29b-41d4-a716-446655440001"),
+											DeploymentType: to.Ptr(armcognitiveservices.AgentDeploymentTypeManaged),
+											DisplayName:    to.Ptr("Production Deployment"),
+											Protocols: []*armcognitiveservices.AgentProtocolVersion{
+												{
+													Version:  to.Ptr("1.0"),
+													Protocol: to.Ptr(armcognitiveservices.AgentProtocolAgent),
+												},
+											},
+											ProvisioningState: to.Ptr(armcognitiveservices.AgentDeploymentProvisioningStateSucceeded),
+											State:             to.Ptr(armcognitiveservices.AgentDeploymentStateRunning),
+										},
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+											CreatedBy:          to.Ptr("user@contoso.com"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@contoso.com"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -184,60 +267,33 @@ func ExampleAgentDeploymentsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.AgentDeploymentsClientListResponse{
-		// 	AgentDeploymentResourceArmPaginatedResult: armcognitiveservices.AgentDeploymentResourceArmPaginatedResult{
-		// 		Value: []*armcognitiveservices.AgentDeployment{
-		// 			{
-		// 				Name: to.Ptr("deployment-1"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/applications/agentDeployments"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agentDeployments/deployment-1"),
-		// 				Properties: &armcognitiveservices.ManagedAgentDeployment{
-		// 					Agents: []*armcognitiveservices.VersionedAgentReference{
-		// 						{
-		// 							AgentID: to.Ptr("agent-123"),
-		// 							AgentName: to.Ptr("support-agent"),
-		// 							AgentVersion: to.Ptr("1.0.0"),
-		// 						},
-		// 					},
-		// 					DeploymentID: to.Ptr("550e8400-e29b-41d4-a716-446655440001"),
-		// 					DeploymentType: to.Ptr(armcognitiveservices.AgentDeploymentTypeManaged),
-		// 					DisplayName: to.Ptr("Production Deployment"),
-		// 					Protocols: []*armcognitiveservices.AgentProtocolVersion{
-		// 						{
-		// 							Version: to.Ptr("1.0"),
-		// 							Protocol: to.Ptr(armcognitiveservices.AgentProtocolAgent),
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.AgentDeploymentProvisioningStateSucceeded),
-		// 					State: to.Ptr(armcognitiveservices.AgentDeploymentStateRunning),
-		// 				},
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-		// 					CreatedBy: to.Ptr("user@contoso.com"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-09-29T12:34:56.999Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@contoso.com"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: deployment-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/applications/agentDeployments
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/my-cognitive-services-account/projects/my-project/applications/agent-app-1/agentDeployments/deployment-1
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentDeployment/start.json
 func ExampleAgentDeploymentsClient_Start() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentDeploymentsServer: fake.AgentDeploymentsServer{
+					Start: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, appName string, deploymentName string, options *armcognitiveservices.AgentDeploymentsClientStartOptions) (resp azfake.Responder[armcognitiveservices.AgentDeploymentsClientStartResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AgentDeploymentsClientStartResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -247,19 +303,25 @@ func ExampleAgentDeploymentsClient_Start() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AgentDeploymentsClientStartResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/AgentDeployment/stop.json
 func ExampleAgentDeploymentsClient_Stop() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AgentDeploymentsServer: fake.AgentDeploymentsServer{
+					Stop: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, appName string, deploymentName string, options *armcognitiveservices.AgentDeploymentsClientStopOptions) (resp azfake.Responder[armcognitiveservices.AgentDeploymentsClientStopResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.AgentDeploymentsClientStopResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -269,7 +331,5 @@ func ExampleAgentDeploymentsClient_Stop() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.AgentDeploymentsClientStopResponse{
-	// }
+	// Output:
 }
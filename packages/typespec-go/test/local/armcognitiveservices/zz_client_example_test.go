@@ -6,20 +6,46 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/CalculateModelCapacity.json
 func ExampleClient_CalculateModelCapacity() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					CalculateModelCapacity: func(ctx context.Context, parameters armcognitiveservices.CalculateModelCapacityParameter, options *armcognitiveservices.ClientCalculateModelCapacityOptions) (resp azfake.Responder[armcognitiveservices.ClientCalculateModelCapacityResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ClientCalculateModelCapacityResponse{
+							CalculateModelCapacityResult: armcognitiveservices.CalculateModelCapacityResult{
+								EstimatedCapacity: &armcognitiveservices.CalculateModelCapacityResultEstimatedCapacity{
+									DeployableValue: to.Ptr[int32](400),
+									Value:           to.Ptr[int32](346),
+								},
+								Model: &armcognitiveservices.DeploymentModel{
+									Name:    to.Ptr("gpt-4"),
+									Format:  to.Ptr("OpenAI"),
+									Version: to.Ptr("0613"),
+								},
+								SKUName: to.Ptr("ProvisionedManaged"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -50,33 +76,34 @@ func ExampleClient_CalculateModelCapacity() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ClientCalculateModelCapacityResponse{
-	// 	CalculateModelCapacityResult: armcognitiveservices.CalculateModelCapacityResult{
-	// 		EstimatedCapacity: &armcognitiveservices.CalculateModelCapacityResultEstimatedCapacity{
-	// 			DeployableValue: to.Ptr[int32](400),
-	// 			Value: to.Ptr[int32](346),
-	// 		},
-	// 		Model: &armcognitiveservices.DeploymentModel{
-	// 			Name: to.Ptr("gpt-4"),
-	// 			Format: to.Ptr("OpenAI"),
-	// 			Version: to.Ptr("0613"),
-	// 		},
-	// 		SKUName: to.Ptr("ProvisionedManaged"),
-	// 	},
-	// }
+	fmt.Println("SKUName:", *res.SKUName)
+	// Output:
+	// SKUName: ProvisionedManaged
 }
 
 // Generated from example definition: 2025-10-01-preview/CheckDomainAvailability.json
 func ExampleClient_CheckDomainAvailability() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					CheckDomainAvailability: func(ctx context.Context, parameters armcognitiveservices.CheckDomainAvailabilityParameter, options *armcognitiveservices.ClientCheckDomainAvailabilityOptions) (resp azfake.Responder[armcognitiveservices.ClientCheckDomainAvailabilityResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ClientCheckDomainAvailabilityResponse{
+							DomainAvailability: armcognitiveservices.DomainAvailability{
+								Type:                 to.Ptr("Microsoft.CognitiveServices/accounts"),
+								IsSubdomainAvailable: to.Ptr(false),
+								Reason:               to.Ptr("Sub domain name 'contosodemoapp1' is not valid"),
+								SubdomainName:        to.Ptr("contosodemoapp1"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -87,27 +114,44 @@ func ExampleClient_CheckDomainAvailability() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ClientCheckDomainAvailabilityResponse{
-	// 	DomainAvailability: armcognitiveservices.DomainAvailability{
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-	// 		IsSubdomainAvailable: to.Ptr(false),
-	// 		Reason: to.Ptr("Sub domain name 'contosodemoapp1' is not valid"),
-	// 		SubdomainName: to.Ptr("contosodemoapp1"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("IsSubdomainAvailable:", *res.IsSubdomainAvailable)
+	fmt.Println("Reason:", *res.Reason)
+	fmt.Println("SubdomainName:", *res.SubdomainName)
+	// Output:
+	// Type: Microsoft.CognitiveServices/accounts
+	// IsSubdomainAvailable: false
+	// Reason: Sub domain name 'contosodemoapp1' is not valid
+	// SubdomainName: contosodemoapp1
 }
 
 // Generated from example definition: 2025-10-01-preview/CheckSkuAvailability.json
 func ExampleClient_CheckSKUAvailability() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					CheckSKUAvailability: func(ctx context.Context, location string, parameters armcognitiveservices.CheckSKUAvailabilityParameter, options *armcognitiveservices.ClientCheckSKUAvailabilityOptions) (resp azfake.Responder[armcognitiveservices.ClientCheckSKUAvailabilityResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ClientCheckSKUAvailabilityResponse{
+							SKUAvailabilityListResult: armcognitiveservices.SKUAvailabilityListResult{
+								Value: []*armcognitiveservices.SKUAvailability{
+									{
+										Type:         to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Kind:         to.Ptr("Face"),
+										SKUAvailable: to.Ptr(true),
+										SKUName:      to.Ptr("S0"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -123,17 +167,5 @@ func ExampleClient_CheckSKUAvailability() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ClientCheckSKUAvailabilityResponse{
-	// 	SKUAvailabilityListResult: armcognitiveservices.SKUAvailabilityListResult{
-	// 		Value: []*armcognitiveservices.SKUAvailability{
-	// 			{
-	// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-	// 				Kind: to.Ptr("Face"),
-	// 				SKUAvailable: to.Ptr(true),
-	// 				SKUName: to.Ptr("S0"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
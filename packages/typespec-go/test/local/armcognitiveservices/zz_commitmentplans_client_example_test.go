@@ -6,20 +6,47 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutCommitmentPlan.json
 func ExampleCommitmentPlansClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, commitmentPlanName string, commitmentPlan armcognitiveservices.CommitmentPlan, options *armcognitiveservices.CommitmentPlansClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.CommitmentPlansClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientCreateOrUpdateResponse{
+							CommitmentPlan: armcognitiveservices.CommitmentPlan{
+								Name: to.Ptr("commitmentPlanName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/commitmentPlans"),
+								ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/commitmentPlans/commitmentPlanName"),
+								Properties: &armcognitiveservices.CommitmentPlanProperties{
+									AutoRenew: to.Ptr(true),
+									Current: &armcognitiveservices.CommitmentPeriod{
+										Tier: to.Ptr("T1"),
+									},
+									HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
+									PlanType:     to.Ptr("Speech2Text"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -36,34 +63,40 @@ func ExampleCommitmentPlansClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientCreateOrUpdateResponse{
-	// 	CommitmentPlan: armcognitiveservices.CommitmentPlan{
-	// 		Name: to.Ptr("commitmentPlanName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/commitmentPlans"),
-	// 		ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/commitmentPlans/commitmentPlanName"),
-	// 		Properties: &armcognitiveservices.CommitmentPlanProperties{
-	// 			AutoRenew: to.Ptr(true),
-	// 			Current: &armcognitiveservices.CommitmentPeriod{
-	// 				Tier: to.Ptr("T1"),
-	// 			},
-	// 			HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
-	// 			PlanType: to.Ptr("Speech2Text"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: commitmentPlanName
+	// Type: Microsoft.CognitiveServices/accounts/commitmentPlans
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/commitmentPlans/commitmentPlanName
 }
 
 // Generated from example definition: 2025-10-01-preview/CreateSharedCommitmentPlanAssociation.json
 func ExampleCommitmentPlansClient_BeginCreateOrUpdateAssociation() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					BeginCreateOrUpdateAssociation: func(ctx context.Context, resourceGroupName string, commitmentPlanName string, commitmentPlanAssociationName string, association armcognitiveservices.CommitmentPlanAccountAssociation, options *armcognitiveservices.CommitmentPlansClientBeginCreateOrUpdateAssociationOptions) (resp azfake.PollerResponder[armcognitiveservices.CommitmentPlansClientCreateOrUpdateAssociationResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientCreateOrUpdateAssociationResponse{
+							CommitmentPlanAccountAssociation: armcognitiveservices.CommitmentPlanAccountAssociation{
+								Name: to.Ptr("commitmentPlanAssociationName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans/accountAssociations"),
+								ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName/accountAssociations/commitmentPlanAssociationName"),
+								Properties: &armcognitiveservices.CommitmentPlanAccountAssociationProperties{
+									AccountID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -79,29 +112,51 @@ func ExampleCommitmentPlansClient_BeginCreateOrUpdateAssociation() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientCreateOrUpdateAssociationResponse{
-	// 	CommitmentPlanAccountAssociation: armcognitiveservices.CommitmentPlanAccountAssociation{
-	// 		Name: to.Ptr("commitmentPlanAssociationName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans/accountAssociations"),
-	// 		ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName/accountAssociations/commitmentPlanAssociationName"),
-	// 		Properties: &armcognitiveservices.CommitmentPlanAccountAssociationProperties{
-	// 			AccountID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: commitmentPlanAssociationName
+	// Type: Microsoft.CognitiveServices/commitmentPlans/accountAssociations
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName/accountAssociations/commitmentPlanAssociationName
 }
 
 // Generated from example definition: 2025-10-01-preview/CreateSharedCommitmentPlan.json
 func ExampleCommitmentPlansClient_BeginCreateOrUpdatePlan() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					BeginCreateOrUpdatePlan: func(ctx context.Context, resourceGroupName string, commitmentPlanName string, commitmentPlan armcognitiveservices.CommitmentPlan, options *armcognitiveservices.CommitmentPlansClientBeginCreateOrUpdatePlanOptions) (resp azfake.PollerResponder[armcognitiveservices.CommitmentPlansClientCreateOrUpdatePlanResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientCreateOrUpdatePlanResponse{
+							CommitmentPlan: armcognitiveservices.CommitmentPlan{
+								Name:     to.Ptr("commitmentPlanName"),
+								Type:     to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
+								Kind:     to.Ptr("SpeechServices"),
+								Location: to.Ptr("West US"),
+								Properties: &armcognitiveservices.CommitmentPlanProperties{
+									AutoRenew: to.Ptr(true),
+									Current: &armcognitiveservices.CommitmentPeriod{
+										Tier: to.Ptr("T1"),
+									},
+									HostingModel:      to.Ptr(armcognitiveservices.HostingModelWeb),
+									PlanType:          to.Ptr("STT"),
+									ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name: to.Ptr("S0"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -127,40 +182,35 @@ func ExampleCommitmentPlansClient_BeginCreateOrUpdatePlan() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientCreateOrUpdatePlanResponse{
-	// 	CommitmentPlan: armcognitiveservices.CommitmentPlan{
-	// 		Name: to.Ptr("commitmentPlanName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
-	// 		Kind: to.Ptr("SpeechServices"),
-	// 		Location: to.Ptr("West US"),
-	// 		Properties: &armcognitiveservices.CommitmentPlanProperties{
-	// 			AutoRenew: to.Ptr(true),
-	// 			Current: &armcognitiveservices.CommitmentPeriod{
-	// 				Tier: to.Ptr("T1"),
-	// 			},
-	// 			HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
-	// 			PlanType: to.Ptr("STT"),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("S0"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Kind:", *res.Kind)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: commitmentPlanName
+	// Type: Microsoft.CognitiveServices/commitmentPlans
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName
+	// Kind: SpeechServices
+	// Location: West US
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteCommitmentPlan.json
 func ExampleCommitmentPlansClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, commitmentPlanName string, options *armcognitiveservices.CommitmentPlansClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.CommitmentPlansClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -174,19 +224,25 @@ func ExampleCommitmentPlansClient_BeginDelete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteSharedCommitmentPlanAssociation.json
 func ExampleCommitmentPlansClient_BeginDeleteAssociation() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					BeginDeleteAssociation: func(ctx context.Context, resourceGroupName string, commitmentPlanName string, commitmentPlanAssociationName string, options *armcognitiveservices.CommitmentPlansClientBeginDeleteAssociationOptions) (resp azfake.PollerResponder[armcognitiveservices.CommitmentPlansClientDeleteAssociationResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientDeleteAssociationResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -200,19 +256,25 @@ func ExampleCommitmentPlansClient_BeginDeleteAssociation() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientDeleteAssociationResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteSharedCommitmentPlan.json
 func ExampleCommitmentPlansClient_BeginDeletePlan() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					BeginDeletePlan: func(ctx context.Context, resourceGroupName string, commitmentPlanName string, options *armcognitiveservices.CommitmentPlansClientBeginDeletePlanOptions) (resp azfake.PollerResponder[armcognitiveservices.CommitmentPlansClientDeletePlanResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientDeletePlanResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -226,19 +288,39 @@ func ExampleCommitmentPlansClient_BeginDeletePlan() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientDeletePlanResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetCommitmentPlan.json
 func ExampleCommitmentPlansClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, commitmentPlanName string, options *armcognitiveservices.CommitmentPlansClientGetOptions) (resp azfake.Responder[armcognitiveservices.CommitmentPlansClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientGetResponse{
+							CommitmentPlan: armcognitiveservices.CommitmentPlan{
+								Name: to.Ptr("commitmentPlanName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/commitmentPlans"),
+								ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/commitmentPlans/commitmentPlanName"),
+								Properties: &armcognitiveservices.CommitmentPlanProperties{
+									AutoRenew: to.Ptr(true),
+									Current: &armcognitiveservices.CommitmentPeriod{
+										Tier: to.Ptr("T1"),
+									},
+									HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
+									PlanType:     to.Ptr("Speech2Text"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -246,34 +328,40 @@ func ExampleCommitmentPlansClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientGetResponse{
-	// 	CommitmentPlan: armcognitiveservices.CommitmentPlan{
-	// 		Name: to.Ptr("commitmentPlanName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/commitmentPlans"),
-	// 		ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/commitmentPlans/commitmentPlanName"),
-	// 		Properties: &armcognitiveservices.CommitmentPlanProperties{
-	// 			AutoRenew: to.Ptr(true),
-	// 			Current: &armcognitiveservices.CommitmentPeriod{
-	// 				Tier: to.Ptr("T1"),
-	// 			},
-	// 			HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
-	// 			PlanType: to.Ptr("Speech2Text"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: commitmentPlanName
+	// Type: Microsoft.CognitiveServices/accounts/commitmentPlans
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/commitmentPlans/commitmentPlanName
 }
 
 // Generated from example definition: 2025-10-01-preview/GetSharedCommitmentPlanAssociation.json
 func ExampleCommitmentPlansClient_GetAssociation() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					GetAssociation: func(ctx context.Context, resourceGroupName string, commitmentPlanName string, commitmentPlanAssociationName string, options *armcognitiveservices.CommitmentPlansClientGetAssociationOptions) (resp azfake.Responder[armcognitiveservices.CommitmentPlansClientGetAssociationResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientGetAssociationResponse{
+							CommitmentPlanAccountAssociation: armcognitiveservices.CommitmentPlanAccountAssociation{
+								Name: to.Ptr("commitmentPlanAssociationName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans/accountAssociations"),
+								ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName/accountAssociations/commitmentPlanAssociationName"),
+								Properties: &armcognitiveservices.CommitmentPlanAccountAssociationProperties{
+									AccountID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -281,29 +369,51 @@ func ExampleCommitmentPlansClient_GetAssociation() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientGetAssociationResponse{
-	// 	CommitmentPlanAccountAssociation: armcognitiveservices.CommitmentPlanAccountAssociation{
-	// 		Name: to.Ptr("commitmentPlanAssociationName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans/accountAssociations"),
-	// 		ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName/accountAssociations/commitmentPlanAssociationName"),
-	// 		Properties: &armcognitiveservices.CommitmentPlanAccountAssociationProperties{
-	// 			AccountID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: commitmentPlanAssociationName
+	// Type: Microsoft.CognitiveServices/commitmentPlans/accountAssociations
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName/accountAssociations/commitmentPlanAssociationName
 }
 
 // Generated from example definition: 2025-10-01-preview/GetSharedCommitmentPlan.json
 func ExampleCommitmentPlansClient_GetPlan() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					GetPlan: func(ctx context.Context, resourceGroupName string, commitmentPlanName string, options *armcognitiveservices.CommitmentPlansClientGetPlanOptions) (resp azfake.Responder[armcognitiveservices.CommitmentPlansClientGetPlanResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientGetPlanResponse{
+							CommitmentPlan: armcognitiveservices.CommitmentPlan{
+								Name:     to.Ptr("commitmentPlanName"),
+								Type:     to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
+								Kind:     to.Ptr("SpeechServices"),
+								Location: to.Ptr("West US"),
+								Properties: &armcognitiveservices.CommitmentPlanProperties{
+									AutoRenew: to.Ptr(true),
+									Current: &armcognitiveservices.CommitmentPeriod{
+										Tier: to.Ptr("T1"),
+									},
+									HostingModel:      to.Ptr(armcognitiveservices.HostingModelWeb),
+									PlanType:          to.Ptr("STT"),
+									ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name: to.Ptr("S0"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -311,40 +421,53 @@ func ExampleCommitmentPlansClient_GetPlan() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientGetPlanResponse{
-	// 	CommitmentPlan: armcognitiveservices.CommitmentPlan{
-	// 		Name: to.Ptr("commitmentPlanName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
-	// 		Kind: to.Ptr("SpeechServices"),
-	// 		Location: to.Ptr("West US"),
-	// 		Properties: &armcognitiveservices.CommitmentPlanProperties{
-	// 			AutoRenew: to.Ptr(true),
-	// 			Current: &armcognitiveservices.CommitmentPeriod{
-	// 				Tier: to.Ptr("T1"),
-	// 			},
-	// 			HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
-	// 			PlanType: to.Ptr("STT"),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("S0"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Kind:", *res.Kind)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: commitmentPlanName
+	// Type: Microsoft.CognitiveServices/commitmentPlans
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName
+	// Kind: SpeechServices
+	// Location: West US
 }
 
 // Generated from example definition: 2025-10-01-preview/ListCommitmentPlans.json
 func ExampleCommitmentPlansClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.CommitmentPlansClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.CommitmentPlansClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.CommitmentPlansClientListResponse{
+							CommitmentPlanListResult: armcognitiveservices.CommitmentPlanListResult{
+								Value: []*armcognitiveservices.CommitmentPlan{
+									{
+										Name: to.Ptr("commitmentPlanName"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/commitmentPlans"),
+										ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/commitmentPlans/commitmentPlanName"),
+										Properties: &armcognitiveservices.CommitmentPlanProperties{
+											AutoRenew: to.Ptr(true),
+											Current: &armcognitiveservices.CommitmentPeriod{
+												Tier: to.Ptr("T1"),
+											},
+											HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
+											PlanType:     to.Ptr("Speech2Text"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -355,40 +478,46 @@ func ExampleCommitmentPlansClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.CommitmentPlansClientListResponse{
-		// 	CommitmentPlanListResult: armcognitiveservices.CommitmentPlanListResult{
-		// 		Value: []*armcognitiveservices.CommitmentPlan{
-		// 			{
-		// 				Name: to.Ptr("commitmentPlanName"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/commitmentPlans"),
-		// 				ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/commitmentPlans/commitmentPlanName"),
-		// 				Properties: &armcognitiveservices.CommitmentPlanProperties{
-		// 					AutoRenew: to.Ptr(true),
-		// 					Current: &armcognitiveservices.CommitmentPeriod{
-		// 						Tier: to.Ptr("T1"),
-		// 					},
-		// 					HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
-		// 					PlanType: to.Ptr("Speech2Text"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: commitmentPlanName
+	// Type: Microsoft.CognitiveServices/accounts/commitmentPlans
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/commitmentPlans/commitmentPlanName
 }
 
 // Generated from example definition: 2025-10-01-preview/ListSharedCommitmentPlanAssociations.json
 func ExampleCommitmentPlansClient_NewListAssociationsPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					NewListAssociationsPager: func(resourceGroupName string, commitmentPlanName string, options *armcognitiveservices.CommitmentPlansClientListAssociationsOptions) (resp azfake.PagerResponder[armcognitiveservices.CommitmentPlansClientListAssociationsResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.CommitmentPlansClientListAssociationsResponse{
+							CommitmentPlanAccountAssociationListResult: armcognitiveservices.CommitmentPlanAccountAssociationListResult{
+								Value: []*armcognitiveservices.CommitmentPlanAccountAssociation{
+									{
+										Name: to.Ptr("accountAssociationName"),
+										Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans/accountAssociations"),
+										ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName/accountAssociations/accountAssociationName"),
+										Properties: &armcognitiveservices.CommitmentPlanAccountAssociationProperties{
+											AccountID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -399,35 +528,57 @@ func ExampleCommitmentPlansClient_NewListAssociationsPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.CommitmentPlansClientListAssociationsResponse{
-		// 	CommitmentPlanAccountAssociationListResult: armcognitiveservices.CommitmentPlanAccountAssociationListResult{
-		// 		Value: []*armcognitiveservices.CommitmentPlanAccountAssociation{
-		// 			{
-		// 				Name: to.Ptr("accountAssociationName"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans/accountAssociations"),
-		// 				ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName/accountAssociations/accountAssociationName"),
-		// 				Properties: &armcognitiveservices.CommitmentPlanAccountAssociationProperties{
-		// 					AccountID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: accountAssociationName
+	// Type: Microsoft.CognitiveServices/commitmentPlans/accountAssociations
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName/accountAssociations/accountAssociationName
 }
 
 // Generated from example definition: 2025-10-01-preview/ListSharedCommitmentPlansByResourceGroup.json
 func ExampleCommitmentPlansClient_NewListPlansByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					NewListPlansByResourceGroupPager: func(resourceGroupName string, options *armcognitiveservices.CommitmentPlansClientListPlansByResourceGroupOptions) (resp azfake.PagerResponder[armcognitiveservices.CommitmentPlansClientListPlansByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.CommitmentPlansClientListPlansByResourceGroupResponse{
+							CommitmentPlanListResult: armcognitiveservices.CommitmentPlanListResult{
+								Value: []*armcognitiveservices.CommitmentPlan{
+									{
+										Name:     to.Ptr("commitmentPlanName"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
+										Kind:     to.Ptr("SpeechServices"),
+										Location: to.Ptr("West US"),
+										Properties: &armcognitiveservices.CommitmentPlanProperties{
+											AutoRenew: to.Ptr(true),
+											Current: &armcognitiveservices.CommitmentPeriod{
+												Tier: to.Ptr("T1"),
+											},
+											HostingModel:      to.Ptr(armcognitiveservices.HostingModelWeb),
+											PlanType:          to.Ptr("STT"),
+											ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -438,46 +589,61 @@ func ExampleCommitmentPlansClient_NewListPlansByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Kind:", *v.Kind)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.CommitmentPlansClientListPlansByResourceGroupResponse{
-		// 	CommitmentPlanListResult: armcognitiveservices.CommitmentPlanListResult{
-		// 		Value: []*armcognitiveservices.CommitmentPlan{
-		// 			{
-		// 				Name: to.Ptr("commitmentPlanName"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
-		// 				Kind: to.Ptr("SpeechServices"),
-		// 				Location: to.Ptr("West US"),
-		// 				Properties: &armcognitiveservices.CommitmentPlanProperties{
-		// 					AutoRenew: to.Ptr(true),
-		// 					Current: &armcognitiveservices.CommitmentPeriod{
-		// 						Tier: to.Ptr("T1"),
-		// 					},
-		// 					HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
-		// 					PlanType: to.Ptr("STT"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S0"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: commitmentPlanName
+	// Type: Microsoft.CognitiveServices/commitmentPlans
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName
+	// Kind: SpeechServices
+	// Location: West US
 }
 
 // Generated from example definition: 2025-10-01-preview/ListSharedCommitmentPlansBySubscription.json
 func ExampleCommitmentPlansClient_NewListPlansBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					NewListPlansBySubscriptionPager: func(options *armcognitiveservices.CommitmentPlansClientListPlansBySubscriptionOptions) (resp azfake.PagerResponder[armcognitiveservices.CommitmentPlansClientListPlansBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.CommitmentPlansClientListPlansBySubscriptionResponse{
+							CommitmentPlanListResult: armcognitiveservices.CommitmentPlanListResult{
+								Value: []*armcognitiveservices.CommitmentPlan{
+									{
+										Name:     to.Ptr("commitmentPlanName"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
+										Kind:     to.Ptr("SpeechServices"),
+										Location: to.Ptr("West US"),
+										Properties: &armcognitiveservices.CommitmentPlanProperties{
+											AutoRenew: to.Ptr(true),
+											Current: &armcognitiveservices.CommitmentPeriod{
+												Tier: to.Ptr("T1"),
+											},
+											HostingModel:      to.Ptr(armcognitiveservices.HostingModelWeb),
+											PlanType:          to.Ptr("STT"),
+											ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -488,46 +654,60 @@ func ExampleCommitmentPlansClient_NewListPlansBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Kind:", *v.Kind)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.CommitmentPlansClientListPlansBySubscriptionResponse{
-		// 	CommitmentPlanListResult: armcognitiveservices.CommitmentPlanListResult{
-		// 		Value: []*armcognitiveservices.CommitmentPlan{
-		// 			{
-		// 				Name: to.Ptr("commitmentPlanName"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
-		// 				Kind: to.Ptr("SpeechServices"),
-		// 				Location: to.Ptr("West US"),
-		// 				Properties: &armcognitiveservices.CommitmentPlanProperties{
-		// 					AutoRenew: to.Ptr(true),
-		// 					Current: &armcognitiveservices.CommitmentPeriod{
-		// 						Tier: to.Ptr("T1"),
-		// 					},
-		// 					HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
-		// 					PlanType: to.Ptr("STT"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S0"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: commitmentPlanName
+	// Type: Microsoft.CognitiveServices/commitmentPlans
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName
+	// Kind: SpeechServices
+	// Location: West US
 }
 
 // Generated from example definition: 2025-10-01-preview/UpdateSharedCommitmentPlan.json
 func ExampleCommitmentPlansClient_BeginUpdatePlan() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentPlansServer: fake.CommitmentPlansServer{
+					BeginUpdatePlan: func(ctx context.Context, resourceGroupName string, commitmentPlanName string, commitmentPlan armcognitiveservices.PatchResourceTagsAndSKU, options *armcognitiveservices.CommitmentPlansClientBeginUpdatePlanOptions) (resp azfake.PollerResponder[armcognitiveservices.CommitmentPlansClientUpdatePlanResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.CommitmentPlansClientUpdatePlanResponse{
+							CommitmentPlan: armcognitiveservices.CommitmentPlan{
+								Name:     to.Ptr("commitmentPlanName"),
+								Type:     to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
+								Kind:     to.Ptr("SpeechServices"),
+								Location: to.Ptr("West US"),
+								Properties: &armcognitiveservices.CommitmentPlanProperties{
+									AutoRenew: to.Ptr(true),
+									Current: &armcognitiveservices.CommitmentPeriod{
+										Tier: to.Ptr("T1"),
+									},
+									HostingModel:      to.Ptr(armcognitiveservices.HostingModelWeb),
+									PlanType:          to.Ptr("STT"),
+									ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name: to.Ptr("S0"),
+								},
+								Tags: map[string]*string{
+									"name": to.Ptr("value"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -543,31 +723,15 @@ func ExampleCommitmentPlansClient_BeginUpdatePlan() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.CommitmentPlansClientUpdatePlanResponse{
-	// 	CommitmentPlan: armcognitiveservices.CommitmentPlan{
-	// 		Name: to.Ptr("commitmentPlanName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/commitmentPlans"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName"),
-	// 		Kind: to.Ptr("SpeechServices"),
-	// 		Location: to.Ptr("West US"),
-	// 		Properties: &armcognitiveservices.CommitmentPlanProperties{
-	// 			AutoRenew: to.Ptr(true),
-	// 			Current: &armcognitiveservices.CommitmentPeriod{
-	// 				Tier: to.Ptr("T1"),
-	// 			},
-	// 			HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
-	// 			PlanType: to.Ptr("STT"),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.CommitmentPlanProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("S0"),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"name": to.Ptr("value"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Kind:", *res.Kind)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: commitmentPlanName
+	// Type: Microsoft.CognitiveServices/commitmentPlans
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/commitmentPlans/commitmentPlanName
+	// Kind: SpeechServices
+	// Location: West US
 }
@@ -6,19 +6,50 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/ListCommitmentTiers.json
 func ExampleCommitmentTiersClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommitmentTiersServer: fake.CommitmentTiersServer{
+					NewListPager: func(location string, options *armcognitiveservices.CommitmentTiersClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.CommitmentTiersClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.CommitmentTiersClientListResponse{
+							CommitmentTierListResult: armcognitiveservices.CommitmentTierListResult{
+								Value: []*armcognitiveservices.CommitmentTier{
+									{
+										Cost:         &armcognitiveservices.CommitmentCost{},
+										HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
+										Kind:         to.Ptr("TextAnalytics"),
+										PlanType:     to.Ptr("TA"),
+										Quota: &armcognitiveservices.CommitmentQuota{
+											Quantity: to.Ptr[int64](1000000),
+											Unit:     to.Ptr("Transaction"),
+										},
+										SKUName: to.Ptr("S"),
+										Tier:    to.Ptr("T1"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,28 +60,17 @@ func ExampleCommitmentTiersClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("HostingModel:", *v.HostingModel)
+			fmt.Println("Kind:", *v.Kind)
+			fmt.Println("PlanType:", *v.PlanType)
+			fmt.Println("SKUName:", *v.SKUName)
+			fmt.Println("Tier:", *v.Tier)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.CommitmentTiersClientListResponse{
-		// 	CommitmentTierListResult: armcognitiveservices.CommitmentTierListResult{
-		// 		Value: []*armcognitiveservices.CommitmentTier{
-		// 			{
-		// 				Cost: &armcognitiveservices.CommitmentCost{
-		// 				},
-		// 				HostingModel: to.Ptr(armcognitiveservices.HostingModelWeb),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				PlanType: to.Ptr("TA"),
-		// 				Quota: &armcognitiveservices.CommitmentQuota{
-		// 					Quantity: to.Ptr[int64](1000000),
-		// 					Unit: to.Ptr("Transaction"),
-		// 				},
-		// 				SKUName: to.Ptr("S"),
-		// 				Tier: to.Ptr("T1"),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// HostingModel: Web
+	// Kind: TextAnalytics
+	// PlanType: TA
+	// SKUName: S
+	// Tier: T1
 }
@@ -6,20 +6,52 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutDefenderForAISetting.json
 func ExampleDefenderForAISettingsClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DefenderForAISettingsServer: fake.DefenderForAISettingsServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, defenderForAISettingName string, defenderForAISettings armcognitiveservices.DefenderForAISetting, options *armcognitiveservices.DefenderForAISettingsClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.DefenderForAISettingsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.DefenderForAISettingsClientCreateOrUpdateResponse{
+							DefenderForAISetting: armcognitiveservices.DefenderForAISetting{
+								Name: to.Ptr("Default"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/defenderForAISettings"),
+								Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default"),
+								Properties: &armcognitiveservices.DefenderForAISettingProperties{
+									State: to.Ptr(armcognitiveservices.DefenderForAISettingStateEnabled),
+								},
+								SystemData: &armcognitiveservices.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t }()),
+									CreatedBy:          to.Ptr("xxx@microsoft.com"),
+									CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t }()),
+									LastModifiedBy:     to.Ptr("xxx@microsoft.com"),
+									LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -31,38 +63,51 @@ func ExampleDefenderForAISettingsClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DefenderForAISettingsClientCreateOrUpdateResponse{
-	// 	DefenderForAISetting: armcognitiveservices.DefenderForAISetting{
-	// 		Name: to.Ptr("Default"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/defenderForAISettings"),
-	// 		Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default"),
-	// 		Properties: &armcognitiveservices.DefenderForAISettingProperties{
-	// 			State: to.Ptr(armcognitiveservices.DefenderForAISettingStateEnabled),
-	// 		},
-	// 		SystemData: &armcognitiveservices.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t}()),
-	// 			CreatedBy: to.Ptr("xxx@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("xxx@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: Default
+	// Type: Microsoft.CognitiveServices/accounts/defenderForAISettings
+	// Etag: "00000000-0000-0000-0000-000000000000"
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default
 }
 
 // Generated from example definition: 2025-10-01-preview/GetDefenderForAISetting.json
 func ExampleDefenderForAISettingsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DefenderForAISettingsServer: fake.DefenderForAISettingsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, defenderForAISettingName string, options *armcognitiveservices.DefenderForAISettingsClientGetOptions) (resp azfake.Responder[armcognitiveservices.DefenderForAISettingsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.DefenderForAISettingsClientGetResponse{
+							DefenderForAISetting: armcognitiveservices.DefenderForAISetting{
+								Name: to.Ptr("Default"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/defenderForAISettings"),
+								Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default"),
+								Properties: &armcognitiveservices.DefenderForAISettingProperties{
+									State: to.Ptr(armcognitiveservices.DefenderForAISettingStateEnabled),
+								},
+								SystemData: &armcognitiveservices.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t }()),
+									CreatedBy:          to.Ptr("xxx@microsoft.com"),
+									CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t }()),
+									LastModifiedBy:     to.Ptr("xxx@microsoft.com"),
+									LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -70,38 +115,55 @@ func ExampleDefenderForAISettingsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DefenderForAISettingsClientGetResponse{
-	// 	DefenderForAISetting: armcognitiveservices.DefenderForAISetting{
-	// 		Name: to.Ptr("Default"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/defenderForAISettings"),
-	// 		Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default"),
-	// 		Properties: &armcognitiveservices.DefenderForAISettingProperties{
-	// 			State: to.Ptr(armcognitiveservices.DefenderForAISettingStateEnabled),
-	// 		},
-	// 		SystemData: &armcognitiveservices.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t}()),
-	// 			CreatedBy: to.Ptr("xxx@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("xxx@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: Default
+	// Type: Microsoft.CognitiveServices/accounts/defenderForAISettings
+	// Etag: "00000000-0000-0000-0000-000000000000"
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default
 }
 
 // Generated from example definition: 2025-10-01-preview/ListDefenderForAISetting.json
 func ExampleDefenderForAISettingsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DefenderForAISettingsServer: fake.DefenderForAISettingsServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.DefenderForAISettingsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.DefenderForAISettingsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.DefenderForAISettingsClientListResponse{
+							DefenderForAISettingResult: armcognitiveservices.DefenderForAISettingResult{
+								Value: []*armcognitiveservices.DefenderForAISetting{
+									{
+										Name: to.Ptr("Default"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/defenderForAISettings"),
+										Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default"),
+										Properties: &armcognitiveservices.DefenderForAISettingProperties{
+											State: to.Ptr(armcognitiveservices.DefenderForAISettingStateEnabled),
+										},
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t }()),
+											CreatedBy:          to.Ptr("xxx@microsoft.com"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t }()),
+											LastModifiedBy:     to.Ptr("xxx@microsoft.com"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -112,44 +174,53 @@ func ExampleDefenderForAISettingsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Etag:", *v.Etag)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.DefenderForAISettingsClientListResponse{
-		// 	DefenderForAISettingResult: armcognitiveservices.DefenderForAISettingResult{
-		// 		Value: []*armcognitiveservices.DefenderForAISetting{
-		// 			{
-		// 				Name: to.Ptr("Default"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/defenderForAISettings"),
-		// 				Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default"),
-		// 				Properties: &armcognitiveservices.DefenderForAISettingProperties{
-		// 					State: to.Ptr(armcognitiveservices.DefenderForAISettingStateEnabled),
-		// 				},
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t}()),
-		// 					CreatedBy: to.Ptr("xxx@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("xxx@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Default
+	// Type: Microsoft.CognitiveServices/accounts/defenderForAISettings
+	// Etag: "00000000-0000-0000-0000-000000000000"
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default
 }
 
 // Generated from example definition: 2025-10-01-preview/UpdateDefenderForAISetting.json
 func ExampleDefenderForAISettingsClient_Update() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DefenderForAISettingsServer: fake.DefenderForAISettingsServer{
+					Update: func(ctx context.Context, resourceGroupName string, accountName string, defenderForAISettingName string, defenderForAISettings armcognitiveservices.DefenderForAISetting, options *armcognitiveservices.DefenderForAISettingsClientUpdateOptions) (resp azfake.Responder[armcognitiveservices.DefenderForAISettingsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.DefenderForAISettingsClientUpdateResponse{
+							DefenderForAISetting: armcognitiveservices.DefenderForAISetting{
+								Name: to.Ptr("Default"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/defenderForAISettings"),
+								Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default"),
+								Properties: &armcognitiveservices.DefenderForAISettingProperties{
+									State: to.Ptr(armcognitiveservices.DefenderForAISettingStateEnabled),
+								},
+								SystemData: &armcognitiveservices.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t }()),
+									CreatedBy:          to.Ptr("xxx@microsoft.com"),
+									CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t }()),
+									LastModifiedBy:     to.Ptr("xxx@microsoft.com"),
+									LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -161,26 +232,13 @@ func ExampleDefenderForAISettingsClient_Update() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DefenderForAISettingsClientUpdateResponse{
-	// 	DefenderForAISetting: armcognitiveservices.DefenderForAISetting{
-	// 		Name: to.Ptr("Default"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/defenderForAISettings"),
-	// 		Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default"),
-	// 		Properties: &armcognitiveservices.DefenderForAISettingProperties{
-	// 			State: to.Ptr(armcognitiveservices.DefenderForAISettingStateEnabled),
-	// 		},
-	// 		SystemData: &armcognitiveservices.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t}()),
-	// 			CreatedBy: to.Ptr("xxx@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-04-03T04:41:33.937Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("xxx@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: Default
+	// Type: Microsoft.CognitiveServices/accounts/defenderForAISettings
+	// Etag: "00000000-0000-0000-0000-000000000000"
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/defenderForAISettings/Default
 }
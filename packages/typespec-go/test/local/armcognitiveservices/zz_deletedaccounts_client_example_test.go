@@ -6,19 +6,53 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/GetDeletedAccount.json
 func ExampleDeletedAccountsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeletedAccountsServer: fake.DeletedAccountsServer{
+					Get: func(ctx context.Context, location string, resourceGroupName string, accountName string, options *armcognitiveservices.DeletedAccountsClientGetOptions) (resp azfake.Responder[armcognitiveservices.DeletedAccountsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.DeletedAccountsClientGetResponse{
+							Account: armcognitiveservices.Account{
+								Name:     to.Ptr("myAccount"),
+								Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+								Etag:     to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/providers/Microsoft.CognitiveServices/locations/westus/resourceGroups/myResourceGroup/deletedAccounts/myAccount"),
+								Kind:     to.Ptr("Emotion"),
+								Location: to.Ptr("westus"),
+								Properties: &armcognitiveservices.AccountProperties{
+									Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
+									ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name: to.Ptr("F0"),
+								},
+								Tags: map[string]*string{
+									"ExpiredDate": to.Ptr("2017/09/01"),
+									"Owner":       to.Ptr("felixwa"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -26,40 +60,105 @@ func ExampleDeletedAccountsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DeletedAccountsClientGetResponse{
-	// 	Account: armcognitiveservices.Account{
-	// 		Name: to.Ptr("myAccount"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-	// 		Etag: to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/providers/Microsoft.CognitiveServices/locations/westus/resourceGroups/myResourceGroup/deletedAccounts/myAccount"),
-	// 		Kind: to.Ptr("Emotion"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armcognitiveservices.AccountProperties{
-	// 			Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("F0"),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"ExpiredDate": to.Ptr("2017/09/01"),
-	// 			"Owner": to.Ptr("felixwa"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Kind:", *res.Kind)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: myAccount
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-04-10T04%3A42%3A19.7067387Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/providers/Microsoft.CognitiveServices/locations/westus/resourceGroups/myResourceGroup/deletedAccounts/myAccount
+	// Kind: Emotion
+	// Location: westus
 }
 
 // Generated from example definition: 2025-10-01-preview/ListDeletedAccountsBySubscription.json
 func ExampleDeletedAccountsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeletedAccountsServer: fake.DeletedAccountsServer{
+					NewListPager: func(options *armcognitiveservices.DeletedAccountsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.DeletedAccountsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.DeletedAccountsClientListResponse{
+							AccountListResult: armcognitiveservices.AccountListResult{
+								Value: []*armcognitiveservices.Account{
+									{
+										Name:     to.Ptr("bingSearch"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-03-27T11%3A19%3A08.762494Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch"),
+										Kind:     to.Ptr("Bing.Search"),
+										Location: to.Ptr("global"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://api.cognitive.microsoft.com/bing/v5.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S1"),
+										},
+									},
+									{
+										Name:     to.Ptr("CrisProd"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-03-31T08%3A57%3A07.4499566Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/CrisProd"),
+										Kind:     to.Ptr("CRIS"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/sts/v1.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+										},
+										Tags: map[string]*string{
+											"can't delete it successfully": to.Ptr("v-yunjin"),
+										},
+									},
+									{
+										Name:     to.Ptr("rayrptest0308"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-03-27T11%3A15%3A23.5232645Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/rayrptest0308"),
+										Kind:     to.Ptr("Face"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/face/v1.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+										},
+									},
+									{
+										Name:     to.Ptr("raytest02"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts"),
+										Etag:     to.Ptr("W/\"datetime'2017-04-04T02%3A07%3A07.3957572Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/raytest02"),
+										Kind:     to.Ptr("Emotion"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.AccountProperties{
+											Endpoint:          to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name: to.Ptr("S0"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -70,90 +169,57 @@ func ExampleDeletedAccountsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Etag:", *v.Etag)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Kind:", *v.Kind)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.DeletedAccountsClientListResponse{
-		// 	AccountListResult: armcognitiveservices.AccountListResult{
-		// 		Value: []*armcognitiveservices.Account{
-		// 			{
-		// 				Name: to.Ptr("bingSearch"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-03-27T11%3A19%3A08.762494Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch"),
-		// 				Kind: to.Ptr("Bing.Search"),
-		// 				Location: to.Ptr("global"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://api.cognitive.microsoft.com/bing/v5.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S1"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("CrisProd"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-03-31T08%3A57%3A07.4499566Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/CrisProd"),
-		// 				Kind: to.Ptr("CRIS"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/sts/v1.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S0"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"can't delete it successfully": to.Ptr("v-yunjin"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("rayrptest0308"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-03-27T11%3A15%3A23.5232645Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/rayrptest0308"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/face/v1.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S0"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("raytest02"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-04-04T02%3A07%3A07.3957572Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/raytest02"),
-		// 				Kind: to.Ptr("Emotion"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.AccountProperties{
-		// 					Endpoint: to.Ptr("https://westus.api.cognitive.microsoft.com/emotion/v1.0"),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("S0"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: bingSearch
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-03-27T11%3A19%3A08.762494Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch
+	// Kind: Bing.Search
+	// Location: global
+	// Name: CrisProd
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-03-31T08%3A57%3A07.4499566Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/CrisProd
+	// Kind: CRIS
+	// Location: westus
+	// Name: rayrptest0308
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-03-27T11%3A15%3A23.5232645Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/rayrptest0308
+	// Kind: Face
+	// Location: westus
+	// Name: raytest02
+	// Type: Microsoft.CognitiveServices/accounts
+	// Etag: W/"datetime'2017-04-04T02%3A07%3A07.3957572Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/raytest02
+	// Kind: Emotion
+	// Location: westus
 }
 
 // Generated from example definition: 2025-10-01-preview/PurgeDeletedAccount.json
 func ExampleDeletedAccountsClient_BeginPurge() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeletedAccountsServer: fake.DeletedAccountsServer{
+					BeginPurge: func(ctx context.Context, location string, resourceGroupName string, accountName string, options *armcognitiveservices.DeletedAccountsClientBeginPurgeOptions) (resp azfake.PollerResponder[armcognitiveservices.DeletedAccountsClientPurgeResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.DeletedAccountsClientPurgeResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -167,7 +233,5 @@ func ExampleDeletedAccountsClient_BeginPurge() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DeletedAccountsClientPurgeResponse{
-	// }
+	// Output:
 }
@@ -6,20 +6,53 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutDeployment.json
 func ExampleDeploymentsClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, deploymentName string, deployment armcognitiveservices.Deployment, options *armcognitiveservices.DeploymentsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.DeploymentsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.DeploymentsClientCreateOrUpdateResponse{
+							Deployment: armcognitiveservices.Deployment{
+								Name: to.Ptr("deploymentName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
+								ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
+								Properties: &armcognitiveservices.DeploymentProperties{
+									DeploymentState: to.Ptr(armcognitiveservices.DeploymentStateRunning),
+									Model: &armcognitiveservices.DeploymentModel{
+										Name:    to.Ptr("ada"),
+										Format:  to.Ptr("OpenAI"),
+										Version: to.Ptr("1"),
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
+									ServiceTier:       to.Ptr(armcognitiveservices.ServiceTierPriority),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name:     to.Ptr("Standard"),
+									Capacity: to.Ptr[int32](1),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -45,40 +78,31 @@ func ExampleDeploymentsClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DeploymentsClientCreateOrUpdateResponse{
-	// 	Deployment: armcognitiveservices.Deployment{
-	// 		Name: to.Ptr("deploymentName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
-	// 		ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
-	// 		Properties: &armcognitiveservices.DeploymentProperties{
-	// 			DeploymentState: to.Ptr(armcognitiveservices.DeploymentStateRunning),
-	// 			Model: &armcognitiveservices.DeploymentModel{
-	// 				Name: to.Ptr("ada"),
-	// 				Format: to.Ptr("OpenAI"),
-	// 				Version: to.Ptr("1"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
-	// 			ServiceTier: to.Ptr(armcognitiveservices.ServiceTierPriority),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("Standard"),
-	// 			Capacity: to.Ptr[int32](1),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: deploymentName
+	// Type: Microsoft.CognitiveServices/accounts/deployments
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteDeployment.json
 func ExampleDeploymentsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, deploymentName string, options *armcognitiveservices.DeploymentsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.DeploymentsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.DeploymentsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -92,19 +116,45 @@ func ExampleDeploymentsClient_BeginDelete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DeploymentsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetDeployment.json
 func ExampleDeploymentsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, deploymentName string, options *armcognitiveservices.DeploymentsClientGetOptions) (resp azfake.Responder[armcognitiveservices.DeploymentsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.DeploymentsClientGetResponse{
+							Deployment: armcognitiveservices.Deployment{
+								Name: to.Ptr("deploymentName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
+								ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
+								Properties: &armcognitiveservices.DeploymentProperties{
+									DeploymentState: to.Ptr(armcognitiveservices.DeploymentStateRunning),
+									Model: &armcognitiveservices.DeploymentModel{
+										Name:    to.Ptr("ada"),
+										Format:  to.Ptr("OpenAI"),
+										Version: to.Ptr("1"),
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
+									ServiceTier:       to.Ptr(armcognitiveservices.ServiceTierDefault),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name:     to.Ptr("Standard"),
+									Capacity: to.Ptr[int32](1),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -112,40 +162,55 @@ func ExampleDeploymentsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DeploymentsClientGetResponse{
-	// 	Deployment: armcognitiveservices.Deployment{
-	// 		Name: to.Ptr("deploymentName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
-	// 		ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
-	// 		Properties: &armcognitiveservices.DeploymentProperties{
-	// 			DeploymentState: to.Ptr(armcognitiveservices.DeploymentStateRunning),
-	// 			Model: &armcognitiveservices.DeploymentModel{
-	// 				Name: to.Ptr("ada"),
-	// 				Format: to.Ptr("OpenAI"),
-	// 				Version: to.Ptr("1"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
-	// 			ServiceTier: to.Ptr(armcognitiveservices.ServiceTierDefault),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("Standard"),
-	// 			Capacity: to.Ptr[int32](1),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: deploymentName
+	// Type: Microsoft.CognitiveServices/accounts/deployments
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName
 }
 
 // Generated from example definition: 2025-10-01-preview/ListDeployments.json
 func ExampleDeploymentsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.DeploymentsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.DeploymentsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.DeploymentsClientListResponse{
+							DeploymentListResult: armcognitiveservices.DeploymentListResult{
+								Value: []*armcognitiveservices.Deployment{
+									{
+										Name: to.Ptr("deploymentName"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
+										ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
+										Properties: &armcognitiveservices.DeploymentProperties{
+											DeploymentState: to.Ptr(armcognitiveservices.DeploymentStateRunning),
+											Model: &armcognitiveservices.DeploymentModel{
+												Name:    to.Ptr("ada"),
+												Format:  to.Ptr("OpenAI"),
+												Version: to.Ptr("1"),
+											},
+											ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
+											ServiceTier:       to.Ptr(armcognitiveservices.ServiceTierDefault),
+										},
+										SKU: &armcognitiveservices.SKU{
+											Name:     to.Ptr("Standard"),
+											Capacity: to.Ptr[int32](1),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -156,46 +221,55 @@ func ExampleDeploymentsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.DeploymentsClientListResponse{
-		// 	DeploymentListResult: armcognitiveservices.DeploymentListResult{
-		// 		Value: []*armcognitiveservices.Deployment{
-		// 			{
-		// 				Name: to.Ptr("deploymentName"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
-		// 				ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
-		// 				Properties: &armcognitiveservices.DeploymentProperties{
-		// 					DeploymentState: to.Ptr(armcognitiveservices.DeploymentStateRunning),
-		// 					Model: &armcognitiveservices.DeploymentModel{
-		// 						Name: to.Ptr("ada"),
-		// 						Format: to.Ptr("OpenAI"),
-		// 						Version: to.Ptr("1"),
-		// 					},
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
-		// 					ServiceTier: to.Ptr(armcognitiveservices.ServiceTierDefault),
-		// 				},
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("Standard"),
-		// 					Capacity: to.Ptr[int32](1),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: deploymentName
+	// Type: Microsoft.CognitiveServices/accounts/deployments
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName
 }
 
 // Generated from example definition: 2025-10-01-preview/ListDeploymentSkus.json
 func ExampleDeploymentsClient_NewListSKUsPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					NewListSKUsPager: func(resourceGroupName string, accountName string, deploymentName string, options *armcognitiveservices.DeploymentsClientListSKUsOptions) (resp azfake.PagerResponder[armcognitiveservices.DeploymentsClientListSKUsResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.DeploymentsClientListSKUsResponse{
+							DeploymentSKUListResult: armcognitiveservices.DeploymentSKUListResult{
+								Value: []*armcognitiveservices.SKUResource{
+									{
+										Capacity: &armcognitiveservices.CapacityConfig{
+											Default: to.Ptr[int32](100),
+											AllowedValues: []*int32{
+												to.Ptr[int32](100),
+												to.Ptr[int32](200),
+											},
+											Maximum: to.Ptr[int32](1000),
+											Minimum: to.Ptr[int32](100),
+											Step:    to.Ptr[int32](100),
+										},
+										ResourceType: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
+										SKU: &armcognitiveservices.SKU{
+											Name:     to.Ptr("Standard"),
+											Capacity: to.Ptr[int32](1),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -206,44 +280,48 @@ func ExampleDeploymentsClient_NewListSKUsPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ResourceType:", *v.ResourceType)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.DeploymentsClientListSKUsResponse{
-		// 	DeploymentSKUListResult: armcognitiveservices.DeploymentSKUListResult{
-		// 		Value: []*armcognitiveservices.SKUResource{
-		// 			{
-		// 				Capacity: &armcognitiveservices.CapacityConfig{
-		// 					Default: to.Ptr[int32](100),
-		// 					AllowedValues: []*int32{
-		// 						to.Ptr[int32](100),
-		// 						to.Ptr[int32](200),
-		// 					},
-		// 					Maximum: to.Ptr[int32](1000),
-		// 					Minimum: to.Ptr[int32](100),
-		// 					Step: to.Ptr[int32](100),
-		// 				},
-		// 				ResourceType: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
-		// 				SKU: &armcognitiveservices.SKU{
-		// 					Name: to.Ptr("Standard"),
-		// 					Capacity: to.Ptr[int32](1),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ResourceType: Microsoft.CognitiveServices/accounts/deployments
 }
 
 // Generated from example definition: 2025-10-01-preview/PauseDeployment.json
 func ExampleDeploymentsClient_Pause() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					Pause: func(ctx context.Context, resourceGroupName string, accountName string, deploymentName string, options *armcognitiveservices.DeploymentsClientPauseOptions) (resp azfake.Responder[armcognitiveservices.DeploymentsClientPauseResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.DeploymentsClientPauseResponse{
+							Deployment: armcognitiveservices.Deployment{
+								Name: to.Ptr("deploymentName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
+								ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
+								Properties: &armcognitiveservices.DeploymentProperties{
+									DeploymentState: to.Ptr(armcognitiveservices.DeploymentStatePaused),
+									Model: &armcognitiveservices.DeploymentModel{
+										Name:    to.Ptr("gpt-4"),
+										Format:  to.Ptr("OpenAI"),
+										Version: to.Ptr("0613"),
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name:     to.Ptr("Standard"),
+									Capacity: to.Ptr[int32](1),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -251,39 +329,50 @@ func ExampleDeploymentsClient_Pause() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DeploymentsClientPauseResponse{
-	// 	Deployment: armcognitiveservices.Deployment{
-	// 		Name: to.Ptr("deploymentName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
-	// 		ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
-	// 		Properties: &armcognitiveservices.DeploymentProperties{
-	// 			DeploymentState: to.Ptr(armcognitiveservices.DeploymentStatePaused),
-	// 			Model: &armcognitiveservices.DeploymentModel{
-	// 				Name: to.Ptr("gpt-4"),
-	// 				Format: to.Ptr("OpenAI"),
-	// 				Version: to.Ptr("0613"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("Standard"),
-	// 			Capacity: to.Ptr[int32](1),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: deploymentName
+	// Type: Microsoft.CognitiveServices/accounts/deployments
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName
 }
 
 // Generated from example definition: 2025-10-01-preview/ResumeDeployment.json
 func ExampleDeploymentsClient_Resume() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					Resume: func(ctx context.Context, resourceGroupName string, accountName string, deploymentName string, options *armcognitiveservices.DeploymentsClientResumeOptions) (resp azfake.Responder[armcognitiveservices.DeploymentsClientResumeResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.DeploymentsClientResumeResponse{
+							Deployment: armcognitiveservices.Deployment{
+								Name: to.Ptr("deploymentName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
+								ID:   to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
+								Properties: &armcognitiveservices.DeploymentProperties{
+									DeploymentState: to.Ptr(armcognitiveservices.DeploymentStateRunning),
+									Model: &armcognitiveservices.DeploymentModel{
+										Name:    to.Ptr("gpt-4"),
+										Format:  to.Ptr("OpenAI"),
+										Version: to.Ptr("0613"),
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name:     to.Ptr("Standard"),
+									Capacity: to.Ptr[int32](1),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -291,39 +380,51 @@ func ExampleDeploymentsClient_Resume() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DeploymentsClientResumeResponse{
-	// 	Deployment: armcognitiveservices.Deployment{
-	// 		Name: to.Ptr("deploymentName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
-	// 		ID: to.Ptr("/subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
-	// 		Properties: &armcognitiveservices.DeploymentProperties{
-	// 			DeploymentState: to.Ptr(armcognitiveservices.DeploymentStateRunning),
-	// 			Model: &armcognitiveservices.DeploymentModel{
-	// 				Name: to.Ptr("gpt-4"),
-	// 				Format: to.Ptr("OpenAI"),
-	// 				Version: to.Ptr("0613"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("Standard"),
-	// 			Capacity: to.Ptr[int32](1),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: deploymentName
+	// Type: Microsoft.CognitiveServices/accounts/deployments
+	// ID: /subscriptions/subscriptionId/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName
 }
 
 // Generated from example definition: 2025-10-01-preview/UpdateDeployment.json
 func ExampleDeploymentsClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DeploymentsServer: fake.DeploymentsServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, accountName string, deploymentName string, deployment armcognitiveservices.PatchResourceTagsAndSKU, options *armcognitiveservices.DeploymentsClientBeginUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.DeploymentsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.DeploymentsClientUpdateResponse{
+							Deployment: armcognitiveservices.Deployment{
+								Name: to.Ptr("deploymentName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
+								Properties: &armcognitiveservices.DeploymentProperties{
+									DeploymentState: to.Ptr(armcognitiveservices.DeploymentStatePaused),
+									Model: &armcognitiveservices.DeploymentModel{
+										Name:    to.Ptr("ada"),
+										Format:  to.Ptr("OpenAI"),
+										Version: to.Ptr("1"),
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
+									ServiceTier:       to.Ptr(armcognitiveservices.ServiceTierPriority),
+								},
+								SKU: &armcognitiveservices.SKU{
+									Name:     to.Ptr("Standard"),
+									Capacity: to.Ptr[int32](1),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -340,28 +441,11 @@ func ExampleDeploymentsClient_BeginUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.DeploymentsClientUpdateResponse{
-	// 	Deployment: armcognitiveservices.Deployment{
-	// 		Name: to.Ptr("deploymentName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/deployments"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName"),
-	// 		Properties: &armcognitiveservices.DeploymentProperties{
-	// 			DeploymentState: to.Ptr(armcognitiveservices.DeploymentStatePaused),
-	// 			Model: &armcognitiveservices.DeploymentModel{
-	// 				Name: to.Ptr("ada"),
-	// 				Format: to.Ptr("OpenAI"),
-	// 				Version: to.Ptr("1"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.DeploymentProvisioningStateSucceeded),
-	// 			ServiceTier: to.Ptr(armcognitiveservices.ServiceTierPriority),
-	// 		},
-	// 		SKU: &armcognitiveservices.SKU{
-	// 			Name: to.Ptr("Standard"),
-	// 			Capacity: to.Ptr[int32](1),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: deploymentName
+	// Type: Microsoft.CognitiveServices/accounts/deployments
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/deployments/deploymentName
 }
@@ -6,20 +6,60 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutEncryptionScope.json
 func ExampleEncryptionScopesClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				EncryptionScopesServer: fake.EncryptionScopesServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, encryptionScopeName string, encryptionScope armcognitiveservices.EncryptionScope, options *armcognitiveservices.EncryptionScopesClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.EncryptionScopesClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.EncryptionScopesClientCreateOrUpdateResponse{
+							EncryptionScope: armcognitiveservices.EncryptionScope{
+								Name: to.Ptr("encryptionScopeName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/encryptionScopes"),
+								Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/encryptionScopes/encryptionScopeName"),
+								Properties: &armcognitiveservices.EncryptionScopeProperties{
+									KeySource: to.Ptr(armcognitiveservices.KeySourceMicrosoftKeyVault),
+									KeyVaultProperties: &armcognitiveservices.KeyVaultProperties{
+										IdentityClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+										KeyName:          to.Ptr("DevKeyWestUS2"),
+										KeyVaultURI:      to.Ptr("https://devkvwestus2.vault.azure.net/"),
+										KeyVersion:       to.Ptr("9f85549d7bf14ff4bf178c10d3bdca95"),
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.EncryptionScopeProvisioningStateSucceeded),
+									State:             to.Ptr(armcognitiveservices.EncryptionScopeStateEnabled),
+								},
+								SystemData: &armcognitiveservices.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t }()),
+									CreatedBy:          to.Ptr("xxx@microsoft.com"),
+									CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t }()),
+									LastModifiedBy:     to.Ptr("xxx@microsoft.com"),
+									LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -38,46 +78,33 @@ func ExampleEncryptionScopesClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.EncryptionScopesClientCreateOrUpdateResponse{
-	// 	EncryptionScope: armcognitiveservices.EncryptionScope{
-	// 		Name: to.Ptr("encryptionScopeName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/encryptionScopes"),
-	// 		Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/encryptionScopes/encryptionScopeName"),
-	// 		Properties: &armcognitiveservices.EncryptionScopeProperties{
-	// 			KeySource: to.Ptr(armcognitiveservices.KeySourceMicrosoftKeyVault),
-	// 			KeyVaultProperties: &armcognitiveservices.KeyVaultProperties{
-	// 				IdentityClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 				KeyName: to.Ptr("DevKeyWestUS2"),
-	// 				KeyVaultURI: to.Ptr("https://devkvwestus2.vault.azure.net/"),
-	// 				KeyVersion: to.Ptr("9f85549d7bf14ff4bf178c10d3bdca95"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.EncryptionScopeProvisioningStateSucceeded),
-	// 			State: to.Ptr(armcognitiveservices.EncryptionScopeStateEnabled),
-	// 		},
-	// 		SystemData: &armcognitiveservices.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t}()),
-	// 			CreatedBy: to.Ptr("xxx@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("xxx@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: encryptionScopeName
+	// Type: Microsoft.CognitiveServices/accounts/encryptionScopes
+	// Etag: "00000000-0000-0000-0000-000000000000"
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/encryptionScopes/encryptionScopeName
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteEncryptionScope.json
 func ExampleEncryptionScopesClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				EncryptionScopesServer: fake.EncryptionScopesServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, encryptionScopeName string, options *armcognitiveservices.EncryptionScopesClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.EncryptionScopesClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.EncryptionScopesClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -89,16 +116,51 @@ func ExampleEncryptionScopesClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetEncryptionScope.json
 func ExampleEncryptionScopesClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				EncryptionScopesServer: fake.EncryptionScopesServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, encryptionScopeName string, options *armcognitiveservices.EncryptionScopesClientGetOptions) (resp azfake.Responder[armcognitiveservices.EncryptionScopesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.EncryptionScopesClientGetResponse{
+							EncryptionScope: armcognitiveservices.EncryptionScope{
+								Name: to.Ptr("encryptionScopeName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/encryptionScopes"),
+								Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/encryptionScopes/encryptionScopeName"),
+								Properties: &armcognitiveservices.EncryptionScopeProperties{
+									KeySource: to.Ptr(armcognitiveservices.KeySourceMicrosoftKeyVault),
+									KeyVaultProperties: &armcognitiveservices.KeyVaultProperties{
+										IdentityClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+										KeyName:          to.Ptr("DevKeyWestUS2"),
+										KeyVaultURI:      to.Ptr("https://devkvwestus2.vault.azure.net/"),
+										KeyVersion:       to.Ptr("9f85549d7bf14ff4bf178c10d3bdca95"),
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.EncryptionScopeProvisioningStateSucceeded),
+									State:             to.Ptr(armcognitiveservices.EncryptionScopeStateEnabled),
+								},
+								SystemData: &armcognitiveservices.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t }()),
+									CreatedBy:          to.Ptr("xxx@microsoft.com"),
+									CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t }()),
+									LastModifiedBy:     to.Ptr("xxx@microsoft.com"),
+									LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -106,46 +168,63 @@ func ExampleEncryptionScopesClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.EncryptionScopesClientGetResponse{
-	// 	EncryptionScope: armcognitiveservices.EncryptionScope{
-	// 		Name: to.Ptr("encryptionScopeName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/encryptionScopes"),
-	// 		Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/encryptionScopes/encryptionScopeName"),
-	// 		Properties: &armcognitiveservices.EncryptionScopeProperties{
-	// 			KeySource: to.Ptr(armcognitiveservices.KeySourceMicrosoftKeyVault),
-	// 			KeyVaultProperties: &armcognitiveservices.KeyVaultProperties{
-	// 				IdentityClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 				KeyName: to.Ptr("DevKeyWestUS2"),
-	// 				KeyVaultURI: to.Ptr("https://devkvwestus2.vault.azure.net/"),
-	// 				KeyVersion: to.Ptr("9f85549d7bf14ff4bf178c10d3bdca95"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.EncryptionScopeProvisioningStateSucceeded),
-	// 			State: to.Ptr(armcognitiveservices.EncryptionScopeStateEnabled),
-	// 		},
-	// 		SystemData: &armcognitiveservices.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t}()),
-	// 			CreatedBy: to.Ptr("xxx@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("xxx@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: encryptionScopeName
+	// Type: Microsoft.CognitiveServices/accounts/encryptionScopes
+	// Etag: "00000000-0000-0000-0000-000000000000"
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/encryptionScopes/encryptionScopeName
 }
 
 // Generated from example definition: 2025-10-01-preview/ListEncryptionScopes.json
 func ExampleEncryptionScopesClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				EncryptionScopesServer: fake.EncryptionScopesServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.EncryptionScopesClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.EncryptionScopesClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.EncryptionScopesClientListResponse{
+							EncryptionScopeListResult: armcognitiveservices.EncryptionScopeListResult{
+								Value: []*armcognitiveservices.EncryptionScope{
+									{
+										Name: to.Ptr("encryptionScopeName"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/encryptionScopes"),
+										Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/encryptionScopes/encryptionScopeName"),
+										Properties: &armcognitiveservices.EncryptionScopeProperties{
+											KeySource: to.Ptr(armcognitiveservices.KeySourceMicrosoftKeyVault),
+											KeyVaultProperties: &armcognitiveservices.KeyVaultProperties{
+												IdentityClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+												KeyName:          to.Ptr("DevKeyWestUS2"),
+												KeyVaultURI:      to.Ptr("https://devkvwestus2.vault.azure.net/"),
+												KeyVersion:       to.Ptr("9f85549d7bf14ff4bf178c10d3bdca95"),
+											},
+											ProvisioningState: to.Ptr(armcognitiveservices.EncryptionScopeProvisioningStateSucceeded),
+											State:             to.Ptr(armcognitiveservices.EncryptionScopeStateEnabled),
+										},
+										SystemData: &armcognitiveservices.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t }()),
+											CreatedBy:          to.Ptr("xxx@microsoft.com"),
+											CreatedByType:      to.Ptr(armcognitiveservices.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t }()),
+											LastModifiedBy:     to.Ptr("xxx@microsoft.com"),
+											LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -156,40 +235,15 @@ func ExampleEncryptionScopesClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Etag:", *v.Etag)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.EncryptionScopesClientListResponse{
-		// 	EncryptionScopeListResult: armcognitiveservices.EncryptionScopeListResult{
-		// 		Value: []*armcognitiveservices.EncryptionScope{
-		// 			{
-		// 				Name: to.Ptr("encryptionScopeName"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/encryptionScopes"),
-		// 				Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/encryptionScopes/encryptionScopeName"),
-		// 				Properties: &armcognitiveservices.EncryptionScopeProperties{
-		// 					KeySource: to.Ptr(armcognitiveservices.KeySourceMicrosoftKeyVault),
-		// 					KeyVaultProperties: &armcognitiveservices.KeyVaultProperties{
-		// 						IdentityClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 						KeyName: to.Ptr("DevKeyWestUS2"),
-		// 						KeyVaultURI: to.Ptr("https://devkvwestus2.vault.azure.net/"),
-		// 						KeyVersion: to.Ptr("9f85549d7bf14ff4bf178c10d3bdca95"),
-		// 					},
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.EncryptionScopeProvisioningStateSucceeded),
-		// 					State: to.Ptr(armcognitiveservices.EncryptionScopeStateEnabled),
-		// 				},
-		// 				SystemData: &armcognitiveservices.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t}()),
-		// 					CreatedBy: to.Ptr("xxx@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-06-08T06:35:08.0662558Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("xxx@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armcognitiveservices.CreatedByTypeUser),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: encryptionScopeName
+	// Type: Microsoft.CognitiveServices/accounts/encryptionScopes
+	// Etag: "00000000-0000-0000-0000-000000000000"
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/encryptionScopes/encryptionScopeName
 }
@@ -6,19 +6,54 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/ListLocationBasedModelCapacities.json
 func ExampleLocationBasedModelCapacitiesClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LocationBasedModelCapacitiesServer: fake.LocationBasedModelCapacitiesServer{
+					NewListPager: func(location string, modelFormat string, modelName string, modelVersion string, options *armcognitiveservices.LocationBasedModelCapacitiesClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.LocationBasedModelCapacitiesClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.LocationBasedModelCapacitiesClientListResponse{
+							ModelCapacityListResult: armcognitiveservices.ModelCapacityListResult{
+								Value: []*armcognitiveservices.ModelCapacityListResultValueItem{
+									{
+										Name:     to.Ptr("Standard"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/locations/models/skuCapacities"),
+										ID:       to.Ptr("/subscriptions/{subscriptionContext.SubscriptionId}/providers/Microsoft.CognitiveServices/locations/WestUS/models/OpenAI.ada.1/skuCapacities/Standard"),
+										Location: to.Ptr("WestUS"),
+										Properties: &armcognitiveservices.ModelSKUCapacityProperties{
+											AvailableCapacity:         to.Ptr[float32](300),
+											AvailableFinetuneCapacity: to.Ptr[float32](20),
+											Model: &armcognitiveservices.DeploymentModel{
+												Name:    to.Ptr("ada"),
+												Format:  to.Ptr("OpenAI"),
+												Version: to.Ptr("1"),
+											},
+											SKUName: to.Ptr("Standard"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,31 +64,15 @@ func ExampleLocationBasedModelCapacitiesClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.LocationBasedModelCapacitiesClientListResponse{
-		// 	ModelCapacityListResult: armcognitiveservices.ModelCapacityListResult{
-		// 		Value: []*armcognitiveservices.ModelCapacityListResultValueItem{
-		// 			{
-		// 				Name: to.Ptr("Standard"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/models/skuCapacities"),
-		// 				ID: to.Ptr("/subscriptions/{subscriptionContext.SubscriptionId}/providers/Microsoft.CognitiveServices/locations/WestUS/models/OpenAI.ada.1/skuCapacities/Standard"),
-		// 				Location: to.Ptr("WestUS"),
-		// 				Properties: &armcognitiveservices.ModelSKUCapacityProperties{
-		// 					AvailableCapacity: to.Ptr[float32](300),
-		// 					AvailableFinetuneCapacity: to.Ptr[float32](20),
-		// 					Model: &armcognitiveservices.DeploymentModel{
-		// 						Name: to.Ptr("ada"),
-		// 						Format: to.Ptr("OpenAI"),
-		// 						Version: to.Ptr("1"),
-		// 					},
-		// 					SKUName: to.Ptr("Standard"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Standard
+	// Type: Microsoft.CognitiveServices/locations/models/skuCapacities
+	// ID: /subscriptions/{subscriptionContext.SubscriptionId}/providers/Microsoft.CognitiveServices/locations/WestUS/models/OpenAI.ada.1/skuCapacities/Standard
+	// Location: WestUS
 }
@@ -6,19 +6,37 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/provisionManagedNetwork.json
 func ExampleManagedNetworkProvisionsClient_BeginProvisionManagedNetwork() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ManagedNetworkProvisionsServer: fake.ManagedNetworkProvisionsServer{
+					BeginProvisionManagedNetwork: func(ctx context.Context, resourceGroupName string, accountName string, options *armcognitiveservices.ManagedNetworkProvisionsClientBeginProvisionManagedNetworkOptions) (resp azfake.PollerResponder[armcognitiveservices.ManagedNetworkProvisionsClientProvisionManagedNetworkResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.ManagedNetworkProvisionsClientProvisionManagedNetworkResponse{
+							ManagedNetworkProvisionStatus: armcognitiveservices.ManagedNetworkProvisionStatus{
+								Status: to.Ptr(armcognitiveservices.ManagedNetworkStatusActive),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -31,12 +49,7 @@ func ExampleManagedNetworkProvisionsClient_BeginProvisionManagedNetwork() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ManagedNetworkProvisionsClientProvisionManagedNetworkResponse{
-	// 	ManagedNetworkProvisionStatus: armcognitiveservices.ManagedNetworkProvisionStatus{
-	// 		Status: to.Ptr(armcognitiveservices.ManagedNetworkStatusActive),
-	// 	},
-	// }
+	fmt.Println("Status:", *res.Status)
+	// Output:
+	// Status: Active
 }
@@ -6,20 +6,56 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/getManagedNetworkV2.json
 func ExampleManagedNetworkSettingsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ManagedNetworkSettingsServer: fake.ManagedNetworkSettingsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, managedNetworkName string, options *armcognitiveservices.ManagedNetworkSettingsClientGetOptions) (resp azfake.Responder[armcognitiveservices.ManagedNetworkSettingsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ManagedNetworkSettingsClientGetResponse{
+							ManagedNetworkSettingsPropertiesBasicResource: armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource{
+								Name: to.Ptr("default"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/managedNetworks"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default"),
+								Properties: &armcognitiveservices.ManagedNetworkSettingsProperties{
+									ManagedNetwork: &armcognitiveservices.ManagedNetworkSettingsEx{
+										EgressIPAddress: to.Ptr("22.22.131.49"),
+										FirewallSKU:     to.Ptr(armcognitiveservices.FirewallSKUStandard),
+										IsolationMode:   to.Ptr(armcognitiveservices.IsolationModeAllowOnlyApprovedOutbound),
+										NetworkID:       to.Ptr("00000000-1111-2222-3333-444444444444"),
+										OutboundRules: map[string]armcognitiveservices.OutboundRuleClassification{
+											"rule_name_1": &armcognitiveservices.FqdnOutboundRule{
+												Type:        to.Ptr(armcognitiveservices.RuleTypeFQDN),
+												Category:    to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
+												Destination: to.Ptr("destination_endpoint"),
+												Status:      to.Ptr(armcognitiveservices.RuleStatusActive),
+											},
+										},
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.ManagedNetworkProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -27,43 +63,58 @@ func ExampleManagedNetworkSettingsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ManagedNetworkSettingsClientGetResponse{
-	// 	ManagedNetworkSettingsPropertiesBasicResource: armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource{
-	// 		Name: to.Ptr("default"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/managedNetworks"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default"),
-	// 		Properties: &armcognitiveservices.ManagedNetworkSettingsProperties{
-	// 			ManagedNetwork: &armcognitiveservices.ManagedNetworkSettingsEx{
-	// 				EgressIPAddress: to.Ptr("22.22.131.49"),
-	// 				FirewallSKU: to.Ptr(armcognitiveservices.FirewallSKUStandard),
-	// 				IsolationMode: to.Ptr(armcognitiveservices.IsolationModeAllowOnlyApprovedOutbound),
-	// 				NetworkID: to.Ptr("00000000-1111-2222-3333-444444444444"),
-	// 				OutboundRules: map[string]armcognitiveservices.OutboundRuleClassification{
-	// 					"rule_name_1": &armcognitiveservices.FqdnOutboundRule{
-	// 						Type: to.Ptr(armcognitiveservices.RuleTypeFQDN),
-	// 						Category: to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
-	// 						Destination: to.Ptr("destination_endpoint"),
-	// 						Status: to.Ptr(armcognitiveservices.RuleStatusActive),
-	// 					},
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ManagedNetworkProvisioningStateSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: default
+	// Type: Microsoft.CognitiveServices/accounts/managedNetworks
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default
 }
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/listManagedNetworkV2.json
 func ExampleManagedNetworkSettingsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ManagedNetworkSettingsServer: fake.ManagedNetworkSettingsServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.ManagedNetworkSettingsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.ManagedNetworkSettingsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.ManagedNetworkSettingsClientListResponse{
+							ManagedNetworkListResult: armcognitiveservices.ManagedNetworkListResult{
+								Value: []*armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource{
+									{
+										Name: to.Ptr("default"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/managedNetworks"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default"),
+										Properties: &armcognitiveservices.ManagedNetworkSettingsProperties{
+											ManagedNetwork: &armcognitiveservices.ManagedNetworkSettingsEx{
+												EgressIPAddress: to.Ptr("22.22.131.49"),
+												FirewallSKU:     to.Ptr(armcognitiveservices.FirewallSKUStandard),
+												IsolationMode:   to.Ptr(armcognitiveservices.IsolationModeAllowOnlyApprovedOutbound),
+												NetworkID:       to.Ptr("00000000-1111-2222-3333-444444444444"),
+												OutboundRules: map[string]armcognitiveservices.OutboundRuleClassification{
+													"rule_name_1": &armcognitiveservices.FqdnOutboundRule{
+														Type:        to.Ptr(armcognitiveservices.RuleTypeFQDN),
+														Category:    to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
+														Destination: to.Ptr("destination_endpoint"),
+														Status:      to.Ptr(armcognitiveservices.RuleStatusActive),
+													},
+												},
+											},
+											ProvisioningState: to.Ptr(armcognitiveservices.ManagedNetworkProvisioningStateSucceeded),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -74,49 +125,56 @@ func ExampleManagedNetworkSettingsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.ManagedNetworkSettingsClientListResponse{
-		// 	ManagedNetworkListResult: armcognitiveservices.ManagedNetworkListResult{
-		// 		Value: []*armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource{
-		// 			{
-		// 				Name: to.Ptr("default"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/managedNetworks"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default"),
-		// 				Properties: &armcognitiveservices.ManagedNetworkSettingsProperties{
-		// 					ManagedNetwork: &armcognitiveservices.ManagedNetworkSettingsEx{
-		// 						EgressIPAddress: to.Ptr("22.22.131.49"),
-		// 						FirewallSKU: to.Ptr(armcognitiveservices.FirewallSKUStandard),
-		// 						IsolationMode: to.Ptr(armcognitiveservices.IsolationModeAllowOnlyApprovedOutbound),
-		// 						NetworkID: to.Ptr("00000000-1111-2222-3333-444444444444"),
-		// 						OutboundRules: map[string]armcognitiveservices.OutboundRuleClassification{
-		// 							"rule_name_1": &armcognitiveservices.FqdnOutboundRule{
-		// 								Type: to.Ptr(armcognitiveservices.RuleTypeFQDN),
-		// 								Category: to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
-		// 								Destination: to.Ptr("destination_endpoint"),
-		// 								Status: to.Ptr(armcognitiveservices.RuleStatusActive),
-		// 							},
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ManagedNetworkProvisioningStateSucceeded),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: default
+	// Type: Microsoft.CognitiveServices/accounts/managedNetworks
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default
 }
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/patchManagedNetworkV2.json
 func ExampleManagedNetworkSettingsClient_BeginPatch() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ManagedNetworkSettingsServer: fake.ManagedNetworkSettingsServer{
+					BeginPatch: func(ctx context.Context, resourceGroupName string, accountName string, managedNetworkName string, body armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource, options *armcognitiveservices.ManagedNetworkSettingsClientBeginPatchOptions) (resp azfake.PollerResponder[armcognitiveservices.ManagedNetworkSettingsClientPatchResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.ManagedNetworkSettingsClientPatchResponse{
+							ManagedNetworkSettingsPropertiesBasicResource: armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource{
+								Name: to.Ptr("default"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/managedNetworks"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default"),
+								Properties: &armcognitiveservices.ManagedNetworkSettingsProperties{
+									ManagedNetwork: &armcognitiveservices.ManagedNetworkSettingsEx{
+										EgressIPAddress: to.Ptr("22.22.131.49"),
+										FirewallSKU:     to.Ptr(armcognitiveservices.FirewallSKUStandard),
+										IsolationMode:   to.Ptr(armcognitiveservices.IsolationModeAllowOnlyApprovedOutbound),
+										NetworkID:       to.Ptr("00000000-1111-2222-3333-444444444444"),
+										OutboundRules: map[string]armcognitiveservices.OutboundRuleClassification{
+											"rule_name_1": &armcognitiveservices.FqdnOutboundRule{
+												Type:        to.Ptr(armcognitiveservices.RuleTypeFQDN),
+												Category:    to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
+												Destination: to.Ptr("destination_endpoint"),
+												Status:      to.Ptr(armcognitiveservices.RuleStatusActive),
+											},
+										},
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.ManagedNetworkProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -142,43 +200,54 @@ func ExampleManagedNetworkSettingsClient_BeginPatch() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ManagedNetworkSettingsClientPatchResponse{
-	// 	ManagedNetworkSettingsPropertiesBasicResource: armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource{
-	// 		Name: to.Ptr("default"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/managedNetworks"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default"),
-	// 		Properties: &armcognitiveservices.ManagedNetworkSettingsProperties{
-	// 			ManagedNetwork: &armcognitiveservices.ManagedNetworkSettingsEx{
-	// 				EgressIPAddress: to.Ptr("22.22.131.49"),
-	// 				FirewallSKU: to.Ptr(armcognitiveservices.FirewallSKUStandard),
-	// 				IsolationMode: to.Ptr(armcognitiveservices.IsolationModeAllowOnlyApprovedOutbound),
-	// 				NetworkID: to.Ptr("00000000-1111-2222-3333-444444444444"),
-	// 				OutboundRules: map[string]armcognitiveservices.OutboundRuleClassification{
-	// 					"rule_name_1": &armcognitiveservices.FqdnOutboundRule{
-	// 						Type: to.Ptr(armcognitiveservices.RuleTypeFQDN),
-	// 						Category: to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
-	// 						Destination: to.Ptr("destination_endpoint"),
-	// 						Status: to.Ptr(armcognitiveservices.RuleStatusActive),
-	// 					},
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ManagedNetworkProvisioningStateSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: default
+	// Type: Microsoft.CognitiveServices/accounts/managedNetworks
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default
 }
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/createOrUpdateManagedNetworkV2.json
 func ExampleManagedNetworkSettingsClient_BeginPut() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ManagedNetworkSettingsServer: fake.ManagedNetworkSettingsServer{
+					BeginPut: func(ctx context.Context, resourceGroupName string, accountName string, managedNetworkName string, body armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource, options *armcognitiveservices.ManagedNetworkSettingsClientBeginPutOptions) (resp azfake.PollerResponder[armcognitiveservices.ManagedNetworkSettingsClientPutResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.ManagedNetworkSettingsClientPutResponse{
+							ManagedNetworkSettingsPropertiesBasicResource: armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource{
+								Name: to.Ptr("default"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/managedNetworks"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default"),
+								Properties: &armcognitiveservices.ManagedNetworkSettingsProperties{
+									ManagedNetwork: &armcognitiveservices.ManagedNetworkSettingsEx{
+										EgressIPAddress: to.Ptr("22.22.131.49"),
+										FirewallSKU:     to.Ptr(armcognitiveservices.FirewallSKUStandard),
+										IsolationMode:   to.Ptr(armcognitiveservices.IsolationModeAllowOnlyApprovedOutbound),
+										NetworkID:       to.Ptr("00000000-1111-2222-3333-444444444444"),
+										OutboundRules: map[string]armcognitiveservices.OutboundRuleClassification{
+											"rule_name_1": &armcognitiveservices.FqdnOutboundRule{
+												Type:        to.Ptr(armcognitiveservices.RuleTypeFQDN),
+												Category:    to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
+												Destination: to.Ptr("destination_endpoint"),
+												Status:      to.Ptr(armcognitiveservices.RuleStatusActive),
+											},
+										},
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.ManagedNetworkProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -204,31 +273,11 @@ func ExampleManagedNetworkSettingsClient_BeginPut() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ManagedNetworkSettingsClientPutResponse{
-	// 	ManagedNetworkSettingsPropertiesBasicResource: armcognitiveservices.ManagedNetworkSettingsPropertiesBasicResource{
-	// 		Name: to.Ptr("default"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/managedNetworks"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default"),
-	// 		Properties: &armcognitiveservices.ManagedNetworkSettingsProperties{
-	// 			ManagedNetwork: &armcognitiveservices.ManagedNetworkSettingsEx{
-	// 				EgressIPAddress: to.Ptr("22.22.131.49"),
-	// 				FirewallSKU: to.Ptr(armcognitiveservices.FirewallSKUStandard),
-	// 				IsolationMode: to.Ptr(armcognitiveservices.IsolationModeAllowOnlyApprovedOutbound),
-	// 				NetworkID: to.Ptr("00000000-1111-2222-3333-444444444444"),
-	// 				OutboundRules: map[string]armcognitiveservices.OutboundRuleClassification{
-	// 					"rule_name_1": &armcognitiveservices.FqdnOutboundRule{
-	// 						Type: to.Ptr(armcognitiveservices.RuleTypeFQDN),
-	// 						Category: to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
-	// 						Destination: to.Ptr("destination_endpoint"),
-	// 						Status: to.Ptr(armcognitiveservices.RuleStatusActive),
-	// 					},
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ManagedNetworkProvisioningStateSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: default
+	// Type: Microsoft.CognitiveServices/accounts/managedNetworks
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default
 }
@@ -6,19 +6,67 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/GetNetworkSecurityPerimeterConfigurations.json
 func ExampleNetworkSecurityPerimeterConfigurationsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				NetworkSecurityPerimeterConfigurationsServer: fake.NetworkSecurityPerimeterConfigurationsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, nspConfigurationName string, options *armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientGetOptions) (resp azfake.Responder[armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientGetResponse{
+							NetworkSecurityPerimeterConfiguration: armcognitiveservices.NetworkSecurityPerimeterConfiguration{
+								Name: to.Ptr("networkSecurityPerimeterConfigurationName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/networkSecurityPerimeterConfigurations"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/networkSecurityPerimeterConfigurations/config1"),
+								Properties: &armcognitiveservices.NetworkSecurityPerimeterConfigurationProperties{
+									NetworkSecurityPerimeter: &armcognitiveservices.NetworkSecurityPerimeter{
+										ID:            to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.Network/networkSecurityPerimeters/perimeter"),
+										Location:      to.Ptr("East US"),
+										PerimeterGUID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+									},
+									Profile: &armcognitiveservices.NetworkSecurityPerimeterProfileInfo{
+										Name: to.Ptr("profileName"),
+										AccessRules: []*armcognitiveservices.NetworkSecurityPerimeterAccessRule{
+											{
+												Name: to.Ptr("ruleName"),
+												Properties: &armcognitiveservices.NetworkSecurityPerimeterAccessRuleProperties{
+													AddressPrefixes: []*string{
+														to.Ptr("148.0.0.0/8"),
+														to.Ptr("152.4.6.0/24"),
+													},
+													Direction: to.Ptr(armcognitiveservices.NspAccessRuleDirectionInbound),
+												},
+											},
+										},
+										AccessRulesVersion: to.Ptr[int64](1),
+									},
+									ProvisioningState: to.Ptr("Succeeded"),
+									ResourceAssociation: &armcognitiveservices.NetworkSecurityPerimeterConfigurationAssociationInfo{
+										Name:       to.Ptr("associationName"),
+										AccessMode: to.Ptr("Enforced"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -26,54 +74,69 @@ func ExampleNetworkSecurityPerimeterConfigurationsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientGetResponse{
-	// 	NetworkSecurityPerimeterConfiguration: armcognitiveservices.NetworkSecurityPerimeterConfiguration{
-	// 		Name: to.Ptr("networkSecurityPerimeterConfigurationName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/networkSecurityPerimeterConfigurations"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/networkSecurityPerimeterConfigurations/config1"),
-	// 		Properties: &armcognitiveservices.NetworkSecurityPerimeterConfigurationProperties{
-	// 			NetworkSecurityPerimeter: &armcognitiveservices.NetworkSecurityPerimeter{
-	// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.Network/networkSecurityPerimeters/perimeter"),
-	// 				Location: to.Ptr("East US"),
-	// 				PerimeterGUID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			},
-	// 			Profile: &armcognitiveservices.NetworkSecurityPerimeterProfileInfo{
-	// 				Name: to.Ptr("profileName"),
-	// 				AccessRules: []*armcognitiveservices.NetworkSecurityPerimeterAccessRule{
-	// 					{
-	// 						Name: to.Ptr("ruleName"),
-	// 						Properties: &armcognitiveservices.NetworkSecurityPerimeterAccessRuleProperties{
-	// 							AddressPrefixes: []*string{
-	// 								to.Ptr("148.0.0.0/8"),
-	// 								to.Ptr("152.4.6.0/24"),
-	// 							},
-	// 							Direction: to.Ptr(armcognitiveservices.NspAccessRuleDirectionInbound),
-	// 						},
-	// 					},
-	// 				},
-	// 				AccessRulesVersion: to.Ptr[int64](1),
-	// 			},
-	// 			ProvisioningState: to.Ptr("Succeeded"),
-	// 			ResourceAssociation: &armcognitiveservices.NetworkSecurityPerimeterConfigurationAssociationInfo{
-	// 				Name: to.Ptr("associationName"),
-	// 				AccessMode: to.Ptr("Enforced"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: networkSecurityPerimeterConfigurationName
+	// Type: Microsoft.CognitiveServices/accounts/networkSecurityPerimeterConfigurations
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/networkSecurityPerimeterConfigurations/config1
 }
 
 // Generated from example definition: 2025-10-01-preview/ListNetworkSecurityPerimeterConfigurations.json
 func ExampleNetworkSecurityPerimeterConfigurationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				NetworkSecurityPerimeterConfigurationsServer: fake.NetworkSecurityPerimeterConfigurationsServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientListResponse{
+							NetworkSecurityPerimeterConfigurationList: armcognitiveservices.NetworkSecurityPerimeterConfigurationList{
+								Value: []*armcognitiveservices.NetworkSecurityPerimeterConfiguration{
+									{
+										Name: to.Ptr("networkSecurityPerimeterConfigurationName"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/networkSecurityPerimeterConfigurations"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/networkSecurityPerimeterConfigurations/config1"),
+										Properties: &armcognitiveservices.NetworkSecurityPerimeterConfigurationProperties{
+											NetworkSecurityPerimeter: &armcognitiveservices.NetworkSecurityPerimeter{
+												ID:            to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.Network/networkSecurityPerimeters/perimeter"),
+												Location:      to.Ptr("East US"),
+												PerimeterGUID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+											},
+											Profile: &armcognitiveservices.NetworkSecurityPerimeterProfileInfo{
+												Name: to.Ptr("profileName"),
+												AccessRules: []*armcognitiveservices.NetworkSecurityPerimeterAccessRule{
+													{
+														Name: to.Ptr("ruleName"),
+														Properties: &armcognitiveservices.NetworkSecurityPerimeterAccessRuleProperties{
+															AddressPrefixes: []*string{
+																to.Ptr("148.0.0.0/8"),
+																to.Ptr("152.4.6.0/24"),
+															},
+															Direction: to.Ptr(armcognitiveservices.NspAccessRuleDirectionInbound),
+														},
+													},
+												},
+												AccessRulesVersion: to.Ptr[int64](1),
+											},
+											ProvisioningState: to.Ptr("Succeeded"),
+											ResourceAssociation: &armcognitiveservices.NetworkSecurityPerimeterConfigurationAssociationInfo{
+												Name:       to.Ptr("associationName"),
+												AccessMode: to.Ptr("Enforced"),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -84,60 +147,67 @@ func ExampleNetworkSecurityPerimeterConfigurationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientListResponse{
-		// 	NetworkSecurityPerimeterConfigurationList: armcognitiveservices.NetworkSecurityPerimeterConfigurationList{
-		// 		Value: []*armcognitiveservices.NetworkSecurityPerimeterConfiguration{
-		// 			{
-		// 				Name: to.Ptr("networkSecurityPerimeterConfigurationName"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/networkSecurityPerimeterConfigurations"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/networkSecurityPerimeterConfigurations/config1"),
-		// 				Properties: &armcognitiveservices.NetworkSecurityPerimeterConfigurationProperties{
-		// 					NetworkSecurityPerimeter: &armcognitiveservices.NetworkSecurityPerimeter{
-		// 						ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.Network/networkSecurityPerimeters/perimeter"),
-		// 						Location: to.Ptr("East US"),
-		// 						PerimeterGUID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					},
-		// 					Profile: &armcognitiveservices.NetworkSecurityPerimeterProfileInfo{
-		// 						Name: to.Ptr("profileName"),
-		// 						AccessRules: []*armcognitiveservices.NetworkSecurityPerimeterAccessRule{
-		// 							{
-		// 								Name: to.Ptr("ruleName"),
-		// 								Properties: &armcognitiveservices.NetworkSecurityPerimeterAccessRuleProperties{
-		// 									AddressPrefixes: []*string{
-		// 										to.Ptr("148.0.0.0/8"),
-		// 										to.Ptr("152.4.6.0/24"),
-		// 									},
-		// 									Direction: to.Ptr(armcognitiveservices.NspAccessRuleDirectionInbound),
-		// 								},
-		// 							},
-		// 						},
-		// 						AccessRulesVersion: to.Ptr[int64](1),
-		// 					},
-		// 					ProvisioningState: to.Ptr("Succeeded"),
-		// 					ResourceAssociation: &armcognitiveservices.NetworkSecurityPerimeterConfigurationAssociationInfo{
-		// 						Name: to.Ptr("associationName"),
-		// 						AccessMode: to.Ptr("Enforced"),
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: networkSecurityPerimeterConfigurationName
+	// Type: Microsoft.CognitiveServices/accounts/networkSecurityPerimeterConfigurations
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/networkSecurityPerimeterConfigurations/config1
 }
 
 // Generated from example definition: 2025-10-01-preview/ReconcileNetworkSecurityPerimeterConfigurations.json
 func ExampleNetworkSecurityPerimeterConfigurationsClient_BeginReconcile() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				NetworkSecurityPerimeterConfigurationsServer: fake.NetworkSecurityPerimeterConfigurationsServer{
+					BeginReconcile: func(ctx context.Context, resourceGroupName string, accountName string, nspConfigurationName string, options *armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientBeginReconcileOptions) (resp azfake.PollerResponder[armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientReconcileResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientReconcileResponse{
+							NetworkSecurityPerimeterConfiguration: armcognitiveservices.NetworkSecurityPerimeterConfiguration{
+								Name: to.Ptr("networkSecurityPerimeterConfigurationName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/networkSecurityPerimeterConfigurations"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/networkSecurityPerimeterConfigurations/config1"),
+								Properties: &armcognitiveservices.NetworkSecurityPerimeterConfigurationProperties{
+									NetworkSecurityPerimeter: &armcognitiveservices.NetworkSecurityPerimeter{
+										ID:            to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.Network/networkSecurityPerimeters/perimeter"),
+										Location:      to.Ptr("East US"),
+										PerimeterGUID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+									},
+									Profile: &armcognitiveservices.NetworkSecurityPerimeterProfileInfo{
+										Name: to.Ptr("profileName"),
+										AccessRules: []*armcognitiveservices.NetworkSecurityPerimeterAccessRule{
+											{
+												Name: to.Ptr("ruleName"),
+												Properties: &armcognitiveservices.NetworkSecurityPerimeterAccessRuleProperties{
+													AddressPrefixes: []*string{
+														to.Ptr("148.0.0.0/8"),
+														to.Ptr("152.4.6.0/24"),
+													},
+													Direction: to.Ptr(armcognitiveservices.NspAccessRuleDirectionInbound),
+												},
+											},
+										},
+										AccessRulesVersion: to.Ptr[int64](1),
+									},
+									ProvisioningState: to.Ptr("Succeeded"),
+									ResourceAssociation: &armcognitiveservices.NetworkSecurityPerimeterConfigurationAssociationInfo{
+										Name:       to.Ptr("associationName"),
+										AccessMode: to.Ptr("Enforced"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -149,42 +219,11 @@ func ExampleNetworkSecurityPerimeterConfigurationsClient_BeginReconcile() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.NetworkSecurityPerimeterConfigurationsClientReconcileResponse{
-	// 	NetworkSecurityPerimeterConfiguration: armcognitiveservices.NetworkSecurityPerimeterConfiguration{
-	// 		Name: to.Ptr("networkSecurityPerimeterConfigurationName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/networkSecurityPerimeterConfigurations"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/networkSecurityPerimeterConfigurations/config1"),
-	// 		Properties: &armcognitiveservices.NetworkSecurityPerimeterConfigurationProperties{
-	// 			NetworkSecurityPerimeter: &armcognitiveservices.NetworkSecurityPerimeter{
-	// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.Network/networkSecurityPerimeters/perimeter"),
-	// 				Location: to.Ptr("East US"),
-	// 				PerimeterGUID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			},
-	// 			Profile: &armcognitiveservices.NetworkSecurityPerimeterProfileInfo{
-	// 				Name: to.Ptr("profileName"),
-	// 				AccessRules: []*armcognitiveservices.NetworkSecurityPerimeterAccessRule{
-	// 					{
-	// 						Name: to.Ptr("ruleName"),
-	// 						Properties: &armcognitiveservices.NetworkSecurityPerimeterAccessRuleProperties{
-	// 							AddressPrefixes: []*string{
-	// 								to.Ptr("148.0.0.0/8"),
-	// 								to.Ptr("152.4.6.0/24"),
-	// 							},
-	// 							Direction: to.Ptr(armcognitiveservices.NspAccessRuleDirectionInbound),
-	// 						},
-	// 					},
-	// 				},
-	// 				AccessRulesVersion: to.Ptr[int64](1),
-	// 			},
-	// 			ProvisioningState: to.Ptr("Succeeded"),
-	// 			ResourceAssociation: &armcognitiveservices.NetworkSecurityPerimeterConfigurationAssociationInfo{
-	// 				Name: to.Ptr("associationName"),
-	// 				AccessMode: to.Ptr("Enforced"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: networkSecurityPerimeterConfigurationName
+	// Type: Microsoft.CognitiveServices/accounts/networkSecurityPerimeterConfigurations
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/networkSecurityPerimeterConfigurations/config1
 }
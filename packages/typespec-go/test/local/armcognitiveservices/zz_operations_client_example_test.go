@@ -6,19 +6,68 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/GetOperations.json
 func ExampleOperationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armcognitiveservices.OperationsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.OperationsClientListResponse{
+							OperationListResult: armcognitiveservices.OperationListResult{
+								Value: []*armcognitiveservices.Operation{
+									{
+										Name: to.Ptr("Microsoft.CognitiveServices/accounts/read"),
+										Display: &armcognitiveservices.OperationDisplay{
+											Description: to.Ptr("Reads API accounts."),
+											Operation:   to.Ptr("Read API Account"),
+											Provider:    to.Ptr("Microsoft Cognitive Services"),
+											Resource:    to.Ptr("Cognitive Services API Account"),
+										},
+										Origin: to.Ptr(armcognitiveservices.OriginUserSystem),
+									},
+									{
+										Name: to.Ptr("Microsoft.CognitiveServices/accounts/write"),
+										Display: &armcognitiveservices.OperationDisplay{
+											Description: to.Ptr("Writes API Accounts."),
+											Operation:   to.Ptr("Write API Account"),
+											Provider:    to.Ptr("Microsoft Cognitive Services"),
+											Resource:    to.Ptr("Cognitive Services API Account"),
+										},
+										Origin: to.Ptr(armcognitiveservices.OriginUserSystem),
+									},
+									{
+										Name: to.Ptr("Microsoft.CognitiveServices/accounts/delete"),
+										Display: &armcognitiveservices.OperationDisplay{
+											Description: to.Ptr("Deletes API accounts"),
+											Operation:   to.Ptr("Delete API Account"),
+											Provider:    to.Ptr("Microsoft Cognitive Services"),
+											Resource:    to.Ptr("Cognitive Services API Account"),
+										},
+										Origin: to.Ptr(armcognitiveservices.OriginUserSystem),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,45 +78,15 @@ func ExampleOperationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Origin:", *v.Origin)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.OperationsClientListResponse{
-		// 	OperationListResult: armcognitiveservices.OperationListResult{
-		// 		Value: []*armcognitiveservices.Operation{
-		// 			{
-		// 				Name: to.Ptr("Microsoft.CognitiveServices/accounts/read"),
-		// 				Display: &armcognitiveservices.OperationDisplay{
-		// 					Description: to.Ptr("Reads API accounts."),
-		// 					Operation: to.Ptr("Read API Account"),
-		// 					Provider: to.Ptr("Microsoft Cognitive Services"),
-		// 					Resource: to.Ptr("Cognitive Services API Account"),
-		// 				},
-		// 				Origin: to.Ptr(armcognitiveservices.OriginUserSystem),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Microsoft.CognitiveServices/accounts/write"),
-		// 				Display: &armcognitiveservices.OperationDisplay{
-		// 					Description: to.Ptr("Writes API Accounts."),
-		// 					Operation: to.Ptr("Write API Account"),
-		// 					Provider: to.Ptr("Microsoft Cognitive Services"),
-		// 					Resource: to.Ptr("Cognitive Services API Account"),
-		// 				},
-		// 				Origin: to.Ptr(armcognitiveservices.OriginUserSystem),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Microsoft.CognitiveServices/accounts/delete"),
-		// 				Display: &armcognitiveservices.OperationDisplay{
-		// 					Description: to.Ptr("Deletes API accounts"),
-		// 					Operation: to.Ptr("Delete API Account"),
-		// 					Provider: to.Ptr("Microsoft Cognitive Services"),
-		// 					Resource: to.Ptr("Cognitive Services API Account"),
-		// 				},
-		// 				Origin: to.Ptr(armcognitiveservices.OriginUserSystem),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Microsoft.CognitiveServices/accounts/read
+	// Origin: user,system
+	// Name: Microsoft.CognitiveServices/accounts/write
+	// Origin: user,system
+	// Name: Microsoft.CognitiveServices/accounts/delete
+	// Origin: user,system
 }
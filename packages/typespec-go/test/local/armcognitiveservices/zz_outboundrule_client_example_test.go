@@ -6,20 +6,45 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/createOrUpdateRuleV2.json
 func ExampleOutboundRuleClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OutboundRuleServer: fake.OutboundRuleServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, managedNetworkName string, ruleName string, body armcognitiveservices.OutboundRuleBasicResource, options *armcognitiveservices.OutboundRuleClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.OutboundRuleClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.OutboundRuleClientCreateOrUpdateResponse{
+							OutboundRuleBasicResource: armcognitiveservices.OutboundRuleBasicResource{
+								Name: to.Ptr("rule_name_1"),
+								Type: to.Ptr("accounts/outboundRules"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1"),
+								Properties: &armcognitiveservices.FqdnOutboundRule{
+									Type:        to.Ptr(armcognitiveservices.RuleTypeFQDN),
+									Category:    to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
+									Destination: to.Ptr("destination_endpoint"),
+									Status:      to.Ptr(armcognitiveservices.RuleStatusActive),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -38,32 +63,31 @@ func ExampleOutboundRuleClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.OutboundRuleClientCreateOrUpdateResponse{
-	// 	OutboundRuleBasicResource: armcognitiveservices.OutboundRuleBasicResource{
-	// 		Name: to.Ptr("rule_name_1"),
-	// 		Type: to.Ptr("accounts/outboundRules"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1"),
-	// 		Properties: &armcognitiveservices.FqdnOutboundRule{
-	// 			Type: to.Ptr(armcognitiveservices.RuleTypeFQDN),
-	// 			Category: to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
-	// 			Destination: to.Ptr("destination_endpoint"),
-	// 			Status: to.Ptr(armcognitiveservices.RuleStatusActive),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: rule_name_1
+	// Type: accounts/outboundRules
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1
 }
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/deleteRuleV2.json
 func ExampleOutboundRuleClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OutboundRuleServer: fake.OutboundRuleServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, managedNetworkName string, ruleName string, options *armcognitiveservices.OutboundRuleClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.OutboundRuleClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.OutboundRuleClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -75,16 +99,37 @@ func ExampleOutboundRuleClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/getRuleV2.json
 func ExampleOutboundRuleClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OutboundRuleServer: fake.OutboundRuleServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, managedNetworkName string, ruleName string, options *armcognitiveservices.OutboundRuleClientGetOptions) (resp azfake.Responder[armcognitiveservices.OutboundRuleClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.OutboundRuleClientGetResponse{
+							OutboundRuleBasicResource: armcognitiveservices.OutboundRuleBasicResource{
+								Name: to.Ptr("rule_name_1"),
+								Type: to.Ptr("accounts/outboundRules"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1"),
+								Properties: &armcognitiveservices.FqdnOutboundRule{
+									Type:        to.Ptr(armcognitiveservices.RuleTypeFQDN),
+									Category:    to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
+									Destination: to.Ptr("destination_of_the_fqdn_rule"),
+									Status:      to.Ptr(armcognitiveservices.RuleStatusActive),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -92,32 +137,60 @@ func ExampleOutboundRuleClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.OutboundRuleClientGetResponse{
-	// 	OutboundRuleBasicResource: armcognitiveservices.OutboundRuleBasicResource{
-	// 		Name: to.Ptr("rule_name_1"),
-	// 		Type: to.Ptr("accounts/outboundRules"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1"),
-	// 		Properties: &armcognitiveservices.FqdnOutboundRule{
-	// 			Type: to.Ptr(armcognitiveservices.RuleTypeFQDN),
-	// 			Category: to.Ptr(armcognitiveservices.RuleCategoryUserDefined),
-	// 			Destination: to.Ptr("destination_of_the_fqdn_rule"),
-	// 			Status: to.Ptr(armcognitiveservices.RuleStatusActive),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: rule_name_1
+	// Type: accounts/outboundRules
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1
 }
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/listRuleV2.json
 func ExampleOutboundRuleClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OutboundRuleServer: fake.OutboundRuleServer{
+					NewListPager: func(resourceGroupName string, accountName string, managedNetworkName string, options *armcognitiveservices.OutboundRuleClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.OutboundRuleClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.OutboundRuleClientListResponse{
+							OutboundRuleListResult: armcognitiveservices.OutboundRuleListResult{
+								Value: []*armcognitiveservices.OutboundRuleBasicResource{
+									{
+										Name: to.Ptr("rule_name_1"),
+										Type: to.Ptr("accounts/outboundRules"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1"),
+										Properties: &armcognitiveservices.FqdnOutboundRule{
+											Type:             to.Ptr(armcognitiveservices.RuleTypeFQDN),
+											Category:         to.Ptr(armcognitiveservices.RuleCategoryRequired),
+											Destination:      to.Ptr("destination_of_the_fqdn_rule"),
+											ErrorInformation: to.Ptr("Error message"),
+											Status:           to.Ptr(armcognitiveservices.RuleStatusInactive),
+										},
+									},
+									{
+										Name: to.Ptr("rule_name_2"),
+										Type: to.Ptr("accounts/outboundRules"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_2"),
+										Properties: &armcognitiveservices.FqdnOutboundRule{
+											Type:             to.Ptr(armcognitiveservices.RuleTypeFQDN),
+											Category:         to.Ptr(armcognitiveservices.RuleCategoryRequired),
+											Destination:      to.Ptr("destination_of_the_fqdn_rule"),
+											ErrorInformation: to.Ptr("Error message"),
+											Status:           to.Ptr(armcognitiveservices.RuleStatusInactive),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -128,39 +201,16 @@ func ExampleOutboundRuleClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.OutboundRuleClientListResponse{
-		// 	OutboundRuleListResult: armcognitiveservices.OutboundRuleListResult{
-		// 		Value: []*armcognitiveservices.OutboundRuleBasicResource{
-		// 			{
-		// 				Name: to.Ptr("rule_name_1"),
-		// 				Type: to.Ptr("accounts/outboundRules"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1"),
-		// 				Properties: &armcognitiveservices.FqdnOutboundRule{
-		// 					Type: to.Ptr(armcognitiveservices.RuleTypeFQDN),
-		// 					Category: to.Ptr(armcognitiveservices.RuleCategoryRequired),
-		// 					Destination: to.Ptr("destination_of_the_fqdn_rule"),
-		// 					ErrorInformation: to.Ptr("Error message"),
-		// 					Status: to.Ptr(armcognitiveservices.RuleStatusInactive),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("rule_name_2"),
-		// 				Type: to.Ptr("accounts/outboundRules"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_2"),
-		// 				Properties: &armcognitiveservices.FqdnOutboundRule{
-		// 					Type: to.Ptr(armcognitiveservices.RuleTypeFQDN),
-		// 					Category: to.Ptr(armcognitiveservices.RuleCategoryRequired),
-		// 					Destination: to.Ptr("destination_of_the_fqdn_rule"),
-		// 					ErrorInformation: to.Ptr("Error message"),
-		// 					Status: to.Ptr(armcognitiveservices.RuleStatusInactive),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: rule_name_1
+	// Type: accounts/outboundRules
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1
+	// Name: rule_name_2
+	// Type: accounts/outboundRules
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_2
 }
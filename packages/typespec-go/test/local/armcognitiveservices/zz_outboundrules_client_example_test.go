@@ -6,20 +6,48 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/ManagedNetwork/postOutboundRulesV2.json
 func ExampleOutboundRulesClient_BeginPost() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OutboundRulesServer: fake.OutboundRulesServer{
+					BeginPost: func(ctx context.Context, resourceGroupName string, accountName string, managedNetworkName string, body armcognitiveservices.ManagedNetworkSettingsBasicResource, options *armcognitiveservices.OutboundRulesClientBeginPostOptions) (resp azfake.PollerResponder[armcognitiveservices.OutboundRulesClientPostResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.OutboundRulesClientPostResponse{
+							OutboundRuleListResult: armcognitiveservices.OutboundRuleListResult{
+								Value: []*armcognitiveservices.OutboundRuleBasicResource{
+									{
+										Name: to.Ptr("rule_name_1"),
+										Type: to.Ptr("accounts/outboundRules"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1"),
+										Properties: &armcognitiveservices.FqdnOutboundRule{
+											Type:        to.Ptr(armcognitiveservices.RuleTypeFQDN),
+											Category:    to.Ptr(armcognitiveservices.RuleCategoryRequired),
+											Destination: to.Ptr("destination_of_the_fqdn_rule"),
+											Status:      to.Ptr(armcognitiveservices.RuleStatusActive),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -45,22 +73,5 @@ func ExampleOutboundRulesClient_BeginPost() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.OutboundRulesClientPostResponse{
-	// 	OutboundRuleListResult: armcognitiveservices.OutboundRuleListResult{
-	// 		Value: []*armcognitiveservices.OutboundRuleBasicResource{
-	// 			{
-	// 				Name: to.Ptr("rule_name_1"),
-	// 				Type: to.Ptr("accounts/outboundRules"),
-	// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/cognitive-account-name/managedNetworks/default/outboundRules/rule_name_1"),
-	// 				Properties: &armcognitiveservices.FqdnOutboundRule{
-	// 					Type: to.Ptr(armcognitiveservices.RuleTypeFQDN),
-	// 					Category: to.Ptr(armcognitiveservices.RuleCategoryRequired),
-	// 					Destination: to.Ptr("destination_of_the_fqdn_rule"),
-	// 					Status: to.Ptr(armcognitiveservices.RuleStatusActive),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
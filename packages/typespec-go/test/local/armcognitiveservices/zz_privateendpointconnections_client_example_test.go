@@ -6,20 +6,49 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutPrivateEndpointConnection.json
 func ExamplePrivateEndpointConnectionsClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, privateEndpointConnectionName string, properties armcognitiveservices.PrivateEndpointConnection, options *armcognitiveservices.PrivateEndpointConnectionsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.PrivateEndpointConnectionsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.PrivateEndpointConnectionsClientCreateOrUpdateResponse{
+							PrivateEndpointConnection: armcognitiveservices.PrivateEndpointConnection{
+								Name: to.Ptr("{privateEndpointConnectionName}"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/privateEndpointConnections"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.CognitiveServices/accounts/sto288/privateEndpointConnections/{privateEndpointConnectionName}"),
+								Properties: &armcognitiveservices.PrivateEndpointConnectionProperties{
+									PrivateEndpoint: &armcognitiveservices.PrivateEndpoint{
+										ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.Network/privateEndpoints/petest01"),
+									},
+									PrivateLinkServiceConnectionState: &armcognitiveservices.PrivateLinkServiceConnectionState{
+										Description:     to.Ptr("Auto-Approved"),
+										ActionsRequired: to.Ptr("None"),
+										Status:          to.Ptr(armcognitiveservices.PrivateEndpointServiceConnectionStatusApproved),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -38,36 +67,31 @@ func ExamplePrivateEndpointConnectionsClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.PrivateEndpointConnectionsClientCreateOrUpdateResponse{
-	// 	PrivateEndpointConnection: armcognitiveservices.PrivateEndpointConnection{
-	// 		Name: to.Ptr("{privateEndpointConnectionName}"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/privateEndpointConnections"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.CognitiveServices/accounts/sto288/privateEndpointConnections/{privateEndpointConnectionName}"),
-	// 		Properties: &armcognitiveservices.PrivateEndpointConnectionProperties{
-	// 			PrivateEndpoint: &armcognitiveservices.PrivateEndpoint{
-	// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.Network/privateEndpoints/petest01"),
-	// 			},
-	// 			PrivateLinkServiceConnectionState: &armcognitiveservices.PrivateLinkServiceConnectionState{
-	// 				Description: to.Ptr("Auto-Approved"),
-	// 				ActionsRequired: to.Ptr("None"),
-	// 				Status: to.Ptr(armcognitiveservices.PrivateEndpointServiceConnectionStatusApproved),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: {privateEndpointConnectionName}
+	// Type: Microsoft.CognitiveServices/accounts/privateEndpointConnections
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.CognitiveServices/accounts/sto288/privateEndpointConnections/{privateEndpointConnectionName}
 }
 
 // Generated from example definition: 2025-10-01-preview/DeletePrivateEndpointConnection.json
 func ExamplePrivateEndpointConnectionsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, privateEndpointConnectionName string, options *armcognitiveservices.PrivateEndpointConnectionsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.PrivateEndpointConnectionsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.PrivateEndpointConnectionsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -81,19 +105,41 @@ func ExamplePrivateEndpointConnectionsClient_BeginDelete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.PrivateEndpointConnectionsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetPrivateEndpointConnection.json
 func ExamplePrivateEndpointConnectionsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, privateEndpointConnectionName string, options *armcognitiveservices.PrivateEndpointConnectionsClientGetOptions) (resp azfake.Responder[armcognitiveservices.PrivateEndpointConnectionsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.PrivateEndpointConnectionsClientGetResponse{
+							PrivateEndpointConnection: armcognitiveservices.PrivateEndpointConnection{
+								Name: to.Ptr("{privateEndpointConnectionName}"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/privateEndpointConnections"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.CognitiveServices/accounts/sto288/privateEndpointConnections/{privateEndpointConnectionName}"),
+								Properties: &armcognitiveservices.PrivateEndpointConnectionProperties{
+									PrivateEndpoint: &armcognitiveservices.PrivateEndpoint{
+										ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.Network/privateEndpoints/petest01"),
+									},
+									PrivateLinkServiceConnectionState: &armcognitiveservices.PrivateLinkServiceConnectionState{
+										Description:     to.Ptr("Auto-Approved"),
+										ActionsRequired: to.Ptr("None"),
+										Status:          to.Ptr(armcognitiveservices.PrivateEndpointServiceConnectionStatusApproved),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -101,36 +147,51 @@ func ExamplePrivateEndpointConnectionsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.PrivateEndpointConnectionsClientGetResponse{
-	// 	PrivateEndpointConnection: armcognitiveservices.PrivateEndpointConnection{
-	// 		Name: to.Ptr("{privateEndpointConnectionName}"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/privateEndpointConnections"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.CognitiveServices/accounts/sto288/privateEndpointConnections/{privateEndpointConnectionName}"),
-	// 		Properties: &armcognitiveservices.PrivateEndpointConnectionProperties{
-	// 			PrivateEndpoint: &armcognitiveservices.PrivateEndpoint{
-	// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.Network/privateEndpoints/petest01"),
-	// 			},
-	// 			PrivateLinkServiceConnectionState: &armcognitiveservices.PrivateLinkServiceConnectionState{
-	// 				Description: to.Ptr("Auto-Approved"),
-	// 				ActionsRequired: to.Ptr("None"),
-	// 				Status: to.Ptr(armcognitiveservices.PrivateEndpointServiceConnectionStatusApproved),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: {privateEndpointConnectionName}
+	// Type: Microsoft.CognitiveServices/accounts/privateEndpointConnections
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.CognitiveServices/accounts/sto288/privateEndpointConnections/{privateEndpointConnectionName}
 }
 
 // Generated from example definition: 2025-10-01-preview/ListPrivateEndpointConnections.json
 func ExamplePrivateEndpointConnectionsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.PrivateEndpointConnectionsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.PrivateEndpointConnectionsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.PrivateEndpointConnectionsClientListResponse{
+							PrivateEndpointConnectionListResult: armcognitiveservices.PrivateEndpointConnectionListResult{
+								Value: []*armcognitiveservices.PrivateEndpointConnection{
+									{
+										Name: to.Ptr("{privateEndpointConnectionName}"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/privateEndpointConnections"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.CognitiveServices/accounts/sto288/privateEndpointConnections/{privateEndpointConnectionName}"),
+										Properties: &armcognitiveservices.PrivateEndpointConnectionProperties{
+											PrivateEndpoint: &armcognitiveservices.PrivateEndpoint{
+												ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.Network/privateEndpoints/petest01"),
+											},
+											PrivateLinkServiceConnectionState: &armcognitiveservices.PrivateLinkServiceConnectionState{
+												Description:     to.Ptr("Auto-Approved"),
+												ActionsRequired: to.Ptr("None"),
+												Status:          to.Ptr(armcognitiveservices.PrivateEndpointServiceConnectionStatusApproved),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -141,30 +202,13 @@ func ExamplePrivateEndpointConnectionsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.PrivateEndpointConnectionsClientListResponse{
-		// 	PrivateEndpointConnectionListResult: armcognitiveservices.PrivateEndpointConnectionListResult{
-		// 		Value: []*armcognitiveservices.PrivateEndpointConnection{
-		// 			{
-		// 				Name: to.Ptr("{privateEndpointConnectionName}"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/privateEndpointConnections"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.CognitiveServices/accounts/sto288/privateEndpointConnections/{privateEndpointConnectionName}"),
-		// 				Properties: &armcognitiveservices.PrivateEndpointConnectionProperties{
-		// 					PrivateEndpoint: &armcognitiveservices.PrivateEndpoint{
-		// 						ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.Network/privateEndpoints/petest01"),
-		// 					},
-		// 					PrivateLinkServiceConnectionState: &armcognitiveservices.PrivateLinkServiceConnectionState{
-		// 						Description: to.Ptr("Auto-Approved"),
-		// 						ActionsRequired: to.Ptr("None"),
-		// 						Status: to.Ptr(armcognitiveservices.PrivateEndpointServiceConnectionStatusApproved),
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: {privateEndpointConnectionName}
+	// Type: Microsoft.CognitiveServices/accounts/privateEndpointConnections
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res7231/providers/Microsoft.CognitiveServices/accounts/sto288/privateEndpointConnections/{privateEndpointConnectionName}
 }
@@ -6,19 +6,51 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/ListPrivateLinkResources.json
 func ExamplePrivateLinkResourcesClient_List() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateLinkResourcesServer: fake.PrivateLinkResourcesServer{
+					List: func(ctx context.Context, resourceGroupName string, accountName string, options *armcognitiveservices.PrivateLinkResourcesClientListOptions) (resp azfake.Responder[armcognitiveservices.PrivateLinkResourcesClientListResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.PrivateLinkResourcesClientListResponse{
+							PrivateLinkResourceListResult: armcognitiveservices.PrivateLinkResourceListResult{
+								Value: []*armcognitiveservices.PrivateLinkResource{
+									{
+										Name: to.Ptr("blob"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/privateLinkResources"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res6977/providers/Microsoft.CognitiveServices/accounts/sto2527/privateLinkResources/account"),
+										Properties: &armcognitiveservices.PrivateLinkResourceProperties{
+											GroupID: to.Ptr("account"),
+											RequiredMembers: []*string{
+												to.Ptr("default"),
+											},
+											RequiredZoneNames: []*string{
+												to.Ptr("privatelink.cognitiveservices.azure.com"),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -28,25 +60,5 @@ func ExamplePrivateLinkResourcesClient_List() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.PrivateLinkResourcesClientListResponse{
-	// 	PrivateLinkResourceListResult: armcognitiveservices.PrivateLinkResourceListResult{
-	// 		Value: []*armcognitiveservices.PrivateLinkResource{
-	// 			{
-	// 				Name: to.Ptr("blob"),
-	// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/privateLinkResources"),
-	// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/res6977/providers/Microsoft.CognitiveServices/accounts/sto2527/privateLinkResources/account"),
-	// 				Properties: &armcognitiveservices.PrivateLinkResourceProperties{
-	// 					GroupID: to.Ptr("account"),
-	// 					RequiredMembers: []*string{
-	// 						to.Ptr("default"),
-	// 					},
-	// 					RequiredZoneNames: []*string{
-	// 						to.Ptr("privatelink.cognitiveservices.azure.com"),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
@@ -6,20 +6,54 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/ProjectCapabilityHost/createOrUpdate.json
 func ExampleProjectCapabilityHostsClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectCapabilityHostsServer: fake.ProjectCapabilityHostsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, capabilityHostName string, capabilityHost armcognitiveservices.ProjectCapabilityHost, options *armcognitiveservices.ProjectCapabilityHostsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.ProjectCapabilityHostsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.ProjectCapabilityHostsClientCreateOrUpdateResponse{
+							ProjectCapabilityHost: armcognitiveservices.ProjectCapabilityHost{
+								Name: to.Ptr("capabilityHostName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/capabilityHosts"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/capabilityHosts/capabilityHostName"),
+								Properties: &armcognitiveservices.ProjectCapabilityHostProperties{
+									AiServicesConnections: []*string{
+										to.Ptr("aoai_connection"),
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
+									StorageConnections: []*string{
+										to.Ptr("blob_connection"),
+									},
+									ThreadStorageConnections: []*string{
+										to.Ptr("aca_connection"),
+									},
+									VectorStoreConnections: []*string{
+										to.Ptr("acs_connection"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -46,41 +80,31 @@ func ExampleProjectCapabilityHostsClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectCapabilityHostsClientCreateOrUpdateResponse{
-	// 	ProjectCapabilityHost: armcognitiveservices.ProjectCapabilityHost{
-	// 		Name: to.Ptr("capabilityHostName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/capabilityHosts"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/capabilityHosts/capabilityHostName"),
-	// 		Properties: &armcognitiveservices.ProjectCapabilityHostProperties{
-	// 			AiServicesConnections: []*string{
-	// 				to.Ptr("aoai_connection"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
-	// 			StorageConnections: []*string{
-	// 				to.Ptr("blob_connection"),
-	// 			},
-	// 			ThreadStorageConnections: []*string{
-	// 				to.Ptr("aca_connection"),
-	// 			},
-	// 			VectorStoreConnections: []*string{
-	// 				to.Ptr("acs_connection"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: capabilityHostName
+	// Type: Microsoft.CognitiveServices/accounts/projects/capabilityHosts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/capabilityHosts/capabilityHostName
 }
 
 // Generated from example definition: 2025-10-01-preview/ProjectCapabilityHost/delete.json
 func ExampleProjectCapabilityHostsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectCapabilityHostsServer: fake.ProjectCapabilityHostsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, capabilityHostName string, options *armcognitiveservices.ProjectCapabilityHostsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.ProjectCapabilityHostsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.ProjectCapabilityHostsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -92,16 +116,46 @@ func ExampleProjectCapabilityHostsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/ProjectCapabilityHost/get.json
 func ExampleProjectCapabilityHostsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectCapabilityHostsServer: fake.ProjectCapabilityHostsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, capabilityHostName string, options *armcognitiveservices.ProjectCapabilityHostsClientGetOptions) (resp azfake.Responder[armcognitiveservices.ProjectCapabilityHostsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ProjectCapabilityHostsClientGetResponse{
+							ProjectCapabilityHost: armcognitiveservices.ProjectCapabilityHost{
+								Name: to.Ptr("capabilityHostName"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/capabilityHosts"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/capabilityHosts/capabilityHostName"),
+								Properties: &armcognitiveservices.ProjectCapabilityHostProperties{
+									AiServicesConnections: []*string{
+										to.Ptr("aoai_connection"),
+									},
+									ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
+									StorageConnections: []*string{
+										to.Ptr("blob_connection"),
+									},
+									ThreadStorageConnections: []*string{
+										to.Ptr("aca_connection"),
+									},
+									VectorStoreConnections: []*string{
+										to.Ptr("acs_connection"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -109,41 +163,56 @@ func ExampleProjectCapabilityHostsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectCapabilityHostsClientGetResponse{
-	// 	ProjectCapabilityHost: armcognitiveservices.ProjectCapabilityHost{
-	// 		Name: to.Ptr("capabilityHostName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/capabilityHosts"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/capabilityHosts/capabilityHostName"),
-	// 		Properties: &armcognitiveservices.ProjectCapabilityHostProperties{
-	// 			AiServicesConnections: []*string{
-	// 				to.Ptr("aoai_connection"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
-	// 			StorageConnections: []*string{
-	// 				to.Ptr("blob_connection"),
-	// 			},
-	// 			ThreadStorageConnections: []*string{
-	// 				to.Ptr("aca_connection"),
-	// 			},
-	// 			VectorStoreConnections: []*string{
-	// 				to.Ptr("acs_connection"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: capabilityHostName
+	// Type: Microsoft.CognitiveServices/accounts/projects/capabilityHosts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/capabilityHosts/capabilityHostName
 }
 
 // Generated from example definition: 2025-10-01-preview/ProjectCapabilityHost/list.json
 func ExampleProjectCapabilityHostsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectCapabilityHostsServer: fake.ProjectCapabilityHostsServer{
+					NewListPager: func(resourceGroupName string, accountName string, projectName string, options *armcognitiveservices.ProjectCapabilityHostsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.ProjectCapabilityHostsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.ProjectCapabilityHostsClientListResponse{
+							ProjectCapabilityHostResourceArmPaginatedResult: armcognitiveservices.ProjectCapabilityHostResourceArmPaginatedResult{
+								Value: []*armcognitiveservices.ProjectCapabilityHost{
+									{
+										Name: to.Ptr("capabilityHostName"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/capabilityHosts"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/capabilityHosts/capabilityHostName"),
+										Properties: &armcognitiveservices.ProjectCapabilityHostProperties{
+											AiServicesConnections: []*string{
+												to.Ptr("aoai_connection"),
+											},
+											ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
+											StorageConnections: []*string{
+												to.Ptr("blob_connection"),
+											},
+											ThreadStorageConnections: []*string{
+												to.Ptr("aca_connection"),
+											},
+											VectorStoreConnections: []*string{
+												to.Ptr("acs_connection"),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -154,35 +223,13 @@ func ExampleProjectCapabilityHostsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.ProjectCapabilityHostsClientListResponse{
-		// 	ProjectCapabilityHostResourceArmPaginatedResult: armcognitiveservices.ProjectCapabilityHostResourceArmPaginatedResult{
-		// 		Value: []*armcognitiveservices.ProjectCapabilityHost{
-		// 			{
-		// 				Name: to.Ptr("capabilityHostName"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/capabilityHosts"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/capabilityHosts/capabilityHostName"),
-		// 				Properties: &armcognitiveservices.ProjectCapabilityHostProperties{
-		// 					AiServicesConnections: []*string{
-		// 						to.Ptr("aoai_connection"),
-		// 					},
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.CapabilityHostProvisioningStateSucceeded),
-		// 					StorageConnections: []*string{
-		// 						to.Ptr("blob_connection"),
-		// 					},
-		// 					ThreadStorageConnections: []*string{
-		// 						to.Ptr("aca_connection"),
-		// 					},
-		// 					VectorStoreConnections: []*string{
-		// 						to.Ptr("acs_connection"),
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: capabilityHostName
+	// Type: Microsoft.CognitiveServices/accounts/projects/capabilityHosts
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/test-rg/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/capabilityHosts/capabilityHostName
 }
@@ -6,21 +6,46 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/ProjectConnection/create.json
 func ExampleProjectConnectionsClient_Create() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectConnectionsServer: fake.ProjectConnectionsServer{
+					Create: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, connectionName string, connection armcognitiveservices.ConnectionPropertiesV2BasicResource, options *armcognitiveservices.ProjectConnectionsClientCreateOptions) (resp azfake.Responder[armcognitiveservices.ProjectConnectionsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ProjectConnectionsClientCreateResponse{
+							ConnectionPropertiesV2BasicResource: armcognitiveservices.ConnectionPropertiesV2BasicResource{
+								Name: to.Ptr("connection-1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/connections/connection-1"),
+								Properties: &armcognitiveservices.NoneAuthTypeConnectionProperties{
+									AuthType:   to.Ptr(armcognitiveservices.ConnectionAuthTypeNone),
+									Category:   to.Ptr(armcognitiveservices.ConnectionCategoryContainerRegistry),
+									ExpiryTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-15T14:30:00Z"); return t }()),
+									Target:     to.Ptr("[tartget url]"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -35,32 +60,31 @@ func ExampleProjectConnectionsClient_Create() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectConnectionsClientCreateResponse{
-	// 	ConnectionPropertiesV2BasicResource: armcognitiveservices.ConnectionPropertiesV2BasicResource{
-	// 		Name: to.Ptr("connection-1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/connections/connection-1"),
-	// 		Properties: &armcognitiveservices.NoneAuthTypeConnectionProperties{
-	// 			AuthType: to.Ptr(armcognitiveservices.ConnectionAuthTypeNone),
-	// 			Category: to.Ptr(armcognitiveservices.ConnectionCategoryContainerRegistry),
-	// 			ExpiryTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-15T14:30:00Z"); return t}()),
-	// 			Target: to.Ptr("[tartget url]"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: connection-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/connections
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/connections/connection-1
 }
 
 // Generated from example definition: 2025-10-01-preview/ProjectConnection/delete.json
 func ExampleProjectConnectionsClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectConnectionsServer: fake.ProjectConnectionsServer{
+					Delete: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, connectionName string, options *armcognitiveservices.ProjectConnectionsClientDeleteOptions) (resp azfake.Responder[armcognitiveservices.ProjectConnectionsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ProjectConnectionsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -70,19 +94,37 @@ func ExampleProjectConnectionsClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectConnectionsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/ProjectConnection/get.json
 func ExampleProjectConnectionsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectConnectionsServer: fake.ProjectConnectionsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, connectionName string, options *armcognitiveservices.ProjectConnectionsClientGetOptions) (resp azfake.Responder[armcognitiveservices.ProjectConnectionsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ProjectConnectionsClientGetResponse{
+							ConnectionPropertiesV2BasicResource: armcognitiveservices.ConnectionPropertiesV2BasicResource{
+								Name: to.Ptr("connection-1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/connections/connection-1"),
+								Properties: &armcognitiveservices.NoneAuthTypeConnectionProperties{
+									AuthType:   to.Ptr(armcognitiveservices.ConnectionAuthTypeNone),
+									Category:   to.Ptr(armcognitiveservices.ConnectionCategoryContainerRegistry),
+									ExpiryTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-15T14:30:00Z"); return t }()),
+									Target:     to.Ptr("[tartget url]"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -90,32 +132,57 @@ func ExampleProjectConnectionsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectConnectionsClientGetResponse{
-	// 	ConnectionPropertiesV2BasicResource: armcognitiveservices.ConnectionPropertiesV2BasicResource{
-	// 		Name: to.Ptr("connection-1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/connections/connection-1"),
-	// 		Properties: &armcognitiveservices.NoneAuthTypeConnectionProperties{
-	// 			AuthType: to.Ptr(armcognitiveservices.ConnectionAuthTypeNone),
-	// 			Category: to.Ptr(armcognitiveservices.ConnectionCategoryContainerRegistry),
-	// 			ExpiryTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-15T14:30:00Z"); return t}()),
-	// 			Target: to.Ptr("[tartget url]"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: connection-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/connections
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/connections/connection-1
 }
 
 // Generated from example definition: 2025-10-01-preview/ProjectConnection/list.json
 func ExampleProjectConnectionsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectConnectionsServer: fake.ProjectConnectionsServer{
+					NewListPager: func(resourceGroupName string, accountName string, projectName string, options *armcognitiveservices.ProjectConnectionsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.ProjectConnectionsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.ProjectConnectionsClientListResponse{
+							ConnectionPropertiesV2BasicResourceArmPaginatedResult: armcognitiveservices.ConnectionPropertiesV2BasicResourceArmPaginatedResult{
+								Value: []*armcognitiveservices.ConnectionPropertiesV2BasicResource{
+									{
+										Name: to.Ptr("connection-1"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/linkedaccounts/connection-1"),
+										Properties: &armcognitiveservices.PATAuthTypeConnectionProperties{
+											AuthType:   to.Ptr(armcognitiveservices.ConnectionAuthTypePAT),
+											Category:   to.Ptr(armcognitiveservices.ConnectionCategoryContainerRegistry),
+											ExpiryTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-15T14:30:00Z"); return t }()),
+											Target:     to.Ptr("[tartget url]"),
+										},
+									},
+									{
+										Name: to.Ptr("connection-2"),
+										Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/linkedaccounts/connection-2"),
+										Properties: &armcognitiveservices.PATAuthTypeConnectionProperties{
+											AuthType: to.Ptr(armcognitiveservices.ConnectionAuthTypePAT),
+											Category: to.Ptr(armcognitiveservices.ConnectionCategoryContainerRegistry),
+											Target:   to.Ptr("[tartget url]"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -128,48 +195,49 @@ func ExampleProjectConnectionsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.ProjectConnectionsClientListResponse{
-		// 	ConnectionPropertiesV2BasicResourceArmPaginatedResult: armcognitiveservices.ConnectionPropertiesV2BasicResourceArmPaginatedResult{
-		// 		Value: []*armcognitiveservices.ConnectionPropertiesV2BasicResource{
-		// 			{
-		// 				Name: to.Ptr("connection-1"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/linkedaccounts/connection-1"),
-		// 				Properties: &armcognitiveservices.PATAuthTypeConnectionProperties{
-		// 					AuthType: to.Ptr(armcognitiveservices.ConnectionAuthTypePAT),
-		// 					Category: to.Ptr(armcognitiveservices.ConnectionCategoryContainerRegistry),
-		// 					ExpiryTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-03-15T14:30:00Z"); return t}()),
-		// 					Target: to.Ptr("[tartget url]"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("connection-2"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/linkedaccounts/connection-2"),
-		// 				Properties: &armcognitiveservices.PATAuthTypeConnectionProperties{
-		// 					AuthType: to.Ptr(armcognitiveservices.ConnectionAuthTypePAT),
-		// 					Category: to.Ptr(armcognitiveservices.ConnectionCategoryContainerRegistry),
-		// 					Target: to.Ptr("[tartget url]"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: connection-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/connections
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/linkedaccounts/connection-1
+	// Name: connection-2
+	// Type: Microsoft.CognitiveServices/accounts/projects/connections
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/linkedaccounts/connection-2
 }
 
 // Generated from example definition: 2025-10-01-preview/ProjectConnection/update.json
 func ExampleProjectConnectionsClient_Update() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectConnectionsServer: fake.ProjectConnectionsServer{
+					Update: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, connectionName string, connection armcognitiveservices.ConnectionUpdateContent, options *armcognitiveservices.ProjectConnectionsClientUpdateOptions) (resp azfake.Responder[armcognitiveservices.ProjectConnectionsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ProjectConnectionsClientUpdateResponse{
+							ConnectionPropertiesV2BasicResource: armcognitiveservices.ConnectionPropertiesV2BasicResource{
+								Name: to.Ptr("connection-1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/connections/connection-1"),
+								Properties: &armcognitiveservices.AccessKeyAuthTypeConnectionProperties{
+									AuthType:   to.Ptr(armcognitiveservices.ConnectionAuthTypeAccessKey),
+									Category:   to.Ptr(armcognitiveservices.ConnectionCategoryADLSGen2),
+									ExpiryTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T00:00:00Z"); return t }()),
+									Metadata:   map[string]*string{},
+									Target:     to.Ptr("some_string"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -189,22 +257,11 @@ func ExampleProjectConnectionsClient_Update() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectConnectionsClientUpdateResponse{
-	// 	ConnectionPropertiesV2BasicResource: armcognitiveservices.ConnectionPropertiesV2BasicResource{
-	// 		Name: to.Ptr("connection-1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects/connections"),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/connections/connection-1"),
-	// 		Properties: &armcognitiveservices.AccessKeyAuthTypeConnectionProperties{
-	// 			AuthType: to.Ptr(armcognitiveservices.ConnectionAuthTypeAccessKey),
-	// 			Category: to.Ptr(armcognitiveservices.ConnectionCategoryADLSGen2),
-	// 			ExpiryTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T00:00:00Z"); return t}()),
-	// 			Metadata: map[string]*string{
-	// 			},
-	// 			Target: to.Ptr("some_string"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: connection-1
+	// Type: Microsoft.CognitiveServices/accounts/projects/connections
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/resourceGroup-1/providers/Microsoft.CognitiveServices/accounts/account-1/projects/project-1/connections/connection-1
 }
@@ -6,20 +6,57 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/CreateProject.json
 func ExampleProjectsClient_BeginCreate_createProject() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectsServer: fake.ProjectsServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, project armcognitiveservices.Project, options *armcognitiveservices.ProjectsClientBeginCreateOptions) (resp azfake.PollerResponder[armcognitiveservices.ProjectsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.ProjectsClientCreateResponse{
+							Project: armcognitiveservices.Project{
+								Name: to.Ptr("testProject1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
+								Etag: to.Ptr("W/\"datetime'2017-04-10T08%3A00%3A05.445595Z'\""),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1/projects/testProject1"),
+								Identity: &armcognitiveservices.Identity{
+									Type:        to.Ptr(armcognitiveservices.ResourceIdentityTypeSystemAssigned),
+									PrincipalID: to.Ptr("b5cf119e-a5c2-42c7-802f-592e0efb169f"),
+									TenantID:    to.Ptr("72f988bf-86f1-41af-91ab-2d7cd011db47"),
+								},
+								Location: to.Ptr("West US"),
+								Properties: &armcognitiveservices.ProjectProperties{
+									Description: to.Ptr("Description of this project"),
+									DisplayName: to.Ptr("p1"),
+									Endpoints: map[string]*string{
+										"OpenAI Dall-E API":                  to.Ptr("https://sub-donmain-name.openai.azure.com/"),
+										"OpenAI Language Model Instance API": to.Ptr("https://sub-donmain-name.openai.azure.com/"),
+										"OpenAI Sora API":                    to.Ptr("https://sub-donmain-name.openai.azure.com/"),
+									},
+									IsDefault:         to.Ptr(true),
+									ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -40,44 +77,57 @@ func ExampleProjectsClient_BeginCreate_createProject() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectsClientCreateResponse{
-	// 	Project: armcognitiveservices.Project{
-	// 		Name: to.Ptr("testProject1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
-	// 		Etag: to.Ptr("W/\"datetime'2017-04-10T08%3A00%3A05.445595Z'\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1/projects/testProject1"),
-	// 		Identity: &armcognitiveservices.Identity{
-	// 			Type: to.Ptr(armcognitiveservices.ResourceIdentityTypeSystemAssigned),
-	// 			PrincipalID: to.Ptr("b5cf119e-a5c2-42c7-802f-592e0efb169f"),
-	// 			TenantID: to.Ptr("72f988bf-86f1-41af-91ab-2d7cd011db47"),
-	// 		},
-	// 		Location: to.Ptr("West US"),
-	// 		Properties: &armcognitiveservices.ProjectProperties{
-	// 			Description: to.Ptr("Description of this project"),
-	// 			DisplayName: to.Ptr("p1"),
-	// 			Endpoints: map[string]*string{
-	// 				"OpenAI Dall-E API": to.Ptr("https://sub-donmain-name.openai.azure.com/"),
-	// 				"OpenAI Language Model Instance API": to.Ptr("https://sub-donmain-name.openai.azure.com/"),
-	// 				"OpenAI Sora API": to.Ptr("https://sub-donmain-name.openai.azure.com/"),
-	// 			},
-	// 			IsDefault: to.Ptr(true),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: testProject1
+	// Type: Microsoft.CognitiveServices/accounts/projects
+	// Etag: W/"datetime'2017-04-10T08%3A00%3A05.445595Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1/projects/testProject1
+	// Location: West US
 }
 
 // Generated from example definition: 2025-10-01-preview/CreateProjectMin.json
 func ExampleProjectsClient_BeginCreate_createProjectMin() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectsServer: fake.ProjectsServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, project armcognitiveservices.Project, options *armcognitiveservices.ProjectsClientBeginCreateOptions) (resp azfake.PollerResponder[armcognitiveservices.ProjectsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.ProjectsClientCreateResponse{
+							Project: armcognitiveservices.Project{
+								Name: to.Ptr("testProject1"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
+								Etag: to.Ptr("W/\"datetime'2017-04-10T08%3A00%3A05.445595Z'\""),
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1/projects/testProject1"),
+								Identity: &armcognitiveservices.Identity{
+									Type:        to.Ptr(armcognitiveservices.ResourceIdentityTypeSystemAssigned),
+									PrincipalID: to.Ptr("b5cf119e-a5c2-42c7-802f-592e0efb169f"),
+									TenantID:    to.Ptr("72f988bf-86f1-41af-91ab-2d7cd011db47"),
+								},
+								Location: to.Ptr("West US"),
+								Properties: &armcognitiveservices.ProjectProperties{
+									Endpoints: map[string]*string{
+										"OpenAI Dall-E API":                  to.Ptr("https://sub-donmain-name.openai.azure.com/"),
+										"OpenAI Language Model Instance API": to.Ptr("https://sub-donmain-name.openai.azure.com/"),
+										"OpenAI Sora API":                    to.Ptr("https://sub-donmain-name.openai.azure.com/"),
+									},
+									IsDefault:         to.Ptr(true),
+									ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -95,42 +145,35 @@ func ExampleProjectsClient_BeginCreate_createProjectMin() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectsClientCreateResponse{
-	// 	Project: armcognitiveservices.Project{
-	// 		Name: to.Ptr("testProject1"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
-	// 		Etag: to.Ptr("W/\"datetime'2017-04-10T08%3A00%3A05.445595Z'\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1/projects/testProject1"),
-	// 		Identity: &armcognitiveservices.Identity{
-	// 			Type: to.Ptr(armcognitiveservices.ResourceIdentityTypeSystemAssigned),
-	// 			PrincipalID: to.Ptr("b5cf119e-a5c2-42c7-802f-592e0efb169f"),
-	// 			TenantID: to.Ptr("72f988bf-86f1-41af-91ab-2d7cd011db47"),
-	// 		},
-	// 		Location: to.Ptr("West US"),
-	// 		Properties: &armcognitiveservices.ProjectProperties{
-	// 			Endpoints: map[string]*string{
-	// 				"OpenAI Dall-E API": to.Ptr("https://sub-donmain-name.openai.azure.com/"),
-	// 				"OpenAI Language Model Instance API": to.Ptr("https://sub-donmain-name.openai.azure.com/"),
-	// 				"OpenAI Sora API": to.Ptr("https://sub-donmain-name.openai.azure.com/"),
-	// 			},
-	// 			IsDefault: to.Ptr(true),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: testProject1
+	// Type: Microsoft.CognitiveServices/accounts/projects
+	// Etag: W/"datetime'2017-04-10T08%3A00%3A05.445595Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/testCreate1/projects/testProject1
+	// Location: West US
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteProject.json
 func ExampleProjectsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectsServer: fake.ProjectsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, options *armcognitiveservices.ProjectsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.ProjectsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.ProjectsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -142,16 +185,47 @@ func ExampleProjectsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetProject.json
 func ExampleProjectsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectsServer: fake.ProjectsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, options *armcognitiveservices.ProjectsClientGetOptions) (resp azfake.Responder[armcognitiveservices.ProjectsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.ProjectsClientGetResponse{
+							Project: armcognitiveservices.Project{
+								Name:     to.Ptr("myProject"),
+								Type:     to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
+								Etag:     to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount/projects/myProject"),
+								Location: to.Ptr("westus"),
+								Properties: &armcognitiveservices.ProjectProperties{
+									Description: to.Ptr("This is my project"),
+									DisplayName: to.Ptr("myProject"),
+									Endpoints: map[string]*string{
+										"OpenAI Dall-E API":                  to.Ptr("https://customSubDomainName.openai.azure.com/"),
+										"OpenAI Language Model Instance API": to.Ptr("https://customSubDomainName.openai.azure.com/"),
+									},
+									IsDefault:         to.Ptr(false),
+									ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+								},
+								Tags: map[string]*string{
+									"ExpiredDate": to.Ptr("2017/09/01"),
+									"Owner":       to.Ptr("felixwa"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -159,42 +233,79 @@ func ExampleProjectsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectsClientGetResponse{
-	// 	Project: armcognitiveservices.Project{
-	// 		Name: to.Ptr("myProject"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
-	// 		Etag: to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount/projects/myProject"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armcognitiveservices.ProjectProperties{
-	// 			Description: to.Ptr("This is my project"),
-	// 			DisplayName: to.Ptr("myProject"),
-	// 			Endpoints: map[string]*string{
-	// 				"OpenAI Dall-E API": to.Ptr("https://customSubDomainName.openai.azure.com/"),
-	// 				"OpenAI Language Model Instance API": to.Ptr("https://customSubDomainName.openai.azure.com/"),
-	// 			},
-	// 			IsDefault: to.Ptr(false),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"ExpiredDate": to.Ptr("2017/09/01"),
-	// 			"Owner": to.Ptr("felixwa"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: myProject
+	// Type: Microsoft.CognitiveServices/accounts/projects
+	// Etag: W/"datetime'2017-04-10T04%3A42%3A19.7067387Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount/projects/myProject
+	// Location: westus
 }
 
 // Generated from example definition: 2025-10-01-preview/ListProjects.json
 func ExampleProjectsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectsServer: fake.ProjectsServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.ProjectsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.ProjectsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.ProjectsClientListResponse{
+							ProjectListResult: armcognitiveservices.ProjectListResult{
+								Value: []*armcognitiveservices.Project{
+									{
+										Name:     to.Ptr("myProject"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
+										Etag:     to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount/projects/myProject"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.ProjectProperties{
+											Description: to.Ptr("This is my project 1"),
+											DisplayName: to.Ptr("myProject1"),
+											Endpoints: map[string]*string{
+												"OpenAI Dall-E API":                  to.Ptr("https://customSubDomainName.openai.azure.com/"),
+												"OpenAI Language Model Instance API": to.Ptr("https://customSubDomainName.openai.azure.com/"),
+											},
+											IsDefault:         to.Ptr(true),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										Tags: map[string]*string{
+											"ExpiredDate": to.Ptr("2017/09/01"),
+											"Owner":       to.Ptr("felixwa"),
+										},
+									},
+									{
+										Name:     to.Ptr("myProject-2"),
+										Type:     to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
+										Etag:     to.Ptr("W/\"datetime'2017-04-07T04%3A32%3A38.9187216Z'\""),
+										ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount/projects/myProject-2"),
+										Location: to.Ptr("westus"),
+										Properties: &armcognitiveservices.ProjectProperties{
+											Description: to.Ptr("This is my project 2"),
+											DisplayName: to.Ptr("myProject2"),
+											Endpoints: map[string]*string{
+												"OpenAI Dall-E API":                  to.Ptr("https://customSubDomainName2.openai.azure.com/"),
+												"OpenAI Language Model Instance API": to.Ptr("https://customSubDomainName2.openai.azure.com/"),
+											},
+											IsDefault:         to.Ptr(false),
+											ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+										},
+										Tags: map[string]*string{},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -205,67 +316,60 @@ func ExampleProjectsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Etag:", *v.Etag)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.ProjectsClientListResponse{
-		// 	ProjectListResult: armcognitiveservices.ProjectListResult{
-		// 		Value: []*armcognitiveservices.Project{
-		// 			{
-		// 				Name: to.Ptr("myProject"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-04-10T04%3A42%3A19.7067387Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount/projects/myProject"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.ProjectProperties{
-		// 					Description: to.Ptr("This is my project 1"),
-		// 					DisplayName: to.Ptr("myProject1"),
-		// 					Endpoints: map[string]*string{
-		// 						"OpenAI Dall-E API": to.Ptr("https://customSubDomainName.openai.azure.com/"),
-		// 						"OpenAI Language Model Instance API": to.Ptr("https://customSubDomainName.openai.azure.com/"),
-		// 					},
-		// 					IsDefault: to.Ptr(true),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"ExpiredDate": to.Ptr("2017/09/01"),
-		// 					"Owner": to.Ptr("felixwa"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("myProject-2"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
-		// 				Etag: to.Ptr("W/\"datetime'2017-04-07T04%3A32%3A38.9187216Z'\""),
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount/projects/myProject-2"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armcognitiveservices.ProjectProperties{
-		// 					Description: to.Ptr("This is my project 2"),
-		// 					DisplayName: to.Ptr("myProject2"),
-		// 					Endpoints: map[string]*string{
-		// 						"OpenAI Dall-E API": to.Ptr("https://customSubDomainName2.openai.azure.com/"),
-		// 						"OpenAI Language Model Instance API": to.Ptr("https://customSubDomainName2.openai.azure.com/"),
-		// 					},
-		// 					IsDefault: to.Ptr(false),
-		// 					ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: myProject
+	// Type: Microsoft.CognitiveServices/accounts/projects
+	// Etag: W/"datetime'2017-04-10T04%3A42%3A19.7067387Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount/projects/myProject
+	// Location: westus
+	// Name: myProject-2
+	// Type: Microsoft.CognitiveServices/accounts/projects
+	// Etag: W/"datetime'2017-04-07T04%3A32%3A38.9187216Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/myResourceGroup/providers/Microsoft.CognitiveServices/accounts/myAccount/projects/myProject-2
+	// Location: westus
 }
 
 // Generated from example definition: 2025-10-01-preview/UpdateProjects.json
 func ExampleProjectsClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ProjectsServer: fake.ProjectsServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, accountName string, projectName string, project armcognitiveservices.Project, options *armcognitiveservices.ProjectsClientBeginUpdateOptions) (resp azfake.PollerResponder[armcognitiveservices.ProjectsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcognitiveservices.ProjectsClientUpdateResponse{
+							Project: armcognitiveservices.Project{
+								Name:     to.Ptr("projectName"),
+								Type:     to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
+								Etag:     to.Ptr("W/\"datetime'2017-04-10T07%3A46%3A21.5618831Z'\""),
+								ID:       to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch/projects/projectName"),
+								Location: to.Ptr("global"),
+								Properties: &armcognitiveservices.ProjectProperties{
+									Description: to.Ptr("new description."),
+									DisplayName: to.Ptr("myProject"),
+									Endpoints: map[string]*string{
+										"OpenAI Dall-E API":                  to.Ptr("https://customSubDomainName.openai.azure.com/"),
+										"OpenAI Language Model Instance API": to.Ptr("https://customSubDomainName.openai.azure.com/"),
+									},
+									IsDefault:         to.Ptr(false),
+									ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -282,26 +386,15 @@ func ExampleProjectsClient_BeginUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.ProjectsClientUpdateResponse{
-	// 	Project: armcognitiveservices.Project{
-	// 		Name: to.Ptr("projectName"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/projects"),
-	// 		Etag: to.Ptr("W/\"datetime'2017-04-10T07%3A46%3A21.5618831Z'\""),
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch/projects/projectName"),
-	// 		Location: to.Ptr("global"),
-	// 		Properties: &armcognitiveservices.ProjectProperties{
-	// 			Description: to.Ptr("new description."),
-	// 			DisplayName: to.Ptr("myProject"),
-	// 			Endpoints: map[string]*string{
-	// 				"OpenAI Dall-E API": to.Ptr("https://customSubDomainName.openai.azure.com/"),
-	// 				"OpenAI Language Model Instance API": to.Ptr("https://customSubDomainName.openai.azure.com/"),
-	// 			},
-	// 			IsDefault: to.Ptr(false),
-	// 			ProvisioningState: to.Ptr(armcognitiveservices.ProvisioningStateSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Etag:", *res.Etag)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: projectName
+	// Type: Microsoft.CognitiveServices/accounts/projects
+	// Etag: W/"datetime'2017-04-10T07%3A46%3A21.5618831Z'"
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/bvttest/providers/Microsoft.CognitiveServices/accounts/bingSearch/projects/projectName
+	// Location: global
 }
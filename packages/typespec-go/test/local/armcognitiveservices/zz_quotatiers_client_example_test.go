@@ -6,20 +6,45 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/CreateOrUpdateQuotaTier.json
 func ExampleQuotaTiersClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-00000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-00000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				QuotaTiersServer: fake.QuotaTiersServer{
+					CreateOrUpdate: func(ctx context.Context, defaultParam string, tier armcognitiveservices.QuotaTier, options *armcognitiveservices.QuotaTiersClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.QuotaTiersClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.QuotaTiersClientCreateOrUpdateResponse{
+							QuotaTier: armcognitiveservices.QuotaTier{
+								Name: to.Ptr("default"),
+								Type: to.Ptr("Microsoft.CognitiveServices/quotaTiers"),
+								ID:   to.Ptr("/subscriptions/00000000-00000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default"),
+								Properties: &armcognitiveservices.QuotaTierProperties{
+									AssignmentDate:    to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-09T18:13:29.389Z"); return t }()),
+									CurrentTierName:   to.Ptr("Free-Tier"),
+									TierUpgradePolicy: to.Ptr(armcognitiveservices.TierUpgradePolicyNoAutoUpgrade),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -31,31 +56,47 @@ func ExampleQuotaTiersClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.QuotaTiersClientCreateOrUpdateResponse{
-	// 	QuotaTier: armcognitiveservices.QuotaTier{
-	// 		Name: to.Ptr("default"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/quotaTiers"),
-	// 		ID: to.Ptr("/subscriptions/00000000-00000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default"),
-	// 		Properties: &armcognitiveservices.QuotaTierProperties{
-	// 			AssignmentDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-09T18:13:29.389Z"); return t}()),
-	// 			CurrentTierName: to.Ptr("Free-Tier"),
-	// 			TierUpgradePolicy: to.Ptr(armcognitiveservices.TierUpgradePolicyNoAutoUpgrade),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: default
+	// Type: Microsoft.CognitiveServices/quotaTiers
+	// ID: /subscriptions/00000000-00000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default
 }
 
 // Generated from example definition: 2025-10-01-preview/GetQuotaTier.json
 func ExampleQuotaTiersClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				QuotaTiersServer: fake.QuotaTiersServer{
+					Get: func(ctx context.Context, defaultParam string, options *armcognitiveservices.QuotaTiersClientGetOptions) (resp azfake.Responder[armcognitiveservices.QuotaTiersClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.QuotaTiersClientGetResponse{
+							QuotaTier: armcognitiveservices.QuotaTier{
+								Name: to.Ptr("default"),
+								Type: to.Ptr("Microsoft.CognitiveServices/quotaTiers"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default"),
+								Properties: &armcognitiveservices.QuotaTierProperties{
+									AssignmentDate:  to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-09T18:13:29.389Z"); return t }()),
+									CurrentTierName: to.Ptr("Free-Tier"),
+									TierUpgradeEligibilityInfo: &armcognitiveservices.QuotaTierUpgradeEligibilityInfo{
+										NextTierName:              to.Ptr("Tier-1"),
+										UpgradeApplicableDate:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-15T18:13:29.389Z"); return t }()),
+										UpgradeAvailabilityStatus: to.Ptr(armcognitiveservices.UpgradeAvailabilityStatusAvailable),
+									},
+									TierUpgradePolicy: to.Ptr(armcognitiveservices.TierUpgradePolicyOnceUpgradeIsAvailable),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -63,36 +104,51 @@ func ExampleQuotaTiersClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.QuotaTiersClientGetResponse{
-	// 	QuotaTier: armcognitiveservices.QuotaTier{
-	// 		Name: to.Ptr("default"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/quotaTiers"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default"),
-	// 		Properties: &armcognitiveservices.QuotaTierProperties{
-	// 			AssignmentDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-09T18:13:29.389Z"); return t}()),
-	// 			CurrentTierName: to.Ptr("Free-Tier"),
-	// 			TierUpgradeEligibilityInfo: &armcognitiveservices.QuotaTierUpgradeEligibilityInfo{
-	// 				NextTierName: to.Ptr("Tier-1"),
-	// 				UpgradeApplicableDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-15T18:13:29.389Z"); return t}()),
-	// 				UpgradeAvailabilityStatus: to.Ptr(armcognitiveservices.UpgradeAvailabilityStatusAvailable),
-	// 			},
-	// 			TierUpgradePolicy: to.Ptr(armcognitiveservices.TierUpgradePolicyOnceUpgradeIsAvailable),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: default
+	// Type: Microsoft.CognitiveServices/quotaTiers
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default
 }
 
 // Generated from example definition: 2025-10-01-preview/ListQuotaTiers.json
 func ExampleQuotaTiersClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-1111-2222-3333-444444444444", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				QuotaTiersServer: fake.QuotaTiersServer{
+					NewListBySubscriptionPager: func(options *armcognitiveservices.QuotaTiersClientListBySubscriptionOptions) (resp azfake.PagerResponder[armcognitiveservices.QuotaTiersClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.QuotaTiersClientListBySubscriptionResponse{
+							QuotaTierListResult: armcognitiveservices.QuotaTierListResult{
+								Value: []*armcognitiveservices.QuotaTier{
+									{
+										Name: to.Ptr("default"),
+										Type: to.Ptr("Microsoft.CognitiveServices/quotaTiers"),
+										ID:   to.Ptr("/subscriptions/00000000-00000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default"),
+										Properties: &armcognitiveservices.QuotaTierProperties{
+											AssignmentDate:  to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-09T18:13:29.389Z"); return t }()),
+											CurrentTierName: to.Ptr("Free-Tier"),
+											TierUpgradeEligibilityInfo: &armcognitiveservices.QuotaTierUpgradeEligibilityInfo{
+												NextTierName:              to.Ptr("Tier-1"),
+												UpgradeApplicableDate:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-15T18:13:29.389Z"); return t }()),
+												UpgradeAvailabilityStatus: to.Ptr(armcognitiveservices.UpgradeAvailabilityStatusAvailable),
+											},
+											TierUpgradePolicy: to.Ptr(armcognitiveservices.TierUpgradePolicyOnceUpgradeIsAvailable),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -103,42 +159,44 @@ func ExampleQuotaTiersClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.QuotaTiersClientListBySubscriptionResponse{
-		// 	QuotaTierListResult: armcognitiveservices.QuotaTierListResult{
-		// 		Value: []*armcognitiveservices.QuotaTier{
-		// 			{
-		// 				Name: to.Ptr("default"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/quotaTiers"),
-		// 				ID: to.Ptr("/subscriptions/00000000-00000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default"),
-		// 				Properties: &armcognitiveservices.QuotaTierProperties{
-		// 					AssignmentDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-09T18:13:29.389Z"); return t}()),
-		// 					CurrentTierName: to.Ptr("Free-Tier"),
-		// 					TierUpgradeEligibilityInfo: &armcognitiveservices.QuotaTierUpgradeEligibilityInfo{
-		// 						NextTierName: to.Ptr("Tier-1"),
-		// 						UpgradeApplicableDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-15T18:13:29.389Z"); return t}()),
-		// 						UpgradeAvailabilityStatus: to.Ptr(armcognitiveservices.UpgradeAvailabilityStatusAvailable),
-		// 					},
-		// 					TierUpgradePolicy: to.Ptr(armcognitiveservices.TierUpgradePolicyOnceUpgradeIsAvailable),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: default
+	// Type: Microsoft.CognitiveServices/quotaTiers
+	// ID: /subscriptions/00000000-00000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default
 }
 
 // Generated from example definition: 2025-10-01-preview/UpdateQuotaTier.json
 func ExampleQuotaTiersClient_Update() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				QuotaTiersServer: fake.QuotaTiersServer{
+					Update: func(ctx context.Context, defaultParam string, tier armcognitiveservices.QuotaTier, options *armcognitiveservices.QuotaTiersClientUpdateOptions) (resp azfake.Responder[armcognitiveservices.QuotaTiersClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.QuotaTiersClientUpdateResponse{
+							QuotaTier: armcognitiveservices.QuotaTier{
+								Name: to.Ptr("default"),
+								Type: to.Ptr("Microsoft.CognitiveServices/quotaTiers"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default"),
+								Properties: &armcognitiveservices.QuotaTierProperties{
+									AssignmentDate:    to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-09T18:13:29.389Z"); return t }()),
+									CurrentTierName:   to.Ptr("Free-Tier"),
+									TierUpgradePolicy: to.Ptr(armcognitiveservices.TierUpgradePolicyNoAutoUpgrade),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -150,19 +208,11 @@ func ExampleQuotaTiersClient_Update() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.QuotaTiersClientUpdateResponse{
-	// 	QuotaTier: armcognitiveservices.QuotaTier{
-	// 		Name: to.Ptr("default"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/quotaTiers"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default"),
-	// 		Properties: &armcognitiveservices.QuotaTierProperties{
-	// 			AssignmentDate: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-06-09T18:13:29.389Z"); return t}()),
-	// 			CurrentTierName: to.Ptr("Free-Tier"),
-	// 			TierUpgradePolicy: to.Ptr(armcognitiveservices.TierUpgradePolicyNoAutoUpgrade),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: default
+	// Type: Microsoft.CognitiveServices/quotaTiers
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/quotaTiers/default
 }
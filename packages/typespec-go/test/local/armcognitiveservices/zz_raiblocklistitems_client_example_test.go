@@ -6,20 +6,41 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/AddRaiBlocklistItems.json
 func ExampleRaiBlocklistItemsClient_BatchAdd() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiBlocklistItemsServer: fake.RaiBlocklistItemsServer{
+					BatchAdd: func(ctx context.Context, resourceGroupName string, accountName string, raiBlocklistName string, raiBlocklistItems []*armcognitiveservices.RaiBlocklistItemBulkRequest, options *armcognitiveservices.RaiBlocklistItemsClientBatchAddOptions) (resp azfake.Responder[armcognitiveservices.RaiBlocklistItemsClientBatchAddResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiBlocklistItemsClientBatchAddResponse{
+							RaiBlocklist: armcognitiveservices.RaiBlocklist{
+								Name: to.Ptr("myblocklist"),
+								Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
+								Properties: &armcognitiveservices.RaiBlocklistProperties{
+									Description: to.Ptr("Brief description of the blocklist"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -42,28 +63,29 @@ func ExampleRaiBlocklistItemsClient_BatchAdd() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiBlocklistItemsClientBatchAddResponse{
-	// 	RaiBlocklist: armcognitiveservices.RaiBlocklist{
-	// 		Name: to.Ptr("myblocklist"),
-	// 		Etag: to.Ptr("\"00000000-0000-0000-0000-000000000000\""),
-	// 		Properties: &armcognitiveservices.RaiBlocklistProperties{
-	// 			Description: to.Ptr("Brief description of the blocklist"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Etag:", *res.Etag)
+	// Output:
+	// Name: myblocklist
+	// Etag: "00000000-0000-0000-0000-000000000000"
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteRaiBlocklistItems.json
 func ExampleRaiBlocklistItemsClient_BatchDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiBlocklistItemsServer: fake.RaiBlocklistItemsServer{
+					BatchDelete: func(ctx context.Context, resourceGroupName string, accountName string, raiBlocklistName string, raiBlocklistItemsNames []*string, options *armcognitiveservices.RaiBlocklistItemsClientBatchDeleteOptions) (resp azfake.Responder[armcognitiveservices.RaiBlocklistItemsClientBatchDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusNoContent, armcognitiveservices.RaiBlocklistItemsClientBatchDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -74,16 +96,34 @@ func ExampleRaiBlocklistItemsClient_BatchDelete() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/PutRaiBlocklistItem.json
 func ExampleRaiBlocklistItemsClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiBlocklistItemsServer: fake.RaiBlocklistItemsServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, raiBlocklistName string, raiBlocklistItemName string, raiBlocklistItem armcognitiveservices.RaiBlocklistItem, options *armcognitiveservices.RaiBlocklistItemsClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.RaiBlocklistItemsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiBlocklistItemsClientCreateOrUpdateResponse{
+							RaiBlocklistItem: armcognitiveservices.RaiBlocklistItem{
+								Name: to.Ptr("raiBlocklistItemName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName"),
+								Properties: &armcognitiveservices.RaiBlocklistItemProperties{
+									IsRegex: to.Ptr(false),
+									Pattern: to.Ptr("Pattern To Block"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -96,29 +136,29 @@ func ExampleRaiBlocklistItemsClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiBlocklistItemsClientCreateOrUpdateResponse{
-	// 	RaiBlocklistItem: armcognitiveservices.RaiBlocklistItem{
-	// 		Name: to.Ptr("raiBlocklistItemName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName"),
-	// 		Properties: &armcognitiveservices.RaiBlocklistItemProperties{
-	// 			IsRegex: to.Ptr(false),
-	// 			Pattern: to.Ptr("Pattern To Block"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: raiBlocklistItemName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteRaiBlocklistItem.json
 func ExampleRaiBlocklistItemsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiBlocklistItemsServer: fake.RaiBlocklistItemsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, raiBlocklistName string, raiBlocklistItemName string, options *armcognitiveservices.RaiBlocklistItemsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.RaiBlocklistItemsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.RaiBlocklistItemsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -130,16 +170,34 @@ func ExampleRaiBlocklistItemsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetRaiBlocklistItem.json
 func ExampleRaiBlocklistItemsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiBlocklistItemsServer: fake.RaiBlocklistItemsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, raiBlocklistName string, raiBlocklistItemName string, options *armcognitiveservices.RaiBlocklistItemsClientGetOptions) (resp azfake.Responder[armcognitiveservices.RaiBlocklistItemsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiBlocklistItemsClientGetResponse{
+							RaiBlocklistItem: armcognitiveservices.RaiBlocklistItem{
+								Name: to.Ptr("raiBlocklistItemName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName"),
+								Properties: &armcognitiveservices.RaiBlocklistItemProperties{
+									IsRegex: to.Ptr(false),
+									Pattern: to.Ptr("Pattern To Block"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -147,29 +205,50 @@ func ExampleRaiBlocklistItemsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiBlocklistItemsClientGetResponse{
-	// 	RaiBlocklistItem: armcognitiveservices.RaiBlocklistItem{
-	// 		Name: to.Ptr("raiBlocklistItemName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName"),
-	// 		Properties: &armcognitiveservices.RaiBlocklistItemProperties{
-	// 			IsRegex: to.Ptr(false),
-	// 			Pattern: to.Ptr("Pattern To Block"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: raiBlocklistItemName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName
 }
 
 // Generated from example definition: 2025-10-01-preview/ListBlocklistItems.json
 func ExampleRaiBlocklistItemsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiBlocklistItemsServer: fake.RaiBlocklistItemsServer{
+					NewListPager: func(resourceGroupName string, accountName string, raiBlocklistName string, options *armcognitiveservices.RaiBlocklistItemsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.RaiBlocklistItemsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.RaiBlocklistItemsClientListResponse{
+							RaiBlockListItemsResult: armcognitiveservices.RaiBlockListItemsResult{
+								Value: []*armcognitiveservices.RaiBlocklistItem{
+									{
+										Name: to.Ptr("raiBlocklistItemName"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName"),
+										Properties: &armcognitiveservices.RaiBlocklistItemProperties{
+											IsRegex: to.Ptr(false),
+											Pattern: to.Ptr("Pattern To Block"),
+										},
+									},
+									{
+										Name: to.Ptr("raiBlocklistItemName2"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName2"),
+										Properties: &armcognitiveservices.RaiBlocklistItemProperties{
+											IsRegex: to.Ptr(false),
+											Pattern: to.Ptr("Another Pattern To Block"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -180,31 +259,13 @@ func ExampleRaiBlocklistItemsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.RaiBlocklistItemsClientListResponse{
-		// 	RaiBlockListItemsResult: armcognitiveservices.RaiBlockListItemsResult{
-		// 		Value: []*armcognitiveservices.RaiBlocklistItem{
-		// 			{
-		// 				Name: to.Ptr("raiBlocklistItemName"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName"),
-		// 				Properties: &armcognitiveservices.RaiBlocklistItemProperties{
-		// 					IsRegex: to.Ptr(false),
-		// 					Pattern: to.Ptr("Pattern To Block"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("raiBlocklistItemName2"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName2"),
-		// 				Properties: &armcognitiveservices.RaiBlocklistItemProperties{
-		// 					IsRegex: to.Ptr(false),
-		// 					Pattern: to.Ptr("Another Pattern To Block"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: raiBlocklistItemName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName
+	// Name: raiBlocklistItemName2
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiBlocklists/raiBlocklistName/raiBlocklistItems/raiBlocklistItemName2
 }
@@ -6,19 +6,44 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/GetRaiContentFilter.json
 func ExampleRaiContentFiltersClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiContentFiltersServer: fake.RaiContentFiltersServer{
+					Get: func(ctx context.Context, location string, filterName string, options *armcognitiveservices.RaiContentFiltersClientGetOptions) (resp azfake.Responder[armcognitiveservices.RaiContentFiltersClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiContentFiltersClientGetResponse{
+							RaiContentFilter: armcognitiveservices.RaiContentFilter{
+								Name: to.Ptr("IndirectAttack"),
+								Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/IndirectAttack"),
+								Properties: &armcognitiveservices.RaiContentFilterProperties{
+									Name:               to.Ptr("Indirect Attack"),
+									IsMultiLevelFilter: to.Ptr(false),
+									Source:             to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -26,31 +51,121 @@ func ExampleRaiContentFiltersClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiContentFiltersClientGetResponse{
-	// 	RaiContentFilter: armcognitiveservices.RaiContentFilter{
-	// 		Name: to.Ptr("IndirectAttack"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/IndirectAttack"),
-	// 		Properties: &armcognitiveservices.RaiContentFilterProperties{
-	// 			Name: to.Ptr("Indirect Attack"),
-	// 			IsMultiLevelFilter: to.Ptr(false),
-	// 			Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: IndirectAttack
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/IndirectAttack
 }
 
 // Generated from example definition: 2025-10-01-preview/ListRaiContentFilters.json
 func ExampleRaiContentFiltersClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiContentFiltersServer: fake.RaiContentFiltersServer{
+					NewListPager: func(location string, options *armcognitiveservices.RaiContentFiltersClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.RaiContentFiltersClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.RaiContentFiltersClientListResponse{
+							RaiContentFilterListResult: armcognitiveservices.RaiContentFilterListResult{
+								Value: []*armcognitiveservices.RaiContentFilter{
+									{
+										Name: to.Ptr("Hate"),
+										Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Hate"),
+										Properties: &armcognitiveservices.RaiContentFilterProperties{
+											Name:               to.Ptr("Hate"),
+											IsMultiLevelFilter: to.Ptr(true),
+										},
+									},
+									{
+										Name: to.Ptr("Sexual"),
+										Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Sexual"),
+										Properties: &armcognitiveservices.RaiContentFilterProperties{
+											Name:               to.Ptr("Sexual"),
+											IsMultiLevelFilter: to.Ptr(true),
+										},
+									},
+									{
+										Name: to.Ptr("Violence"),
+										Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Violence"),
+										Properties: &armcognitiveservices.RaiContentFilterProperties{
+											Name:               to.Ptr("Violence"),
+											IsMultiLevelFilter: to.Ptr(true),
+										},
+									},
+									{
+										Name: to.Ptr("Selfharm"),
+										Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Selfharm"),
+										Properties: &armcognitiveservices.RaiContentFilterProperties{
+											Name:               to.Ptr("Selfharm"),
+											IsMultiLevelFilter: to.Ptr(true),
+										},
+									},
+									{
+										Name: to.Ptr("Jailbreak"),
+										Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Jailbreak"),
+										Properties: &armcognitiveservices.RaiContentFilterProperties{
+											Name:               to.Ptr("Jailbreak"),
+											IsMultiLevelFilter: to.Ptr(false),
+											Source:             to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+									},
+									{
+										Name: to.Ptr("ProtectedMaterialText"),
+										Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/ProtectedMaterialText"),
+										Properties: &armcognitiveservices.RaiContentFilterProperties{
+											Name:               to.Ptr("Protected Material Text"),
+											IsMultiLevelFilter: to.Ptr(false),
+											Source:             to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+									},
+									{
+										Name: to.Ptr("ProtectedMaterialCode"),
+										Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/ProtectedMaterialCode"),
+										Properties: &armcognitiveservices.RaiContentFilterProperties{
+											Name:               to.Ptr("Protected Material Code"),
+											IsMultiLevelFilter: to.Ptr(false),
+											Source:             to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+									},
+									{
+										Name: to.Ptr("Profanity"),
+										Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Profanity"),
+										Properties: &armcognitiveservices.RaiContentFilterProperties{
+											Name:               to.Ptr("Profanity"),
+											IsMultiLevelFilter: to.Ptr(false),
+										},
+									},
+									{
+										Name: to.Ptr("IndirectAttack"),
+										Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/IndirectAttack"),
+										Properties: &armcognitiveservices.RaiContentFilterProperties{
+											Name:               to.Ptr("Indirect Attack"),
+											IsMultiLevelFilter: to.Ptr(false),
+											Source:             to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -61,100 +176,37 @@ func ExampleRaiContentFiltersClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.RaiContentFiltersClientListResponse{
-		// 	RaiContentFilterListResult: armcognitiveservices.RaiContentFilterListResult{
-		// 		Value: []*armcognitiveservices.RaiContentFilter{
-		// 			{
-		// 				Name: to.Ptr("Hate"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Hate"),
-		// 				Properties: &armcognitiveservices.RaiContentFilterProperties{
-		// 					Name: to.Ptr("Hate"),
-		// 					IsMultiLevelFilter: to.Ptr(true),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Sexual"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Sexual"),
-		// 				Properties: &armcognitiveservices.RaiContentFilterProperties{
-		// 					Name: to.Ptr("Sexual"),
-		// 					IsMultiLevelFilter: to.Ptr(true),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Violence"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Violence"),
-		// 				Properties: &armcognitiveservices.RaiContentFilterProperties{
-		// 					Name: to.Ptr("Violence"),
-		// 					IsMultiLevelFilter: to.Ptr(true),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Selfharm"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Selfharm"),
-		// 				Properties: &armcognitiveservices.RaiContentFilterProperties{
-		// 					Name: to.Ptr("Selfharm"),
-		// 					IsMultiLevelFilter: to.Ptr(true),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Jailbreak"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Jailbreak"),
-		// 				Properties: &armcognitiveservices.RaiContentFilterProperties{
-		// 					Name: to.Ptr("Jailbreak"),
-		// 					IsMultiLevelFilter: to.Ptr(false),
-		// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("ProtectedMaterialText"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/ProtectedMaterialText"),
-		// 				Properties: &armcognitiveservices.RaiContentFilterProperties{
-		// 					Name: to.Ptr("Protected Material Text"),
-		// 					IsMultiLevelFilter: to.Ptr(false),
-		// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("ProtectedMaterialCode"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/ProtectedMaterialCode"),
-		// 				Properties: &armcognitiveservices.RaiContentFilterProperties{
-		// 					Name: to.Ptr("Protected Material Code"),
-		// 					IsMultiLevelFilter: to.Ptr(false),
-		// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Profanity"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Profanity"),
-		// 				Properties: &armcognitiveservices.RaiContentFilterProperties{
-		// 					Name: to.Ptr("Profanity"),
-		// 					IsMultiLevelFilter: to.Ptr(false),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("IndirectAttack"),
-		// 				Type: to.Ptr("Microsoft.CognitiveServices/locations/raiContentFilters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/IndirectAttack"),
-		// 				Properties: &armcognitiveservices.RaiContentFilterProperties{
-		// 					Name: to.Ptr("Indirect Attack"),
-		// 					IsMultiLevelFilter: to.Ptr(false),
-		// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Hate
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Hate
+	// Name: Sexual
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Sexual
+	// Name: Violence
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Violence
+	// Name: Selfharm
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Selfharm
+	// Name: Jailbreak
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Jailbreak
+	// Name: ProtectedMaterialText
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/ProtectedMaterialText
+	// Name: ProtectedMaterialCode
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/ProtectedMaterialCode
+	// Name: Profanity
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/Profanity
+	// Name: IndirectAttack
+	// Type: Microsoft.CognitiveServices/locations/raiContentFilters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/locations/WestUS/raiContentFilters/IndirectAttack
 }
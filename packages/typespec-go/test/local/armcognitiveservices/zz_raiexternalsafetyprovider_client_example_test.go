@@ -6,20 +6,50 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutRaiExternalSafetyProvider.json
 func ExampleRaiExternalSafetyProviderClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiExternalSafetyProviderServer: fake.RaiExternalSafetyProviderServer{
+					CreateOrUpdate: func(ctx context.Context, safetyProviderName string, safetyProvider armcognitiveservices.RaiExternalSafetyProviderSchema, options *armcognitiveservices.RaiExternalSafetyProviderClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.RaiExternalSafetyProviderClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiExternalSafetyProviderClientCreateOrUpdateResponse{
+							Value: armcognitiveservices.RaiExternalSafetyProviderSchema{
+								Name: to.Ptr("safetyProviderName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/safetyProviderName"),
+								Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
+									CreatedAt:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t }()),
+									KeyVaultURI:     to.Ptr("https://example.vault.azure.net"),
+									LastModifiedAt:  to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t }()),
+									ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
+									Mode:            to.Ptr("sync"),
+									ProviderID:      to.Ptr("00000000-0000-0000-0000-000000000000"),
+									ProviderName:    to.Ptr("safetyProviderName"),
+									SecretName:      to.Ptr("mySecretName"),
+									URL:             to.Ptr("https://example.webhook.endpoint"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -39,34 +69,25 @@ func ExampleRaiExternalSafetyProviderClient_CreateOrUpdate() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiExternalSafetyProviderClientCreateOrUpdateResponse{
-	// 	Value: armcognitiveservices.RaiExternalSafetyProviderSchema{
-	// 		Name: to.Ptr("safetyProviderName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/safetyProviderName"),
-	// 		Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t}()),
-	// 			KeyVaultURI: to.Ptr("https://example.vault.azure.net"),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t}()),
-	// 			ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			Mode: to.Ptr("sync"),
-	// 			ProviderID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			ProviderName: to.Ptr("safetyProviderName"),
-	// 			SecretName: to.Ptr("mySecretName"),
-	// 			URL: to.Ptr("https://example.webhook.endpoint"),
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteRaiExternalSafetyProvider.json
 func ExampleRaiExternalSafetyProviderClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiExternalSafetyProviderServer: fake.RaiExternalSafetyProviderServer{
+					BeginDelete: func(ctx context.Context, safetyProviderName string, options *armcognitiveservices.RaiExternalSafetyProviderClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.RaiExternalSafetyProviderClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.RaiExternalSafetyProviderClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -78,16 +99,41 @@ func ExampleRaiExternalSafetyProviderClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetRaiExternalSafetyProvider.json
 func ExampleRaiExternalSafetyProviderClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiExternalSafetyProviderServer: fake.RaiExternalSafetyProviderServer{
+					Get: func(ctx context.Context, safetyProviderName string, options *armcognitiveservices.RaiExternalSafetyProviderClientGetOptions) (resp azfake.Responder[armcognitiveservices.RaiExternalSafetyProviderClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiExternalSafetyProviderClientGetResponse{
+							RaiExternalSafetyProviderSchema: armcognitiveservices.RaiExternalSafetyProviderSchema{
+								Name: to.Ptr("safetyProviderName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/safetyProviderName"),
+								Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
+									CreatedAt:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t }()),
+									KeyVaultURI:     to.Ptr("https://example.vault.azure.net"),
+									LastModifiedAt:  to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t }()),
+									ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
+									Mode:            to.Ptr("sync"),
+									ProviderID:      to.Ptr("00000000-0000-0000-0000-000000000000"),
+									ProviderName:    to.Ptr("safetyProviderName"),
+									SecretName:      to.Ptr("mySecretName"),
+									URL:             to.Ptr("https://example.webhook.endpoint"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -95,24 +141,9 @@ func ExampleRaiExternalSafetyProviderClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiExternalSafetyProviderClientGetResponse{
-	// 	RaiExternalSafetyProviderSchema: armcognitiveservices.RaiExternalSafetyProviderSchema{
-	// 		Name: to.Ptr("safetyProviderName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/safetyProviderName"),
-	// 		Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t}()),
-	// 			KeyVaultURI: to.Ptr("https://example.vault.azure.net"),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t}()),
-	// 			ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			Mode: to.Ptr("sync"),
-	// 			ProviderID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			ProviderName: to.Ptr("safetyProviderName"),
-	// 			SecretName: to.Ptr("mySecretName"),
-	// 			URL: to.Ptr("https://example.webhook.endpoint"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: safetyProviderName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/safetyProviderName
 }
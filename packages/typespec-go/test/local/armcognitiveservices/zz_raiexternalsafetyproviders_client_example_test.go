@@ -6,19 +6,84 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/ListRaiExternalSafetyProviders.json
 func ExampleRaiExternalSafetyProvidersClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiExternalSafetyProvidersServer: fake.RaiExternalSafetyProvidersServer{
+					NewListPager: func(options *armcognitiveservices.RaiExternalSafetyProvidersClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.RaiExternalSafetyProvidersClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.RaiExternalSafetyProvidersClientListResponse{
+							RaiExternalSafetyProviderResult: armcognitiveservices.RaiExternalSafetyProviderResult{
+								Value: []*armcognitiveservices.RaiExternalSafetyProviderSchema{
+									{
+										Name: to.Ptr("purview"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/purview"),
+										Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
+											CreatedAt:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t }()),
+											KeyVaultURI:     to.Ptr("https://example.vault.azure.net"),
+											LastModifiedAt:  to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t }()),
+											ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
+											Mode:            to.Ptr("sync"),
+											ProviderID:      to.Ptr("00000000-0000-0000-0000-000000000000"),
+											ProviderName:    to.Ptr("purview"),
+											SecretName:      to.Ptr("mySecretName"),
+											URL:             to.Ptr("https://example.webhook.endpoint"),
+										},
+									},
+									{
+										Name: to.Ptr("microsoftDefenderForCloud"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/microsoftDefenderForCloud"),
+										Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
+											CreatedAt:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t }()),
+											KeyVaultURI:     to.Ptr("https://example.vault.azure.net"),
+											LastModifiedAt:  to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t }()),
+											ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
+											Mode:            to.Ptr("sync"),
+											ProviderID:      to.Ptr("00000000-0000-0000-0000-000000000000"),
+											ProviderName:    to.Ptr("microsoftDefenderForCloud"),
+											SecretName:      to.Ptr("mySecretName"),
+											URL:             to.Ptr("https://example.webhook.endpoint"),
+										},
+									},
+									{
+										Name: to.Ptr("sampleSafetyProvider"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/sampleSafetyProvider"),
+										Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
+											CreatedAt:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t }()),
+											KeyVaultURI:     to.Ptr("https://example.vault.azure.net"),
+											LastModifiedAt:  to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t }()),
+											ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
+											Mode:            to.Ptr("sync"),
+											ProviderID:      to.Ptr("00000000-0000-0000-0000-000000000000"),
+											ProviderName:    to.Ptr("sampleSafetyProvider"),
+											SecretName:      to.Ptr("mySecretName"),
+											URL:             to.Ptr("https://example.webhook.endpoint"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,60 +94,15 @@ func ExampleRaiExternalSafetyProvidersClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.RaiExternalSafetyProvidersClientListResponse{
-		// 	RaiExternalSafetyProviderResult: armcognitiveservices.RaiExternalSafetyProviderResult{
-		// 		Value: []*armcognitiveservices.RaiExternalSafetyProviderSchema{
-		// 			{
-		// 				Name: to.Ptr("purview"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/purview"),
-		// 				Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t}()),
-		// 					KeyVaultURI: to.Ptr("https://example.vault.azure.net"),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t}()),
-		// 					ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					Mode: to.Ptr("sync"),
-		// 					ProviderID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					ProviderName: to.Ptr("purview"),
-		// 					SecretName: to.Ptr("mySecretName"),
-		// 					URL: to.Ptr("https://example.webhook.endpoint"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("microsoftDefenderForCloud"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/microsoftDefenderForCloud"),
-		// 				Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t}()),
-		// 					KeyVaultURI: to.Ptr("https://example.vault.azure.net"),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t}()),
-		// 					ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					Mode: to.Ptr("sync"),
-		// 					ProviderID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					ProviderName: to.Ptr("microsoftDefenderForCloud"),
-		// 					SecretName: to.Ptr("mySecretName"),
-		// 					URL: to.Ptr("https://example.webhook.endpoint"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("sampleSafetyProvider"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/sampleSafetyProvider"),
-		// 				Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t}()),
-		// 					KeyVaultURI: to.Ptr("https://example.vault.azure.net"),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t}()),
-		// 					ManagedIdentity: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					Mode: to.Ptr("sync"),
-		// 					ProviderID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					ProviderName: to.Ptr("sampleSafetyProvider"),
-		// 					SecretName: to.Ptr("mySecretName"),
-		// 					URL: to.Ptr("https://example.webhook.endpoint"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: purview
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/purview
+	// Name: microsoftDefenderForCloud
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/microsoftDefenderForCloud
+	// Name: sampleSafetyProvider
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/sampleSafetyProvider
 }
@@ -6,20 +6,124 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutRaiPolicy.json
 func ExampleRaiPoliciesClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiPoliciesServer: fake.RaiPoliciesServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, raiPolicyName string, raiPolicy armcognitiveservices.RaiPolicy, options *armcognitiveservices.RaiPoliciesClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.RaiPoliciesClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiPoliciesClientCreateOrUpdateResponse{
+							RaiPolicy: armcognitiveservices.RaiPolicy{
+								Name: to.Ptr("raiPolicyName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
+								Properties: &armcognitiveservices.RaiPolicyProperties{
+									BasePolicyName: to.Ptr("Microsoft.Default"),
+									ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
+										{
+											Name:              to.Ptr("Hate"),
+											Blocking:          to.Ptr(false),
+											Enabled:           to.Ptr(false),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Hate"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Sexual"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Sexual"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Selfharm"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Selfharm"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Violence"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Violence"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Jailbreak"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:     to.Ptr("Protected Material Text"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Protected Material Code"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Profanity"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+									},
+									Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -114,111 +218,29 @@ func ExampleRaiPoliciesClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiPoliciesClientCreateOrUpdateResponse{
-	// 	RaiPolicy: armcognitiveservices.RaiPolicy{
-	// 		Name: to.Ptr("raiPolicyName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
-	// 		Properties: &armcognitiveservices.RaiPolicyProperties{
-	// 			BasePolicyName: to.Ptr("Microsoft.Default"),
-	// 			ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
-	// 				{
-	// 					Name: to.Ptr("Hate"),
-	// 					Blocking: to.Ptr(false),
-	// 					Enabled: to.Ptr(false),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Hate"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Sexual"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Sexual"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Selfharm"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Selfharm"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Violence"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Violence"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Jailbreak"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Protected Material Text"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Protected Material Code"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Profanity"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 			},
-	// 			Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: raiPolicyName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteRaiPolicy.json
 func ExampleRaiPoliciesClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiPoliciesServer: fake.RaiPoliciesServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, raiPolicyName string, options *armcognitiveservices.RaiPoliciesClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.RaiPoliciesClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.RaiPoliciesClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -230,16 +252,116 @@ func ExampleRaiPoliciesClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetRaiPolicy.json
 func ExampleRaiPoliciesClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiPoliciesServer: fake.RaiPoliciesServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, raiPolicyName string, options *armcognitiveservices.RaiPoliciesClientGetOptions) (resp azfake.Responder[armcognitiveservices.RaiPoliciesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiPoliciesClientGetResponse{
+							RaiPolicy: armcognitiveservices.RaiPolicy{
+								Name: to.Ptr("raiPolicyName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
+								Properties: &armcognitiveservices.RaiPolicyProperties{
+									BasePolicyName: to.Ptr("Microsoft.Default"),
+									ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
+										{
+											Name:              to.Ptr("Hate"),
+											Blocking:          to.Ptr(false),
+											Enabled:           to.Ptr(false),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Hate"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Sexual"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Sexual"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Selfharm"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Selfharm"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Violence"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Violence"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Jailbreak"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:     to.Ptr("Protected Material Text"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Protected Material Code"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Profanity"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+									},
+									Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -247,111 +369,124 @@ func ExampleRaiPoliciesClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiPoliciesClientGetResponse{
-	// 	RaiPolicy: armcognitiveservices.RaiPolicy{
-	// 		Name: to.Ptr("raiPolicyName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
-	// 		Properties: &armcognitiveservices.RaiPolicyProperties{
-	// 			BasePolicyName: to.Ptr("Microsoft.Default"),
-	// 			ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
-	// 				{
-	// 					Name: to.Ptr("Hate"),
-	// 					Blocking: to.Ptr(false),
-	// 					Enabled: to.Ptr(false),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Hate"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Sexual"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Sexual"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Selfharm"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Selfharm"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Violence"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Violence"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Jailbreak"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Protected Material Text"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Protected Material Code"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Profanity"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 			},
-	// 			Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: raiPolicyName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName
 }
 
 // Generated from example definition: 2025-10-01-preview/ListRaiPolicies.json
 func ExampleRaiPoliciesClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiPoliciesServer: fake.RaiPoliciesServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.RaiPoliciesClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.RaiPoliciesClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.RaiPoliciesClientListResponse{
+							RaiPolicyListResult: armcognitiveservices.RaiPolicyListResult{
+								Value: []*armcognitiveservices.RaiPolicy{
+									{
+										Name: to.Ptr("raiPolicyName"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
+										Properties: &armcognitiveservices.RaiPolicyProperties{
+											BasePolicyName: to.Ptr("Microsoft.Default"),
+											ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
+												{
+													Name:              to.Ptr("Hate"),
+													Blocking:          to.Ptr(false),
+													Enabled:           to.Ptr(false),
+													SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+													Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+												},
+												{
+													Name:              to.Ptr("Hate"),
+													Blocking:          to.Ptr(true),
+													Enabled:           to.Ptr(true),
+													SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+													Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+												},
+												{
+													Name:              to.Ptr("Sexual"),
+													Blocking:          to.Ptr(true),
+													Enabled:           to.Ptr(true),
+													SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+													Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+												},
+												{
+													Name:              to.Ptr("Sexual"),
+													Blocking:          to.Ptr(true),
+													Enabled:           to.Ptr(true),
+													SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+													Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+												},
+												{
+													Name:              to.Ptr("Selfharm"),
+													Blocking:          to.Ptr(true),
+													Enabled:           to.Ptr(true),
+													SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+													Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+												},
+												{
+													Name:              to.Ptr("Selfharm"),
+													Blocking:          to.Ptr(true),
+													Enabled:           to.Ptr(true),
+													SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+													Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+												},
+												{
+													Name:              to.Ptr("Violence"),
+													Blocking:          to.Ptr(true),
+													Enabled:           to.Ptr(true),
+													SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+													Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+												},
+												{
+													Name:              to.Ptr("Violence"),
+													Blocking:          to.Ptr(true),
+													Enabled:           to.Ptr(true),
+													SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+													Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+												},
+												{
+													Name:     to.Ptr("Jailbreak"),
+													Blocking: to.Ptr(true),
+													Enabled:  to.Ptr(true),
+													Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+												},
+												{
+													Name:     to.Ptr("Protected Material Text"),
+													Blocking: to.Ptr(true),
+													Enabled:  to.Ptr(true),
+													Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+												},
+												{
+													Name:     to.Ptr("Protected Material Code"),
+													Blocking: to.Ptr(true),
+													Enabled:  to.Ptr(true),
+													Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+												},
+												{
+													Name:     to.Ptr("Profanity"),
+													Blocking: to.Ptr(true),
+													Enabled:  to.Ptr(true),
+													Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+												},
+											},
+											Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -362,105 +497,11 @@ func ExampleRaiPoliciesClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.RaiPoliciesClientListResponse{
-		// 	RaiPolicyListResult: armcognitiveservices.RaiPolicyListResult{
-		// 		Value: []*armcognitiveservices.RaiPolicy{
-		// 			{
-		// 				Name: to.Ptr("raiPolicyName"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
-		// 				Properties: &armcognitiveservices.RaiPolicyProperties{
-		// 					BasePolicyName: to.Ptr("Microsoft.Default"),
-		// 					ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
-		// 						{
-		// 							Name: to.Ptr("Hate"),
-		// 							Blocking: to.Ptr(false),
-		// 							Enabled: to.Ptr(false),
-		// 							SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Hate"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Sexual"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Sexual"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Selfharm"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Selfharm"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Violence"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Violence"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Jailbreak"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Protected Material Text"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Protected Material Code"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-		// 						},
-		// 						{
-		// 							Name: to.Ptr("Profanity"),
-		// 							Blocking: to.Ptr(true),
-		// 							Enabled: to.Ptr(true),
-		// 							Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-		// 						},
-		// 					},
-		// 					Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: raiPolicyName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName
 }
@@ -6,20 +6,61 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutRaiToolLabel.json
 func ExampleRaiToolLabelsClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiToolLabelsServer: fake.RaiToolLabelsServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, raiToolConnectionName string, raiToolLabel armcognitiveservices.RaiToolLabel, options *armcognitiveservices.RaiToolLabelsClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.RaiToolLabelsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiToolLabelsClientCreateOrUpdateResponse{
+							RaiToolLabel: armcognitiveservices.RaiToolLabel{
+								Name: to.Ptr("Web_Search"),
+								Type: to.Ptr("Microsoft.CognitiveServices/accounts/raiToolLabels"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiToolLabels/Web_Search"),
+								Properties: &armcognitiveservices.RaiToolLabelProperties{
+									AccountScope: &armcognitiveservices.RaiToolLabelPropertiesAccountScope{
+										LabelValues: map[string]*string{
+											"confidentiality": to.Ptr("low"),
+										},
+									},
+									ProjectScopes: []*armcognitiveservices.RaiToolLabelPropertiesProjectScopesItem{
+										{
+											LabelValues: map[string]*string{
+												"confidentiality": to.Ptr("low"),
+											},
+											Project: to.Ptr("test-project"),
+										},
+										{
+											LabelValues: map[string]*string{
+												"confidentiality": to.Ptr("low"),
+											},
+											Project: to.Ptr("sample-project"),
+										},
+									},
+									ToolConnectionName: to.Ptr("Web_Search"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -50,48 +91,31 @@ func ExampleRaiToolLabelsClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiToolLabelsClientCreateOrUpdateResponse{
-	// 	RaiToolLabel: armcognitiveservices.RaiToolLabel{
-	// 		Name: to.Ptr("Web_Search"),
-	// 		Type: to.Ptr("Microsoft.CognitiveServices/accounts/raiToolLabels"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiToolLabels/Web_Search"),
-	// 		Properties: &armcognitiveservices.RaiToolLabelProperties{
-	// 			AccountScope: &armcognitiveservices.RaiToolLabelPropertiesAccountScope{
-	// 				LabelValues: map[string]*string{
-	// 					"confidentiality": to.Ptr("low"),
-	// 				},
-	// 			},
-	// 			ProjectScopes: []*armcognitiveservices.RaiToolLabelPropertiesProjectScopesItem{
-	// 				{
-	// 					LabelValues: map[string]*string{
-	// 						"confidentiality": to.Ptr("low"),
-	// 					},
-	// 					Project: to.Ptr("test-project"),
-	// 				},
-	// 				{
-	// 					LabelValues: map[string]*string{
-	// 						"confidentiality": to.Ptr("low"),
-	// 					},
-	// 					Project: to.Ptr("sample-project"),
-	// 				},
-	// 			},
-	// 			ToolConnectionName: to.Ptr("Web_Search"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: Web_Search
+	// Type: Microsoft.CognitiveServices/accounts/raiToolLabels
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiToolLabels/Web_Search
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteRaiToolLabel.json
 func ExampleRaiToolLabelsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiToolLabelsServer: fake.RaiToolLabelsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, raiToolConnectionName string, options *armcognitiveservices.RaiToolLabelsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.RaiToolLabelsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.RaiToolLabelsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -103,16 +127,55 @@ func ExampleRaiToolLabelsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetRaiToolLabel.json
 func ExampleRaiToolLabelsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiToolLabelsServer: fake.RaiToolLabelsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, raiToolConnectionName string, options *armcognitiveservices.RaiToolLabelsClientGetOptions) (resp azfake.Responder[armcognitiveservices.RaiToolLabelsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiToolLabelsClientGetResponse{
+							RaiToolLabel: armcognitiveservices.RaiToolLabel{
+								Name: to.Ptr("raiToolLabelName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiIfcToolLabels/raiIfcToolLabelName"),
+								Properties: &armcognitiveservices.RaiToolLabelProperties{
+									AccountScope: &armcognitiveservices.RaiToolLabelPropertiesAccountScope{
+										LabelValues: map[string]*string{
+											"confidentiality": to.Ptr("low"),
+											"dataDirection":   to.Ptr("egress"),
+										},
+									},
+									ProjectScopes: []*armcognitiveservices.RaiToolLabelPropertiesProjectScopesItem{
+										{
+											LabelValues: map[string]*string{
+												"confidentiality": to.Ptr("low"),
+												"dataDirection":   to.Ptr("egress"),
+											},
+											Project: to.Ptr("ProjectA"),
+										},
+										{
+											LabelValues: map[string]*string{
+												"confidentiality": to.Ptr("high"),
+												"dataDirection":   to.Ptr("egress"),
+											},
+											Project: to.Ptr("ProjectB"),
+										},
+									},
+									ToolConnectionName: to.Ptr("SampleToolLabel"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -120,50 +183,60 @@ func ExampleRaiToolLabelsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiToolLabelsClientGetResponse{
-	// 	RaiToolLabel: armcognitiveservices.RaiToolLabel{
-	// 		Name: to.Ptr("raiToolLabelName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiIfcToolLabels/raiIfcToolLabelName"),
-	// 		Properties: &armcognitiveservices.RaiToolLabelProperties{
-	// 			AccountScope: &armcognitiveservices.RaiToolLabelPropertiesAccountScope{
-	// 				LabelValues: map[string]*string{
-	// 					"confidentiality": to.Ptr("low"),
-	// 					"dataDirection": to.Ptr("egress"),
-	// 				},
-	// 			},
-	// 			ProjectScopes: []*armcognitiveservices.RaiToolLabelPropertiesProjectScopesItem{
-	// 				{
-	// 					LabelValues: map[string]*string{
-	// 						"confidentiality": to.Ptr("low"),
-	// 						"dataDirection": to.Ptr("egress"),
-	// 					},
-	// 					Project: to.Ptr("ProjectA"),
-	// 				},
-	// 				{
-	// 					LabelValues: map[string]*string{
-	// 						"confidentiality": to.Ptr("high"),
-	// 						"dataDirection": to.Ptr("egress"),
-	// 					},
-	// 					Project: to.Ptr("ProjectB"),
-	// 				},
-	// 			},
-	// 			ToolConnectionName: to.Ptr("SampleToolLabel"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: raiToolLabelName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiIfcToolLabels/raiIfcToolLabelName
 }
 
 // Generated from example definition: 2025-10-01-preview/ListRaiToolLabels.json
 func ExampleRaiToolLabelsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiToolLabelsServer: fake.RaiToolLabelsServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.RaiToolLabelsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.RaiToolLabelsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.RaiToolLabelsClientListResponse{
+							RaiToolLabelResult: armcognitiveservices.RaiToolLabelResult{
+								Value: []*armcognitiveservices.RaiToolLabel{
+									{
+										Name: to.Ptr("raiIfcToolLabelName"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiIfcToolLabels/raiIfcToolLabelName"),
+										Properties: &armcognitiveservices.RaiToolLabelProperties{
+											AccountScope: &armcognitiveservices.RaiToolLabelPropertiesAccountScope{
+												LabelValues: map[string]*string{
+													"confidentiality": to.Ptr("low"),
+												},
+											},
+											ProjectScopes: []*armcognitiveservices.RaiToolLabelPropertiesProjectScopesItem{
+												{
+													LabelValues: map[string]*string{
+														"confidentiality": to.Ptr("low"),
+													},
+													Project: to.Ptr("hualiang-project"),
+												},
+												{
+													LabelValues: map[string]*string{
+														"confidentiality": to.Ptr("low"),
+													},
+													Project: to.Ptr("sahana-project"),
+												},
+											},
+											ToolConnectionName: to.Ptr("Web_Search"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -174,41 +247,11 @@ func ExampleRaiToolLabelsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.RaiToolLabelsClientListResponse{
-		// 	RaiToolLabelResult: armcognitiveservices.RaiToolLabelResult{
-		// 		Value: []*armcognitiveservices.RaiToolLabel{
-		// 			{
-		// 				Name: to.Ptr("raiIfcToolLabelName"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiIfcToolLabels/raiIfcToolLabelName"),
-		// 				Properties: &armcognitiveservices.RaiToolLabelProperties{
-		// 					AccountScope: &armcognitiveservices.RaiToolLabelPropertiesAccountScope{
-		// 						LabelValues: map[string]*string{
-		// 							"confidentiality": to.Ptr("low"),
-		// 						},
-		// 					},
-		// 					ProjectScopes: []*armcognitiveservices.RaiToolLabelPropertiesProjectScopesItem{
-		// 						{
-		// 							LabelValues: map[string]*string{
-		// 								"confidentiality": to.Ptr("low"),
-		// 							},
-		// 							Project: to.Ptr("hualiang-project"),
-		// 						},
-		// 						{
-		// 							LabelValues: map[string]*string{
-		// 								"confidentiality": to.Ptr("low"),
-		// 							},
-		// 							Project: to.Ptr("sahana-project"),
-		// 						},
-		// 					},
-		// 					ToolConnectionName: to.Ptr("Web_Search"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: raiIfcToolLabelName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiIfcToolLabels/raiIfcToolLabelName
 }
@@ -6,20 +6,48 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutRaiTopic.json
 func ExampleRaiTopicsClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiTopicsServer: fake.RaiTopicsServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, raiTopicName string, raiTopic armcognitiveservices.RaiTopic, options *armcognitiveservices.RaiTopicsClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.RaiTopicsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiTopicsClientCreateOrUpdateResponse{
+							RaiTopic: armcognitiveservices.RaiTopic{
+								Name: to.Ptr("raiTopicName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiTopics/raiTopicName"),
+								Properties: &armcognitiveservices.RaiTopicProperties{
+									Description:    to.Ptr("This is a sample topic."),
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t }()),
+									SampleBlobURL:  to.Ptr("https://example.blob.core.windows.net/sampleblob"),
+									Status:         to.Ptr("Training"),
+									TopicID:        to.Ptr("00000000-0000-0000-0000-000000000000"),
+									TopicName:      to.Ptr("raiTopicName"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -33,34 +61,29 @@ func ExampleRaiTopicsClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiTopicsClientCreateOrUpdateResponse{
-	// 	RaiTopic: armcognitiveservices.RaiTopic{
-	// 		Name: to.Ptr("raiTopicName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiTopics/raiTopicName"),
-	// 		Properties: &armcognitiveservices.RaiTopicProperties{
-	// 			Description: to.Ptr("This is a sample topic."),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t}()),
-	// 			SampleBlobURL: to.Ptr("https://example.blob.core.windows.net/sampleblob"),
-	// 			Status: to.Ptr("Training"),
-	// 			TopicID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			TopicName: to.Ptr("raiTopicName"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: raiTopicName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiTopics/raiTopicName
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteRaiTopic.json
 func ExampleRaiTopicsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiTopicsServer: fake.RaiTopicsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, accountName string, raiTopicName string, options *armcognitiveservices.RaiTopicsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.RaiTopicsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.RaiTopicsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -72,16 +95,39 @@ func ExampleRaiTopicsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetRaiTopic.json
 func ExampleRaiTopicsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiTopicsServer: fake.RaiTopicsServer{
+					Get: func(ctx context.Context, resourceGroupName string, accountName string, raiTopicName string, options *armcognitiveservices.RaiTopicsClientGetOptions) (resp azfake.Responder[armcognitiveservices.RaiTopicsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.RaiTopicsClientGetResponse{
+							RaiTopic: armcognitiveservices.RaiTopic{
+								Name: to.Ptr("raiTopicName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiTopics/raiTopicName"),
+								Properties: &armcognitiveservices.RaiTopicProperties{
+									Description:    to.Ptr("This is a sample topic."),
+									CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t }()),
+									LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t }()),
+									SampleBlobURL:  to.Ptr("https://example.blob.core.windows.net/sampleblob"),
+									Status:         to.Ptr("Succeeded"),
+									TopicID:        to.Ptr("00000000-0000-0000-0000-000000000000"),
+									TopicName:      to.Ptr("raiTopicName"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -89,34 +135,47 @@ func ExampleRaiTopicsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.RaiTopicsClientGetResponse{
-	// 	RaiTopic: armcognitiveservices.RaiTopic{
-	// 		Name: to.Ptr("raiTopicName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiTopics/raiTopicName"),
-	// 		Properties: &armcognitiveservices.RaiTopicProperties{
-	// 			Description: to.Ptr("This is a sample topic."),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t}()),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t}()),
-	// 			SampleBlobURL: to.Ptr("https://example.blob.core.windows.net/sampleblob"),
-	// 			Status: to.Ptr("Succeeded"),
-	// 			TopicID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			TopicName: to.Ptr("raiTopicName"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: raiTopicName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiTopics/raiTopicName
 }
 
 // Generated from example definition: 2025-10-01-preview/ListRaiTopics.json
 func ExampleRaiTopicsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RaiTopicsServer: fake.RaiTopicsServer{
+					NewListPager: func(resourceGroupName string, accountName string, options *armcognitiveservices.RaiTopicsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.RaiTopicsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.RaiTopicsClientListResponse{
+							RaiTopicResult: armcognitiveservices.RaiTopicResult{
+								Value: []*armcognitiveservices.RaiTopic{
+									{
+										Name: to.Ptr("raiTopicName"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiTopics/raiTopicName"),
+										Properties: &armcognitiveservices.RaiTopicProperties{
+											Description:    to.Ptr("This is a sample topic."),
+											CreatedAt:      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t }()),
+											LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t }()),
+											SampleBlobURL:  to.Ptr("https://example.blob.core.windows.net/sampleblob"),
+											Status:         to.Ptr("Succeeded"),
+											TopicID:        to.Ptr("00000000-0000-0000-0000-000000000000"),
+											TopicName:      to.Ptr("raiTopicName"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -127,28 +186,11 @@ func ExampleRaiTopicsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.RaiTopicsClientListResponse{
-		// 	RaiTopicResult: armcognitiveservices.RaiTopicResult{
-		// 		Value: []*armcognitiveservices.RaiTopic{
-		// 			{
-		// 				Name: to.Ptr("raiTopicName"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiTopics/raiTopicName"),
-		// 				Properties: &armcognitiveservices.RaiTopicProperties{
-		// 					Description: to.Ptr("This is a sample topic."),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-01T00:00:00Z"); return t}()),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-07-02T00:00:00Z"); return t}()),
-		// 					SampleBlobURL: to.Ptr("https://example.blob.core.windows.net/sampleblob"),
-		// 					Status: to.Ptr("Succeeded"),
-		// 					TopicID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 					TopicName: to.Ptr("raiTopicName"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: raiTopicName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiTopics/raiTopicName
 }
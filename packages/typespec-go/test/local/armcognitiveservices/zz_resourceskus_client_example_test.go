@@ -6,19 +6,2188 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/GetSkus.json
 func ExampleResourceSKUsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("f1c637e4-72ec-4f89-8d2b-0f933c036002", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("f1c637e4-72ec-4f89-8d2b-0f933c036002", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ResourceSKUsServer: fake.ResourceSKUsServer{
+					NewListPager: func(options *armcognitiveservices.ResourceSKUsClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.ResourceSKUsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.ResourceSKUsClientListResponse{
+							ResourceSKUListResult: armcognitiveservices.ResourceSKUListResult{
+								Value: []*armcognitiveservices.ResourceSKU{
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Bing.Speech"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Bing.Speech"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("SpeechTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("SpeechTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("SpeechTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("SpeechTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("SpeechTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextTranslation"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("Bing.Search.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("Bing.Search.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("Bing.Search.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("Bing.Search.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S5"),
+										Kind: to.Ptr("Bing.Search.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S6"),
+										Kind: to.Ptr("Bing.Search.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S7"),
+										Kind: to.Ptr("Bing.Search.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S8"),
+										Kind: to.Ptr("Bing.Search.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("Bing.Autosuggest.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("Bing.CustomSearch"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("Bing.SpellCheck.v7"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Bing.EntitySearch"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("Bing.EntitySearch"),
+										Locations: []*string{
+											to.Ptr("GLOBAL"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("AUSTRALIAEAST"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("BRAZILSOUTH"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("CENTRALUSEUAP"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("SpeakerRecognition"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("SpeakerRecognition"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("CustomSpeech"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("CustomSpeech"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("WESTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("WESTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("NORTHEUROPE"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHEASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("EASTASIA"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("WESTCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("CustomVision.Training"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("CustomVision.Training"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("CustomVision.Prediction"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("CustomVision.Prediction"),
+										Locations: []*string{
+											to.Ptr("SOUTHCENTRALUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("EASTUS"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("ContentModerator"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("Face"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("LUIS"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("ComputerVision"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("F0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Free"),
+									},
+									{
+										Name: to.Ptr("S0"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S1"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S2"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S3"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+									{
+										Name: to.Ptr("S4"),
+										Kind: to.Ptr("TextAnalytics"),
+										Locations: []*string{
+											to.Ptr("EASTUS2"),
+										},
+										ResourceType: to.Ptr("accounts"),
+										Restrictions: []*armcognitiveservices.ResourceSKURestrictions{},
+										Tier:         to.Ptr("Standard"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,2380 +2198,871 @@ func ExampleResourceSKUsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Kind:", *v.Kind)
+			fmt.Println("ResourceType:", *v.ResourceType)
+			fmt.Println("Tier:", *v.Tier)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.ResourceSKUsClientListResponse{
-		// 	ResourceSKUListResult: armcognitiveservices.ResourceSKUListResult{
-		// 		Value: []*armcognitiveservices.ResourceSKU{
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Bing.Speech"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Bing.Speech"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("SpeechTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("SpeechTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("SpeechTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("SpeechTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("SpeechTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextTranslation"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("Bing.Search.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("Bing.Search.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("Bing.Search.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("Bing.Search.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S5"),
-		// 				Kind: to.Ptr("Bing.Search.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S6"),
-		// 				Kind: to.Ptr("Bing.Search.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S7"),
-		// 				Kind: to.Ptr("Bing.Search.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S8"),
-		// 				Kind: to.Ptr("Bing.Search.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("Bing.Autosuggest.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("Bing.CustomSearch"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("Bing.SpellCheck.v7"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Bing.EntitySearch"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("Bing.EntitySearch"),
-		// 				Locations: []*string{
-		// 					to.Ptr("GLOBAL"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("AUSTRALIAEAST"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("BRAZILSOUTH"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("CENTRALUSEUAP"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("SpeakerRecognition"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("SpeakerRecognition"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("CustomSpeech"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("CustomSpeech"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("NORTHEUROPE"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHEASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTASIA"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("WESTCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("CustomVision.Training"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("CustomVision.Training"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("CustomVision.Prediction"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("CustomVision.Prediction"),
-		// 				Locations: []*string{
-		// 					to.Ptr("SOUTHCENTRALUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("ContentModerator"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("Face"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("LUIS"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("ComputerVision"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("F0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Free"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S0"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S1"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S2"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S3"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("S4"),
-		// 				Kind: to.Ptr("TextAnalytics"),
-		// 				Locations: []*string{
-		// 					to.Ptr("EASTUS2"),
-		// 				},
-		// 				ResourceType: to.Ptr("accounts"),
-		// 				Restrictions: []*armcognitiveservices.ResourceSKURestrictions{
-		// 				},
-		// 				Tier: to.Ptr("Standard"),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: F0
+	// Kind: Bing.Speech
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Bing.Speech
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: SpeechTranslation
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: SpeechTranslation
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: SpeechTranslation
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: SpeechTranslation
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: SpeechTranslation
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextTranslation
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: TextTranslation
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextTranslation
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextTranslation
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextTranslation
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: Bing.Search.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: Bing.Search.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: Bing.Search.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: Bing.Search.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S5
+	// Kind: Bing.Search.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S6
+	// Kind: Bing.Search.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S7
+	// Kind: Bing.Search.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S8
+	// Kind: Bing.Search.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: Bing.Autosuggest.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: Bing.CustomSearch
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: Bing.SpellCheck.v7
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Bing.EntitySearch
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: Bing.EntitySearch
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: SpeakerRecognition
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: SpeakerRecognition
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: CustomSpeech
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S2
+	// Kind: CustomSpeech
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: CustomVision.Training
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: CustomVision.Training
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: CustomVision.Prediction
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: CustomVision.Prediction
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: ContentModerator
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: Face
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: LUIS
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S1
+	// Kind: ComputerVision
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: F0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Free
+	// Name: S0
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S1
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S2
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S3
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
+	// Name: S4
+	// Kind: TextAnalytics
+	// ResourceType: accounts
+	// Tier: Standard
 }
@@ -6,20 +6,124 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/PutSubscriptionRaiPolicy.json
 func ExampleSubscriptionRaiPolicyClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				SubscriptionRaiPolicyServer: fake.SubscriptionRaiPolicyServer{
+					CreateOrUpdate: func(ctx context.Context, raiPolicyName string, raiPolicy armcognitiveservices.RaiPolicy, options *armcognitiveservices.SubscriptionRaiPolicyClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.SubscriptionRaiPolicyClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.SubscriptionRaiPolicyClientCreateOrUpdateResponse{
+							RaiPolicy: armcognitiveservices.RaiPolicy{
+								Name: to.Ptr("raiPolicyName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
+								Properties: &armcognitiveservices.RaiPolicyProperties{
+									BasePolicyName: to.Ptr("Microsoft.Default"),
+									ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
+										{
+											Name:              to.Ptr("Hate"),
+											Blocking:          to.Ptr(false),
+											Enabled:           to.Ptr(false),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Hate"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Sexual"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Sexual"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Selfharm"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Selfharm"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Violence"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Violence"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Jailbreak"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:     to.Ptr("Protected Material Text"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Protected Material Code"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Profanity"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+									},
+									Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -114,111 +218,29 @@ func ExampleSubscriptionRaiPolicyClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.SubscriptionRaiPolicyClientCreateOrUpdateResponse{
-	// 	RaiPolicy: armcognitiveservices.RaiPolicy{
-	// 		Name: to.Ptr("raiPolicyName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
-	// 		Properties: &armcognitiveservices.RaiPolicyProperties{
-	// 			BasePolicyName: to.Ptr("Microsoft.Default"),
-	// 			ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
-	// 				{
-	// 					Name: to.Ptr("Hate"),
-	// 					Blocking: to.Ptr(false),
-	// 					Enabled: to.Ptr(false),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Hate"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Sexual"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Sexual"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Selfharm"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Selfharm"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Violence"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Violence"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Jailbreak"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Protected Material Text"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Protected Material Code"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Profanity"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 			},
-	// 			Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: raiPolicyName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName
 }
 
 // Generated from example definition: 2025-10-01-preview/DeleteSubscriptionRaiPolicy.json
 func ExampleSubscriptionRaiPolicyClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				SubscriptionRaiPolicyServer: fake.SubscriptionRaiPolicyServer{
+					BeginDelete: func(ctx context.Context, raiPolicyName string, options *armcognitiveservices.SubscriptionRaiPolicyClientBeginDeleteOptions) (resp azfake.PollerResponder[armcognitiveservices.SubscriptionRaiPolicyClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcognitiveservices.SubscriptionRaiPolicyClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -230,16 +252,116 @@ func ExampleSubscriptionRaiPolicyClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-10-01-preview/GetSubscriptionRaiPolicy.json
 func ExampleSubscriptionRaiPolicyClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				SubscriptionRaiPolicyServer: fake.SubscriptionRaiPolicyServer{
+					Get: func(ctx context.Context, raiPolicyName string, options *armcognitiveservices.SubscriptionRaiPolicyClientGetOptions) (resp azfake.Responder[armcognitiveservices.SubscriptionRaiPolicyClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.SubscriptionRaiPolicyClientGetResponse{
+							RaiPolicy: armcognitiveservices.RaiPolicy{
+								Name: to.Ptr("raiPolicyName"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
+								Properties: &armcognitiveservices.RaiPolicyProperties{
+									BasePolicyName: to.Ptr("Microsoft.Default"),
+									ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
+										{
+											Name:              to.Ptr("Hate"),
+											Blocking:          to.Ptr(false),
+											Enabled:           to.Ptr(false),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Hate"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Sexual"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Sexual"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Selfharm"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Selfharm"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:              to.Ptr("Violence"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:              to.Ptr("Violence"),
+											Blocking:          to.Ptr(true),
+											Enabled:           to.Ptr(true),
+											SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
+											Source:            to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Jailbreak"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+										{
+											Name:     to.Ptr("Protected Material Text"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Protected Material Code"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
+										},
+										{
+											Name:     to.Ptr("Profanity"),
+											Blocking: to.Ptr(true),
+											Enabled:  to.Ptr(true),
+											Source:   to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
+										},
+									},
+									Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -247,99 +369,9 @@ func ExampleSubscriptionRaiPolicyClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.SubscriptionRaiPolicyClientGetResponse{
-	// 	RaiPolicy: armcognitiveservices.RaiPolicy{
-	// 		Name: to.Ptr("raiPolicyName"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName"),
-	// 		Properties: &armcognitiveservices.RaiPolicyProperties{
-	// 			BasePolicyName: to.Ptr("Microsoft.Default"),
-	// 			ContentFilters: []*armcognitiveservices.RaiPolicyContentFilter{
-	// 				{
-	// 					Name: to.Ptr("Hate"),
-	// 					Blocking: to.Ptr(false),
-	// 					Enabled: to.Ptr(false),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Hate"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Sexual"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Sexual"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Selfharm"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelHigh),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Selfharm"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Violence"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Violence"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					SeverityThreshold: to.Ptr(armcognitiveservices.ContentLevelMedium),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Jailbreak"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Protected Material Text"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Protected Material Code"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourceCompletion),
-	// 				},
-	// 				{
-	// 					Name: to.Ptr("Profanity"),
-	// 					Blocking: to.Ptr(true),
-	// 					Enabled: to.Ptr(true),
-	// 					Source: to.Ptr(armcognitiveservices.RaiPolicyContentSourcePrompt),
-	// 				},
-	// 			},
-	// 			Mode: to.Ptr(armcognitiveservices.RaiPolicyModeAsynchronousFilter),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: raiPolicyName
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroupName/providers/Microsoft.CognitiveServices/accounts/accountName/raiPolicies/raiPolicyName
 }
@@ -6,20 +6,47 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/TestRaiExternalSafetyProvider.json
 func ExampleTestRaiExternalSafetyProviderClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				TestRaiExternalSafetyProviderServer: fake.TestRaiExternalSafetyProviderServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, accountName string, safetyProviderName string, safetyProvider armcognitiveservices.RaiExternalSafetyProviderSchema, options *armcognitiveservices.TestRaiExternalSafetyProviderClientCreateOrUpdateOptions) (resp azfake.Responder[armcognitiveservices.TestRaiExternalSafetyProviderClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcognitiveservices.TestRaiExternalSafetyProviderClientCreateOrUpdateResponse{
+							RaiExternalSafetyProviderSchema: armcognitiveservices.RaiExternalSafetyProviderSchema{
+								Name: to.Ptr("mySafetyProvider"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/mySafetyProvider"),
+								Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
+									KeyVaultURI:     to.Ptr("https://contoso-vault.vault.azure.net/"),
+									ManagedIdentity: to.Ptr("f3b9c2e7-4aad-4b1f-9d9c-9e9b1e9b1f9b"),
+									Mode:            to.Ptr("sync"),
+									ProviderID:      to.Ptr("00000000-0000-0000-0000-000000000000"),
+									ProviderName:    to.Ptr("safetyProviderName"),
+									SecretName:      to.Ptr("safety-provider-secret"),
+									URL:             to.Ptr("https://example-safety-provider.contoso.com/webhook"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -37,22 +64,9 @@ func ExampleTestRaiExternalSafetyProviderClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcognitiveservices.TestRaiExternalSafetyProviderClientCreateOrUpdateResponse{
-	// 	RaiExternalSafetyProviderSchema: armcognitiveservices.RaiExternalSafetyProviderSchema{
-	// 		Name: to.Ptr("mySafetyProvider"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/mySafetyProvider"),
-	// 		Properties: &armcognitiveservices.RaiExternalSafetyProviderSchemaProperties{
-	// 			KeyVaultURI: to.Ptr("https://contoso-vault.vault.azure.net/"),
-	// 			ManagedIdentity: to.Ptr("f3b9c2e7-4aad-4b1f-9d9c-9e9b1e9b1f9b"),
-	// 			Mode: to.Ptr("sync"),
-	// 			ProviderID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 			ProviderName: to.Ptr("safetyProviderName"),
-	// 			SecretName: to.Ptr("safety-provider-secret"),
-	// 			URL: to.Ptr("https://example-safety-provider.contoso.com/webhook"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: mySafetyProvider
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.CognitiveServices/raiExternalSafetyProviders/mySafetyProvider
 }
@@ -6,19 +6,47 @@ package armcognitiveservices_test
 
 import (
 	"armcognitiveservices"
+	"armcognitiveservices/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-10-01-preview/ListUsages.json
 func ExampleUsagesClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcognitiveservices.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				UsagesServer: fake.UsagesServer{
+					NewListPager: func(location string, options *armcognitiveservices.UsagesClientListOptions) (resp azfake.PagerResponder[armcognitiveservices.UsagesClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcognitiveservices.UsagesClientListResponse{
+							UsageListResult: armcognitiveservices.UsageListResult{
+								Value: []*armcognitiveservices.Usage{
+									{
+										Name: &armcognitiveservices.MetricName{
+											LocalizedValue: to.Ptr("Cognitive Services total account limit"),
+											Value:          to.Ptr("AccountCount"),
+										},
+										CurrentValue: to.Ptr[float64](3),
+										Limit:        to.Ptr[float64](200),
+										Unit:         to.Ptr(armcognitiveservices.UnitTypeCount),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,24 +57,9 @@ func ExampleUsagesClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Unit:", *v.Unit)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcognitiveservices.UsagesClientListResponse{
-		// 	UsageListResult: armcognitiveservices.UsageListResult{
-		// 		Value: []*armcognitiveservices.Usage{
-		// 			{
-		// 				Name: &armcognitiveservices.MetricName{
-		// 					LocalizedValue: to.Ptr("Cognitive Services total account limit"),
-		// 					Value: to.Ptr("AccountCount"),
-		// 				},
-		// 				CurrentValue: to.Ptr[float64](3),
-		// 				Limit: to.Ptr[float64](200),
-		// 				Unit: to.Ptr(armcognitiveservices.UnitTypeCount),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Unit: Count
 }
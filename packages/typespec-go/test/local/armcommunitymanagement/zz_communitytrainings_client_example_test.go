@@ -6,20 +6,73 @@ package armcommunitymanagement_test
 
 import (
 	"armcommunitymanagement"
+	"armcommunitymanagement/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2023-11-01/CommunityTrainings_Create.json
 func ExampleCommunityTrainingsClient_BeginCreate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommunityTrainingsServer: fake.CommunityTrainingsServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, communityTrainingName string, resource armcommunitymanagement.CommunityTraining, options *armcommunitymanagement.CommunityTrainingsClientBeginCreateOptions) (resp azfake.PollerResponder[armcommunitymanagement.CommunityTrainingsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcommunitymanagement.CommunityTrainingsClientCreateResponse{
+							CommunityTraining: armcommunitymanagement.CommunityTraining{
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
+								Name: to.Ptr("ctApplication"),
+								Type: to.Ptr("Microsoft.Community/communityTrainings"),
+								Properties: &armcommunitymanagement.CommunityTrainingProperties{
+									PortalName:                  to.Ptr("ctwebsite"),
+									PortalAdminEmailAddress:     to.Ptr("ctadmin@ct.com"),
+									PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
+									PortalOwnerEmailAddress:     to.Ptr("ctcontact@ct.com"),
+									IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
+										IdentityType:            to.Ptr("ADB2C"),
+										TeamsEnabled:            to.Ptr(false),
+										TenantID:                to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
+										DomainName:              to.Ptr("cttenant"),
+										ClientID:                to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
+										B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
+										B2CPasswordResetPolicy:  to.Ptr("B2C_1_pwd_reset"),
+										CustomLoginParameters:   to.Ptr("custom_hint"),
+									},
+									ZoneRedundancyEnabled:   to.Ptr(true),
+									DisasterRecoveryEnabled: to.Ptr(true),
+									ProvisioningState:       to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
+								},
+								SKU: &armcommunitymanagement.SKU{
+									Name: to.Ptr("Commercial"),
+									Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
+								},
+								Tags:     map[string]*string{},
+								Location: to.Ptr("southeastasia"),
+								SystemData: &armcommunitymanagement.SystemData{
+									CreatedBy:          to.Ptr("ctowner@ct.com"),
+									CreatedByType:      to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+									LastModifiedBy:     to.Ptr("ctowner@ct.com"),
+									LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -56,60 +109,33 @@ func ExampleCommunityTrainingsClient_BeginCreate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcommunitymanagement.CommunityTrainingsClientCreateResponse{
-	// 	CommunityTraining: armcommunitymanagement.CommunityTraining{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
-	// 		Name: to.Ptr("ctApplication"),
-	// 		Type: to.Ptr("Microsoft.Community/communityTrainings"),
-	// 		Properties: &armcommunitymanagement.CommunityTrainingProperties{
-	// 			PortalName: to.Ptr("ctwebsite"),
-	// 			PortalAdminEmailAddress: to.Ptr("ctadmin@ct.com"),
-	// 			PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
-	// 			PortalOwnerEmailAddress: to.Ptr("ctcontact@ct.com"),
-	// 			IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
-	// 				IdentityType: to.Ptr("ADB2C"),
-	// 				TeamsEnabled: to.Ptr(false),
-	// 				TenantID: to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
-	// 				DomainName: to.Ptr("cttenant"),
-	// 				ClientID: to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
-	// 				B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
-	// 				B2CPasswordResetPolicy: to.Ptr("B2C_1_pwd_reset"),
-	// 				CustomLoginParameters: to.Ptr("custom_hint"),
-	// 			},
-	// 			ZoneRedundancyEnabled: to.Ptr(true),
-	// 			DisasterRecoveryEnabled: to.Ptr(true),
-	// 			ProvisioningState: to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcommunitymanagement.SKU{
-	// 			Name: to.Ptr("Commercial"),
-	// 			Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Location: to.Ptr("southeastasia"),
-	// 		SystemData: &armcommunitymanagement.SystemData{
-	// 			CreatedBy: to.Ptr("ctowner@ct.com"),
-	// 			CreatedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("ctowner@ct.com"),
-	// 			LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication
+	// Name: ctApplication
+	// Type: Microsoft.Community/communityTrainings
+	// Location: southeastasia
 }
 
 // Generated from example definition: 2023-11-01/CommunityTrainings_Delete.json
 func ExampleCommunityTrainingsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommunityTrainingsServer: fake.CommunityTrainingsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, communityTrainingName string, options *armcommunitymanagement.CommunityTrainingsClientBeginDeleteOptions) (resp azfake.PollerResponder[armcommunitymanagement.CommunityTrainingsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcommunitymanagement.CommunityTrainingsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -123,19 +149,64 @@ func ExampleCommunityTrainingsClient_BeginDelete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcommunitymanagement.CommunityTrainingsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2023-11-01/CommunityTrainings_Get.json
 func ExampleCommunityTrainingsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommunityTrainingsServer: fake.CommunityTrainingsServer{
+					Get: func(ctx context.Context, resourceGroupName string, communityTrainingName string, options *armcommunitymanagement.CommunityTrainingsClientGetOptions) (resp azfake.Responder[armcommunitymanagement.CommunityTrainingsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcommunitymanagement.CommunityTrainingsClientGetResponse{
+							CommunityTraining: armcommunitymanagement.CommunityTraining{
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
+								Name: to.Ptr("ctApplication"),
+								Type: to.Ptr("Microsoft.Community/communityTrainings"),
+								Properties: &armcommunitymanagement.CommunityTrainingProperties{
+									PortalName:                  to.Ptr("ctwebsite"),
+									PortalAdminEmailAddress:     to.Ptr("ctadmin@ct.com"),
+									PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
+									PortalOwnerEmailAddress:     to.Ptr("ctcontact@ct.com"),
+									IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
+										IdentityType:            to.Ptr("ADB2C"),
+										TeamsEnabled:            to.Ptr(false),
+										TenantID:                to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
+										DomainName:              to.Ptr("cttenant"),
+										ClientID:                to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
+										B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
+										B2CPasswordResetPolicy:  to.Ptr("B2C_1_pwd_reset"),
+										CustomLoginParameters:   to.Ptr("custom_hint"),
+									},
+									ZoneRedundancyEnabled:   to.Ptr(true),
+									DisasterRecoveryEnabled: to.Ptr(true),
+									ProvisioningState:       to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
+								},
+								SKU: &armcommunitymanagement.SKU{
+									Name: to.Ptr("Commercial"),
+									Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
+								},
+								Tags:     map[string]*string{},
+								Location: to.Ptr("southeastasia"),
+								SystemData: &armcommunitymanagement.SystemData{
+									CreatedBy:          to.Ptr("ctowner@ct.com"),
+									CreatedByType:      to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+									LastModifiedBy:     to.Ptr("ctowner@ct.com"),
+									LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -143,60 +214,76 @@ func ExampleCommunityTrainingsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcommunitymanagement.CommunityTrainingsClientGetResponse{
-	// 	CommunityTraining: armcommunitymanagement.CommunityTraining{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
-	// 		Name: to.Ptr("ctApplication"),
-	// 		Type: to.Ptr("Microsoft.Community/communityTrainings"),
-	// 		Properties: &armcommunitymanagement.CommunityTrainingProperties{
-	// 			PortalName: to.Ptr("ctwebsite"),
-	// 			PortalAdminEmailAddress: to.Ptr("ctadmin@ct.com"),
-	// 			PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
-	// 			PortalOwnerEmailAddress: to.Ptr("ctcontact@ct.com"),
-	// 			IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
-	// 				IdentityType: to.Ptr("ADB2C"),
-	// 				TeamsEnabled: to.Ptr(false),
-	// 				TenantID: to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
-	// 				DomainName: to.Ptr("cttenant"),
-	// 				ClientID: to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
-	// 				B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
-	// 				B2CPasswordResetPolicy: to.Ptr("B2C_1_pwd_reset"),
-	// 				CustomLoginParameters: to.Ptr("custom_hint"),
-	// 			},
-	// 			ZoneRedundancyEnabled: to.Ptr(true),
-	// 			DisasterRecoveryEnabled: to.Ptr(true),
-	// 			ProvisioningState: to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcommunitymanagement.SKU{
-	// 			Name: to.Ptr("Commercial"),
-	// 			Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Location: to.Ptr("southeastasia"),
-	// 		SystemData: &armcommunitymanagement.SystemData{
-	// 			CreatedBy: to.Ptr("ctowner@ct.com"),
-	// 			CreatedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("ctowner@ct.com"),
-	// 			LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication
+	// Name: ctApplication
+	// Type: Microsoft.Community/communityTrainings
+	// Location: southeastasia
 }
 
 // Generated from example definition: 2023-11-01/CommunityTrainings_ListByResourceGroup.json
 func ExampleCommunityTrainingsClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommunityTrainingsServer: fake.CommunityTrainingsServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armcommunitymanagement.CommunityTrainingsClientListByResourceGroupOptions) (resp azfake.PagerResponder[armcommunitymanagement.CommunityTrainingsClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armcommunitymanagement.CommunityTrainingsClientListByResourceGroupResponse{
+							CommunityTrainingListResult: armcommunitymanagement.CommunityTrainingListResult{
+								Value: []*armcommunitymanagement.CommunityTraining{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
+										Name: to.Ptr("ctApplication"),
+										Type: to.Ptr("Microsoft.Community/communityTrainings"),
+										Properties: &armcommunitymanagement.CommunityTrainingProperties{
+											PortalName:                  to.Ptr("ctwebsite"),
+											PortalAdminEmailAddress:     to.Ptr("ctadmin@ct.com"),
+											PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
+											PortalOwnerEmailAddress:     to.Ptr("ctcontact@ct.com"),
+											IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
+												IdentityType:            to.Ptr("ADB2C"),
+												TeamsEnabled:            to.Ptr(false),
+												TenantID:                to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
+												DomainName:              to.Ptr("cttenant"),
+												ClientID:                to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
+												B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
+												B2CPasswordResetPolicy:  to.Ptr("B2C_1_pwd_reset"),
+												CustomLoginParameters:   to.Ptr("custom_hint"),
+											},
+											ZoneRedundancyEnabled:   to.Ptr(true),
+											DisasterRecoveryEnabled: to.Ptr(true),
+											ProvisioningState:       to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
+										},
+										SKU: &armcommunitymanagement.SKU{
+											Name: to.Ptr("Commercial"),
+											Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
+										},
+										Tags:     map[string]*string{},
+										Location: to.Ptr("southeastasia"),
+										SystemData: &armcommunitymanagement.SystemData{
+											CreatedBy:          to.Ptr("ctowner@ct.com"),
+											CreatedByType:      to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+											LastModifiedBy:     to.Ptr("ctowner@ct.com"),
+											LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -207,66 +294,78 @@ func ExampleCommunityTrainingsClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcommunitymanagement.CommunityTrainingsClientListByResourceGroupResponse{
-		// 	CommunityTrainingListResult: armcommunitymanagement.CommunityTrainingListResult{
-		// 		Value: []*armcommunitymanagement.CommunityTraining{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
-		// 				Name: to.Ptr("ctApplication"),
-		// 				Type: to.Ptr("Microsoft.Community/communityTrainings"),
-		// 				Properties: &armcommunitymanagement.CommunityTrainingProperties{
-		// 					PortalName: to.Ptr("ctwebsite"),
-		// 					PortalAdminEmailAddress: to.Ptr("ctadmin@ct.com"),
-		// 					PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
-		// 					PortalOwnerEmailAddress: to.Ptr("ctcontact@ct.com"),
-		// 					IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
-		// 						IdentityType: to.Ptr("ADB2C"),
-		// 						TeamsEnabled: to.Ptr(false),
-		// 						TenantID: to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
-		// 						DomainName: to.Ptr("cttenant"),
-		// 						ClientID: to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
-		// 						B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
-		// 						B2CPasswordResetPolicy: to.Ptr("B2C_1_pwd_reset"),
-		// 						CustomLoginParameters: to.Ptr("custom_hint"),
-		// 					},
-		// 					ZoneRedundancyEnabled: to.Ptr(true),
-		// 					DisasterRecoveryEnabled: to.Ptr(true),
-		// 					ProvisioningState: to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcommunitymanagement.SKU{
-		// 					Name: to.Ptr("Commercial"),
-		// 					Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				Location: to.Ptr("southeastasia"),
-		// 				SystemData: &armcommunitymanagement.SystemData{
-		// 					CreatedBy: to.Ptr("ctowner@ct.com"),
-		// 					CreatedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("ctowner@ct.com"),
-		// 					LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication
+	// Name: ctApplication
+	// Type: Microsoft.Community/communityTrainings
+	// Location: southeastasia
 }
 
 // Generated from example definition: 2023-11-01/CommunityTrainings_ListBySubscription.json
 func ExampleCommunityTrainingsClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommunityTrainingsServer: fake.CommunityTrainingsServer{
+					NewListBySubscriptionPager: func(options *armcommunitymanagement.CommunityTrainingsClientListBySubscriptionOptions) (resp azfake.PagerResponder[armcommunitymanagement.CommunityTrainingsClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armcommunitymanagement.CommunityTrainingsClientListBySubscriptionResponse{
+							CommunityTrainingListResult: armcommunitymanagement.CommunityTrainingListResult{
+								Value: []*armcommunitymanagement.CommunityTraining{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
+										Name: to.Ptr("ctApplication"),
+										Type: to.Ptr("Microsoft.Community/communityTrainings"),
+										Properties: &armcommunitymanagement.CommunityTrainingProperties{
+											PortalName:                  to.Ptr("ctwebsite"),
+											PortalAdminEmailAddress:     to.Ptr("ctadmin@ct.com"),
+											PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
+											PortalOwnerEmailAddress:     to.Ptr("ctcontact@ct.com"),
+											IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
+												IdentityType:            to.Ptr("ADB2C"),
+												TeamsEnabled:            to.Ptr(false),
+												TenantID:                to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
+												DomainName:              to.Ptr("cttenant"),
+												ClientID:                to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
+												B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
+												B2CPasswordResetPolicy:  to.Ptr("B2C_1_pwd_reset"),
+												CustomLoginParameters:   to.Ptr("custom_hint"),
+											},
+											ZoneRedundancyEnabled:   to.Ptr(true),
+											DisasterRecoveryEnabled: to.Ptr(true),
+											ProvisioningState:       to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
+										},
+										SKU: &armcommunitymanagement.SKU{
+											Name: to.Ptr("Commercial"),
+											Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
+										},
+										Tags:     map[string]*string{},
+										Location: to.Ptr("southeastasia"),
+										SystemData: &armcommunitymanagement.SystemData{
+											CreatedBy:          to.Ptr("ctowner@ct.com"),
+											CreatedByType:      to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+											LastModifiedBy:     to.Ptr("ctowner@ct.com"),
+											LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -277,66 +376,74 @@ func ExampleCommunityTrainingsClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcommunitymanagement.CommunityTrainingsClientListBySubscriptionResponse{
-		// 	CommunityTrainingListResult: armcommunitymanagement.CommunityTrainingListResult{
-		// 		Value: []*armcommunitymanagement.CommunityTraining{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
-		// 				Name: to.Ptr("ctApplication"),
-		// 				Type: to.Ptr("Microsoft.Community/communityTrainings"),
-		// 				Properties: &armcommunitymanagement.CommunityTrainingProperties{
-		// 					PortalName: to.Ptr("ctwebsite"),
-		// 					PortalAdminEmailAddress: to.Ptr("ctadmin@ct.com"),
-		// 					PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
-		// 					PortalOwnerEmailAddress: to.Ptr("ctcontact@ct.com"),
-		// 					IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
-		// 						IdentityType: to.Ptr("ADB2C"),
-		// 						TeamsEnabled: to.Ptr(false),
-		// 						TenantID: to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
-		// 						DomainName: to.Ptr("cttenant"),
-		// 						ClientID: to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
-		// 						B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
-		// 						B2CPasswordResetPolicy: to.Ptr("B2C_1_pwd_reset"),
-		// 						CustomLoginParameters: to.Ptr("custom_hint"),
-		// 					},
-		// 					ZoneRedundancyEnabled: to.Ptr(true),
-		// 					DisasterRecoveryEnabled: to.Ptr(true),
-		// 					ProvisioningState: to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
-		// 				},
-		// 				SKU: &armcommunitymanagement.SKU{
-		// 					Name: to.Ptr("Commercial"),
-		// 					Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				Location: to.Ptr("southeastasia"),
-		// 				SystemData: &armcommunitymanagement.SystemData{
-		// 					CreatedBy: to.Ptr("ctowner@ct.com"),
-		// 					CreatedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("ctowner@ct.com"),
-		// 					LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication
+	// Name: ctApplication
+	// Type: Microsoft.Community/communityTrainings
+	// Location: southeastasia
 }
 
 // Generated from example definition: 2023-11-01/CommunityTrainings_Update.json
 func ExampleCommunityTrainingsClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armcommunitymanagement.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CommunityTrainingsServer: fake.CommunityTrainingsServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, communityTrainingName string, properties armcommunitymanagement.CommunityTrainingUpdate, options *armcommunitymanagement.CommunityTrainingsClientBeginUpdateOptions) (resp azfake.PollerResponder[armcommunitymanagement.CommunityTrainingsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcommunitymanagement.CommunityTrainingsClientUpdateResponse{
+							CommunityTraining: armcommunitymanagement.CommunityTraining{
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
+								Name: to.Ptr("ctApplication"),
+								Type: to.Ptr("Microsoft.Community/communityTrainings"),
+								Properties: &armcommunitymanagement.CommunityTrainingProperties{
+									PortalName:                  to.Ptr("ctwebsite"),
+									PortalAdminEmailAddress:     to.Ptr("ctadmin@ct.com"),
+									PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
+									PortalOwnerEmailAddress:     to.Ptr("ctcontact@ct.com"),
+									IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
+										IdentityType:            to.Ptr("ADB2C"),
+										TeamsEnabled:            to.Ptr(false),
+										TenantID:                to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
+										DomainName:              to.Ptr("cttenant"),
+										ClientID:                to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
+										B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
+										B2CPasswordResetPolicy:  to.Ptr("B2C_1_pwd_reset"),
+										CustomLoginParameters:   to.Ptr("custom_hint"),
+									},
+									ZoneRedundancyEnabled:   to.Ptr(true),
+									DisasterRecoveryEnabled: to.Ptr(true),
+									ProvisioningState:       to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
+								},
+								SKU: &armcommunitymanagement.SKU{
+									Name: to.Ptr("Commercial"),
+									Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
+								},
+								Tags:     map[string]*string{},
+								Location: to.Ptr("southeastasia"),
+								SystemData: &armcommunitymanagement.SystemData{
+									CreatedBy:          to.Ptr("ctowner@ct.com"),
+									CreatedByType:      to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+									LastModifiedBy:     to.Ptr("ctowner@ct.com"),
+									LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -367,48 +474,13 @@ func ExampleCommunityTrainingsClient_BeginUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcommunitymanagement.CommunityTrainingsClientUpdateResponse{
-	// 	CommunityTraining: armcommunitymanagement.CommunityTraining{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication"),
-	// 		Name: to.Ptr("ctApplication"),
-	// 		Type: to.Ptr("Microsoft.Community/communityTrainings"),
-	// 		Properties: &armcommunitymanagement.CommunityTrainingProperties{
-	// 			PortalName: to.Ptr("ctwebsite"),
-	// 			PortalAdminEmailAddress: to.Ptr("ctadmin@ct.com"),
-	// 			PortalOwnerOrganizationName: to.Ptr("CT Portal Owner Organization"),
-	// 			PortalOwnerEmailAddress: to.Ptr("ctcontact@ct.com"),
-	// 			IdentityConfiguration: &armcommunitymanagement.IdentityConfigurationProperties{
-	// 				IdentityType: to.Ptr("ADB2C"),
-	// 				TeamsEnabled: to.Ptr(false),
-	// 				TenantID: to.Ptr("c1ffbb60-88cf-4b83-b54f-c47ae6220c19"),
-	// 				DomainName: to.Ptr("cttenant"),
-	// 				ClientID: to.Ptr("8c92390f-2f30-493d-bd13-d3c3eba3709d"),
-	// 				B2CAuthenticationPolicy: to.Ptr("B2C_1_signup_signin"),
-	// 				B2CPasswordResetPolicy: to.Ptr("B2C_1_pwd_reset"),
-	// 				CustomLoginParameters: to.Ptr("custom_hint"),
-	// 			},
-	// 			ZoneRedundancyEnabled: to.Ptr(true),
-	// 			DisasterRecoveryEnabled: to.Ptr(true),
-	// 			ProvisioningState: to.Ptr(armcommunitymanagement.ProvisioningStateSucceeded),
-	// 		},
-	// 		SKU: &armcommunitymanagement.SKU{
-	// 			Name: to.Ptr("Commercial"),
-	// 			Tier: to.Ptr(armcommunitymanagement.SKUTierStandard),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Location: to.Ptr("southeastasia"),
-	// 		SystemData: &armcommunitymanagement.SystemData{
-	// 			CreatedBy: to.Ptr("ctowner@ct.com"),
-	// 			CreatedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("ctowner@ct.com"),
-	// 			LastModifiedByType: to.Ptr(armcommunitymanagement.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2023-08-01T11:42:44.657Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgCommunityTraining/providers/Microsoft.Community/communityTrainings/ctApplication
+	// Name: ctApplication
+	// Type: Microsoft.Community/communityTrainings
+	// Location: southeastasia
 }
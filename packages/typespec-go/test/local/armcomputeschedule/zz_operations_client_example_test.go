@@ -6,19 +6,50 @@ package armcomputeschedule_test
 
 import (
 	"armcomputeschedule"
+	"armcomputeschedule/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-05-01/Operations_List_MaximumSet_Gen.json
 func ExampleOperationsClient_NewListPager_operationsListMaximumSetGenGeneratedByMaximumSetRuleGeneratedByMaximumSetRuleGeneratedByMaximumSetRuleGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armcomputeschedule.OperationsClientListOptions) (resp azfake.PagerResponder[armcomputeschedule.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcomputeschedule.OperationsClientListResponse{
+							OperationListResult: armcomputeschedule.OperationListResult{
+								Value: []*armcomputeschedule.Operation{
+									{
+										Name:         to.Ptr("ldqzcrujeitsnm"),
+										IsDataAction: to.Ptr(true),
+										Display: &armcomputeschedule.OperationDisplay{
+											Provider:    to.Ptr("oxdxyfefyvtxexszpvt"),
+											Resource:    to.Ptr("icchvmkobgsviwonpruioyd"),
+											Operation:   to.Ptr("ibqrspiv"),
+											Description: to.Ptr("iavvwrkurztnb"),
+										},
+										Origin:     to.Ptr(armcomputeschedule.OriginUser),
+										ActionType: to.Ptr(armcomputeschedule.ActionTypeInternal),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,40 +60,37 @@ func ExampleOperationsClient_NewListPager_operationsListMaximumSetGenGeneratedBy
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("IsDataAction:", *v.IsDataAction)
+			fmt.Println("Origin:", *v.Origin)
+			fmt.Println("ActionType:", *v.ActionType)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcomputeschedule.OperationsClientListResponse{
-		// 	OperationListResult: armcomputeschedule.OperationListResult{
-		// 		Value: []*armcomputeschedule.Operation{
-		// 			{
-		// 				Name: to.Ptr("ldqzcrujeitsnm"),
-		// 				IsDataAction: to.Ptr(true),
-		// 				Display: &armcomputeschedule.OperationDisplay{
-		// 					Provider: to.Ptr("oxdxyfefyvtxexszpvt"),
-		// 					Resource: to.Ptr("icchvmkobgsviwonpruioyd"),
-		// 					Operation: to.Ptr("ibqrspiv"),
-		// 					Description: to.Ptr("iavvwrkurztnb"),
-		// 				},
-		// 				Origin: to.Ptr(armcomputeschedule.OriginUser),
-		// 				ActionType: to.Ptr(armcomputeschedule.ActionTypeInternal),
-		// 			},
-		// 		},
-		// 		NextLink: to.Ptr("https://microsoft.com/a"),
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: ldqzcrujeitsnm
+	// IsDataAction: true
+	// Origin: user
+	// ActionType: Internal
 }
 
 // Generated from example definition: 2025-05-01/Operations_List_MinimumSet_Gen.json
 func ExampleOperationsClient_NewListPager_operationsListMaximumSetGenGeneratedByMaximumSetRuleGeneratedByMaximumSetRuleGeneratedByMaximumSetRuleGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armcomputeschedule.OperationsClientListOptions) (resp azfake.PagerResponder[armcomputeschedule.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcomputeschedule.OperationsClientListResponse{
+							OperationListResult: armcomputeschedule.OperationListResult{},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -76,10 +104,6 @@ func ExampleOperationsClient_NewListPager_operationsListMaximumSetGenGeneratedBy
 			// You could use page here. We use blank identifier for just demo purposes.
 			_ = v
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcomputeschedule.OperationsClientListResponse{
-		// 	OperationListResult: armcomputeschedule.OperationListResult{
-		// 	},
-		// }
 	}
+	// Output:
 }
@@ -6,21 +6,64 @@ package armcomputeschedule_test
 
 import (
 	"armcomputeschedule"
+	"armcomputeschedule/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 	"time"
 )
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesCancelOperations_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesCancelOperations_scheduledActionsVirtualMachinesCancelOperationsMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesCancelOperations: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.CancelOperationsRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesCancelOperationsOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesCancelOperationsResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesCancelOperationsResponse{
+							CancelOperationsResponse: armcomputeschedule.CancelOperationsResponse{
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr(""),
+										ErrorDetails: to.Ptr(""),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("0505D8E5-D41A-48FB-9CA5-4AF8D93BE75F"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationTypeStart),
+											SubscriptionID: to.Ptr("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
+											State:          to.Ptr(armcomputeschedule.OperationStateScheduled),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											ResourceOperationError: &armcomputeschedule.ResourceOperationError{
+												ErrorCode:    to.Ptr("fagfsojftlff"),
+												ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
+											},
+											CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -35,48 +78,31 @@ func ExampleScheduledActionsClient_VirtualMachinesCancelOperations_scheduledActi
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesCancelOperationsResponse{
-	// 	CancelOperationsResponse: armcomputeschedule.CancelOperationsResponse{
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr(""),
-	// 				ErrorDetails: to.Ptr(""),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("0505D8E5-D41A-48FB-9CA5-4AF8D93BE75F"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationTypeStart),
-	// 					SubscriptionID: to.Ptr("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
-	// 					State: to.Ptr(armcomputeschedule.OperationStateScheduled),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					ResourceOperationError: &armcomputeschedule.ResourceOperationError{
-	// 						ErrorCode: to.Ptr("fagfsojftlff"),
-	// 						ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
-	// 					},
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesCancelOperations_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesCancelOperations_scheduledActionsVirtualMachinesCancelOperationsMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesCancelOperations: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.CancelOperationsRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesCancelOperationsOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesCancelOperationsResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesCancelOperationsResponse{
+							CancelOperationsResponse: armcomputeschedule.CancelOperationsResponse{
+								Results: []*armcomputeschedule.ResourceOperation{
+									{},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -91,25 +117,67 @@ func ExampleScheduledActionsClient_VirtualMachinesCancelOperations_scheduledActi
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesCancelOperationsResponse{
-	// 	CancelOperationsResponse: armcomputeschedule.CancelOperationsResponse{
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteCreate_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteCreate_scheduledActionsVirtualMachinesExecuteCreateMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteCreate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteCreateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteCreateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteCreateResponse{
+							CreateResourceOperationResponse: armcomputeschedule.CreateResourceOperationResponse{
+								Type:     to.Ptr("virtualmachines"),
+								Location: to.Ptr("eastus"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL13"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("5cc987d0-4a3a-4b01-b31a-c99219ece5e2"),
+											ResourceID:     to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL13"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationType("Create")),
+											SubscriptionID: to.Ptr("1d04e8f1-ee04-4056-b0b2-718f5bb45b04"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-05-23T22:51:50.1355051+00:00"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
+											State:          to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
+											TimeZone:       to.Ptr("UTC"),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](3),
+												RetryWindowInMinutes: to.Ptr[int32](10),
+											},
+										},
+									},
+									{
+										ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL14"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("5cc987d0-4a3a-4b01-b31a-c99219ece5e2"),
+											ResourceID:     to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL14"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationType("Create")),
+											SubscriptionID: to.Ptr("1d04e8f1-ee04-4056-b0b2-718f5bb45b04"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-05-23T22:51:50.1355051+00:00"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
+											State:          to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
+											TimeZone:       to.Ptr("UTC"),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](3),
+												RetryWindowInMinutes: to.Ptr[int32](10),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("Create Resource response"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -220,62 +288,73 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteCreate_scheduledActions
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteCreateResponse{
-	// 	CreateResourceOperationResponse: armcomputeschedule.CreateResourceOperationResponse{
-	// 		Type: to.Ptr("virtualmachines"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL13"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("5cc987d0-4a3a-4b01-b31a-c99219ece5e2"),
-	// 					ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL13"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationType("Create")),
-	// 					SubscriptionID: to.Ptr("1d04e8f1-ee04-4056-b0b2-718f5bb45b04"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-05-23T22:51:50.1355051+00:00"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
-	// 					State: to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
-	// 					TimeZone: to.Ptr("UTC"),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](3),
-	// 						RetryWindowInMinutes: to.Ptr[int32](10),
-	// 					},
-	// 				},
-	// 			},
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL14"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("5cc987d0-4a3a-4b01-b31a-c99219ece5e2"),
-	// 					ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL14"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationType("Create")),
-	// 					SubscriptionID: to.Ptr("1d04e8f1-ee04-4056-b0b2-718f5bb45b04"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-05-23T22:51:50.1355051+00:00"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
-	// 					State: to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
-	// 					TimeZone: to.Ptr("UTC"),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](3),
-	// 						RetryWindowInMinutes: to.Ptr[int32](10),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("Create Resource response"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: virtualmachines
+	// Location: eastus
+	// Description: Create Resource response
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteCreate_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteCreate_scheduledActionsVirtualMachinesExecuteCreateMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteCreate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteCreateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteCreateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteCreateResponse{
+							CreateResourceOperationResponse: armcomputeschedule.CreateResourceOperationResponse{
+								Type:     to.Ptr("virtualmachines"),
+								Location: to.Ptr("eastus"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL13"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("5cc987d0-4a3a-4b01-b31a-c99219ece5e2"),
+											ResourceID:     to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL13"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationType("Create")),
+											SubscriptionID: to.Ptr("1d04e8f1-ee04-4056-b0b2-718f5bb45b04"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-05-23T22:51:50.1355051+00:00"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
+											State:          to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
+											TimeZone:       to.Ptr("UTC"),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](3),
+												RetryWindowInMinutes: to.Ptr[int32](10),
+											},
+										},
+									},
+									{
+										ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL14"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("5cc987d0-4a3a-4b01-b31a-c99219ece5e2"),
+											ResourceID:     to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL14"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationType("Create")),
+											SubscriptionID: to.Ptr("1d04e8f1-ee04-4056-b0b2-718f5bb45b04"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-05-23T22:51:50.1355051+00:00"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
+											State:          to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
+											TimeZone:       to.Ptr("UTC"),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](3),
+												RetryWindowInMinutes: to.Ptr[int32](10),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("Create Resource response"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -379,62 +458,64 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteCreate_scheduledActions
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteCreateResponse{
-	// 	CreateResourceOperationResponse: armcomputeschedule.CreateResourceOperationResponse{
-	// 		Type: to.Ptr("virtualmachines"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL13"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("5cc987d0-4a3a-4b01-b31a-c99219ece5e2"),
-	// 					ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL13"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationType("Create")),
-	// 					SubscriptionID: to.Ptr("1d04e8f1-ee04-4056-b0b2-718f5bb45b04"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-05-23T22:51:50.1355051+00:00"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
-	// 					State: to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
-	// 					TimeZone: to.Ptr("UTC"),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](3),
-	// 						RetryWindowInMinutes: to.Ptr[int32](10),
-	// 					},
-	// 				},
-	// 			},
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL14"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("5cc987d0-4a3a-4b01-b31a-c99219ece5e2"),
-	// 					ResourceID: to.Ptr("/subscriptions/1d04e8f1-ee04-4056-b0b2-718f5bb45b04/resourceGroups/RG5ABF491C-3164-42A6-8CB5-BF3CB53B018B/providers/Microsoft.Compute/virtualMachines/TL14"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationType("Create")),
-	// 					SubscriptionID: to.Ptr("1d04e8f1-ee04-4056-b0b2-718f5bb45b04"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-05-23T22:51:50.1355051+00:00"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
-	// 					State: to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
-	// 					TimeZone: to.Ptr("UTC"),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](3),
-	// 						RetryWindowInMinutes: to.Ptr[int32](10),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("Create Resource response"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: virtualmachines
+	// Location: eastus
+	// Description: Create Resource response
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteDeallocate_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteDeallocate_scheduledActionsVirtualMachinesExecuteDeallocateMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteDeallocate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteDeallocateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeallocateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeallocateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeallocateResponse{
+							DeallocateResourceOperationResponse: armcomputeschedule.DeallocateResourceOperationResponse{
+								Type:     to.Ptr("fpnhqvrtbqizlylnwy"),
+								Location: to.Ptr("hhioerbsucdqayxk"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr("ynukyltendgmn"),
+										ErrorDetails: to.Ptr("tifeuh"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("vppyaxq"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
+											SubscriptionID: to.Ptr("vofvsus"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
+											State:          to.Ptr(armcomputeschedule.OperationStateUnknown),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											ResourceOperationError: &armcomputeschedule.ResourceOperationError{
+												ErrorCode:    to.Ptr("fagfsojftlff"),
+												ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
+											},
+											CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("hjkgtlph"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -456,53 +537,37 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteDeallocate_scheduledAct
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeallocateResponse{
-	// 	DeallocateResourceOperationResponse: armcomputeschedule.DeallocateResourceOperationResponse{
-	// 		Type: to.Ptr("fpnhqvrtbqizlylnwy"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr("ynukyltendgmn"),
-	// 				ErrorDetails: to.Ptr("tifeuh"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("vppyaxq"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
-	// 					SubscriptionID: to.Ptr("vofvsus"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
-	// 					State: to.Ptr(armcomputeschedule.OperationStateUnknown),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					ResourceOperationError: &armcomputeschedule.ResourceOperationError{
-	// 						ErrorCode: to.Ptr("fagfsojftlff"),
-	// 						ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
-	// 					},
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("hjkgtlph"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: fpnhqvrtbqizlylnwy
+	// Location: hhioerbsucdqayxk
+	// Description: hjkgtlph
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteDeallocate_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteDeallocate_scheduledActionsVirtualMachinesExecuteDeallocateMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteDeallocate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteDeallocateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeallocateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeallocateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeallocateResponse{
+							DeallocateResourceOperationResponse: armcomputeschedule.DeallocateResourceOperationResponse{
+								Type:        to.Ptr("fpnhqvrtbqizlylnwy"),
+								Location:    to.Ptr("hhioerbsucdqayxk"),
+								Description: to.Ptr("hjkgtlph"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -518,26 +583,81 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteDeallocate_scheduledAct
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeallocateResponse{
-	// 	DeallocateResourceOperationResponse: armcomputeschedule.DeallocateResourceOperationResponse{
-	// 		Type: to.Ptr("fpnhqvrtbqizlylnwy"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Description: to.Ptr("hjkgtlph"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: fpnhqvrtbqizlylnwy
+	// Location: hhioerbsucdqayxk
+	// Description: hjkgtlph
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteDelete_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteDelete_scheduledActionsVirtualMachinesExecuteDeleteMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteDelete: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteDeleteRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeleteOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeleteResponse{
+							DeleteResourceOperationResponse: armcomputeschedule.DeleteResourceOperationResponse{
+								Type:     to.Ptr("virtualmachines"),
+								Location: to.Ptr("eastus"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr("ynukyltendgmn"),
+										ErrorDetails: to.Ptr("tifeuh"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("0505H8E4-D41A-48FB-9CA5-4AF8D93BE75F"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationType("Delete")),
+											SubscriptionID: to.Ptr("YourSubscriptionId"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
+											State:          to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											CompletedAt:    to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource4"),
+										ErrorCode:    to.Ptr(""),
+										ErrorDetails: to.Ptr(""),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("Create Resource request"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource4"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationType("Delete")),
+											SubscriptionID: to.Ptr("YourSubscriptionId"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
+											State:          to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											CompletedAt:    to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](2),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("Delete Resource response"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -560,70 +680,81 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteDelete_scheduledActions
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeleteResponse{
-	// 	DeleteResourceOperationResponse: armcomputeschedule.DeleteResourceOperationResponse{
-	// 		Type: to.Ptr("virtualmachines"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr("ynukyltendgmn"),
-	// 				ErrorDetails: to.Ptr("tifeuh"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("0505H8E4-D41A-48FB-9CA5-4AF8D93BE75F"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationType("Delete")),
-	// 					SubscriptionID: to.Ptr("YourSubscriptionId"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
-	// 					State: to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource4"),
-	// 				ErrorCode: to.Ptr(""),
-	// 				ErrorDetails: to.Ptr(""),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("Create Resource request"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource4"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationType("Delete")),
-	// 					SubscriptionID: to.Ptr("YourSubscriptionId"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
-	// 					State: to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](2),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("Delete Resource response"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: virtualmachines
+	// Location: eastus
+	// Description: Delete Resource response
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteDelete_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteDelete_scheduledActionsVirtualMachinesExecuteDeleteMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteDelete: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteDeleteRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeleteOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeleteResponse{
+							DeleteResourceOperationResponse: armcomputeschedule.DeleteResourceOperationResponse{
+								Type:     to.Ptr("virtualMachines"),
+								Location: to.Ptr("eastus"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr("ynukyltendgmn"),
+										ErrorDetails: to.Ptr("tifeuh"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("0505H8E4-D41A-48FB-9CA5-4AF8D93BE75F"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationType("Delete")),
+											SubscriptionID: to.Ptr("YourSubscriptionId"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
+											State:          to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											CompletedAt:    to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource4"),
+										ErrorCode:    to.Ptr(""),
+										ErrorDetails: to.Ptr(""),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("Create Resource request"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource4"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationType("Delete")),
+											SubscriptionID: to.Ptr("YourSubscriptionId"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
+											State:          to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											CompletedAt:    to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](2),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("Delete Resource response"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -639,70 +770,64 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteDelete_scheduledActions
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteDeleteResponse{
-	// 	DeleteResourceOperationResponse: armcomputeschedule.DeleteResourceOperationResponse{
-	// 		Type: to.Ptr("virtualMachines"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr("ynukyltendgmn"),
-	// 				ErrorDetails: to.Ptr("tifeuh"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("0505H8E4-D41A-48FB-9CA5-4AF8D93BE75F"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationType("Delete")),
-	// 					SubscriptionID: to.Ptr("YourSubscriptionId"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
-	// 					State: to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource4"),
-	// 				ErrorCode: to.Ptr(""),
-	// 				ErrorDetails: to.Ptr(""),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("Create Resource request"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource4"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationType("Delete")),
-	// 					SubscriptionID: to.Ptr("YourSubscriptionId"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeInitiateAt),
-	// 					State: to.Ptr(armcomputeschedule.OperationStatePendingScheduling),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](2),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("Delete Resource response"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: virtualMachines
+	// Location: eastus
+	// Description: Delete Resource response
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteHibernate_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteHibernate_scheduledActionsVirtualMachinesExecuteHibernateMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteHibernate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteHibernateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteHibernateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteHibernateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteHibernateResponse{
+							HibernateResourceOperationResponse: armcomputeschedule.HibernateResourceOperationResponse{
+								Type:     to.Ptr("yrmuumqaqiyotst"),
+								Location: to.Ptr("hhioerbsucdqayxk"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr("ynukyltendgmn"),
+										ErrorDetails: to.Ptr("tifeuh"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("vppyaxq"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
+											SubscriptionID: to.Ptr("vofvsus"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
+											State:          to.Ptr(armcomputeschedule.OperationStateUnknown),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											ResourceOperationError: &armcomputeschedule.ResourceOperationError{
+												ErrorCode:    to.Ptr("fagfsojftlff"),
+												ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
+											},
+											CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("ploigcuqj"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -724,53 +849,37 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteHibernate_scheduledActi
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteHibernateResponse{
-	// 	HibernateResourceOperationResponse: armcomputeschedule.HibernateResourceOperationResponse{
-	// 		Type: to.Ptr("yrmuumqaqiyotst"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr("ynukyltendgmn"),
-	// 				ErrorDetails: to.Ptr("tifeuh"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("vppyaxq"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
-	// 					SubscriptionID: to.Ptr("vofvsus"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
-	// 					State: to.Ptr(armcomputeschedule.OperationStateUnknown),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					ResourceOperationError: &armcomputeschedule.ResourceOperationError{
-	// 						ErrorCode: to.Ptr("fagfsojftlff"),
-	// 						ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
-	// 					},
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("ploigcuqj"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: yrmuumqaqiyotst
+	// Location: hhioerbsucdqayxk
+	// Description: ploigcuqj
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteHibernate_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteHibernate_scheduledActionsVirtualMachinesExecuteHibernateMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteHibernate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteHibernateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteHibernateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteHibernateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteHibernateResponse{
+							HibernateResourceOperationResponse: armcomputeschedule.HibernateResourceOperationResponse{
+								Type:        to.Ptr("yrmuumqaqiyotst"),
+								Location:    to.Ptr("hhioerbsucdqayxk"),
+								Description: to.Ptr("ploigcuqj"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -786,26 +895,64 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteHibernate_scheduledActi
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteHibernateResponse{
-	// 	HibernateResourceOperationResponse: armcomputeschedule.HibernateResourceOperationResponse{
-	// 		Type: to.Ptr("yrmuumqaqiyotst"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Description: to.Ptr("ploigcuqj"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: yrmuumqaqiyotst
+	// Location: hhioerbsucdqayxk
+	// Description: ploigcuqj
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteStart_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteStart_scheduledActionsVirtualMachinesExecuteStartMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteStart: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteStartRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteStartOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteStartResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteStartResponse{
+							StartResourceOperationResponse: armcomputeschedule.StartResourceOperationResponse{
+								Type:     to.Ptr("lcikdomhndewkiqpf"),
+								Location: to.Ptr("hhioerbsucdqayxk"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr("ynukyltendgmn"),
+										ErrorDetails: to.Ptr("tifeuh"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("vppyaxq"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
+											SubscriptionID: to.Ptr("vofvsus"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
+											State:          to.Ptr(armcomputeschedule.OperationStateUnknown),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											ResourceOperationError: &armcomputeschedule.ResourceOperationError{
+												ErrorCode:    to.Ptr("fagfsojftlff"),
+												ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
+											},
+											CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("gzgowsi"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -827,53 +974,37 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteStart_scheduledActionsV
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteStartResponse{
-	// 	StartResourceOperationResponse: armcomputeschedule.StartResourceOperationResponse{
-	// 		Type: to.Ptr("lcikdomhndewkiqpf"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr("ynukyltendgmn"),
-	// 				ErrorDetails: to.Ptr("tifeuh"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("vppyaxq"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
-	// 					SubscriptionID: to.Ptr("vofvsus"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
-	// 					State: to.Ptr(armcomputeschedule.OperationStateUnknown),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					ResourceOperationError: &armcomputeschedule.ResourceOperationError{
-	// 						ErrorCode: to.Ptr("fagfsojftlff"),
-	// 						ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
-	// 					},
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("gzgowsi"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: lcikdomhndewkiqpf
+	// Location: hhioerbsucdqayxk
+	// Description: gzgowsi
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesExecuteStart_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesExecuteStart_scheduledActionsVirtualMachinesExecuteStartMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesExecuteStart: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.ExecuteStartRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteStartOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteStartResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteStartResponse{
+							StartResourceOperationResponse: armcomputeschedule.StartResourceOperationResponse{
+								Type:        to.Ptr("lcikdomhndewkiqpf"),
+								Location:    to.Ptr("hhioerbsucdqayxk"),
+								Description: to.Ptr("gzgowsi"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -889,26 +1020,54 @@ func ExampleScheduledActionsClient_VirtualMachinesExecuteStart_scheduledActionsV
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesExecuteStartResponse{
-	// 	StartResourceOperationResponse: armcomputeschedule.StartResourceOperationResponse{
-	// 		Type: to.Ptr("lcikdomhndewkiqpf"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Description: to.Ptr("gzgowsi"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: lcikdomhndewkiqpf
+	// Location: hhioerbsucdqayxk
+	// Description: gzgowsi
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesGetOperationErrors_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesGetOperationErrors_scheduledActionsVirtualMachinesGetOperationErrorsMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesGetOperationErrors: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.GetOperationErrorsRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationErrorsOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationErrorsResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationErrorsResponse{
+							GetOperationErrorsResponse: armcomputeschedule.GetOperationErrorsResponse{
+								Results: []*armcomputeschedule.OperationErrorsResult{
+									{
+										OperationID:    to.Ptr("emftjglfbsxaboxqzxlpbjian"),
+										CreationTime:   to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.130Z"); return t }()),
+										ActivationTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.130Z"); return t }()),
+										CompletedAt:    to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.130Z"); return t }()),
+										OperationErrors: []*armcomputeschedule.OperationErrorDetails{
+											{
+												ErrorCode:          to.Ptr("awrovoihpnqsotznapyrrb"),
+												ErrorDetails:       to.Ptr("af"),
+												Timestamp:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.131Z"); return t }()),
+												TimeStamp:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.131Z"); return t }()),
+												AzureOperationName: to.Ptr("ipsoybbslitmwsfkygfjhb"),
+												CrpOperationID:     to.Ptr("cqkmbfb"),
+											},
+										},
+										RequestErrorCode:    to.Ptr("vzixjphgeygyhzpbbkgzcjol"),
+										RequestErrorDetails: to.Ptr("ficjafazcvbmlbnqhffwtevkla"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -922,41 +1081,31 @@ func ExampleScheduledActionsClient_VirtualMachinesGetOperationErrors_scheduledAc
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationErrorsResponse{
-	// 	GetOperationErrorsResponse: armcomputeschedule.GetOperationErrorsResponse{
-	// 		Results: []*armcomputeschedule.OperationErrorsResult{
-	// 			{
-	// 				OperationID: to.Ptr("emftjglfbsxaboxqzxlpbjian"),
-	// 				CreationTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.130Z"); return t}()),
-	// 				ActivationTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.130Z"); return t}()),
-	// 				CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.130Z"); return t}()),
-	// 				OperationErrors: []*armcomputeschedule.OperationErrorDetails{
-	// 					{
-	// 						ErrorCode: to.Ptr("awrovoihpnqsotznapyrrb"),
-	// 						ErrorDetails: to.Ptr("af"),
-	// 						Timestamp: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.131Z"); return t}()),
-	// 						TimeStamp: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:04.131Z"); return t}()),
-	// 						AzureOperationName: to.Ptr("ipsoybbslitmwsfkygfjhb"),
-	// 						CrpOperationID: to.Ptr("cqkmbfb"),
-	// 					},
-	// 				},
-	// 				RequestErrorCode: to.Ptr("vzixjphgeygyhzpbbkgzcjol"),
-	// 				RequestErrorDetails: to.Ptr("ficjafazcvbmlbnqhffwtevkla"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesGetOperationErrors_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesGetOperationErrors_scheduledActionsVirtualMachinesGetOperationErrorsMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesGetOperationErrors: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.GetOperationErrorsRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationErrorsOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationErrorsResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationErrorsResponse{
+							GetOperationErrorsResponse: armcomputeschedule.GetOperationErrorsResponse{
+								Results: []*armcomputeschedule.OperationErrorsResult{
+									{},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -970,25 +1119,55 @@ func ExampleScheduledActionsClient_VirtualMachinesGetOperationErrors_scheduledAc
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationErrorsResponse{
-	// 	GetOperationErrorsResponse: armcomputeschedule.GetOperationErrorsResponse{
-	// 		Results: []*armcomputeschedule.OperationErrorsResult{
-	// 			{
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesGetOperationStatus_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesGetOperationStatus_scheduledActionsVirtualMachinesGetOperationStatusMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesGetOperationStatus: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.GetOperationStatusRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationStatusOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationStatusResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationStatusResponse{
+							GetOperationStatusResponse: armcomputeschedule.GetOperationStatusResponse{
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr("ynukyltendgmn"),
+										ErrorDetails: to.Ptr("tifeuh"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("vppyaxq"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
+											SubscriptionID: to.Ptr("vofvsus"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
+											State:          to.Ptr(armcomputeschedule.OperationStateUnknown),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											ResourceOperationError: &armcomputeschedule.ResourceOperationError{
+												ErrorCode:    to.Ptr("fagfsojftlff"),
+												ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
+											},
+											CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -1003,48 +1182,31 @@ func ExampleScheduledActionsClient_VirtualMachinesGetOperationStatus_scheduledAc
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationStatusResponse{
-	// 	GetOperationStatusResponse: armcomputeschedule.GetOperationStatusResponse{
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr("ynukyltendgmn"),
-	// 				ErrorDetails: to.Ptr("tifeuh"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("vppyaxq"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
-	// 					SubscriptionID: to.Ptr("vofvsus"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
-	// 					State: to.Ptr(armcomputeschedule.OperationStateUnknown),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					ResourceOperationError: &armcomputeschedule.ResourceOperationError{
-	// 						ErrorCode: to.Ptr("fagfsojftlff"),
-	// 						ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
-	// 					},
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesGetOperationStatus_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesGetOperationStatus_scheduledActionsVirtualMachinesGetOperationStatusMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesGetOperationStatus: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.GetOperationStatusRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationStatusOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationStatusResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationStatusResponse{
+							GetOperationStatusResponse: armcomputeschedule.GetOperationStatusResponse{
+								Results: []*armcomputeschedule.ResourceOperation{
+									{},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -1059,25 +1221,58 @@ func ExampleScheduledActionsClient_VirtualMachinesGetOperationStatus_scheduledAc
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesGetOperationStatusResponse{
-	// 	GetOperationStatusResponse: armcomputeschedule.GetOperationStatusResponse{
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesSubmitDeallocate_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesSubmitDeallocate_scheduledActionsVirtualMachinesSubmitDeallocateMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesSubmitDeallocate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.SubmitDeallocateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitDeallocateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitDeallocateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitDeallocateResponse{
+							DeallocateResourceOperationResponse: armcomputeschedule.DeallocateResourceOperationResponse{
+								Type:     to.Ptr("fpnhqvrtbqizlylnwy"),
+								Location: to.Ptr("hhioerbsucdqayxk"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr("ynukyltendgmn"),
+										ErrorDetails: to.Ptr("tifeuh"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("vppyaxq"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
+											SubscriptionID: to.Ptr("vofvsus"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
+											State:          to.Ptr(armcomputeschedule.OperationStateUnknown),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											ResourceOperationError: &armcomputeschedule.ResourceOperationError{
+												ErrorCode:    to.Ptr("fagfsojftlff"),
+												ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
+											},
+											CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("hjkgtlph"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -1106,53 +1301,37 @@ func ExampleScheduledActionsClient_VirtualMachinesSubmitDeallocate_scheduledActi
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitDeallocateResponse{
-	// 	DeallocateResourceOperationResponse: armcomputeschedule.DeallocateResourceOperationResponse{
-	// 		Type: to.Ptr("fpnhqvrtbqizlylnwy"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr("ynukyltendgmn"),
-	// 				ErrorDetails: to.Ptr("tifeuh"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("vppyaxq"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
-	// 					SubscriptionID: to.Ptr("vofvsus"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
-	// 					State: to.Ptr(armcomputeschedule.OperationStateUnknown),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					ResourceOperationError: &armcomputeschedule.ResourceOperationError{
-	// 						ErrorCode: to.Ptr("fagfsojftlff"),
-	// 						ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
-	// 					},
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("hjkgtlph"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: fpnhqvrtbqizlylnwy
+	// Location: hhioerbsucdqayxk
+	// Description: hjkgtlph
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesSubmitDeallocate_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesSubmitDeallocate_scheduledActionsVirtualMachinesSubmitDeallocateMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesSubmitDeallocate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.SubmitDeallocateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitDeallocateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitDeallocateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitDeallocateResponse{
+							DeallocateResourceOperationResponse: armcomputeschedule.DeallocateResourceOperationResponse{
+								Type:        to.Ptr("fpnhqvrtbqizlylnwy"),
+								Location:    to.Ptr("hhioerbsucdqayxk"),
+								Description: to.Ptr("hjkgtlph"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -1171,26 +1350,64 @@ func ExampleScheduledActionsClient_VirtualMachinesSubmitDeallocate_scheduledActi
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitDeallocateResponse{
-	// 	DeallocateResourceOperationResponse: armcomputeschedule.DeallocateResourceOperationResponse{
-	// 		Type: to.Ptr("fpnhqvrtbqizlylnwy"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Description: to.Ptr("hjkgtlph"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: fpnhqvrtbqizlylnwy
+	// Location: hhioerbsucdqayxk
+	// Description: hjkgtlph
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesSubmitHibernate_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesSubmitHibernate_scheduledActionsVirtualMachinesSubmitHibernateMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesSubmitHibernate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.SubmitHibernateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitHibernateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitHibernateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitHibernateResponse{
+							HibernateResourceOperationResponse: armcomputeschedule.HibernateResourceOperationResponse{
+								Type:     to.Ptr("yrmuumqaqiyotst"),
+								Location: to.Ptr("hhioerbsucdqayxk"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr("ynukyltendgmn"),
+										ErrorDetails: to.Ptr("tifeuh"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("vppyaxq"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
+											SubscriptionID: to.Ptr("vofvsus"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
+											State:          to.Ptr(armcomputeschedule.OperationStateUnknown),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											ResourceOperationError: &armcomputeschedule.ResourceOperationError{
+												ErrorCode:    to.Ptr("fagfsojftlff"),
+												ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
+											},
+											CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("ploigcuqj"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -1219,53 +1436,37 @@ func ExampleScheduledActionsClient_VirtualMachinesSubmitHibernate_scheduledActio
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitHibernateResponse{
-	// 	HibernateResourceOperationResponse: armcomputeschedule.HibernateResourceOperationResponse{
-	// 		Type: to.Ptr("yrmuumqaqiyotst"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr("ynukyltendgmn"),
-	// 				ErrorDetails: to.Ptr("tifeuh"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("vppyaxq"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
-	// 					SubscriptionID: to.Ptr("vofvsus"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
-	// 					State: to.Ptr(armcomputeschedule.OperationStateUnknown),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					ResourceOperationError: &armcomputeschedule.ResourceOperationError{
-	// 						ErrorCode: to.Ptr("fagfsojftlff"),
-	// 						ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
-	// 					},
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("ploigcuqj"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: yrmuumqaqiyotst
+	// Location: hhioerbsucdqayxk
+	// Description: ploigcuqj
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesSubmitHibernate_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesSubmitHibernate_scheduledActionsVirtualMachinesSubmitHibernateMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesSubmitHibernate: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.SubmitHibernateRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitHibernateOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitHibernateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitHibernateResponse{
+							HibernateResourceOperationResponse: armcomputeschedule.HibernateResourceOperationResponse{
+								Type:        to.Ptr("yrmuumqaqiyotst"),
+								Location:    to.Ptr("hhioerbsucdqayxk"),
+								Description: to.Ptr("ploigcuqj"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -1284,26 +1485,64 @@ func ExampleScheduledActionsClient_VirtualMachinesSubmitHibernate_scheduledActio
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitHibernateResponse{
-	// 	HibernateResourceOperationResponse: armcomputeschedule.HibernateResourceOperationResponse{
-	// 		Type: to.Ptr("yrmuumqaqiyotst"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Description: to.Ptr("ploigcuqj"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: yrmuumqaqiyotst
+	// Location: hhioerbsucdqayxk
+	// Description: ploigcuqj
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesSubmitStart_MaximumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesSubmitStart_scheduledActionsVirtualMachinesSubmitStartMaximumSetGenGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesSubmitStart: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.SubmitStartRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitStartOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitStartResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitStartResponse{
+							StartResourceOperationResponse: armcomputeschedule.StartResourceOperationResponse{
+								Type:     to.Ptr("lcikdomhndewkiqpf"),
+								Location: to.Ptr("hhioerbsucdqayxk"),
+								Results: []*armcomputeschedule.ResourceOperation{
+									{
+										ResourceID:   to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+										ErrorCode:    to.Ptr("ynukyltendgmn"),
+										ErrorDetails: to.Ptr("tifeuh"),
+										Operation: &armcomputeschedule.ResourceOperationDetails{
+											OperationID:    to.Ptr("vppyaxq"),
+											ResourceID:     to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
+											OpType:         to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
+											SubscriptionID: to.Ptr("vofvsus"),
+											Deadline:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											DeadlineType:   to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
+											State:          to.Ptr(armcomputeschedule.OperationStateUnknown),
+											Timezone:       to.Ptr("nwugsooykqggcokphgdj"),
+											TimeZone:       to.Ptr("qkxnxnumvfqmsmpyccv"),
+											ResourceOperationError: &armcomputeschedule.ResourceOperationError{
+												ErrorCode:    to.Ptr("fagfsojftlff"),
+												ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
+											},
+											CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t }()),
+											RetryPolicy: &armcomputeschedule.RetryPolicy{
+												RetryCount:           to.Ptr[int32](25),
+												RetryWindowInMinutes: to.Ptr[int32](4),
+											},
+										},
+									},
+								},
+								Description: to.Ptr("gzgowsi"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -1332,53 +1571,37 @@ func ExampleScheduledActionsClient_VirtualMachinesSubmitStart_scheduledActionsVi
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitStartResponse{
-	// 	StartResourceOperationResponse: armcomputeschedule.StartResourceOperationResponse{
-	// 		Type: to.Ptr("lcikdomhndewkiqpf"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Results: []*armcomputeschedule.ResourceOperation{
-	// 			{
-	// 				ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 				ErrorCode: to.Ptr("ynukyltendgmn"),
-	// 				ErrorDetails: to.Ptr("tifeuh"),
-	// 				Operation: &armcomputeschedule.ResourceOperationDetails{
-	// 					OperationID: to.Ptr("vppyaxq"),
-	// 					ResourceID: to.Ptr("/subscriptions/YourSubscriptionId/resourceGroups/YourResourceGroupName/providers/Microsoft.Compute/virtualMachines/testResource3"),
-	// 					OpType: to.Ptr(armcomputeschedule.ResourceOperationTypeUnknown),
-	// 					SubscriptionID: to.Ptr("vofvsus"),
-	// 					Deadline: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					DeadlineType: to.Ptr(armcomputeschedule.DeadlineTypeUnknown),
-	// 					State: to.Ptr(armcomputeschedule.OperationStateUnknown),
-	// 					Timezone: to.Ptr("nwugsooykqggcokphgdj"),
-	// 					TimeZone: to.Ptr("qkxnxnumvfqmsmpyccv"),
-	// 					ResourceOperationError: &armcomputeschedule.ResourceOperationError{
-	// 						ErrorCode: to.Ptr("fagfsojftlff"),
-	// 						ErrorDetails: to.Ptr("rtihrkjasrjkllqccuysjrg"),
-	// 					},
-	// 					CompletedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2025-04-15T19:47:03.591Z"); return t}()),
-	// 					RetryPolicy: &armcomputeschedule.RetryPolicy{
-	// 						RetryCount: to.Ptr[int32](25),
-	// 						RetryWindowInMinutes: to.Ptr[int32](4),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		Description: to.Ptr("gzgowsi"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: lcikdomhndewkiqpf
+	// Location: hhioerbsucdqayxk
+	// Description: gzgowsi
 }
 
 // Generated from example definition: 2025-05-01/ScheduledActions_VirtualMachinesSubmitStart_MinimumSet_Gen.json
 func ExampleScheduledActionsClient_VirtualMachinesSubmitStart_scheduledActionsVirtualMachinesSubmitStartMinimumSetGenGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, nil)
+	clientFactory, err := armcomputeschedule.NewClientFactory("0505D8E4-D41A-48FB-9CA5-4AF8D93BE75F", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ScheduledActionsServer: fake.ScheduledActionsServer{
+					VirtualMachinesSubmitStart: func(ctx context.Context, locationparameter string, requestBody armcomputeschedule.SubmitStartRequest, options *armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitStartOptions) (resp azfake.Responder[armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitStartResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitStartResponse{
+							StartResourceOperationResponse: armcomputeschedule.StartResourceOperationResponse{
+								Type:        to.Ptr("lcikdomhndewkiqpf"),
+								Location:    to.Ptr("hhioerbsucdqayxk"),
+								Description: to.Ptr("gzgowsi"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -1397,14 +1620,11 @@ func ExampleScheduledActionsClient_VirtualMachinesSubmitStart_scheduledActionsVi
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcomputeschedule.ScheduledActionsClientVirtualMachinesSubmitStartResponse{
-	// 	StartResourceOperationResponse: armcomputeschedule.StartResourceOperationResponse{
-	// 		Type: to.Ptr("lcikdomhndewkiqpf"),
-	// 		Location: to.Ptr("hhioerbsucdqayxk"),
-	// 		Description: to.Ptr("gzgowsi"),
-	// 	},
-	// }
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Description:", *res.Description)
+	// Output:
+	// Type: lcikdomhndewkiqpf
+	// Location: hhioerbsucdqayxk
+	// Description: gzgowsi
 }
@@ -6,20 +6,45 @@ package armcontainerorchestratorruntime_test
 
 import (
 	"armcontainerorchestratorruntime"
+	"armcontainerorchestratorruntime/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-03-01/BgpPeers_CreateOrUpdate.json
 func ExampleBgpPeersClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				BgpPeersServer: fake.BgpPeersServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceURI string, bgpPeerName string, resource armcontainerorchestratorruntime.BgpPeer, options *armcontainerorchestratorruntime.BgpPeersClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcontainerorchestratorruntime.BgpPeersClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcontainerorchestratorruntime.BgpPeersClientCreateOrUpdateResponse{
+							BgpPeer: armcontainerorchestratorruntime.BgpPeer{
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/bgpPeers/testpeer"),
+								Name: to.Ptr("testpeer"),
+								Type: to.Ptr("Microsoft.KubernetesRuntime/BgpPeers"),
+								Properties: &armcontainerorchestratorruntime.BgpPeerProperties{
+									ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
+									MyAsn:             to.Ptr[int32](64500),
+									PeerAsn:           to.Ptr[int32](64501),
+									PeerAddress:       to.Ptr("10.0.0.1"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -37,32 +62,31 @@ func ExampleBgpPeersClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.BgpPeersClientCreateOrUpdateResponse{
-	// 	BgpPeer: armcontainerorchestratorruntime.BgpPeer{
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/bgpPeers/testpeer"),
-	// 		Name: to.Ptr("testpeer"),
-	// 		Type: to.Ptr("Microsoft.KubernetesRuntime/BgpPeers"),
-	// 		Properties: &armcontainerorchestratorruntime.BgpPeerProperties{
-	// 			ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
-	// 			MyAsn: to.Ptr[int32](64500),
-	// 			PeerAsn: to.Ptr[int32](64501),
-	// 			PeerAddress: to.Ptr("10.0.0.1"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/bgpPeers/testpeer
+	// Name: testpeer
+	// Type: Microsoft.KubernetesRuntime/BgpPeers
 }
 
 // Generated from example definition: 2024-03-01/BgpPeers_Delete.json
 func ExampleBgpPeersClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				BgpPeersServer: fake.BgpPeersServer{
+					Delete: func(ctx context.Context, resourceURI string, bgpPeerName string, options *armcontainerorchestratorruntime.BgpPeersClientDeleteOptions) (resp azfake.Responder[armcontainerorchestratorruntime.BgpPeersClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcontainerorchestratorruntime.BgpPeersClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -72,19 +96,37 @@ func ExampleBgpPeersClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.BgpPeersClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-01/BgpPeers_Get.json
 func ExampleBgpPeersClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				BgpPeersServer: fake.BgpPeersServer{
+					Get: func(ctx context.Context, resourceURI string, bgpPeerName string, options *armcontainerorchestratorruntime.BgpPeersClientGetOptions) (resp azfake.Responder[armcontainerorchestratorruntime.BgpPeersClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcontainerorchestratorruntime.BgpPeersClientGetResponse{
+							BgpPeer: armcontainerorchestratorruntime.BgpPeer{
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/bgpPeers/testpeer"),
+								Name: to.Ptr("testpeer"),
+								Type: to.Ptr("Microsoft.KubernetesRuntime/BgpPeers"),
+								Properties: &armcontainerorchestratorruntime.BgpPeerProperties{
+									ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
+									MyAsn:             to.Ptr[int32](64500),
+									PeerAsn:           to.Ptr[int32](64501),
+									PeerAddress:       to.Ptr("10.0.0.1"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -92,32 +134,47 @@ func ExampleBgpPeersClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.BgpPeersClientGetResponse{
-	// 	BgpPeer: armcontainerorchestratorruntime.BgpPeer{
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/bgpPeers/testpeer"),
-	// 		Name: to.Ptr("testpeer"),
-	// 		Type: to.Ptr("Microsoft.KubernetesRuntime/BgpPeers"),
-	// 		Properties: &armcontainerorchestratorruntime.BgpPeerProperties{
-	// 			ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
-	// 			MyAsn: to.Ptr[int32](64500),
-	// 			PeerAsn: to.Ptr[int32](64501),
-	// 			PeerAddress: to.Ptr("10.0.0.1"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/bgpPeers/testpeer
+	// Name: testpeer
+	// Type: Microsoft.KubernetesRuntime/BgpPeers
 }
 
 // Generated from example definition: 2024-03-01/BgpPeers_List.json
 func ExampleBgpPeersClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				BgpPeersServer: fake.BgpPeersServer{
+					NewListPager: func(resourceURI string, options *armcontainerorchestratorruntime.BgpPeersClientListOptions) (resp azfake.PagerResponder[armcontainerorchestratorruntime.BgpPeersClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcontainerorchestratorruntime.BgpPeersClientListResponse{
+							BgpPeerListResult: armcontainerorchestratorruntime.BgpPeerListResult{
+								Value: []*armcontainerorchestratorruntime.BgpPeer{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/bgpPeers/testpeer"),
+										Name: to.Ptr("testpeer"),
+										Type: to.Ptr("Microsoft.KubernetesRuntime/BgpPeers"),
+										Properties: &armcontainerorchestratorruntime.BgpPeerProperties{
+											ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
+											MyAsn:             to.Ptr[int32](64500),
+											PeerAsn:           to.Ptr[int32](64501),
+											PeerAddress:       to.Ptr("10.0.0.1"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -128,26 +185,13 @@ func ExampleBgpPeersClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcontainerorchestratorruntime.BgpPeersClientListResponse{
-		// 	BgpPeerListResult: armcontainerorchestratorruntime.BgpPeerListResult{
-		// 		Value: []*armcontainerorchestratorruntime.BgpPeer{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/bgpPeers/testpeer"),
-		// 				Name: to.Ptr("testpeer"),
-		// 				Type: to.Ptr("Microsoft.KubernetesRuntime/BgpPeers"),
-		// 				Properties: &armcontainerorchestratorruntime.BgpPeerProperties{
-		// 					ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
-		// 					MyAsn: to.Ptr[int32](64500),
-		// 					PeerAsn: to.Ptr[int32](64501),
-		// 					PeerAddress: to.Ptr("10.0.0.1"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/bgpPeers/testpeer
+	// Name: testpeer
+	// Type: Microsoft.KubernetesRuntime/BgpPeers
 }
@@ -6,20 +6,50 @@ package armcontainerorchestratorruntime_test
 
 import (
 	"armcontainerorchestratorruntime"
+	"armcontainerorchestratorruntime/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-03-01/LoadBalancers_CreateOrUpdate.json
 func ExampleLoadBalancersClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadBalancersServer: fake.LoadBalancersServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceURI string, loadBalancerName string, resource armcontainerorchestratorruntime.LoadBalancer, options *armcontainerorchestratorruntime.LoadBalancersClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcontainerorchestratorruntime.LoadBalancersClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcontainerorchestratorruntime.LoadBalancersClientCreateOrUpdateResponse{
+							LoadBalancer: armcontainerorchestratorruntime.LoadBalancer{
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/loadBalancers/testlb"),
+								Name: to.Ptr("testlb"),
+								Type: to.Ptr("Microsoft.KubernetesRuntime/loadBalancers"),
+								Properties: &armcontainerorchestratorruntime.LoadBalancerProperties{
+									ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
+									Addresses: []*string{
+										to.Ptr("192.168.50.1/24"),
+										to.Ptr("192.168.51.2-192.168.51.10"),
+									},
+									ServiceSelector: map[string]*string{
+										"app": to.Ptr("frontend"),
+									},
+									AdvertiseMode: to.Ptr(armcontainerorchestratorruntime.AdvertiseModeARP),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -42,37 +72,31 @@ func ExampleLoadBalancersClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.LoadBalancersClientCreateOrUpdateResponse{
-	// 	LoadBalancer: armcontainerorchestratorruntime.LoadBalancer{
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/loadBalancers/testlb"),
-	// 		Name: to.Ptr("testlb"),
-	// 		Type: to.Ptr("Microsoft.KubernetesRuntime/loadBalancers"),
-	// 		Properties: &armcontainerorchestratorruntime.LoadBalancerProperties{
-	// 			ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
-	// 			Addresses: []*string{
-	// 				to.Ptr("192.168.50.1/24"),
-	// 				to.Ptr("192.168.51.2-192.168.51.10"),
-	// 			},
-	// 			ServiceSelector: map[string]*string{
-	// 				"app": to.Ptr("frontend"),
-	// 			},
-	// 			AdvertiseMode: to.Ptr(armcontainerorchestratorruntime.AdvertiseModeARP),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/loadBalancers/testlb
+	// Name: testlb
+	// Type: Microsoft.KubernetesRuntime/loadBalancers
 }
 
 // Generated from example definition: 2024-03-01/LoadBalancers_Delete.json
 func ExampleLoadBalancersClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadBalancersServer: fake.LoadBalancersServer{
+					Delete: func(ctx context.Context, resourceURI string, loadBalancerName string, options *armcontainerorchestratorruntime.LoadBalancersClientDeleteOptions) (resp azfake.Responder[armcontainerorchestratorruntime.LoadBalancersClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcontainerorchestratorruntime.LoadBalancersClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -82,19 +106,45 @@ func ExampleLoadBalancersClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.LoadBalancersClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-01/LoadBalancers_Get.json
 func ExampleLoadBalancersClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadBalancersServer: fake.LoadBalancersServer{
+					Get: func(ctx context.Context, resourceURI string, loadBalancerName string, options *armcontainerorchestratorruntime.LoadBalancersClientGetOptions) (resp azfake.Responder[armcontainerorchestratorruntime.LoadBalancersClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcontainerorchestratorruntime.LoadBalancersClientGetResponse{
+							LoadBalancer: armcontainerorchestratorruntime.LoadBalancer{
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/loadBalancers/testlb"),
+								Name: to.Ptr("testlb"),
+								Type: to.Ptr("Microsoft.KubernetesRuntime/loadBalancers"),
+								Properties: &armcontainerorchestratorruntime.LoadBalancerProperties{
+									ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
+									Addresses: []*string{
+										to.Ptr("192.168.50.1/24"),
+										to.Ptr("192.168.51.2-192.168.51.10"),
+									},
+									ServiceSelector: map[string]*string{
+										"app": to.Ptr("frontend"),
+									},
+									AdvertiseMode: to.Ptr(armcontainerorchestratorruntime.AdvertiseModeARP),
+									BgpPeers: []*string{
+										to.Ptr("bgpPeer1"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -102,40 +152,52 @@ func ExampleLoadBalancersClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.LoadBalancersClientGetResponse{
-	// 	LoadBalancer: armcontainerorchestratorruntime.LoadBalancer{
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/loadBalancers/testlb"),
-	// 		Name: to.Ptr("testlb"),
-	// 		Type: to.Ptr("Microsoft.KubernetesRuntime/loadBalancers"),
-	// 		Properties: &armcontainerorchestratorruntime.LoadBalancerProperties{
-	// 			ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
-	// 			Addresses: []*string{
-	// 				to.Ptr("192.168.50.1/24"),
-	// 				to.Ptr("192.168.51.2-192.168.51.10"),
-	// 			},
-	// 			ServiceSelector: map[string]*string{
-	// 				"app": to.Ptr("frontend"),
-	// 			},
-	// 			AdvertiseMode: to.Ptr(armcontainerorchestratorruntime.AdvertiseModeARP),
-	// 			BgpPeers: []*string{
-	// 				to.Ptr("bgpPeer1"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/loadBalancers/testlb
+	// Name: testlb
+	// Type: Microsoft.KubernetesRuntime/loadBalancers
 }
 
 // Generated from example definition: 2024-03-01/LoadBalancers_List.json
 func ExampleLoadBalancersClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadBalancersServer: fake.LoadBalancersServer{
+					NewListPager: func(resourceURI string, options *armcontainerorchestratorruntime.LoadBalancersClientListOptions) (resp azfake.PagerResponder[armcontainerorchestratorruntime.LoadBalancersClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcontainerorchestratorruntime.LoadBalancersClientListResponse{
+							LoadBalancerListResult: armcontainerorchestratorruntime.LoadBalancerListResult{
+								Value: []*armcontainerorchestratorruntime.LoadBalancer{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/loadBalancers/testlb"),
+										Name: to.Ptr("testlb"),
+										Type: to.Ptr("Microsoft.KubernetesRuntime/loadBalancers"),
+										Properties: &armcontainerorchestratorruntime.LoadBalancerProperties{
+											ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
+											Addresses: []*string{
+												to.Ptr("192.168.50.1/24"),
+												to.Ptr("192.168.51.2-192.168.51.10"),
+											},
+											ServiceSelector: map[string]*string{
+												"app": to.Ptr("frontend"),
+											},
+											AdvertiseMode: to.Ptr(armcontainerorchestratorruntime.AdvertiseModeARP),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -146,31 +208,13 @@ func ExampleLoadBalancersClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcontainerorchestratorruntime.LoadBalancersClientListResponse{
-		// 	LoadBalancerListResult: armcontainerorchestratorruntime.LoadBalancerListResult{
-		// 		Value: []*armcontainerorchestratorruntime.LoadBalancer{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/loadBalancers/testlb"),
-		// 				Name: to.Ptr("testlb"),
-		// 				Type: to.Ptr("Microsoft.KubernetesRuntime/loadBalancers"),
-		// 				Properties: &armcontainerorchestratorruntime.LoadBalancerProperties{
-		// 					ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
-		// 					Addresses: []*string{
-		// 						to.Ptr("192.168.50.1/24"),
-		// 						to.Ptr("192.168.51.2-192.168.51.10"),
-		// 					},
-		// 					ServiceSelector: map[string]*string{
-		// 						"app": to.Ptr("frontend"),
-		// 					},
-		// 					AdvertiseMode: to.Ptr(armcontainerorchestratorruntime.AdvertiseModeARP),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/loadBalancers/testlb
+	// Name: testlb
+	// Type: Microsoft.KubernetesRuntime/loadBalancers
 }
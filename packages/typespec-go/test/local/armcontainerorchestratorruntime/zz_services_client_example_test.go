@@ -6,19 +6,42 @@ package armcontainerorchestratorruntime_test
 
 import (
 	"armcontainerorchestratorruntime"
+	"armcontainerorchestratorruntime/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-03-01/Services_CreateOrUpdate.json
 func ExampleServicesClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					CreateOrUpdate: func(ctx context.Context, resourceURI string, serviceName string, resource armcontainerorchestratorruntime.ServiceResource, options *armcontainerorchestratorruntime.ServicesClientCreateOrUpdateOptions) (resp azfake.Responder[armcontainerorchestratorruntime.ServicesClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcontainerorchestratorruntime.ServicesClientCreateOrUpdateResponse{
+							ServiceResource: armcontainerorchestratorruntime.ServiceResource{
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/services/storageclass"),
+								Name: to.Ptr("storageclass"),
+								Type: to.Ptr("Microsoft.KubernetesRuntime/services"),
+								Properties: &armcontainerorchestratorruntime.ServiceProperties{
+									RpObjectID: to.Ptr("00000000-1111-2222-3333-444444444444"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -28,29 +51,31 @@ func ExampleServicesClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.ServicesClientCreateOrUpdateResponse{
-	// 	ServiceResource: armcontainerorchestratorruntime.ServiceResource{
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/services/storageclass"),
-	// 		Name: to.Ptr("storageclass"),
-	// 		Type: to.Ptr("Microsoft.KubernetesRuntime/services"),
-	// 		Properties: &armcontainerorchestratorruntime.ServiceProperties{
-	// 			RpObjectID: to.Ptr("00000000-1111-2222-3333-444444444444"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/services/storageclass
+	// Name: storageclass
+	// Type: Microsoft.KubernetesRuntime/services
 }
 
 // Generated from example definition: 2024-03-01/Services_Delete.json
 func ExampleServicesClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					Delete: func(ctx context.Context, resourceURI string, serviceName string, options *armcontainerorchestratorruntime.ServicesClientDeleteOptions) (resp azfake.Responder[armcontainerorchestratorruntime.ServicesClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcontainerorchestratorruntime.ServicesClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -60,19 +85,34 @@ func ExampleServicesClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.ServicesClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-03-01/Services_Get.json
 func ExampleServicesClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					Get: func(ctx context.Context, resourceURI string, serviceName string, options *armcontainerorchestratorruntime.ServicesClientGetOptions) (resp azfake.Responder[armcontainerorchestratorruntime.ServicesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcontainerorchestratorruntime.ServicesClientGetResponse{
+							ServiceResource: armcontainerorchestratorruntime.ServiceResource{
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/services/storageclass"),
+								Name: to.Ptr("storageclass"),
+								Type: to.Ptr("Microsoft.KubernetesRuntime/services"),
+								Properties: &armcontainerorchestratorruntime.ServiceProperties{
+									RpObjectID: to.Ptr("00000000-1111-2222-3333-444444444444"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -80,29 +120,44 @@ func ExampleServicesClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.ServicesClientGetResponse{
-	// 	ServiceResource: armcontainerorchestratorruntime.ServiceResource{
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/services/storageclass"),
-	// 		Name: to.Ptr("storageclass"),
-	// 		Type: to.Ptr("Microsoft.KubernetesRuntime/services"),
-	// 		Properties: &armcontainerorchestratorruntime.ServiceProperties{
-	// 			RpObjectID: to.Ptr("00000000-1111-2222-3333-444444444444"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/services/storageclass
+	// Name: storageclass
+	// Type: Microsoft.KubernetesRuntime/services
 }
 
 // Generated from example definition: 2024-03-01/Services_List.json
 func ExampleServicesClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ServicesServer: fake.ServicesServer{
+					NewListPager: func(resourceURI string, options *armcontainerorchestratorruntime.ServicesClientListOptions) (resp azfake.PagerResponder[armcontainerorchestratorruntime.ServicesClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcontainerorchestratorruntime.ServicesClientListResponse{
+							ServiceResourceListResult: armcontainerorchestratorruntime.ServiceResourceListResult{
+								Value: []*armcontainerorchestratorruntime.ServiceResource{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/services/storageclass"),
+										Name: to.Ptr("storageclass"),
+										Type: to.Ptr("Microsoft.KubernetesRuntime/Services"),
+										Properties: &armcontainerorchestratorruntime.ServiceProperties{
+											RpObjectID: to.Ptr("00000000-1111-2222-3333-444444444444"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -113,23 +168,13 @@ func ExampleServicesClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcontainerorchestratorruntime.ServicesClientListResponse{
-		// 	ServiceResourceListResult: armcontainerorchestratorruntime.ServiceResourceListResult{
-		// 		Value: []*armcontainerorchestratorruntime.ServiceResource{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/services/storageclass"),
-		// 				Name: to.Ptr("storageclass"),
-		// 				Type: to.Ptr("Microsoft.KubernetesRuntime/Services"),
-		// 				Properties: &armcontainerorchestratorruntime.ServiceProperties{
-		// 					RpObjectID: to.Ptr("00000000-1111-2222-3333-444444444444"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/services/storageclass
+	// Name: storageclass
+	// Type: Microsoft.KubernetesRuntime/Services
 }
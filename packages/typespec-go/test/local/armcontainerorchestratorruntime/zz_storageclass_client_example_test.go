@@ -6,20 +6,53 @@ package armcontainerorchestratorruntime_test
 
 import (
 	"armcontainerorchestratorruntime"
+	"armcontainerorchestratorruntime/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-03-01/StorageClass_CreateOrUpdate.json
 func ExampleStorageClassClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				StorageClassServer: fake.StorageClassServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceURI string, storageClassName string, resource armcontainerorchestratorruntime.StorageClassResource, options *armcontainerorchestratorruntime.StorageClassClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armcontainerorchestratorruntime.StorageClassClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcontainerorchestratorruntime.StorageClassClientCreateOrUpdateResponse{
+							StorageClassResource: armcontainerorchestratorruntime.StorageClassResource{
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx"),
+								Name: to.Ptr("testrwx"),
+								Type: to.Ptr("microsoft.kubernetesruntime/storageclass"),
+								Properties: &armcontainerorchestratorruntime.StorageClassProperties{
+									Performance: to.Ptr(armcontainerorchestratorruntime.PerformanceTierBasic),
+									TypeProperties: &armcontainerorchestratorruntime.RwxStorageClassTypeProperties{
+										Type:                    to.Ptr(armcontainerorchestratorruntime.SCTypeRWX),
+										BackingStorageClassName: to.Ptr("default"),
+									},
+									AccessModes: []*armcontainerorchestratorruntime.AccessMode{
+										to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteOnce),
+										to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteMany),
+									},
+									AllowVolumeExpansion: to.Ptr(armcontainerorchestratorruntime.VolumeExpansionAllow),
+									Provisioner:          to.Ptr("rwx.csi.microsoft.com"),
+									VolumeBindingMode:    to.Ptr(armcontainerorchestratorruntime.VolumeBindingModeImmediate),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -38,40 +71,31 @@ func ExampleStorageClassClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.StorageClassClientCreateOrUpdateResponse{
-	// 	StorageClassResource: armcontainerorchestratorruntime.StorageClassResource{
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx"),
-	// 		Name: to.Ptr("testrwx"),
-	// 		Type: to.Ptr("microsoft.kubernetesruntime/storageclass"),
-	// 		Properties: &armcontainerorchestratorruntime.StorageClassProperties{
-	// 			Performance: to.Ptr(armcontainerorchestratorruntime.PerformanceTierBasic),
-	// 			TypeProperties: &armcontainerorchestratorruntime.RwxStorageClassTypeProperties{
-	// 				Type: to.Ptr(armcontainerorchestratorruntime.SCTypeRWX),
-	// 				BackingStorageClassName: to.Ptr("default"),
-	// 			},
-	// 			AccessModes: []*armcontainerorchestratorruntime.AccessMode{
-	// 				to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteOnce),
-	// 				to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteMany),
-	// 			},
-	// 			AllowVolumeExpansion: to.Ptr(armcontainerorchestratorruntime.VolumeExpansionAllow),
-	// 			Provisioner: to.Ptr("rwx.csi.microsoft.com"),
-	// 			VolumeBindingMode: to.Ptr(armcontainerorchestratorruntime.VolumeBindingModeImmediate),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx
+	// Name: testrwx
+	// Type: microsoft.kubernetesruntime/storageclass
 }
 
 // Generated from example definition: 2024-03-01/StorageClass_Delete.json
 func ExampleStorageClassClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				StorageClassServer: fake.StorageClassServer{
+					BeginDelete: func(ctx context.Context, resourceURI string, storageClassName string, options *armcontainerorchestratorruntime.StorageClassClientBeginDeleteOptions) (resp azfake.PollerResponder[armcontainerorchestratorruntime.StorageClassClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armcontainerorchestratorruntime.StorageClassClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -83,16 +107,45 @@ func ExampleStorageClassClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-03-01/StorageClass_Get.json
 func ExampleStorageClassClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				StorageClassServer: fake.StorageClassServer{
+					Get: func(ctx context.Context, resourceURI string, storageClassName string, options *armcontainerorchestratorruntime.StorageClassClientGetOptions) (resp azfake.Responder[armcontainerorchestratorruntime.StorageClassClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armcontainerorchestratorruntime.StorageClassClientGetResponse{
+							StorageClassResource: armcontainerorchestratorruntime.StorageClassResource{
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx"),
+								Name: to.Ptr("testrwx"),
+								Type: to.Ptr("microsoft.kubernetesruntime/storageclass"),
+								Properties: &armcontainerorchestratorruntime.StorageClassProperties{
+									Performance: to.Ptr(armcontainerorchestratorruntime.PerformanceTierBasic),
+									TypeProperties: &armcontainerorchestratorruntime.RwxStorageClassTypeProperties{
+										Type:                    to.Ptr(armcontainerorchestratorruntime.SCTypeRWX),
+										BackingStorageClassName: to.Ptr("default"),
+									},
+									AccessModes: []*armcontainerorchestratorruntime.AccessMode{
+										to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteOnce),
+										to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteMany),
+									},
+									AllowVolumeExpansion: to.Ptr(armcontainerorchestratorruntime.VolumeExpansionAllow),
+									Provisioner:          to.Ptr("rwx.csi.microsoft.com"),
+									VolumeBindingMode:    to.Ptr(armcontainerorchestratorruntime.VolumeBindingModeImmediate),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -100,40 +153,56 @@ func ExampleStorageClassClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.StorageClassClientGetResponse{
-	// 	StorageClassResource: armcontainerorchestratorruntime.StorageClassResource{
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx"),
-	// 		Name: to.Ptr("testrwx"),
-	// 		Type: to.Ptr("microsoft.kubernetesruntime/storageclass"),
-	// 		Properties: &armcontainerorchestratorruntime.StorageClassProperties{
-	// 			Performance: to.Ptr(armcontainerorchestratorruntime.PerformanceTierBasic),
-	// 			TypeProperties: &armcontainerorchestratorruntime.RwxStorageClassTypeProperties{
-	// 				Type: to.Ptr(armcontainerorchestratorruntime.SCTypeRWX),
-	// 				BackingStorageClassName: to.Ptr("default"),
-	// 			},
-	// 			AccessModes: []*armcontainerorchestratorruntime.AccessMode{
-	// 				to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteOnce),
-	// 				to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteMany),
-	// 			},
-	// 			AllowVolumeExpansion: to.Ptr(armcontainerorchestratorruntime.VolumeExpansionAllow),
-	// 			Provisioner: to.Ptr("rwx.csi.microsoft.com"),
-	// 			VolumeBindingMode: to.Ptr(armcontainerorchestratorruntime.VolumeBindingModeImmediate),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx
+	// Name: testrwx
+	// Type: microsoft.kubernetesruntime/storageclass
 }
 
 // Generated from example definition: 2024-03-01/StorageClass_List.json
 func ExampleStorageClassClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				StorageClassServer: fake.StorageClassServer{
+					NewListPager: func(resourceURI string, options *armcontainerorchestratorruntime.StorageClassClientListOptions) (resp azfake.PagerResponder[armcontainerorchestratorruntime.StorageClassClientListResponse]) {
+						resp.AddPage(http.StatusOK, armcontainerorchestratorruntime.StorageClassClientListResponse{
+							StorageClassResourceListResult: armcontainerorchestratorruntime.StorageClassResourceListResult{
+								Value: []*armcontainerorchestratorruntime.StorageClassResource{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx"),
+										Name: to.Ptr("testrwx"),
+										Type: to.Ptr("microsoft.kubernetesruntime/storageclass"),
+										Properties: &armcontainerorchestratorruntime.StorageClassProperties{
+											ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
+											Performance:       to.Ptr(armcontainerorchestratorruntime.PerformanceTierBasic),
+											TypeProperties: &armcontainerorchestratorruntime.RwxStorageClassTypeProperties{
+												Type:                    to.Ptr(armcontainerorchestratorruntime.SCTypeRWX),
+												BackingStorageClassName: to.Ptr("default"),
+											},
+											AccessModes: []*armcontainerorchestratorruntime.AccessMode{
+												to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteOnce),
+												to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteMany),
+											},
+											AllowVolumeExpansion: to.Ptr(armcontainerorchestratorruntime.VolumeExpansionAllow),
+											Provisioner:          to.Ptr("rwx.csi.microsoft.com"),
+											VolumeBindingMode:    to.Ptr(armcontainerorchestratorruntime.VolumeBindingModeImmediate),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -144,47 +213,53 @@ func ExampleStorageClassClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armcontainerorchestratorruntime.StorageClassClientListResponse{
-		// 	StorageClassResourceListResult: armcontainerorchestratorruntime.StorageClassResourceListResult{
-		// 		Value: []*armcontainerorchestratorruntime.StorageClassResource{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx"),
-		// 				Name: to.Ptr("testrwx"),
-		// 				Type: to.Ptr("microsoft.kubernetesruntime/storageclass"),
-		// 				Properties: &armcontainerorchestratorruntime.StorageClassProperties{
-		// 					ProvisioningState: to.Ptr(armcontainerorchestratorruntime.ProvisioningStateSucceeded),
-		// 					Performance: to.Ptr(armcontainerorchestratorruntime.PerformanceTierBasic),
-		// 					TypeProperties: &armcontainerorchestratorruntime.RwxStorageClassTypeProperties{
-		// 						Type: to.Ptr(armcontainerorchestratorruntime.SCTypeRWX),
-		// 						BackingStorageClassName: to.Ptr("default"),
-		// 					},
-		// 					AccessModes: []*armcontainerorchestratorruntime.AccessMode{
-		// 						to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteOnce),
-		// 						to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteMany),
-		// 					},
-		// 					AllowVolumeExpansion: to.Ptr(armcontainerorchestratorruntime.VolumeExpansionAllow),
-		// 					Provisioner: to.Ptr("rwx.csi.microsoft.com"),
-		// 					VolumeBindingMode: to.Ptr(armcontainerorchestratorruntime.VolumeBindingModeImmediate),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx
+	// Name: testrwx
+	// Type: microsoft.kubernetesruntime/storageclass
 }
 
 // Generated from example definition: 2024-03-01/StorageClass_Update.json
 func ExampleStorageClassClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, nil)
+	clientFactory, err := armcontainerorchestratorruntime.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				StorageClassServer: fake.StorageClassServer{
+					BeginUpdate: func(ctx context.Context, resourceURI string, storageClassName string, properties armcontainerorchestratorruntime.StorageClassResourceUpdate, options *armcontainerorchestratorruntime.StorageClassClientBeginUpdateOptions) (resp azfake.PollerResponder[armcontainerorchestratorruntime.StorageClassClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armcontainerorchestratorruntime.StorageClassClientUpdateResponse{
+							StorageClassResource: armcontainerorchestratorruntime.StorageClassResource{
+								ID:   to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx"),
+								Name: to.Ptr("testrwx"),
+								Type: to.Ptr("microsoft.kubernetesruntime/storageclass"),
+								Properties: &armcontainerorchestratorruntime.StorageClassProperties{
+									Performance: to.Ptr(armcontainerorchestratorruntime.PerformanceTierBasic),
+									TypeProperties: &armcontainerorchestratorruntime.RwxStorageClassTypeProperties{
+										Type:                    to.Ptr(armcontainerorchestratorruntime.SCTypeRWX),
+										BackingStorageClassName: to.Ptr("default"),
+									},
+									AccessModes: []*armcontainerorchestratorruntime.AccessMode{
+										to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteOnce),
+										to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteMany),
+									},
+									AllowVolumeExpansion: to.Ptr(armcontainerorchestratorruntime.VolumeExpansionAllow),
+									Provisioner:          to.Ptr("rwx.csi.microsoft.com"),
+									VolumeBindingMode:    to.Ptr(armcontainerorchestratorruntime.VolumeBindingModeImmediate),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -202,28 +277,11 @@ func ExampleStorageClassClient_BeginUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armcontainerorchestratorruntime.StorageClassClientUpdateResponse{
-	// 	StorageClassResource: armcontainerorchestratorruntime.StorageClassResource{
-	// 		ID: to.Ptr("/subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx"),
-	// 		Name: to.Ptr("testrwx"),
-	// 		Type: to.Ptr("microsoft.kubernetesruntime/storageclass"),
-	// 		Properties: &armcontainerorchestratorruntime.StorageClassProperties{
-	// 			Performance: to.Ptr(armcontainerorchestratorruntime.PerformanceTierBasic),
-	// 			TypeProperties: &armcontainerorchestratorruntime.RwxStorageClassTypeProperties{
-	// 				Type: to.Ptr(armcontainerorchestratorruntime.SCTypeRWX),
-	// 				BackingStorageClassName: to.Ptr("default"),
-	// 			},
-	// 			AccessModes: []*armcontainerorchestratorruntime.AccessMode{
-	// 				to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteOnce),
-	// 				to.Ptr(armcontainerorchestratorruntime.AccessModeReadWriteMany),
-	// 			},
-	// 			AllowVolumeExpansion: to.Ptr(armcontainerorchestratorruntime.VolumeExpansionAllow),
-	// 			Provisioner: to.Ptr("rwx.csi.microsoft.com"),
-	// 			VolumeBindingMode: to.Ptr(armcontainerorchestratorruntime.VolumeBindingModeImmediate),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-1111-2222-3333-444444444444/resourceGroups/example/providers/Microsoft.Kubernetes/connectedClusters/cluster1/providers/Microsoft.KubernetesRuntime/storageclasses/testrwx
+	// Name: testrwx
+	// Type: microsoft.kubernetesruntime/storageclass
 }
@@ -6,21 +6,56 @@ package armdatabasewatcher_test
 
 import (
 	"armdatabasewatcher"
+	"armdatabasewatcher/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 	"time"
 )
 
 // Generated from example definition: 2024-07-19-preview/AlertRuleResources_CreateOrUpdate_MaximumSet_Gen.json
 func ExampleAlertRuleResourcesClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AlertRuleResourcesServer: fake.AlertRuleResourcesServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, watcherName string, alertRuleResourceName string, resource armdatabasewatcher.AlertRuleResource, options *armdatabasewatcher.AlertRuleResourcesClientCreateOrUpdateOptions) (resp azfake.Responder[armdatabasewatcher.AlertRuleResourcesClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armdatabasewatcher.AlertRuleResourcesClientCreateOrUpdateResponse{
+							AlertRuleResource: armdatabasewatcher.AlertRuleResource{
+								Properties: &armdatabasewatcher.AlertRuleResourceProperties{
+									AlertRuleResourceID:      to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.insights/scheduledqueryrules/alerts-demo"),
+									CreationTime:             to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.798Z"); return t }()),
+									AlertRuleTemplateVersion: to.Ptr("1.0"),
+									AlertRuleTemplateID:      to.Ptr("someTemplateId"),
+									CreatedWithProperties:    to.Ptr(armdatabasewatcher.AlertRuleCreationPropertiesCreatedWithActionGroup),
+									ProvisioningState:        to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
+								},
+								ID:   to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.databasewatcher/watchers/testWatcher/alertRuleResources/testAlert"),
+								Name: to.Ptr("testAlert"),
+								Type: to.Ptr("microsoft.databasewatcher/watchers/alertRuleResources"),
+								SystemData: &armdatabasewatcher.SystemData{
+									CreatedBy:          to.Ptr("enbpvlpqbwd"),
+									CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+									LastModifiedBy:     to.Ptr("mxp"),
+									LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -36,42 +71,31 @@ func ExampleAlertRuleResourcesClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.AlertRuleResourcesClientCreateOrUpdateResponse{
-	// 	AlertRuleResource: armdatabasewatcher.AlertRuleResource{
-	// 		Properties: &armdatabasewatcher.AlertRuleResourceProperties{
-	// 			AlertRuleResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.insights/scheduledqueryrules/alerts-demo"),
-	// 			CreationTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.798Z"); return t}()),
-	// 			AlertRuleTemplateVersion: to.Ptr("1.0"),
-	// 			AlertRuleTemplateID: to.Ptr("someTemplateId"),
-	// 			CreatedWithProperties: to.Ptr(armdatabasewatcher.AlertRuleCreationPropertiesCreatedWithActionGroup),
-	// 			ProvisioningState: to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.databasewatcher/watchers/testWatcher/alertRuleResources/testAlert"),
-	// 		Name: to.Ptr("testAlert"),
-	// 		Type: to.Ptr("microsoft.databasewatcher/watchers/alertRuleResources"),
-	// 		SystemData: &armdatabasewatcher.SystemData{
-	// 			CreatedBy: to.Ptr("enbpvlpqbwd"),
-	// 			CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("mxp"),
-	// 			LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.databasewatcher/watchers/testWatcher/alertRuleResources/testAlert
+	// Name: testAlert
+	// Type: microsoft.databasewatcher/watchers/alertRuleResources
 }
 
 // Generated from example definition: 2024-07-19-preview/AlertRuleResources_Delete_MaximumSet_Gen.json
 func ExampleAlertRuleResourcesClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AlertRuleResourcesServer: fake.AlertRuleResourcesServer{
+					Delete: func(ctx context.Context, resourceGroupName string, watcherName string, alertRuleResourceName string, options *armdatabasewatcher.AlertRuleResourcesClientDeleteOptions) (resp azfake.Responder[armdatabasewatcher.AlertRuleResourcesClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armdatabasewatcher.AlertRuleResourcesClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -81,19 +105,47 @@ func ExampleAlertRuleResourcesClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.AlertRuleResourcesClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-07-19-preview/AlertRuleResources_Get_MaximumSet_Gen.json
 func ExampleAlertRuleResourcesClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AlertRuleResourcesServer: fake.AlertRuleResourcesServer{
+					Get: func(ctx context.Context, resourceGroupName string, watcherName string, alertRuleResourceName string, options *armdatabasewatcher.AlertRuleResourcesClientGetOptions) (resp azfake.Responder[armdatabasewatcher.AlertRuleResourcesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armdatabasewatcher.AlertRuleResourcesClientGetResponse{
+							AlertRuleResource: armdatabasewatcher.AlertRuleResource{
+								Properties: &armdatabasewatcher.AlertRuleResourceProperties{
+									AlertRuleResourceID:      to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.insights/scheduledqueryrules/alerts-demo"),
+									CreationTime:             to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.798Z"); return t }()),
+									AlertRuleTemplateVersion: to.Ptr("1.0"),
+									AlertRuleTemplateID:      to.Ptr("someTemplateId"),
+									CreatedWithProperties:    to.Ptr(armdatabasewatcher.AlertRuleCreationPropertiesCreatedWithActionGroup),
+									ProvisioningState:        to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
+								},
+								ID:   to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.databasewatcher/watchers/testWatcher/alertRuleResources/testAlert"),
+								Name: to.Ptr("testAlert"),
+								Type: to.Ptr("microsoft.databasewatcher/watchers/alertRuleResources"),
+								SystemData: &armdatabasewatcher.SystemData{
+									CreatedBy:          to.Ptr("enbpvlpqbwd"),
+									CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+									LastModifiedBy:     to.Ptr("mxp"),
+									LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -101,42 +153,57 @@ func ExampleAlertRuleResourcesClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.AlertRuleResourcesClientGetResponse{
-	// 	AlertRuleResource: armdatabasewatcher.AlertRuleResource{
-	// 		Properties: &armdatabasewatcher.AlertRuleResourceProperties{
-	// 			AlertRuleResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.insights/scheduledqueryrules/alerts-demo"),
-	// 			CreationTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.798Z"); return t}()),
-	// 			AlertRuleTemplateVersion: to.Ptr("1.0"),
-	// 			AlertRuleTemplateID: to.Ptr("someTemplateId"),
-	// 			CreatedWithProperties: to.Ptr(armdatabasewatcher.AlertRuleCreationPropertiesCreatedWithActionGroup),
-	// 			ProvisioningState: to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.databasewatcher/watchers/testWatcher/alertRuleResources/testAlert"),
-	// 		Name: to.Ptr("testAlert"),
-	// 		Type: to.Ptr("microsoft.databasewatcher/watchers/alertRuleResources"),
-	// 		SystemData: &armdatabasewatcher.SystemData{
-	// 			CreatedBy: to.Ptr("enbpvlpqbwd"),
-	// 			CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("mxp"),
-	// 			LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.databasewatcher/watchers/testWatcher/alertRuleResources/testAlert
+	// Name: testAlert
+	// Type: microsoft.databasewatcher/watchers/alertRuleResources
 }
 
 // Generated from example definition: 2024-07-19-preview/AlertRuleResources_ListByParent_MaximumSet_Gen.json
 func ExampleAlertRuleResourcesClient_NewListByParentPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AlertRuleResourcesServer: fake.AlertRuleResourcesServer{
+					NewListByParentPager: func(resourceGroupName string, watcherName string, options *armdatabasewatcher.AlertRuleResourcesClientListByParentOptions) (resp azfake.PagerResponder[armdatabasewatcher.AlertRuleResourcesClientListByParentResponse]) {
+						resp.AddPage(http.StatusOK, armdatabasewatcher.AlertRuleResourcesClientListByParentResponse{
+							AlertRuleResourceListResult: armdatabasewatcher.AlertRuleResourceListResult{
+								Value: []*armdatabasewatcher.AlertRuleResource{
+									{
+										Properties: &armdatabasewatcher.AlertRuleResourceProperties{
+											AlertRuleResourceID:      to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.insights/scheduledqueryrules/alerts-demo"),
+											CreationTime:             to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.798Z"); return t }()),
+											AlertRuleTemplateVersion: to.Ptr("1.0"),
+											ProvisioningState:        to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
+											AlertRuleTemplateID:      to.Ptr("someTemplateId"),
+											CreatedWithProperties:    to.Ptr(armdatabasewatcher.AlertRuleCreationPropertiesCreatedWithActionGroup),
+										},
+										ID:   to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.databasewatcher/watchers/testWatcher/alertRuleResources/testAlert"),
+										Name: to.Ptr("testAlert"),
+										Type: to.Ptr("microsoft.databasewatcher/watchers/alertRuleResources"),
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -147,37 +214,13 @@ func ExampleAlertRuleResourcesClient_NewListByParentPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdatabasewatcher.AlertRuleResourcesClientListByParentResponse{
-		// 	AlertRuleResourceListResult: armdatabasewatcher.AlertRuleResourceListResult{
-		// 		Value: []*armdatabasewatcher.AlertRuleResource{
-		// 			{
-		// 				Properties: &armdatabasewatcher.AlertRuleResourceProperties{
-		// 					AlertRuleResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.insights/scheduledqueryrules/alerts-demo"),
-		// 					CreationTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.798Z"); return t}()),
-		// 					AlertRuleTemplateVersion: to.Ptr("1.0"),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
-		// 					AlertRuleTemplateID: to.Ptr("someTemplateId"),
-		// 					CreatedWithProperties: to.Ptr(armdatabasewatcher.AlertRuleCreationPropertiesCreatedWithActionGroup),
-		// 				},
-		// 				ID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.databasewatcher/watchers/testWatcher/alertRuleResources/testAlert"),
-		// 				Name: to.Ptr("testAlert"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers/alertRuleResources"),
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 		NextLink: to.Ptr("https://microsoft.com/a"),
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878Be/resourceGroups/rgWatcher/providers/microsoft.databasewatcher/watchers/testWatcher/alertRuleResources/testAlert
+	// Name: testAlert
+	// Type: microsoft.databasewatcher/watchers/alertRuleResources
 }
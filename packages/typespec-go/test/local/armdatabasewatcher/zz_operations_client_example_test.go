@@ -6,19 +6,50 @@ package armdatabasewatcher_test
 
 import (
 	"armdatabasewatcher"
+	"armdatabasewatcher/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-07-19-preview/Operations_List_MaximumSet_Gen.json
 func ExampleOperationsClient_NewListPager_operationsListGeneratedByMaximumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armdatabasewatcher.OperationsClientListOptions) (resp azfake.PagerResponder[armdatabasewatcher.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armdatabasewatcher.OperationsClientListResponse{
+							OperationListResult: armdatabasewatcher.OperationListResult{
+								Value: []*armdatabasewatcher.Operation{
+									{
+										Name:         to.Ptr("snzrdvltunnrz"),
+										IsDataAction: to.Ptr(true),
+										Display: &armdatabasewatcher.OperationDisplay{
+											Provider:    to.Ptr("dtfrqzamclscchmghtxn"),
+											Resource:    to.Ptr("lvlhnsfnquorjuuutjxex"),
+											Operation:   to.Ptr("vbgvamoxqwthpbdghxzaw"),
+											Description: to.Ptr("nvbtuwwjfehylzmoatd"),
+										},
+										Origin:     to.Ptr(armdatabasewatcher.OriginUser),
+										ActionType: to.Ptr(armdatabasewatcher.ActionTypeInternal),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,40 +60,37 @@ func ExampleOperationsClient_NewListPager_operationsListGeneratedByMaximumSetRul
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("IsDataAction:", *v.IsDataAction)
+			fmt.Println("Origin:", *v.Origin)
+			fmt.Println("ActionType:", *v.ActionType)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdatabasewatcher.OperationsClientListResponse{
-		// 	OperationListResult: armdatabasewatcher.OperationListResult{
-		// 		Value: []*armdatabasewatcher.Operation{
-		// 			{
-		// 				Name: to.Ptr("snzrdvltunnrz"),
-		// 				IsDataAction: to.Ptr(true),
-		// 				Display: &armdatabasewatcher.OperationDisplay{
-		// 					Provider: to.Ptr("dtfrqzamclscchmghtxn"),
-		// 					Resource: to.Ptr("lvlhnsfnquorjuuutjxex"),
-		// 					Operation: to.Ptr("vbgvamoxqwthpbdghxzaw"),
-		// 					Description: to.Ptr("nvbtuwwjfehylzmoatd"),
-		// 				},
-		// 				Origin: to.Ptr(armdatabasewatcher.OriginUser),
-		// 				ActionType: to.Ptr(armdatabasewatcher.ActionTypeInternal),
-		// 			},
-		// 		},
-		// 		NextLink: to.Ptr("https://microsoft.com/awfba"),
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: snzrdvltunnrz
+	// IsDataAction: true
+	// Origin: user
+	// ActionType: Internal
 }
 
 // Generated from example definition: 2024-07-19-preview/Operations_List_MinimumSet_Gen.json
 func ExampleOperationsClient_NewListPager_operationsListGeneratedByMinimumSetRule() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armdatabasewatcher.OperationsClientListOptions) (resp azfake.PagerResponder[armdatabasewatcher.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armdatabasewatcher.OperationsClientListResponse{
+							OperationListResult: armdatabasewatcher.OperationListResult{},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -76,10 +104,6 @@ func ExampleOperationsClient_NewListPager_operationsListGeneratedByMinimumSetRul
 			// You could use page here. We use blank identifier for just demo purposes.
 			_ = v
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdatabasewatcher.OperationsClientListResponse{
-		// 	OperationListResult: armdatabasewatcher.OperationListResult{
-		// 	},
-		// }
 	}
+	// Output:
 }
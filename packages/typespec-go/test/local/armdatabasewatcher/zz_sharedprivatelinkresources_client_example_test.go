@@ -6,20 +6,56 @@ package armdatabasewatcher_test
 
 import (
 	"armdatabasewatcher"
+	"armdatabasewatcher/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-07-19-preview/SharedPrivateLinkResources_Create_MaximumSet_Gen.json
 func ExampleSharedPrivateLinkResourcesClient_BeginCreate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				SharedPrivateLinkResourcesServer: fake.SharedPrivateLinkResourcesServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, watcherName string, sharedPrivateLinkResourceName string, resource armdatabasewatcher.SharedPrivateLinkResource, options *armdatabasewatcher.SharedPrivateLinkResourcesClientBeginCreateOptions) (resp azfake.PollerResponder[armdatabasewatcher.SharedPrivateLinkResourcesClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armdatabasewatcher.SharedPrivateLinkResourcesClientCreateResponse{
+							SharedPrivateLinkResource: armdatabasewatcher.SharedPrivateLinkResource{
+								Properties: &armdatabasewatcher.SharedPrivateLinkResourceProperties{
+									PrivateLinkResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.KeyVault/vaults/kvmo3ej9ih"),
+									GroupID:               to.Ptr("vault"),
+									RequestMessage:        to.Ptr("request message"),
+									DNSZone:               to.Ptr("ec3ae9d410ba"),
+									Status:                to.Ptr(armdatabasewatcher.SharedPrivateLinkResourceStatusPending),
+									ProvisioningState:     to.Ptr(armdatabasewatcher.ResourceProvisioningState("Completed")),
+								},
+								ID:   to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih/sharedPrivateLinkResources/monitoringh22eed"),
+								Name: to.Ptr("monitoringh22eed"),
+								Type: to.Ptr("microsoft.databasewatcher/watchers/sharedPrivateLinkResources"),
+								SystemData: &armdatabasewatcher.SystemData{
+									CreatedBy:          to.Ptr("enbpvlpqbwd"),
+									CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+									LastModifiedBy:     to.Ptr("mxp"),
+									LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -39,42 +75,31 @@ func ExampleSharedPrivateLinkResourcesClient_BeginCreate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.SharedPrivateLinkResourcesClientCreateResponse{
-	// 	SharedPrivateLinkResource: armdatabasewatcher.SharedPrivateLinkResource{
-	// 		Properties: &armdatabasewatcher.SharedPrivateLinkResourceProperties{
-	// 			PrivateLinkResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.KeyVault/vaults/kvmo3ej9ih"),
-	// 			GroupID: to.Ptr("vault"),
-	// 			RequestMessage: to.Ptr("request message"),
-	// 			DNSZone: to.Ptr("ec3ae9d410ba"),
-	// 			Status: to.Ptr(armdatabasewatcher.SharedPrivateLinkResourceStatusPending),
-	// 			ProvisioningState: to.Ptr(armdatabasewatcher.ResourceProvisioningState("Completed")),
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih/sharedPrivateLinkResources/monitoringh22eed"),
-	// 		Name: to.Ptr("monitoringh22eed"),
-	// 		Type: to.Ptr("microsoft.databasewatcher/watchers/sharedPrivateLinkResources"),
-	// 		SystemData: &armdatabasewatcher.SystemData{
-	// 			CreatedBy: to.Ptr("enbpvlpqbwd"),
-	// 			CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("mxp"),
-	// 			LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih/sharedPrivateLinkResources/monitoringh22eed
+	// Name: monitoringh22eed
+	// Type: microsoft.databasewatcher/watchers/sharedPrivateLinkResources
 }
 
 // Generated from example definition: 2024-07-19-preview/SharedPrivateLinkResources_Delete_MaximumSet_Gen.json
 func ExampleSharedPrivateLinkResourcesClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				SharedPrivateLinkResourcesServer: fake.SharedPrivateLinkResourcesServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, watcherName string, sharedPrivateLinkResourceName string, options *armdatabasewatcher.SharedPrivateLinkResourcesClientBeginDeleteOptions) (resp azfake.PollerResponder[armdatabasewatcher.SharedPrivateLinkResourcesClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armdatabasewatcher.SharedPrivateLinkResourcesClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -86,16 +111,47 @@ func ExampleSharedPrivateLinkResourcesClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-07-19-preview/SharedPrivateLinkResources_Get_MaximumSet_Gen.json
 func ExampleSharedPrivateLinkResourcesClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				SharedPrivateLinkResourcesServer: fake.SharedPrivateLinkResourcesServer{
+					Get: func(ctx context.Context, resourceGroupName string, watcherName string, sharedPrivateLinkResourceName string, options *armdatabasewatcher.SharedPrivateLinkResourcesClientGetOptions) (resp azfake.Responder[armdatabasewatcher.SharedPrivateLinkResourcesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armdatabasewatcher.SharedPrivateLinkResourcesClientGetResponse{
+							SharedPrivateLinkResource: armdatabasewatcher.SharedPrivateLinkResource{
+								Properties: &armdatabasewatcher.SharedPrivateLinkResourceProperties{
+									PrivateLinkResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.KeyVault/vaults/kvmo3ej9ih"),
+									GroupID:               to.Ptr("vault"),
+									RequestMessage:        to.Ptr("request message"),
+									DNSZone:               to.Ptr("ec3ae9d410ba"),
+									Status:                to.Ptr(armdatabasewatcher.SharedPrivateLinkResourceStatusPending),
+									ProvisioningState:     to.Ptr(armdatabasewatcher.ResourceProvisioningState("Completed")),
+								},
+								ID:   to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih/sharedPrivateLinkResources/monitoringh22eed"),
+								Name: to.Ptr("monitoringh22eed"),
+								Type: to.Ptr("microsoft.databasewatcher/watchers/sharedPrivateLinkResources"),
+								SystemData: &armdatabasewatcher.SystemData{
+									CreatedBy:          to.Ptr("enbpvlpqbwd"),
+									CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+									LastModifiedBy:     to.Ptr("mxp"),
+									LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -103,42 +159,57 @@ func ExampleSharedPrivateLinkResourcesClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.SharedPrivateLinkResourcesClientGetResponse{
-	// 	SharedPrivateLinkResource: armdatabasewatcher.SharedPrivateLinkResource{
-	// 		Properties: &armdatabasewatcher.SharedPrivateLinkResourceProperties{
-	// 			PrivateLinkResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.KeyVault/vaults/kvmo3ej9ih"),
-	// 			GroupID: to.Ptr("vault"),
-	// 			RequestMessage: to.Ptr("request message"),
-	// 			DNSZone: to.Ptr("ec3ae9d410ba"),
-	// 			Status: to.Ptr(armdatabasewatcher.SharedPrivateLinkResourceStatusPending),
-	// 			ProvisioningState: to.Ptr(armdatabasewatcher.ResourceProvisioningState("Completed")),
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih/sharedPrivateLinkResources/monitoringh22eed"),
-	// 		Name: to.Ptr("monitoringh22eed"),
-	// 		Type: to.Ptr("microsoft.databasewatcher/watchers/sharedPrivateLinkResources"),
-	// 		SystemData: &armdatabasewatcher.SystemData{
-	// 			CreatedBy: to.Ptr("enbpvlpqbwd"),
-	// 			CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("mxp"),
-	// 			LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih/sharedPrivateLinkResources/monitoringh22eed
+	// Name: monitoringh22eed
+	// Type: microsoft.databasewatcher/watchers/sharedPrivateLinkResources
 }
 
 // Generated from example definition: 2024-07-19-preview/SharedPrivateLinkResources_ListByWatcher_MaximumSet_Gen.json
 func ExampleSharedPrivateLinkResourcesClient_NewListByWatcherPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				SharedPrivateLinkResourcesServer: fake.SharedPrivateLinkResourcesServer{
+					NewListByWatcherPager: func(resourceGroupName string, watcherName string, options *armdatabasewatcher.SharedPrivateLinkResourcesClientListByWatcherOptions) (resp azfake.PagerResponder[armdatabasewatcher.SharedPrivateLinkResourcesClientListByWatcherResponse]) {
+						resp.AddPage(http.StatusOK, armdatabasewatcher.SharedPrivateLinkResourcesClientListByWatcherResponse{
+							SharedPrivateLinkResourceListResult: armdatabasewatcher.SharedPrivateLinkResourceListResult{
+								Value: []*armdatabasewatcher.SharedPrivateLinkResource{
+									{
+										ID:   to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih/sharedPrivateLinkResources/monitoringh22eed"),
+										Name: to.Ptr("monitoringh22eed"),
+										Type: to.Ptr("microsoft.databasewatcher/watchers/sharedPrivateLinkResources"),
+										Properties: &armdatabasewatcher.SharedPrivateLinkResourceProperties{
+											PrivateLinkResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.KeyVault/vaults/kvmo3ej9ih"),
+											GroupID:               to.Ptr("vault"),
+											RequestMessage:        to.Ptr("request message"),
+											DNSZone:               to.Ptr("ec3ae9d410ba"),
+											Status:                to.Ptr(armdatabasewatcher.SharedPrivateLinkResourceStatusPending),
+											ProvisioningState:     to.Ptr(armdatabasewatcher.ResourceProvisioningState("Completed")),
+										},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -149,37 +220,13 @@ func ExampleSharedPrivateLinkResourcesClient_NewListByWatcherPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdatabasewatcher.SharedPrivateLinkResourcesClientListByWatcherResponse{
-		// 	SharedPrivateLinkResourceListResult: armdatabasewatcher.SharedPrivateLinkResourceListResult{
-		// 		Value: []*armdatabasewatcher.SharedPrivateLinkResource{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih/sharedPrivateLinkResources/monitoringh22eed"),
-		// 				Name: to.Ptr("monitoringh22eed"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers/sharedPrivateLinkResources"),
-		// 				Properties: &armdatabasewatcher.SharedPrivateLinkResourceProperties{
-		// 					PrivateLinkResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.KeyVault/vaults/kvmo3ej9ih"),
-		// 					GroupID: to.Ptr("vault"),
-		// 					RequestMessage: to.Ptr("request message"),
-		// 					DNSZone: to.Ptr("ec3ae9d410ba"),
-		// 					Status: to.Ptr(armdatabasewatcher.SharedPrivateLinkResourceStatusPending),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.ResourceProvisioningState("Completed")),
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 		NextLink: to.Ptr("https://microsoft.com/awfba"),
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih/sharedPrivateLinkResources/monitoringh22eed
+	// Name: monitoringh22eed
+	// Type: microsoft.databasewatcher/watchers/sharedPrivateLinkResources
 }
@@ -6,20 +6,55 @@ package armdatabasewatcher_test
 
 import (
 	"armdatabasewatcher"
+	"armdatabasewatcher/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-07-19-preview/Targets_CreateOrUpdate_MaximumSet_Gen.json
 func ExampleTargetsClient_CreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				TargetsServer: fake.TargetsServer{
+					CreateOrUpdate: func(ctx context.Context, resourceGroupName string, watcherName string, targetName string, resource armdatabasewatcher.Target, options *armdatabasewatcher.TargetsClientCreateOrUpdateOptions) (resp azfake.Responder[armdatabasewatcher.TargetsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armdatabasewatcher.TargetsClientCreateOrUpdateResponse{
+							Target: armdatabasewatcher.Target{
+								Properties: &armdatabasewatcher.SQLDbSingleDatabaseTargetProperties{
+									TargetType:               to.Ptr("SqlDb"),
+									TargetAuthenticationType: to.Ptr(armdatabasewatcher.TargetAuthenticationTypeAAD),
+									ConnectionServerName:     to.Ptr("sqlServero1ihe2"),
+									SQLDbResourceID:          to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.Sql/servers/m1/databases/m2"),
+									ProvisioningState:        to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
+								},
+								ID:   to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-px9ma7/providers/Microsoft.DatabaseWatcher/watchers/databasemo3d9sgt/targets/monitoringzkndgm"),
+								Name: to.Ptr("monitoringzkndgm"),
+								Type: to.Ptr("microsoft.databasewatcher/watchers/targets"),
+								SystemData: &armdatabasewatcher.SystemData{
+									CreatedBy:          to.Ptr("enbpvlpqbwd"),
+									CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+									LastModifiedBy:     to.Ptr("mxp"),
+									LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -34,41 +69,31 @@ func ExampleTargetsClient_CreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.TargetsClientCreateOrUpdateResponse{
-	// 	Target: armdatabasewatcher.Target{
-	// 		Properties: &armdatabasewatcher.SQLDbSingleDatabaseTargetProperties{
-	// 			TargetType: to.Ptr("SqlDb"),
-	// 			TargetAuthenticationType: to.Ptr(armdatabasewatcher.TargetAuthenticationTypeAAD),
-	// 			ConnectionServerName: to.Ptr("sqlServero1ihe2"),
-	// 			SQLDbResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.Sql/servers/m1/databases/m2"),
-	// 			ProvisioningState: to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-px9ma7/providers/Microsoft.DatabaseWatcher/watchers/databasemo3d9sgt/targets/monitoringzkndgm"),
-	// 		Name: to.Ptr("monitoringzkndgm"),
-	// 		Type: to.Ptr("microsoft.databasewatcher/watchers/targets"),
-	// 		SystemData: &armdatabasewatcher.SystemData{
-	// 			CreatedBy: to.Ptr("enbpvlpqbwd"),
-	// 			CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("mxp"),
-	// 			LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-px9ma7/providers/Microsoft.DatabaseWatcher/watchers/databasemo3d9sgt/targets/monitoringzkndgm
+	// Name: monitoringzkndgm
+	// Type: microsoft.databasewatcher/watchers/targets
 }
 
 // Generated from example definition: 2024-07-19-preview/Targets_Delete_MaximumSet_Gen.json
 func ExampleTargetsClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				TargetsServer: fake.TargetsServer{
+					Delete: func(ctx context.Context, resourceGroupName string, watcherName string, targetName string, options *armdatabasewatcher.TargetsClientDeleteOptions) (resp azfake.Responder[armdatabasewatcher.TargetsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armdatabasewatcher.TargetsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -78,19 +103,46 @@ func ExampleTargetsClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.TargetsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-07-19-preview/Targets_Get_MaximumSet_Gen.json
 func ExampleTargetsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				TargetsServer: fake.TargetsServer{
+					Get: func(ctx context.Context, resourceGroupName string, watcherName string, targetName string, options *armdatabasewatcher.TargetsClientGetOptions) (resp azfake.Responder[armdatabasewatcher.TargetsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armdatabasewatcher.TargetsClientGetResponse{
+							Target: armdatabasewatcher.Target{
+								Properties: &armdatabasewatcher.SQLDbSingleDatabaseTargetProperties{
+									TargetType:               to.Ptr("SqlDb"),
+									TargetAuthenticationType: to.Ptr(armdatabasewatcher.TargetAuthenticationTypeAAD),
+									ConnectionServerName:     to.Ptr("sqlServero1ihe2"),
+									SQLDbResourceID:          to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.Sql/servers/m1/databases/m2"),
+									ProvisioningState:        to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
+								},
+								ID:   to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-px9ma7/providers/Microsoft.DatabaseWatcher/watchers/databasemo3d9sgt/targets/monitoringzkndgm"),
+								Name: to.Ptr("monitoringzkndgm"),
+								Type: to.Ptr("microsoft.databasewatcher/watchers/targets"),
+								SystemData: &armdatabasewatcher.SystemData{
+									CreatedBy:          to.Ptr("enbpvlpqbwd"),
+									CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+									LastModifiedBy:     to.Ptr("mxp"),
+									LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -98,41 +150,56 @@ func ExampleTargetsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.TargetsClientGetResponse{
-	// 	Target: armdatabasewatcher.Target{
-	// 		Properties: &armdatabasewatcher.SQLDbSingleDatabaseTargetProperties{
-	// 			TargetType: to.Ptr("SqlDb"),
-	// 			TargetAuthenticationType: to.Ptr(armdatabasewatcher.TargetAuthenticationTypeAAD),
-	// 			ConnectionServerName: to.Ptr("sqlServero1ihe2"),
-	// 			SQLDbResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.Sql/servers/m1/databases/m2"),
-	// 			ProvisioningState: to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-px9ma7/providers/Microsoft.DatabaseWatcher/watchers/databasemo3d9sgt/targets/monitoringzkndgm"),
-	// 		Name: to.Ptr("monitoringzkndgm"),
-	// 		Type: to.Ptr("microsoft.databasewatcher/watchers/targets"),
-	// 		SystemData: &armdatabasewatcher.SystemData{
-	// 			CreatedBy: to.Ptr("enbpvlpqbwd"),
-	// 			CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("mxp"),
-	// 			LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-px9ma7/providers/Microsoft.DatabaseWatcher/watchers/databasemo3d9sgt/targets/monitoringzkndgm
+	// Name: monitoringzkndgm
+	// Type: microsoft.databasewatcher/watchers/targets
 }
 
 // Generated from example definition: 2024-07-19-preview/Targets_ListByWatcher_MaximumSet_Gen.json
 func ExampleTargetsClient_NewListByWatcherPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				TargetsServer: fake.TargetsServer{
+					NewListByWatcherPager: func(resourceGroupName string, watcherName string, options *armdatabasewatcher.TargetsClientListByWatcherOptions) (resp azfake.PagerResponder[armdatabasewatcher.TargetsClientListByWatcherResponse]) {
+						resp.AddPage(http.StatusOK, armdatabasewatcher.TargetsClientListByWatcherResponse{
+							TargetListResult: armdatabasewatcher.TargetListResult{
+								Value: []*armdatabasewatcher.Target{
+									{
+										ID:   to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-px9ma7/providers/Microsoft.DatabaseWatcher/watchers/databasemo3d9sgt/targets/monitoringzkndgm"),
+										Name: to.Ptr("monitoringzkndgm"),
+										Type: to.Ptr("microsoft.databasewatcher/watchers/targets"),
+										Properties: &armdatabasewatcher.SQLDbSingleDatabaseTargetProperties{
+											TargetType:               to.Ptr("SqlDb"),
+											TargetAuthenticationType: to.Ptr(armdatabasewatcher.TargetAuthenticationTypeAAD),
+											ConnectionServerName:     to.Ptr("sqlServero1ihe2"),
+											SQLDbResourceID:          to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.Sql/servers/m1/databases/m2"),
+											ProvisioningState:        to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
+										},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -143,36 +210,13 @@ func ExampleTargetsClient_NewListByWatcherPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdatabasewatcher.TargetsClientListByWatcherResponse{
-		// 	TargetListResult: armdatabasewatcher.TargetListResult{
-		// 		Value: []*armdatabasewatcher.Target{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-px9ma7/providers/Microsoft.DatabaseWatcher/watchers/databasemo3d9sgt/targets/monitoringzkndgm"),
-		// 				Name: to.Ptr("monitoringzkndgm"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers/targets"),
-		// 				Properties: &armdatabasewatcher.SQLDbSingleDatabaseTargetProperties{
-		// 					TargetType: to.Ptr("SqlDb"),
-		// 					TargetAuthenticationType: to.Ptr(armdatabasewatcher.TargetAuthenticationTypeAAD),
-		// 					ConnectionServerName: to.Ptr("sqlServero1ihe2"),
-		// 					SQLDbResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.Sql/servers/m1/databases/m2"),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.ResourceProvisioningStateSucceeded),
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 		NextLink: to.Ptr("https://microsoft.com/a"),
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-px9ma7/providers/Microsoft.DatabaseWatcher/watchers/databasemo3d9sgt/targets/monitoringzkndgm
+	// Name: monitoringzkndgm
+	// Type: microsoft.databasewatcher/watchers/targets
 }
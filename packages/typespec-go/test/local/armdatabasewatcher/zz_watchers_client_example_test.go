@@ -6,20 +6,70 @@ package armdatabasewatcher_test
 
 import (
 	"armdatabasewatcher"
+	"armdatabasewatcher/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-07-19-preview/Watchers_CreateOrUpdate_MaximumSet_Gen.json
 func ExampleWatchersClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				WatchersServer: fake.WatchersServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, watcherName string, resource armdatabasewatcher.Watcher, options *armdatabasewatcher.WatchersClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armdatabasewatcher.WatchersClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armdatabasewatcher.WatchersClientCreateOrUpdateResponse{
+							Watcher: armdatabasewatcher.Watcher{
+								Properties: &armdatabasewatcher.WatcherProperties{
+									Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+									DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+									Datastore: &armdatabasewatcher.Datastore{
+										AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+										KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+										KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+										KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+										KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+										KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+										KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+									},
+									ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+								},
+								Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+									Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityTypeSystemAssigned),
+									UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+									PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+									TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+								},
+								Tags:     map[string]*string{},
+								Location: to.Ptr("eastus2euap"),
+								ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
+								Name:     to.Ptr("myWatcher"),
+								Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+								SystemData: &armdatabasewatcher.SystemData{
+									CreatedBy:          to.Ptr("enbpvlpqbwd"),
+									CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+									LastModifiedBy:     to.Ptr("mxp"),
+									LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -50,58 +100,33 @@ func ExampleWatchersClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.WatchersClientCreateOrUpdateResponse{
-	// 	Watcher: armdatabasewatcher.Watcher{
-	// 		Properties: &armdatabasewatcher.WatcherProperties{
-	// 			Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-	// 			DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-	// 			Datastore: &armdatabasewatcher.Datastore{
-	// 				AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-	// 				KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-	// 				KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-	// 				KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-	// 				KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-	// 				KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-	// 				KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-	// 		},
-	// 		Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-	// 			Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityTypeSystemAssigned),
-	// 			UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-	// 			},
-	// 			PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-	// 			TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Location: to.Ptr("eastus2euap"),
-	// 		ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
-	// 		Name: to.Ptr("myWatcher"),
-	// 		Type: to.Ptr("microsoft.databasewatcher/watchers"),
-	// 		SystemData: &armdatabasewatcher.SystemData{
-	// 			CreatedBy: to.Ptr("enbpvlpqbwd"),
-	// 			CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("mxp"),
-	// 			LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// Location: eastus2euap
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher
+	// Name: myWatcher
+	// Type: microsoft.databasewatcher/watchers
 }
 
 // Generated from example definition: 2024-07-19-preview/Watchers_Delete_MaximumSet_Gen.json
 func ExampleWatchersClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				WatchersServer: fake.WatchersServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, watcherName string, options *armdatabasewatcher.WatchersClientBeginDeleteOptions) (resp azfake.PollerResponder[armdatabasewatcher.WatchersClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armdatabasewatcher.WatchersClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -113,16 +138,61 @@ func ExampleWatchersClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-07-19-preview/Watchers_Get_MaximumSet_Gen.json
 func ExampleWatchersClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				WatchersServer: fake.WatchersServer{
+					Get: func(ctx context.Context, resourceGroupName string, watcherName string, options *armdatabasewatcher.WatchersClientGetOptions) (resp azfake.Responder[armdatabasewatcher.WatchersClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armdatabasewatcher.WatchersClientGetResponse{
+							Watcher: armdatabasewatcher.Watcher{
+								Properties: &armdatabasewatcher.WatcherProperties{
+									Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+									DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+									Datastore: &armdatabasewatcher.Datastore{
+										AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+										KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+										KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+										KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+										KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+										KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+										KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+									},
+									ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+								},
+								Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+									Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+									UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+									PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+									TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+								},
+								Tags:     map[string]*string{},
+								Location: to.Ptr("eastus2euap"),
+								ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
+								Name:     to.Ptr("myWatcher"),
+								Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+								SystemData: &armdatabasewatcher.SystemData{
+									CreatedBy:          to.Ptr("enbpvlpqbwd"),
+									CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+									LastModifiedBy:     to.Ptr("mxp"),
+									LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -130,58 +200,283 @@ func ExampleWatchersClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.WatchersClientGetResponse{
-	// 	Watcher: armdatabasewatcher.Watcher{
-	// 		Properties: &armdatabasewatcher.WatcherProperties{
-	// 			Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-	// 			DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-	// 			Datastore: &armdatabasewatcher.Datastore{
-	// 				AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-	// 				KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-	// 				KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-	// 				KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-	// 				KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-	// 				KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-	// 				KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-	// 		},
-	// 		Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-	// 			Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-	// 			UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-	// 			},
-	// 			PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-	// 			TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Location: to.Ptr("eastus2euap"),
-	// 		ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
-	// 		Name: to.Ptr("myWatcher"),
-	// 		Type: to.Ptr("microsoft.databasewatcher/watchers"),
-	// 		SystemData: &armdatabasewatcher.SystemData{
-	// 			CreatedBy: to.Ptr("enbpvlpqbwd"),
-	// 			CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("mxp"),
-	// 			LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// Location: eastus2euap
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher
+	// Name: myWatcher
+	// Type: microsoft.databasewatcher/watchers
 }
 
 // Generated from example definition: 2024-07-19-preview/Watchers_ListByResourceGroup_MaximumSet_Gen.json
 func ExampleWatchersClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				WatchersServer: fake.WatchersServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armdatabasewatcher.WatchersClientListByResourceGroupOptions) (resp azfake.PagerResponder[armdatabasewatcher.WatchersClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armdatabasewatcher.WatchersClientListByResourceGroupResponse{
+							WatcherListResult: armdatabasewatcher.WatcherListResult{
+								Value: []*armdatabasewatcher.Watcher{
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
+										Name:     to.Ptr("myWatcher"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("West US"),
+										Tags:     map[string]*string{},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-4sz1jg/providers/Microsoft.DatabaseWatcher/watchers/databasemo4o4zdf"),
+										Name:     to.Ptr("databasemo4o4zdf"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-d77ftn/providers/Microsoft.DatabaseWatcher/watchers/databasemosn3h6l"),
+										Name:     to.Ptr("databasemosn3h6l"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-nyb4gm/providers/Microsoft.DatabaseWatcher/watchers/databasemoyb6iar"),
+										Name:     to.Ptr("databasemoyb6iar"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-llis4j/providers/Microsoft.DatabaseWatcher/watchers/databasemoi04xst"),
+										Name:     to.Ptr("databasemoi04xst"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-thy6zd/providers/Microsoft.DatabaseWatcher/watchers/databasemonpyl24"),
+										Name:     to.Ptr("databasemonpyl24"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("ysoqerxnmxqsvhmvjojoyzotc"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T18:01:18.690Z"); return t }()),
+											LastModifiedBy:     to.Ptr("yrilzsg"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T18:01:18.690Z"); return t }()),
+										},
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih"),
+										Name:     to.Ptr("databasemo3ej9ih"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -192,287 +487,306 @@ func ExampleWatchersClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdatabasewatcher.WatchersClientListByResourceGroupResponse{
-		// 	WatcherListResult: armdatabasewatcher.WatcherListResult{
-		// 		Value: []*armdatabasewatcher.Watcher{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
-		// 				Name: to.Ptr("myWatcher"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("West US"),
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-4sz1jg/providers/Microsoft.DatabaseWatcher/watchers/databasemo4o4zdf"),
-		// 				Name: to.Ptr("databasemo4o4zdf"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-d77ftn/providers/Microsoft.DatabaseWatcher/watchers/databasemosn3h6l"),
-		// 				Name: to.Ptr("databasemosn3h6l"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-nyb4gm/providers/Microsoft.DatabaseWatcher/watchers/databasemoyb6iar"),
-		// 				Name: to.Ptr("databasemoyb6iar"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-llis4j/providers/Microsoft.DatabaseWatcher/watchers/databasemoi04xst"),
-		// 				Name: to.Ptr("databasemoi04xst"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-thy6zd/providers/Microsoft.DatabaseWatcher/watchers/databasemonpyl24"),
-		// 				Name: to.Ptr("databasemonpyl24"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("ysoqerxnmxqsvhmvjojoyzotc"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T18:01:18.690Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("yrilzsg"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T18:01:18.690Z"); return t}()),
-		// 				},
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih"),
-		// 				Name: to.Ptr("databasemo3ej9ih"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 		NextLink: to.Ptr("https://microsoft.com/a"),
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher
+	// Name: myWatcher
+	// Type: microsoft.databasewatcher/watchers
+	// Location: West US
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-4sz1jg/providers/Microsoft.DatabaseWatcher/watchers/databasemo4o4zdf
+	// Name: databasemo4o4zdf
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-d77ftn/providers/Microsoft.DatabaseWatcher/watchers/databasemosn3h6l
+	// Name: databasemosn3h6l
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-nyb4gm/providers/Microsoft.DatabaseWatcher/watchers/databasemoyb6iar
+	// Name: databasemoyb6iar
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-llis4j/providers/Microsoft.DatabaseWatcher/watchers/databasemoi04xst
+	// Name: databasemoi04xst
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-thy6zd/providers/Microsoft.DatabaseWatcher/watchers/databasemonpyl24
+	// Name: databasemonpyl24
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih
+	// Name: databasemo3ej9ih
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
 }
 
 // Generated from example definition: 2024-07-19-preview/Watchers_ListBySubscription_MaximumSet_Gen.json
 func ExampleWatchersClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				WatchersServer: fake.WatchersServer{
+					NewListBySubscriptionPager: func(options *armdatabasewatcher.WatchersClientListBySubscriptionOptions) (resp azfake.PagerResponder[armdatabasewatcher.WatchersClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armdatabasewatcher.WatchersClientListBySubscriptionResponse{
+							WatcherListResult: armdatabasewatcher.WatcherListResult{
+								Value: []*armdatabasewatcher.Watcher{
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
+										Name:     to.Ptr("myWatcher"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("West US"),
+										Tags:     map[string]*string{},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityTypeSystemAssigned),
+										},
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-4sz1jg/providers/Microsoft.DatabaseWatcher/watchers/databasemo4o4zdf"),
+										Name:     to.Ptr("databasemo4o4zdf"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-d77ftn/providers/Microsoft.DatabaseWatcher/watchers/databasemosn3h6l"),
+										Name:     to.Ptr("databasemosn3h6l"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-nyb4gm/providers/Microsoft.DatabaseWatcher/watchers/databasemoyb6iar"),
+										Name:     to.Ptr("databasemoyb6iar"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-llis4j/providers/Microsoft.DatabaseWatcher/watchers/databasemoi04xst"),
+										Name:     to.Ptr("databasemoi04xst"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-thy6zd/providers/Microsoft.DatabaseWatcher/watchers/databasemonpyl24"),
+										Name:     to.Ptr("databasemonpyl24"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("ysoqerxnmxqsvhmvjojoyzotc"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T18:01:18.690Z"); return t }()),
+											LastModifiedBy:     to.Ptr("yrilzsg"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T18:01:18.690Z"); return t }()),
+										},
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih"),
+										Name:     to.Ptr("databasemo3ej9ih"),
+										Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+										Location: to.Ptr("westus"),
+										Properties: &armdatabasewatcher.WatcherProperties{
+											Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+											ProvisioningState:                  to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+											DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+											Datastore: &armdatabasewatcher.Datastore{
+												AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+												KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+												KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+												KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+												KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+												KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+												KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+											},
+										},
+										Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+											Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
+											UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+											PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+											TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+										},
+										Tags: map[string]*string{},
+										SystemData: &armdatabasewatcher.SystemData{
+											CreatedBy:          to.Ptr("enbpvlpqbwd"),
+											CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+											LastModifiedBy:     to.Ptr("mxp"),
+											LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -483,283 +797,59 @@ func ExampleWatchersClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdatabasewatcher.WatchersClientListBySubscriptionResponse{
-		// 	WatcherListResult: armdatabasewatcher.WatcherListResult{
-		// 		Value: []*armdatabasewatcher.Watcher{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
-		// 				Name: to.Ptr("myWatcher"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("West US"),
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityTypeSystemAssigned),
-		// 				},
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-4sz1jg/providers/Microsoft.DatabaseWatcher/watchers/databasemo4o4zdf"),
-		// 				Name: to.Ptr("databasemo4o4zdf"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-d77ftn/providers/Microsoft.DatabaseWatcher/watchers/databasemosn3h6l"),
-		// 				Name: to.Ptr("databasemosn3h6l"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-nyb4gm/providers/Microsoft.DatabaseWatcher/watchers/databasemoyb6iar"),
-		// 				Name: to.Ptr("databasemoyb6iar"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-llis4j/providers/Microsoft.DatabaseWatcher/watchers/databasemoi04xst"),
-		// 				Name: to.Ptr("databasemoi04xst"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-thy6zd/providers/Microsoft.DatabaseWatcher/watchers/databasemonpyl24"),
-		// 				Name: to.Ptr("databasemonpyl24"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("ysoqerxnmxqsvhmvjojoyzotc"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T18:01:18.690Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("yrilzsg"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T18:01:18.690Z"); return t}()),
-		// 				},
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih"),
-		// 				Name: to.Ptr("databasemo3ej9ih"),
-		// 				Type: to.Ptr("microsoft.databasewatcher/watchers"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armdatabasewatcher.WatcherProperties{
-		// 					Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-		// 					ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-		// 					DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-		// 					Datastore: &armdatabasewatcher.Datastore{
-		// 						AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-		// 						KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-		// 						KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-		// 						KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-		// 						KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-		// 						KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-		// 					},
-		// 				},
-		// 				Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-		// 					Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityType("SystemAssignedIdentity")),
-		// 					UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-		// 					},
-		// 					PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 					TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 				},
-		// 				SystemData: &armdatabasewatcher.SystemData{
-		// 					CreatedBy: to.Ptr("enbpvlpqbwd"),
-		// 					CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("mxp"),
-		// 					LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 		NextLink: to.Ptr("https://microsoft.com/a"),
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher
+	// Name: myWatcher
+	// Type: microsoft.databasewatcher/watchers
+	// Location: West US
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-4sz1jg/providers/Microsoft.DatabaseWatcher/watchers/databasemo4o4zdf
+	// Name: databasemo4o4zdf
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-d77ftn/providers/Microsoft.DatabaseWatcher/watchers/databasemosn3h6l
+	// Name: databasemosn3h6l
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-nyb4gm/providers/Microsoft.DatabaseWatcher/watchers/databasemoyb6iar
+	// Name: databasemoyb6iar
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-llis4j/providers/Microsoft.DatabaseWatcher/watchers/databasemoi04xst
+	// Name: databasemoi04xst
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-thy6zd/providers/Microsoft.DatabaseWatcher/watchers/databasemonpyl24
+	// Name: databasemonpyl24
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest-ddat4p/providers/Microsoft.DatabaseWatcher/watchers/databasemo3ej9ih
+	// Name: databasemo3ej9ih
+	// Type: microsoft.databasewatcher/watchers
+	// Location: westus
 }
 
 // Generated from example definition: 2024-07-19-preview/Watchers_Start_MaximumSet_Gen.json
 func ExampleWatchersClient_BeginStart() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				WatchersServer: fake.WatchersServer{
+					BeginStart: func(ctx context.Context, resourceGroupName string, watcherName string, options *armdatabasewatcher.WatchersClientBeginStartOptions) (resp azfake.PollerResponder[armdatabasewatcher.WatchersClientStartResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armdatabasewatcher.WatchersClientStartResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -773,19 +863,25 @@ func ExampleWatchersClient_BeginStart() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.WatchersClientStartResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-07-19-preview/Watchers_Stop_MaximumSet_Gen.json
 func ExampleWatchersClient_BeginStop() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				WatchersServer: fake.WatchersServer{
+					BeginStop: func(ctx context.Context, resourceGroupName string, watcherName string, options *armdatabasewatcher.WatchersClientBeginStopOptions) (resp azfake.PollerResponder[armdatabasewatcher.WatchersClientStopResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armdatabasewatcher.WatchersClientStopResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -799,19 +895,61 @@ func ExampleWatchersClient_BeginStop() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.WatchersClientStopResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-07-19-preview/Watchers_Update_MaximumSet_Gen.json
 func ExampleWatchersClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, nil)
+	clientFactory, err := armdatabasewatcher.NewClientFactory("6f53185c-ea09-4fc3-9075-318dec805303", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				WatchersServer: fake.WatchersServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, watcherName string, properties armdatabasewatcher.WatcherUpdate, options *armdatabasewatcher.WatchersClientBeginUpdateOptions) (resp azfake.PollerResponder[armdatabasewatcher.WatchersClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armdatabasewatcher.WatchersClientUpdateResponse{
+							Watcher: armdatabasewatcher.Watcher{
+								Properties: &armdatabasewatcher.WatcherProperties{
+									Status:                             to.Ptr(armdatabasewatcher.WatcherStatusStarting),
+									DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
+									Datastore: &armdatabasewatcher.Datastore{
+										AdxClusterResourceID:    to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
+										KustoClusterURI:         to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
+										KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
+										KustoDataIngestionURI:   to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
+										KustoDatabaseName:       to.Ptr("kustoDatabaseName1"),
+										KustoManagementURL:      to.Ptr("https://portal.azure.com/"),
+										KustoOfferingType:       to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
+									},
+									ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
+								},
+								Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
+									Type:                   to.Ptr(armdatabasewatcher.ManagedServiceIdentityTypeSystemAssigned),
+									UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{},
+									PrincipalID:            to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+									TenantID:               to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
+								},
+								Tags:     map[string]*string{},
+								Location: to.Ptr("eastus2euap"),
+								ID:       to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
+								Name:     to.Ptr("myWatcher"),
+								Type:     to.Ptr("microsoft.databasewatcher/watchers"),
+								SystemData: &armdatabasewatcher.SystemData{
+									CreatedBy:          to.Ptr("enbpvlpqbwd"),
+									CreatedByType:      to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+									LastModifiedBy:     to.Ptr("mxp"),
+									LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -840,46 +978,13 @@ func ExampleWatchersClient_BeginUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdatabasewatcher.WatchersClientUpdateResponse{
-	// 	Watcher: armdatabasewatcher.Watcher{
-	// 		Properties: &armdatabasewatcher.WatcherProperties{
-	// 			Status: to.Ptr(armdatabasewatcher.WatcherStatusStarting),
-	// 			DefaultAlertRuleIdentityResourceID: to.Ptr("/subscriptions/469DD77C-C8DB-47B7-B9E1-72D29F8C878B/resourceGroups/rgWatcher/providers/Microsoft.ManagedIdentity/userAssignedIdentities/3pmtest"),
-	// 			Datastore: &armdatabasewatcher.Datastore{
-	// 				AdxClusterResourceID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/apiTest/providers/Microsoft.Kusto/clusters/apiTestKusto"),
-	// 				KustoClusterURI: to.Ptr("https://kustouri-adx.eastus.kusto.windows.net"),
-	// 				KustoClusterDisplayName: to.Ptr("kustoUri-adx"),
-	// 				KustoDataIngestionURI: to.Ptr("https://ingest-kustouri-adx.eastus.kusto.windows.net"),
-	// 				KustoDatabaseName: to.Ptr("kustoDatabaseName1"),
-	// 				KustoManagementURL: to.Ptr("https://portal.azure.com/"),
-	// 				KustoOfferingType: to.Ptr(armdatabasewatcher.KustoOfferingTypeAdx),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armdatabasewatcher.DatabaseWatcherProvisioningStateSucceeded),
-	// 		},
-	// 		Identity: &armdatabasewatcher.ManagedServiceIdentityV4{
-	// 			Type: to.Ptr(armdatabasewatcher.ManagedServiceIdentityTypeSystemAssigned),
-	// 			UserAssignedIdentities: map[string]*armdatabasewatcher.UserAssignedIdentity{
-	// 			},
-	// 			PrincipalID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-	// 			TenantID: to.Ptr("6f53185c-ea09-4fc3-9075-318dec805303"),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Location: to.Ptr("eastus2euap"),
-	// 		ID: to.Ptr("/subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher"),
-	// 		Name: to.Ptr("myWatcher"),
-	// 		Type: to.Ptr("microsoft.databasewatcher/watchers"),
-	// 		SystemData: &armdatabasewatcher.SystemData{
-	// 			CreatedBy: to.Ptr("enbpvlpqbwd"),
-	// 			CreatedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("mxp"),
-	// 			LastModifiedByType: to.Ptr(armdatabasewatcher.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-07-25T15:38:47.092Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// Location: eastus2euap
+	// ID: /subscriptions/6f53185c-ea09-4fc3-9075-318dec805303/resourceGroups/dummyrg/providers/Microsoft.DatabaseWatcher/watchers/myWatcher
+	// Name: myWatcher
+	// Type: microsoft.databasewatcher/watchers
 }
@@ -6,20 +6,65 @@ package armdevopsinfrastructure_test
 
 import (
 	"armdevopsinfrastructure"
+	"armdevopsinfrastructure/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-04-04-preview/CreateOrUpdatePool.json
 func ExamplePoolsClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, nil)
+	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PoolsServer: fake.PoolsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, poolName string, resource armdevopsinfrastructure.Pool, options *armdevopsinfrastructure.PoolsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armdevopsinfrastructure.PoolsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armdevopsinfrastructure.PoolsClientCreateOrUpdateResponse{
+							Pool: armdevopsinfrastructure.Pool{
+								Properties: &armdevopsinfrastructure.PoolProperties{
+									ProvisioningState:          to.Ptr(armdevopsinfrastructure.ProvisioningStateSucceeded),
+									MaximumConcurrency:         to.Ptr[int32](10),
+									DevCenterProjectResourceID: to.Ptr("/subscriptions/222e81d0-cf38-4dab-baa5-289bf16baaa4/resourceGroups/rg-1es-devcenter/providers/Microsoft.DevCenter/projects/1ES"),
+									OrganizationProfile: &armdevopsinfrastructure.AzureDevOpsOrganizationProfile{
+										Kind: to.Ptr("AzureDevOps"),
+										Organizations: []*armdevopsinfrastructure.Organization{
+											{
+												URL: to.Ptr("https://mseng.visualstudio.com"),
+											},
+										},
+									},
+									AgentProfile: &armdevopsinfrastructure.StatelessAgentProfile{
+										Kind: to.Ptr("Stateless"),
+									},
+									FabricProfile: &armdevopsinfrastructure.VmssFabricProfile{
+										Kind: to.Ptr("Vmss"),
+										SKU: &armdevopsinfrastructure.DevOpsAzureSKU{
+											Name: to.Ptr("Standard_D4ads_v5"),
+										},
+										Images: []*armdevopsinfrastructure.PoolImage{
+											{
+												ResourceID: to.Ptr("/MicrosoftWindowsServer/WindowsServer/2019-Datacenter/latest"),
+											},
+										},
+									},
+								},
+								ID:       to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/rg/providers/Microsoft.DevOpsInfrastructure/Pools/pool"),
+								Location: to.Ptr("eastus"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -60,52 +105,29 @@ func ExamplePoolsClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdevopsinfrastructure.PoolsClientCreateOrUpdateResponse{
-	// 	Pool: armdevopsinfrastructure.Pool{
-	// 		Properties: &armdevopsinfrastructure.PoolProperties{
-	// 			ProvisioningState: to.Ptr(armdevopsinfrastructure.ProvisioningStateSucceeded),
-	// 			MaximumConcurrency: to.Ptr[int32](10),
-	// 			DevCenterProjectResourceID: to.Ptr("/subscriptions/222e81d0-cf38-4dab-baa5-289bf16baaa4/resourceGroups/rg-1es-devcenter/providers/Microsoft.DevCenter/projects/1ES"),
-	// 			OrganizationProfile: &armdevopsinfrastructure.AzureDevOpsOrganizationProfile{
-	// 				Kind: to.Ptr("AzureDevOps"),
-	// 				Organizations: []*armdevopsinfrastructure.Organization{
-	// 					{
-	// 						URL: to.Ptr("https://mseng.visualstudio.com"),
-	// 					},
-	// 				},
-	// 			},
-	// 			AgentProfile: &armdevopsinfrastructure.StatelessAgentProfile{
-	// 				Kind: to.Ptr("Stateless"),
-	// 			},
-	// 			FabricProfile: &armdevopsinfrastructure.VmssFabricProfile{
-	// 				Kind: to.Ptr("Vmss"),
-	// 				SKU: &armdevopsinfrastructure.DevOpsAzureSKU{
-	// 					Name: to.Ptr("Standard_D4ads_v5"),
-	// 				},
-	// 				Images: []*armdevopsinfrastructure.PoolImage{
-	// 					{
-	// 						ResourceID: to.Ptr("/MicrosoftWindowsServer/WindowsServer/2019-Datacenter/latest"),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/rg/providers/Microsoft.DevOpsInfrastructure/Pools/pool"),
-	// 		Location: to.Ptr("eastus"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/rg/providers/Microsoft.DevOpsInfrastructure/Pools/pool
+	// Location: eastus
 }
 
 // Generated from example definition: 2024-04-04-preview/DeletePool.json
 func ExamplePoolsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, nil)
+	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PoolsServer: fake.PoolsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, poolName string, options *armdevopsinfrastructure.PoolsClientBeginDeleteOptions) (resp azfake.PollerResponder[armdevopsinfrastructure.PoolsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armdevopsinfrastructure.PoolsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -117,16 +139,57 @@ func ExamplePoolsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-04-04-preview/GetPool.json
 func ExamplePoolsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, nil)
+	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PoolsServer: fake.PoolsServer{
+					Get: func(ctx context.Context, resourceGroupName string, poolName string, options *armdevopsinfrastructure.PoolsClientGetOptions) (resp azfake.Responder[armdevopsinfrastructure.PoolsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armdevopsinfrastructure.PoolsClientGetResponse{
+							Pool: armdevopsinfrastructure.Pool{
+								Properties: &armdevopsinfrastructure.PoolProperties{
+									ProvisioningState:          to.Ptr(armdevopsinfrastructure.ProvisioningStateSucceeded),
+									MaximumConcurrency:         to.Ptr[int32](10),
+									DevCenterProjectResourceID: to.Ptr("/subscriptions/222e81d0-cf38-4dab-baa5-289bf16baaa4/resourceGroups/rg-1es-devcenter/providers/Microsoft.DevCenter/projects/1ES"),
+									OrganizationProfile: &armdevopsinfrastructure.AzureDevOpsOrganizationProfile{
+										Kind: to.Ptr("AzureDevOps"),
+										Organizations: []*armdevopsinfrastructure.Organization{
+											{
+												URL: to.Ptr("https://mseng.visualstudio.com"),
+											},
+										},
+									},
+									AgentProfile: &armdevopsinfrastructure.StatelessAgentProfile{
+										Kind: to.Ptr("Stateless"),
+									},
+									FabricProfile: &armdevopsinfrastructure.VmssFabricProfile{
+										Kind: to.Ptr("Vmss"),
+										SKU: &armdevopsinfrastructure.DevOpsAzureSKU{
+											Name: to.Ptr("Standard_D4ads_v5"),
+										},
+										Images: []*armdevopsinfrastructure.PoolImage{
+											{
+												ResourceID: to.Ptr("/MicrosoftWindowsServer/WindowsServer/2019-Datacenter/latest"),
+											},
+										},
+									},
+								},
+								ID:       to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/rg/providers/Microsoft.DevOpsInfrastructure/Pools/pool"),
+								Location: to.Ptr("eastus"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -134,52 +197,38 @@ func ExamplePoolsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdevopsinfrastructure.PoolsClientGetResponse{
-	// 	Pool: armdevopsinfrastructure.Pool{
-	// 		Properties: &armdevopsinfrastructure.PoolProperties{
-	// 			ProvisioningState: to.Ptr(armdevopsinfrastructure.ProvisioningStateSucceeded),
-	// 			MaximumConcurrency: to.Ptr[int32](10),
-	// 			DevCenterProjectResourceID: to.Ptr("/subscriptions/222e81d0-cf38-4dab-baa5-289bf16baaa4/resourceGroups/rg-1es-devcenter/providers/Microsoft.DevCenter/projects/1ES"),
-	// 			OrganizationProfile: &armdevopsinfrastructure.AzureDevOpsOrganizationProfile{
-	// 				Kind: to.Ptr("AzureDevOps"),
-	// 				Organizations: []*armdevopsinfrastructure.Organization{
-	// 					{
-	// 						URL: to.Ptr("https://mseng.visualstudio.com"),
-	// 					},
-	// 				},
-	// 			},
-	// 			AgentProfile: &armdevopsinfrastructure.StatelessAgentProfile{
-	// 				Kind: to.Ptr("Stateless"),
-	// 			},
-	// 			FabricProfile: &armdevopsinfrastructure.VmssFabricProfile{
-	// 				Kind: to.Ptr("Vmss"),
-	// 				SKU: &armdevopsinfrastructure.DevOpsAzureSKU{
-	// 					Name: to.Ptr("Standard_D4ads_v5"),
-	// 				},
-	// 				Images: []*armdevopsinfrastructure.PoolImage{
-	// 					{
-	// 						ResourceID: to.Ptr("/MicrosoftWindowsServer/WindowsServer/2019-Datacenter/latest"),
-	// 					},
-	// 				},
-	// 			},
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/rg/providers/Microsoft.DevOpsInfrastructure/Pools/pool"),
-	// 		Location: to.Ptr("eastus"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/rg/providers/Microsoft.DevOpsInfrastructure/Pools/pool
+	// Location: eastus
 }
 
 // Generated from example definition: 2024-04-04-preview/ListPoolsBySubscriptionAndResourceGroup.json
 func ExamplePoolsClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, nil)
+	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PoolsServer: fake.PoolsServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armdevopsinfrastructure.PoolsClientListByResourceGroupOptions) (resp azfake.PagerResponder[armdevopsinfrastructure.PoolsClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armdevopsinfrastructure.PoolsClientListByResourceGroupResponse{
+							PoolListResult: armdevopsinfrastructure.PoolListResult{
+								Value: []*armdevopsinfrastructure.Pool{
+									{
+										ID:       to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/rg/providers/Microsoft.DevOpsInfrastructure/Pools/pool"),
+										Location: to.Ptr("eastus"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -190,31 +239,40 @@ func ExamplePoolsClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdevopsinfrastructure.PoolsClientListByResourceGroupResponse{
-		// 	PoolListResult: armdevopsinfrastructure.PoolListResult{
-		// 		Value: []*armdevopsinfrastructure.Pool{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/rg/providers/Microsoft.DevOpsInfrastructure/Pools/pool"),
-		// 				Location: to.Ptr("eastus"),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/rg/providers/Microsoft.DevOpsInfrastructure/Pools/pool
+	// Location: eastus
 }
 
 // Generated from example definition: 2024-04-04-preview/ListPoolsBySubscription.json
 func ExamplePoolsClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, nil)
+	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PoolsServer: fake.PoolsServer{
+					NewListBySubscriptionPager: func(options *armdevopsinfrastructure.PoolsClientListBySubscriptionOptions) (resp azfake.PagerResponder[armdevopsinfrastructure.PoolsClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armdevopsinfrastructure.PoolsClientListBySubscriptionResponse{
+							PoolListResult: armdevopsinfrastructure.PoolListResult{
+								Value: []*armdevopsinfrastructure.Pool{
+									{
+										ID:       to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/aoiresourceGroupName/providers/Microsoft.DevOpsInfrastructure/Pools/pool"),
+										Location: to.Ptr("eastus"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -225,31 +283,35 @@ func ExamplePoolsClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdevopsinfrastructure.PoolsClientListBySubscriptionResponse{
-		// 	PoolListResult: armdevopsinfrastructure.PoolListResult{
-		// 		Value: []*armdevopsinfrastructure.Pool{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/aoiresourceGroupName/providers/Microsoft.DevOpsInfrastructure/Pools/pool"),
-		// 				Location: to.Ptr("eastus"),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/aoiresourceGroupName/providers/Microsoft.DevOpsInfrastructure/Pools/pool
+	// Location: eastus
 }
 
 // Generated from example definition: 2024-04-04-preview/UpdatePool.json
 func ExamplePoolsClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, nil)
+	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PoolsServer: fake.PoolsServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, poolName string, properties armdevopsinfrastructure.PoolUpdate, options *armdevopsinfrastructure.PoolsClientBeginUpdateOptions) (resp azfake.PollerResponder[armdevopsinfrastructure.PoolsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armdevopsinfrastructure.PoolsClientUpdateResponse{
+							Pool: armdevopsinfrastructure.Pool{
+								Location: to.Ptr("eastus"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -261,12 +323,7 @@ func ExamplePoolsClient_BeginUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armdevopsinfrastructure.PoolsClientUpdateResponse{
-	// 	Pool: armdevopsinfrastructure.Pool{
-	// 		Location: to.Ptr("eastus"),
-	// 	},
-	// }
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Location: eastus
 }
@@ -6,19 +6,56 @@ package armdevopsinfrastructure_test
 
 import (
 	"armdevopsinfrastructure"
+	"armdevopsinfrastructure/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-04-04-preview/ResourceDetails_ListByPool.json
 func ExampleResourceDetailsClient_NewListByPoolPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, nil)
+	clientFactory, err := armdevopsinfrastructure.NewClientFactory("a2e95d27-c161-4b61-bda4-11512c14c2c2", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ResourceDetailsServer: fake.ResourceDetailsServer{
+					NewListByPoolPager: func(resourceGroupName string, poolName string, options *armdevopsinfrastructure.ResourceDetailsClientListByPoolOptions) (resp azfake.PagerResponder[armdevopsinfrastructure.ResourceDetailsClientListByPoolResponse]) {
+						resp.AddPage(http.StatusOK, armdevopsinfrastructure.ResourceDetailsClientListByPoolResponse{
+							ResourceDetailsObjectListResult: armdevopsinfrastructure.ResourceDetailsObjectListResult{
+								Value: []*armdevopsinfrastructure.ResourceDetailsObject{
+									{
+										ID:   to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/my-resource-group/providers/Microsoft.DevOpsInfrastructure/pools/my-devops-pool/resources/my-dev-ops-pool:04dcde21-626e-5a7e-8659-ce12f9284b29:dd8cc705c_0"),
+										Name: to.Ptr("dd8cc705c000000"),
+										Properties: &armdevopsinfrastructure.ResourceDetailsObjectProperties{
+											Image:        to.Ptr("my-image"),
+											ImageVersion: to.Ptr("4.0.0"),
+											Status:       to.Ptr(armdevopsinfrastructure.ResourceStatusReady),
+										},
+									},
+									{
+										ID:   to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/my-resource-group/providers/Microsoft.DevOpsInfrastructure/pools/my-devops-pool/resources/my-dev-ops-pool:04dcde21-626e-5a7e-8659-ce12f9284b29:dd8cc705c_1"),
+										Name: to.Ptr("dd8cc705c000001"),
+										Properties: &armdevopsinfrastructure.ResourceDetailsObjectProperties{
+											Image:        to.Ptr("my-image"),
+											ImageVersion: to.Ptr("4.0.0"),
+											Status:       to.Ptr(armdevopsinfrastructure.ResourceStatusAllocated),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,33 +66,13 @@ func ExampleResourceDetailsClient_NewListByPoolPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armdevopsinfrastructure.ResourceDetailsClientListByPoolResponse{
-		// 	ResourceDetailsObjectListResult: armdevopsinfrastructure.ResourceDetailsObjectListResult{
-		// 		Value: []*armdevopsinfrastructure.ResourceDetailsObject{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/my-resource-group/providers/Microsoft.DevOpsInfrastructure/pools/my-devops-pool/resources/my-dev-ops-pool:04dcde21-626e-5a7e-8659-ce12f9284b29:dd8cc705c_0"),
-		// 				Name: to.Ptr("dd8cc705c000000"),
-		// 				Properties: &armdevopsinfrastructure.ResourceDetailsObjectProperties{
-		// 					Image: to.Ptr("my-image"),
-		// 					ImageVersion: to.Ptr("4.0.0"),
-		// 					Status: to.Ptr(armdevopsinfrastructure.ResourceStatusReady),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/my-resource-group/providers/Microsoft.DevOpsInfrastructure/pools/my-devops-pool/resources/my-dev-ops-pool:04dcde21-626e-5a7e-8659-ce12f9284b29:dd8cc705c_1"),
-		// 				Name: to.Ptr("dd8cc705c000001"),
-		// 				Properties: &armdevopsinfrastructure.ResourceDetailsObjectProperties{
-		// 					Image: to.Ptr("my-image"),
-		// 					ImageVersion: to.Ptr("4.0.0"),
-		// 					Status: to.Ptr(armdevopsinfrastructure.ResourceStatusAllocated),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/my-resource-group/providers/Microsoft.DevOpsInfrastructure/pools/my-devops-pool/resources/my-dev-ops-pool:04dcde21-626e-5a7e-8659-ce12f9284b29:dd8cc705c_0
+	// Name: dd8cc705c000000
+	// ID: /subscriptions/a2e95d27-c161-4b61-bda4-11512c14c2c2/resourceGroups/my-resource-group/providers/Microsoft.DevOpsInfrastructure/pools/my-devops-pool/resources/my-dev-ops-pool:04dcde21-626e-5a7e-8659-ce12f9284b29:dd8cc705c_1
+	// Name: dd8cc705c000001
 }
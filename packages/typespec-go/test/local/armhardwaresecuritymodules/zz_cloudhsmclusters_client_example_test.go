@@ -6,20 +6,34 @@ package armhardwaresecuritymodules_test
 
 import (
 	"armhardwaresecuritymodules"
+	"armhardwaresecuritymodules/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_Create_Backup_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_BeginBackup() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					BeginBackup: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, options *armhardwaresecuritymodules.CloudHsmClustersClientBeginBackupOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.CloudHsmClustersClientBackupResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientBackupResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -37,19 +51,36 @@ func ExampleCloudHsmClustersClient_BeginBackup() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.CloudHsmClustersClientBackupResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_Backup_Pending_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_CloudHsmClusterBackupStatusGet() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					CloudHsmClusterBackupStatusGet: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, jobID string, options *armhardwaresecuritymodules.CloudHsmClustersClientCloudHsmClusterBackupStatusGetOptions) (resp azfake.Responder[armhardwaresecuritymodules.CloudHsmClustersClientCloudHsmClusterBackupStatusGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientCloudHsmClusterBackupStatusGetResponse{
+							BackupResult: armhardwaresecuritymodules.BackupResult{
+								Properties: &armhardwaresecuritymodules.BackupResultProperties{
+									AzureStorageBlobContainerURI: to.Ptr("https://myaccount.blob.core.windows.net/sascontainer/sasContainer"),
+									EndTime:                      to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									JobID:                        to.Ptr("572a45927fc240e1ac075de27371680b"),
+									StartTime:                    to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									Status:                       to.Ptr(armhardwaresecuritymodules.BackupRestoreOperationStatusInProgress),
+									StatusDetails:                to.Ptr("Backup operation is in progress"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -59,29 +90,35 @@ func ExampleCloudHsmClustersClient_CloudHsmClusterBackupStatusGet() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.CloudHsmClustersClientCloudHsmClusterBackupStatusGetResponse{
-	// 	BackupResult: armhardwaresecuritymodules.BackupResult{
-	// 		Properties: &armhardwaresecuritymodules.BackupResultProperties{
-	// 			AzureStorageBlobContainerURI: to.Ptr("https://myaccount.blob.core.windows.net/sascontainer/sasContainer"),
-	// 			EndTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			JobID: to.Ptr("572a45927fc240e1ac075de27371680b"),
-	// 			StartTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			Status: to.Ptr(armhardwaresecuritymodules.BackupRestoreOperationStatusInProgress),
-	// 			StatusDetails: to.Ptr("Backup operation is in progress"),
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_Restore_Pending_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_CloudHsmClusterRestoreStatusGet() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					CloudHsmClusterRestoreStatusGet: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, jobID string, options *armhardwaresecuritymodules.CloudHsmClustersClientCloudHsmClusterRestoreStatusGetOptions) (resp azfake.Responder[armhardwaresecuritymodules.CloudHsmClustersClientCloudHsmClusterRestoreStatusGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientCloudHsmClusterRestoreStatusGetResponse{
+							RestoreResult: armhardwaresecuritymodules.RestoreResult{
+								Properties: &armhardwaresecuritymodules.BackupRestoreBaseResultProperties{
+									EndTime:       to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									JobID:         to.Ptr("572a45927fc240e1ac075de27371680b"),
+									StartTime:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									Status:        to.Ptr(armhardwaresecuritymodules.BackupRestoreOperationStatusInProgress),
+									StatusDetails: to.Ptr("Restore operation is in progress"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -91,28 +128,64 @@ func ExampleCloudHsmClustersClient_CloudHsmClusterRestoreStatusGet() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.CloudHsmClustersClientCloudHsmClusterRestoreStatusGetResponse{
-	// 	RestoreResult: armhardwaresecuritymodules.RestoreResult{
-	// 		Properties: &armhardwaresecuritymodules.BackupRestoreBaseResultProperties{
-	// 			EndTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			JobID: to.Ptr("572a45927fc240e1ac075de27371680b"),
-	// 			StartTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			Status: to.Ptr(armhardwaresecuritymodules.BackupRestoreOperationStatusInProgress),
-	// 			StatusDetails: to.Ptr("Restore operation is in progress"),
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_CreateOrUpdate_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, body armhardwaresecuritymodules.CloudHsmCluster, options *armhardwaresecuritymodules.CloudHsmClustersClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.CloudHsmClustersClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientCreateOrUpdateResponse{
+							CloudHsmCluster: armhardwaresecuritymodules.CloudHsmCluster{
+								Name: to.Ptr("chsm1"),
+								Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
+								Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
+									Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
+									UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
+										"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{
+											ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+											PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+										},
+									},
+								},
+								Location: to.Ptr("eastus2"),
+								Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
+									ActivationState:                   to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
+									AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
+									ProvisioningState:                 to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
+									PublicNetworkAccess:               to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
+									StatusMessage:                     to.Ptr("This is a status message"),
+								},
+								SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
+									Name:   to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
+									Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
+								},
+								SystemData: &armhardwaresecuritymodules.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									CreatedBy:          to.Ptr("CHsmUser1"),
+									CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									LastModifiedBy:     to.Ptr("CHsmUser2"),
+									LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+								},
+								Tags: map[string]*string{
+									"Dept":        to.Ptr("hsm"),
+									"Environment": to.Ptr("dogfood"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -143,59 +216,33 @@ func ExampleCloudHsmClustersClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.CloudHsmClustersClientCreateOrUpdateResponse{
-	// 	CloudHsmCluster: armhardwaresecuritymodules.CloudHsmCluster{
-	// 		Name: to.Ptr("chsm1"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
-	// 		Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
-	// 			Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
-	// 			UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
-	// 				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{
-	// 					ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 					PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 				},
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("eastus2"),
-	// 		Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
-	// 			ActivationState: to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
-	// 			AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
-	// 			PublicNetworkAccess: to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
-	// 			StatusMessage: to.Ptr("This is a status message"),
-	// 		},
-	// 		SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
-	// 			Name: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
-	// 			Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
-	// 		},
-	// 		SystemData: &armhardwaresecuritymodules.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			CreatedBy: to.Ptr("CHsmUser1"),
-	// 			CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("CHsmUser2"),
-	// 			LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"Dept": to.Ptr("hsm"),
-	// 			"Environment": to.Ptr("dogfood"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: chsm1
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1
+	// Location: eastus2
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_Delete_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, options *armhardwaresecuritymodules.CloudHsmClustersClientBeginDeleteOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.CloudHsmClustersClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armhardwaresecuritymodules.CloudHsmClustersClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -207,16 +254,64 @@ func ExampleCloudHsmClustersClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_Get_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					Get: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, options *armhardwaresecuritymodules.CloudHsmClustersClientGetOptions) (resp azfake.Responder[armhardwaresecuritymodules.CloudHsmClustersClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientGetResponse{
+							CloudHsmCluster: armhardwaresecuritymodules.CloudHsmCluster{
+								Name: to.Ptr("chsm1"),
+								Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
+								Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
+									Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
+									UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
+										"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{
+											ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+											PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+										},
+									},
+								},
+								Location: to.Ptr("eastus2"),
+								Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
+									ActivationState:                   to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
+									AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
+									ProvisioningState:                 to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
+									PublicNetworkAccess:               to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
+									StatusMessage:                     to.Ptr("This is a status message"),
+								},
+								SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
+									Name:   to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
+									Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
+								},
+								SystemData: &armhardwaresecuritymodules.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									CreatedBy:          to.Ptr("CHsmUser1"),
+									CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									LastModifiedBy:     to.Ptr("CHsmUser2"),
+									LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+								},
+								Tags: map[string]*string{
+									"Dept":        to.Ptr("hsm"),
+									"Environment": to.Ptr("dogfood"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -224,59 +319,54 @@ func ExampleCloudHsmClustersClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.CloudHsmClustersClientGetResponse{
-	// 	CloudHsmCluster: armhardwaresecuritymodules.CloudHsmCluster{
-	// 		Name: to.Ptr("chsm1"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
-	// 		Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
-	// 			Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
-	// 			UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
-	// 				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{
-	// 					ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 					PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 				},
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("eastus2"),
-	// 		Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
-	// 			ActivationState: to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
-	// 			AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
-	// 			PublicNetworkAccess: to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
-	// 			StatusMessage: to.Ptr("This is a status message"),
-	// 		},
-	// 		SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
-	// 			Name: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
-	// 			Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
-	// 		},
-	// 		SystemData: &armhardwaresecuritymodules.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			CreatedBy: to.Ptr("CHsmUser1"),
-	// 			CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("CHsmUser2"),
-	// 			LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"Dept": to.Ptr("hsm"),
-	// 			"Environment": to.Ptr("dogfood"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: chsm1
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1
+	// Location: eastus2
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmClusterPrivateLinkResource_ListByCloudHsmCluster_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_NewListByCloudHsmClusterPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					NewListByCloudHsmClusterPager: func(resourceGroupName string, cloudHsmClusterName string, options *armhardwaresecuritymodules.CloudHsmClustersClientListByCloudHsmClusterOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.CloudHsmClustersClientListByCloudHsmClusterResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientListByCloudHsmClusterResponse{
+							PrivateLinkResourceListResult: armhardwaresecuritymodules.PrivateLinkResourceListResult{
+								Value: []*armhardwaresecuritymodules.PrivateLinkResource{
+									{
+										Name: to.Ptr("sample-pls"),
+										Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateLinkResources"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateLinkResources/sample-pls"),
+										Properties: &armhardwaresecuritymodules.PrivateLinkResourceProperties{
+											GroupID: to.Ptr("cloudHsm"),
+											RequiredMembers: []*string{
+												to.Ptr("hsm1"),
+												to.Ptr("hsm2"),
+												to.Ptr("hsm3"),
+											},
+											RequiredZoneNames: []*string{
+												to.Ptr("privatelink.cloudhsm.azure-int.net"),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -287,43 +377,113 @@ func ExampleCloudHsmClustersClient_NewListByCloudHsmClusterPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.CloudHsmClustersClientListByCloudHsmClusterResponse{
-		// 	PrivateLinkResourceListResult: armhardwaresecuritymodules.PrivateLinkResourceListResult{
-		// 		Value: []*armhardwaresecuritymodules.PrivateLinkResource{
-		// 			{
-		// 				Name: to.Ptr("sample-pls"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateLinkResources"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateLinkResources/sample-pls"),
-		// 				Properties: &armhardwaresecuritymodules.PrivateLinkResourceProperties{
-		// 					GroupID: to.Ptr("cloudHsm"),
-		// 					RequiredMembers: []*string{
-		// 						to.Ptr("hsm1"),
-		// 						to.Ptr("hsm2"),
-		// 						to.Ptr("hsm3"),
-		// 					},
-		// 					RequiredZoneNames: []*string{
-		// 						to.Ptr("privatelink.cloudhsm.azure-int.net"),
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: sample-pls
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters/privateLinkResources
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateLinkResources/sample-pls
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_ListByResourceGroup_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armhardwaresecuritymodules.CloudHsmClustersClientListByResourceGroupOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.CloudHsmClustersClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientListByResourceGroupResponse{
+							CloudHsmClusterListResult: armhardwaresecuritymodules.CloudHsmClusterListResult{
+								Value: []*armhardwaresecuritymodules.CloudHsmCluster{
+									{
+										Name: to.Ptr("chsm1"),
+										Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
+										Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
+											Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
+											UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
+												"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{
+													ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+													PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+												},
+											},
+										},
+										Location: to.Ptr("eastus2"),
+										Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
+											ActivationState:                   to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
+											AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
+											ProvisioningState:                 to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
+											PublicNetworkAccess:               to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
+											StatusMessage:                     to.Ptr("This is a status message"),
+										},
+										SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
+											Name:   to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
+											Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("CHsmUser1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("CHsmUser2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+										},
+									},
+									{
+										Name: to.Ptr("chsm2"),
+										Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm2"),
+										Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
+											Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
+											UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
+												"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-2": &armhardwaresecuritymodules.UserAssignedIdentity{
+													ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+													PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+												},
+											},
+										},
+										Location: to.Ptr("eastus2"),
+										Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
+											ActivationState:                   to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
+											AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
+											ProvisioningState:                 to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
+											PublicNetworkAccess:               to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
+										},
+										SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
+											Name:   to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
+											Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("CHsmUser1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("CHsmUser2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -334,103 +494,117 @@ func ExampleCloudHsmClustersClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.CloudHsmClustersClientListByResourceGroupResponse{
-		// 	CloudHsmClusterListResult: armhardwaresecuritymodules.CloudHsmClusterListResult{
-		// 		NextLink: to.Ptr("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters?api-version=2022-03-31&$skiptoken=dmF1bHQtcGVza3ktanVyeS03MzA3Ng=="),
-		// 		Value: []*armhardwaresecuritymodules.CloudHsmCluster{
-		// 			{
-		// 				Name: to.Ptr("chsm1"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
-		// 				Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
-		// 					Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
-		// 					UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
-		// 						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{
-		// 							ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 							PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 						},
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("eastus2"),
-		// 				Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
-		// 					ActivationState: to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
-		// 					AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
-		// 					PublicNetworkAccess: to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
-		// 					StatusMessage: to.Ptr("This is a status message"),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
-		// 					Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("CHsmUser1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("CHsmUser2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("chsm2"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm2"),
-		// 				Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
-		// 					Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
-		// 					UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
-		// 						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-2": &armhardwaresecuritymodules.UserAssignedIdentity{
-		// 							ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 							PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 						},
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("eastus2"),
-		// 				Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
-		// 					ActivationState: to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
-		// 					AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
-		// 					PublicNetworkAccess: to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
-		// 					Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("CHsmUser1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("CHsmUser2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: chsm1
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1
+	// Location: eastus2
+	// Name: chsm2
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm2
+	// Location: eastus2
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_ListBySubscription_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					NewListBySubscriptionPager: func(options *armhardwaresecuritymodules.CloudHsmClustersClientListBySubscriptionOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.CloudHsmClustersClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientListBySubscriptionResponse{
+							CloudHsmClusterListResult: armhardwaresecuritymodules.CloudHsmClusterListResult{
+								Value: []*armhardwaresecuritymodules.CloudHsmCluster{
+									{
+										Name: to.Ptr("chsm1"),
+										Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
+										Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
+											Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
+											UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
+												"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{},
+											},
+										},
+										Location: to.Ptr("eastus2"),
+										Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
+											ActivationState:                   to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
+											AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
+											ProvisioningState:                 to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
+											PublicNetworkAccess:               to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
+											StatusMessage:                     to.Ptr("This is a status message"),
+										},
+										SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
+											Name:   to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
+											Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("CHsmUser1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("CHsmUser2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+										},
+									},
+									{
+										Name: to.Ptr("chsm2"),
+										Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm2"),
+										Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
+											Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
+											UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
+												"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-2": &armhardwaresecuritymodules.UserAssignedIdentity{
+													ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+													PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+												},
+											},
+										},
+										Location: to.Ptr("eastus2"),
+										Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
+											ActivationState:                   to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
+											AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
+											ProvisioningState:                 to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
+											PublicNetworkAccess:               to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
+											StatusMessage:                     to.Ptr("This is a status message"),
+										},
+										SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
+											Name:   to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
+											Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("CHsmUser1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("CHsmUser2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -441,102 +615,39 @@ func ExampleCloudHsmClustersClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.CloudHsmClustersClientListBySubscriptionResponse{
-		// 	CloudHsmClusterListResult: armhardwaresecuritymodules.CloudHsmClusterListResult{
-		// 		NextLink: to.Ptr("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters?api-version=2022-07-01&$skiptoken=dmF1bHQtcGVza3ktanVyeS03MzA3Ng=="),
-		// 		Value: []*armhardwaresecuritymodules.CloudHsmCluster{
-		// 			{
-		// 				Name: to.Ptr("chsm1"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
-		// 				Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
-		// 					Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
-		// 					UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
-		// 						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{
-		// 						},
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("eastus2"),
-		// 				Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
-		// 					ActivationState: to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
-		// 					AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
-		// 					PublicNetworkAccess: to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
-		// 					StatusMessage: to.Ptr("This is a status message"),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
-		// 					Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("CHsmUser1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("CHsmUser2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("chsm2"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm2"),
-		// 				Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
-		// 					Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
-		// 					UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
-		// 						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-2": &armhardwaresecuritymodules.UserAssignedIdentity{
-		// 							ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 							PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-		// 						},
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("eastus2"),
-		// 				Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
-		// 					ActivationState: to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
-		// 					AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
-		// 					PublicNetworkAccess: to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
-		// 					StatusMessage: to.Ptr("This is a status message"),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
-		// 					Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("CHsmUser1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("CHsmUser2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: chsm1
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1
+	// Location: eastus2
+	// Name: chsm2
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm2
+	// Location: eastus2
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_RequestOrValidate_Restore_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_BeginRestore() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					BeginRestore: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, restoreRequestProperties armhardwaresecuritymodules.RestoreRequestProperties, options *armhardwaresecuritymodules.CloudHsmClustersClientBeginRestoreOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.CloudHsmClustersClientRestoreResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientRestoreResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -553,19 +664,65 @@ func ExampleCloudHsmClustersClient_BeginRestore() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.CloudHsmClustersClientRestoreResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_Update_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, body armhardwaresecuritymodules.CloudHsmClusterPatchParameters, options *armhardwaresecuritymodules.CloudHsmClustersClientBeginUpdateOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.CloudHsmClustersClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientUpdateResponse{
+							CloudHsmCluster: armhardwaresecuritymodules.CloudHsmCluster{
+								Name: to.Ptr("chsm1"),
+								Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
+								Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
+									Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
+									UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
+										"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{
+											ClientID:    to.Ptr("00000000-0000-0000-0000-000000000000"),
+											PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+										},
+									},
+								},
+								Location: to.Ptr("eastus2"),
+								Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
+									ActivationState:                   to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
+									AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
+									ProvisioningState:                 to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
+									PublicNetworkAccess:               to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
+									StatusMessage:                     to.Ptr("This is a status message"),
+								},
+								SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
+									Name:   to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
+									Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
+								},
+								SystemData: &armhardwaresecuritymodules.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									CreatedBy:          to.Ptr("CHsmUser1"),
+									CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t }()),
+									LastModifiedBy:     to.Ptr("CHsmUser2"),
+									LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+								},
+								Tags: map[string]*string{
+									"Dept":        to.Ptr("hsm"),
+									"Environment": to.Ptr("dogfood"),
+									"Slice":       to.Ptr("A"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -589,60 +746,33 @@ func ExampleCloudHsmClustersClient_BeginUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.CloudHsmClustersClientUpdateResponse{
-	// 	CloudHsmCluster: armhardwaresecuritymodules.CloudHsmCluster{
-	// 		Name: to.Ptr("chsm1"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1"),
-	// 		Identity: &armhardwaresecuritymodules.ManagedServiceIdentity{
-	// 			Type: to.Ptr(armhardwaresecuritymodules.ManagedServiceIdentityTypeUserAssigned),
-	// 			UserAssignedIdentities: map[string]*armhardwaresecuritymodules.UserAssignedIdentity{
-	// 				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/contoso-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity-1": &armhardwaresecuritymodules.UserAssignedIdentity{
-	// 					ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 					PrincipalID: to.Ptr("00000000-0000-0000-0000-000000000000"),
-	// 				},
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("eastus2"),
-	// 		Properties: &armhardwaresecuritymodules.CloudHsmClusterProperties{
-	// 			ActivationState: to.Ptr(armhardwaresecuritymodules.ActivationState("null")),
-	// 			AutoGeneratedDomainNameLabelScope: to.Ptr(armhardwaresecuritymodules.AutoGeneratedDomainNameLabelScopeTenantReuse),
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.ProvisioningStateSucceeded),
-	// 			PublicNetworkAccess: to.Ptr(armhardwaresecuritymodules.PublicNetworkAccessDisabled),
-	// 			StatusMessage: to.Ptr("This is a status message"),
-	// 		},
-	// 		SKU: &armhardwaresecuritymodules.CloudHsmClusterSKU{
-	// 			Name: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUNameStandardB1),
-	// 			Family: to.Ptr(armhardwaresecuritymodules.CloudHsmClusterSKUFamilyB),
-	// 		},
-	// 		SystemData: &armhardwaresecuritymodules.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			CreatedBy: to.Ptr("CHsmUser1"),
-	// 			CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2022-09-12T12:00:00.0000000Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("CHsmUser2"),
-	// 			LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"Dept": to.Ptr("hsm"),
-	// 			"Environment": to.Ptr("dogfood"),
-	// 			"Slice": to.Ptr("A"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: chsm1
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1
+	// Location: eastus2
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_CreateOrValidate_Backup_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_BeginValidateBackupProperties() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					BeginValidateBackupProperties: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, options *armhardwaresecuritymodules.CloudHsmClustersClientBeginValidateBackupPropertiesOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.CloudHsmClustersClientValidateBackupPropertiesResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientValidateBackupPropertiesResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -660,19 +790,25 @@ func ExampleCloudHsmClustersClient_BeginValidateBackupProperties() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.CloudHsmClustersClientValidateBackupPropertiesResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmCluster_RequestOrValidate_Restore_Properties_MaximumSet_Gen.json
 func ExampleCloudHsmClustersClient_BeginValidateRestoreProperties() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				CloudHsmClustersServer: fake.CloudHsmClustersServer{
+					BeginValidateRestoreProperties: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, options *armhardwaresecuritymodules.CloudHsmClustersClientBeginValidateRestorePropertiesOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.CloudHsmClustersClientValidateRestorePropertiesResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.CloudHsmClustersClientValidateRestorePropertiesResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -690,7 +826,5 @@ func ExampleCloudHsmClustersClient_BeginValidateRestoreProperties() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.CloudHsmClustersClientValidateRestorePropertiesResponse{
-	// }
+	// Output:
 }
@@ -6,20 +6,34 @@ package armhardwaresecuritymodules_test
 
 import (
 	"armhardwaresecuritymodules"
+	"armhardwaresecuritymodules/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-06-30-preview/DedicatedHsm_CreateOrUpdate.json
 func ExampleDedicatedHsmsClient_BeginCreateOrUpdate_createANewOrUpdateAnExistingDedicatedHsm() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, name string, parameters armhardwaresecuritymodules.DedicatedHsm, options *armhardwaresecuritymodules.DedicatedHsmsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.DedicatedHsmsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientCreateOrUpdateResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -55,19 +69,25 @@ func ExampleDedicatedHsmsClient_BeginCreateOrUpdate_createANewOrUpdateAnExisting
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.DedicatedHsmsClientCreateOrUpdateResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/PaymentHsm_CreateOrUpdate.json
 func ExampleDedicatedHsmsClient_BeginCreateOrUpdate_createANewOrUpdateAnExistingPaymentHsm() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, name string, parameters armhardwaresecuritymodules.DedicatedHsm, options *armhardwaresecuritymodules.DedicatedHsmsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.DedicatedHsmsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientCreateOrUpdateResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -103,19 +123,25 @@ func ExampleDedicatedHsmsClient_BeginCreateOrUpdate_createANewOrUpdateAnExisting
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.DedicatedHsmsClientCreateOrUpdateResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/PaymentHsm_CreateOrUpdate_WithManagementProfile.json
 func ExampleDedicatedHsmsClient_BeginCreateOrUpdate_createANewOrUpdateAnExistingPaymentHsmWithManagementProfile() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, name string, parameters armhardwaresecuritymodules.DedicatedHsm, options *armhardwaresecuritymodules.DedicatedHsmsClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.DedicatedHsmsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientCreateOrUpdateResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -161,19 +187,25 @@ func ExampleDedicatedHsmsClient_BeginCreateOrUpdate_createANewOrUpdateAnExisting
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.DedicatedHsmsClientCreateOrUpdateResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/DedicatedHsm_Delete.json
 func ExampleDedicatedHsmsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, name string, options *armhardwaresecuritymodules.DedicatedHsmsClientBeginDeleteOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.DedicatedHsmsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armhardwaresecuritymodules.DedicatedHsmsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -185,16 +217,56 @@ func ExampleDedicatedHsmsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/DedicatedHsm_Get.json
 func ExampleDedicatedHsmsClient_Get_getADedicatedHsm() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					Get: func(ctx context.Context, resourceGroupName string, name string, options *armhardwaresecuritymodules.DedicatedHsmsClientGetOptions) (resp azfake.Responder[armhardwaresecuritymodules.DedicatedHsmsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientGetResponse{
+							DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
+								Name:     to.Ptr("hsm1"),
+								Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
+								Location: to.Ptr("westus"),
+								Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+									NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+										NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+											{
+												PrivateIPAddress: to.Ptr("1.0.0.1"),
+												ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
+											},
+										},
+										Subnet: &armhardwaresecuritymodules.APIEntityReference{
+											ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+										},
+									},
+									ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+									StampID:           to.Ptr("stamp01"),
+									StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+								},
+								SKU: &armhardwaresecuritymodules.SKU{
+									Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
+								},
+								Tags: map[string]*string{
+									"Dept":        to.Ptr("hsm"),
+									"Environment": to.Ptr("dogfood"),
+									"Slice":       to.Ptr("A"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -202,51 +274,83 @@ func ExampleDedicatedHsmsClient_Get_getADedicatedHsm() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.DedicatedHsmsClientGetResponse{
-	// 	DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
-	// 		Name: to.Ptr("hsm1"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-	// 			NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-	// 				NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-	// 					{
-	// 						PrivateIPAddress: to.Ptr("1.0.0.1"),
-	// 						ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
-	// 					},
-	// 				},
-	// 				Subnet: &armhardwaresecuritymodules.APIEntityReference{
-	// 					ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-	// 			StampID: to.Ptr("stamp01"),
-	// 			StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-	// 		},
-	// 		SKU: &armhardwaresecuritymodules.SKU{
-	// 			Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"Dept": to.Ptr("hsm"),
-	// 			"Environment": to.Ptr("dogfood"),
-	// 			"Slice": to.Ptr("A"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+	// Location: westus
 }
 
 // Generated from example definition: 2024-06-30-preview/PaymentHsm_Get.json
 func ExampleDedicatedHsmsClient_Get_getAPaymentHsm() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					Get: func(ctx context.Context, resourceGroupName string, name string, options *armhardwaresecuritymodules.DedicatedHsmsClientGetOptions) (resp azfake.Responder[armhardwaresecuritymodules.DedicatedHsmsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientGetResponse{
+							DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
+								Name:     to.Ptr("hsm1"),
+								Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
+								Location: to.Ptr("westus"),
+								Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+									ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+										NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+											{
+												PrivateIPAddress: to.Ptr("1.0.0.2"),
+												ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMMgmtNic"),
+											},
+										},
+										Subnet: &armhardwaresecuritymodules.APIEntityReference{
+											ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+										},
+									},
+									NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+										NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+											{
+												PrivateIPAddress: to.Ptr("1.0.0.1"),
+												ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
+											},
+										},
+										Subnet: &armhardwaresecuritymodules.APIEntityReference{
+											ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+										},
+									},
+									ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+									StampID:           to.Ptr("stamp01"),
+									StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+								},
+								SKU: &armhardwaresecuritymodules.SKU{
+									Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
+								},
+								SystemData: &armhardwaresecuritymodules.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+								},
+								Tags: map[string]*string{
+									"Dept":        to.Ptr("hsm"),
+									"Environment": to.Ptr("dogfood"),
+									"Slice":       to.Ptr("A"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -254,70 +358,64 @@ func ExampleDedicatedHsmsClient_Get_getAPaymentHsm() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.DedicatedHsmsClientGetResponse{
-	// 	DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
-	// 		Name: to.Ptr("hsm1"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-	// 			ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-	// 				NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-	// 					{
-	// 						PrivateIPAddress: to.Ptr("1.0.0.2"),
-	// 						ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMMgmtNic"),
-	// 					},
-	// 				},
-	// 				Subnet: &armhardwaresecuritymodules.APIEntityReference{
-	// 					ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-	// 				},
-	// 			},
-	// 			NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-	// 				NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-	// 					{
-	// 						PrivateIPAddress: to.Ptr("1.0.0.1"),
-	// 						ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
-	// 					},
-	// 				},
-	// 				Subnet: &armhardwaresecuritymodules.APIEntityReference{
-	// 					ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-	// 			StampID: to.Ptr("stamp01"),
-	// 			StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-	// 		},
-	// 		SKU: &armhardwaresecuritymodules.SKU{
-	// 			Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
-	// 		},
-	// 		SystemData: &armhardwaresecuritymodules.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"Dept": to.Ptr("hsm"),
-	// 			"Environment": to.Ptr("dogfood"),
-	// 			"Slice": to.Ptr("A"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+	// Location: westus
 }
 
 // Generated from example definition: 2024-06-30-preview/PaymentHsm_Get_With_2018-10-31Preview_Version.json
 func ExampleDedicatedHsmsClient_Get_getAPaymentHsmWith20181031PreviewApiVersion() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					Get: func(ctx context.Context, resourceGroupName string, name string, options *armhardwaresecuritymodules.DedicatedHsmsClientGetOptions) (resp azfake.Responder[armhardwaresecuritymodules.DedicatedHsmsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientGetResponse{
+							DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
+								Name:     to.Ptr("hsm1"),
+								Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
+								Location: to.Ptr("westus"),
+								Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+									NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+										NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+											{
+												PrivateIPAddress: to.Ptr("1.0.0.1"),
+												ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
+											},
+										},
+										Subnet: &armhardwaresecuritymodules.APIEntityReference{
+											ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+										},
+									},
+									ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+									StampID:           to.Ptr("stamp01"),
+									StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+								},
+								SKU: &armhardwaresecuritymodules.SKU{
+									Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
+								},
+								Tags: map[string]*string{
+									"Dept":        to.Ptr("hsm"),
+									"Environment": to.Ptr("dogfood"),
+									"Slice":       to.Ptr("A"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -325,51 +423,98 @@ func ExampleDedicatedHsmsClient_Get_getAPaymentHsmWith20181031PreviewApiVersion(
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.DedicatedHsmsClientGetResponse{
-	// 	DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
-	// 		Name: to.Ptr("hsm1"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-	// 			NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-	// 				NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-	// 					{
-	// 						PrivateIPAddress: to.Ptr("1.0.0.1"),
-	// 						ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
-	// 					},
-	// 				},
-	// 				Subnet: &armhardwaresecuritymodules.APIEntityReference{
-	// 					ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-	// 			StampID: to.Ptr("stamp01"),
-	// 			StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-	// 		},
-	// 		SKU: &armhardwaresecuritymodules.SKU{
-	// 			Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"Dept": to.Ptr("hsm"),
-	// 			"Environment": to.Ptr("dogfood"),
-	// 			"Slice": to.Ptr("A"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+	// Location: westus
 }
 
 // Generated from example definition: 2024-06-30-preview/DedicatedHsm_ListByResourceGroup.json
 func ExampleDedicatedHsmsClient_NewListByResourceGroupPager_listDedicatedHsmDevicesInAResourceGroup() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armhardwaresecuritymodules.DedicatedHsmsClientListByResourceGroupOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.DedicatedHsmsClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientListByResourceGroupResponse{
+							DedicatedHsmListResult: armhardwaresecuritymodules.DedicatedHsmListResult{
+								Value: []*armhardwaresecuritymodules.DedicatedHsm{
+									{
+										Name:     to.Ptr("hsm1"),
+										Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+										ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
+										Location: to.Ptr("westus"),
+										Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+											NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.1"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+											StampID:           to.Ptr("stamp01"),
+											StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+										},
+										SKU: &armhardwaresecuritymodules.SKU{
+											Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+											"Slice":       to.Ptr("A"),
+										},
+									},
+									{
+										Name:     to.Ptr("hsm1"),
+										Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+										ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2"),
+										Location: to.Ptr("westus"),
+										Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+											NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.2"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+											StampID:           to.Ptr("stamp01"),
+											StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+										},
+										SKU: &armhardwaresecuritymodules.SKU{
+											Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+											"Slice":       to.Ptr("B"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -380,88 +525,142 @@ func ExampleDedicatedHsmsClient_NewListByResourceGroupPager_listDedicatedHsmDevi
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.DedicatedHsmsClientListByResourceGroupResponse{
-		// 	DedicatedHsmListResult: armhardwaresecuritymodules.DedicatedHsmListResult{
-		// 		NextLink: to.Ptr("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs?api-version=2018-10-31-preview&$skiptoken=dmF1bHQtcGVza3ktanVyeS03MzA3Ng=="),
-		// 		Value: []*armhardwaresecuritymodules.DedicatedHsm{
-		// 			{
-		// 				Name: to.Ptr("hsm1"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-		// 					NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.1"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-		// 					StampID: to.Ptr("stamp01"),
-		// 					StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.SKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 					"Slice": to.Ptr("A"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("hsm1"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-		// 					NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.2"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-		// 					StampID: to.Ptr("stamp01"),
-		// 					StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.SKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 					"Slice": to.Ptr("B"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+	// Location: westus
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2
+	// Location: westus
 }
 
 // Generated from example definition: 2024-06-30-preview/PaymentHsm_ListByResourceGroup.json
 func ExampleDedicatedHsmsClient_NewListByResourceGroupPager_listDedicatedHsmDevicesInAResourceGroupIncludingPaymentHsm() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armhardwaresecuritymodules.DedicatedHsmsClientListByResourceGroupOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.DedicatedHsmsClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientListByResourceGroupResponse{
+							DedicatedHsmListResult: armhardwaresecuritymodules.DedicatedHsmListResult{
+								Value: []*armhardwaresecuritymodules.DedicatedHsm{
+									{
+										Name:     to.Ptr("hsm1"),
+										Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+										ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
+										Location: to.Ptr("westus"),
+										Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+											ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.2"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMMgmtNic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.1"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+											StampID:           to.Ptr("stamp01"),
+											StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+										},
+										SKU: &armhardwaresecuritymodules.SKU{
+											Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("user1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+											"Slice":       to.Ptr("A"),
+										},
+									},
+									{
+										Name:     to.Ptr("hsm2"),
+										Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+										ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2"),
+										Location: to.Ptr("westus"),
+										Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+											ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.4"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm2_HSMMgmtNic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.3"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm2_HSMHost1Nic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+											StampID:           to.Ptr("stamp01"),
+											StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+										},
+										SKU: &armhardwaresecuritymodules.SKU{
+											Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("user1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+											"Slice":       to.Ptr("B"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -472,126 +671,104 @@ func ExampleDedicatedHsmsClient_NewListByResourceGroupPager_listDedicatedHsmDevi
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.DedicatedHsmsClientListByResourceGroupResponse{
-		// 	DedicatedHsmListResult: armhardwaresecuritymodules.DedicatedHsmListResult{
-		// 		NextLink: to.Ptr("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs?api-version=2021-11-30&$skiptoken=dmF1bHQtcGVza3ktanVyeS03MzA3Ng=="),
-		// 		Value: []*armhardwaresecuritymodules.DedicatedHsm{
-		// 			{
-		// 				Name: to.Ptr("hsm1"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-		// 					ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.2"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMMgmtNic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.1"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-		// 					StampID: to.Ptr("stamp01"),
-		// 					StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.SKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("user1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 					"Slice": to.Ptr("A"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("hsm2"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-		// 					ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.4"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm2_HSMMgmtNic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.3"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm2_HSMHost1Nic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-		// 					StampID: to.Ptr("stamp01"),
-		// 					StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.SKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("user1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 					"Slice": to.Ptr("B"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+	// Location: westus
+	// Name: hsm2
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2
+	// Location: westus
 }
 
 // Generated from example definition: 2024-06-30-preview/DedicatedHsm_ListBySubscription.json
 func ExampleDedicatedHsmsClient_NewListBySubscriptionPager_listDedicatedHsmDevicesInASubscription() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					NewListBySubscriptionPager: func(options *armhardwaresecuritymodules.DedicatedHsmsClientListBySubscriptionOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.DedicatedHsmsClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientListBySubscriptionResponse{
+							DedicatedHsmListResult: armhardwaresecuritymodules.DedicatedHsmListResult{
+								Value: []*armhardwaresecuritymodules.DedicatedHsm{
+									{
+										Name:     to.Ptr("hsm1"),
+										Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+										ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
+										Location: to.Ptr("westus"),
+										Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+											NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.1"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+											StampID:           to.Ptr("stamp01"),
+											StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+										},
+										SKU: &armhardwaresecuritymodules.SKU{
+											Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+											"Slice":       to.Ptr("A"),
+										},
+									},
+									{
+										Name:     to.Ptr("hsm1"),
+										Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+										ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2"),
+										Location: to.Ptr("westus"),
+										Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+											NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.2"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+											StampID:           to.Ptr("stamp01"),
+											StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+										},
+										SKU: &armhardwaresecuritymodules.SKU{
+											Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+											"Slice":       to.Ptr("B"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -602,88 +779,142 @@ func ExampleDedicatedHsmsClient_NewListBySubscriptionPager_listDedicatedHsmDevic
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.DedicatedHsmsClientListBySubscriptionResponse{
-		// 	DedicatedHsmListResult: armhardwaresecuritymodules.DedicatedHsmListResult{
-		// 		NextLink: to.Ptr("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs?api-version=2018-10-31-preview&$skiptoken=dmF1bHQtcGVza3ktanVyeS03MzA3Ng=="),
-		// 		Value: []*armhardwaresecuritymodules.DedicatedHsm{
-		// 			{
-		// 				Name: to.Ptr("hsm1"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-		// 					NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.1"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-		// 					StampID: to.Ptr("stamp01"),
-		// 					StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.SKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 					"Slice": to.Ptr("A"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("hsm1"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-		// 					NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.2"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-		// 					StampID: to.Ptr("stamp01"),
-		// 					StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.SKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 					"Slice": to.Ptr("B"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+	// Location: westus
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2
+	// Location: westus
 }
 
 // Generated from example definition: 2024-06-30-preview/PaymentHsm_ListBySubscription.json
 func ExampleDedicatedHsmsClient_NewListBySubscriptionPager_listDedicatedHsmDevicesInASubscriptionIncludingPaymentHsm() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					NewListBySubscriptionPager: func(options *armhardwaresecuritymodules.DedicatedHsmsClientListBySubscriptionOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.DedicatedHsmsClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientListBySubscriptionResponse{
+							DedicatedHsmListResult: armhardwaresecuritymodules.DedicatedHsmListResult{
+								Value: []*armhardwaresecuritymodules.DedicatedHsm{
+									{
+										Name:     to.Ptr("hsm1"),
+										Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+										ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
+										Location: to.Ptr("westus"),
+										Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+											ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.2"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMMgmtNic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.1"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+											StampID:           to.Ptr("stamp01"),
+											StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+										},
+										SKU: &armhardwaresecuritymodules.SKU{
+											Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("user1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm1"),
+											"Environment": to.Ptr("dogfood"),
+											"Slice":       to.Ptr("A"),
+										},
+									},
+									{
+										Name:     to.Ptr("hsm2"),
+										Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+										ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2"),
+										Location: to.Ptr("westus"),
+										Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+											ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.4"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm2_HSMMgmtNic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+												NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+													{
+														PrivateIPAddress: to.Ptr("1.0.0.3"),
+														ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm2_HSMHost1Nic"),
+													},
+												},
+												Subnet: &armhardwaresecuritymodules.APIEntityReference{
+													ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+												},
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+											StampID:           to.Ptr("stamp01"),
+											StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+										},
+										SKU: &armhardwaresecuritymodules.SKU{
+											Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("user1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+										Tags: map[string]*string{
+											"Dept":        to.Ptr("hsm"),
+											"Environment": to.Ptr("dogfood"),
+											"Slice":       to.Ptr("B"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -694,126 +925,43 @@ func ExampleDedicatedHsmsClient_NewListBySubscriptionPager_listDedicatedHsmDevic
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.DedicatedHsmsClientListBySubscriptionResponse{
-		// 	DedicatedHsmListResult: armhardwaresecuritymodules.DedicatedHsmListResult{
-		// 		NextLink: to.Ptr("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs?api-version=2021-11-30&$skiptoken=dmF1bHQtcGVza3ktanVyeS03MzA3Ng=="),
-		// 		Value: []*armhardwaresecuritymodules.DedicatedHsm{
-		// 			{
-		// 				Name: to.Ptr("hsm1"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-		// 					ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.2"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMMgmtNic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.1"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-		// 					StampID: to.Ptr("stamp01"),
-		// 					StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.SKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("user1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm1"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 					"Slice": to.Ptr("A"),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("hsm2"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2"),
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-		// 					ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.4"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm2_HSMMgmtNic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-		// 						NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-		// 							{
-		// 								PrivateIPAddress: to.Ptr("1.0.0.3"),
-		// 								ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm2_HSMHost1Nic"),
-		// 							},
-		// 						},
-		// 						Subnet: &armhardwaresecuritymodules.APIEntityReference{
-		// 							ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-		// 						},
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-		// 					StampID: to.Ptr("stamp01"),
-		// 					StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-		// 				},
-		// 				SKU: &armhardwaresecuritymodules.SKU{
-		// 					Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("user1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 				Tags: map[string]*string{
-		// 					"Dept": to.Ptr("hsm"),
-		// 					"Environment": to.Ptr("dogfood"),
-		// 					"Slice": to.Ptr("B"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+	// Location: westus
+	// Name: hsm2
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm2
+	// Location: westus
 }
 
 // Generated from example definition: 2024-06-30-preview/GetOutboundNetworkDependenciesEndpointsList.json
 func ExampleDedicatedHsmsClient_NewListOutboundNetworkDependenciesEndpointsPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					NewListOutboundNetworkDependenciesEndpointsPager: func(resourceGroupName string, name string, options *armhardwaresecuritymodules.DedicatedHsmsClientListOutboundNetworkDependenciesEndpointsOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.DedicatedHsmsClientListOutboundNetworkDependenciesEndpointsResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientListOutboundNetworkDependenciesEndpointsResponse{
+							OutboundEnvironmentEndpointCollection: armhardwaresecuritymodules.OutboundEnvironmentEndpointCollection{
+								Value: []*armhardwaresecuritymodules.OutboundEnvironmentEndpoint{},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -827,24 +975,57 @@ func ExampleDedicatedHsmsClient_NewListOutboundNetworkDependenciesEndpointsPager
 			// You could use page here. We use blank identifier for just demo purposes.
 			_ = v
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.DedicatedHsmsClientListOutboundNetworkDependenciesEndpointsResponse{
-		// 	OutboundEnvironmentEndpointCollection: armhardwaresecuritymodules.OutboundEnvironmentEndpointCollection{
-		// 		Value: []*armhardwaresecuritymodules.OutboundEnvironmentEndpoint{
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/DedicatedHsm_Update.json
 func ExampleDedicatedHsmsClient_BeginUpdate_updateAnExistingDedicatedHsm() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, name string, parameters armhardwaresecuritymodules.DedicatedHsmPatchParameters, options *armhardwaresecuritymodules.DedicatedHsmsClientBeginUpdateOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.DedicatedHsmsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientUpdateResponse{
+							DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
+								Name:     to.Ptr("hsm1"),
+								Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
+								Location: to.Ptr("westus"),
+								Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+									NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+										NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+											{
+												PrivateIPAddress: to.Ptr("1.0.0.1"),
+												ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
+											},
+										},
+										Subnet: &armhardwaresecuritymodules.APIEntityReference{
+											ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+										},
+									},
+									ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+									StampID:           to.Ptr("stamp01"),
+									StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+								},
+								SKU: &armhardwaresecuritymodules.SKU{
+									Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
+								},
+								Tags: map[string]*string{
+									"Dept":        to.Ptr("hsm"),
+									"Environment": to.Ptr("dogfood"),
+									"Slice":       to.Ptr("A"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -862,51 +1043,83 @@ func ExampleDedicatedHsmsClient_BeginUpdate_updateAnExistingDedicatedHsm() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.DedicatedHsmsClientUpdateResponse{
-	// 	DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
-	// 		Name: to.Ptr("hsm1"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-	// 			NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-	// 				NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-	// 					{
-	// 						PrivateIPAddress: to.Ptr("1.0.0.1"),
-	// 						ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm_vnic"),
-	// 					},
-	// 				},
-	// 				Subnet: &armhardwaresecuritymodules.APIEntityReference{
-	// 					ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-	// 			StampID: to.Ptr("stamp01"),
-	// 			StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-	// 		},
-	// 		SKU: &armhardwaresecuritymodules.SKU{
-	// 			Name: to.Ptr(armhardwaresecuritymodules.SKUNameSafeNetLunaNetworkHSMA790),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"Dept": to.Ptr("hsm"),
-	// 			"Environment": to.Ptr("dogfood"),
-	// 			"Slice": to.Ptr("A"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+	// Location: westus
 }
 
 // Generated from example definition: 2024-06-30-preview/PaymentHsm_Update.json
 func ExampleDedicatedHsmsClient_BeginUpdate_updateAnExistingPaymentHsm() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				DedicatedHsmsServer: fake.DedicatedHsmsServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, name string, parameters armhardwaresecuritymodules.DedicatedHsmPatchParameters, options *armhardwaresecuritymodules.DedicatedHsmsClientBeginUpdateOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.DedicatedHsmsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhardwaresecuritymodules.DedicatedHsmsClientUpdateResponse{
+							DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
+								Name:     to.Ptr("hsm1"),
+								Type:     to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
+								Location: to.Ptr("westus"),
+								Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
+									ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+										NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+											{
+												PrivateIPAddress: to.Ptr("1.0.0.2"),
+												ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMMgmtNic"),
+											},
+										},
+										Subnet: &armhardwaresecuritymodules.APIEntityReference{
+											ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+										},
+									},
+									NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
+										NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
+											{
+												PrivateIPAddress: to.Ptr("1.0.0.1"),
+												ResourceID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
+											},
+										},
+										Subnet: &armhardwaresecuritymodules.APIEntityReference{
+											ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
+										},
+									},
+									ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
+									StampID:           to.Ptr("stamp01"),
+									StatusMessage:     to.Ptr("DedicatedHsm device is functional."),
+								},
+								SKU: &armhardwaresecuritymodules.SKU{
+									Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
+								},
+								SystemData: &armhardwaresecuritymodules.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+								},
+								Tags: map[string]*string{
+									"Dept":        to.Ptr("hsm"),
+									"Environment": to.Ptr("dogfood"),
+									"Slice":       to.Ptr("A"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -924,58 +1137,13 @@ func ExampleDedicatedHsmsClient_BeginUpdate_updateAnExistingPaymentHsm() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.DedicatedHsmsClientUpdateResponse{
-	// 	DedicatedHsm: armhardwaresecuritymodules.DedicatedHsm{
-	// 		Name: to.Ptr("hsm1"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedHSMs"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1"),
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armhardwaresecuritymodules.DedicatedHsmProperties{
-	// 			ManagementNetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-	// 				NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-	// 					{
-	// 						PrivateIPAddress: to.Ptr("1.0.0.2"),
-	// 						ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMMgmtNic"),
-	// 					},
-	// 				},
-	// 				Subnet: &armhardwaresecuritymodules.APIEntityReference{
-	// 					ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-	// 				},
-	// 			},
-	// 			NetworkProfile: &armhardwaresecuritymodules.NetworkProfile{
-	// 				NetworkInterfaces: []*armhardwaresecuritymodules.NetworkInterface{
-	// 					{
-	// 						PrivateIPAddress: to.Ptr("1.0.0.1"),
-	// 						ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/networkInterfaces/hsm1_HSMHost1Nic"),
-	// 					},
-	// 				},
-	// 				Subnet: &armhardwaresecuritymodules.APIEntityReference{
-	// 					ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.Network/virtualNetworks/stamp01/subnets/stamp01"),
-	// 				},
-	// 			},
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.JSONWebKeyTypeSucceeded),
-	// 			StampID: to.Ptr("stamp01"),
-	// 			StatusMessage: to.Ptr("DedicatedHsm device is functional."),
-	// 		},
-	// 		SKU: &armhardwaresecuritymodules.SKU{
-	// 			Name: to.Ptr(armhardwaresecuritymodules.SKUNamePayShield10KLMK1CPS60),
-	// 		},
-	// 		SystemData: &armhardwaresecuritymodules.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 		},
-	// 		Tags: map[string]*string{
-	// 			"Dept": to.Ptr("hsm"),
-	// 			"Environment": to.Ptr("dogfood"),
-	// 			"Slice": to.Ptr("A"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: hsm1
+	// Type: Microsoft.HardwareSecurityModules/dedicatedHSMs
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/hsm-group/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+	// Location: westus
 }
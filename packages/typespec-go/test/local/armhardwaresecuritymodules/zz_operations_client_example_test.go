@@ -6,19 +6,60 @@ package armhardwaresecuritymodules_test
 
 import (
 	"armhardwaresecuritymodules"
+	"armhardwaresecuritymodules/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-06-30-preview/OperationsList.json
 func ExampleOperationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armhardwaresecuritymodules.OperationsClientListOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.OperationsClientListResponse{
+							OperationListResult: armhardwaresecuritymodules.OperationListResult{
+								Value: []*armhardwaresecuritymodules.Operation{
+									{
+										Name: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedhsms/read"),
+										Display: &armhardwaresecuritymodules.OperationDisplay{
+											Description: to.Ptr("View the properties of a DedicatedHsm"),
+											Operation:   to.Ptr("View DedicatedHsm"),
+											Provider:    to.Ptr("Microsoft Hardware Security Modules"),
+											Resource:    to.Ptr("DedicatedHsm"),
+										},
+										IsDataAction: to.Ptr(false),
+										Origin:       to.Ptr(armhardwaresecuritymodules.OriginSystem),
+									},
+									{
+										Name: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/read"),
+										Display: &armhardwaresecuritymodules.OperationDisplay{
+											Description: to.Ptr("View the properties of a CloudHsm"),
+											Operation:   to.Ptr("View CloudHsm"),
+											Provider:    to.Ptr("Microsoft Hardware Security Modules"),
+											Resource:    to.Ptr("CloudHsm"),
+										},
+										IsDataAction: to.Ptr(false),
+										Origin:       to.Ptr(armhardwaresecuritymodules.OriginSystem),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,37 +70,16 @@ func ExampleOperationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("IsDataAction:", *v.IsDataAction)
+			fmt.Println("Origin:", *v.Origin)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.OperationsClientListResponse{
-		// 	OperationListResult: armhardwaresecuritymodules.OperationListResult{
-		// 		Value: []*armhardwaresecuritymodules.Operation{
-		// 			{
-		// 				Name: to.Ptr("Microsoft.HardwareSecurityModules/dedicatedhsms/read"),
-		// 				Display: &armhardwaresecuritymodules.OperationDisplay{
-		// 					Description: to.Ptr("View the properties of a DedicatedHsm"),
-		// 					Operation: to.Ptr("View DedicatedHsm"),
-		// 					Provider: to.Ptr("Microsoft Hardware Security Modules"),
-		// 					Resource: to.Ptr("DedicatedHsm"),
-		// 				},
-		// 				IsDataAction: to.Ptr(false),
-		// 				Origin: to.Ptr(armhardwaresecuritymodules.OriginSystem),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/read"),
-		// 				Display: &armhardwaresecuritymodules.OperationDisplay{
-		// 					Description: to.Ptr("View the properties of a CloudHsm"),
-		// 					Operation: to.Ptr("View CloudHsm"),
-		// 					Provider: to.Ptr("Microsoft Hardware Security Modules"),
-		// 					Resource: to.Ptr("CloudHsm"),
-		// 				},
-		// 				IsDataAction: to.Ptr(false),
-		// 				Origin: to.Ptr(armhardwaresecuritymodules.OriginSystem),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Microsoft.HardwareSecurityModules/dedicatedhsms/read
+	// IsDataAction: false
+	// Origin: system
+	// Name: Microsoft.HardwareSecurityModules/cloudHsmClusters/read
+	// IsDataAction: false
+	// Origin: system
 }
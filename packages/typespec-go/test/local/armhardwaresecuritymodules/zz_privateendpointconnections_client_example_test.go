@@ -6,20 +6,59 @@ package armhardwaresecuritymodules_test
 
 import (
 	"armhardwaresecuritymodules"
+	"armhardwaresecuritymodules/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmClusterPrivateEndpointConnection_Create_MaximumSet_Gen.json
 func ExamplePrivateEndpointConnectionsClient_Create() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					Create: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, peConnectionName string, properties armhardwaresecuritymodules.PrivateEndpointConnection, options *armhardwaresecuritymodules.PrivateEndpointConnectionsClientCreateOptions) (resp azfake.Responder[armhardwaresecuritymodules.PrivateEndpointConnectionsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhardwaresecuritymodules.PrivateEndpointConnectionsClientCreateResponse{
+							PrivateEndpointConnection: armhardwaresecuritymodules.PrivateEndpointConnection{
+								Name: to.Ptr("sample-pec"),
+								Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateEndpointConnections/sample-pec"),
+								Properties: &armhardwaresecuritymodules.PrivateEndpointConnectionProperties{
+									PrivateEndpoint: &armhardwaresecuritymodules.PrivateEndpoint{
+										ID: to.Ptr("/subscriptions/00000000-0000-0000-1234-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.Network/privateEndpoints/sample-pec"),
+									},
+									PrivateLinkServiceConnectionState: &armhardwaresecuritymodules.PrivateLinkServiceConnectionState{
+										Description:     to.Ptr("My name is Joe and I'm approving this."),
+										ActionsRequired: to.Ptr("None"),
+										Status:          to.Ptr(armhardwaresecuritymodules.PrivateEndpointServiceConnectionStatusApproved),
+									},
+									ProvisioningState: to.Ptr(armhardwaresecuritymodules.PrivateEndpointConnectionProvisioningStateSucceeded),
+								},
+								SystemData: &armhardwaresecuritymodules.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+									CreatedBy:          to.Ptr("User1"),
+									CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+									LastModifiedBy:     to.Ptr("User2"),
+									LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -34,45 +73,31 @@ func ExamplePrivateEndpointConnectionsClient_Create() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.PrivateEndpointConnectionsClientCreateResponse{
-	// 	PrivateEndpointConnection: armhardwaresecuritymodules.PrivateEndpointConnection{
-	// 		Name: to.Ptr("sample-pec"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateEndpointConnections/sample-pec"),
-	// 		Properties: &armhardwaresecuritymodules.PrivateEndpointConnectionProperties{
-	// 			PrivateEndpoint: &armhardwaresecuritymodules.PrivateEndpoint{
-	// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-1234-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.Network/privateEndpoints/sample-pec"),
-	// 			},
-	// 			PrivateLinkServiceConnectionState: &armhardwaresecuritymodules.PrivateLinkServiceConnectionState{
-	// 				Description: to.Ptr("My name is Joe and I'm approving this."),
-	// 				ActionsRequired: to.Ptr("None"),
-	// 				Status: to.Ptr(armhardwaresecuritymodules.PrivateEndpointServiceConnectionStatusApproved),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.PrivateEndpointConnectionProvisioningStateSucceeded),
-	// 		},
-	// 		SystemData: &armhardwaresecuritymodules.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-	// 			CreatedBy: to.Ptr("User1"),
-	// 			CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("User2"),
-	// 			LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: sample-pec
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateEndpointConnections/sample-pec
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmClusterPrivateEndpointConnection_Delete_MaximumSet_Gen.json
 func ExamplePrivateEndpointConnectionsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, peConnectionName string, options *armhardwaresecuritymodules.PrivateEndpointConnectionsClientBeginDeleteOptions) (resp azfake.PollerResponder[armhardwaresecuritymodules.PrivateEndpointConnectionsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armhardwaresecuritymodules.PrivateEndpointConnectionsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -84,16 +109,51 @@ func ExamplePrivateEndpointConnectionsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmClusterPrivateEndpointConnection_Get_MaximumSet_Gen.json
 func ExamplePrivateEndpointConnectionsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					Get: func(ctx context.Context, resourceGroupName string, cloudHsmClusterName string, peConnectionName string, options *armhardwaresecuritymodules.PrivateEndpointConnectionsClientGetOptions) (resp azfake.Responder[armhardwaresecuritymodules.PrivateEndpointConnectionsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhardwaresecuritymodules.PrivateEndpointConnectionsClientGetResponse{
+							PrivateEndpointConnection: armhardwaresecuritymodules.PrivateEndpointConnection{
+								Name: to.Ptr("sample-pec"),
+								Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections"),
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateEndpointConnections/sample-pec"),
+								Properties: &armhardwaresecuritymodules.PrivateEndpointConnectionProperties{
+									PrivateEndpoint: &armhardwaresecuritymodules.PrivateEndpoint{
+										ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/sample-group/providers/Microsoft.Network/privateEndpoints/sample-pec"),
+									},
+									PrivateLinkServiceConnectionState: &armhardwaresecuritymodules.PrivateLinkServiceConnectionState{
+										Description:     to.Ptr("This was automatically approved by user1234@contoso.com"),
+										ActionsRequired: to.Ptr("None"),
+										Status:          to.Ptr(armhardwaresecuritymodules.PrivateEndpointServiceConnectionStatusApproved),
+									},
+									ProvisioningState: to.Ptr(armhardwaresecuritymodules.PrivateEndpointConnectionProvisioningStateSucceeded),
+								},
+								SystemData: &armhardwaresecuritymodules.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+									CreatedBy:          to.Ptr("User1"),
+									CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+									LastModifiedBy:     to.Ptr("User2"),
+									LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+								},
+								Etag: to.Ptr(azcore.ETag("\"etag\"")),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -101,46 +161,84 @@ func ExamplePrivateEndpointConnectionsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhardwaresecuritymodules.PrivateEndpointConnectionsClientGetResponse{
-	// 	PrivateEndpointConnection: armhardwaresecuritymodules.PrivateEndpointConnection{
-	// 		Name: to.Ptr("sample-pec"),
-	// 		Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections"),
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateEndpointConnections/sample-pec"),
-	// 		Properties: &armhardwaresecuritymodules.PrivateEndpointConnectionProperties{
-	// 			PrivateEndpoint: &armhardwaresecuritymodules.PrivateEndpoint{
-	// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/sample-group/providers/Microsoft.Network/privateEndpoints/sample-pec"),
-	// 			},
-	// 			PrivateLinkServiceConnectionState: &armhardwaresecuritymodules.PrivateLinkServiceConnectionState{
-	// 				Description: to.Ptr("This was automatically approved by user1234@contoso.com"),
-	// 				ActionsRequired: to.Ptr("None"),
-	// 				Status: to.Ptr(armhardwaresecuritymodules.PrivateEndpointServiceConnectionStatusApproved),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armhardwaresecuritymodules.PrivateEndpointConnectionProvisioningStateSucceeded),
-	// 		},
-	// 		SystemData: &armhardwaresecuritymodules.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-	// 			CreatedBy: to.Ptr("User1"),
-	// 			CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("User2"),
-	// 			LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-	// 		},
-	// 		Etag: to.Ptr(azcore.ETag("\"etag\"")),
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	// Output:
+	// Name: sample-pec
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateEndpointConnections/sample-pec
 }
 
 // Generated from example definition: 2024-06-30-preview/CloudHsmClusterPrivateEndpointConnection_ListByCloudHsmCluster_MaximumSet_Gen.json
 func ExamplePrivateEndpointConnectionsClient_NewListByCloudHsmClusterPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armhardwaresecuritymodules.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					NewListByCloudHsmClusterPager: func(resourceGroupName string, cloudHsmClusterName string, options *armhardwaresecuritymodules.PrivateEndpointConnectionsClientListByCloudHsmClusterOptions) (resp azfake.PagerResponder[armhardwaresecuritymodules.PrivateEndpointConnectionsClientListByCloudHsmClusterResponse]) {
+						resp.AddPage(http.StatusOK, armhardwaresecuritymodules.PrivateEndpointConnectionsClientListByCloudHsmClusterResponse{
+							PrivateEndpointConnectionListResult: armhardwaresecuritymodules.PrivateEndpointConnectionListResult{
+								Value: []*armhardwaresecuritymodules.PrivateEndpointConnection{
+									{
+										Name: to.Ptr("chsm1"),
+										Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateEndpointConnections/sample-pec1"),
+										Properties: &armhardwaresecuritymodules.PrivateEndpointConnectionProperties{
+											PrivateEndpoint: &armhardwaresecuritymodules.PrivateEndpoint{
+												ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.Network/privateEndpoints/sample-pec1"),
+											},
+											PrivateLinkServiceConnectionState: &armhardwaresecuritymodules.PrivateLinkServiceConnectionState{
+												Description:     to.Ptr("This was automatically approved by user1234@contoso.com"),
+												ActionsRequired: to.Ptr("None"),
+												Status:          to.Ptr(armhardwaresecuritymodules.PrivateEndpointServiceConnectionStatusApproved),
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.PrivateEndpointConnectionProvisioningStateSucceeded),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("User1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("User2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+									},
+									{
+										Name: to.Ptr("chsm2"),
+										Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections"),
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm2/privateEndpointConnections/sample-pec2"),
+										Properties: &armhardwaresecuritymodules.PrivateEndpointConnectionProperties{
+											PrivateEndpoint: &armhardwaresecuritymodules.PrivateEndpoint{
+												ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.Network/privateEndpoints/sample-pec2"),
+											},
+											PrivateLinkServiceConnectionState: &armhardwaresecuritymodules.PrivateLinkServiceConnectionState{
+												Description:     to.Ptr("This was automatically approved by user1234@contoso.com"),
+												ActionsRequired: to.Ptr("None"),
+												Status:          to.Ptr(armhardwaresecuritymodules.PrivateEndpointServiceConnectionStatusApproved),
+											},
+											ProvisioningState: to.Ptr(armhardwaresecuritymodules.PrivateEndpointConnectionProvisioningStateSucceeded),
+										},
+										SystemData: &armhardwaresecuritymodules.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											CreatedBy:          to.Ptr("User1"),
+											CreatedByType:      to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("User2"),
+											LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -151,63 +249,16 @@ func ExamplePrivateEndpointConnectionsClient_NewListByCloudHsmClusterPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhardwaresecuritymodules.PrivateEndpointConnectionsClientListByCloudHsmClusterResponse{
-		// 	PrivateEndpointConnectionListResult: armhardwaresecuritymodules.PrivateEndpointConnectionListResult{
-		// 		Value: []*armhardwaresecuritymodules.PrivateEndpointConnection{
-		// 			{
-		// 				Name: to.Ptr("chsm1"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateEndpointConnections/sample-pec1"),
-		// 				Properties: &armhardwaresecuritymodules.PrivateEndpointConnectionProperties{
-		// 					PrivateEndpoint: &armhardwaresecuritymodules.PrivateEndpoint{
-		// 						ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.Network/privateEndpoints/sample-pec1"),
-		// 					},
-		// 					PrivateLinkServiceConnectionState: &armhardwaresecuritymodules.PrivateLinkServiceConnectionState{
-		// 						Description: to.Ptr("This was automatically approved by user1234@contoso.com"),
-		// 						ActionsRequired: to.Ptr("None"),
-		// 						Status: to.Ptr(armhardwaresecuritymodules.PrivateEndpointServiceConnectionStatusApproved),
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.PrivateEndpointConnectionProvisioningStateSucceeded),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("User1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("User2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("chsm2"),
-		// 				Type: to.Ptr("Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections"),
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm2/privateEndpointConnections/sample-pec2"),
-		// 				Properties: &armhardwaresecuritymodules.PrivateEndpointConnectionProperties{
-		// 					PrivateEndpoint: &armhardwaresecuritymodules.PrivateEndpoint{
-		// 						ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.Network/privateEndpoints/sample-pec2"),
-		// 					},
-		// 					PrivateLinkServiceConnectionState: &armhardwaresecuritymodules.PrivateLinkServiceConnectionState{
-		// 						Description: to.Ptr("This was automatically approved by user1234@contoso.com"),
-		// 						ActionsRequired: to.Ptr("None"),
-		// 						Status: to.Ptr(armhardwaresecuritymodules.PrivateEndpointServiceConnectionStatusApproved),
-		// 					},
-		// 					ProvisioningState: to.Ptr(armhardwaresecuritymodules.PrivateEndpointConnectionProvisioningStateSucceeded),
-		// 				},
-		// 				SystemData: &armhardwaresecuritymodules.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					CreatedBy: to.Ptr("User1"),
-		// 					CreatedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T12:00:00.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("User2"),
-		// 					LastModifiedByType: to.Ptr(armhardwaresecuritymodules.CreatedByTypeUser),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: chsm1
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm1/privateEndpointConnections/sample-pec1
+	// Name: chsm2
+	// Type: Microsoft.HardwareSecurityModules/cloudHsmClusters/privateEndpointConnections
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rgcloudhsm/providers/Microsoft.HardwareSecurityModules/cloudHsmClusters/chsm2/privateEndpointConnections/sample-pec2
 }
@@ -0,0 +1,266 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package armhealthbot_test
+
+import (
+	"armhealthbot"
+	"armhealthbot/fake"
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/tracing"
+)
+
+type spanKey struct{}
+
+type recordedSpan struct {
+	name  string
+	attrs map[string]any
+}
+
+// spanRecorder is a tracing.Provider that records the attributes of each span.
+type spanRecorder struct {
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+func (r *spanRecorder) provider() tracing.Provider {
+	return tracing.NewProvider(func(name, version string) tracing.Tracer {
+		return tracing.NewTracer(func(ctx context.Context, spanName string, options *tracing.SpanOptions) (context.Context, tracing.Span) {
+			span := &recordedSpan{name: spanName, attrs: map[string]any{}}
+			r.mu.Lock()
+			r.spans = append(r.spans, span)
+			r.mu.Unlock()
+			setAttrs := func(attrs ...tracing.Attribute) {
+				r.mu.Lock()
+				defer r.mu.Unlock()
+				for _, attr := range attrs {
+					span.attrs[attr.Key] = attr.Value
+				}
+			}
+			if options != nil {
+				setAttrs(options.Attributes...)
+			}
+			return context.WithValue(ctx, spanKey{}, setAttrs), tracing.NewSpan(tracing.SpanImpl{SetAttributes: setAttrs})
+		}, &tracing.TracerOptions{
+			SpanFromContext: func(ctx context.Context) tracing.Span {
+				setAttrs, _ := ctx.Value(spanKey{}).(func(...tracing.Attribute))
+				return tracing.NewSpan(tracing.SpanImpl{SetAttributes: setAttrs})
+			},
+		})
+	}, nil)
+}
+
+func (r *spanRecorder) find(name string) []*recordedSpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var spans []*recordedSpan
+	for _, span := range r.spans {
+		if span.name == name {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+type testMeter struct {
+	mu        sync.Mutex
+	durations map[string]int
+	errors    map[string]int
+}
+
+func (m *testMeter) RecordDuration(ctx context.Context, operation string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.durations[operation]++
+}
+
+func (m *testMeter) AddError(ctx context.Context, operation string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.errors[operation]++
+}
+
+func newTelemetryClient(t *testing.T, srv *fake.HealthBotsServer, recorder *spanRecorder) *armhealthbot.HealthBotsClient {
+	client, err := armhealthbot.NewHealthBotsClient("subid", &azfake.TokenCredential{}, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport:       fake.NewHealthBotsServerTransport(srv),
+			TracingProvider: recorder.provider(),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestSpanAttributes(t *testing.T) {
+	recorder := &spanRecorder{}
+	client := newTelemetryClient(t, &fake.HealthBotsServer{
+		Get: func(ctx context.Context, resourceGroupName string, botName string, options *armhealthbot.HealthBotsClientGetOptions) (resp azfake.Responder[armhealthbot.HealthBotsClientGetResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, armhealthbot.HealthBotsClientGetResponse{}, nil)
+			return
+		},
+	}, recorder)
+	if _, err := client.Get(context.Background(), "rg", "bot", nil); err != nil {
+		t.Fatal(err)
+	}
+	spans := recorder.find("HealthBotsClient.Get")
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	expected := map[string]any{
+		"url.template":   "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthBot/healthBots/{botName}",
+		"az.api_version": "2024-02-01",
+		"az.namespace":   "Microsoft.HealthBot",
+		"az.resource.id": "/subscriptions/subid/resourceGroups/rg/providers/Microsoft.HealthBot/healthBots/bot",
+	}
+	for key, value := range expected {
+		if spans[0].attrs[key] != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, spans[0].attrs[key])
+		}
+	}
+}
+
+func TestSpanAttributesPager(t *testing.T) {
+	recorder := &spanRecorder{}
+	client := newTelemetryClient(t, &fake.HealthBotsServer{
+		NewListPager: func(options *armhealthbot.HealthBotsClientListOptions) (resp azfake.PagerResponder[armhealthbot.HealthBotsClientListResponse]) {
+			resp.AddPage(http.StatusOK, armhealthbot.HealthBotsClientListResponse{}, nil)
+			resp.AddPage(http.StatusOK, armhealthbot.HealthBotsClientListResponse{}, nil)
+			return
+		},
+	}, recorder)
+	pager := client.NewListPager(nil)
+	for pager.More() {
+		if _, err := pager.NextPage(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	spans := recorder.find("Pager[HealthBotsClientListResponse].NextPage")
+	if len(spans) != 2 {
+		t.Fatalf("expected two spans, got %d", len(spans))
+	}
+	for i, span := range spans {
+		if span.attrs["az.page.number"] != i+1 {
+			t.Errorf("expected page number %d, got %v", i+1, span.attrs["az.page.number"])
+		}
+		if span.attrs["az.namespace"] != "Microsoft.HealthBot" {
+			t.Errorf("unexpected namespace %v", span.attrs["az.namespace"])
+		}
+	}
+}
+
+func TestSpanAttributesPoller(t *testing.T) {
+	recorder := &spanRecorder{}
+	client := newTelemetryClient(t, &fake.HealthBotsServer{
+		BeginCreate: func(ctx context.Context, resourceGroupName string, botName string, parameters armhealthbot.HealthBot, options *armhealthbot.HealthBotsClientBeginCreateOptions) (resp azfake.PollerResponder[armhealthbot.HealthBotsClientCreateResponse], errResp azfake.ErrorResponder) {
+			resp.AddNonTerminalResponse(http.StatusCreated, nil)
+			resp.AddNonTerminalResponse(http.StatusOK, nil)
+			resp.SetTerminalResponse(http.StatusOK, armhealthbot.HealthBotsClientCreateResponse{
+				HealthBot: armhealthbot.HealthBot{Name: to.Ptr("bot")},
+			}, nil)
+			return
+		},
+	}, recorder)
+	poller, err := client.BeginCreate(context.Background(), "rg", "bot", armhealthbot.HealthBot{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := poller.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the resume token can be used to resume the traced poller
+	tk, err := poller.ResumeToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	poller, err = client.BeginCreate(context.Background(), "rg", "bot", armhealthbot.HealthBot{}, &armhealthbot.HealthBotsClientBeginCreateOptions{
+		ResumeToken: tk,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := poller.PollUntilDone(context.Background(), &runtime.PollUntilDoneOptions{Frequency: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Name == nil || *resp.Name != "bot" {
+		t.Fatalf("unexpected response %v", resp.Name)
+	}
+
+	polls := recorder.find("Poller[HealthBotsClientCreateResponse].Poll")
+	if len(polls) != 1 || polls[0].attrs["az.lro.poll_count"] != 1 {
+		t.Fatalf("unexpected poll spans %v", polls)
+	}
+	// the resumed poller counts its polls from 1
+	polls = recorder.find("Poller[HealthBotsClientCreateResponse].PollUntilDone")
+	if len(polls) != 1 || polls[0].attrs["az.lro.poll_count"] != 1 {
+		t.Fatalf("unexpected PollUntilDone spans %v", polls)
+	}
+}
+
+func TestOperationMetrics(t *testing.T) {
+	meter := &testMeter{durations: map[string]int{}, errors: map[string]int{}}
+	srv := fake.ServerFactory{
+		HealthBotsServer: fake.HealthBotsServer{
+			Get: func(ctx context.Context, resourceGroupName string, botName string, options *armhealthbot.HealthBotsClientGetOptions) (resp azfake.Responder[armhealthbot.HealthBotsClientGetResponse], errResp azfake.ErrorResponder) {
+				if botName == "missing" {
+					errResp.SetResponseError(http.StatusNotFound, "NotFound")
+					return
+				}
+				resp.SetResponse(http.StatusOK, armhealthbot.HealthBotsClientGetResponse{}, nil)
+				return
+			},
+		},
+	}
+	factory, err := armhealthbot.NewClientFactory("subid", &azfake.TokenCredential{}, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport:       fake.NewServerFactoryTransport(&srv),
+			PerCallPolicies: []policy.Policy{armhealthbot.NewOperationMeterPolicy(meter)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := factory.NewHealthBotsClient()
+	if _, err := client.Get(context.Background(), "rg", "bot", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get(context.Background(), "rg", "missing", nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if meter.durations["HealthBotsClient.Get"] != 2 {
+		t.Errorf("expected two durations, got %d", meter.durations["HealthBotsClient.Get"])
+	}
+	if meter.errors["HealthBotsClient.Get"] != 1 {
+		t.Errorf("expected one error, got %d", meter.errors["HealthBotsClient.Get"])
+	}
+
+	// clients created without the policy don't record metrics
+	unmetered, err := armhealthbot.NewHealthBotsClient("subid", &azfake.TokenCredential{}, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewHealthBotsServerTransport(&srv.HealthBotsServer),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := unmetered.Get(context.Background(), "rg", "bot", nil); err != nil {
+		t.Fatal(err)
+	}
+	if meter.durations["HealthBotsClient.Get"] != 2 {
+		t.Errorf("expected two durations, got %d", meter.durations["HealthBotsClient.Get"])
+	}
+}
@@ -14,6 +14,7 @@ import (
 type ClientFactory struct {
 	subscriptionID string
 	internal       *arm.Client
+	meter          OperationMeter
 }
 
 // NewClientFactory creates a new instance of ClientFactory with the specified values.
@@ -26,9 +27,14 @@ func NewClientFactory(subscriptionID string, credential azcore.TokenCredential,
 	if err != nil {
 		return nil, err
 	}
+	var meter OperationMeter
+	if options != nil {
+		meter = getOperationMeter(options.PerCallPolicies)
+	}
 	return &ClientFactory{
 		subscriptionID: subscriptionID,
 		internal:       internal,
+		meter:          meter,
 	}, nil
 }
 
@@ -37,6 +43,7 @@ func (c *ClientFactory) NewHealthBotsClient() *HealthBotsClient {
 	return &HealthBotsClient{
 		subscriptionID: c.subscriptionID,
 		internal:       c.internal,
+		meter:          c.meter,
 	}
 }
 
@@ -44,5 +51,6 @@ func (c *ClientFactory) NewHealthBotsClient() *HealthBotsClient {
 func (c *ClientFactory) NewOperationsClient() *OperationsClient {
 	return &OperationsClient{
 		internal: c.internal,
+		meter:    c.meter,
 	}
 }
@@ -11,9 +11,11 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/tracing"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // HealthBotsClient contains the methods for the HealthBots group.
@@ -22,6 +24,7 @@ import (
 // Generated from API version 2024-02-01
 type HealthBotsClient struct {
 	internal       *arm.Client
+	meter          OperationMeter
 	subscriptionID string
 }
 
@@ -34,9 +37,14 @@ func NewHealthBotsClient(subscriptionID string, credential azcore.TokenCredentia
 	if err != nil {
 		return nil, err
 	}
+	var meter OperationMeter
+	if options != nil {
+		meter = getOperationMeter(options.PerCallPolicies)
+	}
 	client := &HealthBotsClient{
 		subscriptionID: subscriptionID,
 		internal:       cl,
+		meter:          meter,
 	}
 	return client, nil
 }
@@ -53,14 +61,17 @@ func (client *HealthBotsClient) BeginCreate(ctx context.Context, resourceGroupNa
 		if err != nil {
 			return nil, err
 		}
-		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[HealthBotsClientCreateResponse]{
-			Tracer: client.internal.Tracer(),
-		})
-		return poller, err
+		poller, err := runtime.NewPoller[HealthBotsClientCreateResponse](resp, client.internal.Pipeline(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return tracePolls(resp, poller, client.internal.Pipeline(), client.internal.Tracer())
 	} else {
-		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[HealthBotsClientCreateResponse]{
-			Tracer: client.internal.Tracer(),
-		})
+		poller, err := runtime.NewPollerFromResumeToken[HealthBotsClientCreateResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return tracePolls(nil, poller, client.internal.Pipeline(), client.internal.Tracer())
 	}
 }
 
@@ -70,12 +81,20 @@ func (client *HealthBotsClient) create(ctx context.Context, resourceGroupName st
 	var err error
 	const operationName = "HealthBotsClient.BeginCreate"
 	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
-	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), &runtime.StartSpanOptions{
+		Attributes: []tracing.Attribute{
+			{Key: "url.template", Value: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthBot/healthBots/{botName}"},
+			{Key: "az.api_version", Value: version20240201},
+			{Key: "az.namespace", Value: "Microsoft.HealthBot"},
+		},
+	})
 	defer func() { endSpan(err) }()
+	defer recordOperation(ctx, client.meter, operationName, time.Now(), &err)
 	req, err := client.createCreateRequest(ctx, resourceGroupName, botName, parameters, options)
 	if err != nil {
 		return nil, err
 	}
+	client.internal.Tracer().SpanFromContext(ctx).SetAttributes(tracing.Attribute{Key: "az.resource.id", Value: armResourceID(req, 0)})
 	httpResp, err := client.internal.Pipeline().Do(req)
 	if err != nil {
 		return nil, err
@@ -128,14 +147,17 @@ func (client *HealthBotsClient) BeginDelete(ctx context.Context, resourceGroupNa
 		if err != nil {
 			return nil, err
 		}
-		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[HealthBotsClientDeleteResponse]{
-			Tracer: client.internal.Tracer(),
-		})
-		return poller, err
+		poller, err := runtime.NewPoller[HealthBotsClientDeleteResponse](resp, client.internal.Pipeline(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return tracePolls(resp, poller, client.internal.Pipeline(), client.internal.Tracer())
 	} else {
-		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[HealthBotsClientDeleteResponse]{
-			Tracer: client.internal.Tracer(),
-		})
+		poller, err := runtime.NewPollerFromResumeToken[HealthBotsClientDeleteResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return tracePolls(nil, poller, client.internal.Pipeline(), client.internal.Tracer())
 	}
 }
 
@@ -145,12 +167,20 @@ func (client *HealthBotsClient) deleteOperation(ctx context.Context, resourceGro
 	var err error
 	const operationName = "HealthBotsClient.BeginDelete"
 	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
-	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), &runtime.StartSpanOptions{
+		Attributes: []tracing.Attribute{
+			{Key: "url.template", Value: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthBot/healthBots/{botName}"},
+			{Key: "az.api_version", Value: version20240201},
+			{Key: "az.namespace", Value: "Microsoft.HealthBot"},
+		},
+	})
 	defer func() { endSpan(err) }()
+	defer recordOperation(ctx, client.meter, operationName, time.Now(), &err)
 	req, err := client.deleteCreateRequest(ctx, resourceGroupName, botName, options)
 	if err != nil {
 		return nil, err
 	}
+	client.internal.Tracer().SpanFromContext(ctx).SetAttributes(tracing.Attribute{Key: "az.resource.id", Value: armResourceID(req, 0)})
 	httpResp, err := client.internal.Pipeline().Do(req)
 	if err != nil {
 		return nil, err
@@ -196,12 +226,20 @@ func (client *HealthBotsClient) Get(ctx context.Context, resourceGroupName strin
 	var err error
 	const operationName = "HealthBotsClient.Get"
 	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
-	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), &runtime.StartSpanOptions{
+		Attributes: []tracing.Attribute{
+			{Key: "url.template", Value: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthBot/healthBots/{botName}"},
+			{Key: "az.api_version", Value: version20240201},
+			{Key: "az.namespace", Value: "Microsoft.HealthBot"},
+		},
+	})
 	defer func() { endSpan(err) }()
+	defer recordOperation(ctx, client.meter, operationName, time.Now(), &err)
 	req, err := client.getCreateRequest(ctx, resourceGroupName, botName, options)
 	if err != nil {
 		return HealthBotsClientGetResponse{}, err
 	}
+	client.internal.Tracer().SpanFromContext(ctx).SetAttributes(tracing.Attribute{Key: "az.resource.id", Value: armResourceID(req, 0)})
 	httpResp, err := client.internal.Pipeline().Do(req)
 	if err != nil {
 		return HealthBotsClientGetResponse{}, err
@@ -256,7 +294,7 @@ func (client *HealthBotsClient) NewListPager(options *HealthBotsClientListOption
 		More: func(page HealthBotsClientListResponse) bool {
 			return page.NextLink != nil && len(*page.NextLink) > 0
 		},
-		Fetcher: func(ctx context.Context, page *HealthBotsClientListResponse) (HealthBotsClientListResponse, error) {
+		Fetcher: tracePages(client.internal.Tracer(), []tracing.Attribute{{Key: "url.template", Value: "/subscriptions/{subscriptionId}/providers/Microsoft.HealthBot/healthBots"}, {Key: "az.api_version", Value: version20240201}, {Key: "az.namespace", Value: "Microsoft.HealthBot"}}, meterPages(client.meter, "HealthBotsClient.NewListPager", func(ctx context.Context, page *HealthBotsClientListResponse) (HealthBotsClientListResponse, error) {
 			ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, "HealthBotsClient.NewListPager")
 			nextLink := ""
 			if page != nil {
@@ -269,7 +307,7 @@ func (client *HealthBotsClient) NewListPager(options *HealthBotsClientListOption
 				return HealthBotsClientListResponse{}, err
 			}
 			return client.listHandleResponse(resp)
-		},
+		})),
 		Tracer: client.internal.Tracer(),
 	})
 }
@@ -310,7 +348,7 @@ func (client *HealthBotsClient) NewListByResourceGroupPager(resourceGroupName st
 		More: func(page HealthBotsClientListByResourceGroupResponse) bool {
 			return page.NextLink != nil && len(*page.NextLink) > 0
 		},
-		Fetcher: func(ctx context.Context, page *HealthBotsClientListByResourceGroupResponse) (HealthBotsClientListByResourceGroupResponse, error) {
+		Fetcher: tracePages(client.internal.Tracer(), []tracing.Attribute{{Key: "url.template", Value: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthBot/healthBots"}, {Key: "az.api_version", Value: version20240201}, {Key: "az.namespace", Value: "Microsoft.HealthBot"}}, meterPages(client.meter, "HealthBotsClient.NewListByResourceGroupPager", func(ctx context.Context, page *HealthBotsClientListByResourceGroupResponse) (HealthBotsClientListByResourceGroupResponse, error) {
 			ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, "HealthBotsClient.NewListByResourceGroupPager")
 			nextLink := ""
 			if page != nil {
@@ -323,7 +361,7 @@ func (client *HealthBotsClient) NewListByResourceGroupPager(resourceGroupName st
 				return HealthBotsClientListByResourceGroupResponse{}, err
 			}
 			return client.listByResourceGroupHandleResponse(resp)
-		},
+		})),
 		Tracer: client.internal.Tracer(),
 	})
 }
@@ -368,12 +406,20 @@ func (client *HealthBotsClient) ListSecrets(ctx context.Context, resourceGroupNa
 	var err error
 	const operationName = "HealthBotsClient.ListSecrets"
 	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
-	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), &runtime.StartSpanOptions{
+		Attributes: []tracing.Attribute{
+			{Key: "url.template", Value: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthBot/healthBots/{botName}/listSecrets"},
+			{Key: "az.api_version", Value: version20240201},
+			{Key: "az.namespace", Value: "Microsoft.HealthBot"},
+		},
+	})
 	defer func() { endSpan(err) }()
+	defer recordOperation(ctx, client.meter, operationName, time.Now(), &err)
 	req, err := client.listSecretsCreateRequest(ctx, resourceGroupName, botName, options)
 	if err != nil {
 		return HealthBotsClientListSecretsResponse{}, err
 	}
+	client.internal.Tracer().SpanFromContext(ctx).SetAttributes(tracing.Attribute{Key: "az.resource.id", Value: armResourceID(req, 1)})
 	httpResp, err := client.internal.Pipeline().Do(req)
 	if err != nil {
 		return HealthBotsClientListSecretsResponse{}, err
@@ -431,12 +477,20 @@ func (client *HealthBotsClient) RegenerateAPIJwtSecret(ctx context.Context, reso
 	var err error
 	const operationName = "HealthBotsClient.RegenerateAPIJwtSecret"
 	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
-	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), &runtime.StartSpanOptions{
+		Attributes: []tracing.Attribute{
+			{Key: "url.template", Value: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthBot/healthBots/{botName}/regenerateApiJwtSecret"},
+			{Key: "az.api_version", Value: version20240201},
+			{Key: "az.namespace", Value: "Microsoft.HealthBot"},
+		},
+	})
 	defer func() { endSpan(err) }()
+	defer recordOperation(ctx, client.meter, operationName, time.Now(), &err)
 	req, err := client.regenerateAPIJwtSecretCreateRequest(ctx, resourceGroupName, botName, options)
 	if err != nil {
 		return HealthBotsClientRegenerateAPIJwtSecretResponse{}, err
 	}
+	client.internal.Tracer().SpanFromContext(ctx).SetAttributes(tracing.Attribute{Key: "az.resource.id", Value: armResourceID(req, 1)})
 	httpResp, err := client.internal.Pipeline().Do(req)
 	if err != nil {
 		return HealthBotsClientRegenerateAPIJwtSecretResponse{}, err
@@ -496,14 +550,17 @@ func (client *HealthBotsClient) BeginUpdate(ctx context.Context, resourceGroupNa
 		if err != nil {
 			return nil, err
 		}
-		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[HealthBotsClientUpdateResponse]{
-			Tracer: client.internal.Tracer(),
-		})
-		return poller, err
+		poller, err := runtime.NewPoller[HealthBotsClientUpdateResponse](resp, client.internal.Pipeline(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return tracePolls(resp, poller, client.internal.Pipeline(), client.internal.Tracer())
 	} else {
-		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[HealthBotsClientUpdateResponse]{
-			Tracer: client.internal.Tracer(),
-		})
+		poller, err := runtime.NewPollerFromResumeToken[HealthBotsClientUpdateResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return tracePolls(nil, poller, client.internal.Pipeline(), client.internal.Tracer())
 	}
 }
 
@@ -513,12 +570,20 @@ func (client *HealthBotsClient) update(ctx context.Context, resourceGroupName st
 	var err error
 	const operationName = "HealthBotsClient.BeginUpdate"
 	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
-	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), &runtime.StartSpanOptions{
+		Attributes: []tracing.Attribute{
+			{Key: "url.template", Value: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.HealthBot/healthBots/{botName}"},
+			{Key: "az.api_version", Value: version20240201},
+			{Key: "az.namespace", Value: "Microsoft.HealthBot"},
+		},
+	})
 	defer func() { endSpan(err) }()
+	defer recordOperation(ctx, client.meter, operationName, time.Now(), &err)
 	req, err := client.updateCreateRequest(ctx, resourceGroupName, botName, parameters, options)
 	if err != nil {
 		return nil, err
 	}
+	client.internal.Tracer().SpanFromContext(ctx).SetAttributes(tracing.Attribute{Key: "az.resource.id", Value: armResourceID(req, 0)})
 	httpResp, err := client.internal.Pipeline().Do(req)
 	if err != nil {
 		return nil, err
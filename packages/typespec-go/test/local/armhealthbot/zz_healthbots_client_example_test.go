@@ -6,20 +6,66 @@ package armhealthbot_test
 
 import (
 	"armhealthbot"
+	"armhealthbot/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-02-01/ResourceCreationPut.json
 func ExampleHealthBotsClient_BeginCreate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, nil)
+	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				HealthBotsServer: fake.HealthBotsServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, botName string, parameters armhealthbot.HealthBot, options *armhealthbot.HealthBotsClientBeginCreateOptions) (resp azfake.PollerResponder[armhealthbot.HealthBotsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhealthbot.HealthBotsClientCreateResponse{
+							HealthBot: armhealthbot.HealthBot{
+								Name: to.Ptr("samplebotname"),
+								Type: to.Ptr("Microsoft.HealthBot/healthBots"),
+								ID:   to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname"),
+								Identity: &armhealthbot.Identity{
+									Type:        to.Ptr(armhealthbot.ResourceIdentityTypeSystemAssignedUserAssigned),
+									PrincipalID: to.Ptr("principalId"),
+									TenantID:    to.Ptr("tenantId"),
+									UserAssignedIdentities: &armhealthbot.UserAssignedIdentityMap{
+										AdditionalProperties: map[string]*armhealthbot.UserAssignedIdentity{
+											"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi":  {},
+											"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi2": {},
+										},
+									},
+								},
+								Location: to.Ptr("East US"),
+								Properties: &armhealthbot.Properties{
+									BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/contoso"),
+								},
+								SKU: &armhealthbot.SKU{
+									Name: to.Ptr(armhealthbot.SKUNameF0),
+								},
+								SystemData: &armhealthbot.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t }()),
+									CreatedBy:          to.Ptr("jack@outlook.com"),
+									CreatedByType:      to.Ptr(armhealthbot.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("ryan@outlook.com"),
+									LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -45,54 +91,33 @@ func ExampleHealthBotsClient_BeginCreate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhealthbot.HealthBotsClientCreateResponse{
-	// 	HealthBot: armhealthbot.HealthBot{
-	// 		Name: to.Ptr("samplebotname"),
-	// 		Type: to.Ptr("Microsoft.HealthBot/healthBots"),
-	// 		ID: to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname"),
-	// 		Identity: &armhealthbot.Identity{
-	// 			Type: to.Ptr(armhealthbot.ResourceIdentityTypeSystemAssignedUserAssigned),
-	// 			PrincipalID: to.Ptr("principalId"),
-	// 			TenantID: to.Ptr("tenantId"),
-	// 			UserAssignedIdentities: &armhealthbot.UserAssignedIdentityMap{
-	// 				AdditionalProperties: map[string]*armhealthbot.UserAssignedIdentity{
-	// 				"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi": &armhealthbot.UserAssignedIdentity{
-	// 				},
-	// 				"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi2": &armhealthbot.UserAssignedIdentity{
-	// 				},
-	// 			},
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("East US"),
-	// 		Properties: &armhealthbot.Properties{
-	// 			BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/contoso"),
-	// 		},
-	// 		SKU: &armhealthbot.SKU{
-	// 			Name: to.Ptr(armhealthbot.SKUNameF0),
-	// 		},
-	// 		SystemData: &armhealthbot.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t}()),
-	// 			CreatedBy: to.Ptr("jack@outlook.com"),
-	// 			CreatedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("ryan@outlook.com"),
-	// 			LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: samplebotname
+	// Type: Microsoft.HealthBot/healthBots
+	// ID: /subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname
+	// Location: East US
 }
 
 // Generated from example definition: 2024-02-01/ResourceDeletionDelete.json
 func ExampleHealthBotsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, nil)
+	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				HealthBotsServer: fake.HealthBotsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, botName string, options *armhealthbot.HealthBotsClientBeginDeleteOptions) (resp azfake.PollerResponder[armhealthbot.HealthBotsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhealthbot.HealthBotsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -106,19 +131,57 @@ func ExampleHealthBotsClient_BeginDelete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhealthbot.HealthBotsClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-02-01/ResourceInfoGet.json
 func ExampleHealthBotsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, nil)
+	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				HealthBotsServer: fake.HealthBotsServer{
+					Get: func(ctx context.Context, resourceGroupName string, botName string, options *armhealthbot.HealthBotsClientGetOptions) (resp azfake.Responder[armhealthbot.HealthBotsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhealthbot.HealthBotsClientGetResponse{
+							HealthBot: armhealthbot.HealthBot{
+								Name: to.Ptr("samplebotname"),
+								Type: to.Ptr("Microsoft.HealthBot/healthBots"),
+								ID:   to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname"),
+								Identity: &armhealthbot.Identity{
+									Type:        to.Ptr(armhealthbot.ResourceIdentityTypeSystemAssignedUserAssigned),
+									PrincipalID: to.Ptr("principalId"),
+									TenantID:    to.Ptr("tenantId"),
+									UserAssignedIdentities: &armhealthbot.UserAssignedIdentityMap{
+										AdditionalProperties: map[string]*armhealthbot.UserAssignedIdentity{
+											"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi":  {},
+											"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi2": {},
+										},
+									},
+								},
+								Location: to.Ptr("East US"),
+								Properties: &armhealthbot.Properties{
+									BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/contoso"),
+								},
+								SKU: &armhealthbot.SKU{
+									Name: to.Ptr(armhealthbot.SKUNameF0),
+								},
+								SystemData: &armhealthbot.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t }()),
+									CreatedBy:          to.Ptr("jack@outlook.com"),
+									CreatedByType:      to.Ptr(armhealthbot.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("ryan@outlook.com"),
+									LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -126,54 +189,69 @@ func ExampleHealthBotsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhealthbot.HealthBotsClientGetResponse{
-	// 	HealthBot: armhealthbot.HealthBot{
-	// 		Name: to.Ptr("samplebotname"),
-	// 		Type: to.Ptr("Microsoft.HealthBot/healthBots"),
-	// 		ID: to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname"),
-	// 		Identity: &armhealthbot.Identity{
-	// 			Type: to.Ptr(armhealthbot.ResourceIdentityTypeSystemAssignedUserAssigned),
-	// 			PrincipalID: to.Ptr("principalId"),
-	// 			TenantID: to.Ptr("tenantId"),
-	// 			UserAssignedIdentities: &armhealthbot.UserAssignedIdentityMap{
-	// 				AdditionalProperties: map[string]*armhealthbot.UserAssignedIdentity{
-	// 				"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi": &armhealthbot.UserAssignedIdentity{
-	// 				},
-	// 				"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi2": &armhealthbot.UserAssignedIdentity{
-	// 				},
-	// 			},
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("East US"),
-	// 		Properties: &armhealthbot.Properties{
-	// 			BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/contoso"),
-	// 		},
-	// 		SKU: &armhealthbot.SKU{
-	// 			Name: to.Ptr(armhealthbot.SKUNameF0),
-	// 		},
-	// 		SystemData: &armhealthbot.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t}()),
-	// 			CreatedBy: to.Ptr("jack@outlook.com"),
-	// 			CreatedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("ryan@outlook.com"),
-	// 			LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// Name: samplebotname
+	// Type: Microsoft.HealthBot/healthBots
+	// ID: /subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname
+	// Location: East US
 }
 
 // Generated from example definition: 2024-02-01/ListBotsBySubscription.json
 func ExampleHealthBotsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhealthbot.NewClientFactory("subscription-id", cred, nil)
+	clientFactory, err := armhealthbot.NewClientFactory("subscription-id", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				HealthBotsServer: fake.HealthBotsServer{
+					NewListPager: func(options *armhealthbot.HealthBotsClientListOptions) (resp azfake.PagerResponder[armhealthbot.HealthBotsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armhealthbot.HealthBotsClientListResponse{
+							BotResponseList: armhealthbot.BotResponseList{
+								Value: []*armhealthbot.HealthBot{
+									{
+										Name: to.Ptr("samplebotname2"),
+										Type: to.Ptr("Microsoft.HealthBot/healthBots"),
+										ID:   to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname2"),
+										Identity: &armhealthbot.Identity{
+											Type:        to.Ptr(armhealthbot.ResourceIdentityTypeSystemAssignedUserAssigned),
+											PrincipalID: to.Ptr("principalId"),
+											TenantID:    to.Ptr("tenantId"),
+											UserAssignedIdentities: &armhealthbot.UserAssignedIdentityMap{
+												AdditionalProperties: map[string]*armhealthbot.UserAssignedIdentity{
+													"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi":  {},
+													"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi2": {},
+												},
+											},
+										},
+										Location: to.Ptr("East US"),
+										Properties: &armhealthbot.Properties{
+											BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/samplebotname2-hdi1osc"),
+										},
+										SKU: &armhealthbot.SKU{
+											Name: to.Ptr(armhealthbot.SKUNameS1),
+										},
+										SystemData: &armhealthbot.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t }()),
+											CreatedBy:          to.Ptr("jack@outlook.com"),
+											CreatedByType:      to.Ptr(armhealthbot.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t }()),
+											LastModifiedBy:     to.Ptr("ryan@outlook.com"),
+											LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -184,60 +262,91 @@ func ExampleHealthBotsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhealthbot.HealthBotsClientListResponse{
-		// 	BotResponseList: armhealthbot.BotResponseList{
-		// 		Value: []*armhealthbot.HealthBot{
-		// 			{
-		// 				Name: to.Ptr("samplebotname2"),
-		// 				Type: to.Ptr("Microsoft.HealthBot/healthBots"),
-		// 				ID: to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname2"),
-		// 				Identity: &armhealthbot.Identity{
-		// 					Type: to.Ptr(armhealthbot.ResourceIdentityTypeSystemAssignedUserAssigned),
-		// 					PrincipalID: to.Ptr("principalId"),
-		// 					TenantID: to.Ptr("tenantId"),
-		// 					UserAssignedIdentities: &armhealthbot.UserAssignedIdentityMap{
-		// 						AdditionalProperties: map[string]*armhealthbot.UserAssignedIdentity{
-		// 						"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi": &armhealthbot.UserAssignedIdentity{
-		// 						},
-		// 						"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi2": &armhealthbot.UserAssignedIdentity{
-		// 						},
-		// 					},
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("East US"),
-		// 				Properties: &armhealthbot.Properties{
-		// 					BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/samplebotname2-hdi1osc"),
-		// 				},
-		// 				SKU: &armhealthbot.SKU{
-		// 					Name: to.Ptr(armhealthbot.SKUNameS1),
-		// 				},
-		// 				SystemData: &armhealthbot.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t}()),
-		// 					CreatedBy: to.Ptr("jack@outlook.com"),
-		// 					CreatedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("ryan@outlook.com"),
-		// 					LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: samplebotname2
+	// Type: Microsoft.HealthBot/healthBots
+	// ID: /subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname2
+	// Location: East US
 }
 
 // Generated from example definition: 2024-02-01/ListBotsByResourceGroup.json
 func ExampleHealthBotsClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhealthbot.NewClientFactory("subscription-id", cred, nil)
+	clientFactory, err := armhealthbot.NewClientFactory("subscription-id", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				HealthBotsServer: fake.HealthBotsServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armhealthbot.HealthBotsClientListByResourceGroupOptions) (resp azfake.PagerResponder[armhealthbot.HealthBotsClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armhealthbot.HealthBotsClientListByResourceGroupResponse{
+							BotResponseList: armhealthbot.BotResponseList{
+								Value: []*armhealthbot.HealthBot{
+									{
+										Name:     to.Ptr("samplebotname"),
+										Type:     to.Ptr("Microsoft.HealthBot/healthBots"),
+										ID:       to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname"),
+										Location: to.Ptr("East US"),
+										Properties: &armhealthbot.Properties{
+											BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/samplebotname-1yhd91k"),
+										},
+										SKU: &armhealthbot.SKU{
+											Name: to.Ptr(armhealthbot.SKUNameF0),
+										},
+										SystemData: &armhealthbot.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t }()),
+											CreatedBy:          to.Ptr("jack@outlook.com"),
+											CreatedByType:      to.Ptr(armhealthbot.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t }()),
+											LastModifiedBy:     to.Ptr("ryan@outlook.com"),
+											LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
+										},
+									},
+									{
+										Name: to.Ptr("samplebotname2"),
+										Type: to.Ptr("Microsoft.HealthBot/healthBots"),
+										ID:   to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname2"),
+										Identity: &armhealthbot.Identity{
+											Type:        to.Ptr(armhealthbot.ResourceIdentityTypeSystemAssignedUserAssigned),
+											PrincipalID: to.Ptr("principalId"),
+											TenantID:    to.Ptr("tenantId"),
+											UserAssignedIdentities: &armhealthbot.UserAssignedIdentityMap{
+												AdditionalProperties: map[string]*armhealthbot.UserAssignedIdentity{
+													"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi":  {},
+													"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi2": {},
+												},
+											},
+										},
+										Location: to.Ptr("East US"),
+										Properties: &armhealthbot.Properties{
+											BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/samplebotname2-hdi1osc"),
+										},
+										SKU: &armhealthbot.SKU{
+											Name: to.Ptr(armhealthbot.SKUNameS1),
+										},
+										SystemData: &armhealthbot.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t }()),
+											CreatedBy:          to.Ptr("jack@outlook.com"),
+											CreatedByType:      to.Ptr(armhealthbot.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t }()),
+											LastModifiedBy:     to.Ptr("ryan@outlook.com"),
+											LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -248,80 +357,56 @@ func ExampleHealthBotsClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhealthbot.HealthBotsClientListByResourceGroupResponse{
-		// 	BotResponseList: armhealthbot.BotResponseList{
-		// 		Value: []*armhealthbot.HealthBot{
-		// 			{
-		// 				Name: to.Ptr("samplebotname"),
-		// 				Type: to.Ptr("Microsoft.HealthBot/healthBots"),
-		// 				ID: to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname"),
-		// 				Location: to.Ptr("East US"),
-		// 				Properties: &armhealthbot.Properties{
-		// 					BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/samplebotname-1yhd91k"),
-		// 				},
-		// 				SKU: &armhealthbot.SKU{
-		// 					Name: to.Ptr(armhealthbot.SKUNameF0),
-		// 				},
-		// 				SystemData: &armhealthbot.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t}()),
-		// 					CreatedBy: to.Ptr("jack@outlook.com"),
-		// 					CreatedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("ryan@outlook.com"),
-		// 					LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("samplebotname2"),
-		// 				Type: to.Ptr("Microsoft.HealthBot/healthBots"),
-		// 				ID: to.Ptr("/subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname2"),
-		// 				Identity: &armhealthbot.Identity{
-		// 					Type: to.Ptr(armhealthbot.ResourceIdentityTypeSystemAssignedUserAssigned),
-		// 					PrincipalID: to.Ptr("principalId"),
-		// 					TenantID: to.Ptr("tenantId"),
-		// 					UserAssignedIdentities: &armhealthbot.UserAssignedIdentityMap{
-		// 						AdditionalProperties: map[string]*armhealthbot.UserAssignedIdentity{
-		// 						"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi": &armhealthbot.UserAssignedIdentity{
-		// 						},
-		// 						"/subscriptions/subscription-id/resourcegroups/myrg/providers/microsoft.managedidentity/userassignedidentities/my-mi2": &armhealthbot.UserAssignedIdentity{
-		// 						},
-		// 					},
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("East US"),
-		// 				Properties: &armhealthbot.Properties{
-		// 					BotManagementPortalLink: to.Ptr("https://us.healthbot.microsoft.com/account/samplebotname2-hdi1osc"),
-		// 				},
-		// 				SKU: &armhealthbot.SKU{
-		// 					Name: to.Ptr(armhealthbot.SKUNameS1),
-		// 				},
-		// 				SystemData: &armhealthbot.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-05T17:18:19.1234567Z"); return t}()),
-		// 					CreatedBy: to.Ptr("jack@outlook.com"),
-		// 					CreatedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-05-06T17:18:19.1234567Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("ryan@outlook.com"),
-		// 					LastModifiedByType: to.Ptr(armhealthbot.CreatedByTypeUser),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: samplebotname
+	// Type: Microsoft.HealthBot/healthBots
+	// ID: /subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname
+	// Location: East US
+	// Name: samplebotname2
+	// Type: Microsoft.HealthBot/healthBots
+	// ID: /subscriptions/subscription-id/resourceGroups/OneResourceGroupName/providers/Microsoft.HealthBot/healthBots/samplebotname2
+	// Location: East US
 }
 
 // Generated from example definition: 2024-02-01/ListSecrets.json
 func ExampleHealthBotsClient_ListSecrets() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, nil)
+	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				HealthBotsServer: fake.HealthBotsServer{
+					ListSecrets: func(ctx context.Context, resourceGroupName string, botName string, options *armhealthbot.HealthBotsClientListSecretsOptions) (resp azfake.Responder[armhealthbot.HealthBotsClientListSecretsResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhealthbot.HealthBotsClientListSecretsResponse{
+							KeysResponse: armhealthbot.KeysResponse{
+								Secrets: []*armhealthbot.Key{
+									{
+										KeyName: to.Ptr("APP_SECRET"),
+										Value:   to.Ptr("XXXXX"),
+									},
+									{
+										KeyName: to.Ptr("WEBCHAT_SECRET"),
+										Value:   to.Ptr("XXXXX"),
+									},
+									{
+										KeyName: to.Ptr("API_JWT_SECRET"),
+										Value:   to.Ptr("XXXXX"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -331,35 +416,30 @@ func ExampleHealthBotsClient_ListSecrets() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhealthbot.HealthBotsClientListSecretsResponse{
-	// 	KeysResponse: armhealthbot.KeysResponse{
-	// 		Secrets: []*armhealthbot.Key{
-	// 			{
-	// 				KeyName: to.Ptr("APP_SECRET"),
-	// 				Value: to.Ptr("XXXXX"),
-	// 			},
-	// 			{
-	// 				KeyName: to.Ptr("WEBCHAT_SECRET"),
-	// 				Value: to.Ptr("XXXXX"),
-	// 			},
-	// 			{
-	// 				KeyName: to.Ptr("API_JWT_SECRET"),
-	// 				Value: to.Ptr("XXXXX"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-02-01/RegenerateApiJwtSecret.json
 func ExampleHealthBotsClient_RegenerateAPIJwtSecret() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, nil)
+	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				HealthBotsServer: fake.HealthBotsServer{
+					RegenerateAPIJwtSecret: func(ctx context.Context, resourceGroupName string, botName string, options *armhealthbot.HealthBotsClientRegenerateAPIJwtSecretOptions) (resp azfake.Responder[armhealthbot.HealthBotsClientRegenerateAPIJwtSecretResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armhealthbot.HealthBotsClientRegenerateAPIJwtSecretResponse{
+							Key: armhealthbot.Key{
+								KeyName: to.Ptr("API_JWT_SECRET"),
+								Value:   to.Ptr("XXXXX"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -367,25 +447,29 @@ func ExampleHealthBotsClient_RegenerateAPIJwtSecret() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhealthbot.HealthBotsClientRegenerateAPIJwtSecretResponse{
-	// 	Key: armhealthbot.Key{
-	// 		KeyName: to.Ptr("API_JWT_SECRET"),
-	// 		Value: to.Ptr("XXXXX"),
-	// 	},
-	// }
+	fmt.Println("KeyName:", *res.KeyName)
+	fmt.Println("Value:", *res.Value)
+	// Output:
+	// KeyName: API_JWT_SECRET
+	// Value: XXXXX
 }
 
 // Generated from example definition: 2024-02-01/ResourceUpdatePatch.json
 func ExampleHealthBotsClient_BeginUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, nil)
+	clientFactory, err := armhealthbot.NewClientFactory("subid", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				HealthBotsServer: fake.HealthBotsServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, botName string, parameters armhealthbot.UpdateParameters, options *armhealthbot.HealthBotsClientBeginUpdateOptions) (resp azfake.PollerResponder[armhealthbot.HealthBotsClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armhealthbot.HealthBotsClientUpdateResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -403,7 +487,5 @@ func ExampleHealthBotsClient_BeginUpdate() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armhealthbot.HealthBotsClientUpdateResponse{
-	// }
+	// Output:
 }
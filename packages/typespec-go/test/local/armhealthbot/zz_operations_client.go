@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/tracing"
 	"net/http"
 	"strings"
 )
@@ -23,6 +24,7 @@ import (
 // Generated from API version 2024-02-01
 type OperationsClient struct {
 	internal *arm.Client
+	meter    OperationMeter
 }
 
 // NewOperationsClient creates a new instance of OperationsClient with the specified values.
@@ -33,8 +35,13 @@ func NewOperationsClient(credential azcore.TokenCredential, options *arm.ClientO
 	if err != nil {
 		return nil, err
 	}
+	var meter OperationMeter
+	if options != nil {
+		meter = getOperationMeter(options.PerCallPolicies)
+	}
 	client := &OperationsClient{
 		internal: cl,
+		meter:    meter,
 	}
 	return client, nil
 }
@@ -46,7 +53,7 @@ func (client *OperationsClient) NewListPager(options *OperationsClientListOption
 		More: func(page OperationsClientListResponse) bool {
 			return page.NextLink != nil && len(*page.NextLink) > 0
 		},
-		Fetcher: func(ctx context.Context, page *OperationsClientListResponse) (OperationsClientListResponse, error) {
+		Fetcher: tracePages(client.internal.Tracer(), []tracing.Attribute{{Key: "url.template", Value: "/providers/Microsoft.HealthBot/operations"}, {Key: "az.api_version", Value: version20240201}, {Key: "az.namespace", Value: "Microsoft.HealthBot"}}, meterPages(client.meter, "OperationsClient.NewListPager", func(ctx context.Context, page *OperationsClientListResponse) (OperationsClientListResponse, error) {
 			ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, "OperationsClient.NewListPager")
 			nextLink := ""
 			if page != nil {
@@ -59,7 +66,7 @@ func (client *OperationsClient) NewListPager(options *OperationsClientListOption
 				return OperationsClientListResponse{}, err
 			}
 			return client.listHandleResponse(resp)
-		},
+		})),
 		Tracer: client.internal.Tracer(),
 	})
 }
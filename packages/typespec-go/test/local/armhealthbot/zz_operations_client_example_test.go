@@ -6,19 +6,68 @@ package armhealthbot_test
 
 import (
 	"armhealthbot"
+	"armhealthbot/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-02-01/GetOperations.json
 func ExampleOperationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armhealthbot.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armhealthbot.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armhealthbot.OperationsClientListOptions) (resp azfake.PagerResponder[armhealthbot.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armhealthbot.OperationsClientListResponse{
+							AvailableOperations: armhealthbot.AvailableOperations{
+								Value: []*armhealthbot.OperationDetail{
+									{
+										Name: to.Ptr("Microsoft.Healthbot/healthbots/read"),
+										Display: &armhealthbot.OperationDisplay{
+											Description: to.Ptr("Read Azure Health Bot"),
+											Operation:   to.Ptr("Read Azure Health Bot"),
+											Provider:    to.Ptr("Azure Health Bot"),
+											Resource:    to.Ptr("Azure Health Bot"),
+										},
+										Origin: to.Ptr("user,system"),
+									},
+									{
+										Name: to.Ptr("Microsoft.Healthbot/healthbots/write"),
+										Display: &armhealthbot.OperationDisplay{
+											Description: to.Ptr("Writes Azure Health Bot"),
+											Operation:   to.Ptr("Write Azure Health Bot"),
+											Provider:    to.Ptr("Azure Health Bot"),
+											Resource:    to.Ptr("Azure Health Bot"),
+										},
+										Origin: to.Ptr("user,system"),
+									},
+									{
+										Name: to.Ptr("Microsoft.Healthbot/healthbots/delete"),
+										Display: &armhealthbot.OperationDisplay{
+											Description: to.Ptr("Deletes Azure Health Bot"),
+											Operation:   to.Ptr("Delete Azure Health Bot"),
+											Provider:    to.Ptr("Azure Health Bot"),
+											Resource:    to.Ptr("Azure Health Bot"),
+										},
+										Origin: to.Ptr("user,system"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,45 +78,15 @@ func ExampleOperationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Origin:", *v.Origin)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armhealthbot.OperationsClientListResponse{
-		// 	AvailableOperations: armhealthbot.AvailableOperations{
-		// 		Value: []*armhealthbot.OperationDetail{
-		// 			{
-		// 				Name: to.Ptr("Microsoft.Healthbot/healthbots/read"),
-		// 				Display: &armhealthbot.OperationDisplay{
-		// 					Description: to.Ptr("Read Azure Health Bot"),
-		// 					Operation: to.Ptr("Read Azure Health Bot"),
-		// 					Provider: to.Ptr("Azure Health Bot"),
-		// 					Resource: to.Ptr("Azure Health Bot"),
-		// 				},
-		// 				Origin: to.Ptr("user,system"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Microsoft.Healthbot/healthbots/write"),
-		// 				Display: &armhealthbot.OperationDisplay{
-		// 					Description: to.Ptr("Writes Azure Health Bot"),
-		// 					Operation: to.Ptr("Write Azure Health Bot"),
-		// 					Provider: to.Ptr("Azure Health Bot"),
-		// 					Resource: to.Ptr("Azure Health Bot"),
-		// 				},
-		// 				Origin: to.Ptr("user,system"),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Microsoft.Healthbot/healthbots/delete"),
-		// 				Display: &armhealthbot.OperationDisplay{
-		// 					Description: to.Ptr("Deletes Azure Health Bot"),
-		// 					Operation: to.Ptr("Delete Azure Health Bot"),
-		// 					Provider: to.Ptr("Azure Health Bot"),
-		// 					Resource: to.Ptr("Azure Health Bot"),
-		// 				},
-		// 				Origin: to.Ptr("user,system"),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Microsoft.Healthbot/healthbots/read
+	// Origin: user,system
+	// Name: Microsoft.Healthbot/healthbots/write
+	// Origin: user,system
+	// Name: Microsoft.Healthbot/healthbots/delete
+	// Origin: user,system
 }
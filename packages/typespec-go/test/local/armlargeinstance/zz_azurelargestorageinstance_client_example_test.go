@@ -6,20 +6,67 @@ package armlargeinstance_test
 
 import (
 	"armlargeinstance"
+	"armlargeinstance/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeStorageInstance_Create.json
 func ExampleAzureLargeStorageInstanceClient_Create() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AzureLargeStorageInstanceServer: fake.AzureLargeStorageInstanceServer{
+					Create: func(ctx context.Context, resourceGroupName string, azureLargeStorageInstanceName string, resource armlargeinstance.AzureLargeStorageInstance, options *armlargeinstance.AzureLargeStorageInstanceClientCreateOptions) (resp azfake.Responder[armlargeinstance.AzureLargeStorageInstanceClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.AzureLargeStorageInstanceClientCreateResponse{
+							AzureLargeStorageInstance: armlargeinstance.AzureLargeStorageInstance{
+								ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance"),
+								Location: to.Ptr("westus2"),
+								Name:     to.Ptr("myAzureLargeStorageInstance"),
+								Tags: map[string]*string{
+									"key": to.Ptr("value"),
+								},
+								Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
+								SystemData: &armlargeinstance.SystemData{
+									CreatedBy:          to.Ptr("user@microsoft.com"),
+									CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@microsoft.com"),
+									LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+								},
+								Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
+									AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
+									StorageProperties: &armlargeinstance.StorageProperties{
+										ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
+										OfferingType:      to.Ptr("EPIC"),
+										StorageType:       to.Ptr("FC"),
+										Generation:        to.Ptr("Gen4"),
+										HardwareType:      to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
+										WorkloadType:      to.Ptr("ODB"),
+										StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
+											BillingMode: to.Ptr("PAYG"),
+											SKU:         to.Ptr(""),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -46,53 +93,33 @@ func ExampleAzureLargeStorageInstanceClient_Create() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.AzureLargeStorageInstanceClientCreateResponse{
-	// 	AzureLargeStorageInstance: armlargeinstance.AzureLargeStorageInstance{
-	// 		ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance"),
-	// 		Location: to.Ptr("westus2"),
-	// 		Name: to.Ptr("myAzureLargeStorageInstance"),
-	// 		Tags: map[string]*string{
-	// 			"key": to.Ptr("value"),
-	// 		},
-	// 		Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
-	// 		SystemData: &armlargeinstance.SystemData{
-	// 			CreatedBy: to.Ptr("user@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-	// 		},
-	// 		Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
-	// 			AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
-	// 			StorageProperties: &armlargeinstance.StorageProperties{
-	// 				ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
-	// 				OfferingType: to.Ptr("EPIC"),
-	// 				StorageType: to.Ptr("FC"),
-	// 				Generation: to.Ptr("Gen4"),
-	// 				HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
-	// 				WorkloadType: to.Ptr("ODB"),
-	// 				StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
-	// 					BillingMode: to.Ptr("PAYG"),
-	// 					SKU: to.Ptr(""),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance
+	// Location: westus2
+	// Name: myAzureLargeStorageInstance
+	// Type: Microsoft.AzureLargeInstance/AzureLargeStorageInstances
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeStorageInstance_Delete.json
 func ExampleAzureLargeStorageInstanceClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AzureLargeStorageInstanceServer: fake.AzureLargeStorageInstanceServer{
+					Delete: func(ctx context.Context, resourceGroupName string, azureLargeStorageInstanceName string, options *armlargeinstance.AzureLargeStorageInstanceClientDeleteOptions) (resp azfake.Responder[armlargeinstance.AzureLargeStorageInstanceClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.AzureLargeStorageInstanceClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -102,19 +129,58 @@ func ExampleAzureLargeStorageInstanceClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.AzureLargeStorageInstanceClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeStorageInstance_Get.json
 func ExampleAzureLargeStorageInstanceClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AzureLargeStorageInstanceServer: fake.AzureLargeStorageInstanceServer{
+					Get: func(ctx context.Context, resourceGroupName string, azureLargeStorageInstanceName string, options *armlargeinstance.AzureLargeStorageInstanceClientGetOptions) (resp azfake.Responder[armlargeinstance.AzureLargeStorageInstanceClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.AzureLargeStorageInstanceClientGetResponse{
+							AzureLargeStorageInstance: armlargeinstance.AzureLargeStorageInstance{
+								ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance"),
+								Location: to.Ptr("westus2"),
+								Name:     to.Ptr("myAzureLargeStorageInstance"),
+								Tags: map[string]*string{
+									"key": to.Ptr("value"),
+								},
+								Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
+								SystemData: &armlargeinstance.SystemData{
+									CreatedBy:          to.Ptr("user@microsoft.com"),
+									CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@microsoft.com"),
+									LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+								},
+								Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
+									AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
+									StorageProperties: &armlargeinstance.StorageProperties{
+										ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
+										OfferingType:      to.Ptr("EPIC"),
+										StorageType:       to.Ptr("FC"),
+										Generation:        to.Ptr("Gen4"),
+										HardwareType:      to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
+										WorkloadType:      to.Ptr("ODB"),
+										StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
+											BillingMode: to.Ptr("PAYG"),
+											SKU:         to.Ptr(""),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -122,53 +188,102 @@ func ExampleAzureLargeStorageInstanceClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.AzureLargeStorageInstanceClientGetResponse{
-	// 	AzureLargeStorageInstance: armlargeinstance.AzureLargeStorageInstance{
-	// 		ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance"),
-	// 		Location: to.Ptr("westus2"),
-	// 		Name: to.Ptr("myAzureLargeStorageInstance"),
-	// 		Tags: map[string]*string{
-	// 			"key": to.Ptr("value"),
-	// 		},
-	// 		Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
-	// 		SystemData: &armlargeinstance.SystemData{
-	// 			CreatedBy: to.Ptr("user@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-	// 		},
-	// 		Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
-	// 			AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
-	// 			StorageProperties: &armlargeinstance.StorageProperties{
-	// 				ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
-	// 				OfferingType: to.Ptr("EPIC"),
-	// 				StorageType: to.Ptr("FC"),
-	// 				Generation: to.Ptr("Gen4"),
-	// 				HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
-	// 				WorkloadType: to.Ptr("ODB"),
-	// 				StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
-	// 					BillingMode: to.Ptr("PAYG"),
-	// 					SKU: to.Ptr(""),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance
+	// Location: westus2
+	// Name: myAzureLargeStorageInstance
+	// Type: Microsoft.AzureLargeInstance/AzureLargeStorageInstances
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeStorageInstance_ListByResourceGroup.json
 func ExampleAzureLargeStorageInstanceClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AzureLargeStorageInstanceServer: fake.AzureLargeStorageInstanceServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armlargeinstance.AzureLargeStorageInstanceClientListByResourceGroupOptions) (resp azfake.PagerResponder[armlargeinstance.AzureLargeStorageInstanceClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armlargeinstance.AzureLargeStorageInstanceClientListByResourceGroupResponse{
+							AzureLargeStorageInstanceListResult: armlargeinstance.AzureLargeStorageInstanceListResult{
+								Value: []*armlargeinstance.AzureLargeStorageInstance{
+									{
+										ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance1"),
+										Location: to.Ptr("westus2"),
+										Name:     to.Ptr("myAzureLargeStorageInstance1"),
+										Tags: map[string]*string{
+											"key": to.Ptr("value"),
+										},
+										Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
+										SystemData: &armlargeinstance.SystemData{
+											CreatedBy:          to.Ptr("user@microsoft.com"),
+											CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@microsoft.com"),
+											LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+										},
+										Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
+											AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
+											StorageProperties: &armlargeinstance.StorageProperties{
+												ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
+												OfferingType:      to.Ptr("EPIC"),
+												StorageType:       to.Ptr("FC"),
+												Generation:        to.Ptr("Gen4"),
+												HardwareType:      to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
+												WorkloadType:      to.Ptr("ODB"),
+												StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
+													BillingMode: to.Ptr("PAYG"),
+													SKU:         to.Ptr(""),
+												},
+											},
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance2"),
+										Location: to.Ptr("westus2"),
+										Name:     to.Ptr("myAzureLargeStorageInstance2"),
+										Tags: map[string]*string{
+											"key": to.Ptr("value"),
+										},
+										Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
+										SystemData: &armlargeinstance.SystemData{
+											CreatedBy:          to.Ptr("user@microsoft.com"),
+											CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@microsoft.com"),
+											LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t }()),
+										},
+										Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
+											AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24f7"),
+											StorageProperties: &armlargeinstance.StorageProperties{
+												ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
+												OfferingType:      to.Ptr("EPIC"),
+												StorageType:       to.Ptr("NFS"),
+												Generation:        to.Ptr("Gen4"),
+												HardwareType:      to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
+												WorkloadType:      to.Ptr("Cogito"),
+												StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
+													BillingMode: to.Ptr("RI"),
+													SKU:         to.Ptr(""),
+												},
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -179,91 +294,108 @@ func ExampleAzureLargeStorageInstanceClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armlargeinstance.AzureLargeStorageInstanceClientListByResourceGroupResponse{
-		// 	AzureLargeStorageInstanceListResult: armlargeinstance.AzureLargeStorageInstanceListResult{
-		// 		Value: []*armlargeinstance.AzureLargeStorageInstance{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance1"),
-		// 				Location: to.Ptr("westus2"),
-		// 				Name: to.Ptr("myAzureLargeStorageInstance1"),
-		// 				Tags: map[string]*string{
-		// 					"key": to.Ptr("value"),
-		// 				},
-		// 				Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
-		// 				SystemData: &armlargeinstance.SystemData{
-		// 					CreatedBy: to.Ptr("user@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-		// 				},
-		// 				Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
-		// 					AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
-		// 					StorageProperties: &armlargeinstance.StorageProperties{
-		// 						ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
-		// 						OfferingType: to.Ptr("EPIC"),
-		// 						StorageType: to.Ptr("FC"),
-		// 						Generation: to.Ptr("Gen4"),
-		// 						HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
-		// 						WorkloadType: to.Ptr("ODB"),
-		// 						StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
-		// 							BillingMode: to.Ptr("PAYG"),
-		// 							SKU: to.Ptr(""),
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance2"),
-		// 				Location: to.Ptr("westus2"),
-		// 				Name: to.Ptr("myAzureLargeStorageInstance2"),
-		// 				Tags: map[string]*string{
-		// 					"key": to.Ptr("value"),
-		// 				},
-		// 				Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
-		// 				SystemData: &armlargeinstance.SystemData{
-		// 					CreatedBy: to.Ptr("user@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t}()),
-		// 				},
-		// 				Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
-		// 					AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24f7"),
-		// 					StorageProperties: &armlargeinstance.StorageProperties{
-		// 						ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
-		// 						OfferingType: to.Ptr("EPIC"),
-		// 						StorageType: to.Ptr("NFS"),
-		// 						Generation: to.Ptr("Gen4"),
-		// 						HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
-		// 						WorkloadType: to.Ptr("Cogito"),
-		// 						StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
-		// 							BillingMode: to.Ptr("RI"),
-		// 							SKU: to.Ptr(""),
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance1
+	// Location: westus2
+	// Name: myAzureLargeStorageInstance1
+	// Type: Microsoft.AzureLargeInstance/AzureLargeStorageInstances
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance2
+	// Location: westus2
+	// Name: myAzureLargeStorageInstance2
+	// Type: Microsoft.AzureLargeInstance/AzureLargeStorageInstances
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeStorageInstance_ListBySubscription.json
 func ExampleAzureLargeStorageInstanceClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AzureLargeStorageInstanceServer: fake.AzureLargeStorageInstanceServer{
+					NewListBySubscriptionPager: func(options *armlargeinstance.AzureLargeStorageInstanceClientListBySubscriptionOptions) (resp azfake.PagerResponder[armlargeinstance.AzureLargeStorageInstanceClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armlargeinstance.AzureLargeStorageInstanceClientListBySubscriptionResponse{
+							AzureLargeStorageInstanceListResult: armlargeinstance.AzureLargeStorageInstanceListResult{
+								Value: []*armlargeinstance.AzureLargeStorageInstance{
+									{
+										ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeInstance1"),
+										Location: to.Ptr("westus2"),
+										Name:     to.Ptr("myAzureLargeInstance1"),
+										Tags: map[string]*string{
+											"key": to.Ptr("value"),
+										},
+										Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
+										SystemData: &armlargeinstance.SystemData{
+											CreatedBy:          to.Ptr("user@microsoft.com"),
+											CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@microsoft.com"),
+											LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+										},
+										Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
+											AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
+											StorageProperties: &armlargeinstance.StorageProperties{
+												ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
+												OfferingType:      to.Ptr("EPIC"),
+												StorageType:       to.Ptr("FC"),
+												Generation:        to.Ptr("Gen4"),
+												HardwareType:      to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
+												WorkloadType:      to.Ptr("ODB"),
+												StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
+													BillingMode: to.Ptr("PAYG"),
+													SKU:         to.Ptr(""),
+												},
+											},
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeInstance2"),
+										Location: to.Ptr("westus2"),
+										Name:     to.Ptr("myAzureLargeInstance2"),
+										Tags: map[string]*string{
+											"key": to.Ptr("value"),
+										},
+										Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
+										SystemData: &armlargeinstance.SystemData{
+											CreatedBy:          to.Ptr("user@microsoft.com"),
+											CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@microsoft.com"),
+											LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t }()),
+										},
+										Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
+											AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24f7"),
+											StorageProperties: &armlargeinstance.StorageProperties{
+												ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
+												OfferingType:      to.Ptr("EPIC"),
+												StorageType:       to.Ptr("NFS"),
+												Generation:        to.Ptr("Gen4"),
+												HardwareType:      to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
+												WorkloadType:      to.Ptr("Cogito"),
+												StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
+													BillingMode: to.Ptr("RI"),
+													SKU:         to.Ptr(""),
+												},
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -274,91 +406,72 @@ func ExampleAzureLargeStorageInstanceClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armlargeinstance.AzureLargeStorageInstanceClientListBySubscriptionResponse{
-		// 	AzureLargeStorageInstanceListResult: armlargeinstance.AzureLargeStorageInstanceListResult{
-		// 		Value: []*armlargeinstance.AzureLargeStorageInstance{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeInstance1"),
-		// 				Location: to.Ptr("westus2"),
-		// 				Name: to.Ptr("myAzureLargeInstance1"),
-		// 				Tags: map[string]*string{
-		// 					"key": to.Ptr("value"),
-		// 				},
-		// 				Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
-		// 				SystemData: &armlargeinstance.SystemData{
-		// 					CreatedBy: to.Ptr("user@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-		// 				},
-		// 				Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
-		// 					AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
-		// 					StorageProperties: &armlargeinstance.StorageProperties{
-		// 						ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
-		// 						OfferingType: to.Ptr("EPIC"),
-		// 						StorageType: to.Ptr("FC"),
-		// 						Generation: to.Ptr("Gen4"),
-		// 						HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
-		// 						WorkloadType: to.Ptr("ODB"),
-		// 						StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
-		// 							BillingMode: to.Ptr("PAYG"),
-		// 							SKU: to.Ptr(""),
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeInstance2"),
-		// 				Location: to.Ptr("westus2"),
-		// 				Name: to.Ptr("myAzureLargeInstance2"),
-		// 				Tags: map[string]*string{
-		// 					"key": to.Ptr("value"),
-		// 				},
-		// 				Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
-		// 				SystemData: &armlargeinstance.SystemData{
-		// 					CreatedBy: to.Ptr("user@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t}()),
-		// 				},
-		// 				Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
-		// 					AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24f7"),
-		// 					StorageProperties: &armlargeinstance.StorageProperties{
-		// 						ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
-		// 						OfferingType: to.Ptr("EPIC"),
-		// 						StorageType: to.Ptr("NFS"),
-		// 						Generation: to.Ptr("Gen4"),
-		// 						HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
-		// 						WorkloadType: to.Ptr("Cogito"),
-		// 						StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
-		// 							BillingMode: to.Ptr("RI"),
-		// 							SKU: to.Ptr(""),
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeInstance1
+	// Location: westus2
+	// Name: myAzureLargeInstance1
+	// Type: Microsoft.AzureLargeInstance/AzureLargeStorageInstances
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeInstance2
+	// Location: westus2
+	// Name: myAzureLargeInstance2
+	// Type: Microsoft.AzureLargeInstance/AzureLargeStorageInstances
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeStorageInstance_PatchTags.json
 func ExampleAzureLargeStorageInstanceClient_Update_azureLargeStorageInstanceUpdateTag() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AzureLargeStorageInstanceServer: fake.AzureLargeStorageInstanceServer{
+					Update: func(ctx context.Context, resourceGroupName string, azureLargeStorageInstanceName string, properties armlargeinstance.AzureLargeStorageInstanceTagsUpdate, options *armlargeinstance.AzureLargeStorageInstanceClientUpdateOptions) (resp azfake.Responder[armlargeinstance.AzureLargeStorageInstanceClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.AzureLargeStorageInstanceClientUpdateResponse{
+							AzureLargeStorageInstance: armlargeinstance.AzureLargeStorageInstance{
+								ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance"),
+								Location: to.Ptr("westus2"),
+								Name:     to.Ptr("myAzureLargeStorageInstance"),
+								Tags: map[string]*string{
+									"testkey": to.Ptr("testvalue"),
+								},
+								Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
+								SystemData: &armlargeinstance.SystemData{
+									CreatedBy:          to.Ptr("user@microsoft.com"),
+									CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@microsoft.com"),
+									LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+								},
+								Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
+									AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
+									StorageProperties: &armlargeinstance.StorageProperties{
+										ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
+										OfferingType:      to.Ptr("EPIC"),
+										StorageType:       to.Ptr("FC"),
+										Generation:        to.Ptr("Gen4"),
+										HardwareType:      to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
+										WorkloadType:      to.Ptr("ODB"),
+										StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
+											BillingMode: to.Ptr("PAYG"),
+											SKU:         to.Ptr(""),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -370,53 +483,64 @@ func ExampleAzureLargeStorageInstanceClient_Update_azureLargeStorageInstanceUpda
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.AzureLargeStorageInstanceClientUpdateResponse{
-	// 	AzureLargeStorageInstance: armlargeinstance.AzureLargeStorageInstance{
-	// 		ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance"),
-	// 		Location: to.Ptr("westus2"),
-	// 		Name: to.Ptr("myAzureLargeStorageInstance"),
-	// 		Tags: map[string]*string{
-	// 			"testkey": to.Ptr("testvalue"),
-	// 		},
-	// 		Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
-	// 		SystemData: &armlargeinstance.SystemData{
-	// 			CreatedBy: to.Ptr("user@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-	// 		},
-	// 		Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
-	// 			AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
-	// 			StorageProperties: &armlargeinstance.StorageProperties{
-	// 				ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
-	// 				OfferingType: to.Ptr("EPIC"),
-	// 				StorageType: to.Ptr("FC"),
-	// 				Generation: to.Ptr("Gen4"),
-	// 				HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
-	// 				WorkloadType: to.Ptr("ODB"),
-	// 				StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
-	// 					BillingMode: to.Ptr("PAYG"),
-	// 					SKU: to.Ptr(""),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance
+	// Location: westus2
+	// Name: myAzureLargeStorageInstance
+	// Type: Microsoft.AzureLargeInstance/AzureLargeStorageInstances
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeStorageInstance_PatchTags_Delete.json
 func ExampleAzureLargeStorageInstanceClient_Update_azureLargeStorageInstanceDeleteTag() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				AzureLargeStorageInstanceServer: fake.AzureLargeStorageInstanceServer{
+					Update: func(ctx context.Context, resourceGroupName string, azureLargeStorageInstanceName string, properties armlargeinstance.AzureLargeStorageInstanceTagsUpdate, options *armlargeinstance.AzureLargeStorageInstanceClientUpdateOptions) (resp azfake.Responder[armlargeinstance.AzureLargeStorageInstanceClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.AzureLargeStorageInstanceClientUpdateResponse{
+							AzureLargeStorageInstance: armlargeinstance.AzureLargeStorageInstance{
+								ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance"),
+								Location: to.Ptr("westus2"),
+								Name:     to.Ptr("myAzureLargeStorageInstance"),
+								Tags:     map[string]*string{},
+								Type:     to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
+								SystemData: &armlargeinstance.SystemData{
+									CreatedBy:          to.Ptr("user@microsoft.com"),
+									CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@microsoft.com"),
+									LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+								},
+								Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
+									AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
+									StorageProperties: &armlargeinstance.StorageProperties{
+										ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
+										OfferingType:      to.Ptr("EPIC"),
+										StorageType:       to.Ptr("FC"),
+										Generation:        to.Ptr("Gen4"),
+										HardwareType:      to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
+										WorkloadType:      to.Ptr("ODB"),
+										StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
+											BillingMode: to.Ptr("PAYG"),
+											SKU:         to.Ptr(""),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -426,40 +550,13 @@ func ExampleAzureLargeStorageInstanceClient_Update_azureLargeStorageInstanceDele
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.AzureLargeStorageInstanceClientUpdateResponse{
-	// 	AzureLargeStorageInstance: armlargeinstance.AzureLargeStorageInstance{
-	// 		ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance"),
-	// 		Location: to.Ptr("westus2"),
-	// 		Name: to.Ptr("myAzureLargeStorageInstance"),
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeStorageInstances"),
-	// 		SystemData: &armlargeinstance.SystemData{
-	// 			CreatedBy: to.Ptr("user@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-	// 		},
-	// 		Properties: &armlargeinstance.AzureLargeStorageInstanceProperties{
-	// 			AzureLargeStorageInstanceUniqueIdentifier: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e9"),
-	// 			StorageProperties: &armlargeinstance.StorageProperties{
-	// 				ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStateSucceeded),
-	// 				OfferingType: to.Ptr("EPIC"),
-	// 				StorageType: to.Ptr("FC"),
-	// 				Generation: to.Ptr("Gen4"),
-	// 				HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnum("NetApp")),
-	// 				WorkloadType: to.Ptr("ODB"),
-	// 				StorageBillingProperties: &armlargeinstance.StorageBillingProperties{
-	// 					BillingMode: to.Ptr("PAYG"),
-	// 					SKU: to.Ptr(""),
-	// 				},
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeStorageInstances/myAzureLargeStorageInstance
+	// Location: westus2
+	// Name: myAzureLargeStorageInstance
+	// Type: Microsoft.AzureLargeInstance/AzureLargeStorageInstances
 }
@@ -6,20 +6,80 @@ package armlargeinstance_test
 
 import (
 	"armlargeinstance"
+	"armlargeinstance/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_Create.json
 func ExampleClient_Create() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					Create: func(ctx context.Context, resourceGroupName string, azureLargeInstanceName string, resource armlargeinstance.AzureLargeInstance, options *armlargeinstance.ClientCreateOptions) (resp azfake.Responder[armlargeinstance.ClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.ClientCreateResponse{
+							AzureLargeInstance: armlargeinstance.AzureLargeInstance{
+								ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myALInstance"),
+								Name:     to.Ptr("myALInstance"),
+								Type:     to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
+								Location: to.Ptr("westus"),
+								Tags: map[string]*string{
+									"testkey": to.Ptr("testvalue"),
+								},
+								SystemData: &armlargeinstance.SystemData{
+									CreatedBy:          to.Ptr("user@microsoft.com"),
+									CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@microsoft.com"),
+									LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+								},
+								Properties: &armlargeinstance.Properties{
+									AzureLargeInstanceID:    to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
+									PowerState:              to.Ptr(armlargeinstance.PowerStateEnumStarted),
+									ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
+									HwRevision:              to.Ptr("Rev 3"),
+									HardwareProfile: &armlargeinstance.HardwareProfile{
+										HardwareType:           to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
+										AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
+									},
+									NetworkProfile: &armlargeinstance.NetworkProfile{
+										NetworkInterfaces: []*armlargeinstance.IPAddress{
+											{
+												IPAddress: to.Ptr("100.100.100.100"),
+											},
+										},
+										CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
+									},
+									StorageProfile: &armlargeinstance.StorageProfile{
+										NfsIPAddress: to.Ptr("200.200.200.200"),
+									},
+									OSProfile: &armlargeinstance.OsProfile{
+										ComputerName: to.Ptr("myComputerName"),
+										OSType:       to.Ptr("SUSE"),
+										Version:      to.Ptr("12 SP1"),
+										SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
+									},
+									ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -59,66 +119,33 @@ func ExampleClient_Create() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.ClientCreateResponse{
-	// 	AzureLargeInstance: armlargeinstance.AzureLargeInstance{
-	// 		ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myALInstance"),
-	// 		Name: to.Ptr("myALInstance"),
-	// 		Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
-	// 		Location: to.Ptr("westus"),
-	// 		Tags: map[string]*string{
-	// 			"testkey": to.Ptr("testvalue"),
-	// 		},
-	// 		SystemData: &armlargeinstance.SystemData{
-	// 			CreatedBy: to.Ptr("user@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-	// 		},
-	// 		Properties: &armlargeinstance.Properties{
-	// 			AzureLargeInstanceID: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
-	// 			PowerState: to.Ptr(armlargeinstance.PowerStateEnumStarted),
-	// 			ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
-	// 			HwRevision: to.Ptr("Rev 3"),
-	// 			HardwareProfile: &armlargeinstance.HardwareProfile{
-	// 				HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
-	// 				AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
-	// 			},
-	// 			NetworkProfile: &armlargeinstance.NetworkProfile{
-	// 				NetworkInterfaces: []*armlargeinstance.IPAddress{
-	// 					{
-	// 						IPAddress: to.Ptr("100.100.100.100"),
-	// 					},
-	// 				},
-	// 				CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
-	// 			},
-	// 			StorageProfile: &armlargeinstance.StorageProfile{
-	// 				NfsIPAddress: to.Ptr("200.200.200.200"),
-	// 			},
-	// 			OSProfile: &armlargeinstance.OsProfile{
-	// 				ComputerName: to.Ptr("myComputerName"),
-	// 				OSType: to.Ptr("SUSE"),
-	// 				Version: to.Ptr("12 SP1"),
-	// 				SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myALInstance
+	// Name: myALInstance
+	// Type: Microsoft.AzureLargeInstance/AzureLargeInstances
+	// Location: westus
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_Delete.json
 func ExampleClient_Delete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					Delete: func(ctx context.Context, resourceGroupName string, azureLargeInstanceName string, options *armlargeinstance.ClientDeleteOptions) (resp azfake.Responder[armlargeinstance.ClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.ClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -128,19 +155,69 @@ func ExampleClient_Delete() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.ClientDeleteResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_Get.json
 func ExampleClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					Get: func(ctx context.Context, resourceGroupName string, azureLargeInstanceName string, options *armlargeinstance.ClientGetOptions) (resp azfake.Responder[armlargeinstance.ClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.ClientGetResponse{
+							AzureLargeInstance: armlargeinstance.AzureLargeInstance{
+								ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeInstance"),
+								Location: to.Ptr("westus2"),
+								Name:     to.Ptr("myAzureLargeInstance"),
+								Tags: map[string]*string{
+									"key": to.Ptr("value"),
+								},
+								Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
+								SystemData: &armlargeinstance.SystemData{
+									CreatedBy:          to.Ptr("user@microsoft.com"),
+									CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@microsoft.com"),
+									LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+								},
+								Properties: &armlargeinstance.Properties{
+									AzureLargeInstanceID: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
+									PowerState:           to.Ptr(armlargeinstance.PowerStateEnumRestarting),
+									HwRevision:           to.Ptr("Rev 4.2"),
+									HardwareProfile: &armlargeinstance.HardwareProfile{
+										HardwareType:           to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
+										AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
+									},
+									NetworkProfile: &armlargeinstance.NetworkProfile{
+										NetworkInterfaces: []*armlargeinstance.IPAddress{
+											{
+												IPAddress: to.Ptr("123.123.123.123"),
+											},
+										},
+										CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuits/myCircuitId"),
+									},
+									StorageProfile: &armlargeinstance.StorageProfile{
+										NfsIPAddress: to.Ptr("123.123.119.123"),
+									},
+									OSProfile: &armlargeinstance.OsProfile{
+										ComputerName: to.Ptr("myComputerName"),
+										OSType:       to.Ptr("SLES 12 SP2"),
+										Version:      to.Ptr("12 SP2"),
+									},
+									ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -148,64 +225,128 @@ func ExampleClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.ClientGetResponse{
-	// 	AzureLargeInstance: armlargeinstance.AzureLargeInstance{
-	// 		ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeInstance"),
-	// 		Location: to.Ptr("westus2"),
-	// 		Name: to.Ptr("myAzureLargeInstance"),
-	// 		Tags: map[string]*string{
-	// 			"key": to.Ptr("value"),
-	// 		},
-	// 		Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
-	// 		SystemData: &armlargeinstance.SystemData{
-	// 			CreatedBy: to.Ptr("user@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-	// 		},
-	// 		Properties: &armlargeinstance.Properties{
-	// 			AzureLargeInstanceID: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
-	// 			PowerState: to.Ptr(armlargeinstance.PowerStateEnumRestarting),
-	// 			HwRevision: to.Ptr("Rev 4.2"),
-	// 			HardwareProfile: &armlargeinstance.HardwareProfile{
-	// 				HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
-	// 				AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
-	// 			},
-	// 			NetworkProfile: &armlargeinstance.NetworkProfile{
-	// 				NetworkInterfaces: []*armlargeinstance.IPAddress{
-	// 					{
-	// 						IPAddress: to.Ptr("123.123.123.123"),
-	// 					},
-	// 				},
-	// 				CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuits/myCircuitId"),
-	// 			},
-	// 			StorageProfile: &armlargeinstance.StorageProfile{
-	// 				NfsIPAddress: to.Ptr("123.123.119.123"),
-	// 			},
-	// 			OSProfile: &armlargeinstance.OsProfile{
-	// 				ComputerName: to.Ptr("myComputerName"),
-	// 				OSType: to.Ptr("SLES 12 SP2"),
-	// 				Version: to.Ptr("12 SP2"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeInstance
+	// Location: westus2
+	// Name: myAzureLargeInstance
+	// Type: Microsoft.AzureLargeInstance/AzureLargeInstances
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_ListByResourceGroup.json
 func ExampleClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armlargeinstance.ClientListByResourceGroupOptions) (resp azfake.PagerResponder[armlargeinstance.ClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armlargeinstance.ClientListByResourceGroupResponse{
+							ListResult: armlargeinstance.ListResult{
+								Value: []*armlargeinstance.AzureLargeInstance{
+									{
+										ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeMetalInstance1"),
+										Name:     to.Ptr("myAzureLargeMetalInstance1"),
+										Type:     to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
+										Location: to.Ptr("westus"),
+										Tags: map[string]*string{
+											"key": to.Ptr("value"),
+										},
+										SystemData: &armlargeinstance.SystemData{
+											CreatedBy:          to.Ptr("user@microsoft.com"),
+											CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@microsoft.com"),
+											LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+										},
+										Properties: &armlargeinstance.Properties{
+											AzureLargeInstanceID:    to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
+											PowerState:              to.Ptr(armlargeinstance.PowerStateEnumStarted),
+											ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
+											HwRevision:              to.Ptr("Rev 3"),
+											HardwareProfile: &armlargeinstance.HardwareProfile{
+												HardwareType:           to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
+												AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
+											},
+											NetworkProfile: &armlargeinstance.NetworkProfile{
+												NetworkInterfaces: []*armlargeinstance.IPAddress{
+													{
+														IPAddress: to.Ptr("100.100.100.100"),
+													},
+												},
+												CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
+											},
+											StorageProfile: &armlargeinstance.StorageProfile{
+												NfsIPAddress: to.Ptr("200.200.200.200"),
+											},
+											OSProfile: &armlargeinstance.OsProfile{
+												ComputerName: to.Ptr("myComputerName1"),
+												OSType:       to.Ptr("SUSE"),
+												Version:      to.Ptr("12 SP1"),
+												SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
+											},
+											ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myABMInstance2"),
+										Name:     to.Ptr("myABMInstance2"),
+										Type:     to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
+										Location: to.Ptr("westus"),
+										Tags: map[string]*string{
+											"key": to.Ptr("value"),
+										},
+										SystemData: &armlargeinstance.SystemData{
+											CreatedBy:          to.Ptr("user@microsoft.com"),
+											CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@microsoft.com"),
+											LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t }()),
+										},
+										Properties: &armlargeinstance.Properties{
+											AzureLargeInstanceID:    to.Ptr("589bce49-9fe6-4dc8-82df-cf6ae25e0cb9"),
+											PowerState:              to.Ptr(armlargeinstance.PowerStateEnumStarted),
+											ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
+											HwRevision:              to.Ptr("Rev 3"),
+											HardwareProfile: &armlargeinstance.HardwareProfile{
+												HardwareType:           to.Ptr(armlargeinstance.HardwareTypeNamesEnumHPE),
+												AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS384),
+											},
+											NetworkProfile: &armlargeinstance.NetworkProfile{
+												NetworkInterfaces: []*armlargeinstance.IPAddress{
+													{
+														IPAddress: to.Ptr("100.100.100.101"),
+													},
+												},
+												CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
+											},
+											StorageProfile: &armlargeinstance.StorageProfile{
+												NfsIPAddress: to.Ptr("200.200.200.201"),
+											},
+											OSProfile: &armlargeinstance.OsProfile{
+												ComputerName: to.Ptr("myComputerName2"),
+												OSType:       to.Ptr("SUSE"),
+												Version:      to.Ptr("12 SP1"),
+												SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
+											},
+											ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -216,117 +357,130 @@ func ExampleClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armlargeinstance.ClientListByResourceGroupResponse{
-		// 	ListResult: armlargeinstance.ListResult{
-		// 		Value: []*armlargeinstance.AzureLargeInstance{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeMetalInstance1"),
-		// 				Name: to.Ptr("myAzureLargeMetalInstance1"),
-		// 				Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
-		// 				Location: to.Ptr("westus"),
-		// 				Tags: map[string]*string{
-		// 					"key": to.Ptr("value"),
-		// 				},
-		// 				SystemData: &armlargeinstance.SystemData{
-		// 					CreatedBy: to.Ptr("user@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-		// 				},
-		// 				Properties: &armlargeinstance.Properties{
-		// 					AzureLargeInstanceID: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
-		// 					PowerState: to.Ptr(armlargeinstance.PowerStateEnumStarted),
-		// 					ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
-		// 					HwRevision: to.Ptr("Rev 3"),
-		// 					HardwareProfile: &armlargeinstance.HardwareProfile{
-		// 						HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
-		// 						AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
-		// 					},
-		// 					NetworkProfile: &armlargeinstance.NetworkProfile{
-		// 						NetworkInterfaces: []*armlargeinstance.IPAddress{
-		// 							{
-		// 								IPAddress: to.Ptr("100.100.100.100"),
-		// 							},
-		// 						},
-		// 						CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
-		// 					},
-		// 					StorageProfile: &armlargeinstance.StorageProfile{
-		// 						NfsIPAddress: to.Ptr("200.200.200.200"),
-		// 					},
-		// 					OSProfile: &armlargeinstance.OsProfile{
-		// 						ComputerName: to.Ptr("myComputerName1"),
-		// 						OSType: to.Ptr("SUSE"),
-		// 						Version: to.Ptr("12 SP1"),
-		// 						SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
-		// 					},
-		// 					ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myABMInstance2"),
-		// 				Name: to.Ptr("myABMInstance2"),
-		// 				Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
-		// 				Location: to.Ptr("westus"),
-		// 				Tags: map[string]*string{
-		// 					"key": to.Ptr("value"),
-		// 				},
-		// 				SystemData: &armlargeinstance.SystemData{
-		// 					CreatedBy: to.Ptr("user@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t}()),
-		// 				},
-		// 				Properties: &armlargeinstance.Properties{
-		// 					AzureLargeInstanceID: to.Ptr("589bce49-9fe6-4dc8-82df-cf6ae25e0cb9"),
-		// 					PowerState: to.Ptr(armlargeinstance.PowerStateEnumStarted),
-		// 					ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
-		// 					HwRevision: to.Ptr("Rev 3"),
-		// 					HardwareProfile: &armlargeinstance.HardwareProfile{
-		// 						HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnumHPE),
-		// 						AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS384),
-		// 					},
-		// 					NetworkProfile: &armlargeinstance.NetworkProfile{
-		// 						NetworkInterfaces: []*armlargeinstance.IPAddress{
-		// 							{
-		// 								IPAddress: to.Ptr("100.100.100.101"),
-		// 							},
-		// 						},
-		// 						CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
-		// 					},
-		// 					StorageProfile: &armlargeinstance.StorageProfile{
-		// 						NfsIPAddress: to.Ptr("200.200.200.201"),
-		// 					},
-		// 					OSProfile: &armlargeinstance.OsProfile{
-		// 						ComputerName: to.Ptr("myComputerName2"),
-		// 						OSType: to.Ptr("SUSE"),
-		// 						Version: to.Ptr("12 SP1"),
-		// 						SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
-		// 					},
-		// 					ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeMetalInstance1
+	// Name: myAzureLargeMetalInstance1
+	// Type: Microsoft.AzureLargeInstance/AzureLargeInstances
+	// Location: westus
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myABMInstance2
+	// Name: myABMInstance2
+	// Type: Microsoft.AzureLargeInstance/AzureLargeInstances
+	// Location: westus
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_ListBySubscription.json
 func ExampleClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					NewListBySubscriptionPager: func(options *armlargeinstance.ClientListBySubscriptionOptions) (resp azfake.PagerResponder[armlargeinstance.ClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armlargeinstance.ClientListBySubscriptionResponse{
+							ListResult: armlargeinstance.ListResult{
+								Value: []*armlargeinstance.AzureLargeInstance{
+									{
+										ID:       to.Ptr("/subscriptions/57d3422f-467a-448e-b798-ebf490849542/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeInstance1"),
+										Location: to.Ptr("westus2"),
+										Name:     to.Ptr("myAzureLargeInstance1"),
+										Tags: map[string]*string{
+											"key": to.Ptr("value"),
+										},
+										SystemData: &armlargeinstance.SystemData{
+											CreatedBy:          to.Ptr("user@microsoft.com"),
+											CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@microsoft.com"),
+											LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t }()),
+										},
+										Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
+										Properties: &armlargeinstance.Properties{
+											AzureLargeInstanceID: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
+											PowerState:           to.Ptr(armlargeinstance.PowerStateEnumRestarting),
+											HwRevision:           to.Ptr("Rev 4.2"),
+											HardwareProfile: &armlargeinstance.HardwareProfile{
+												HardwareType:           to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
+												AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
+											},
+											NetworkProfile: &armlargeinstance.NetworkProfile{
+												NetworkInterfaces: []*armlargeinstance.IPAddress{
+													{
+														IPAddress: to.Ptr("123.123.123.123"),
+													},
+												},
+												CircuitID: to.Ptr("/subscriptions/57d3422f-467a-448e-b798-ebf490849542/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuits/myCircuitId"),
+											},
+											StorageProfile: &armlargeinstance.StorageProfile{
+												NfsIPAddress: to.Ptr("123.123.119.123"),
+											},
+											OSProfile: &armlargeinstance.OsProfile{
+												ComputerName: to.Ptr("myComputerName"),
+												OSType:       to.Ptr("SLES 12 SP2"),
+												Version:      to.Ptr("12 SP2"),
+											},
+											ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
+										},
+									},
+									{
+										ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeInstance2"),
+										Location: to.Ptr("westus2"),
+										Name:     to.Ptr("myAzureLargeInstance2"),
+										Tags: map[string]*string{
+											"key": to.Ptr("value"),
+										},
+										SystemData: &armlargeinstance.SystemData{
+											CreatedBy:          to.Ptr("user@microsoft.com"),
+											CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user@microsoft.com"),
+											LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t }()),
+										},
+										Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
+										Properties: &armlargeinstance.Properties{
+											AzureLargeInstanceID: to.Ptr("589bce49-9fe6-4dc8-82df-cf6ae25e0cb9"),
+											PowerState:           to.Ptr(armlargeinstance.PowerStateEnumRestarting),
+											HwRevision:           to.Ptr("Rev 4.2"),
+											HardwareProfile: &armlargeinstance.HardwareProfile{
+												HardwareType:           to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
+												AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
+											},
+											NetworkProfile: &armlargeinstance.NetworkProfile{
+												NetworkInterfaces: []*armlargeinstance.IPAddress{
+													{
+														IPAddress: to.Ptr("123.123.123.123"),
+													},
+												},
+												CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuits/myCircuitId"),
+											},
+											StorageProfile: &armlargeinstance.StorageProfile{
+												NfsIPAddress: to.Ptr("123.123.119.123"),
+											},
+											OSProfile: &armlargeinstance.OsProfile{
+												ComputerName: to.Ptr("myComputerName2"),
+												OSType:       to.Ptr("SLES 12 SP2"),
+												Version:      to.Ptr("12 SP2"),
+											},
+											ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -337,113 +491,39 @@ func ExampleClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Location:", *v.Location)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armlargeinstance.ClientListBySubscriptionResponse{
-		// 	ListResult: armlargeinstance.ListResult{
-		// 		Value: []*armlargeinstance.AzureLargeInstance{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/57d3422f-467a-448e-b798-ebf490849542/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeInstance1"),
-		// 				Location: to.Ptr("westus2"),
-		// 				Name: to.Ptr("myAzureLargeInstance1"),
-		// 				Tags: map[string]*string{
-		// 					"key": to.Ptr("value"),
-		// 				},
-		// 				SystemData: &armlargeinstance.SystemData{
-		// 					CreatedBy: to.Ptr("user@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t}()),
-		// 				},
-		// 				Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
-		// 				Properties: &armlargeinstance.Properties{
-		// 					AzureLargeInstanceID: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
-		// 					PowerState: to.Ptr(armlargeinstance.PowerStateEnumRestarting),
-		// 					HwRevision: to.Ptr("Rev 4.2"),
-		// 					HardwareProfile: &armlargeinstance.HardwareProfile{
-		// 						HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
-		// 						AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
-		// 					},
-		// 					NetworkProfile: &armlargeinstance.NetworkProfile{
-		// 						NetworkInterfaces: []*armlargeinstance.IPAddress{
-		// 							{
-		// 								IPAddress: to.Ptr("123.123.123.123"),
-		// 							},
-		// 						},
-		// 						CircuitID: to.Ptr("/subscriptions/57d3422f-467a-448e-b798-ebf490849542/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuits/myCircuitId"),
-		// 					},
-		// 					StorageProfile: &armlargeinstance.StorageProfile{
-		// 						NfsIPAddress: to.Ptr("123.123.119.123"),
-		// 					},
-		// 					OSProfile: &armlargeinstance.OsProfile{
-		// 						ComputerName: to.Ptr("myComputerName"),
-		// 						OSType: to.Ptr("SLES 12 SP2"),
-		// 						Version: to.Ptr("12 SP2"),
-		// 					},
-		// 					ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeInstance2"),
-		// 				Location: to.Ptr("westus2"),
-		// 				Name: to.Ptr("myAzureLargeInstance2"),
-		// 				Tags: map[string]*string{
-		// 					"key": to.Ptr("value"),
-		// 				},
-		// 				SystemData: &armlargeinstance.SystemData{
-		// 					CreatedBy: to.Ptr("user@microsoft.com"),
-		// 					CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user@microsoft.com"),
-		// 					LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-08-13T08:01:22.1234567Z"); return t}()),
-		// 				},
-		// 				Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
-		// 				Properties: &armlargeinstance.Properties{
-		// 					AzureLargeInstanceID: to.Ptr("589bce49-9fe6-4dc8-82df-cf6ae25e0cb9"),
-		// 					PowerState: to.Ptr(armlargeinstance.PowerStateEnumRestarting),
-		// 					HwRevision: to.Ptr("Rev 4.2"),
-		// 					HardwareProfile: &armlargeinstance.HardwareProfile{
-		// 						HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
-		// 						AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
-		// 					},
-		// 					NetworkProfile: &armlargeinstance.NetworkProfile{
-		// 						NetworkInterfaces: []*armlargeinstance.IPAddress{
-		// 							{
-		// 								IPAddress: to.Ptr("123.123.123.123"),
-		// 							},
-		// 						},
-		// 						CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuits/myCircuitId"),
-		// 					},
-		// 					StorageProfile: &armlargeinstance.StorageProfile{
-		// 						NfsIPAddress: to.Ptr("123.123.119.123"),
-		// 					},
-		// 					OSProfile: &armlargeinstance.OsProfile{
-		// 						ComputerName: to.Ptr("myComputerName2"),
-		// 						OSType: to.Ptr("SLES 12 SP2"),
-		// 						Version: to.Ptr("12 SP2"),
-		// 					},
-		// 					ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/57d3422f-467a-448e-b798-ebf490849542/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeInstance1
+	// Location: westus2
+	// Name: myAzureLargeInstance1
+	// Type: Microsoft.AzureLargeInstance/AzureLargeInstances
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myAzureLargeInstance2
+	// Location: westus2
+	// Name: myAzureLargeInstance2
+	// Type: Microsoft.AzureLargeInstance/AzureLargeInstances
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_Restart.json
 func ExampleClient_BeginRestart() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					BeginRestart: func(ctx context.Context, resourceGroupName string, azureLargeInstanceName string, options *armlargeinstance.ClientBeginRestartOptions) (resp azfake.PollerResponder[armlargeinstance.ClientRestartResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armlargeinstance.ClientRestartResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -457,19 +537,35 @@ func ExampleClient_BeginRestart() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.ClientRestartResponse{
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_Shutdown.json
 func ExampleClient_BeginShutdown() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					BeginShutdown: func(ctx context.Context, resourceGroupName string, azureLargeInstanceName string, options *armlargeinstance.ClientBeginShutdownOptions) (resp azfake.PollerResponder[armlargeinstance.ClientShutdownResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armlargeinstance.ClientShutdownResponse{
+							OperationStatusResult: armlargeinstance.OperationStatusResult{
+								Name:      to.Ptr("00000000-0000-0000-0000-000000000001"),
+								Status:    to.Ptr("InProgress"),
+								StartTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-08-04T21:17:24.9052926Z"); return t }()),
+								Error: &armlargeinstance.ErrorDetail{
+									Code:    to.Ptr(""),
+									Message: to.Ptr(""),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -481,30 +577,39 @@ func ExampleClient_BeginShutdown() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.ClientShutdownResponse{
-	// 	OperationStatusResult: armlargeinstance.OperationStatusResult{
-	// 		Name: to.Ptr("00000000-0000-0000-0000-000000000001"),
-	// 		Status: to.Ptr("InProgress"),
-	// 		StartTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-08-04T21:17:24.9052926Z"); return t}()),
-	// 		Error: &armlargeinstance.ErrorDetail{
-	// 			Code: to.Ptr(""),
-	// 			Message: to.Ptr(""),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Status:", *res.Status)
+	// Output:
+	// Name: 00000000-0000-0000-0000-000000000001
+	// Status: InProgress
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_Start.json
 func ExampleClient_BeginStart() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					BeginStart: func(ctx context.Context, resourceGroupName string, azureLargeInstanceName string, options *armlargeinstance.ClientBeginStartOptions) (resp azfake.PollerResponder[armlargeinstance.ClientStartResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armlargeinstance.ClientStartResponse{
+							OperationStatusResult: armlargeinstance.OperationStatusResult{
+								Name:      to.Ptr("00000000-0000-0000-0000-000000000001"),
+								Status:    to.Ptr("InProgress"),
+								StartTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-08-04T21:17:24.9052926Z"); return t }()),
+								Error: &armlargeinstance.ErrorDetail{
+									Code:    to.Ptr(""),
+									Message: to.Ptr(""),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -516,30 +621,75 @@ func ExampleClient_BeginStart() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.ClientStartResponse{
-	// 	OperationStatusResult: armlargeinstance.OperationStatusResult{
-	// 		Name: to.Ptr("00000000-0000-0000-0000-000000000001"),
-	// 		Status: to.Ptr("InProgress"),
-	// 		StartTime: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-08-04T21:17:24.9052926Z"); return t}()),
-	// 		Error: &armlargeinstance.ErrorDetail{
-	// 			Code: to.Ptr(""),
-	// 			Message: to.Ptr(""),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Status:", *res.Status)
+	// Output:
+	// Name: 00000000-0000-0000-0000-000000000001
+	// Status: InProgress
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_PatchTags.json
 func ExampleClient_Update_azureLargeInstanceUpdateTag() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					Update: func(ctx context.Context, resourceGroupName string, azureLargeInstanceName string, properties armlargeinstance.TagsUpdate, options *armlargeinstance.ClientUpdateOptions) (resp azfake.Responder[armlargeinstance.ClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.ClientUpdateResponse{
+							AzureLargeInstance: armlargeinstance.AzureLargeInstance{
+								ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myALInstance"),
+								Name:     to.Ptr("myALInstance"),
+								Type:     to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
+								Location: to.Ptr("westus"),
+								Tags: map[string]*string{
+									"testkey": to.Ptr("testvalue"),
+								},
+								SystemData: &armlargeinstance.SystemData{
+									CreatedBy:          to.Ptr("user@microsoft.com"),
+									CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@microsoft.com"),
+									LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+								},
+								Properties: &armlargeinstance.Properties{
+									AzureLargeInstanceID:    to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
+									PowerState:              to.Ptr(armlargeinstance.PowerStateEnumStarted),
+									ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
+									HwRevision:              to.Ptr("Rev 3"),
+									HardwareProfile: &armlargeinstance.HardwareProfile{
+										HardwareType:           to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
+										AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
+									},
+									NetworkProfile: &armlargeinstance.NetworkProfile{
+										NetworkInterfaces: []*armlargeinstance.IPAddress{
+											{
+												IPAddress: to.Ptr("100.100.100.100"),
+											},
+										},
+										CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
+									},
+									StorageProfile: &armlargeinstance.StorageProfile{
+										NfsIPAddress: to.Ptr("200.200.200.200"),
+									},
+									OSProfile: &armlargeinstance.OsProfile{
+										ComputerName: to.Ptr("myComputerName"),
+										OSType:       to.Ptr("SUSE"),
+										Version:      to.Ptr("12 SP1"),
+										SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
+									},
+									ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -551,66 +701,77 @@ func ExampleClient_Update_azureLargeInstanceUpdateTag() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.ClientUpdateResponse{
-	// 	AzureLargeInstance: armlargeinstance.AzureLargeInstance{
-	// 		ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myALInstance"),
-	// 		Name: to.Ptr("myALInstance"),
-	// 		Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
-	// 		Location: to.Ptr("westus"),
-	// 		Tags: map[string]*string{
-	// 			"testkey": to.Ptr("testvalue"),
-	// 		},
-	// 		SystemData: &armlargeinstance.SystemData{
-	// 			CreatedBy: to.Ptr("user@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-	// 		},
-	// 		Properties: &armlargeinstance.Properties{
-	// 			AzureLargeInstanceID: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
-	// 			PowerState: to.Ptr(armlargeinstance.PowerStateEnumStarted),
-	// 			ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
-	// 			HwRevision: to.Ptr("Rev 3"),
-	// 			HardwareProfile: &armlargeinstance.HardwareProfile{
-	// 				HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
-	// 				AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
-	// 			},
-	// 			NetworkProfile: &armlargeinstance.NetworkProfile{
-	// 				NetworkInterfaces: []*armlargeinstance.IPAddress{
-	// 					{
-	// 						IPAddress: to.Ptr("100.100.100.100"),
-	// 					},
-	// 				},
-	// 				CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
-	// 			},
-	// 			StorageProfile: &armlargeinstance.StorageProfile{
-	// 				NfsIPAddress: to.Ptr("200.200.200.200"),
-	// 			},
-	// 			OSProfile: &armlargeinstance.OsProfile{
-	// 				ComputerName: to.Ptr("myComputerName"),
-	// 				OSType: to.Ptr("SUSE"),
-	// 				Version: to.Ptr("12 SP1"),
-	// 				SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myALInstance
+	// Name: myALInstance
+	// Type: Microsoft.AzureLargeInstance/AzureLargeInstances
+	// Location: westus
 }
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstance_PatchTags_Delete.json
 func ExampleClient_Update_azureLargeInstanceDeleteTag() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("f0f4887f-d13c-4943-a8ba-d7da28d2a3fd", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				Server: fake.Server{
+					Update: func(ctx context.Context, resourceGroupName string, azureLargeInstanceName string, properties armlargeinstance.TagsUpdate, options *armlargeinstance.ClientUpdateOptions) (resp azfake.Responder[armlargeinstance.ClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armlargeinstance.ClientUpdateResponse{
+							AzureLargeInstance: armlargeinstance.AzureLargeInstance{
+								ID:       to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myALInstance"),
+								Name:     to.Ptr("myALInstance"),
+								Type:     to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
+								Location: to.Ptr("westus"),
+								Tags:     map[string]*string{},
+								SystemData: &armlargeinstance.SystemData{
+									CreatedBy:          to.Ptr("user@microsoft.com"),
+									CreatedByType:      to.Ptr(armlargeinstance.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user@microsoft.com"),
+									LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t }()),
+								},
+								Properties: &armlargeinstance.Properties{
+									AzureLargeInstanceID:    to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
+									PowerState:              to.Ptr(armlargeinstance.PowerStateEnumStarted),
+									ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
+									HwRevision:              to.Ptr("Rev 3"),
+									HardwareProfile: &armlargeinstance.HardwareProfile{
+										HardwareType:           to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
+										AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
+									},
+									NetworkProfile: &armlargeinstance.NetworkProfile{
+										NetworkInterfaces: []*armlargeinstance.IPAddress{
+											{
+												IPAddress: to.Ptr("100.100.100.100"),
+											},
+										},
+										CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
+									},
+									StorageProfile: &armlargeinstance.StorageProfile{
+										NfsIPAddress: to.Ptr("200.200.200.200"),
+									},
+									OSProfile: &armlargeinstance.OsProfile{
+										ComputerName: to.Ptr("myComputerName"),
+										OSType:       to.Ptr("SUSE"),
+										Version:      to.Ptr("12 SP1"),
+										SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
+									},
+									ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -620,53 +781,13 @@ func ExampleClient_Update_azureLargeInstanceDeleteTag() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armlargeinstance.ClientUpdateResponse{
-	// 	AzureLargeInstance: armlargeinstance.AzureLargeInstance{
-	// 		ID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myALInstance"),
-	// 		Name: to.Ptr("myALInstance"),
-	// 		Type: to.Ptr("Microsoft.AzureLargeInstance/AzureLargeInstances"),
-	// 		Location: to.Ptr("westus"),
-	// 		Tags: map[string]*string{
-	// 		},
-	// 		SystemData: &armlargeinstance.SystemData{
-	// 			CreatedBy: to.Ptr("user@microsoft.com"),
-	// 			CreatedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-12-20T23:10:22.6828621Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user@microsoft.com"),
-	// 			LastModifiedByType: to.Ptr(armlargeinstance.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-07-21T08:01:22.0000000Z"); return t}()),
-	// 		},
-	// 		Properties: &armlargeinstance.Properties{
-	// 			AzureLargeInstanceID: to.Ptr("23415635-4d7e-41dc-9598-8194f22c24e1"),
-	// 			PowerState: to.Ptr(armlargeinstance.PowerStateEnumStarted),
-	// 			ProximityPlacementGroup: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Compute/proximityPlacementGroups/myplacementgroup"),
-	// 			HwRevision: to.Ptr("Rev 3"),
-	// 			HardwareProfile: &armlargeinstance.HardwareProfile{
-	// 				HardwareType: to.Ptr(armlargeinstance.HardwareTypeNamesEnumCiscoUCS),
-	// 				AzureLargeInstanceSize: to.Ptr(armlargeinstance.SizeNamesEnumS72),
-	// 			},
-	// 			NetworkProfile: &armlargeinstance.NetworkProfile{
-	// 				NetworkInterfaces: []*armlargeinstance.IPAddress{
-	// 					{
-	// 						IPAddress: to.Ptr("100.100.100.100"),
-	// 					},
-	// 				},
-	// 				CircuitID: to.Ptr("/subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.Network/expressRouteCircuit"),
-	// 			},
-	// 			StorageProfile: &armlargeinstance.StorageProfile{
-	// 				NfsIPAddress: to.Ptr("200.200.200.200"),
-	// 			},
-	// 			OSProfile: &armlargeinstance.OsProfile{
-	// 				ComputerName: to.Ptr("myComputerName"),
-	// 				OSType: to.Ptr("SUSE"),
-	// 				Version: to.Ptr("12 SP1"),
-	// 				SSHPublicKey: to.Ptr("{ssh-rsa public key}"),
-	// 			},
-	// 			ProvisioningState: to.Ptr(armlargeinstance.ProvisioningStatesEnumSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/f0f4887f-d13c-4943-a8ba-d7da28d2a3fd/resourceGroups/myResourceGroup/providers/Microsoft.AzureLargeInstance/AzureLargeInstances/myALInstance
+	// Name: myALInstance
+	// Type: Microsoft.AzureLargeInstance/AzureLargeInstances
+	// Location: westus
 }
@@ -6,19 +6,58 @@ package armlargeinstance_test
 
 import (
 	"armlargeinstance"
+	"armlargeinstance/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-08-01-preview/AzureLargeInstanceOperations_List.json
 func ExampleOperationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armlargeinstance.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armlargeinstance.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armlargeinstance.OperationsClientListOptions) (resp azfake.PagerResponder[armlargeinstance.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armlargeinstance.OperationsClientListResponse{
+							OperationListResult: armlargeinstance.OperationListResult{
+								Value: []*armlargeinstance.Operation{
+									{
+										Name: to.Ptr("Microsoft.AzureLargeInstance/read"),
+										Display: &armlargeinstance.OperationDisplay{
+											Provider:    to.Ptr("Microsoft Azure Large Instance"),
+											Resource:    to.Ptr("AzureLargeInstance"),
+											Operation:   to.Ptr("Read AzureLargeInstance"),
+											Description: to.Ptr("Read any AzureLargeInstance"),
+										},
+										IsDataAction: to.Ptr(false),
+									},
+									{
+										Name: to.Ptr("Microsoft.AzureLargeInstance"),
+										Display: &armlargeinstance.OperationDisplay{
+											Provider:    to.Ptr("Microsoft Azure Large Instance"),
+											Resource:    to.Ptr("AzureLargeInstance"),
+											Operation:   to.Ptr("Start AzureLargeInstance"),
+											Description: to.Ptr("Start any AzureLargeInstance"),
+										},
+										IsDataAction: to.Ptr(true),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,35 +68,13 @@ func ExampleOperationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("IsDataAction:", *v.IsDataAction)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armlargeinstance.OperationsClientListResponse{
-		// 	OperationListResult: armlargeinstance.OperationListResult{
-		// 		Value: []*armlargeinstance.Operation{
-		// 			{
-		// 				Name: to.Ptr("Microsoft.AzureLargeInstance/read"),
-		// 				Display: &armlargeinstance.OperationDisplay{
-		// 					Provider: to.Ptr("Microsoft Azure Large Instance"),
-		// 					Resource: to.Ptr("AzureLargeInstance"),
-		// 					Operation: to.Ptr("Read AzureLargeInstance"),
-		// 					Description: to.Ptr("Read any AzureLargeInstance"),
-		// 				},
-		// 				IsDataAction: to.Ptr(false),
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Microsoft.AzureLargeInstance"),
-		// 				Display: &armlargeinstance.OperationDisplay{
-		// 					Provider: to.Ptr("Microsoft Azure Large Instance"),
-		// 					Resource: to.Ptr("AzureLargeInstance"),
-		// 					Operation: to.Ptr("Start AzureLargeInstance"),
-		// 					Description: to.Ptr("Start any AzureLargeInstance"),
-		// 				},
-		// 				IsDataAction: to.Ptr(true),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Microsoft.AzureLargeInstance/read
+	// IsDataAction: false
+	// Name: Microsoft.AzureLargeInstance
+	// IsDataAction: true
 }
@@ -6,20 +6,44 @@ package armloadtestservice_test
 
 import (
 	"armloadtestservice"
+	"armloadtestservice/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2023-12-01-preview/Quotas_CheckAvailability.json
 func ExampleLoadTestMgmtClient_CheckAvailabilityQuota() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					CheckAvailabilityQuota: func(ctx context.Context, location string, quotaBucketName string, quotaBucketRequest armloadtestservice.QuotaBucketRequest, options *armloadtestservice.LoadTestMgmtClientCheckAvailabilityQuotaOptions) (resp azfake.Responder[armloadtestservice.LoadTestMgmtClientCheckAvailabilityQuotaResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armloadtestservice.LoadTestMgmtClientCheckAvailabilityQuotaResponse{
+							CheckQuotaAvailabilityResponse: armloadtestservice.CheckQuotaAvailabilityResponse{
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.LoadTestService/locations/westus/quotas/testQuotaBucket"),
+								Name: to.Ptr("testQuotaBucket"),
+								Type: to.Ptr("Microsoft.LoadTestService/locations/quotas"),
+								Properties: &armloadtestservice.CheckQuotaAvailabilityResponseProperties{
+									IsAvailable:        to.Ptr(false),
+									AvailabilityStatus: to.Ptr("The requested quota is currently unavailable. Please request for different quota, or upgrade subscription offer type and try again later."),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -37,30 +61,72 @@ func ExampleLoadTestMgmtClient_CheckAvailabilityQuota() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armloadtestservice.LoadTestMgmtClientCheckAvailabilityQuotaResponse{
-	// 	CheckQuotaAvailabilityResponse: armloadtestservice.CheckQuotaAvailabilityResponse{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.LoadTestService/locations/westus/quotas/testQuotaBucket"),
-	// 		Name: to.Ptr("testQuotaBucket"),
-	// 		Type: to.Ptr("Microsoft.LoadTestService/locations/quotas"),
-	// 		Properties: &armloadtestservice.CheckQuotaAvailabilityResponseProperties{
-	// 			IsAvailable: to.Ptr(false),
-	// 			AvailabilityStatus: to.Ptr("The requested quota is currently unavailable. Please request for different quota, or upgrade subscription offer type and try again later."),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.LoadTestService/locations/westus/quotas/testQuotaBucket
+	// Name: testQuotaBucket
+	// Type: Microsoft.LoadTestService/locations/quotas
 }
 
 // Generated from example definition: 2023-12-01-preview/LoadTests_CreateOrUpdate.json
 func ExampleLoadTestMgmtClient_BeginCreateOrUpdateLoadtest() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					BeginCreateOrUpdateLoadtest: func(ctx context.Context, resourceGroupName string, loadTestName string, loadTestResource armloadtestservice.LoadTestResource, options *armloadtestservice.LoadTestMgmtClientBeginCreateOrUpdateLoadtestOptions) (resp azfake.PollerResponder[armloadtestservice.LoadTestMgmtClientCreateOrUpdateLoadtestResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armloadtestservice.LoadTestMgmtClientCreateOrUpdateLoadtestResponse{
+							LoadTestResource: armloadtestservice.LoadTestResource{
+								Location: to.Ptr("westus"),
+								Identity: &armloadtestservice.ManagedServiceIdentity{
+									Type:        to.Ptr(armloadtestservice.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
+									PrincipalID: to.Ptr("12345678-1234-1234-1234-123456789012"),
+									TenantID:    to.Ptr("12345678-1234-1234-4321-123456789012"),
+									UserAssignedIdentities: map[string]*armloadtestservice.UserAssignedIdentity{
+										"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id1": &armloadtestservice.UserAssignedIdentity{
+											PrincipalID: to.Ptr("12345678-1234-4321-1234-123456789012"),
+											ClientID:    to.Ptr("12345678-4321-1234-1234-123456789012"),
+										},
+									},
+								},
+								Properties: &armloadtestservice.LoadTestProperties{
+									Description:       to.Ptr("This is new load test resource"),
+									DataPlaneURI:      to.Ptr("https://myLoadTest.00000000-0000-0000-0000-000000000000.cnt-dp.domain.com"),
+									ProvisioningState: to.Ptr(armloadtestservice.ResourceStateSucceeded),
+									Encryption: &armloadtestservice.EncryptionProperties{
+										Identity: &armloadtestservice.EncryptionPropertiesIdentity{
+											Type:       to.Ptr(armloadtestservice.TypeUserAssigned),
+											ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id1"),
+										},
+										KeyURL: to.Ptr("https://dummy.vault.azure.net/keys/dummykey1"),
+									},
+								},
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest"),
+								Name: to.Ptr("myLoadTest"),
+								Type: to.Ptr("Microsoft.LoadTestService/loadTests"),
+								Tags: map[string]*string{
+									"Team": to.Ptr("Dev Exp"),
+								},
+								SystemData: &armloadtestservice.SystemData{
+									CreatedBy:          to.Ptr("userId1001"),
+									CreatedByType:      to.Ptr(armloadtestservice.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t }()),
+									LastModifiedBy:     to.Ptr("userId1001"),
+									LastModifiedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -93,61 +159,33 @@ func ExampleLoadTestMgmtClient_BeginCreateOrUpdateLoadtest() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armloadtestservice.LoadTestMgmtClientCreateOrUpdateLoadtestResponse{
-	// 	LoadTestResource: armloadtestservice.LoadTestResource{
-	// 		Location: to.Ptr("westus"),
-	// 		Identity: &armloadtestservice.ManagedServiceIdentity{
-	// 			Type: to.Ptr(armloadtestservice.ManagedServiceIdentityTypeSystemAssignedUserAssigned),
-	// 			PrincipalID: to.Ptr("12345678-1234-1234-1234-123456789012"),
-	// 			TenantID: to.Ptr("12345678-1234-1234-4321-123456789012"),
-	// 			UserAssignedIdentities: map[string]*armloadtestservice.UserAssignedIdentity{
-	// 				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id1": &armloadtestservice.UserAssignedIdentity{
-	// 					PrincipalID: to.Ptr("12345678-1234-4321-1234-123456789012"),
-	// 					ClientID: to.Ptr("12345678-4321-1234-1234-123456789012"),
-	// 				},
-	// 			},
-	// 		},
-	// 		Properties: &armloadtestservice.LoadTestProperties{
-	// 			Description: to.Ptr("This is new load test resource"),
-	// 			DataPlaneURI: to.Ptr("https://myLoadTest.00000000-0000-0000-0000-000000000000.cnt-dp.domain.com"),
-	// 			ProvisioningState: to.Ptr(armloadtestservice.ResourceStateSucceeded),
-	// 			Encryption: &armloadtestservice.EncryptionProperties{
-	// 				Identity: &armloadtestservice.EncryptionPropertiesIdentity{
-	// 					Type: to.Ptr(armloadtestservice.TypeUserAssigned),
-	// 					ResourceID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id1"),
-	// 				},
-	// 				KeyURL: to.Ptr("https://dummy.vault.azure.net/keys/dummykey1"),
-	// 			},
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest"),
-	// 		Name: to.Ptr("myLoadTest"),
-	// 		Type: to.Ptr("Microsoft.LoadTestService/loadTests"),
-	// 		Tags: map[string]*string{
-	// 			"Team": to.Ptr("Dev Exp"),
-	// 		},
-	// 		SystemData: &armloadtestservice.SystemData{
-	// 			CreatedBy: to.Ptr("userId1001"),
-	// 			CreatedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("userId1001"),
-	// 			LastModifiedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// Location: westus
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest
+	// Name: myLoadTest
+	// Type: Microsoft.LoadTestService/loadTests
 }
 
 // Generated from example definition: 2023-12-01-preview/LoadTests_Delete.json
 func ExampleLoadTestMgmtClient_BeginDeleteLoadtest() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					BeginDeleteLoadtest: func(ctx context.Context, resourceGroupName string, loadTestName string, options *armloadtestservice.LoadTestMgmtClientBeginDeleteLoadtestOptions) (resp azfake.PollerResponder[armloadtestservice.LoadTestMgmtClientDeleteLoadtestResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armloadtestservice.LoadTestMgmtClientDeleteLoadtestResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -159,16 +197,48 @@ func ExampleLoadTestMgmtClient_BeginDeleteLoadtest() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2023-12-01-preview/LoadTests_Get.json
 func ExampleLoadTestMgmtClient_GetLoadtest() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					GetLoadtest: func(ctx context.Context, resourceGroupName string, loadTestName string, options *armloadtestservice.LoadTestMgmtClientGetLoadtestOptions) (resp azfake.Responder[armloadtestservice.LoadTestMgmtClientGetLoadtestResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armloadtestservice.LoadTestMgmtClientGetLoadtestResponse{
+							LoadTestResource: armloadtestservice.LoadTestResource{
+								Location: to.Ptr("westus"),
+								Properties: &armloadtestservice.LoadTestProperties{
+									Description:       to.Ptr("This is new load test resource"),
+									DataPlaneURI:      to.Ptr("https://myLoadTest.00000000-0000-0000-0000-000000000000.cnt-dp.domain.com"),
+									ProvisioningState: to.Ptr(armloadtestservice.ResourceStateSucceeded),
+								},
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest"),
+								Name: to.Ptr("myLoadTest"),
+								Type: to.Ptr("Microsoft.LoadTestService/loadTests"),
+								Tags: map[string]*string{
+									"Team": to.Ptr("Dev Exp"),
+								},
+								SystemData: &armloadtestservice.SystemData{
+									CreatedBy:          to.Ptr("userId1001"),
+									CreatedByType:      to.Ptr(armloadtestservice.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t }()),
+									LastModifiedBy:     to.Ptr("userId1001"),
+									LastModifiedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t }()),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -176,43 +246,43 @@ func ExampleLoadTestMgmtClient_GetLoadtest() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armloadtestservice.LoadTestMgmtClientGetLoadtestResponse{
-	// 	LoadTestResource: armloadtestservice.LoadTestResource{
-	// 		Location: to.Ptr("westus"),
-	// 		Properties: &armloadtestservice.LoadTestProperties{
-	// 			Description: to.Ptr("This is new load test resource"),
-	// 			DataPlaneURI: to.Ptr("https://myLoadTest.00000000-0000-0000-0000-000000000000.cnt-dp.domain.com"),
-	// 			ProvisioningState: to.Ptr(armloadtestservice.ResourceStateSucceeded),
-	// 		},
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest"),
-	// 		Name: to.Ptr("myLoadTest"),
-	// 		Type: to.Ptr("Microsoft.LoadTestService/loadTests"),
-	// 		Tags: map[string]*string{
-	// 			"Team": to.Ptr("Dev Exp"),
-	// 		},
-	// 		SystemData: &armloadtestservice.SystemData{
-	// 			CreatedBy: to.Ptr("userId1001"),
-	// 			CreatedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("userId1001"),
-	// 			LastModifiedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t}()),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Location:", *res.Location)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// Location: westus
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest
+	// Name: myLoadTest
+	// Type: Microsoft.LoadTestService/loadTests
 }
 
 // Generated from example definition: 2023-12-01-preview/Quotas_Get.json
 func ExampleLoadTestMgmtClient_GetQuota() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					GetQuota: func(ctx context.Context, location string, quotaBucketName string, options *armloadtestservice.LoadTestMgmtClientGetQuotaOptions) (resp azfake.Responder[armloadtestservice.LoadTestMgmtClientGetQuotaResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armloadtestservice.LoadTestMgmtClientGetQuotaResponse{
+							QuotaResource: armloadtestservice.QuotaResource{
+								ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.LoadTestService/locations/westus/quotas/testQuotaBucket"),
+								Name: to.Ptr("testQuotaBucket"),
+								Type: to.Ptr("Microsoft.LoadTestService/locations/quotas"),
+								Properties: &armloadtestservice.QuotaResourceProperties{
+									Limit: to.Ptr[int32](50),
+									Usage: to.Ptr[int32](20),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -220,30 +290,58 @@ func ExampleLoadTestMgmtClient_GetQuota() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armloadtestservice.LoadTestMgmtClientGetQuotaResponse{
-	// 	QuotaResource: armloadtestservice.QuotaResource{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.LoadTestService/locations/westus/quotas/testQuotaBucket"),
-	// 		Name: to.Ptr("testQuotaBucket"),
-	// 		Type: to.Ptr("Microsoft.LoadTestService/locations/quotas"),
-	// 		Properties: &armloadtestservice.QuotaResourceProperties{
-	// 			Limit: to.Ptr[int32](50),
-	// 			Usage: to.Ptr[int32](20),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.LoadTestService/locations/westus/quotas/testQuotaBucket
+	// Name: testQuotaBucket
+	// Type: Microsoft.LoadTestService/locations/quotas
 }
 
 // Generated from example definition: 2023-12-01-preview/LoadTests_ListByResourceGroup.json
 func ExampleLoadTestMgmtClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armloadtestservice.LoadTestMgmtClientListByResourceGroupOptions) (resp azfake.PagerResponder[armloadtestservice.LoadTestMgmtClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armloadtestservice.LoadTestMgmtClientListByResourceGroupResponse{
+							LoadTestResourceListResult: armloadtestservice.LoadTestResourceListResult{
+								Value: []*armloadtestservice.LoadTestResource{
+									{
+										Location: to.Ptr("westus"),
+										Properties: &armloadtestservice.LoadTestProperties{
+											Description:       to.Ptr("This is new load test resource"),
+											DataPlaneURI:      to.Ptr("https://myLoadTest.00000000-0000-0000-0000-000000000000.cnt-dp.domain.com"),
+											ProvisioningState: to.Ptr(armloadtestservice.ResourceStateSucceeded),
+										},
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest"),
+										Name: to.Ptr("myLoadTest"),
+										Type: to.Ptr("Microsoft.LoadTestService/loadTests"),
+										Tags: map[string]*string{
+											"Team": to.Ptr("Dev Exp"),
+										},
+										SystemData: &armloadtestservice.SystemData{
+											CreatedBy:          to.Ptr("userId1001"),
+											CreatedByType:      to.Ptr(armloadtestservice.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t }()),
+											LastModifiedBy:     to.Ptr("userId1001"),
+											LastModifiedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -254,49 +352,62 @@ func ExampleLoadTestMgmtClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Location:", *v.Location)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armloadtestservice.LoadTestMgmtClientListByResourceGroupResponse{
-		// 	LoadTestResourceListResult: armloadtestservice.LoadTestResourceListResult{
-		// 		Value: []*armloadtestservice.LoadTestResource{
-		// 			{
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armloadtestservice.LoadTestProperties{
-		// 					Description: to.Ptr("This is new load test resource"),
-		// 					DataPlaneURI: to.Ptr("https://myLoadTest.00000000-0000-0000-0000-000000000000.cnt-dp.domain.com"),
-		// 					ProvisioningState: to.Ptr(armloadtestservice.ResourceStateSucceeded),
-		// 				},
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest"),
-		// 				Name: to.Ptr("myLoadTest"),
-		// 				Type: to.Ptr("Microsoft.LoadTestService/loadTests"),
-		// 				Tags: map[string]*string{
-		// 					"Team": to.Ptr("Dev Exp"),
-		// 				},
-		// 				SystemData: &armloadtestservice.SystemData{
-		// 					CreatedBy: to.Ptr("userId1001"),
-		// 					CreatedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("userId1001"),
-		// 					LastModifiedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Location: westus
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest
+	// Name: myLoadTest
+	// Type: Microsoft.LoadTestService/loadTests
 }
 
 // Generated from example definition: 2023-12-01-preview/LoadTests_ListBySubscription.json
 func ExampleLoadTestMgmtClient_NewListBySubscriptionPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					NewListBySubscriptionPager: func(options *armloadtestservice.LoadTestMgmtClientListBySubscriptionOptions) (resp azfake.PagerResponder[armloadtestservice.LoadTestMgmtClientListBySubscriptionResponse]) {
+						resp.AddPage(http.StatusOK, armloadtestservice.LoadTestMgmtClientListBySubscriptionResponse{
+							LoadTestResourceListResult: armloadtestservice.LoadTestResourceListResult{
+								Value: []*armloadtestservice.LoadTestResource{
+									{
+										Location: to.Ptr("westus"),
+										Properties: &armloadtestservice.LoadTestProperties{
+											Description:       to.Ptr("This is new load test resource"),
+											DataPlaneURI:      to.Ptr("https://myLoadTest.00000000-0000-0000-0000-000000000000.cnt-dp.domain.com"),
+											ProvisioningState: to.Ptr(armloadtestservice.ResourceStateSucceeded),
+										},
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest"),
+										Name: to.Ptr("myLoadTest"),
+										Type: to.Ptr("Microsoft.LoadTestService/loadTests"),
+										Tags: map[string]*string{
+											"Team": to.Ptr("Dev Exp"),
+										},
+										SystemData: &armloadtestservice.SystemData{
+											CreatedBy:          to.Ptr("userId1001"),
+											CreatedByType:      to.Ptr(armloadtestservice.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t }()),
+											LastModifiedBy:     to.Ptr("userId1001"),
+											LastModifiedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t }()),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -307,49 +418,49 @@ func ExampleLoadTestMgmtClient_NewListBySubscriptionPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Location:", *v.Location)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armloadtestservice.LoadTestMgmtClientListBySubscriptionResponse{
-		// 	LoadTestResourceListResult: armloadtestservice.LoadTestResourceListResult{
-		// 		Value: []*armloadtestservice.LoadTestResource{
-		// 			{
-		// 				Location: to.Ptr("westus"),
-		// 				Properties: &armloadtestservice.LoadTestProperties{
-		// 					Description: to.Ptr("This is new load test resource"),
-		// 					DataPlaneURI: to.Ptr("https://myLoadTest.00000000-0000-0000-0000-000000000000.cnt-dp.domain.com"),
-		// 					ProvisioningState: to.Ptr(armloadtestservice.ResourceStateSucceeded),
-		// 				},
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest"),
-		// 				Name: to.Ptr("myLoadTest"),
-		// 				Type: to.Ptr("Microsoft.LoadTestService/loadTests"),
-		// 				Tags: map[string]*string{
-		// 					"Team": to.Ptr("Dev Exp"),
-		// 				},
-		// 				SystemData: &armloadtestservice.SystemData{
-		// 					CreatedBy: to.Ptr("userId1001"),
-		// 					CreatedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("userId1001"),
-		// 					LastModifiedByType: to.Ptr(armloadtestservice.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2021-09-28T12:32:33Z"); return t}()),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Location: westus
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dummyrg/providers/Microsoft.LoadTestService/loadTests/myLoadTest
+	// Name: myLoadTest
+	// Type: Microsoft.LoadTestService/loadTests
 }
 
 // Generated from example definition: 2023-12-01-preview/Quotas_List.json
 func ExampleLoadTestMgmtClient_NewListQuotaPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					NewListQuotaPager: func(location string, options *armloadtestservice.LoadTestMgmtClientListQuotaOptions) (resp azfake.PagerResponder[armloadtestservice.LoadTestMgmtClientListQuotaResponse]) {
+						resp.AddPage(http.StatusOK, armloadtestservice.LoadTestMgmtClientListQuotaResponse{
+							QuotaResourceListResult: armloadtestservice.QuotaResourceListResult{
+								Value: []*armloadtestservice.QuotaResource{
+									{
+										ID:   to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.LoadTestService/locations/westus/quotas/testQuotaBucket"),
+										Name: to.Ptr("testQuotaBucket"),
+										Type: to.Ptr("Microsoft.LoadTestService/locations/quotas"),
+										Properties: &armloadtestservice.QuotaResourceProperties{
+											Limit: to.Ptr[int32](50),
+											Usage: to.Ptr[int32](20),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -360,36 +471,130 @@ func ExampleLoadTestMgmtClient_NewListQuotaPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armloadtestservice.LoadTestMgmtClientListQuotaResponse{
-		// 	QuotaResourceListResult: armloadtestservice.QuotaResourceListResult{
-		// 		Value: []*armloadtestservice.QuotaResource{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.LoadTestService/locations/westus/quotas/testQuotaBucket"),
-		// 				Name: to.Ptr("testQuotaBucket"),
-		// 				Type: to.Ptr("Microsoft.LoadTestService/locations/quotas"),
-		// 				Properties: &armloadtestservice.QuotaResourceProperties{
-		// 					Limit: to.Ptr[int32](50),
-		// 					Usage: to.Ptr[int32](20),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.LoadTestService/locations/westus/quotas/testQuotaBucket
+	// Name: testQuotaBucket
+	// Type: Microsoft.LoadTestService/locations/quotas
 }
 
 // Generated from example definition: 2023-12-01-preview/LoadTests_ListOutboundNetworkDependenciesEndpoints.json
 func ExampleLoadTestMgmtClient_NewOutboundNetworkDependenciesEndpointsPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					NewOutboundNetworkDependenciesEndpointsPager: func(resourceGroupName string, loadTestName string, options *armloadtestservice.LoadTestMgmtClientOutboundNetworkDependenciesEndpointsOptions) (resp azfake.PagerResponder[armloadtestservice.LoadTestMgmtClientOutboundNetworkDependenciesEndpointsResponse]) {
+						resp.AddPage(http.StatusOK, armloadtestservice.LoadTestMgmtClientOutboundNetworkDependenciesEndpointsResponse{
+							PagedOutboundEnvironmentEndpoint: armloadtestservice.PagedOutboundEnvironmentEndpoint{
+								Value: []*armloadtestservice.OutboundEnvironmentEndpoint{
+									{
+										Category: to.Ptr("Azure Batch"),
+										Endpoints: []*armloadtestservice.EndpointDependency{
+											{
+												DomainName:  to.Ptr("sampleacct.japaneast.batch.azure.com"),
+												Description: to.Ptr("Applicable to job manager tasks, tasks that use job scoped authentication, or any task that makes calls to Batch."),
+												EndpointDetails: []*armloadtestservice.EndpointDetail{
+													{
+														Port: to.Ptr[int32](443),
+													},
+												},
+											},
+											{
+												DomainName:  to.Ptr("japaneast.service.batch.azure.com"),
+												Description: to.Ptr("Applicable to all Azure Batch pools."),
+												EndpointDetails: []*armloadtestservice.EndpointDetail{
+													{
+														Port: to.Ptr[int32](443),
+													},
+												},
+											},
+										},
+									},
+									{
+										Category: to.Ptr("Azure Storage"),
+										Endpoints: []*armloadtestservice.EndpointDependency{
+											{
+												DomainName:  to.Ptr("autostorageaccountname.blob.core.windows.net"),
+												Description: to.Ptr("AutoStorage endpoint for this Batch account. Applicable to all Azure Batch pools under this account."),
+												EndpointDetails: []*armloadtestservice.EndpointDetail{
+													{
+														Port: to.Ptr[int32](443),
+													},
+												},
+											},
+											{
+												DomainName:  to.Ptr("*.blob.core.windows.net"),
+												Description: to.Ptr("Applicable to all Azure Batch pools."),
+												EndpointDetails: []*armloadtestservice.EndpointDetail{
+													{
+														Port: to.Ptr[int32](443),
+													},
+												},
+											},
+											{
+												DomainName:  to.Ptr("*.table.core.windows.net"),
+												Description: to.Ptr("Applicable to all Azure Batch pools."),
+												EndpointDetails: []*armloadtestservice.EndpointDetail{
+													{
+														Port: to.Ptr[int32](443),
+													},
+												},
+											},
+											{
+												DomainName:  to.Ptr("*.queue.core.windows.net"),
+												Description: to.Ptr("Applicable to all Azure Batch pools."),
+												EndpointDetails: []*armloadtestservice.EndpointDetail{
+													{
+														Port: to.Ptr[int32](443),
+													},
+												},
+											},
+										},
+									},
+									{
+										Category: to.Ptr("Microsoft Package Repository"),
+										Endpoints: []*armloadtestservice.EndpointDependency{
+											{
+												DomainName:  to.Ptr("packages.microsoft.com"),
+												Description: to.Ptr("Only applicable to pools containing a Mount Configuration. Learn about Mount Configurations in Batch at https://docs.microsoft.com/azure/batch/virtual-file-mount."),
+												EndpointDetails: []*armloadtestservice.EndpointDetail{
+													{
+														Port: to.Ptr[int32](443),
+													},
+												},
+											},
+										},
+									},
+									{
+										Category: to.Ptr("Azure Key Vault"),
+										Endpoints: []*armloadtestservice.EndpointDependency{
+											{
+												DomainName:  to.Ptr("*.vault.azure.net"),
+												Description: to.Ptr("Only applicable to pools containing a Disk Encryption Configuration and whose VM size does not support encryption at host. Learn more about disk encryption in Azure Batch at https://docs.microsoft.com/azure/batch/disk-encryption. Learn more about encryption at host and supported VM sizes at https://docs.microsoft.com/azure/virtual-machines/disks-enable-host-based-encryption-portal."),
+												EndpointDetails: []*armloadtestservice.EndpointDetail{
+													{
+														Port: to.Ptr[int32](443),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -400,119 +605,32 @@ func ExampleLoadTestMgmtClient_NewOutboundNetworkDependenciesEndpointsPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Category:", *v.Category)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armloadtestservice.LoadTestMgmtClientOutboundNetworkDependenciesEndpointsResponse{
-		// 	PagedOutboundEnvironmentEndpoint: armloadtestservice.PagedOutboundEnvironmentEndpoint{
-		// 		Value: []*armloadtestservice.OutboundEnvironmentEndpoint{
-		// 			{
-		// 				Category: to.Ptr("Azure Batch"),
-		// 				Endpoints: []*armloadtestservice.EndpointDependency{
-		// 					{
-		// 						DomainName: to.Ptr("sampleacct.japaneast.batch.azure.com"),
-		// 						Description: to.Ptr("Applicable to job manager tasks, tasks that use job scoped authentication, or any task that makes calls to Batch."),
-		// 						EndpointDetails: []*armloadtestservice.EndpointDetail{
-		// 							{
-		// 								Port: to.Ptr[int32](443),
-		// 							},
-		// 						},
-		// 					},
-		// 					{
-		// 						DomainName: to.Ptr("japaneast.service.batch.azure.com"),
-		// 						Description: to.Ptr("Applicable to all Azure Batch pools."),
-		// 						EndpointDetails: []*armloadtestservice.EndpointDetail{
-		// 							{
-		// 								Port: to.Ptr[int32](443),
-		// 							},
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 			{
-		// 				Category: to.Ptr("Azure Storage"),
-		// 				Endpoints: []*armloadtestservice.EndpointDependency{
-		// 					{
-		// 						DomainName: to.Ptr("autostorageaccountname.blob.core.windows.net"),
-		// 						Description: to.Ptr("AutoStorage endpoint for this Batch account. Applicable to all Azure Batch pools under this account."),
-		// 						EndpointDetails: []*armloadtestservice.EndpointDetail{
-		// 							{
-		// 								Port: to.Ptr[int32](443),
-		// 							},
-		// 						},
-		// 					},
-		// 					{
-		// 						DomainName: to.Ptr("*.blob.core.windows.net"),
-		// 						Description: to.Ptr("Applicable to all Azure Batch pools."),
-		// 						EndpointDetails: []*armloadtestservice.EndpointDetail{
-		// 							{
-		// 								Port: to.Ptr[int32](443),
-		// 							},
-		// 						},
-		// 					},
-		// 					{
-		// 						DomainName: to.Ptr("*.table.core.windows.net"),
-		// 						Description: to.Ptr("Applicable to all Azure Batch pools."),
-		// 						EndpointDetails: []*armloadtestservice.EndpointDetail{
-		// 							{
-		// 								Port: to.Ptr[int32](443),
-		// 							},
-		// 						},
-		// 					},
-		// 					{
-		// 						DomainName: to.Ptr("*.queue.core.windows.net"),
-		// 						Description: to.Ptr("Applicable to all Azure Batch pools."),
-		// 						EndpointDetails: []*armloadtestservice.EndpointDetail{
-		// 							{
-		// 								Port: to.Ptr[int32](443),
-		// 							},
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 			{
-		// 				Category: to.Ptr("Microsoft Package Repository"),
-		// 				Endpoints: []*armloadtestservice.EndpointDependency{
-		// 					{
-		// 						DomainName: to.Ptr("packages.microsoft.com"),
-		// 						Description: to.Ptr("Only applicable to pools containing a Mount Configuration. Learn about Mount Configurations in Batch at https://docs.microsoft.com/azure/batch/virtual-file-mount."),
-		// 						EndpointDetails: []*armloadtestservice.EndpointDetail{
-		// 							{
-		// 								Port: to.Ptr[int32](443),
-		// 							},
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 			{
-		// 				Category: to.Ptr("Azure Key Vault"),
-		// 				Endpoints: []*armloadtestservice.EndpointDependency{
-		// 					{
-		// 						DomainName: to.Ptr("*.vault.azure.net"),
-		// 						Description: to.Ptr("Only applicable to pools containing a Disk Encryption Configuration and whose VM size does not support encryption at host. Learn more about disk encryption in Azure Batch at https://docs.microsoft.com/azure/batch/disk-encryption. Learn more about encryption at host and supported VM sizes at https://docs.microsoft.com/azure/virtual-machines/disks-enable-host-based-encryption-portal."),
-		// 						EndpointDetails: []*armloadtestservice.EndpointDetail{
-		// 							{
-		// 								Port: to.Ptr[int32](443),
-		// 							},
-		// 						},
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Category: Azure Batch
+	// Category: Azure Storage
+	// Category: Microsoft Package Repository
+	// Category: Azure Key Vault
 }
 
 // Generated from example definition: 2023-12-01-preview/LoadTests_Update.json
 func ExampleLoadTestMgmtClient_BeginUpdateLoadtest() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armloadtestservice.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				LoadTestMgmtServer: fake.LoadTestMgmtServer{
+					BeginUpdateLoadtest: func(ctx context.Context, resourceGroupName string, loadTestName string, loadTestResourcePatchRequestBody armloadtestservice.LoadTestResourceUpdate, options *armloadtestservice.LoadTestMgmtClientBeginUpdateLoadtestOptions) (resp azfake.PollerResponder[armloadtestservice.LoadTestMgmtClientUpdateLoadtestResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armloadtestservice.LoadTestMgmtClientUpdateLoadtestResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -547,7 +665,5 @@ func ExampleLoadTestMgmtClient_BeginUpdateLoadtest() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armloadtestservice.LoadTestMgmtClientUpdateLoadtestResponse{
-	// }
+	// Output:
 }
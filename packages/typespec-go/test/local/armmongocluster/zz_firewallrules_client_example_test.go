@@ -6,20 +6,53 @@ package armmongocluster_test
 
 import (
 	"armmongocluster"
+	"armmongocluster/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-07-01/MongoClusters_FirewallRuleCreate.json
 func ExampleFirewallRulesClient_BeginCreateOrUpdate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				FirewallRulesServer: fake.FirewallRulesServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, mongoClusterName string, firewallRuleName string, resource armmongocluster.FirewallRule, options *armmongocluster.FirewallRulesClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armmongocluster.FirewallRulesClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armmongocluster.FirewallRulesClientCreateOrUpdateResponse{
+							FirewallRule: armmongocluster.FirewallRule{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule1"),
+								Name: to.Ptr("rule1"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters/firewallRules"),
+								SystemData: &armmongocluster.SystemData{
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+								},
+								Properties: &armmongocluster.FirewallRuleProperties{
+									ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+									StartIPAddress:    to.Ptr("0.0.0.0"),
+									EndIPAddress:      to.Ptr("255.255.255.255"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -36,39 +69,31 @@ func ExampleFirewallRulesClient_BeginCreateOrUpdate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.FirewallRulesClientCreateOrUpdateResponse{
-	// 	FirewallRule: armmongocluster.FirewallRule{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule1"),
-	// 		Name: to.Ptr("rule1"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters/firewallRules"),
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-	// 		},
-	// 		Properties: &armmongocluster.FirewallRuleProperties{
-	// 			ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-	// 			StartIPAddress: to.Ptr("0.0.0.0"),
-	// 			EndIPAddress: to.Ptr("255.255.255.255"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule1
+	// Name: rule1
+	// Type: /Microsoft.DocumentDB/mongoClusters/firewallRules
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_FirewallRuleDelete.json
 func ExampleFirewallRulesClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				FirewallRulesServer: fake.FirewallRulesServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, mongoClusterName string, firewallRuleName string, options *armmongocluster.FirewallRulesClientBeginDeleteOptions) (resp azfake.PollerResponder[armmongocluster.FirewallRulesClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armmongocluster.FirewallRulesClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -80,16 +105,44 @@ func ExampleFirewallRulesClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_FirewallRuleGet.json
 func ExampleFirewallRulesClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				FirewallRulesServer: fake.FirewallRulesServer{
+					Get: func(ctx context.Context, resourceGroupName string, mongoClusterName string, firewallRuleName string, options *armmongocluster.FirewallRulesClientGetOptions) (resp azfake.Responder[armmongocluster.FirewallRulesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armmongocluster.FirewallRulesClientGetResponse{
+							FirewallRule: armmongocluster.FirewallRule{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule1"),
+								Name: to.Ptr("rule1"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters/firewallRules"),
+								SystemData: &armmongocluster.SystemData{
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+								},
+								Properties: &armmongocluster.FirewallRuleProperties{
+									ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+									StartIPAddress:    to.Ptr("0.0.0.0"),
+									EndIPAddress:      to.Ptr("255.255.255.255"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -97,39 +150,72 @@ func ExampleFirewallRulesClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.FirewallRulesClientGetResponse{
-	// 	FirewallRule: armmongocluster.FirewallRule{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule1"),
-	// 		Name: to.Ptr("rule1"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters/firewallRules"),
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-	// 		},
-	// 		Properties: &armmongocluster.FirewallRuleProperties{
-	// 			ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-	// 			StartIPAddress: to.Ptr("0.0.0.0"),
-	// 			EndIPAddress: to.Ptr("255.255.255.255"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule1
+	// Name: rule1
+	// Type: /Microsoft.DocumentDB/mongoClusters/firewallRules
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_FirewallRuleList.json
 func ExampleFirewallRulesClient_NewListByMongoClusterPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				FirewallRulesServer: fake.FirewallRulesServer{
+					NewListByMongoClusterPager: func(resourceGroupName string, mongoClusterName string, options *armmongocluster.FirewallRulesClientListByMongoClusterOptions) (resp azfake.PagerResponder[armmongocluster.FirewallRulesClientListByMongoClusterResponse]) {
+						resp.AddPage(http.StatusOK, armmongocluster.FirewallRulesClientListByMongoClusterResponse{
+							FirewallRuleListResult: armmongocluster.FirewallRuleListResult{
+								Value: []*armmongocluster.FirewallRule{
+									{
+										ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule1"),
+										Name: to.Ptr("rule1"),
+										Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters/firewallRules"),
+										SystemData: &armmongocluster.SystemData{
+											CreatedBy:          to.Ptr("user1"),
+											CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+										},
+										Properties: &armmongocluster.FirewallRuleProperties{
+											ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+											StartIPAddress:    to.Ptr("0.0.0.0"),
+											EndIPAddress:      to.Ptr("255.255.255.255"),
+										},
+									},
+									{
+										ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule2"),
+										Name: to.Ptr("rule2"),
+										Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters/firewallRules"),
+										SystemData: &armmongocluster.SystemData{
+											CreatedBy:          to.Ptr("user1"),
+											CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+										},
+										Properties: &armmongocluster.FirewallRuleProperties{
+											ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+											StartIPAddress:    to.Ptr("1.0.0.0"),
+											EndIPAddress:      to.Ptr("255.0.0.0"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -140,51 +226,16 @@ func ExampleFirewallRulesClient_NewListByMongoClusterPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armmongocluster.FirewallRulesClientListByMongoClusterResponse{
-		// 	FirewallRuleListResult: armmongocluster.FirewallRuleListResult{
-		// 		Value: []*armmongocluster.FirewallRule{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule1"),
-		// 				Name: to.Ptr("rule1"),
-		// 				Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters/firewallRules"),
-		// 				SystemData: &armmongocluster.SystemData{
-		// 					CreatedBy: to.Ptr("user1"),
-		// 					CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-		// 				},
-		// 				Properties: &armmongocluster.FirewallRuleProperties{
-		// 					ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-		// 					StartIPAddress: to.Ptr("0.0.0.0"),
-		// 					EndIPAddress: to.Ptr("255.255.255.255"),
-		// 				},
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule2"),
-		// 				Name: to.Ptr("rule2"),
-		// 				Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters/firewallRules"),
-		// 				SystemData: &armmongocluster.SystemData{
-		// 					CreatedBy: to.Ptr("user1"),
-		// 					CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-		// 				},
-		// 				Properties: &armmongocluster.FirewallRuleProperties{
-		// 					ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-		// 					StartIPAddress: to.Ptr("1.0.0.0"),
-		// 					EndIPAddress: to.Ptr("255.0.0.0"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule1
+	// Name: rule1
+	// Type: /Microsoft.DocumentDB/mongoClusters/firewallRules
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/firewallRules/rule2
+	// Name: rule2
+	// Type: /Microsoft.DocumentDB/mongoClusters/firewallRules
 }
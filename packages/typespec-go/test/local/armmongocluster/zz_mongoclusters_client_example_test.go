@@ -6,21 +6,38 @@ package armmongocluster_test
 
 import (
 	"armmongocluster"
+	"armmongocluster/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 	"time"
 )
 
 // Generated from example definition: 2024-07-01/MongoClusters_NameAvailability.json
 func ExampleMongoClustersClient_CheckNameAvailability_checksAndConfirmsTheMongoClusterNameIsAvailabilityForUse() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					CheckNameAvailability: func(ctx context.Context, location string, body armmongocluster.CheckNameAvailabilityRequest, options *armmongocluster.MongoClustersClientCheckNameAvailabilityOptions) (resp azfake.Responder[armmongocluster.MongoClustersClientCheckNameAvailabilityResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armmongocluster.MongoClustersClientCheckNameAvailabilityResponse{
+							CheckNameAvailabilityResponse: armmongocluster.CheckNameAvailabilityResponse{
+								NameAvailable: to.Ptr(true),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -31,24 +48,33 @@ func ExampleMongoClustersClient_CheckNameAvailability_checksAndConfirmsTheMongoC
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientCheckNameAvailabilityResponse{
-	// 	CheckNameAvailabilityResponse: armmongocluster.CheckNameAvailabilityResponse{
-	// 		NameAvailable: to.Ptr(true),
-	// 	},
-	// }
+	fmt.Println("NameAvailable:", *res.NameAvailable)
+	// Output:
+	// NameAvailable: true
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_NameAvailability_AlreadyExists.json
 func ExampleMongoClustersClient_CheckNameAvailability_checksAndReturnsThatTheMongoClusterNameIsAlreadyInUse() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					CheckNameAvailability: func(ctx context.Context, location string, body armmongocluster.CheckNameAvailabilityRequest, options *armmongocluster.MongoClustersClientCheckNameAvailabilityOptions) (resp azfake.Responder[armmongocluster.MongoClustersClientCheckNameAvailabilityResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armmongocluster.MongoClustersClientCheckNameAvailabilityResponse{
+							CheckNameAvailabilityResponse: armmongocluster.CheckNameAvailabilityResponse{
+								NameAvailable: to.Ptr(false),
+								Reason:        to.Ptr(armmongocluster.CheckNameAvailabilityReasonAlreadyExists),
+								Message:       to.Ptr("Cluster name 'existingmongocluster' is already in use."),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -59,26 +85,75 @@ func ExampleMongoClustersClient_CheckNameAvailability_checksAndReturnsThatTheMon
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientCheckNameAvailabilityResponse{
-	// 	CheckNameAvailabilityResponse: armmongocluster.CheckNameAvailabilityResponse{
-	// 		NameAvailable: to.Ptr(false),
-	// 		Reason: to.Ptr(armmongocluster.CheckNameAvailabilityReasonAlreadyExists),
-	// 		Message: to.Ptr("Cluster name 'existingmongocluster' is already in use."),
-	// 	},
-	// }
+	fmt.Println("NameAvailable:", *res.NameAvailable)
+	fmt.Println("Reason:", *res.Reason)
+	fmt.Println("Message:", *res.Message)
+	// Output:
+	// NameAvailable: false
+	// Reason: AlreadyExists
+	// Message: Cluster name 'existingmongocluster' is already in use.
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_Create.json
 func ExampleMongoClustersClient_BeginCreateOrUpdate_createsANewMongoClusterResource() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, mongoClusterName string, resource armmongocluster.MongoCluster, options *armmongocluster.MongoClustersClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armmongocluster.MongoClustersClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armmongocluster.MongoClustersClientCreateOrUpdateResponse{
+							MongoCluster: armmongocluster.MongoCluster{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
+								Name: to.Ptr("myMongoCluster"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+								SystemData: &armmongocluster.SystemData{
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+								},
+								Properties: &armmongocluster.Properties{
+									ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+									Administrator: &armmongocluster.AdministratorProperties{
+										UserName: to.Ptr("mongoAdmin"),
+									},
+									ServerVersion: to.Ptr("5.0"),
+									Storage: &armmongocluster.StorageProperties{
+										SizeGb: to.Ptr[int64](128),
+									},
+									Compute: &armmongocluster.ComputeProperties{
+										Tier: to.Ptr("M30"),
+									},
+									Sharding: &armmongocluster.ShardingProperties{
+										ShardCount: to.Ptr[int32](1),
+									},
+									HighAvailability: &armmongocluster.HighAvailabilityProperties{
+										TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+									},
+									Backup: &armmongocluster.BackupProperties{
+										EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+									},
+									PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+									InfrastructureVersion: to.Ptr("2.0"),
+									PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+									ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+									Replica: &armmongocluster.ReplicationProperties{
+										Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
+									},
+								},
+								Location: to.Ptr("westus2"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -111,65 +186,73 @@ func ExampleMongoClustersClient_BeginCreateOrUpdate_createsANewMongoClusterResou
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientCreateOrUpdateResponse{
-	// 	MongoCluster: armmongocluster.MongoCluster{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
-	// 		Name: to.Ptr("myMongoCluster"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-	// 		},
-	// 		Properties: &armmongocluster.Properties{
-	// 			ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-	// 			Administrator: &armmongocluster.AdministratorProperties{
-	// 				UserName: to.Ptr("mongoAdmin"),
-	// 			},
-	// 			ServerVersion: to.Ptr("5.0"),
-	// 			Storage: &armmongocluster.StorageProperties{
-	// 				SizeGb: to.Ptr[int64](128),
-	// 			},
-	// 			Compute: &armmongocluster.ComputeProperties{
-	// 				Tier: to.Ptr("M30"),
-	// 			},
-	// 			Sharding: &armmongocluster.ShardingProperties{
-	// 				ShardCount: to.Ptr[int32](1),
-	// 			},
-	// 			HighAvailability: &armmongocluster.HighAvailabilityProperties{
-	// 				TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-	// 			},
-	// 			Backup: &armmongocluster.BackupProperties{
-	// 				EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-	// 			},
-	// 			PreviewFeatures: []*armmongocluster.PreviewFeature{
-	// 			},
-	// 			InfrastructureVersion: to.Ptr("2.0"),
-	// 			PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-	// 			ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-	// 			Replica: &armmongocluster.ReplicationProperties{
-	// 				Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("westus2"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster
+	// Name: myMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: westus2
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_CreateGeoReplica.json
 func ExampleMongoClustersClient_BeginCreateOrUpdate_createsAReplicaMongoClusterResourceFromASourceResource() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, mongoClusterName string, resource armmongocluster.MongoCluster, options *armmongocluster.MongoClustersClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armmongocluster.MongoClustersClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armmongocluster.MongoClustersClientCreateOrUpdateResponse{
+							MongoCluster: armmongocluster.MongoCluster{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myReplicaMongoCluster"),
+								Name: to.Ptr("myReplicaMongoCluster"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+								Properties: &armmongocluster.Properties{
+									ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+									Administrator: &armmongocluster.AdministratorProperties{
+										UserName: to.Ptr("mongoAdmin"),
+									},
+									ServerVersion: to.Ptr("5.0"),
+									Storage: &armmongocluster.StorageProperties{
+										SizeGb: to.Ptr[int64](128),
+									},
+									Compute: &armmongocluster.ComputeProperties{
+										Tier: to.Ptr("M30"),
+									},
+									Sharding: &armmongocluster.ShardingProperties{
+										ShardCount: to.Ptr[int32](3),
+									},
+									HighAvailability: &armmongocluster.HighAvailabilityProperties{
+										TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+									},
+									Backup: &armmongocluster.BackupProperties{
+										EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+									},
+									PreviewFeatures: []*armmongocluster.PreviewFeature{
+										to.Ptr(armmongocluster.PreviewFeatureGeoReplicas),
+									},
+									InfrastructureVersion: to.Ptr("2.0"),
+									PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+									ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myReplicaMongoCluster.mongocluster.cosmos.azure.com"),
+									Replica: &armmongocluster.ReplicationProperties{
+										Role:             to.Ptr(armmongocluster.ReplicationRoleGeoAsyncReplica),
+										SourceResourceID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/mySourceMongoCluster"),
+										ReplicationState: to.Ptr(armmongocluster.ReplicationStateProvisioning),
+									},
+								},
+								Location: to.Ptr("centralus"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -190,60 +273,69 @@ func ExampleMongoClustersClient_BeginCreateOrUpdate_createsAReplicaMongoClusterR
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientCreateOrUpdateResponse{
-	// 	MongoCluster: armmongocluster.MongoCluster{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myReplicaMongoCluster"),
-	// 		Name: to.Ptr("myReplicaMongoCluster"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-	// 		Properties: &armmongocluster.Properties{
-	// 			ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-	// 			Administrator: &armmongocluster.AdministratorProperties{
-	// 				UserName: to.Ptr("mongoAdmin"),
-	// 			},
-	// 			ServerVersion: to.Ptr("5.0"),
-	// 			Storage: &armmongocluster.StorageProperties{
-	// 				SizeGb: to.Ptr[int64](128),
-	// 			},
-	// 			Compute: &armmongocluster.ComputeProperties{
-	// 				Tier: to.Ptr("M30"),
-	// 			},
-	// 			Sharding: &armmongocluster.ShardingProperties{
-	// 				ShardCount: to.Ptr[int32](3),
-	// 			},
-	// 			HighAvailability: &armmongocluster.HighAvailabilityProperties{
-	// 				TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-	// 			},
-	// 			Backup: &armmongocluster.BackupProperties{
-	// 				EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-	// 			},
-	// 			PreviewFeatures: []*armmongocluster.PreviewFeature{
-	// 				to.Ptr(armmongocluster.PreviewFeatureGeoReplicas),
-	// 			},
-	// 			InfrastructureVersion: to.Ptr("2.0"),
-	// 			PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-	// 			ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myReplicaMongoCluster.mongocluster.cosmos.azure.com"),
-	// 			Replica: &armmongocluster.ReplicationProperties{
-	// 				Role: to.Ptr(armmongocluster.ReplicationRoleGeoAsyncReplica),
-	// 				SourceResourceID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/mySourceMongoCluster"),
-	// 				ReplicationState: to.Ptr(armmongocluster.ReplicationStateProvisioning),
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("centralus"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myReplicaMongoCluster
+	// Name: myReplicaMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: centralus
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_CreatePITR.json
 func ExampleMongoClustersClient_BeginCreateOrUpdate_createsAMongoClusterResourceFromAPointInTimeRestore() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					BeginCreateOrUpdate: func(ctx context.Context, resourceGroupName string, mongoClusterName string, resource armmongocluster.MongoCluster, options *armmongocluster.MongoClustersClientBeginCreateOrUpdateOptions) (resp azfake.PollerResponder[armmongocluster.MongoClustersClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armmongocluster.MongoClustersClientCreateOrUpdateResponse{
+							MongoCluster: armmongocluster.MongoCluster{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
+								Name: to.Ptr("myMongoCluster"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+								Properties: &armmongocluster.Properties{
+									ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+									Administrator: &armmongocluster.AdministratorProperties{
+										UserName: to.Ptr("mongoAdmin"),
+									},
+									ServerVersion: to.Ptr("5.0"),
+									Storage: &armmongocluster.StorageProperties{
+										SizeGb: to.Ptr[int64](128),
+									},
+									Compute: &armmongocluster.ComputeProperties{
+										Tier: to.Ptr("M30"),
+									},
+									Sharding: &armmongocluster.ShardingProperties{
+										ShardCount: to.Ptr[int32](1),
+									},
+									HighAvailability: &armmongocluster.HighAvailabilityProperties{
+										TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+									},
+									Backup: &armmongocluster.BackupProperties{
+										EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+									},
+									PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+									InfrastructureVersion: to.Ptr("2.0"),
+									PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+									ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+									Replica: &armmongocluster.ReplicationProperties{
+										Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
+									},
+								},
+								Location: to.Ptr("westus2"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -264,57 +356,33 @@ func ExampleMongoClustersClient_BeginCreateOrUpdate_createsAMongoClusterResource
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientCreateOrUpdateResponse{
-	// 	MongoCluster: armmongocluster.MongoCluster{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
-	// 		Name: to.Ptr("myMongoCluster"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-	// 		Properties: &armmongocluster.Properties{
-	// 			ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-	// 			Administrator: &armmongocluster.AdministratorProperties{
-	// 				UserName: to.Ptr("mongoAdmin"),
-	// 			},
-	// 			ServerVersion: to.Ptr("5.0"),
-	// 			Storage: &armmongocluster.StorageProperties{
-	// 				SizeGb: to.Ptr[int64](128),
-	// 			},
-	// 			Compute: &armmongocluster.ComputeProperties{
-	// 				Tier: to.Ptr("M30"),
-	// 			},
-	// 			Sharding: &armmongocluster.ShardingProperties{
-	// 				ShardCount: to.Ptr[int32](1),
-	// 			},
-	// 			HighAvailability: &armmongocluster.HighAvailabilityProperties{
-	// 				TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-	// 			},
-	// 			Backup: &armmongocluster.BackupProperties{
-	// 				EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-	// 			},
-	// 			PreviewFeatures: []*armmongocluster.PreviewFeature{
-	// 			},
-	// 			InfrastructureVersion: to.Ptr("2.0"),
-	// 			PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-	// 			ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-	// 			Replica: &armmongocluster.ReplicationProperties{
-	// 				Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("westus2"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster
+	// Name: myMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: westus2
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_Delete.json
 func ExampleMongoClustersClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, mongoClusterName string, options *armmongocluster.MongoClustersClientBeginDeleteOptions) (resp azfake.PollerResponder[armmongocluster.MongoClustersClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armmongocluster.MongoClustersClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -326,16 +394,75 @@ func ExampleMongoClustersClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_Get.json
 func ExampleMongoClustersClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					Get: func(ctx context.Context, resourceGroupName string, mongoClusterName string, options *armmongocluster.MongoClustersClientGetOptions) (resp azfake.Responder[armmongocluster.MongoClustersClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armmongocluster.MongoClustersClientGetResponse{
+							MongoCluster: armmongocluster.MongoCluster{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
+								Name: to.Ptr("myMongoCluster"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+								Tags: map[string]*string{
+									"additionalProp1": to.Ptr("string"),
+									"additionalProp2": to.Ptr("string"),
+									"additionalProp3": to.Ptr("string"),
+								},
+								SystemData: &armmongocluster.SystemData{
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+								},
+								Properties: &armmongocluster.Properties{
+									ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+									Administrator: &armmongocluster.AdministratorProperties{
+										UserName: to.Ptr("mongoAdmin"),
+									},
+									ServerVersion: to.Ptr("5.0"),
+									Storage: &armmongocluster.StorageProperties{
+										SizeGb: to.Ptr[int64](128),
+									},
+									Compute: &armmongocluster.ComputeProperties{
+										Tier: to.Ptr("M30"),
+									},
+									Sharding: &armmongocluster.ShardingProperties{
+										ShardCount: to.Ptr[int32](4),
+									},
+									HighAvailability: &armmongocluster.HighAvailabilityProperties{
+										TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+									},
+									Backup: &armmongocluster.BackupProperties{
+										EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+									},
+									PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+									InfrastructureVersion: to.Ptr("2.0"),
+									PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+									ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+									Replica: &armmongocluster.ReplicationProperties{
+										ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
+										Role:             to.Ptr(armmongocluster.ReplicationRolePrimary),
+									},
+								},
+								Location: to.Ptr("westus2"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -343,71 +470,134 @@ func ExampleMongoClustersClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientGetResponse{
-	// 	MongoCluster: armmongocluster.MongoCluster{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
-	// 		Name: to.Ptr("myMongoCluster"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-	// 		Tags: map[string]*string{
-	// 			"additionalProp1": to.Ptr("string"),
-	// 			"additionalProp2": to.Ptr("string"),
-	// 			"additionalProp3": to.Ptr("string"),
-	// 		},
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-	// 		},
-	// 		Properties: &armmongocluster.Properties{
-	// 			ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-	// 			Administrator: &armmongocluster.AdministratorProperties{
-	// 				UserName: to.Ptr("mongoAdmin"),
-	// 			},
-	// 			ServerVersion: to.Ptr("5.0"),
-	// 			Storage: &armmongocluster.StorageProperties{
-	// 				SizeGb: to.Ptr[int64](128),
-	// 			},
-	// 			Compute: &armmongocluster.ComputeProperties{
-	// 				Tier: to.Ptr("M30"),
-	// 			},
-	// 			Sharding: &armmongocluster.ShardingProperties{
-	// 				ShardCount: to.Ptr[int32](4),
-	// 			},
-	// 			HighAvailability: &armmongocluster.HighAvailabilityProperties{
-	// 				TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-	// 			},
-	// 			Backup: &armmongocluster.BackupProperties{
-	// 				EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-	// 			},
-	// 			PreviewFeatures: []*armmongocluster.PreviewFeature{
-	// 			},
-	// 			InfrastructureVersion: to.Ptr("2.0"),
-	// 			PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-	// 			ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-	// 			Replica: &armmongocluster.ReplicationProperties{
-	// 				ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
-	// 				Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("westus2"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster
+	// Name: myMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: westus2
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_List.json
 func ExampleMongoClustersClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					NewListPager: func(options *armmongocluster.MongoClustersClientListOptions) (resp azfake.PagerResponder[armmongocluster.MongoClustersClientListResponse]) {
+						resp.AddPage(http.StatusOK, armmongocluster.MongoClustersClientListResponse{
+							ListResult: armmongocluster.ListResult{
+								Value: []*armmongocluster.MongoCluster{
+									{
+										ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
+										Name: to.Ptr("myMongoCluster"),
+										Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+										Tags: map[string]*string{
+											"additionalProp1": to.Ptr("string"),
+											"additionalProp2": to.Ptr("string"),
+											"additionalProp3": to.Ptr("string"),
+										},
+										SystemData: &armmongocluster.SystemData{
+											CreatedBy:          to.Ptr("user1"),
+											CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+										},
+										Properties: &armmongocluster.Properties{
+											ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+											Administrator: &armmongocluster.AdministratorProperties{
+												UserName: to.Ptr("mongoAdmin"),
+											},
+											ServerVersion: to.Ptr("5.0"),
+											Storage: &armmongocluster.StorageProperties{
+												SizeGb: to.Ptr[int64](128),
+											},
+											Compute: &armmongocluster.ComputeProperties{
+												Tier: to.Ptr("M30"),
+											},
+											Sharding: &armmongocluster.ShardingProperties{
+												ShardCount: to.Ptr[int32](4),
+											},
+											HighAvailability: &armmongocluster.HighAvailabilityProperties{
+												TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+											},
+											Backup: &armmongocluster.BackupProperties{
+												EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+											},
+											PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+											InfrastructureVersion: to.Ptr("2.0"),
+											PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+											ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+											Replica: &armmongocluster.ReplicationProperties{
+												ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
+												Role:             to.Ptr(armmongocluster.ReplicationRolePrimary),
+											},
+										},
+										Location: to.Ptr("westus2"),
+									},
+									{
+										ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster2"),
+										Name: to.Ptr("myMongoCluster2"),
+										Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+										Tags: map[string]*string{
+											"additionalProp1": to.Ptr("string"),
+										},
+										SystemData: &armmongocluster.SystemData{
+											CreatedBy:          to.Ptr("user2"),
+											CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-02-01T17:18:19.1234567Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-02-02T17:18:19.1234567Z"); return t }()),
+										},
+										Properties: &armmongocluster.Properties{
+											ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+											Administrator: &armmongocluster.AdministratorProperties{
+												UserName: to.Ptr("mongoAdmin"),
+											},
+											ServerVersion: to.Ptr("5.0"),
+											Storage: &armmongocluster.StorageProperties{
+												SizeGb: to.Ptr[int64](256),
+											},
+											Compute: &armmongocluster.ComputeProperties{
+												Tier: to.Ptr("M40"),
+											},
+											Sharding: &armmongocluster.ShardingProperties{
+												ShardCount: to.Ptr[int32](2),
+											},
+											HighAvailability: &armmongocluster.HighAvailabilityProperties{
+												TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+											},
+											Backup: &armmongocluster.BackupProperties{
+												EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+											},
+											PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+											InfrastructureVersion: to.Ptr("1.0"),
+											PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+											ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+											Replica: &armmongocluster.ReplicationProperties{
+												ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
+												Role:             to.Ptr(armmongocluster.ReplicationRolePrimary),
+											},
+										},
+										Location: to.Ptr("eastus"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -418,125 +608,140 @@ func ExampleMongoClustersClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armmongocluster.MongoClustersClientListResponse{
-		// 	ListResult: armmongocluster.ListResult{
-		// 		Value: []*armmongocluster.MongoCluster{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
-		// 				Name: to.Ptr("myMongoCluster"),
-		// 				Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-		// 				Tags: map[string]*string{
-		// 					"additionalProp1": to.Ptr("string"),
-		// 					"additionalProp2": to.Ptr("string"),
-		// 					"additionalProp3": to.Ptr("string"),
-		// 				},
-		// 				SystemData: &armmongocluster.SystemData{
-		// 					CreatedBy: to.Ptr("user1"),
-		// 					CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-		// 				},
-		// 				Properties: &armmongocluster.Properties{
-		// 					ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-		// 					Administrator: &armmongocluster.AdministratorProperties{
-		// 						UserName: to.Ptr("mongoAdmin"),
-		// 					},
-		// 					ServerVersion: to.Ptr("5.0"),
-		// 					Storage: &armmongocluster.StorageProperties{
-		// 						SizeGb: to.Ptr[int64](128),
-		// 					},
-		// 					Compute: &armmongocluster.ComputeProperties{
-		// 						Tier: to.Ptr("M30"),
-		// 					},
-		// 					Sharding: &armmongocluster.ShardingProperties{
-		// 						ShardCount: to.Ptr[int32](4),
-		// 					},
-		// 					HighAvailability: &armmongocluster.HighAvailabilityProperties{
-		// 						TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-		// 					},
-		// 					Backup: &armmongocluster.BackupProperties{
-		// 						EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-		// 					},
-		// 					PreviewFeatures: []*armmongocluster.PreviewFeature{
-		// 					},
-		// 					InfrastructureVersion: to.Ptr("2.0"),
-		// 					PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-		// 					ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-		// 					Replica: &armmongocluster.ReplicationProperties{
-		// 						ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
-		// 						Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("westus2"),
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster2"),
-		// 				Name: to.Ptr("myMongoCluster2"),
-		// 				Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-		// 				Tags: map[string]*string{
-		// 					"additionalProp1": to.Ptr("string"),
-		// 				},
-		// 				SystemData: &armmongocluster.SystemData{
-		// 					CreatedBy: to.Ptr("user2"),
-		// 					CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-02-01T17:18:19.1234567Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-02-02T17:18:19.1234567Z"); return t}()),
-		// 				},
-		// 				Properties: &armmongocluster.Properties{
-		// 					ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-		// 					Administrator: &armmongocluster.AdministratorProperties{
-		// 						UserName: to.Ptr("mongoAdmin"),
-		// 					},
-		// 					ServerVersion: to.Ptr("5.0"),
-		// 					Storage: &armmongocluster.StorageProperties{
-		// 						SizeGb: to.Ptr[int64](256),
-		// 					},
-		// 					Compute: &armmongocluster.ComputeProperties{
-		// 						Tier: to.Ptr("M40"),
-		// 					},
-		// 					Sharding: &armmongocluster.ShardingProperties{
-		// 						ShardCount: to.Ptr[int32](2),
-		// 					},
-		// 					HighAvailability: &armmongocluster.HighAvailabilityProperties{
-		// 						TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-		// 					},
-		// 					Backup: &armmongocluster.BackupProperties{
-		// 						EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-		// 					},
-		// 					PreviewFeatures: []*armmongocluster.PreviewFeature{
-		// 					},
-		// 					InfrastructureVersion: to.Ptr("1.0"),
-		// 					PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-		// 					ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-		// 					Replica: &armmongocluster.ReplicationProperties{
-		// 						ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
-		// 						Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("eastus"),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster
+	// Name: myMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: westus2
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster2
+	// Name: myMongoCluster2
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: eastus
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_ListByResourceGroup.json
 func ExampleMongoClustersClient_NewListByResourceGroupPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					NewListByResourceGroupPager: func(resourceGroupName string, options *armmongocluster.MongoClustersClientListByResourceGroupOptions) (resp azfake.PagerResponder[armmongocluster.MongoClustersClientListByResourceGroupResponse]) {
+						resp.AddPage(http.StatusOK, armmongocluster.MongoClustersClientListByResourceGroupResponse{
+							ListResult: armmongocluster.ListResult{
+								Value: []*armmongocluster.MongoCluster{
+									{
+										ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
+										Name: to.Ptr("myMongoCluster"),
+										Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+										Tags: map[string]*string{
+											"additionalProp1": to.Ptr("string"),
+											"additionalProp2": to.Ptr("string"),
+											"additionalProp3": to.Ptr("string"),
+										},
+										SystemData: &armmongocluster.SystemData{
+											CreatedBy:          to.Ptr("user1"),
+											CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+										},
+										Properties: &armmongocluster.Properties{
+											ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+											Administrator: &armmongocluster.AdministratorProperties{
+												UserName: to.Ptr("mongoAdmin"),
+											},
+											ServerVersion: to.Ptr("5.0"),
+											Storage: &armmongocluster.StorageProperties{
+												SizeGb: to.Ptr[int64](128),
+											},
+											Compute: &armmongocluster.ComputeProperties{
+												Tier: to.Ptr("M30"),
+											},
+											Sharding: &armmongocluster.ShardingProperties{
+												ShardCount: to.Ptr[int32](4),
+											},
+											HighAvailability: &armmongocluster.HighAvailabilityProperties{
+												TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+											},
+											Backup: &armmongocluster.BackupProperties{
+												EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+											},
+											PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+											InfrastructureVersion: to.Ptr("2.0"),
+											PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+											ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+											Replica: &armmongocluster.ReplicationProperties{
+												ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
+												Role:             to.Ptr(armmongocluster.ReplicationRolePrimary),
+											},
+										},
+										Location: to.Ptr("westus2"),
+									},
+									{
+										ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster2"),
+										Name: to.Ptr("myMongoCluster2"),
+										Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+										Tags: map[string]*string{
+											"additionalProp1": to.Ptr("string"),
+										},
+										SystemData: &armmongocluster.SystemData{
+											CreatedBy:          to.Ptr("user2"),
+											CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-02-01T17:18:19.1234567Z"); return t }()),
+											LastModifiedBy:     to.Ptr("user2"),
+											LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-02-02T17:18:19.1234567Z"); return t }()),
+										},
+										Properties: &armmongocluster.Properties{
+											ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+											Administrator: &armmongocluster.AdministratorProperties{
+												UserName: to.Ptr("mongoAdmin"),
+											},
+											ServerVersion: to.Ptr("5.0"),
+											Storage: &armmongocluster.StorageProperties{
+												SizeGb: to.Ptr[int64](256),
+											},
+											Compute: &armmongocluster.ComputeProperties{
+												Tier: to.Ptr("M40"),
+											},
+											Sharding: &armmongocluster.ShardingProperties{
+												ShardCount: to.Ptr[int32](2),
+											},
+											HighAvailability: &armmongocluster.HighAvailabilityProperties{
+												TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+											},
+											Backup: &armmongocluster.BackupProperties{
+												EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+											},
+											PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+											InfrastructureVersion: to.Ptr("1.0"),
+											PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+											ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster2.mongocluster.cosmos.azure.com"),
+											Replica: &armmongocluster.ReplicationProperties{
+												ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
+												Role:             to.Ptr(armmongocluster.ReplicationRolePrimary),
+											},
+										},
+										Location: to.Ptr("eastus"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -547,125 +752,48 @@ func ExampleMongoClustersClient_NewListByResourceGroupPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armmongocluster.MongoClustersClientListByResourceGroupResponse{
-		// 	ListResult: armmongocluster.ListResult{
-		// 		Value: []*armmongocluster.MongoCluster{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
-		// 				Name: to.Ptr("myMongoCluster"),
-		// 				Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-		// 				Tags: map[string]*string{
-		// 					"additionalProp1": to.Ptr("string"),
-		// 					"additionalProp2": to.Ptr("string"),
-		// 					"additionalProp3": to.Ptr("string"),
-		// 				},
-		// 				SystemData: &armmongocluster.SystemData{
-		// 					CreatedBy: to.Ptr("user1"),
-		// 					CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-		// 				},
-		// 				Properties: &armmongocluster.Properties{
-		// 					ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-		// 					Administrator: &armmongocluster.AdministratorProperties{
-		// 						UserName: to.Ptr("mongoAdmin"),
-		// 					},
-		// 					ServerVersion: to.Ptr("5.0"),
-		// 					Storage: &armmongocluster.StorageProperties{
-		// 						SizeGb: to.Ptr[int64](128),
-		// 					},
-		// 					Compute: &armmongocluster.ComputeProperties{
-		// 						Tier: to.Ptr("M30"),
-		// 					},
-		// 					Sharding: &armmongocluster.ShardingProperties{
-		// 						ShardCount: to.Ptr[int32](4),
-		// 					},
-		// 					HighAvailability: &armmongocluster.HighAvailabilityProperties{
-		// 						TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-		// 					},
-		// 					Backup: &armmongocluster.BackupProperties{
-		// 						EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-		// 					},
-		// 					PreviewFeatures: []*armmongocluster.PreviewFeature{
-		// 					},
-		// 					InfrastructureVersion: to.Ptr("2.0"),
-		// 					PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-		// 					ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-		// 					Replica: &armmongocluster.ReplicationProperties{
-		// 						ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
-		// 						Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("westus2"),
-		// 			},
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster2"),
-		// 				Name: to.Ptr("myMongoCluster2"),
-		// 				Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-		// 				Tags: map[string]*string{
-		// 					"additionalProp1": to.Ptr("string"),
-		// 				},
-		// 				SystemData: &armmongocluster.SystemData{
-		// 					CreatedBy: to.Ptr("user2"),
-		// 					CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-02-01T17:18:19.1234567Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("user2"),
-		// 					LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-02-02T17:18:19.1234567Z"); return t}()),
-		// 				},
-		// 				Properties: &armmongocluster.Properties{
-		// 					ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-		// 					Administrator: &armmongocluster.AdministratorProperties{
-		// 						UserName: to.Ptr("mongoAdmin"),
-		// 					},
-		// 					ServerVersion: to.Ptr("5.0"),
-		// 					Storage: &armmongocluster.StorageProperties{
-		// 						SizeGb: to.Ptr[int64](256),
-		// 					},
-		// 					Compute: &armmongocluster.ComputeProperties{
-		// 						Tier: to.Ptr("M40"),
-		// 					},
-		// 					Sharding: &armmongocluster.ShardingProperties{
-		// 						ShardCount: to.Ptr[int32](2),
-		// 					},
-		// 					HighAvailability: &armmongocluster.HighAvailabilityProperties{
-		// 						TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-		// 					},
-		// 					Backup: &armmongocluster.BackupProperties{
-		// 						EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-		// 					},
-		// 					PreviewFeatures: []*armmongocluster.PreviewFeature{
-		// 					},
-		// 					InfrastructureVersion: to.Ptr("1.0"),
-		// 					PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-		// 					ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster2.mongocluster.cosmos.azure.com"),
-		// 					Replica: &armmongocluster.ReplicationProperties{
-		// 						ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
-		// 						Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-		// 					},
-		// 				},
-		// 				Location: to.Ptr("eastus"),
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster
+	// Name: myMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: westus2
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster2
+	// Name: myMongoCluster2
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: eastus
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_ListConnectionStrings.json
 func ExampleMongoClustersClient_ListConnectionStrings() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					ListConnectionStrings: func(ctx context.Context, resourceGroupName string, mongoClusterName string, options *armmongocluster.MongoClustersClientListConnectionStringsOptions) (resp azfake.Responder[armmongocluster.MongoClustersClientListConnectionStringsResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armmongocluster.MongoClustersClientListConnectionStringsResponse{
+							ListConnectionStringsResult: armmongocluster.ListConnectionStringsResult{
+								ConnectionStrings: []*armmongocluster.ConnectionString{
+									{
+										ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+										Description:      to.Ptr("default connection string"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -675,27 +803,25 @@ func ExampleMongoClustersClient_ListConnectionStrings() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientListConnectionStringsResponse{
-	// 	ListConnectionStringsResult: armmongocluster.ListConnectionStringsResult{
-	// 		ConnectionStrings: []*armmongocluster.ConnectionString{
-	// 			{
-	// 				ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-	// 				Description: to.Ptr("default connection string"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_ForcePromoteReplica.json
 func ExampleMongoClustersClient_BeginPromote() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					BeginPromote: func(ctx context.Context, resourceGroupName string, mongoClusterName string, body armmongocluster.PromoteReplicaRequest, options *armmongocluster.MongoClustersClientBeginPromoteOptions) (resp azfake.PollerResponder[armmongocluster.MongoClustersClientPromoteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armmongocluster.MongoClustersClientPromoteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -710,16 +836,69 @@ func ExampleMongoClustersClient_BeginPromote() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_PatchDiskSize.json
 func ExampleMongoClustersClient_BeginUpdate_updatesTheDiskSizeOnAMongoClusterResource() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, mongoClusterName string, properties armmongocluster.Update, options *armmongocluster.MongoClustersClientBeginUpdateOptions) (resp azfake.PollerResponder[armmongocluster.MongoClustersClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armmongocluster.MongoClustersClientUpdateResponse{
+							MongoCluster: armmongocluster.MongoCluster{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
+								Name: to.Ptr("myMongoCluster"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+								SystemData: &armmongocluster.SystemData{
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+								},
+								Properties: &armmongocluster.Properties{
+									Administrator: &armmongocluster.AdministratorProperties{
+										UserName: to.Ptr("mongoAdmin"),
+									},
+									ServerVersion: to.Ptr("5.0"),
+									Storage: &armmongocluster.StorageProperties{
+										SizeGb: to.Ptr[int64](256),
+									},
+									Compute: &armmongocluster.ComputeProperties{
+										Tier: to.Ptr("M30"),
+									},
+									Sharding: &armmongocluster.ShardingProperties{
+										ShardCount: to.Ptr[int32](4),
+									},
+									HighAvailability: &armmongocluster.HighAvailabilityProperties{
+										TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+									},
+									Backup: &armmongocluster.BackupProperties{
+										EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+									},
+									PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+									InfrastructureVersion: to.Ptr("2.0"),
+									PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+									ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+									Replica: &armmongocluster.ReplicationProperties{
+										ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
+										Role:             to.Ptr(armmongocluster.ReplicationRolePrimary),
+									},
+								},
+								Location: to.Ptr("westus2"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -737,65 +916,103 @@ func ExampleMongoClustersClient_BeginUpdate_updatesTheDiskSizeOnAMongoClusterRes
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientUpdateResponse{
-	// 	MongoCluster: armmongocluster.MongoCluster{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
-	// 		Name: to.Ptr("myMongoCluster"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-	// 		},
-	// 		Properties: &armmongocluster.Properties{
-	// 			Administrator: &armmongocluster.AdministratorProperties{
-	// 				UserName: to.Ptr("mongoAdmin"),
-	// 			},
-	// 			ServerVersion: to.Ptr("5.0"),
-	// 			Storage: &armmongocluster.StorageProperties{
-	// 				SizeGb: to.Ptr[int64](256),
-	// 			},
-	// 			Compute: &armmongocluster.ComputeProperties{
-	// 				Tier: to.Ptr("M30"),
-	// 			},
-	// 			Sharding: &armmongocluster.ShardingProperties{
-	// 				ShardCount: to.Ptr[int32](4),
-	// 			},
-	// 			HighAvailability: &armmongocluster.HighAvailabilityProperties{
-	// 				TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-	// 			},
-	// 			Backup: &armmongocluster.BackupProperties{
-	// 				EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-	// 			},
-	// 			PreviewFeatures: []*armmongocluster.PreviewFeature{
-	// 			},
-	// 			InfrastructureVersion: to.Ptr("2.0"),
-	// 			PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-	// 			ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-	// 			Replica: &armmongocluster.ReplicationProperties{
-	// 				ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
-	// 				Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("westus2"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster
+	// Name: myMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: westus2
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_PatchPrivateNetworkAccess.json
 func ExampleMongoClustersClient_BeginUpdate_disablesPublicNetworkAccessOnAMongoClusterResourceWithAPrivateEndpointConnection() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, mongoClusterName string, properties armmongocluster.Update, options *armmongocluster.MongoClustersClientBeginUpdateOptions) (resp azfake.PollerResponder[armmongocluster.MongoClustersClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armmongocluster.MongoClustersClientUpdateResponse{
+							MongoCluster: armmongocluster.MongoCluster{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
+								Name: to.Ptr("myMongoCluster"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+								SystemData: &armmongocluster.SystemData{
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+								},
+								Properties: &armmongocluster.Properties{
+									Administrator: &armmongocluster.AdministratorProperties{
+										UserName: to.Ptr("mongoAdmin"),
+									},
+									ServerVersion: to.Ptr("5.0"),
+									Storage: &armmongocluster.StorageProperties{
+										SizeGb: to.Ptr[int64](256),
+									},
+									Compute: &armmongocluster.ComputeProperties{
+										Tier: to.Ptr("M30"),
+									},
+									Sharding: &armmongocluster.ShardingProperties{
+										ShardCount: to.Ptr[int32](4),
+									},
+									HighAvailability: &armmongocluster.HighAvailabilityProperties{
+										TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+									},
+									Backup: &armmongocluster.BackupProperties{
+										EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+									},
+									PrivateEndpointConnections: []*armmongocluster.PrivateEndpointConnection{
+										{
+											ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/mymongocluster-pe.ffffffff-ffff-ffff-ffff-ffffffffffff"),
+											SystemData: &armmongocluster.SystemData{
+												CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-06T04:00:25.0509765Z"); return t }()),
+												CreatedBy:          to.Ptr("ffffffff-ffff-ffff-ffff-ffffffffffff"),
+												CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeApplication),
+												LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-06T04:00:25.0509765Z"); return t }()),
+												LastModifiedBy:     to.Ptr("ffffffff-ffff-ffff-ffff-ffffffffffff"),
+												LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
+											},
+											Properties: &armmongocluster.PrivateEndpointConnectionProperties{
+												PrivateEndpoint: &armmongocluster.PrivateEndpoint{
+													ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.Network/privateEndpoints/mymongocluster-pe"),
+												},
+												GroupIDs: []*string{
+													to.Ptr("MongoCluster"),
+												},
+												PrivateLinkServiceConnectionState: &armmongocluster.PrivateLinkServiceConnectionState{
+													Status:          to.Ptr(armmongocluster.PrivateEndpointServiceConnectionStatusApproved),
+													Description:     to.Ptr("Auto-approved"),
+													ActionsRequired: to.Ptr("None"),
+												},
+											},
+										},
+									},
+									PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+									InfrastructureVersion: to.Ptr("2.0"),
+									PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessDisabled),
+									ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+									Replica: &armmongocluster.ReplicationProperties{
+										ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
+										Role:             to.Ptr(armmongocluster.ReplicationRolePrimary),
+									},
+								},
+								Location: to.Ptr("westus2"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -811,91 +1028,77 @@ func ExampleMongoClustersClient_BeginUpdate_disablesPublicNetworkAccessOnAMongoC
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientUpdateResponse{
-	// 	MongoCluster: armmongocluster.MongoCluster{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
-	// 		Name: to.Ptr("myMongoCluster"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-	// 		},
-	// 		Properties: &armmongocluster.Properties{
-	// 			Administrator: &armmongocluster.AdministratorProperties{
-	// 				UserName: to.Ptr("mongoAdmin"),
-	// 			},
-	// 			ServerVersion: to.Ptr("5.0"),
-	// 			Storage: &armmongocluster.StorageProperties{
-	// 				SizeGb: to.Ptr[int64](256),
-	// 			},
-	// 			Compute: &armmongocluster.ComputeProperties{
-	// 				Tier: to.Ptr("M30"),
-	// 			},
-	// 			Sharding: &armmongocluster.ShardingProperties{
-	// 				ShardCount: to.Ptr[int32](4),
-	// 			},
-	// 			HighAvailability: &armmongocluster.HighAvailabilityProperties{
-	// 				TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-	// 			},
-	// 			Backup: &armmongocluster.BackupProperties{
-	// 				EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-	// 			},
-	// 			PrivateEndpointConnections: []*armmongocluster.PrivateEndpointConnection{
-	// 				{
-	// 					ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/mymongocluster-pe.ffffffff-ffff-ffff-ffff-ffffffffffff"),
-	// 					SystemData: &armmongocluster.SystemData{
-	// 						CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-06T04:00:25.0509765Z"); return t}()),
-	// 						CreatedBy: to.Ptr("ffffffff-ffff-ffff-ffff-ffffffffffff"),
-	// 						CreatedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
-	// 						LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-06T04:00:25.0509765Z"); return t}()),
-	// 						LastModifiedBy: to.Ptr("ffffffff-ffff-ffff-ffff-ffffffffffff"),
-	// 						LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
-	// 					},
-	// 					Properties: &armmongocluster.PrivateEndpointConnectionProperties{
-	// 						PrivateEndpoint: &armmongocluster.PrivateEndpoint{
-	// 							ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.Network/privateEndpoints/mymongocluster-pe"),
-	// 						},
-	// 						GroupIDs: []*string{
-	// 							to.Ptr("MongoCluster"),
-	// 						},
-	// 						PrivateLinkServiceConnectionState: &armmongocluster.PrivateLinkServiceConnectionState{
-	// 							Status: to.Ptr(armmongocluster.PrivateEndpointServiceConnectionStatusApproved),
-	// 							Description: to.Ptr("Auto-approved"),
-	// 							ActionsRequired: to.Ptr("None"),
-	// 						},
-	// 					},
-	// 				},
-	// 			},
-	// 			PreviewFeatures: []*armmongocluster.PreviewFeature{
-	// 			},
-	// 			InfrastructureVersion: to.Ptr("2.0"),
-	// 			PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessDisabled),
-	// 			ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-	// 			Replica: &armmongocluster.ReplicationProperties{
-	// 				ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
-	// 				Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("westus2"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster
+	// Name: myMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: westus2
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_ResetPassword.json
 func ExampleMongoClustersClient_BeginUpdate_resetsTheAdministratorLoginPassword() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, mongoClusterName string, properties armmongocluster.Update, options *armmongocluster.MongoClustersClientBeginUpdateOptions) (resp azfake.PollerResponder[armmongocluster.MongoClustersClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armmongocluster.MongoClustersClientUpdateResponse{
+							MongoCluster: armmongocluster.MongoCluster{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
+								Name: to.Ptr("myMongoCluster"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+								SystemData: &armmongocluster.SystemData{
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+								},
+								Properties: &armmongocluster.Properties{
+									Administrator: &armmongocluster.AdministratorProperties{
+										UserName: to.Ptr("mongoAdmin"),
+									},
+									ServerVersion: to.Ptr("5.0"),
+									Storage: &armmongocluster.StorageProperties{
+										SizeGb: to.Ptr[int64](256),
+									},
+									Compute: &armmongocluster.ComputeProperties{
+										Tier: to.Ptr("M30"),
+									},
+									Sharding: &armmongocluster.ShardingProperties{
+										ShardCount: to.Ptr[int32](4),
+									},
+									HighAvailability: &armmongocluster.HighAvailabilityProperties{
+										TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+									},
+									Backup: &armmongocluster.BackupProperties{
+										EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+									},
+									PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+									InfrastructureVersion: to.Ptr("2.0"),
+									PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+									ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+									Replica: &armmongocluster.ReplicationProperties{
+										ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
+										Role:             to.Ptr(armmongocluster.ReplicationRolePrimary),
+									},
+								},
+								Location: to.Ptr("westus2"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -914,65 +1117,78 @@ func ExampleMongoClustersClient_BeginUpdate_resetsTheAdministratorLoginPassword(
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientUpdateResponse{
-	// 	MongoCluster: armmongocluster.MongoCluster{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
-	// 		Name: to.Ptr("myMongoCluster"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-	// 		},
-	// 		Properties: &armmongocluster.Properties{
-	// 			Administrator: &armmongocluster.AdministratorProperties{
-	// 				UserName: to.Ptr("mongoAdmin"),
-	// 			},
-	// 			ServerVersion: to.Ptr("5.0"),
-	// 			Storage: &armmongocluster.StorageProperties{
-	// 				SizeGb: to.Ptr[int64](256),
-	// 			},
-	// 			Compute: &armmongocluster.ComputeProperties{
-	// 				Tier: to.Ptr("M30"),
-	// 			},
-	// 			Sharding: &armmongocluster.ShardingProperties{
-	// 				ShardCount: to.Ptr[int32](4),
-	// 			},
-	// 			HighAvailability: &armmongocluster.HighAvailabilityProperties{
-	// 				TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-	// 			},
-	// 			Backup: &armmongocluster.BackupProperties{
-	// 				EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-	// 			},
-	// 			PreviewFeatures: []*armmongocluster.PreviewFeature{
-	// 			},
-	// 			InfrastructureVersion: to.Ptr("2.0"),
-	// 			PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-	// 			ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-	// 			Replica: &armmongocluster.ReplicationProperties{
-	// 				ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
-	// 				Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("westus2"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster
+	// Name: myMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: westus2
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_Update.json
 func ExampleMongoClustersClient_BeginUpdate_updatesAMongoClusterResource() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MongoClustersServer: fake.MongoClustersServer{
+					BeginUpdate: func(ctx context.Context, resourceGroupName string, mongoClusterName string, properties armmongocluster.Update, options *armmongocluster.MongoClustersClientBeginUpdateOptions) (resp azfake.PollerResponder[armmongocluster.MongoClustersClientUpdateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armmongocluster.MongoClustersClientUpdateResponse{
+							MongoCluster: armmongocluster.MongoCluster{
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
+								Name: to.Ptr("myMongoCluster"),
+								Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+								SystemData: &armmongocluster.SystemData{
+									CreatedBy:          to.Ptr("user1"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeUser),
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t }()),
+									LastModifiedBy:     to.Ptr("user2"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t }()),
+								},
+								Properties: &armmongocluster.Properties{
+									ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
+									Administrator: &armmongocluster.AdministratorProperties{
+										UserName: to.Ptr("mongoAdmin"),
+									},
+									ServerVersion: to.Ptr("5.0"),
+									Storage: &armmongocluster.StorageProperties{
+										SizeGb: to.Ptr[int64](256),
+									},
+									Compute: &armmongocluster.ComputeProperties{
+										Tier: to.Ptr("M50"),
+									},
+									Sharding: &armmongocluster.ShardingProperties{
+										ShardCount: to.Ptr[int32](4),
+									},
+									HighAvailability: &armmongocluster.HighAvailabilityProperties{
+										TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
+									},
+									Backup: &armmongocluster.BackupProperties{
+										EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
+									},
+									PreviewFeatures:       []*armmongocluster.PreviewFeature{},
+									InfrastructureVersion: to.Ptr("2.0"),
+									PublicNetworkAccess:   to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
+									ConnectionString:      to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
+									Replica: &armmongocluster.ReplicationProperties{
+										ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
+										Role:             to.Ptr(armmongocluster.ReplicationRolePrimary),
+									},
+								},
+								Location: to.Ptr("westus2"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -1005,54 +1221,13 @@ func ExampleMongoClustersClient_BeginUpdate_updatesAMongoClusterResource() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.MongoClustersClientUpdateResponse{
-	// 	MongoCluster: armmongocluster.MongoCluster{
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster"),
-	// 		Name: to.Ptr("myMongoCluster"),
-	// 		Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedBy: to.Ptr("user1"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-01T17:18:19.1234567Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("user2"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeUser),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2020-01-02T17:18:19.1234567Z"); return t}()),
-	// 		},
-	// 		Properties: &armmongocluster.Properties{
-	// 			ProvisioningState: to.Ptr(armmongocluster.ProvisioningStateSucceeded),
-	// 			Administrator: &armmongocluster.AdministratorProperties{
-	// 				UserName: to.Ptr("mongoAdmin"),
-	// 			},
-	// 			ServerVersion: to.Ptr("5.0"),
-	// 			Storage: &armmongocluster.StorageProperties{
-	// 				SizeGb: to.Ptr[int64](256),
-	// 			},
-	// 			Compute: &armmongocluster.ComputeProperties{
-	// 				Tier: to.Ptr("M50"),
-	// 			},
-	// 			Sharding: &armmongocluster.ShardingProperties{
-	// 				ShardCount: to.Ptr[int32](4),
-	// 			},
-	// 			HighAvailability: &armmongocluster.HighAvailabilityProperties{
-	// 				TargetMode: to.Ptr(armmongocluster.HighAvailabilityModeSameZone),
-	// 			},
-	// 			Backup: &armmongocluster.BackupProperties{
-	// 				EarliestRestoreTime: to.Ptr("2023-01-13T20:07:35Z"),
-	// 			},
-	// 			PreviewFeatures: []*armmongocluster.PreviewFeature{
-	// 			},
-	// 			InfrastructureVersion: to.Ptr("2.0"),
-	// 			PublicNetworkAccess: to.Ptr(armmongocluster.PublicNetworkAccessEnabled),
-	// 			ConnectionString: to.Ptr("mongodb+srv://<user>:<password>@myMongoCluster.mongocluster.cosmos.azure.com"),
-	// 			Replica: &armmongocluster.ReplicationProperties{
-	// 				ReplicationState: to.Ptr(armmongocluster.ReplicationStateActive),
-	// 				Role: to.Ptr(armmongocluster.ReplicationRolePrimary),
-	// 			},
-	// 		},
-	// 		Location: to.Ptr("westus2"),
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster
+	// Name: myMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
+	// Location: westus2
 }
@@ -6,19 +6,56 @@ package armmongocluster_test
 
 import (
 	"armmongocluster"
+	"armmongocluster/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-07-01/Operations_List.json
 func ExampleOperationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armmongocluster.OperationsClientListOptions) (resp azfake.PagerResponder[armmongocluster.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armmongocluster.OperationsClientListResponse{
+							OperationListResult: armmongocluster.OperationListResult{
+								Value: []*armmongocluster.Operation{
+									{
+										Name: to.Ptr("Microsoft.DocumentDB/mongoClusters/read"),
+										Display: &armmongocluster.OperationDisplay{
+											Provider:    to.Ptr("Microsoft.DocumentDB"),
+											Resource:    to.Ptr("Mongo Cluster"),
+											Operation:   to.Ptr("Read Mongo Clusters"),
+											Description: to.Ptr("Reads a Mongo Cluster or list all Mongo Clusters."),
+										},
+									},
+									{
+										Name: to.Ptr("Microsoft.DocumentDB/mongoClusters/write"),
+										Display: &armmongocluster.OperationDisplay{
+											Provider:    to.Ptr("Microsoft.DocumentDB"),
+											Resource:    to.Ptr("Mongo Cluster"),
+											Operation:   to.Ptr("Create or Update Mongo Cluster"),
+											Description: to.Ptr("Create or Update the properties or tags of the specified Mongo Cluster."),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,33 +66,10 @@ func ExampleOperationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armmongocluster.OperationsClientListResponse{
-		// 	OperationListResult: armmongocluster.OperationListResult{
-		// 		Value: []*armmongocluster.Operation{
-		// 			{
-		// 				Name: to.Ptr("Microsoft.DocumentDB/mongoClusters/read"),
-		// 				Display: &armmongocluster.OperationDisplay{
-		// 					Provider: to.Ptr("Microsoft.DocumentDB"),
-		// 					Resource: to.Ptr("Mongo Cluster"),
-		// 					Operation: to.Ptr("Read Mongo Clusters"),
-		// 					Description: to.Ptr("Reads a Mongo Cluster or list all Mongo Clusters."),
-		// 				},
-		// 			},
-		// 			{
-		// 				Name: to.Ptr("Microsoft.DocumentDB/mongoClusters/write"),
-		// 				Display: &armmongocluster.OperationDisplay{
-		// 					Provider: to.Ptr("Microsoft.DocumentDB"),
-		// 					Resource: to.Ptr("Mongo Cluster"),
-		// 					Operation: to.Ptr("Create or Update Mongo Cluster"),
-		// 					Description: to.Ptr("Create or Update the properties or tags of the specified Mongo Cluster."),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Microsoft.DocumentDB/mongoClusters/read
+	// Name: Microsoft.DocumentDB/mongoClusters/write
 }
@@ -6,20 +6,62 @@ package armmongocluster_test
 
 import (
 	"armmongocluster"
+	"armmongocluster/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
+	"time"
 )
 
 // Generated from example definition: 2024-07-01/MongoClusters_PrivateEndpointConnectionPut.json
 func ExamplePrivateEndpointConnectionsClient_BeginCreate() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					BeginCreate: func(ctx context.Context, resourceGroupName string, mongoClusterName string, privateEndpointConnectionName string, resource armmongocluster.PrivateEndpointConnectionResource, options *armmongocluster.PrivateEndpointConnectionsClientBeginCreateOptions) (resp azfake.PollerResponder[armmongocluster.PrivateEndpointConnectionsClientCreateResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armmongocluster.PrivateEndpointConnectionsClientCreateResponse{
+							PrivateEndpointConnectionResource: armmongocluster.PrivateEndpointConnectionResource{
+								Name: to.Ptr("pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
+								Type: to.Ptr("Microsoft.DocumentDB/mongoClusters/privateEndpointConnections"),
+								SystemData: &armmongocluster.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t }()),
+									CreatedBy:          to.Ptr("2df9eb86-36b5-49dc-86ae-9a63135bfa8c"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeApplication),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t }()),
+									LastModifiedBy:     to.Ptr("2ff9eb86-36b5-49dc-86ae-9a63135bfa8c"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
+								},
+								Properties: &armmongocluster.PrivateEndpointConnectionProperties{
+									ProvisioningState: to.Ptr(armmongocluster.PrivateEndpointConnectionProvisioningStateSucceeded),
+									PrivateEndpoint: &armmongocluster.PrivateEndpoint{
+										ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.Network/privateEndpoints/pecTest"),
+									},
+									GroupIDs: []*string{
+										to.Ptr("MongoCluster"),
+									},
+									PrivateLinkServiceConnectionState: &armmongocluster.PrivateLinkServiceConnectionState{
+										Status:          to.Ptr(armmongocluster.PrivateEndpointServiceConnectionStatusApproved),
+										Description:     to.Ptr("Approved by admin"),
+										ActionsRequired: to.Ptr("None"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -38,48 +80,31 @@ func ExamplePrivateEndpointConnectionsClient_BeginCreate() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.PrivateEndpointConnectionsClientCreateResponse{
-	// 	PrivateEndpointConnectionResource: armmongocluster.PrivateEndpointConnectionResource{
-	// 		Name: to.Ptr("pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
-	// 		Type: to.Ptr("Microsoft.DocumentDB/mongoClusters/privateEndpointConnections"),
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t}()),
-	// 			CreatedBy: to.Ptr("2df9eb86-36b5-49dc-86ae-9a63135bfa8c"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("2ff9eb86-36b5-49dc-86ae-9a63135bfa8c"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
-	// 		},
-	// 		Properties: &armmongocluster.PrivateEndpointConnectionProperties{
-	// 			ProvisioningState: to.Ptr(armmongocluster.PrivateEndpointConnectionProvisioningStateSucceeded),
-	// 			PrivateEndpoint: &armmongocluster.PrivateEndpoint{
-	// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.Network/privateEndpoints/pecTest"),
-	// 			},
-	// 			GroupIDs: []*string{
-	// 				to.Ptr("MongoCluster"),
-	// 			},
-	// 			PrivateLinkServiceConnectionState: &armmongocluster.PrivateLinkServiceConnectionState{
-	// 				Status: to.Ptr(armmongocluster.PrivateEndpointServiceConnectionStatusApproved),
-	// 				Description: to.Ptr("Approved by admin"),
-	// 				ActionsRequired: to.Ptr("None"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// Name: pecTest.5d393f64-ef64-46d0-9959-308321c44ac0
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/pecTest.5d393f64-ef64-46d0-9959-308321c44ac0
+	// Type: Microsoft.DocumentDB/mongoClusters/privateEndpointConnections
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_PrivateEndpointConnectionDelete.json
 func ExamplePrivateEndpointConnectionsClient_BeginDelete() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					BeginDelete: func(ctx context.Context, resourceGroupName string, mongoClusterName string, privateEndpointConnectionName string, options *armmongocluster.PrivateEndpointConnectionsClientBeginDeleteOptions) (resp azfake.PollerResponder[armmongocluster.PrivateEndpointConnectionsClientDeleteResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusAccepted, armmongocluster.PrivateEndpointConnectionsClientDeleteResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -91,16 +116,53 @@ func ExamplePrivateEndpointConnectionsClient_BeginDelete() {
 	if err != nil {
 		log.Fatalf("failed to poll the result: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_PrivateEndpointConnectionGet.json
 func ExamplePrivateEndpointConnectionsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					Get: func(ctx context.Context, resourceGroupName string, mongoClusterName string, privateEndpointConnectionName string, options *armmongocluster.PrivateEndpointConnectionsClientGetOptions) (resp azfake.Responder[armmongocluster.PrivateEndpointConnectionsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armmongocluster.PrivateEndpointConnectionsClientGetResponse{
+							PrivateEndpointConnectionResource: armmongocluster.PrivateEndpointConnectionResource{
+								Name: to.Ptr("pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
+								ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
+								Type: to.Ptr("Microsoft.DocumentDB/mongoClusters/privateEndpointConnections"),
+								SystemData: &armmongocluster.SystemData{
+									CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t }()),
+									CreatedBy:          to.Ptr("2df9eb86-36b5-49dc-86ae-9a63135bfa8c"),
+									CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeApplication),
+									LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t }()),
+									LastModifiedBy:     to.Ptr("2ff9eb86-36b5-49dc-86ae-9a63135bfa8c"),
+									LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
+								},
+								Properties: &armmongocluster.PrivateEndpointConnectionProperties{
+									ProvisioningState: to.Ptr(armmongocluster.PrivateEndpointConnectionProvisioningStateSucceeded),
+									PrivateEndpoint: &armmongocluster.PrivateEndpoint{
+										ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.Network/privateEndpoints/pecTest"),
+									},
+									GroupIDs: []*string{
+										to.Ptr("MongoCluster"),
+									},
+									PrivateLinkServiceConnectionState: &armmongocluster.PrivateLinkServiceConnectionState{
+										Status:          to.Ptr(armmongocluster.PrivateEndpointServiceConnectionStatusApproved),
+										Description:     to.Ptr("Auto-approved"),
+										ActionsRequired: to.Ptr("None"),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -108,48 +170,63 @@ func ExamplePrivateEndpointConnectionsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armmongocluster.PrivateEndpointConnectionsClientGetResponse{
-	// 	PrivateEndpointConnectionResource: armmongocluster.PrivateEndpointConnectionResource{
-	// 		Name: to.Ptr("pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
-	// 		ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
-	// 		Type: to.Ptr("Microsoft.DocumentDB/mongoClusters/privateEndpointConnections"),
-	// 		SystemData: &armmongocluster.SystemData{
-	// 			CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t}()),
-	// 			CreatedBy: to.Ptr("2df9eb86-36b5-49dc-86ae-9a63135bfa8c"),
-	// 			CreatedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
-	// 			LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t}()),
-	// 			LastModifiedBy: to.Ptr("2ff9eb86-36b5-49dc-86ae-9a63135bfa8c"),
-	// 			LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
-	// 		},
-	// 		Properties: &armmongocluster.PrivateEndpointConnectionProperties{
-	// 			ProvisioningState: to.Ptr(armmongocluster.PrivateEndpointConnectionProvisioningStateSucceeded),
-	// 			PrivateEndpoint: &armmongocluster.PrivateEndpoint{
-	// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.Network/privateEndpoints/pecTest"),
-	// 			},
-	// 			GroupIDs: []*string{
-	// 				to.Ptr("MongoCluster"),
-	// 			},
-	// 			PrivateLinkServiceConnectionState: &armmongocluster.PrivateLinkServiceConnectionState{
-	// 				Status: to.Ptr(armmongocluster.PrivateEndpointServiceConnectionStatusApproved),
-	// 				Description: to.Ptr("Auto-approved"),
-	// 				ActionsRequired: to.Ptr("None"),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// Name: pecTest.5d393f64-ef64-46d0-9959-308321c44ac0
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/pecTest.5d393f64-ef64-46d0-9959-308321c44ac0
+	// Type: Microsoft.DocumentDB/mongoClusters/privateEndpointConnections
 }
 
 // Generated from example definition: 2024-07-01/MongoClusters_PrivateEndpointConnectionList.json
 func ExamplePrivateEndpointConnectionsClient_NewListByMongoClusterPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateEndpointConnectionsServer: fake.PrivateEndpointConnectionsServer{
+					NewListByMongoClusterPager: func(resourceGroupName string, mongoClusterName string, options *armmongocluster.PrivateEndpointConnectionsClientListByMongoClusterOptions) (resp azfake.PagerResponder[armmongocluster.PrivateEndpointConnectionsClientListByMongoClusterResponse]) {
+						resp.AddPage(http.StatusOK, armmongocluster.PrivateEndpointConnectionsClientListByMongoClusterResponse{
+							PrivateEndpointConnectionResourceListResult: armmongocluster.PrivateEndpointConnectionResourceListResult{
+								Value: []*armmongocluster.PrivateEndpointConnectionResource{
+									{
+										Name: to.Ptr("pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
+										ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
+										Type: to.Ptr("Microsoft.DocumentDB/mongoClusters/privateEndpointConnections"),
+										SystemData: &armmongocluster.SystemData{
+											CreatedAt:          to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t }()),
+											CreatedBy:          to.Ptr("2df9eb86-36b5-49dc-86ae-9a63135bfa8c"),
+											CreatedByType:      to.Ptr(armmongocluster.CreatedByTypeApplication),
+											LastModifiedAt:     to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t }()),
+											LastModifiedBy:     to.Ptr("2ff9eb86-36b5-49dc-86ae-9a63135bfa8c"),
+											LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
+										},
+										Properties: &armmongocluster.PrivateEndpointConnectionProperties{
+											ProvisioningState: to.Ptr(armmongocluster.PrivateEndpointConnectionProvisioningStateSucceeded),
+											PrivateEndpoint: &armmongocluster.PrivateEndpoint{
+												ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.Network/privateEndpoints/pecTest"),
+											},
+											GroupIDs: []*string{
+												to.Ptr("MongoCluster"),
+											},
+											PrivateLinkServiceConnectionState: &armmongocluster.PrivateLinkServiceConnectionState{
+												Status:          to.Ptr(armmongocluster.PrivateEndpointServiceConnectionStatusApproved),
+												Description:     to.Ptr("Auto-approved"),
+												ActionsRequired: to.Ptr("None"),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -160,42 +237,13 @@ func ExamplePrivateEndpointConnectionsClient_NewListByMongoClusterPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armmongocluster.PrivateEndpointConnectionsClientListByMongoClusterResponse{
-		// 	PrivateEndpointConnectionResourceListResult: armmongocluster.PrivateEndpointConnectionResourceListResult{
-		// 		Value: []*armmongocluster.PrivateEndpointConnectionResource{
-		// 			{
-		// 				Name: to.Ptr("pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
-		// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/pecTest.5d393f64-ef64-46d0-9959-308321c44ac0"),
-		// 				Type: to.Ptr("Microsoft.DocumentDB/mongoClusters/privateEndpointConnections"),
-		// 				SystemData: &armmongocluster.SystemData{
-		// 					CreatedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t}()),
-		// 					CreatedBy: to.Ptr("2df9eb86-36b5-49dc-86ae-9a63135bfa8c"),
-		// 					CreatedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
-		// 					LastModifiedAt: to.Ptr(func() time.Time { t, _ := time.Parse(time.RFC3339Nano, "2024-02-09T05:51:31.1386869Z"); return t}()),
-		// 					LastModifiedBy: to.Ptr("2ff9eb86-36b5-49dc-86ae-9a63135bfa8c"),
-		// 					LastModifiedByType: to.Ptr(armmongocluster.CreatedByTypeApplication),
-		// 				},
-		// 				Properties: &armmongocluster.PrivateEndpointConnectionProperties{
-		// 					ProvisioningState: to.Ptr(armmongocluster.PrivateEndpointConnectionProvisioningStateSucceeded),
-		// 					PrivateEndpoint: &armmongocluster.PrivateEndpoint{
-		// 						ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.Network/privateEndpoints/pecTest"),
-		// 					},
-		// 					GroupIDs: []*string{
-		// 						to.Ptr("MongoCluster"),
-		// 					},
-		// 					PrivateLinkServiceConnectionState: &armmongocluster.PrivateLinkServiceConnectionState{
-		// 						Status: to.Ptr(armmongocluster.PrivateEndpointServiceConnectionStatusApproved),
-		// 						Description: to.Ptr("Auto-approved"),
-		// 						ActionsRequired: to.Ptr("None"),
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: pecTest.5d393f64-ef64-46d0-9959-308321c44ac0
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateEndpointConnections/pecTest.5d393f64-ef64-46d0-9959-308321c44ac0
+	// Type: Microsoft.DocumentDB/mongoClusters/privateEndpointConnections
 }
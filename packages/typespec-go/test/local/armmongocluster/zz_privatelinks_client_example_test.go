@@ -6,19 +6,52 @@ package armmongocluster_test
 
 import (
 	"armmongocluster"
+	"armmongocluster/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-07-01/MongoClusters_PrivateLinkResourceList.json
 func ExamplePrivateLinksClient_NewListByMongoClusterPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PrivateLinksServer: fake.PrivateLinksServer{
+					NewListByMongoClusterPager: func(resourceGroupName string, mongoClusterName string, options *armmongocluster.PrivateLinksClientListByMongoClusterOptions) (resp azfake.PagerResponder[armmongocluster.PrivateLinksClientListByMongoClusterResponse]) {
+						resp.AddPage(http.StatusOK, armmongocluster.PrivateLinksClientListByMongoClusterResponse{
+							PrivateLinkResourceListResult: armmongocluster.PrivateLinkResourceListResult{
+								Value: []*armmongocluster.PrivateLinkResource{
+									{
+										ID:   to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateLinkResources/MongoCluster"),
+										Name: to.Ptr("MongoCluster"),
+										Type: to.Ptr("Microsoft.DocumentDB/mongoClusters/privateLinkResources"),
+										Properties: &armmongocluster.PrivateLinkResourceProperties{
+											GroupID: to.Ptr("MongoCluster"),
+											RequiredMembers: []*string{
+												to.Ptr("c.p7ex3v2euquypn"),
+											},
+											RequiredZoneNames: []*string{
+												to.Ptr("privatelink.mongocluster.cosmos.azure.com"),
+											},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,29 +62,13 @@ func ExamplePrivateLinksClient_NewListByMongoClusterPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armmongocluster.PrivateLinksClientListByMongoClusterResponse{
-		// 	PrivateLinkResourceListResult: armmongocluster.PrivateLinkResourceListResult{
-		// 		Value: []*armmongocluster.PrivateLinkResource{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateLinkResources/MongoCluster"),
-		// 				Name: to.Ptr("MongoCluster"),
-		// 				Type: to.Ptr("Microsoft.DocumentDB/mongoClusters/privateLinkResources"),
-		// 				Properties: &armmongocluster.PrivateLinkResourceProperties{
-		// 					GroupID: to.Ptr("MongoCluster"),
-		// 					RequiredMembers: []*string{
-		// 						to.Ptr("c.p7ex3v2euquypn"),
-		// 					},
-		// 					RequiredZoneNames: []*string{
-		// 						to.Ptr("privatelink.mongocluster.cosmos.azure.com"),
-		// 					},
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestGroup/providers/Microsoft.DocumentDB/mongoClusters/myMongoCluster/privateLinkResources/MongoCluster
+	// Name: MongoCluster
+	// Type: Microsoft.DocumentDB/mongoClusters/privateLinkResources
 }
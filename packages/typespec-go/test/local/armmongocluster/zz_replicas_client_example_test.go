@@ -6,19 +6,44 @@ package armmongocluster_test
 
 import (
 	"armmongocluster"
+	"armmongocluster/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-07-01/MongoClusters_ReplicaList.json
 func ExampleReplicasClient_NewListByParentPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, nil)
+	clientFactory, err := armmongocluster.NewClientFactory("ffffffff-ffff-ffff-ffff-ffffffffffff", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ReplicasServer: fake.ReplicasServer{
+					NewListByParentPager: func(resourceGroupName string, mongoClusterName string, options *armmongocluster.ReplicasClientListByParentOptions) (resp azfake.PagerResponder[armmongocluster.ReplicasClientListByParentResponse]) {
+						resp.AddPage(http.StatusOK, armmongocluster.ReplicasClientListByParentResponse{
+							ReplicaListResult: armmongocluster.ReplicaListResult{
+								Value: []*armmongocluster.Replica{
+									{
+										ID:         to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myReplicaMongoCluster"),
+										Name:       to.Ptr("myReplicaMongoCluster"),
+										Type:       to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
+										Properties: &armmongocluster.Properties{},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,22 +54,13 @@ func ExampleReplicasClient_NewListByParentPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armmongocluster.ReplicasClientListByParentResponse{
-		// 	ReplicaListResult: armmongocluster.ReplicaListResult{
-		// 		Value: []*armmongocluster.Replica{
-		// 			{
-		// 				ID: to.Ptr("/subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myReplicaMongoCluster"),
-		// 				Name: to.Ptr("myReplicaMongoCluster"),
-		// 				Type: to.Ptr("/Microsoft.DocumentDB/mongoClusters"),
-		// 				Properties: &armmongocluster.Properties{
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /subscriptions/ffffffff-ffff-ffff-ffff-ffffffffffff/resourceGroups/TestResourceGroup/providers/Microsoft.DocumentDB/mongoClusters/myReplicaMongoCluster
+	// Name: myReplicaMongoCluster
+	// Type: /Microsoft.DocumentDB/mongoClusters
 }
@@ -6,19 +6,48 @@ package armoracledatabase_test
 
 import (
 	"armoracledatabase/v2"
+	"armoracledatabase/v2/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-06-01-preview/operations_list.json
 func ExampleOperationsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armoracledatabase.NewClientFactory("<subscriptionID>", cred, nil)
+	clientFactory, err := armoracledatabase.NewClientFactory("<subscriptionID>", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OperationsServer: fake.OperationsServer{
+					NewListPager: func(options *armoracledatabase.OperationsClientListOptions) (resp azfake.PagerResponder[armoracledatabase.OperationsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armoracledatabase.OperationsClientListResponse{
+							OperationListResult: armoracledatabase.OperationListResult{
+								Value: []*armoracledatabase.Operation{
+									{
+										Name:         to.Ptr("Oracle.Database/cloudExadataInfrastructures/Read"),
+										IsDataAction: to.Ptr(false),
+										Display: &armoracledatabase.OperationDisplay{
+											Provider:    to.Ptr("Oracle.Database"),
+											Resource:    to.Ptr("cloudExadataInfrastructures"),
+											Operation:   to.Ptr("Get/list Exadata Infrastructure resources"),
+											Description: to.Ptr("Reads Exadata Infrastructure"),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,25 +58,11 @@ func ExampleOperationsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("IsDataAction:", *v.IsDataAction)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armoracledatabase.OperationsClientListResponse{
-		// 	OperationListResult: armoracledatabase.OperationListResult{
-		// 		Value: []*armoracledatabase.Operation{
-		// 			{
-		// 				Name: to.Ptr("Oracle.Database/cloudExadataInfrastructures/Read"),
-		// 				IsDataAction: to.Ptr(false),
-		// 				Display: &armoracledatabase.OperationDisplay{
-		// 					Provider: to.Ptr("Oracle.Database"),
-		// 					Resource: to.Ptr("cloudExadataInfrastructures"),
-		// 					Operation: to.Ptr("Get/list Exadata Infrastructure resources"),
-		// 					Description: to.Ptr("Reads Exadata Infrastructure"),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// Name: Oracle.Database/cloudExadataInfrastructures/Read
+	// IsDataAction: false
 }
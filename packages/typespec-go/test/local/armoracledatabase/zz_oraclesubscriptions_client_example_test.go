@@ -6,19 +6,38 @@ package armoracledatabase_test
 
 import (
 	"armoracledatabase/v2"
+	"armoracledatabase/v2/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2024-06-01-preview/oracleSubscriptions_listCloudAccountDetails.json
 func ExampleOracleSubscriptionsClient_ListCloudAccountDetails() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armoracledatabase.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armoracledatabase.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OracleSubscriptionsServer: fake.OracleSubscriptionsServer{
+					ListCloudAccountDetails: func(ctx context.Context, options *armoracledatabase.OracleSubscriptionsClientListCloudAccountDetailsOptions) (resp azfake.Responder[armoracledatabase.OracleSubscriptionsClientListCloudAccountDetailsResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armoracledatabase.OracleSubscriptionsClientListCloudAccountDetailsResponse{
+							CloudAccountDetails: armoracledatabase.CloudAccountDetails{
+								CloudAccountName:       to.Ptr("Cloud Account"),
+								CloudAccountHomeRegion: to.Ptr("East US"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -26,25 +45,29 @@ func ExampleOracleSubscriptionsClient_ListCloudAccountDetails() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armoracledatabase.OracleSubscriptionsClientListCloudAccountDetailsResponse{
-	// 	CloudAccountDetails: armoracledatabase.CloudAccountDetails{
-	// 		CloudAccountName: to.Ptr("Cloud Account"),
-	// 		CloudAccountHomeRegion: to.Ptr("East US"),
-	// 	},
-	// }
+	fmt.Println("CloudAccountName:", *res.CloudAccountName)
+	fmt.Println("CloudAccountHomeRegion:", *res.CloudAccountHomeRegion)
+	// Output:
+	// CloudAccountName: Cloud Account
+	// CloudAccountHomeRegion: East US
 }
 
 // Generated from example definition: 2024-06-01-preview/oracleSubscriptions_listSaasSubscriptionDetails.json
 func ExampleOracleSubscriptionsClient_BeginListSaasSubscriptionDetails() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armoracledatabase.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, nil)
+	clientFactory, err := armoracledatabase.NewClientFactory("00000000-0000-0000-0000-000000000000", cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				OracleSubscriptionsServer: fake.OracleSubscriptionsServer{
+					BeginListSaasSubscriptionDetails: func(ctx context.Context, options *armoracledatabase.OracleSubscriptionsClientBeginListSaasSubscriptionDetailsOptions) (resp azfake.PollerResponder[armoracledatabase.OracleSubscriptionsClientListSaasSubscriptionDetailsResponse], errResp azfake.ErrorResponder) {
+						resp.SetTerminalResponse(http.StatusOK, armoracledatabase.OracleSubscriptionsClientListSaasSubscriptionDetailsResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -58,7 +81,5 @@ func ExampleOracleSubscriptionsClient_BeginListSaasSubscriptionDetails() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armoracledatabase.OracleSubscriptionsClientListSaasSubscriptionDetailsResponse{
-	// }
+	// Output:
 }
@@ -0,0 +1,110 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package armpageablelros_test
+
+import (
+	"armpageablelros"
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+)
+
+// resourceIDs waits for the LRO to complete and returns the
+// IDs of the referenced resources across all of the pages
+func resourceIDs(ctx context.Context, client armpageablelros.ClientAPI) ([]string, error) {
+	poller, err := client.BeginListPrivateEndPoints(ctx, "2024-01-01", "rg", "resource1", nil)
+	if err != nil {
+		return nil, err
+	}
+	pager, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: time.Millisecond})
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range page.Value {
+			for _, resource := range info.Resources {
+				ids = append(ids, *resource.ID)
+			}
+		}
+	}
+	return ids, nil
+}
+
+func newPage(ids ...string) armpageablelros.ClientListPrivateEndPointsResponse {
+	info := &armpageablelros.SomeResourceInfo{}
+	for _, id := range ids {
+		info.Resources = append(info.Resources, &armpageablelros.ReferencedResource{ID: to.Ptr(id)})
+	}
+	return armpageablelros.ClientListPrivateEndPointsResponse{
+		SomeResourceListResult: armpageablelros.SomeResourceListResult{
+			Value: []*armpageablelros.SomeResourceInfo{info},
+		},
+	}
+}
+
+// mockClient replaces BeginListPrivateEndPoints and panics for the methods it doesn't implement
+type mockClient struct {
+	armpageablelros.ClientAPI
+	pages []armpageablelros.ClientListPrivateEndPointsResponse
+}
+
+func (m *mockClient) BeginListPrivateEndPoints(ctx context.Context, apiVersion string, resourceGroupName string, resourceName string, options *armpageablelros.ClientBeginListPrivateEndPointsOptions) (*runtime.Poller[*runtime.Pager[armpageablelros.ClientListPrivateEndPointsResponse]], error) {
+	pages := m.pages
+	pager := runtime.NewPager(runtime.PagingHandler[armpageablelros.ClientListPrivateEndPointsResponse]{
+		More: func(armpageablelros.ClientListPrivateEndPointsResponse) bool {
+			return len(pages) > 0
+		},
+		Fetcher: func(context.Context, *armpageablelros.ClientListPrivateEndPointsResponse) (armpageablelros.ClientListPrivateEndPointsResponse, error) {
+			page := pages[0]
+			pages = pages[1:]
+			return page, nil
+		},
+	})
+	return runtime.NewPoller(nil, runtime.Pipeline{}, &runtime.NewPollerOptions[*runtime.Pager[armpageablelros.ClientListPrivateEndPointsResponse]]{
+		Handler: &completedHandler{pager: pager},
+	})
+}
+
+// completedHandler is an LRO that has already completed with the specified pager
+type completedHandler struct {
+	pager *runtime.Pager[armpageablelros.ClientListPrivateEndPointsResponse]
+}
+
+func (*completedHandler) Done() bool {
+	return true
+}
+
+func (*completedHandler) Poll(context.Context) (*http.Response, error) {
+	return nil, nil
+}
+
+func (c *completedHandler) Result(_ context.Context, out **runtime.Pager[armpageablelros.ClientListPrivateEndPointsResponse]) error {
+	*out = c.pager
+	return nil
+}
+
+func TestClientAPI_Mock(t *testing.T) {
+	ids, err := resourceIDs(context.Background(), &mockClient{
+		pages: []armpageablelros.ClientListPrivateEndPointsResponse{
+			newPage("endpoint1", "endpoint2"),
+			newPage("endpoint3"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"endpoint1", "endpoint2", "endpoint3"}; !reflect.DeepEqual(want, ids) {
+		t.Fatalf("want %v, got %v", want, ids)
+	}
+}
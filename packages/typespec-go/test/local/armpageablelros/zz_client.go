@@ -39,6 +39,16 @@ func NewClient(subscriptionID string, credential azcore.TokenCredential, options
 	return client, nil
 }
 
+// ClientAPI contains the exported methods of [Client].
+// Use it in place of the client to substitute a mock in tests.
+type ClientAPI interface {
+	// BeginListPrivateEndPoints - A long-running resource action.
+	BeginListPrivateEndPoints(ctx context.Context, apiVersion string, resourceGroupName string, resourceName string, options *ClientBeginListPrivateEndPointsOptions) (*runtime.Poller[*runtime.Pager[ClientListPrivateEndPointsResponse]], error)
+}
+
+// ensure Client implements ClientAPI
+var _ ClientAPI = (*Client)(nil)
+
 // BeginListPrivateEndPoints - A long-running resource action.
 //   - apiVersion - The API version to use for this operation.
 //   - resourceGroupName - The name of the resource group. The name is case insensitive.
@@ -33,7 +33,7 @@ func NewClientFactory(subscriptionID string, credential azcore.TokenCredential,
 }
 
 // NewClient creates a new instance of Client.
-func (c *ClientFactory) NewClient() *Client {
+func (c *ClientFactory) NewClient() ClientAPI {
 	return &Client{
 		subscriptionID: c.subscriptionID,
 		internal:       c.internal,
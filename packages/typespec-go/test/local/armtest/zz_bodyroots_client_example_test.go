@@ -6,20 +6,33 @@ package armtest_test
 
 import (
 	"armtest/v2"
+	"armtest/v2/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-01-01/BodyRoots_action_with_no_body.json
 func ExampleBodyRootsClient_Action() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				BodyRootsServer: fake.BodyRootsServer{
+					Action: func(ctx context.Context, resourceGroupName string, bodyRootName string, action armtest.ActionRequest, options *armtest.BodyRootsClientActionOptions) (resp azfake.Responder[armtest.BodyRootsClientActionResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusNoContent, armtest.BodyRootsClientActionResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -29,16 +42,40 @@ func ExampleBodyRootsClient_Action() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
+	// Output:
 }
 
 // Generated from example definition: 2025-01-01/BodyRoots_get_with_availability.json
 func ExampleBodyRootsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				BodyRootsServer: fake.BodyRootsServer{
+					Get: func(ctx context.Context, resourceGroupName string, bodyRootName string, options *armtest.BodyRootsClientGetOptions) (resp azfake.Responder[armtest.BodyRootsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.BodyRootsClientGetResponse{
+							BodyRoot: armtest.BodyRoot{
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/bodyRoots/myBodyRoot"),
+								Name:     to.Ptr("myBodyRoot"),
+								Type:     to.Ptr("Microsoft.Test/bodyRoots"),
+								Location: to.Ptr("eastus"),
+								Properties: &armtest.BodyRootProperties{
+									Description:       to.Ptr("my body root"),
+									ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
+									Availability: &armtest.AvailabilityProperties{
+										IsPreview: to.Ptr(true),
+										Status:    to.Ptr[int32](1),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -46,35 +83,48 @@ func ExampleBodyRootsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.BodyRootsClientGetResponse{
-	// 	BodyRoot: armtest.BodyRoot{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/bodyRoots/myBodyRoot"),
-	// 		Name: to.Ptr("myBodyRoot"),
-	// 		Type: to.Ptr("Microsoft.Test/bodyRoots"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Properties: &armtest.BodyRootProperties{
-	// 			Description: to.Ptr("my body root"),
-	// 			ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
-	// 			Availability: &armtest.AvailabilityProperties{
-	// 				IsPreview: to.Ptr(true),
-	// 				Status: to.Ptr[int32](1),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/bodyRoots/myBodyRoot
+	// Name: myBodyRoot
+	// Type: Microsoft.Test/bodyRoots
+	// Location: eastus
 }
 
 // Generated from example definition: 2025-01-01/BodyRoots_put_with_availability.json
 func ExampleBodyRootsClient_Put() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				BodyRootsServer: fake.BodyRootsServer{
+					Put: func(ctx context.Context, resourceGroupName string, bodyRootName string, resource armtest.BodyRoot, options *armtest.BodyRootsClientPutOptions) (resp azfake.Responder[armtest.BodyRootsClientPutResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.BodyRootsClientPutResponse{
+							BodyRoot: armtest.BodyRoot{
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/bodyRoots/myBodyRoot"),
+								Name:     to.Ptr("myBodyRoot"),
+								Type:     to.Ptr("Microsoft.Test/bodyRoots"),
+								Location: to.Ptr("eastus"),
+								Properties: &armtest.BodyRootProperties{
+									Description:       to.Ptr("my body root"),
+									ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
+									Availability: &armtest.AvailabilityProperties{
+										IsPreview: to.Ptr(true),
+										Status:    to.Ptr[int32](1),
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -91,23 +141,13 @@ func ExampleBodyRootsClient_Put() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.BodyRootsClientPutResponse{
-	// 	BodyRoot: armtest.BodyRoot{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/bodyRoots/myBodyRoot"),
-	// 		Name: to.Ptr("myBodyRoot"),
-	// 		Type: to.Ptr("Microsoft.Test/bodyRoots"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Properties: &armtest.BodyRootProperties{
-	// 			Description: to.Ptr("my body root"),
-	// 			ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
-	// 			Availability: &armtest.AvailabilityProperties{
-	// 				IsPreview: to.Ptr(true),
-	// 				Status: to.Ptr[int32](1),
-	// 			},
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/bodyRoots/myBodyRoot
+	// Name: myBodyRoot
+	// Type: Microsoft.Test/bodyRoots
+	// Location: eastus
 }
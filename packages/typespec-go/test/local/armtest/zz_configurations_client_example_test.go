@@ -6,21 +6,38 @@ package armtest_test
 
 import (
 	"armtest/v2"
+	"armtest/v2/fake"
 	"bytes"
 	"context"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"io"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-01-01/Configurations_getStreamingContent.json
 func ExampleConfigurationsClient_GetStreamingContent() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ConfigurationsServer: fake.ConfigurationsServer{
+					GetStreamingContent: func(ctx context.Context, resourceGroupName string, configurationName string, options *armtest.ConfigurationsClientGetStreamingContentOptions) (resp azfake.Responder[armtest.ConfigurationsClientGetStreamingContentResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.ConfigurationsClientGetStreamingContentResponse{
+							ContentType: to.Ptr("text/powershell"),
+							Body:        io.NopCloser(bytes.NewReader([]byte("Configuration file content as a streaming response"))),
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -30,21 +47,27 @@ func ExampleConfigurationsClient_GetStreamingContent() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.ConfigurationsClientGetStreamingContentResponse{
-	// 	ContentType: to.Ptr("text/powershell"),
-	// 	Body: io.NopCloser(bytes.NewReader([]byte("Configuration file content as a streaming response"))),
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-01-01/Configurations_getTextContent.json
 func ExampleConfigurationsClient_GetTextContent() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ConfigurationsServer: fake.ConfigurationsServer{
+					GetTextContent: func(ctx context.Context, resourceGroupName string, configurationName string, options *armtest.ConfigurationsClientGetTextContentOptions) (resp azfake.Responder[armtest.ConfigurationsClientGetTextContentResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.ConfigurationsClientGetTextContentResponse{
+							Value: to.Ptr("config text"),
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -54,20 +77,25 @@ func ExampleConfigurationsClient_GetTextContent() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.ConfigurationsClientGetTextContentResponse{
-	// 	Value: to.Ptr("config text"),
-	// }
+	// Output:
 }
 
 // Generated from example definition: 2025-01-01/Configurations_putStreamingContent.json
 func ExampleConfigurationsClient_PutStreamingContent() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ConfigurationsServer: fake.ConfigurationsServer{
+					PutStreamingContent: func(ctx context.Context, resourceGroupName string, configurationName string, body io.ReadSeekCloser, options *armtest.ConfigurationsClientPutStreamingContentOptions) (resp azfake.Responder[armtest.ConfigurationsClientPutStreamingContentResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusNoContent, armtest.ConfigurationsClientPutStreamingContentResponse{}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -75,4 +103,5 @@ func ExampleConfigurationsClient_PutStreamingContent() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
+	// Output:
 }
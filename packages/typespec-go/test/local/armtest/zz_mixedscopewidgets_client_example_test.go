@@ -6,19 +6,44 @@ package armtest_test
 
 import (
 	"armtest/v2"
+	"armtest/v2/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-01-01/MixedScopeWidgets_get.json
 func ExampleMixedScopeWidgetsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MixedScopeWidgetsServer: fake.MixedScopeWidgetsServer{
+					Get: func(ctx context.Context, resourceGroupName string, widgetName string, options *armtest.MixedScopeWidgetsClientGetOptions) (resp azfake.Responder[armtest.MixedScopeWidgetsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.MixedScopeWidgetsClientGetResponse{
+							MixedScopeWidget: armtest.MixedScopeWidget{
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/mixedScopeWidgets/myWidget"),
+								Name:     to.Ptr("myWidget"),
+								Type:     to.Ptr("Microsoft.Test/mixedScopeWidgets"),
+								Location: to.Ptr("eastus"),
+								Properties: &armtest.MixedScopeWidgetProperties{
+									Description:       to.Ptr("my widget"),
+									ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -26,31 +51,48 @@ func ExampleMixedScopeWidgetsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.MixedScopeWidgetsClientGetResponse{
-	// 	MixedScopeWidget: armtest.MixedScopeWidget{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/mixedScopeWidgets/myWidget"),
-	// 		Name: to.Ptr("myWidget"),
-	// 		Type: to.Ptr("Microsoft.Test/mixedScopeWidgets"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Properties: &armtest.MixedScopeWidgetProperties{
-	// 			Description: to.Ptr("my widget"),
-	// 			ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/mixedScopeWidgets/myWidget
+	// Name: myWidget
+	// Type: Microsoft.Test/mixedScopeWidgets
+	// Location: eastus
 }
 
 // Generated from example definition: 2025-01-01/MixedScopeWidgets_listAll.json
 func ExampleMixedScopeWidgetsClient_NewListAllPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				MixedScopeWidgetsServer: fake.MixedScopeWidgetsServer{
+					NewListAllPager: func(options *armtest.MixedScopeWidgetsClientListAllOptions) (resp azfake.PagerResponder[armtest.MixedScopeWidgetsClientListAllResponse]) {
+						resp.AddPage(http.StatusOK, armtest.MixedScopeWidgetsClientListAllResponse{
+							MixedScopeWidgetListResult: armtest.MixedScopeWidgetListResult{
+								Value: []*armtest.MixedScopeWidget{
+									{
+										ID:       to.Ptr("/providers/Microsoft.Test/mixedScopeWidgets/myWidget"),
+										Name:     to.Ptr("myWidget"),
+										Type:     to.Ptr("Microsoft.Test/mixedScopeWidgets"),
+										Location: to.Ptr("eastus"),
+										Properties: &armtest.MixedScopeWidgetProperties{
+											Description:       to.Ptr("my widget"),
+											ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -61,25 +103,15 @@ func ExampleMixedScopeWidgetsClient_NewListAllPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
+			fmt.Println("Location:", *v.Location)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armtest.MixedScopeWidgetsClientListAllResponse{
-		// 	MixedScopeWidgetListResult: armtest.MixedScopeWidgetListResult{
-		// 		Value: []*armtest.MixedScopeWidget{
-		// 			{
-		// 				ID: to.Ptr("/providers/Microsoft.Test/mixedScopeWidgets/myWidget"),
-		// 				Name: to.Ptr("myWidget"),
-		// 				Type: to.Ptr("Microsoft.Test/mixedScopeWidgets"),
-		// 				Location: to.Ptr("eastus"),
-		// 				Properties: &armtest.MixedScopeWidgetProperties{
-		// 					Description: to.Ptr("my widget"),
-		// 					ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /providers/Microsoft.Test/mixedScopeWidgets/myWidget
+	// Name: myWidget
+	// Type: Microsoft.Test/mixedScopeWidgets
+	// Location: eastus
 }
@@ -6,19 +6,44 @@ package armtest_test
 
 import (
 	"armtest/v2"
+	"armtest/v2/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-01-01/ParameterGroupOperations_noParameterGroup.json
 func ExampleParameterGroupOperationsClient_NoParameterGroup() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ParameterGroupOperationsServer: fake.ParameterGroupOperationsServer{
+					NoParameterGroup: func(ctx context.Context, resourceGroupName string, widgetName string, options *armtest.ParameterGroupOperationsClientNoParameterGroupOptions) (resp azfake.Responder[armtest.ParameterGroupOperationsClientNoParameterGroupResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.ParameterGroupOperationsClientNoParameterGroupResponse{
+							Widget: armtest.Widget{
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget"),
+								Name:     to.Ptr("myWidget"),
+								Type:     to.Ptr("Microsoft.Test/widgets"),
+								Location: to.Ptr("eastus"),
+								Properties: &armtest.WidgetProperties{
+									Name:        to.Ptr("myWidget"),
+									Description: to.Ptr("A test widget"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -26,31 +51,44 @@ func ExampleParameterGroupOperationsClient_NoParameterGroup() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.ParameterGroupOperationsClientNoParameterGroupResponse{
-	// 	Widget: armtest.Widget{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget"),
-	// 		Name: to.Ptr("myWidget"),
-	// 		Type: to.Ptr("Microsoft.Test/widgets"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Properties: &armtest.WidgetProperties{
-	// 			Name: to.Ptr("myWidget"),
-	// 			Description: to.Ptr("A test widget"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget
+	// Name: myWidget
+	// Type: Microsoft.Test/widgets
+	// Location: eastus
 }
 
 // Generated from example definition: 2025-01-01/ParameterGroupOperations_queryParameterGroup.json
 func ExampleParameterGroupOperationsClient_QueryParameterGroup() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ParameterGroupOperationsServer: fake.ParameterGroupOperationsServer{
+					QueryParameterGroup: func(ctx context.Context, resourceGroupName string, widgetName string, params armtest.QueryParams, options *armtest.ParameterGroupOperationsClientQueryParameterGroupOptions) (resp azfake.Responder[armtest.ParameterGroupOperationsClientQueryParameterGroupResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.ParameterGroupOperationsClientQueryParameterGroupResponse{
+							Widget: armtest.Widget{
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget"),
+								Name:     to.Ptr("myWidget"),
+								Type:     to.Ptr("Microsoft.Test/widgets"),
+								Location: to.Ptr("eastus"),
+								Properties: &armtest.WidgetProperties{
+									Name:        to.Ptr("myWidget"),
+									Description: to.Ptr("A test widget"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -58,31 +96,44 @@ func ExampleParameterGroupOperationsClient_QueryParameterGroup() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.ParameterGroupOperationsClientQueryParameterGroupResponse{
-	// 	Widget: armtest.Widget{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget"),
-	// 		Name: to.Ptr("myWidget"),
-	// 		Type: to.Ptr("Microsoft.Test/widgets"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Properties: &armtest.WidgetProperties{
-	// 			Name: to.Ptr("myWidget"),
-	// 			Description: to.Ptr("A test widget"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget
+	// Name: myWidget
+	// Type: Microsoft.Test/widgets
+	// Location: eastus
 }
 
 // Generated from example definition: 2025-01-01/ParameterGroupOperations_sharedParameterGroup1.json
 func ExampleParameterGroupOperationsClient_SharedParameterGroup1() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ParameterGroupOperationsServer: fake.ParameterGroupOperationsServer{
+					SharedParameterGroup1: func(ctx context.Context, resourceGroupName string, widgetName string, params armtest.ManagementRequestOptions, options *armtest.ParameterGroupOperationsClientSharedParameterGroup1Options) (resp azfake.Responder[armtest.ParameterGroupOperationsClientSharedParameterGroup1Response], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.ParameterGroupOperationsClientSharedParameterGroup1Response{
+							Widget: armtest.Widget{
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget"),
+								Name:     to.Ptr("myWidget"),
+								Type:     to.Ptr("Microsoft.Test/widgets"),
+								Location: to.Ptr("eastus"),
+								Properties: &armtest.WidgetProperties{
+									Name:        to.Ptr("myWidget"),
+									Description: to.Ptr("A test widget"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -90,31 +141,44 @@ func ExampleParameterGroupOperationsClient_SharedParameterGroup1() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.ParameterGroupOperationsClientSharedParameterGroup1Response{
-	// 	Widget: armtest.Widget{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget"),
-	// 		Name: to.Ptr("myWidget"),
-	// 		Type: to.Ptr("Microsoft.Test/widgets"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Properties: &armtest.WidgetProperties{
-	// 			Name: to.Ptr("myWidget"),
-	// 			Description: to.Ptr("A test widget"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget
+	// Name: myWidget
+	// Type: Microsoft.Test/widgets
+	// Location: eastus
 }
 
 // Generated from example definition: 2025-01-01/ParameterGroupOperations_sharedParameterGroup2.json
 func ExampleParameterGroupOperationsClient_SharedParameterGroup2() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ParameterGroupOperationsServer: fake.ParameterGroupOperationsServer{
+					SharedParameterGroup2: func(ctx context.Context, resourceGroupName string, widgetName string, params armtest.ManagementRequestOptions, options *armtest.ParameterGroupOperationsClientSharedParameterGroup2Options) (resp azfake.Responder[armtest.ParameterGroupOperationsClientSharedParameterGroup2Response], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.ParameterGroupOperationsClientSharedParameterGroup2Response{
+							Widget: armtest.Widget{
+								ID:       to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget"),
+								Name:     to.Ptr("myWidget"),
+								Type:     to.Ptr("Microsoft.Test/widgets"),
+								Location: to.Ptr("eastus"),
+								Properties: &armtest.WidgetProperties{
+									Name:        to.Ptr("myWidget"),
+									Description: to.Ptr("A test widget"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -122,19 +186,13 @@ func ExampleParameterGroupOperationsClient_SharedParameterGroup2() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.ParameterGroupOperationsClientSharedParameterGroup2Response{
-	// 	Widget: armtest.Widget{
-	// 		ID: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget"),
-	// 		Name: to.Ptr("myWidget"),
-	// 		Type: to.Ptr("Microsoft.Test/widgets"),
-	// 		Location: to.Ptr("eastus"),
-	// 		Properties: &armtest.WidgetProperties{
-	// 			Name: to.Ptr("myWidget"),
-	// 			Description: to.Ptr("A test widget"),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	fmt.Println("Location:", *res.Location)
+	// Output:
+	// ID: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Test/widgets/myWidget
+	// Name: myWidget
+	// Type: Microsoft.Test/widgets
+	// Location: eastus
 }
@@ -6,20 +6,39 @@ package armtest_test
 
 import (
 	"armtest/v2"
+	"armtest/v2/fake"
 	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-01-01/Pets_adoptCat.json
 func ExamplePetsClient_AdoptCat() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PetsServer: fake.PetsServer{
+					AdoptCat: func(ctx context.Context, resourceGroupName string, petResourceName string, cat armtest.Cat, options *armtest.PetsClientAdoptCatOptions) (resp azfake.Responder[armtest.PetsClientAdoptCatResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.PetsClientAdoptCatResponse{
+							Cat: armtest.Cat{
+								Kind:  to.Ptr(armtest.PetKindCat),
+								Name:  to.Ptr("Whiskers"),
+								Purrs: to.Ptr(true),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -31,26 +50,37 @@ func ExamplePetsClient_AdoptCat() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.PetsClientAdoptCatResponse{
-	// 	Cat: armtest.Cat{
-	// 		Kind: to.Ptr(armtest.PetKindCat),
-	// 		Name: to.Ptr("Whiskers"),
-	// 		Purrs: to.Ptr(true),
-	// 	},
-	// }
+	fmt.Println("Kind:", *res.Kind)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Purrs:", *res.Purrs)
+	// Output:
+	// Kind: Cat
+	// Name: Whiskers
+	// Purrs: true
 }
 
 // Generated from example definition: 2025-01-01/Pets_adoptPet.json
 func ExamplePetsClient_AdoptPet() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				PetsServer: fake.PetsServer{
+					AdoptPet: func(ctx context.Context, resourceGroupName string, petResourceName string, pet armtest.PetClassification, options *armtest.PetsClientAdoptPetOptions) (resp azfake.Responder[armtest.PetsClientAdoptPetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.PetsClientAdoptPetResponse{
+							PetClassification: &armtest.Dog{
+								Kind:  to.Ptr(armtest.PetKindDog),
+								Name:  to.Ptr("Buddy"),
+								Breed: to.Ptr("Labrador"),
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -64,12 +94,5 @@ func ExamplePetsClient_AdoptPet() {
 	}
 	// You could use response here. We use blank identifier for just demo purposes.
 	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.PetsClientAdoptPetResponse{
-	// 	PetClassification: &armtest.Dog{
-	// 		Kind: to.Ptr(armtest.PetKindDog),
-	// 		Name: to.Ptr("Buddy"),
-	// 		Breed: to.Ptr("Labrador"),
-	// 	},
-	// }
+	// Output:
 }
@@ -6,19 +6,43 @@ package armtest_test
 
 import (
 	"armtest/v2"
+	"armtest/v2/fake"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"log"
+	"net/http"
 )
 
 // Generated from example definition: 2025-01-01/TenantItems_get.json
 func ExampleTenantItemsClient_Get() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				TenantItemsServer: fake.TenantItemsServer{
+					Get: func(ctx context.Context, tenantItemName string, options *armtest.TenantItemsClientGetOptions) (resp azfake.Responder[armtest.TenantItemsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, armtest.TenantItemsClientGetResponse{
+							TenantItem: armtest.TenantItem{
+								ID:   to.Ptr("/providers/Microsoft.Test/tenantItems/myTenantItem"),
+								Name: to.Ptr("myTenantItem"),
+								Type: to.Ptr("Microsoft.Test/tenantItems"),
+								Properties: &armtest.TenantItemProperties{
+									Description:       to.Ptr("my tenant item"),
+									ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -26,30 +50,45 @@ func ExampleTenantItemsClient_Get() {
 	if err != nil {
 		log.Fatalf("failed to finish the request: %v", err)
 	}
-	// You could use response here. We use blank identifier for just demo purposes.
-	_ = res
-	// If the HTTP response code is 200 as defined in example definition, your response structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-	// res = armtest.TenantItemsClientGetResponse{
-	// 	TenantItem: armtest.TenantItem{
-	// 		ID: to.Ptr("/providers/Microsoft.Test/tenantItems/myTenantItem"),
-	// 		Name: to.Ptr("myTenantItem"),
-	// 		Type: to.Ptr("Microsoft.Test/tenantItems"),
-	// 		Properties: &armtest.TenantItemProperties{
-	// 			Description: to.Ptr("my tenant item"),
-	// 			ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
-	// 		},
-	// 	},
-	// }
+	fmt.Println("ID:", *res.ID)
+	fmt.Println("Name:", *res.Name)
+	fmt.Println("Type:", *res.Type)
+	// Output:
+	// ID: /providers/Microsoft.Test/tenantItems/myTenantItem
+	// Name: myTenantItem
+	// Type: Microsoft.Test/tenantItems
 }
 
 // Generated from example definition: 2025-01-01/TenantItems_list.json
 func ExampleTenantItemsClient_NewListPager() {
-	cred, err := azidentity.NewDefaultAzureCredential(nil)
-	if err != nil {
-		log.Fatalf("failed to obtain a credential: %v", err)
-	}
+	cred := &azfake.TokenCredential{}
 	ctx := context.Background()
-	clientFactory, err := armtest.NewClientFactory(cred, nil)
+	clientFactory, err := armtest.NewClientFactory(cred, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				TenantItemsServer: fake.TenantItemsServer{
+					NewListPager: func(options *armtest.TenantItemsClientListOptions) (resp azfake.PagerResponder[armtest.TenantItemsClientListResponse]) {
+						resp.AddPage(http.StatusOK, armtest.TenantItemsClientListResponse{
+							TenantItemListResult: armtest.TenantItemListResult{
+								Value: []*armtest.TenantItem{
+									{
+										ID:   to.Ptr("/providers/Microsoft.Test/tenantItems/myTenantItem"),
+										Name: to.Ptr("myTenantItem"),
+										Type: to.Ptr("Microsoft.Test/tenantItems"),
+										Properties: &armtest.TenantItemProperties{
+											Description:       to.Ptr("my tenant item"),
+											ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
@@ -60,24 +99,13 @@ func ExampleTenantItemsClient_NewListPager() {
 			log.Fatalf("failed to advance page: %v", err)
 		}
 		for _, v := range page.Value {
-			// You could use page here. We use blank identifier for just demo purposes.
-			_ = v
+			fmt.Println("ID:", *v.ID)
+			fmt.Println("Name:", *v.Name)
+			fmt.Println("Type:", *v.Type)
 		}
-		// If the HTTP response code is 200 as defined in example definition, your page structure would look as follows. Please pay attention that all the values in the output are fake values for just demo purposes.
-		// page = armtest.TenantItemsClientListResponse{
-		// 	TenantItemListResult: armtest.TenantItemListResult{
-		// 		Value: []*armtest.TenantItem{
-		// 			{
-		// 				ID: to.Ptr("/providers/Microsoft.Test/tenantItems/myTenantItem"),
-		// 				Name: to.Ptr("myTenantItem"),
-		// 				Type: to.Ptr("Microsoft.Test/tenantItems"),
-		// 				Properties: &armtest.TenantItemProperties{
-		// 					Description: to.Ptr("my tenant item"),
-		// 					ProvisioningState: to.Ptr(armtest.ProvisioningStateSucceeded),
-		// 				},
-		// 			},
-		// 		},
-		// 	},
-		// }
 	}
+	// Output:
+	// ID: /providers/Microsoft.Test/tenantItems/myTenantItem
+	// Name: myTenantItem
+	// Type: Microsoft.Test/tenantItems
 }
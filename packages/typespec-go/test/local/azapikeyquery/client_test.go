@@ -0,0 +1,102 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azapikeyquery_test
+
+import (
+	"azapikeyquery"
+	"azapikeyquery/fake"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+// recordQueryPolicy records the query string of each request sent to the fake
+type recordQueryPolicy struct {
+	queries []string
+	headers []string
+}
+
+func (r *recordQueryPolicy) Do(req *policy.Request) (*http.Response, error) {
+	r.queries = append(r.queries, req.Raw().URL.RawQuery)
+	for name := range req.Raw().Header {
+		r.headers = append(r.headers, name)
+	}
+	return req.Next()
+}
+
+// busyOnceTransport fails the first request with a retriable status code
+type busyOnceTransport struct {
+	srv   *fake.ServerTransport
+	tries int
+}
+
+func (b *busyOnceTransport) Do(req *http.Request) (*http.Response, error) {
+	b.tries++
+	if b.tries == 1 {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	}
+	return b.srv.Do(req)
+}
+
+func TestClient_Valid(t *testing.T) {
+	recorder := &recordQueryPolicy{}
+	client, err := azapikeyquery.NewClientWithKeyCredential("http://localhost:3000", azcore.NewKeyCredential("valid key"), &azapikeyquery.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			InsecureAllowCredentialWithHTTP: true,
+			PerRetryPolicies:                []policy.Policy{recorder},
+			Retry: policy.RetryOptions{
+				RetryDelay: time.Millisecond,
+			},
+			Transport: &busyOnceTransport{
+				srv: fake.NewServerTransport(&fake.Server{
+					Valid: func(ctx context.Context, options *azapikeyquery.ClientValidOptions) (resp azfake.Responder[azapikeyquery.ClientValidResponse], errResp azfake.ErrorResponder) {
+						require.NotNil(t, options)
+						require.EqualValues(t, "name eq 'a b'", *options.Filter)
+						resp.SetResponse(http.StatusNoContent, azapikeyquery.ClientValidResponse{}, nil)
+						return
+					},
+				}),
+			},
+		},
+	})
+	require.NoError(t, err)
+	resp, err := client.Valid(context.Background(), &azapikeyquery.ClientValidOptions{
+		Filter: to.Ptr("name eq 'a b'"),
+	})
+	require.NoError(t, err)
+	require.Zero(t, resp)
+	// the key is added once per try and the existing query params keep their encoding
+	require.EqualValues(t, []string{
+		"filter=name%20eq%20%27a%20b%27&api-key=valid%20key",
+		"filter=name%20eq%20%27a%20b%27&api-key=valid%20key",
+	}, recorder.queries)
+	require.NotContains(t, recorder.headers, "Api-Key")
+}
+
+func TestClient_ValidRequiresTLS(t *testing.T) {
+	client, err := azapikeyquery.NewClientWithKeyCredential("http://localhost:3000", azcore.NewKeyCredential("valid key"), &azapikeyquery.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerTransport(&fake.Server{
+				Valid: func(ctx context.Context, options *azapikeyquery.ClientValidOptions) (resp azfake.Responder[azapikeyquery.ClientValidResponse], errResp azfake.ErrorResponder) {
+					t.Fatal("request should not have been sent")
+					return
+				},
+			}),
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.Valid(context.Background(), nil)
+	require.ErrorContains(t, err, "https")
+}
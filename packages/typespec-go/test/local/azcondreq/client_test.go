@@ -0,0 +1,215 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azcondreq_test
+
+import (
+	"azcondreq"
+	"azcondreq/fake"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeClient(t *testing.T, srv *fake.Server, policies ...policy.Policy) *azcondreq.Client {
+	client, err := azcondreq.NewClientWithNoCredential("https://contoso.com", &azcondreq.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			PerCallPolicies: policies,
+			Transport:       fake.NewServerTransport(srv),
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+// widgetStore is a fake service that versions a single widget with an ETag
+type widgetStore struct {
+	widget   azcondreq.Widget
+	version  int
+	modified time.Time
+}
+
+func (w *widgetStore) etag() azcore.ETag {
+	return azcore.ETag(fmt.Sprintf(`"%d"`, w.version))
+}
+
+func (w *widgetStore) update(widget azcondreq.Widget) {
+	w.widget = widget
+	w.version++
+	w.modified = w.modified.Add(time.Minute)
+}
+
+func (w *widgetStore) server() *fake.Server {
+	return &fake.Server{
+		GetWidget: func(ctx context.Context, name string, matchConditions *azcondreq.MatchConditions, options *azcondreq.ClientGetWidgetOptions) (resp azfake.Responder[azcondreq.ClientGetWidgetResponse], errResp azfake.ErrorResponder) {
+			if matchConditions != nil && matchConditions.IfNoneMatch != nil && *matchConditions.IfNoneMatch == w.etag() {
+				errResp.SetResponseError(http.StatusNotModified, "NotModified")
+				return
+			}
+			resp.SetResponse(http.StatusOK, azcondreq.ClientGetWidgetResponse{
+				Widget:       w.widget,
+				ETag:         to.Ptr(w.etag()),
+				LastModified: to.Ptr(w.modified),
+			}, nil)
+			return
+		},
+		PutWidget: func(ctx context.Context, name string, widget azcondreq.Widget, requestConditions *azcondreq.RequestConditions, options *azcondreq.ClientPutWidgetOptions) (resp azfake.Responder[azcondreq.ClientPutWidgetResponse], errResp azfake.ErrorResponder) {
+			if requestConditions != nil && requestConditions.IfMatch != nil && *requestConditions.IfMatch != w.etag() {
+				errResp.SetResponseError(http.StatusPreconditionFailed, "ConditionNotMet")
+				return
+			}
+			w.update(widget)
+			resp.SetResponse(http.StatusOK, azcondreq.ClientPutWidgetResponse{
+				Widget:       w.widget,
+				ETag:         to.Ptr(w.etag()),
+				LastModified: to.Ptr(w.modified),
+			}, nil)
+			return
+		},
+	}
+}
+
+// updateWidget is an optimistic concurrency loop that only uses the shared
+// ETag field of the response envelope and the shared RequestConditions type.
+func updateWidget(ctx context.Context, client *azcondreq.Client, name string, update func(*azcondreq.Widget)) (azcondreq.ClientPutWidgetResponse, error) {
+	for {
+		current, err := client.GetWidget(ctx, name, nil, nil)
+		if err != nil {
+			return azcondreq.ClientPutWidgetResponse{}, err
+		}
+		widget := current.Widget
+		update(&widget)
+		resp, err := client.PutWidget(ctx, name, widget, &azcondreq.RequestConditions{IfMatch: current.ETag}, nil)
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode == http.StatusPreconditionFailed {
+			continue
+		}
+		return resp, err
+	}
+}
+
+func TestClient_OptimisticConcurrency(t *testing.T) {
+	store := &widgetStore{
+		widget:   azcondreq.Widget{Name: to.Ptr("widget1"), Color: to.Ptr("red")},
+		modified: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	srv := store.server()
+	getWidget := srv.GetWidget
+	attempts := 0
+	srv.GetWidget = func(ctx context.Context, name string, matchConditions *azcondreq.MatchConditions, options *azcondreq.ClientGetWidgetOptions) (resp azfake.Responder[azcondreq.ClientGetWidgetResponse], errResp azfake.ErrorResponder) {
+		resp, errResp = getWidget(ctx, name, matchConditions, options)
+		attempts++
+		if attempts == 1 {
+			// simulate a concurrent update after the first read
+			store.update(azcondreq.Widget{Name: to.Ptr("widget1"), Color: to.Ptr("green")})
+		}
+		return
+	}
+	client := newFakeClient(t, srv)
+
+	resp, err := updateWidget(context.Background(), client, "widget1", func(w *azcondreq.Widget) {
+		w.Color = to.Ptr(*w.Color + "-updated")
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+	require.Equal(t, "green-updated", *resp.Color)
+	require.Equal(t, azcore.ETag(`"2"`), *resp.ETag)
+	require.True(t, time.Date(2024, 1, 1, 0, 2, 0, 0, time.UTC).Equal(*resp.LastModified))
+
+	// the ETag from the response can be used to skip unchanged reads
+	_, err = client.GetWidget(context.Background(), "widget1", &azcondreq.MatchConditions{IfNoneMatch: resp.ETag}, nil)
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusNotModified, respErr.StatusCode)
+}
+
+type headersPolicy struct {
+	headers http.Header
+}
+
+func (h *headersPolicy) Do(req *policy.Request) (*http.Response, error) {
+	h.headers = req.Raw().Header.Clone()
+	return req.Next()
+}
+
+func TestClient_DeleteWidgetRequestConditions(t *testing.T) {
+	var got *azcondreq.RequestConditions
+	srv := &fake.Server{
+		DeleteWidget: func(ctx context.Context, name string, requestConditions *azcondreq.RequestConditions, options *azcondreq.ClientDeleteWidgetOptions) (resp azfake.Responder[azcondreq.ClientDeleteWidgetResponse], errResp azfake.ErrorResponder) {
+			got = requestConditions
+			resp.SetResponse(http.StatusNoContent, azcondreq.ClientDeleteWidgetResponse{}, nil)
+			return
+		},
+	}
+	headers := &headersPolicy{}
+	client := newFakeClient(t, srv, headers)
+
+	unmodifiedSince := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	_, err := client.DeleteWidget(context.Background(), "widget1", &azcondreq.RequestConditions{
+		IfMatch:           to.Ptr(azcore.ETag(`"1"`)),
+		IfUnmodifiedSince: &unmodifiedSince,
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, `"1"`, headers.headers.Get("If-Match"))
+	require.Equal(t, "Tue, 02 Jan 2024 03:04:05 GMT", headers.headers.Get("If-Unmodified-Since"))
+	require.Empty(t, headers.headers.Get("If-None-Match"))
+	require.Empty(t, headers.headers.Get("If-Modified-Since"))
+	require.NotNil(t, got)
+	require.Equal(t, azcore.ETag(`"1"`), *got.IfMatch)
+	require.True(t, unmodifiedSince.Equal(*got.IfUnmodifiedSince))
+	require.Nil(t, got.IfNoneMatch)
+	require.Nil(t, got.IfModifiedSince)
+
+	// no conditions
+	_, err = client.DeleteWidget(context.Background(), "widget1", nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, got)
+	require.Empty(t, headers.headers.Get("If-Match"))
+}
+
+func TestClient_TouchWidgetUnsupportedConditions(t *testing.T) {
+	var got *azcondreq.RequestConditions
+	srv := &fake.Server{
+		TouchWidget: func(ctx context.Context, name string, requestConditions *azcondreq.RequestConditions, options *azcondreq.ClientTouchWidgetOptions) (resp azfake.Responder[azcondreq.ClientTouchWidgetResponse], errResp azfake.ErrorResponder) {
+			got = requestConditions
+			resp.SetResponse(http.StatusNoContent, azcondreq.ClientTouchWidgetResponse{}, nil)
+			return
+		},
+	}
+	client := newFakeClient(t, srv)
+
+	// the supported conditions are sent
+	unmodifiedSince := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	_, err := client.TouchWidget(context.Background(), "widget1", &azcondreq.RequestConditions{
+		IfMatch:           to.Ptr(azcore.ETag(`"1"`)),
+		IfUnmodifiedSince: &unmodifiedSince,
+	}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	require.Equal(t, azcore.ETag(`"1"`), *got.IfMatch)
+	require.True(t, unmodifiedSince.Equal(*got.IfUnmodifiedSince))
+
+	// the operation doesn't send If-None-Match or If-Modified-Since so setting them is an error
+	got = nil
+	_, err = client.TouchWidget(context.Background(), "widget1", &azcondreq.RequestConditions{
+		IfNoneMatch: to.Ptr(azcore.ETagAny),
+	}, nil)
+	require.EqualError(t, err, "parameter requestConditions.IfNoneMatch is not supported by operation Client.TouchWidget")
+	require.Nil(t, got)
+
+	_, err = client.TouchWidget(context.Background(), "widget1", &azcondreq.RequestConditions{
+		IfMatch:         to.Ptr(azcore.ETag(`"1"`)),
+		IfModifiedSince: &unmodifiedSince,
+	}, nil)
+	require.EqualError(t, err, "parameter requestConditions.IfModifiedSince is not supported by operation Client.TouchWidget")
+	require.Nil(t, got)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azcookies_test
+
+import (
+	"azcookies"
+	"azcookies/fake"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+// cookieRecorder captures the Cookie header sent on the wire
+type cookieRecorder struct {
+	header string
+}
+
+func (c *cookieRecorder) Do(req *policy.Request) (*http.Response, error) {
+	c.header = req.Raw().Header.Get("Cookie")
+	return req.Next()
+}
+
+func newClient(t *testing.T, srv *fake.Server, recorder *cookieRecorder) *azcookies.Client {
+	opts := &azcookies.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerTransport(srv),
+		},
+	}
+	if recorder != nil {
+		opts.PerRetryPolicies = []policy.Policy{recorder}
+	}
+	client, err := azcookies.NewClientWithNoCredential("https://contoso.com", opts)
+	require.NoError(t, err)
+	return client
+}
+
+func TestGetProfileCookies(t *testing.T) {
+	recorder := &cookieRecorder{}
+	client := newClient(t, &fake.Server{
+		GetProfile: func(ctx context.Context, sessionID string, options *azcookies.ClientGetProfileOptions) (resp azfake.Responder[azcookies.ClientGetProfileResponse], errResp azfake.ErrorResponder) {
+			name := sessionID
+			if options != nil {
+				name += "/" + *options.Theme
+			}
+			resp.SetResponse(http.StatusOK, azcookies.ClientGetProfileResponse{Profile: azcookies.Profile{Name: &name}}, nil)
+			return
+		},
+	}, recorder)
+
+	resp, err := client.GetProfile(context.Background(), "abc123", nil)
+	require.NoError(t, err)
+	require.EqualValues(t, "abc123", *resp.Name)
+	require.EqualValues(t, "session-id=abc123", recorder.header)
+
+	// values containing spaces are percent-encoded
+	resp, err = client.GetProfile(context.Background(), "abc123", &azcookies.ClientGetProfileOptions{Theme: to.Ptr("dark blue")})
+	require.NoError(t, err)
+	require.EqualValues(t, "abc123/dark blue", *resp.Name)
+	require.EqualValues(t, `session-id=abc123; theme=dark%20blue`, recorder.header)
+}
+
+func TestGetProfileCookiesRoundTrip(t *testing.T) {
+	recorder := &cookieRecorder{}
+	var gotSessionID, gotTheme string
+	client := newClient(t, &fake.Server{
+		GetProfile: func(ctx context.Context, sessionID string, options *azcookies.ClientGetProfileOptions) (resp azfake.Responder[azcookies.ClientGetProfileResponse], errResp azfake.ErrorResponder) {
+			gotSessionID = sessionID
+			gotTheme = *options.Theme
+			resp.SetResponse(http.StatusOK, azcookies.ClientGetProfileResponse{}, nil)
+			return
+		},
+	}, recorder)
+
+	// bytes that aren't valid in a cookie value must survive the round trip
+	const sessionID = `a;b"c\dé`
+	const theme = "dark; blue, 100%"
+	_, err := client.GetProfile(context.Background(), sessionID, &azcookies.ClientGetProfileOptions{Theme: to.Ptr(theme)})
+	require.NoError(t, err)
+	require.EqualValues(t, sessionID, gotSessionID)
+	require.EqualValues(t, theme, gotTheme)
+	require.EqualValues(t, `session-id=a%3Bb%22c%5Cd%C3%A9; theme=dark%3B%20blue%2C%20100%25`, recorder.header)
+}
+
+func TestSignInSetCookie(t *testing.T) {
+	client := newClient(t, &fake.Server{
+		SignIn: func(ctx context.Context, options *azcookies.ClientSignInOptions) (resp azfake.Responder[azcookies.ClientSignInResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusNoContent, azcookies.ClientSignInResponse{
+				SetCookie: []*http.Cookie{
+					{Name: "session-id", Value: "abc123", HttpOnly: true},
+					{Name: "theme", Value: "dark"},
+				},
+			}, nil)
+			return
+		},
+	}, nil)
+
+	resp, err := client.SignIn(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, resp.SetCookie, 2)
+	require.EqualValues(t, "session-id", resp.SetCookie[0].Name)
+	require.EqualValues(t, "abc123", resp.SetCookie[0].Value)
+	require.True(t, resp.SetCookie[0].HttpOnly)
+	require.EqualValues(t, "theme", resp.SetCookie[1].Name)
+	require.EqualValues(t, "dark", resp.SetCookie[1].Value)
+}
@@ -0,0 +1,163 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azdecimal_test
+
+import (
+	"azdecimal"
+	"azdecimal/fake"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+const exact = "12345678901234567890.123456789"
+
+// bodyRecorder captures the request body sent on the wire
+type bodyRecorder struct {
+	body string
+}
+
+func (b *bodyRecorder) Do(req *policy.Request) (*http.Response, error) {
+	if req.Body() != nil {
+		data, err := io.ReadAll(req.Body())
+		if err != nil {
+			return nil, err
+		}
+		b.body = string(data)
+		if err := req.RewindBody(); err != nil {
+			return nil, err
+		}
+	}
+	return req.Next()
+}
+
+func newClient(t *testing.T, srv *fake.Server, recorder *bodyRecorder) *azdecimal.Client {
+	opts := &azdecimal.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerTransport(srv),
+		},
+	}
+	if recorder != nil {
+		opts.PerRetryPolicies = []policy.Policy{recorder}
+	}
+	client, err := azdecimal.NewClientWithNoCredential("https://contoso.com", opts)
+	require.NoError(t, err)
+	return client
+}
+
+func TestGetInvoiceDecimalParams(t *testing.T) {
+	client := newClient(t, &fake.Server{
+		GetInvoice: func(ctx context.Context, id string, total json.Number, options *azdecimal.ClientGetInvoiceOptions) (resp azfake.Responder[azdecimal.ClientGetInvoiceResponse], errResp azfake.ErrorResponder) {
+			require.EqualValues(t, "inv1", id)
+			require.EqualValues(t, exact, total)
+			require.NotNil(t, options)
+			require.EqualValues(t, "0.1", *options.MinAmount)
+			resp.SetResponse(http.StatusOK, azdecimal.ClientGetInvoiceResponse{
+				Balance: to.Ptr(json.Number("-0.000000000000000001")),
+				Invoice: azdecimal.Invoice{
+					Amount:    to.Ptr(json.Number(exact)),
+					LineItems: []*json.Number{to.Ptr(json.Number("0.1")), to.Ptr(json.Number("0.2"))},
+				},
+			}, nil)
+			return
+		},
+	}, nil)
+
+	resp, err := client.GetInvoice(context.Background(), "inv1", exact, &azdecimal.ClientGetInvoiceOptions{
+		MinAmount: to.Ptr(json.Number("0.1")),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, "-0.000000000000000001", *resp.Balance)
+	require.EqualValues(t, exact, *resp.Amount)
+	require.Len(t, resp.LineItems, 2)
+	require.EqualValues(t, "0.1", *resp.LineItems[0])
+	require.EqualValues(t, "0.2", *resp.LineItems[1])
+}
+
+func TestPutInvoiceDecimalBody(t *testing.T) {
+	recorder := &bodyRecorder{}
+	client := newClient(t, &fake.Server{
+		PutInvoice: func(ctx context.Context, id string, invoice azdecimal.Invoice, options *azdecimal.ClientPutInvoiceOptions) (resp azfake.Responder[azdecimal.ClientPutInvoiceResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, azdecimal.ClientPutInvoiceResponse{Invoice: invoice}, nil)
+			return
+		},
+	}, recorder)
+
+	resp, err := client.PutInvoice(context.Background(), "inv1", azdecimal.Invoice{
+		Amount: to.Ptr(json.Number(exact)),
+		Tax:    to.Ptr(json.Number("1.10")),
+	}, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":`+exact+`,"tax":"1.10"}`, recorder.body)
+	require.EqualValues(t, exact, *resp.Amount)
+	require.EqualValues(t, "1.10", *resp.Tax)
+}
+
+func TestPutInvoiceNullTax(t *testing.T) {
+	recorder := &bodyRecorder{}
+	client := newClient(t, &fake.Server{
+		PutInvoice: func(ctx context.Context, id string, invoice azdecimal.Invoice, options *azdecimal.ClientPutInvoiceOptions) (resp azfake.Responder[azdecimal.ClientPutInvoiceResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, azdecimal.ClientPutInvoiceResponse{Invoice: invoice}, nil)
+			return
+		},
+	}, recorder)
+
+	resp, err := client.PutInvoice(context.Background(), "inv1", azdecimal.Invoice{
+		Amount: to.Ptr(json.Number(exact)),
+		Tax:    azcore.NullValue[*json.Number](),
+	}, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":`+exact+`,"tax":null}`, recorder.body)
+	require.Nil(t, resp.Tax)
+}
+
+func TestPayInvoiceDecimalPathAndQuery(t *testing.T) {
+	client := newClient(t, &fake.Server{
+		PayInvoice: func(ctx context.Context, id string, amount json.Number, options *azdecimal.ClientPayInvoiceOptions) (resp azfake.Responder[azdecimal.ClientPayInvoiceResponse], errResp azfake.ErrorResponder) {
+			require.EqualValues(t, "inv1", id)
+			require.EqualValues(t, exact, amount)
+			require.NotNil(t, options)
+			require.EqualValues(t, "0.000001", *options.Fee)
+			resp.SetResponse(http.StatusNoContent, azdecimal.ClientPayInvoiceResponse{}, nil)
+			return
+		},
+	}, nil)
+
+	_, err := client.PayInvoice(context.Background(), "inv1", exact, &azdecimal.ClientPayInvoiceOptions{
+		Fee: to.Ptr(json.Number("0.000001")),
+	})
+	require.NoError(t, err)
+}
+
+func TestPutReceiptDecimalXML(t *testing.T) {
+	recorder := &bodyRecorder{}
+	client := newClient(t, &fake.Server{
+		PutReceipt: func(ctx context.Context, id string, receipt azdecimal.Receipt, options *azdecimal.ClientPutReceiptOptions) (resp azfake.Responder[azdecimal.ClientPutReceiptResponse], errResp azfake.ErrorResponder) {
+			require.EqualValues(t, exact, *receipt.Amount)
+			require.EqualValues(t, "1.10", *receipt.Tax)
+			resp.SetResponse(http.StatusOK, azdecimal.ClientPutReceiptResponse{Receipt: receipt}, nil)
+			return
+		},
+	}, recorder)
+
+	resp, err := client.PutReceipt(context.Background(), "rcpt1", azdecimal.Receipt{
+		Amount:   to.Ptr(json.Number(exact)),
+		Currency: to.Ptr("USD"),
+		Tax:      to.Ptr(json.Number("1.10")),
+	}, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, xml.Header+`<Receipt currency="USD"><Amount>`+exact+`</Amount><Tax>1.10</Tax></Receipt>`, recorder.body)
+	require.EqualValues(t, exact, *resp.Amount)
+	require.EqualValues(t, "USD", *resp.Currency)
+	require.EqualValues(t, "1.10", *resp.Tax)
+}
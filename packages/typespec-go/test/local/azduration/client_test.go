@@ -0,0 +1,142 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azduration_test
+
+import (
+	"azduration"
+	"azduration/fake"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+// wireRecorder captures the request as sent on the wire
+type wireRecorder struct {
+	body    string
+	query   string
+	timeout string
+}
+
+func (w *wireRecorder) Do(req *policy.Request) (*http.Response, error) {
+	w.query = req.Raw().URL.RawQuery
+	w.timeout = strings.Join(req.Raw().Header["x-ms-timeout"], ",")
+	if req.Body() != nil {
+		data, err := io.ReadAll(req.Body())
+		if err != nil {
+			return nil, err
+		}
+		w.body = string(data)
+		if err := req.RewindBody(); err != nil {
+			return nil, err
+		}
+	}
+	return req.Next()
+}
+
+func newClient(t *testing.T, srv *fake.Server, recorder *wireRecorder) *azduration.Client {
+	client, err := azduration.NewClientWithNoCredential("https://contoso.com", &azduration.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			PerRetryPolicies: []policy.Policy{recorder},
+			Transport:        fake.NewServerTransport(srv),
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestGetTimerDurationParams(t *testing.T) {
+	recorder := &wireRecorder{}
+	client := newClient(t, &fake.Server{
+		GetTimer: func(ctx context.Context, name string, timeout time.Duration, options *azduration.ClientGetTimerOptions) (resp azfake.Responder[azduration.ClientGetTimerResponse], errResp azfake.ErrorResponder) {
+			require.EqualValues(t, "t1", name)
+			require.EqualValues(t, 90*time.Minute+500*time.Millisecond, timeout)
+			require.NotNil(t, options)
+			require.EqualValues(t, 40*time.Second, *options.MaxAge)
+			resp.SetResponse(http.StatusOK, azduration.ClientGetTimerResponse{
+				RetryAfter: to.Ptr(30 * time.Second),
+				Timer: azduration.Timer{
+					Interval: to.Ptr(40 * 24 * time.Hour),
+				},
+			}, nil)
+			return
+		},
+	}, recorder)
+
+	resp, err := client.GetTimer(context.Background(), "t1", 90*time.Minute+500*time.Millisecond, &azduration.ClientGetTimerOptions{
+		MaxAge: to.Ptr(40 * time.Second),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, "PT1H30M0.5S", recorder.timeout)
+	require.EqualValues(t, "maxAge=40", recorder.query)
+	require.EqualValues(t, 30*time.Second, *resp.RetryAfter)
+	require.EqualValues(t, 40*24*time.Hour, *resp.Interval)
+}
+
+func TestPutTimerDurationBody(t *testing.T) {
+	recorder := &wireRecorder{}
+	client := newClient(t, &fake.Server{
+		PutTimer: func(ctx context.Context, name string, timer azduration.Timer, options *azduration.ClientPutTimerOptions) (resp azfake.Responder[azduration.ClientPutTimerResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, azduration.ClientPutTimerResponse{Timer: timer}, nil)
+			return
+		},
+	}, recorder)
+
+	timer := azduration.Timer{
+		History:  []*time.Duration{to.Ptr(time.Minute), to.Ptr(25 * time.Hour)},
+		Interval: to.Ptr(90 * time.Second),
+		Jitter:   to.Ptr(250 * time.Millisecond),
+		Timeout:  to.Ptr(35625 * time.Millisecond),
+	}
+	resp, err := client.PutTimer(context.Background(), "t1", timer, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"history":["PT1M","PT25H"],"interval":"PT1M30S","jitter":250,"timeout":35.625}`, recorder.body)
+	require.EqualValues(t, timer, resp.Timer)
+}
+
+func TestUnmarshalTimer(t *testing.T) {
+	var timer azduration.Timer
+	require.NoError(t, timer.UnmarshalJSON([]byte(`{"interval":"P1DT2H","timeout":36,"jitter":1500}`)))
+	require.EqualValues(t, 26*time.Hour, *timer.Interval)
+	require.EqualValues(t, 36*time.Second, *timer.Timeout)
+	require.EqualValues(t, 1500*time.Millisecond, *timer.Jitter)
+
+	require.Error(t, timer.UnmarshalJSON([]byte(`{"interval":"P1M"}`)))
+	require.Error(t, timer.UnmarshalJSON([]byte(`{"interval":"1h"}`)))
+}
+
+func TestIntegerDurationsAreRounded(t *testing.T) {
+	recorder := &wireRecorder{}
+	client := newClient(t, &fake.Server{
+		GetTimer: func(ctx context.Context, name string, timeout time.Duration, options *azduration.ClientGetTimerOptions) (resp azfake.Responder[azduration.ClientGetTimerResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, azduration.ClientGetTimerResponse{}, nil)
+			return
+		},
+	}, recorder)
+
+	_, err := client.GetTimer(context.Background(), "t1", time.Minute, &azduration.ClientGetTimerOptions{
+		MaxAge: to.Ptr(40*time.Second + 600*time.Millisecond),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, "maxAge=41", recorder.query)
+
+	data, err := azduration.Timer{
+		Jitter:  to.Ptr(250*time.Millisecond + 400*time.Microsecond),
+		Timeout: to.Ptr(1500 * time.Microsecond),
+	}.MarshalJSON()
+	require.NoError(t, err)
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(data, &payload))
+	require.EqualValues(t, 250, payload["jitter"])
+	require.EqualValues(t, 0.0015, payload["timeout"])
+}
@@ -0,0 +1,131 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azerrors_test
+
+import (
+	"azerrors"
+	"azerrors/fake"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeClient(t *testing.T, srv *fake.Server) *azerrors.Client {
+	client, err := azerrors.NewClientWithNoCredential("https://contoso.com", &azerrors.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerTransport(srv),
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestClient_GetWidgetTypedError(t *testing.T) {
+	client := newFakeClient(t, &fake.Server{
+		GetWidget: func(ctx context.Context, name string, options *azerrors.ClientGetWidgetOptions) (resp azfake.Responder[azerrors.ClientGetWidgetResponse], errResp azfake.ErrorResponder) {
+			fake.SetErrorResponseError(&errResp, http.StatusNotFound, azerrors.ErrorResponse{
+				Code:    to.Ptr("WidgetNotFound"),
+				Message: to.Ptr("widget " + name + " wasn't found"),
+				Details: []*azerrors.ErrorDetail{{Code: to.Ptr("MissingWidget"), Target: to.Ptr(name)}},
+			})
+			return
+		},
+	})
+
+	_, err := client.GetWidget(context.Background(), "missing", nil)
+	var typedErr *azerrors.ErrorResponseError
+	require.ErrorAs(t, err, &typedErr)
+	require.Equal(t, http.StatusNotFound, typedErr.StatusCode)
+	require.NotNil(t, typedErr.Body)
+	require.Equal(t, "WidgetNotFound", *typedErr.Body.Code)
+	require.Equal(t, "widget missing wasn't found", *typedErr.Body.Message)
+	require.Len(t, typedErr.Body.Details, 1)
+	require.Equal(t, "missing", *typedErr.Body.Details[0].Target)
+
+	// the typed error wraps the *azcore.ResponseError
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusNotFound, respErr.StatusCode)
+	require.Equal(t, "WidgetNotFound", respErr.ErrorCode)
+}
+
+func TestClient_GetWidgetUntypedBody(t *testing.T) {
+	client := newFakeClient(t, &fake.Server{
+		GetWidget: func(ctx context.Context, name string, options *azerrors.ClientGetWidgetOptions) (resp azfake.Responder[azerrors.ClientGetWidgetResponse], errResp azfake.ErrorResponder) {
+			errResp.SetResponseError(http.StatusBadRequest, "BadWidget")
+			return
+		},
+	})
+
+	// errors set with SetResponseError are returned as-is
+	_, err := client.GetWidget(context.Background(), "widget", nil)
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, "BadWidget", respErr.ErrorCode)
+}
+
+func TestClient_DeleteWidgetPlainError(t *testing.T) {
+	client := newFakeClient(t, &fake.Server{
+		DeleteWidget: func(ctx context.Context, name string, options *azerrors.ClientDeleteWidgetOptions) (resp azfake.Responder[azerrors.ClientDeleteWidgetResponse], errResp azfake.ErrorResponder) {
+			errResp.SetResponseError(http.StatusConflict, "WidgetInUse")
+			return
+		},
+	})
+
+	_, err := client.DeleteWidget(context.Background(), "widget", nil)
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, "WidgetInUse", respErr.ErrorCode)
+	var typedErr *azerrors.ErrorResponseError
+	require.False(t, errors.As(err, &typedErr))
+}
+
+func TestClient_BeginExportWidgetTypedError(t *testing.T) {
+	client := newFakeClient(t, &fake.Server{
+		BeginExportWidget: func(ctx context.Context, name string, options *azerrors.ClientBeginExportWidgetOptions) (resp azfake.PollerResponder[azerrors.ClientExportWidgetResponse], errResp azfake.ErrorResponder) {
+			fake.SetErrorResponseError(&errResp, http.StatusConflict, azerrors.ErrorResponse{
+				Code:    to.Ptr("ExportInProgress"),
+				Message: to.Ptr("widget " + name + " is already being exported"),
+			})
+			return
+		},
+	})
+
+	// the initial request returns the typed error
+	_, err := client.BeginExportWidget(context.Background(), "widget", nil)
+	var typedErr *azerrors.ErrorResponseError
+	require.ErrorAs(t, err, &typedErr)
+	require.Equal(t, http.StatusConflict, typedErr.StatusCode)
+	require.NotNil(t, typedErr.Body)
+	require.Equal(t, "ExportInProgress", *typedErr.Body.Code)
+}
+
+func TestClient_BeginExportWidgetPollerError(t *testing.T) {
+	client := newFakeClient(t, &fake.Server{
+		BeginExportWidget: func(ctx context.Context, name string, options *azerrors.ClientBeginExportWidgetOptions) (resp azfake.PollerResponder[azerrors.ClientExportWidgetResponse], errResp azfake.ErrorResponder) {
+			resp.AddNonTerminalResponse(http.StatusAccepted, nil)
+			resp.SetTerminalError(http.StatusInternalServerError, "ExportFailed")
+			return
+		},
+	})
+
+	poller, err := client.BeginExportWidget(context.Background(), "widget", nil)
+	require.NoError(t, err)
+
+	// errors returned by the poller are plain *azcore.ResponseError
+	_, err = poller.PollUntilDone(context.Background(), &runtime.PollUntilDoneOptions{Frequency: time.Millisecond})
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, "ExportFailed", respErr.ErrorCode)
+	var typedErr *azerrors.ErrorResponseError
+	require.False(t, errors.As(err, &typedErr))
+}
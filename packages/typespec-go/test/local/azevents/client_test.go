@@ -0,0 +1,178 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azevents_test
+
+import (
+	"azevents"
+	"azevents/fake"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, transport policy.Transporter) *azevents.Client {
+	client, err := azevents.NewClientWithNoCredential("https://contoso.com", &azevents.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: transport,
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+// wireTransport returns the same raw response body for every request
+type wireTransport struct {
+	body string
+}
+
+func (w wireTransport) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(w.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestListWidgets(t *testing.T) {
+	widgets := []azevents.Widget{
+		{Name: to.Ptr("gear"), Count: to.Ptr[int32](1)},
+		{Name: to.Ptr("sprocket")},
+		{Name: to.Ptr("cog"), Count: to.Ptr[int32](3)},
+	}
+	client := newClient(t, fake.NewServerTransport(&fake.Server{
+		ListWidgets: func(ctx context.Context, options *azevents.ClientListWidgetsOptions) (resp azfake.Responder[azevents.ClientListWidgetsResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, azevents.ClientListWidgetsResponse{Stream: azevents.NewEventStream(widgets...)}, nil)
+			return
+		},
+	}))
+	resp, err := client.ListWidgets(context.Background(), nil)
+	require.NoError(t, err)
+	var got []azevents.Widget
+	for widget, err := range resp.Stream.All(context.Background()) {
+		require.NoError(t, err)
+		got = append(got, widget)
+	}
+	require.EqualValues(t, widgets, got)
+}
+
+func TestListWidgetsFromWire(t *testing.T) {
+	client := newClient(t, wireTransport{body: "{\"name\":\"gear\"}\n\n{\"name\":\"cog\",\"count\":2}"})
+	resp, err := client.ListWidgets(context.Background(), nil)
+	require.NoError(t, err)
+	var names []string
+	for widget, err := range resp.Stream.All(context.Background()) {
+		require.NoError(t, err)
+		names = append(names, *widget.Name)
+	}
+	require.EqualValues(t, []string{"gear", "cog"}, names)
+}
+
+func TestListWidgetsMalformed(t *testing.T) {
+	client := newClient(t, wireTransport{body: "{\"name\":\"gear\"}\nnot json\n{\"name\":\"cog\"}\n"})
+	resp, err := client.ListWidgets(context.Background(), nil)
+	require.NoError(t, err)
+	var errs int
+	for _, err := range resp.Stream.All(context.Background()) {
+		if err != nil {
+			errs++
+		}
+	}
+	require.EqualValues(t, 1, errs)
+}
+
+func TestWatchJob(t *testing.T) {
+	client := newClient(t, fake.NewServerTransport(&fake.Server{
+		WatchJob: func(ctx context.Context, id string, options *azevents.ClientWatchJobOptions) (resp azfake.Responder[azevents.ClientWatchJobResponse], errResp azfake.ErrorResponder) {
+			require.EqualValues(t, "job1", id)
+			resp.SetResponse(http.StatusOK, azevents.ClientWatchJobResponse{Stream: azevents.NewEventStream[azevents.JobEvent](
+				&azevents.JobEventProgress{Value: &azevents.Progress{Percent: to.Ptr[int32](50)}},
+				&azevents.JobEventLog{Value: "line one\nline two"},
+				&azevents.JobEventProgress{Value: &azevents.Progress{Percent: to.Ptr[int32](100), Message: to.Ptr("finished")}},
+			)}, nil)
+			return
+		},
+	}))
+	resp, err := client.WatchJob(context.Background(), "job1", nil)
+	require.NoError(t, err)
+	var events []azevents.JobEvent
+	for event, err := range resp.Stream.All(context.Background()) {
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+	require.Len(t, events, 3)
+	require.EqualValues(t, 50, *events[0].(*azevents.JobEventProgress).Value.Percent)
+	require.EqualValues(t, "line one\nline two", events[1].(*azevents.JobEventLog).Value)
+	require.EqualValues(t, "finished", *events[2].(*azevents.JobEventProgress).Value.Message)
+}
+
+func TestWatchJobFromWire(t *testing.T) {
+	const body = ": keep-alive\n\n" +
+		"event: progress\r\ndata: {\"percent\":10}\r\n\r\n" +
+		"event: heartbeat\ndata: ignored\n\n" +
+		"event: log\ndata:first\ndata: second\n\n" +
+		"event: done\ndata: [DONE]\n\n" +
+		"event: log\ndata: never read\n\n"
+	client := newClient(t, wireTransport{body: body})
+	resp, err := client.WatchJob(context.Background(), "job1", nil)
+	require.NoError(t, err)
+	var events []azevents.JobEvent
+	for event, err := range resp.Stream.All(context.Background()) {
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+	require.Len(t, events, 2)
+	require.EqualValues(t, 10, *events[0].(*azevents.JobEventProgress).Value.Percent)
+	require.EqualValues(t, "first\nsecond", events[1].(*azevents.JobEventLog).Value)
+}
+
+func TestWatchJobCanceled(t *testing.T) {
+	client := newClient(t, fake.NewServerTransport(&fake.Server{
+		WatchJob: func(ctx context.Context, id string, options *azevents.ClientWatchJobOptions) (resp azfake.Responder[azevents.ClientWatchJobResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, azevents.ClientWatchJobResponse{Stream: azevents.NewEventStream[azevents.JobEvent](
+				&azevents.JobEventLog{Value: "one"},
+				&azevents.JobEventLog{Value: "two"},
+				&azevents.JobEventLog{Value: "three"},
+			)}, nil)
+			return
+		},
+	}))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp, err := client.WatchJob(ctx, "job1", nil)
+	require.NoError(t, err)
+	var logs []string
+	var iterErr error
+	for event, err := range resp.Stream.All(ctx) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		logs = append(logs, event.(*azevents.JobEventLog).Value)
+		cancel()
+	}
+	require.EqualValues(t, []string{"one"}, logs)
+	require.ErrorIs(t, iterErr, context.Canceled)
+}
+
+func TestWatchJobError(t *testing.T) {
+	client := newClient(t, fake.NewServerTransport(&fake.Server{
+		WatchJob: func(ctx context.Context, id string, options *azevents.ClientWatchJobOptions) (resp azfake.Responder[azevents.ClientWatchJobResponse], errResp azfake.ErrorResponder) {
+			errResp.SetResponseError(http.StatusNotFound, "JobNotFound")
+			return
+		},
+	}))
+	_, err := client.WatchJob(context.Background(), "job1", nil)
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.EqualValues(t, "JobNotFound", respErr.ErrorCode)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package aznextpage_test
+
+import (
+	"aznextpage"
+	"aznextpage/fake"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+// requestRecorder captures the requests sent on the wire
+type requestRecorder struct {
+	reqs []*http.Request
+}
+
+func (r *requestRecorder) Do(req *policy.Request) (*http.Response, error) {
+	r.reqs = append(r.reqs, req.Raw())
+	return req.Next()
+}
+
+func TestNewListPager(t *testing.T) {
+	var filter *string
+	srv := fake.Server{
+		NewListPager: func(options *aznextpage.ClientListOptions) (resp azfake.PagerResponder[aznextpage.ClientListResponse]) {
+			filter = options.Filter
+			resp.AddPage(http.StatusOK, aznextpage.ClientListResponse{
+				WidgetList: aznextpage.WidgetList{
+					Value: []*aznextpage.Widget{{Name: to.Ptr("one")}},
+				},
+			}, nil)
+			resp.AddPage(http.StatusOK, aznextpage.ClientListResponse{
+				WidgetList: aznextpage.WidgetList{
+					Value: []*aznextpage.Widget{{Name: to.Ptr("two")}, {Name: to.Ptr("three")}},
+				},
+			}, nil)
+			return
+		},
+	}
+	recorder := &requestRecorder{}
+	client, err := aznextpage.NewClientWithNoCredential("https://contoso.com", &aznextpage.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport:        fake.NewServerTransport(&srv),
+			PerRetryPolicies: []policy.Policy{recorder},
+		},
+	})
+	require.NoError(t, err)
+
+	pager := client.NewListPager(&aznextpage.ClientListOptions{
+		Filter: to.Ptr("red"),
+	})
+	var names []string
+	for pager.More() {
+		page, err := pager.NextPage(context.Background())
+		require.NoError(t, err)
+		for _, widget := range page.Value {
+			names = append(names, *widget.Name)
+		}
+	}
+	require.Equal(t, []string{"one", "two", "three"}, names)
+	require.Equal(t, to.Ptr("red"), filter)
+
+	// the first page is fetched from the list operation and
+	// the second page from the next page operation
+	require.Len(t, recorder.reqs, 2)
+	require.Equal(t, "/widgets", recorder.reqs[0].URL.Path)
+	require.Empty(t, recorder.reqs[0].Header["next-link"])
+	require.Equal(t, "/widgets/next", recorder.reqs[1].URL.Path)
+	require.Equal(t, "red", recorder.reqs[1].URL.Query().Get("filter"))
+	require.Len(t, recorder.reqs[1].Header["next-link"], 1)
+	require.Contains(t, recorder.reqs[1].Header["next-link"][0], "/widgets")
+}
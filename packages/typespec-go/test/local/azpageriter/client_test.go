@@ -0,0 +1,179 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azpageriter_test
+
+import (
+	"azpageriter"
+	"azpageriter/fake"
+	"context"
+	"iter"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeClient(t *testing.T, srv *fake.Server) *azpageriter.Client {
+	client, err := azpageriter.NewClientWithNoCredential("https://contoso.com", &azpageriter.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerTransport(srv),
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func widgetPages(color string) azfake.PagerResponder[azpageriter.ClientListWidgetsByColorResponse] {
+	resp := azfake.PagerResponder[azpageriter.ClientListWidgetsByColorResponse]{}
+	resp.AddPage(http.StatusOK, azpageriter.ClientListWidgetsByColorResponse{
+		WidgetList: azpageriter.WidgetList{
+			Value: []*azpageriter.Widget{
+				{Name: to.Ptr("widget1"), Color: to.Ptr(color)},
+				{Name: to.Ptr("widget2"), Color: to.Ptr(color)},
+			},
+		},
+	}, nil)
+	// an empty page in the middle of the sequence
+	resp.AddPage(http.StatusOK, azpageriter.ClientListWidgetsByColorResponse{}, nil)
+	resp.AddPage(http.StatusOK, azpageriter.ClientListWidgetsByColorResponse{
+		WidgetList: azpageriter.WidgetList{
+			Value: []*azpageriter.Widget{
+				{Name: to.Ptr("widget3"), Color: to.Ptr(color)},
+			},
+		},
+	}, nil)
+	return resp
+}
+
+func TestClient_AllListWidgetsByColor(t *testing.T) {
+	client := newFakeClient(t, &fake.Server{
+		NewListWidgetsByColorPager: func(color string, options *azpageriter.ClientListWidgetsByColorOptions) (resp azfake.PagerResponder[azpageriter.ClientListWidgetsByColorResponse]) {
+			return widgetPages(color)
+		},
+	})
+
+	var names []string
+	for widget, err := range client.AllListWidgetsByColor(context.Background(), "red", nil) {
+		require.NoError(t, err)
+		require.Equal(t, "red", *widget.Color)
+		names = append(names, *widget.Name)
+	}
+	require.Equal(t, []string{"widget1", "widget2", "widget3"}, names)
+}
+
+func TestClient_AllListWidgetsBreak(t *testing.T) {
+	calls := 0
+	client := newFakeClient(t, &fake.Server{
+		NewListWidgetsByColorPager: func(color string, options *azpageriter.ClientListWidgetsByColorOptions) (resp azfake.PagerResponder[azpageriter.ClientListWidgetsByColorResponse]) {
+			calls++
+			return widgetPages(color)
+		},
+	})
+
+	for widget, err := range client.AllListWidgetsByColor(context.Background(), "blue", nil) {
+		require.NoError(t, err)
+		require.Equal(t, "widget1", *widget.Name)
+		break
+	}
+
+	// the abandoned pager doesn't leak into the next iteration
+	var names []string
+	for widget, err := range client.AllListWidgetsByColor(context.Background(), "blue", nil) {
+		require.NoError(t, err)
+		names = append(names, *widget.Name)
+	}
+	require.Equal(t, []string{"widget1", "widget2", "widget3"}, names)
+	require.Equal(t, 2, calls)
+}
+
+func TestClient_AllListWidgetsError(t *testing.T) {
+	client := newFakeClient(t, &fake.Server{
+		NewListWidgetsPager: func(options *azpageriter.ClientListWidgetsOptions) (resp azfake.PagerResponder[azpageriter.ClientListWidgetsResponse]) {
+			require.NotNil(t, options)
+			require.Equal(t, "green", *options.Color)
+			resp.AddPage(http.StatusOK, azpageriter.ClientListWidgetsResponse{
+				WidgetList: azpageriter.WidgetList{
+					Value: []*azpageriter.Widget{
+						{Name: to.Ptr("widget1"), Color: to.Ptr("green")},
+					},
+				},
+			}, nil)
+			resp.AddResponseError(http.StatusBadRequest, "BadRequest")
+			return
+		},
+	})
+
+	var names []string
+	var iterErr error
+	for widget, err := range client.AllListWidgets(context.Background(), &azpageriter.ClientListWidgetsOptions{Color: to.Ptr("green")}) {
+		if err != nil {
+			require.Nil(t, widget)
+			iterErr = err
+			continue
+		}
+		names = append(names, *widget.Name)
+	}
+	require.Equal(t, []string{"widget1"}, names)
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, iterErr, &respErr)
+	require.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+}
+
+// mockClient replaces AllListWidgets and panics for the methods it doesn't implement
+type mockClient struct {
+	azpageriter.ClientAPI
+	widgets []*azpageriter.Widget
+}
+
+func (m *mockClient) AllListWidgets(ctx context.Context, options *azpageriter.ClientListWidgetsOptions) iter.Seq2[*azpageriter.Widget, error] {
+	return func(yield func(*azpageriter.Widget, error) bool) {
+		for _, widget := range m.widgets {
+			if !yield(widget, nil) {
+				return
+			}
+		}
+	}
+}
+
+func widgetNames(ctx context.Context, client azpageriter.ClientAPI) ([]string, error) {
+	var names []string
+	for widget, err := range client.AllListWidgets(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, *widget.Name)
+	}
+	return names, nil
+}
+
+func TestClientAPI_Mock(t *testing.T) {
+	names, err := widgetNames(context.Background(), &mockClient{
+		widgets: []*azpageriter.Widget{
+			{Name: to.Ptr("widget1"), Color: to.Ptr("red")},
+			{Name: to.Ptr("widget2"), Color: to.Ptr("blue")},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"widget1", "widget2"}, names)
+
+	// the concrete client satisfies the same interface
+	client := newFakeClient(t, &fake.Server{
+		NewListWidgetsPager: func(options *azpageriter.ClientListWidgetsOptions) (resp azfake.PagerResponder[azpageriter.ClientListWidgetsResponse]) {
+			resp.AddPage(http.StatusOK, azpageriter.ClientListWidgetsResponse{
+				WidgetList: azpageriter.WidgetList{
+					Value: []*azpageriter.Widget{
+						{Name: to.Ptr("widget3"), Color: to.Ptr("green")},
+					},
+				},
+			}, nil)
+			return
+		},
+	})
+	names, err = widgetNames(context.Background(), client)
+	require.NoError(t, err)
+	require.Equal(t, []string{"widget3"}, names)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azstatuscodes_test
+
+import (
+	"azstatuscodes"
+	"azstatuscodes/fake"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeClient(t *testing.T, statusCode int) *azstatuscodes.Client {
+	client, err := azstatuscodes.NewClientWithNoCredential("https://contoso.com", &azstatuscodes.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerTransport(&fake.Server{
+				PutThing: func(ctx context.Context, name string, widget azstatuscodes.Widget, options *azstatuscodes.ClientPutThingOptions) (resp azfake.Responder[azstatuscodes.ClientPutThingResponse], errResp azfake.ErrorResponder) {
+					var body azstatuscodes.ClientPutThingResponse
+					switch statusCode {
+					case http.StatusOK:
+						body.Widget = &widget
+					case http.StatusCreated:
+						body.Gadget = &azstatuscodes.Gadget{ID: to.Ptr("gadget-" + name)}
+					case http.StatusAccepted:
+						body.Value202 = []*string{to.Ptr("queued")}
+					}
+					resp.SetResponse(statusCode, body, nil)
+					return
+				},
+			}),
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestClient_PutThing(t *testing.T) {
+	widget := azstatuscodes.Widget{Name: to.Ptr("thing"), Count: to.Ptr[int32](3)}
+
+	resp, err := newFakeClient(t, http.StatusOK).PutThing(context.Background(), "thing", widget, nil)
+	require.NoError(t, err)
+	require.Equal(t, &widget, resp.Widget)
+	require.Nil(t, resp.Gadget)
+	require.Nil(t, resp.Value202)
+
+	resp, err = newFakeClient(t, http.StatusCreated).PutThing(context.Background(), "thing", widget, nil)
+	require.NoError(t, err)
+	require.Nil(t, resp.Widget)
+	require.Equal(t, "gadget-thing", *resp.Gadget.ID)
+	require.Nil(t, resp.Value202)
+
+	resp, err = newFakeClient(t, http.StatusAccepted).PutThing(context.Background(), "thing", widget, nil)
+	require.NoError(t, err)
+	require.Nil(t, resp.Widget)
+	require.Nil(t, resp.Gadget)
+	require.Equal(t, []*string{to.Ptr("queued")}, resp.Value202)
+
+	resp, err = newFakeClient(t, http.StatusNoContent).PutThing(context.Background(), "thing", widget, nil)
+	require.NoError(t, err)
+	require.Zero(t, resp)
+}
+
+func TestClient_PutThingUnexpectedStatus(t *testing.T) {
+	_, err := newFakeClient(t, http.StatusPartialContent).PutThing(context.Background(), "thing", azstatuscodes.Widget{Name: to.Ptr("thing")}, nil)
+	require.ErrorContains(t, err, "unexpected status code 206")
+}
@@ -0,0 +1,182 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azstatusmonitor_test
+
+import (
+	"azstatusmonitor"
+	"azstatusmonitor/fake"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, transport policy.Transporter) *azstatusmonitor.Client {
+	client, err := azstatusmonitor.NewClientWithNoCredential("https://contoso.com", &azstatusmonitor.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: transport,
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+// exportTransport accepts the export request and then
+// returns the status monitors in order for each poll
+type exportTransport struct {
+	statuses []string
+}
+
+func (e *exportTransport) Do(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:  http.Header{},
+		Request: req,
+	}
+	switch {
+	case req.Method == http.MethodPost && req.URL.Path == "/widgets/widget1:export":
+		resp.StatusCode = http.StatusAccepted
+		resp.Header.Set("Operation-Location", "https://contoso.com/exports/export1")
+		resp.Body = http.NoBody
+	case req.Method == http.MethodGet && req.URL.Path == "/exports/export1" && len(e.statuses) > 0:
+		resp.StatusCode = http.StatusOK
+		resp.Header.Set("Content-Type", "application/json")
+		resp.Body = io.NopCloser(strings.NewReader(e.statuses[0]))
+		e.statuses = e.statuses[1:]
+	default:
+		resp.StatusCode = http.StatusNotFound
+		resp.Body = http.NoBody
+	}
+	return resp, nil
+}
+
+func TestStatusMonitorPoller(t *testing.T) {
+	client := newClient(t, &exportTransport{statuses: []string{
+		`{"id":"export1","status":"Running","percentComplete":10}`,
+		`{"id":"export1","status":"Running","percentComplete":60}`,
+		`{"id":"export1","status":"Succeeded","percentComplete":100,"result":{"blobUrl":"https://contoso.com/blobs/export1"}}`,
+	}})
+	poller, err := client.BeginExport(context.Background(), "widget1", azstatusmonitor.ExportOptions{Format: to.Ptr("json")}, nil)
+	require.NoError(t, err)
+	require.Nil(t, poller.Status())
+
+	var progress []int32
+	for !poller.Done() {
+		_, err := poller.Poll(context.Background())
+		require.NoError(t, err)
+		status := poller.Status()
+		require.NotNil(t, status)
+		require.Equal(t, "export1", *status.ID)
+		progress = append(progress, *status.PercentComplete)
+	}
+	require.Equal(t, []int32{10, 60, 100}, progress)
+	require.Equal(t, azstatusmonitor.OperationStateSucceeded, *poller.Status().Status)
+
+	result, err := poller.Result(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "https://contoso.com/blobs/export1", *result.BlobURL)
+}
+
+func TestStatusMonitorPollerFailed(t *testing.T) {
+	client := newClient(t, &exportTransport{statuses: []string{
+		`{"id":"export1","status":"Running","percentComplete":50}`,
+		`{"id":"export1","status":"Failed","percentComplete":50,"error":{"code":"QuotaExceeded","message":"export quota exceeded"}}`,
+	}})
+	poller, err := client.BeginExport(context.Background(), "widget1", azstatusmonitor.ExportOptions{Format: to.Ptr("json")}, nil)
+	require.NoError(t, err)
+
+	_, err = poller.PollUntilDone(context.Background(), &runtime.PollUntilDoneOptions{Frequency: time.Millisecond})
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+
+	status := poller.Status()
+	require.NotNil(t, status)
+	require.Equal(t, azstatusmonitor.OperationStateFailed, *status.Status)
+	require.EqualValues(t, 50, *status.PercentComplete)
+	require.Equal(t, "QuotaExceeded", *status.Error.Code)
+	require.Equal(t, "export quota exceeded", *status.Error.Message)
+}
+
+func TestStatusMonitorPollerResumeToken(t *testing.T) {
+	client := newClient(t, &exportTransport{statuses: []string{
+		`{"id":"export1","status":"Running","percentComplete":25}`,
+		`{"id":"export1","status":"Succeeded","percentComplete":100,"result":{"blobUrl":"https://contoso.com/blobs/export1"}}`,
+	}})
+	poller, err := client.BeginExport(context.Background(), "widget1", azstatusmonitor.ExportOptions{Format: to.Ptr("json")}, nil)
+	require.NoError(t, err)
+	token, err := poller.ResumeToken()
+	require.NoError(t, err)
+
+	resumed, err := client.BeginExport(context.Background(), "widget1", azstatusmonitor.ExportOptions{}, &azstatusmonitor.ClientBeginExportOptions{
+		ResumeToken: token,
+	})
+	require.NoError(t, err)
+	require.Nil(t, resumed.Status())
+
+	result, err := resumed.PollUntilDone(context.Background(), &runtime.PollUntilDoneOptions{Frequency: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, "https://contoso.com/blobs/export1", *result.BlobURL)
+	require.EqualValues(t, 100, *resumed.Status().PercentComplete)
+}
+
+func TestStatusMonitorPollerRetryAfter(t *testing.T) {
+	transport := &retryAfterTransport{exportTransport: exportTransport{statuses: []string{
+		`{"id":"export1","status":"Running","percentComplete":50}`,
+		`{"id":"export1","status":"Succeeded","percentComplete":100,"result":{"blobUrl":"https://contoso.com/blobs/export1"}}`,
+	}}}
+	client := newClient(t, transport)
+	poller, err := client.BeginExport(context.Background(), "widget1", azstatusmonitor.ExportOptions{Format: to.Ptr("json")}, nil)
+	require.NoError(t, err)
+
+	// the frequency is longer than the test timeout so only the Retry-After header can be used
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	result, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, "https://contoso.com/blobs/export1", *result.BlobURL)
+	require.EqualValues(t, 100, *poller.Status().PercentComplete)
+}
+
+// retryAfterTransport adds a short retry-after-ms header to every response
+type retryAfterTransport struct {
+	exportTransport
+}
+
+func (r *retryAfterTransport) Do(req *http.Request) (*http.Response, error) {
+	resp, err := r.exportTransport.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Header.Set("retry-after-ms", "10")
+	return resp, nil
+}
+
+// fake pollers only return the final result so the
+// status monitor isn't available when using fakes
+func TestStatusMonitorPollerFake(t *testing.T) {
+	client := newClient(t, fake.NewServerTransport(&fake.Server{
+		BeginExport: func(ctx context.Context, name string, body azstatusmonitor.ExportOptions, options *azstatusmonitor.ClientBeginExportOptions) (resp azfake.PollerResponder[azstatusmonitor.ClientExportResponse], errResp azfake.ErrorResponder) {
+			require.Equal(t, "widget1", name)
+			require.Equal(t, "json", *body.Format)
+			resp.AddNonTerminalResponse(http.StatusAccepted, nil)
+			resp.SetTerminalResponse(http.StatusOK, azstatusmonitor.ClientExportResponse{
+				ExportResult: azstatusmonitor.ExportResult{BlobURL: to.Ptr("https://contoso.com/blobs/export1")},
+			}, nil)
+			return
+		},
+	}))
+	poller, err := client.BeginExport(context.Background(), "widget1", azstatusmonitor.ExportOptions{Format: to.Ptr("json")}, nil)
+	require.NoError(t, err)
+	result, err := poller.PollUntilDone(context.Background(), &runtime.PollUntilDoneOptions{Frequency: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, "https://contoso.com/blobs/export1", *result.BlobURL)
+}
@@ -0,0 +1,133 @@
+package azstreamingserde
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+)
+
+func TestWidgetRoundTrip(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2024-01-01T12:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := Widget{
+		Name:      to.Ptr("widget \"one\" <&>"),
+		Count:     to.Ptr[int32](3),
+		Size:      to.Ptr[int64](1 << 40),
+		Ratio:     to.Ptr(0.25),
+		Enabled:   to.Ptr(false),
+		CreatedAt: &createdAt,
+		Data:      []byte("payload"),
+		Tags:      []*string{to.Ptr("a"), to.Ptr("b")},
+		Labels:    map[string]*string{"env": to.Ptr("test")},
+		Color:     to.Ptr(WidgetColorRed),
+		Parts:     []*Part{{ID: to.Ptr[int32](1), Description: to.Ptr("first")}},
+		Metadata:  map[string]any{"nested": []any{float64(1), "two"}},
+	}
+	data, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !json.Valid(data) {
+		t.Fatalf("invalid JSON: %s", data)
+	}
+	var got Widget
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(w, got) {
+		t.Fatalf("round trip mismatch:\nwant %#v\ngot  %#v", w, got)
+	}
+}
+
+func TestWidgetMarshalNullValue(t *testing.T) {
+	w := Widget{
+		Name:  to.Ptr("widget"),
+		Count: azcore.NullValue[*int32](),
+		Tags:  azcore.NullValue[[]*string](),
+		Data:  azcore.NullValue[[]byte](),
+	}
+	data, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `{"name":"widget","count":null,"data":null,"tags":null}`
+	if string(data) != want {
+		t.Fatalf("want %s, got %s", want, data)
+	}
+}
+
+func TestWidgetUnmarshal(t *testing.T) {
+	w := Widget{Count: to.Ptr[int32](5)}
+	data := []byte(`{
+		"name": "widget",
+		"count" : null,
+		"unknown": {"a": [1, 2, {"b": null}]},
+		"data": "cGF5bG9hZA==",
+		"createdAt": "2024-01-01T12:00:00Z"
+	}`)
+	if err := json.Unmarshal(data, &w); err != nil {
+		t.Fatal(err)
+	}
+	if w.Name == nil || *w.Name != "widget" {
+		t.Fatalf("unexpected name %v", w.Name)
+	}
+	if w.Count == nil || *w.Count != 5 {
+		t.Fatal("null value should leave the field unchanged")
+	}
+	if string(w.Data) != "payload" {
+		t.Fatalf("unexpected data %q", w.Data)
+	}
+	if w.CreatedAt == nil || w.CreatedAt.Year() != 2024 {
+		t.Fatalf("unexpected createdAt %v", w.CreatedAt)
+	}
+}
+
+func TestWidgetUnmarshalNull(t *testing.T) {
+	w := Widget{Name: to.Ptr("widget")}
+	if err := json.Unmarshal([]byte("null"), &w); err != nil {
+		t.Fatal(err)
+	}
+	if w.Name == nil {
+		t.Fatal("unexpected change to widget")
+	}
+}
+
+func TestWidgetUnmarshalErrors(t *testing.T) {
+	for _, data := range []string{
+		`{"count": "three"}`,
+		`{"createdAt": 1}`,
+		`{"data": "not base64!"}`,
+		`[1, 2]`,
+	} {
+		var w Widget
+		if err := w.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("expected error for %s", data)
+		}
+	}
+}
+
+func TestWidgetBagRoundTrip(t *testing.T) {
+	bag := WidgetBag{
+		Name: to.Ptr("bag"),
+		AdditionalProperties: map[string]*string{
+			"color": to.Ptr("red"),
+		},
+	}
+	data, err := json.Marshal(bag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got WidgetBag
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(bag, got) {
+		t.Fatalf("round trip mismatch:\nwant %#v\ngot  %#v", bag, got)
+	}
+}
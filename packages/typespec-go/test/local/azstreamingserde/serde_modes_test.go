@@ -0,0 +1,143 @@
+package azstreamingserde_test
+
+import (
+	"azstreamingserde"
+	"azstreamingserde/mapserde"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+)
+
+// mapserde contains the same models as azstreamingserde, generated without
+// streaming-json-serde, so both serde modes can be compared on identical input.
+
+func streamingWidget(createdAt time.Time) azstreamingserde.Widget {
+	return azstreamingserde.Widget{
+		Name:      to.Ptr("widget"),
+		Count:     to.Ptr[int32](3),
+		Size:      to.Ptr[int64](1 << 40),
+		Ratio:     to.Ptr(0.25),
+		Enabled:   to.Ptr(true),
+		CreatedAt: &createdAt,
+		Data:      []byte("payload"),
+		Tags:      []*string{to.Ptr("a"), to.Ptr("b")},
+		Labels:    map[string]*string{"env": to.Ptr("test")},
+		Color:     to.Ptr(azstreamingserde.WidgetColorRed),
+		Parts:     []*azstreamingserde.Part{{ID: to.Ptr[int32](1), Description: to.Ptr("first")}},
+		Metadata:  map[string]any{"nested": []any{float64(1), "two"}},
+	}
+}
+
+func mapWidget(createdAt time.Time) mapserde.Widget {
+	return mapserde.Widget{
+		Name:      to.Ptr("widget"),
+		Count:     to.Ptr[int32](3),
+		Size:      to.Ptr[int64](1 << 40),
+		Ratio:     to.Ptr(0.25),
+		Enabled:   to.Ptr(true),
+		CreatedAt: &createdAt,
+		Data:      []byte("payload"),
+		Tags:      []*string{to.Ptr("a"), to.Ptr("b")},
+		Labels:    map[string]*string{"env": to.Ptr("test")},
+		Color:     to.Ptr(mapserde.WidgetColorRed),
+		Parts:     []*mapserde.Part{{ID: to.Ptr[int32](1), Description: to.Ptr("first")}},
+		Metadata:  map[string]any{"nested": []any{float64(1), "two"}},
+	}
+}
+
+func TestSerDeModesParity(t *testing.T) {
+	createdAt, err := time.Parse(time.RFC3339, "2024-01-01T12:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	streamingData, err := json.Marshal(streamingWidget(createdAt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapData, err := json.Marshal(mapWidget(createdAt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the map serde writes keys in sorted order so compare the decoded payloads
+	var streamingDoc, mapDoc map[string]any
+	if err := json.Unmarshal(streamingData, &streamingDoc); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(mapData, &mapDoc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(streamingDoc, mapDoc) {
+		t.Fatalf("payload mismatch:\nstreaming %s\nmap       %s", streamingData, mapData)
+	}
+
+	// each mode must decode the payload written by the other one
+	var fromMap azstreamingserde.Widget
+	if err := json.Unmarshal(mapData, &fromMap); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(streamingWidget(createdAt), fromMap) {
+		t.Fatalf("streaming unmarshal mismatch: %#v", fromMap)
+	}
+	var fromStreaming mapserde.Widget
+	if err := json.Unmarshal(streamingData, &fromStreaming); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(mapWidget(createdAt), fromStreaming) {
+		t.Fatalf("map unmarshal mismatch: %#v", fromStreaming)
+	}
+}
+
+// BenchmarkSerDeModes runs the same Widget through both serde modes.
+// Compare the streaming and map results of each operation, e.g.
+//
+//	go test -run=NONE -bench=SerDeModes -count=10 | benchstat -col /mode -
+func BenchmarkSerDeModes(b *testing.B) {
+	createdAt, err := time.Parse(time.RFC3339, "2024-01-01T12:00:00Z")
+	if err != nil {
+		b.Fatal(err)
+	}
+	sw := streamingWidget(createdAt)
+	mw := mapWidget(createdAt)
+	data, err := json.Marshal(sw)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("MarshalJSON/mode=streaming", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := json.Marshal(sw); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("MarshalJSON/mode=map", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := json.Marshal(mw); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("UnmarshalJSON/mode=streaming", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var target azstreamingserde.Widget
+			if err := json.Unmarshal(data, &target); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("UnmarshalJSON/mode=map", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var target mapserde.Widget
+			if err := json.Unmarshal(data, &target); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,8 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azstreamingserde
+
+// suppress unused vars lint
+var _ = moduleName
+var _ = moduleVersion
@@ -0,0 +1,10 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+// Code generated by Microsoft (R) Go Code Generator.
+
+package azstreamingserde
+
+const (
+	moduleName    = "azstreamingserde"
+	moduleVersion = "v0.1.0"
+)
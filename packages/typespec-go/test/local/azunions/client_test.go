@@ -0,0 +1,99 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azunions_test
+
+import (
+	"azunions"
+	"azunions/fake"
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+)
+
+func newClient(t *testing.T, srv *fake.Server) *azunions.Client {
+	client, err := azunions.NewClientWithNoCredential("http://localhost:3000", &azunions.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerTransport(srv),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestClient_GetFavorite(t *testing.T) {
+	client := newClient(t, &fake.Server{
+		GetFavorite: func(ctx context.Context, options *azunions.ClientGetFavoriteOptions) (resp azfake.Responder[azunions.ClientGetFavoriteResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, azunions.ClientGetFavoriteResponse{
+				Value: &azunions.PetOrNameDog{Value: &azunions.Dog{Name: to.Ptr("rex"), Breed: to.Ptr("lab")}},
+			}, nil)
+			return
+		},
+	})
+	resp, err := client.GetFavorite(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &azunions.PetOrNameDog{Value: &azunions.Dog{Name: to.Ptr("rex"), Breed: to.Ptr("lab")}}
+	if !reflect.DeepEqual(want, resp.Value) {
+		t.Fatalf("want %#v, got %#v", want, resp.Value)
+	}
+}
+
+func TestClient_SetFavorite(t *testing.T) {
+	for _, favorite := range []azunions.PetOrName{
+		&azunions.PetOrNameCat{Value: &azunions.Cat{Name: to.Ptr("tom")}},
+		&azunions.PetOrNameDog{Value: &azunions.Dog{Name: to.Ptr("rex"), Breed: to.Ptr("lab")}},
+		&azunions.PetOrNameInt32{Value: 7},
+		&azunions.PetOrNameString{Value: "rex"},
+	} {
+		var got azunions.PetOrName
+		client := newClient(t, &fake.Server{
+			SetFavorite: func(ctx context.Context, favorite azunions.PetOrName, options *azunions.ClientSetFavoriteOptions) (resp azfake.Responder[azunions.ClientSetFavoriteResponse], errResp azfake.ErrorResponder) {
+				got = favorite
+				resp.SetResponse(http.StatusNoContent, azunions.ClientSetFavoriteResponse{}, nil)
+				return
+			},
+		})
+		if _, err := client.SetFavorite(context.Background(), favorite, nil); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(favorite, got) {
+			t.Fatalf("want %#v, got %#v", favorite, got)
+		}
+	}
+}
+
+func TestClient_PutHousehold(t *testing.T) {
+	household := azunions.Household{
+		Favorite: &azunions.PetOrNameDog{Value: &azunions.Dog{Name: to.Ptr("rex"), Breed: to.Ptr("lab")}},
+		Pets: []azunions.PetOrName{
+			&azunions.PetOrNameCat{Value: &azunions.Cat{Name: to.Ptr("tom")}},
+			&azunions.PetOrNameInt32{Value: 7},
+		},
+		Rooms: map[string]azunions.PetOrName{
+			"yard": &azunions.PetOrNameString{Value: "rex"},
+		},
+		Setting: &azunions.SettingBool{Value: true},
+	}
+	client := newClient(t, &fake.Server{
+		PutHousehold: func(ctx context.Context, household azunions.Household, options *azunions.ClientPutHouseholdOptions) (resp azfake.Responder[azunions.ClientPutHouseholdResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, azunions.ClientPutHouseholdResponse{Household: household}, nil)
+			return
+		},
+	})
+	resp, err := client.PutHousehold(context.Background(), household, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(household, resp.Household) {
+		t.Fatalf("want %#v, got %#v", household, resp.Household)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azunions
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+)
+
+func TestHouseholdRoundTrip(t *testing.T) {
+	h := Household{
+		Favorite: &PetOrNameCat{Value: &Cat{Name: to.Ptr("whiskers")}},
+		Pets: []PetOrName{
+			&PetOrNameInt32{Value: 7},
+			&PetOrNameString{Value: "rex"},
+		},
+		Rooms: map[string]PetOrName{
+			"kitchen": &PetOrNameCat{Value: &Cat{Name: to.Ptr("tom")}},
+		},
+		Setting: &SettingStringArray{Value: []*string{to.Ptr("quiet"), to.Ptr("warm")}},
+	}
+	data, err := json.Marshal(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = `{"favorite":{"name":"whiskers"},"pets":[7,"rex"],"rooms":{"kitchen":{"name":"tom"}},"setting":["quiet","warm"]}`
+	if string(data) != want {
+		t.Fatalf("want %s, got %s", want, data)
+	}
+	var got Household
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(h, got) {
+		t.Fatalf("round trip mismatch:\nwant %#v\ngot  %#v", h, got)
+	}
+}
+
+func TestUnmarshalSettingVariants(t *testing.T) {
+	for _, tc := range []struct {
+		data string
+		want Setting
+	}{
+		{data: `"loud"`, want: &SettingString{Value: "loud"}},
+		{data: `1.5`, want: &SettingFloat64{Value: 1.5}},
+		{data: `true`, want: &SettingBool{Value: true}},
+		{data: `null`, want: nil},
+	} {
+		got, err := unmarshalSetting(json.RawMessage(tc.data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(tc.want, got) {
+			t.Fatalf("%s: want %#v, got %#v", tc.data, tc.want, got)
+		}
+	}
+}
+
+func TestUnmarshalPetOrNameNoMatch(t *testing.T) {
+	if _, err := unmarshalPetOrName(json.RawMessage(`1.5`)); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestUnmarshalPetOrNameStrictMatch(t *testing.T) {
+	for _, tc := range []struct {
+		data string
+		want PetOrName
+	}{
+		{data: `{"name":"tom"}`, want: &PetOrNameCat{Value: &Cat{Name: to.Ptr("tom")}}},
+		{data: `{"name":"rex","breed":"lab"}`, want: &PetOrNameDog{Value: &Dog{Name: to.Ptr("rex"), Breed: to.Ptr("lab")}}},
+		{data: `{"breed":"lab"}`, want: &PetOrNameDog{Value: &Dog{Breed: to.Ptr("lab")}}},
+		{data: `{"name":"tom","color":"grey"}`, want: &PetOrNameCat{Value: &Cat{Name: to.Ptr("tom")}}},
+	} {
+		got, err := unmarshalPetOrName(json.RawMessage(tc.data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(tc.want, got) {
+			t.Fatalf("%s: want %#v, got %#v", tc.data, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package azvalidation_test
+
+import (
+	"azvalidation"
+	"azvalidation/fake"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, srv *fake.Server) *azvalidation.Client {
+	client, err := azvalidation.NewClientWithNoCredential("https://contoso.com", &azvalidation.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewServerTransport(srv),
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestPutWidgetValid(t *testing.T) {
+	client := newClient(t, &fake.Server{
+		PutWidget: func(ctx context.Context, name string, widget azvalidation.Widget, options *azvalidation.ClientPutWidgetOptions) (resp azfake.Responder[azvalidation.ClientPutWidgetResponse], errResp azfake.ErrorResponder) {
+			require.EqualValues(t, "gear", name)
+			require.NotNil(t, options)
+			require.EqualValues(t, 50, *options.Top)
+			resp.SetResponse(http.StatusOK, azvalidation.ClientPutWidgetResponse{Widget: widget}, nil)
+			return
+		},
+	})
+	widget := azvalidation.Widget{
+		Name:  to.Ptr("gear-1"),
+		Count: to.Ptr[int32](100),
+		Parts: []*azvalidation.Part{{SKU: to.Ptr("abcde")}},
+		Ratio: to.Ptr(1.5),
+		Tags:  []*string{to.Ptr("a"), to.Ptr("b"), to.Ptr("c")},
+	}
+	require.NoError(t, widget.Validate())
+	resp, err := client.PutWidget(context.Background(), "gear", widget, &azvalidation.ClientPutWidgetOptions{
+		Top: to.Ptr[int32](50),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, widget, resp.Widget)
+}
+
+func TestPutWidgetInvalid(t *testing.T) {
+	client := newClient(t, &fake.Server{
+		PutWidget: func(ctx context.Context, name string, widget azvalidation.Widget, options *azvalidation.ClientPutWidgetOptions) (resp azfake.Responder[azvalidation.ClientPutWidgetResponse], errResp azfake.ErrorResponder) {
+			t.Fatal("request should not have been sent")
+			return
+		},
+	})
+	widget := azvalidation.Widget{
+		Name:  to.Ptr("Gear"),
+		Count: to.Ptr[int32](0),
+		Parts: []*azvalidation.Part{{SKU: to.Ptr("abcde")}, nil, {SKU: to.Ptr("abcdef")}},
+		Ratio: to.Ptr(1.75),
+		Tags:  []*string{to.Ptr("a"), to.Ptr("b"), to.Ptr("c"), to.Ptr("d")},
+	}
+	_, err := client.PutWidget(context.Background(), "this-name-is-too-long", widget, &azvalidation.ClientPutWidgetOptions{
+		Top: to.Ptr[int32](0),
+	})
+	var validationErr *azvalidation.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.EqualValues(t, []azvalidation.ConstraintViolation{
+		{Path: "name", Constraint: "maxLength", Message: "length must be at most 10"},
+		{Path: "options.Top", Constraint: "minValue", Message: "must be greater than or equal to 1"},
+		{Path: "widget.Name", Constraint: "pattern", Message: "must match the pattern ^[a-z][a-z0-9-]*$"},
+		{Path: "widget.Count", Constraint: "minValue", Message: "must be greater than or equal to 1"},
+		{Path: "widget.Parts[2].SKU", Constraint: "maxLength", Message: "length must be at most 5"},
+		{Path: "widget.Ratio", Constraint: "maxValue", Message: "must be less than or equal to 1.5"},
+		{Path: "widget.Tags", Constraint: "maxItems", Message: "must contain at most 3 items"},
+	}, validationErr.Violations)
+	require.Contains(t, err.Error(), "name: length must be at most 10; options.Top: must be greater than or equal to 1")
+}
+
+func TestWidgetValidate(t *testing.T) {
+	require.NoError(t, (&azvalidation.Widget{}).Validate())
+
+	err := (&azvalidation.Widget{Name: to.Ptr("")}).Validate()
+	var validationErr *azvalidation.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.EqualValues(t, []azvalidation.ConstraintViolation{
+		{Path: "Widget.Name", Constraint: "minLength", Message: "length must be at least 1"},
+		{Path: "Widget.Name", Constraint: "pattern", Message: "must match the pattern ^[a-z][a-z0-9-]*$"},
+	}, validationErr.Violations)
+
+	require.NoError(t, (&azvalidation.Widget{Weight: to.Ptr(9.99)}).Validate())
+	err = (&azvalidation.Widget{Tags: []*string{}, Weight: to.Ptr(10.0)}).Validate()
+	require.True(t, errors.As(err, &validationErr))
+	require.EqualValues(t, []azvalidation.ConstraintViolation{
+		{Path: "Widget.Tags", Constraint: "minItems", Message: "must contain at least 1 items"},
+		{Path: "Widget.Weight", Constraint: "maxValueExclusive", Message: "must be less than 10"},
+	}, validationErr.Violations)
+}
+
+func TestPutPartsInvalid(t *testing.T) {
+	client := newClient(t, &fake.Server{
+		PutParts: func(ctx context.Context, parts []*azvalidation.Part, options *azvalidation.ClientPutPartsOptions) (resp azfake.Responder[azvalidation.ClientPutPartsResponse], errResp azfake.ErrorResponder) {
+			require.Len(t, parts, 2)
+			resp.SetResponse(http.StatusNoContent, azvalidation.ClientPutPartsResponse{}, nil)
+			return
+		},
+	})
+	_, err := client.PutParts(context.Background(), []*azvalidation.Part{{SKU: to.Ptr("abc"), Quantity: to.Ptr[int32](1)}, nil}, nil)
+	require.NoError(t, err)
+
+	_, err = client.PutParts(context.Background(), []*azvalidation.Part{{SKU: to.Ptr("abc")}, nil, {SKU: to.Ptr("abcdef"), Quantity: to.Ptr[int32](0)}}, nil)
+	var validationErr *azvalidation.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.EqualValues(t, []azvalidation.ConstraintViolation{
+		{Path: "parts[2].SKU", Constraint: "maxLength", Message: "length must be at most 5"},
+		{Path: "parts[2].Quantity", Constraint: "minValueExclusive", Message: "must be greater than 0"},
+	}, validationErr.Violations)
+}
+
+func TestPutShapeValidation(t *testing.T) {
+	client := newClient(t, &fake.Server{
+		PutShape: func(ctx context.Context, shape azvalidation.ShapeClassification, options *azvalidation.ClientPutShapeOptions) (resp azfake.Responder[azvalidation.ClientPutShapeResponse], errResp azfake.ErrorResponder) {
+			square, ok := shape.(*azvalidation.Square)
+			require.True(t, ok)
+			require.EqualValues(t, 99, *square.Side)
+			resp.SetResponse(http.StatusNoContent, azvalidation.ClientPutShapeResponse{}, nil)
+			return
+		},
+	})
+	_, err := client.PutShape(context.Background(), &azvalidation.Square{Side: to.Ptr[int32](99)}, nil)
+	require.NoError(t, err)
+
+	_, err = client.PutShape(context.Background(), &azvalidation.Square{Side: to.Ptr[int32](100)}, nil)
+	var validationErr *azvalidation.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.EqualValues(t, []azvalidation.ConstraintViolation{
+		{Path: "shape.Side", Constraint: "maxValueExclusive", Message: "must be less than 100"},
+	}, validationErr.Violations)
+
+	_, err = client.PutShape(context.Background(), &azvalidation.Circle{Radius: to.Ptr(0.0)}, nil)
+	require.True(t, errors.As(err, &validationErr))
+	require.EqualValues(t, []azvalidation.ConstraintViolation{
+		{Path: "shape.Radius", Constraint: "minValueExclusive", Message: "must be greater than 0"},
+	}, validationErr.Violations)
+}
+
+type setHeaderPolicy struct {
+	name, value string
+}
+
+func (p setHeaderPolicy) Do(req *policy.Request) (*http.Response, error) {
+	req.Raw().Header.Set(p.name, p.value)
+	return req.Next()
+}
+
+func TestPaintWidgetFakeValidation(t *testing.T) {
+	srv := &fake.Server{
+		PaintWidget: func(ctx context.Context, name string, finish azvalidation.Finish, batchID string, widget azvalidation.Widget, options *azvalidation.ClientPaintWidgetOptions) (resp azfake.Responder[azvalidation.ClientPaintWidgetResponse], errResp azfake.ErrorResponder) {
+			require.EqualValues(t, azvalidation.FinishGloss, finish)
+			require.EqualValues(t, "batch1", batchID)
+			require.EqualValues(t, azvalidation.PriorityHigh, *options.Priority)
+			resp.SetResponse(http.StatusOK, azvalidation.ClientPaintWidgetResponse{Widget: widget}, nil)
+			return
+		},
+	}
+	widget := azvalidation.Widget{Name: to.Ptr("gear")}
+
+	client := newClient(t, srv)
+	resp, err := client.PaintWidget(context.Background(), "gear", azvalidation.FinishGloss, "batch1", widget, &azvalidation.ClientPaintWidgetOptions{
+		Priority: to.Ptr(azvalidation.PriorityHigh),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, widget, resp.Widget)
+
+	// Color is extensible so values that aren't predefined are permitted
+	resp, err = client.PaintWidget(context.Background(), "gear", azvalidation.FinishGloss, "batch1", widget, &azvalidation.ClientPaintWidgetOptions{
+		Color:    to.Ptr(azvalidation.Color("green")),
+		Priority: to.Ptr(azvalidation.PriorityHigh),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, widget, resp.Widget)
+
+	_, err = client.PaintWidget(context.Background(), "gear", azvalidation.Finish("glossy"), "", widget, &azvalidation.ClientPaintWidgetOptions{
+		Priority: to.Ptr(azvalidation.Priority("urgent")),
+	})
+	var respErr *azcore.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.EqualValues(t, http.StatusBadRequest, respErr.StatusCode)
+	require.EqualValues(t, "InvalidRequest", respErr.ErrorCode)
+	require.ErrorContains(t, err, `invalid value \"glossy\" for query parameter finish, expected one of gloss, matte; missing required header parameter x-ms-batch-id; invalid value \"urgent\" for header parameter x-ms-priority, expected one of high, low`)
+
+	client, err = azvalidation.NewClientWithNoCredential("https://contoso.com", &azvalidation.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			PerCallPolicies: []policy.Policy{setHeaderPolicy{name: "Content-Type", value: "text/plain"}},
+			Transport:       fake.NewServerTransport(srv),
+		},
+	})
+	require.NoError(t, err)
+	_, err = client.PaintWidget(context.Background(), "gear", azvalidation.FinishGloss, "batch1", widget, nil)
+	require.ErrorAs(t, err, &respErr)
+	require.ErrorContains(t, err, `invalid Content-Type \"text/plain\", expected \"application/json\"`)
+}
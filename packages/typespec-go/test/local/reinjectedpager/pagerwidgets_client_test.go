@@ -0,0 +1,155 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+package reinjectedpager_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"reinjectedpager"
+	"reinjectedpager/fake"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/stretchr/testify/require"
+)
+
+// nextLinkPolicy records the query string of each request and,
+// like the service, omits the query params from the next link
+type nextLinkPolicy struct {
+	queries []string
+}
+
+func (n *nextLinkPolicy) Do(req *policy.Request) (*http.Response, error) {
+	n.queries = append(n.queries, req.Raw().URL.RawQuery)
+	resp, err := req.Next()
+	if err != nil {
+		return nil, err
+	}
+	var page reinjectedpager.WidgetList
+	if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+		return nil, err
+	}
+	if page.NextLink != nil {
+		nextLink, err := url.Parse(*page.NextLink)
+		if err != nil {
+			return nil, err
+		}
+		nextLink.RawQuery = ""
+		page.NextLink = to.Ptr(nextLink.String())
+	}
+	body, err := json.Marshal(page)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+func TestPagerWidgetsClient_NewListPager(t *testing.T) {
+	linkPolicy := &nextLinkPolicy{}
+	client, err := reinjectedpager.NewPagerClientWithNoCredential("https://contoso.com", &reinjectedpager.PagerClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			PerRetryPolicies: []policy.Policy{linkPolicy},
+			Transport: fake.NewPagerServerTransport(&fake.PagerServer{
+				PagerWidgetsServer: fake.PagerWidgetsServer{
+					NewListPager: func(options *reinjectedpager.PagerWidgetsClientListOptions) (resp azfake.PagerResponder[reinjectedpager.PagerWidgetsClientListResponse]) {
+						require.NotNil(t, options)
+						require.EqualValues(t, "weight gt 0", *options.Filter)
+						require.EqualValues(t, 2, *options.Maxresults)
+						resp.AddPage(http.StatusOK, reinjectedpager.PagerWidgetsClientListResponse{
+							WidgetList: reinjectedpager.WidgetList{
+								Values: []*reinjectedpager.Widget{{Weight: to.Ptr[int32](1)}},
+							},
+						}, nil)
+						resp.AddPage(http.StatusOK, reinjectedpager.PagerWidgetsClientListResponse{
+							WidgetList: reinjectedpager.WidgetList{
+								Values: []*reinjectedpager.Widget{{Weight: to.Ptr[int32](2)}, {Weight: to.Ptr[int32](3)}},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	pager := client.NewPagerWidgetsClient().NewListPager(&reinjectedpager.PagerWidgetsClientListOptions{
+		Filter:     to.Ptr("weight gt 0"),
+		Maxresults: to.Ptr[int32](2),
+	})
+	widgets := 0
+	for pager.More() {
+		page, err := pager.NextPage(context.Background())
+		require.NoError(t, err)
+		widgets += len(page.Values)
+	}
+	require.EqualValues(t, 3, widgets)
+	// the query params omitted from the next link are re-applied to the next page request
+	require.EqualValues(t, []string{
+		"%24filter=weight%20gt%200&maxresults=2",
+		"%24filter=weight%20gt%200&maxresults=2",
+	}, linkPolicy.queries)
+}
+
+// mockPagerClient returns mockWidgetsClient for its sub-client
+type mockPagerClient struct {
+	widgets *mockWidgetsClient
+}
+
+func (m *mockPagerClient) NewPagerWidgetsClient() reinjectedpager.PagerWidgetsClientAPI {
+	return m.widgets
+}
+
+// mockWidgetsClient returns a single page with the specified widgets
+type mockWidgetsClient struct {
+	widgets []*reinjectedpager.Widget
+}
+
+func (m *mockWidgetsClient) NewListPager(options *reinjectedpager.PagerWidgetsClientListOptions) *runtime.Pager[reinjectedpager.PagerWidgetsClientListResponse] {
+	return runtime.NewPager(runtime.PagingHandler[reinjectedpager.PagerWidgetsClientListResponse]{
+		More: func(reinjectedpager.PagerWidgetsClientListResponse) bool {
+			return false
+		},
+		Fetcher: func(context.Context, *reinjectedpager.PagerWidgetsClientListResponse) (reinjectedpager.PagerWidgetsClientListResponse, error) {
+			return reinjectedpager.PagerWidgetsClientListResponse{
+				WidgetList: reinjectedpager.WidgetList{Values: m.widgets},
+			}, nil
+		},
+	})
+}
+
+func totalWeight(ctx context.Context, client reinjectedpager.PagerClientAPI) (int32, error) {
+	var total int32
+	pager := client.NewPagerWidgetsClient().NewListPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, widget := range page.Values {
+			total += *widget.Weight
+		}
+	}
+	return total, nil
+}
+
+func TestPagerClientAPI_Mock(t *testing.T) {
+	total, err := totalWeight(context.Background(), &mockPagerClient{
+		widgets: &mockWidgetsClient{
+			widgets: []*reinjectedpager.Widget{{Weight: to.Ptr[int32](4)}, {Weight: to.Ptr[int32](5)}},
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 9, total)
+}